@@ -10,6 +10,7 @@ import (
 	"io"
 	"log"
 	"os"
+	"strings"
 	"sync"
 
 	"github.com/ginuerzh/dicom"
@@ -21,6 +22,7 @@ var (
 	filepath            = flag.String("path", "", "path")
 	extractImagesStream = flag.Bool("extract-images-stream", false, "Extract images using frame streaming capability")
 	printJSON           = flag.Bool("json", false, "Print dataset as JSON")
+	printDump           = flag.Bool("dump", false, "Print dataset as a compact dcmdump-style listing")
 )
 
 // FrameBufferSize represents the size of the *Frame buffered channel for streaming calls
@@ -28,6 +30,12 @@ const FrameBufferSize = 100
 
 func main() {
 	flag.Parse()
+	if flag.Arg(0) == "diff" {
+		os.Exit(runDiff(flag.Args()[1:]))
+	}
+	if flag.Arg(0) == "validate" {
+		os.Exit(runValidate(flag.Args()[1:]))
+	}
 	if len(*filepath) > 0 {
 
 		f, err := os.Open(*filepath)
@@ -62,32 +70,148 @@ func main() {
 			}
 
 			fmt.Println(string(j))
+		} else if *printDump {
+			if err := ds.Dump(os.Stdout, dicom.DumpMaxValueLength(64)); err != nil {
+				log.Fatalf("error dumping dataset: %v", err)
+			}
 		} else {
 			log.Println("Printing DICOM dataset parsed elements to stdout:")
 			fmt.Print(ds)
 
 			// In non-streaming frame mode, we need to find all PixelData elements and generate images.
-			for _, elem := range ds.Elements {
-				if elem.Tag == tag.PixelData && !*extractImagesStream {
-					writePixelDataElement(elem, "")
-				}
-				// TODO: remove image icon hack after implementing flat iterator
-				if elem.Tag == tag.IconImageSequence {
-					for _, item := range elem.Value.GetValue().([]*dicom.SequenceItemValue) {
-						for _, subElem := range item.GetValue().([]*dicom.Element) {
-							if subElem.Tag == tag.PixelData {
-								writePixelDataElement(subElem, "_icon")
-							}
-						}
+			if !*extractImagesStream {
+				ds.Walk(func(path dicom.Path, elem *dicom.Element) dicom.WalkAction {
+					if elem.Tag == tag.PixelData {
+						writePixelDataElement(elem, pixelDataSuffix(path))
 					}
-				}
-
+					return dicom.WalkContinue
+				})
 			}
 		}
 	}
 
 }
 
+// matchKeys is a flag.Value collecting "Sequence=Key" keyword pairs as
+// dicom.MatchItemsByKey options.
+type matchKeys []dicom.DiffOption
+
+func (m *matchKeys) String() string {
+	return ""
+}
+
+func (m *matchKeys) Set(v string) error {
+	parts := strings.SplitN(v, "=", 2)
+	if len(parts) != 2 {
+		return fmt.Errorf("want Sequence=Key, got %q", v)
+	}
+	seq, err := tag.FindByName(parts[0])
+	if err != nil {
+		return err
+	}
+	key, err := tag.FindByName(parts[1])
+	if err != nil {
+		return err
+	}
+	*m = append(*m, dicom.MatchItemsByKey(seq.Tag, key.Tag))
+	return nil
+}
+
+// runDiff implements "dicomutil diff [-json] [-match-key Sequence=Key]...
+// a.dcm b.dcm". Like diff(1), it returns 0 if the files are the same, 1 if
+// differences were found and 2 on error.
+func runDiff(args []string) int {
+	fs := flag.NewFlagSet("diff", flag.ExitOnError)
+	diffJSON := fs.Bool("json", false, "Print the differences as JSON")
+	var opts matchKeys
+	fs.Var(&opts, "match-key", "Match the items of a sequence by the value of a key element instead of by index, as Sequence=Key keywords (repeatable), for example ReferencedSeriesSequence=SeriesInstanceUID")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: dicomutil diff [-json] [-match-key Sequence=Key]... a.dcm b.dcm")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() != 2 {
+		fs.Usage()
+		return 2
+	}
+
+	a, err := dicom.ParseFile(fs.Arg(0), nil)
+	if err != nil {
+		log.Printf("error parsing %s: %v", fs.Arg(0), err)
+		return 2
+	}
+	b, err := dicom.ParseFile(fs.Arg(1), nil)
+	if err != nil {
+		log.Printf("error parsing %s: %v", fs.Arg(1), err)
+		return 2
+	}
+
+	report := dicom.Diff(a, b, opts...)
+	if *diffJSON {
+		j, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			log.Printf("error marshaling diff: %v", err)
+			return 2
+		}
+		fmt.Println(string(j))
+	} else {
+		fmt.Print(report)
+	}
+
+	if !report.Empty() {
+		return 1
+	}
+	return 0
+}
+
+// runValidate implements "dicomutil validate [-json] file.dcm...". It checks
+// each file against the IOD of its SOP class and returns 0 if all files are
+// valid, 1 if errors were found and 2 if a file could not be validated.
+func runValidate(args []string) int {
+	fs := flag.NewFlagSet("validate", flag.ExitOnError)
+	validateJSON := fs.Bool("json", false, "Print the violations as JSON")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "usage: dicomutil validate [-json] file.dcm...")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	if fs.NArg() == 0 {
+		fs.Usage()
+		return 2
+	}
+
+	status := 0
+	for _, path := range fs.Args() {
+		ds, err := dicom.ParseFile(path, nil)
+		if err != nil {
+			log.Printf("error parsing %s: %v", path, err)
+			status = 2
+			continue
+		}
+		report, err := dicom.Validate(ds)
+		if err != nil {
+			log.Printf("error validating %s: %v", path, err)
+			status = 2
+			continue
+		}
+		if *validateJSON {
+			j, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				log.Printf("error marshaling report: %v", err)
+				return 2
+			}
+			fmt.Println(string(j))
+		} else {
+			fmt.Printf("%s: %s\n", path, report.IOD)
+			fmt.Print(report)
+		}
+		if !report.Valid() && status == 0 {
+			status = 1
+		}
+	}
+	return status
+}
+
 func parseWithStreaming(in io.Reader, size int64) *dicom.Dataset {
 	fc := make(chan *frame.Frame, FrameBufferSize)
 
@@ -162,6 +286,23 @@ func generateImage(fr *frame.Frame, frameIndex int, frameSuffix string, wg *sync
 	}
 }
 
+// pixelDataSuffix returns the suffix of the image file names of the PixelData
+// at path, so that nested PixelData elements do not overwrite each other.
+// Each enclosing item adds "_icon" for an IconImageSequence, or else the tag
+// of its sequence, followed by its index, as in "_icon_0".
+func pixelDataSuffix(path dicom.Path) string {
+	var b strings.Builder
+	for _, item := range path.Items {
+		if item.Sequence == tag.IconImageSequence {
+			b.WriteString("_icon")
+		} else {
+			fmt.Fprintf(&b, "_%04x%04x", item.Sequence.Group, item.Sequence.Element)
+		}
+		fmt.Fprintf(&b, "_%d", item.Index)
+	}
+	return b.String()
+}
+
 func writePixelDataElement(e *dicom.Element, suffix string) {
 	imageInfo := e.Value.GetValue().(dicom.PixelDataInfo)
 	for idx, f := range imageInfo.Frames {
@@ -29,49 +29,56 @@ type Frame struct {
 	Size           int64 `json:"size"`
 }
 
+const (
+	privateCreator = "GODICOM PARSE EXAMPLE"
+	privateGroup   = 0x0015
+)
+
 var (
-	dict = map[tag.Tag]tag.TagInfo{
-		{Group: 0x0015, Element: 0x0001}: {VR: "AE", Name: "0015,0001", VM: "1"},
-		{Group: 0x0015, Element: 0x0002}: {VR: "AS", Name: "0015,0002", VM: "1"},
-		{Group: 0x0015, Element: 0x0003}: {VR: "AT", Name: "0015,0003", VM: "1"},
-		{Group: 0x0015, Element: 0x0004}: {VR: "CS", Name: "0015,0004", VM: "1"},
-		{Group: 0x0015, Element: 0x0005}: {VR: "DA", Name: "0015,0005", VM: "1"},
-		{Group: 0x0015, Element: 0x0006}: {VR: "DS", Name: "0015,0006", VM: "1"},
-		{Group: 0x0015, Element: 0x0007}: {VR: "DT", Name: "0015,0007", VM: "1"},
-		{Group: 0x0015, Element: 0x0008}: {VR: "FL", Name: "0015,0008", VM: "1"},
-		{Group: 0x0015, Element: 0x0009}: {VR: "FD", Name: "0015,0009", VM: "1"},
-		{Group: 0x0015, Element: 0x0010}: {VR: "IS", Name: "0015,0010", VM: "1"},
-		{Group: 0x0015, Element: 0x0011}: {VR: "LO", Name: "0015,0011", VM: "1"},
-		{Group: 0x0015, Element: 0x0012}: {VR: "LT", Name: "0015,0012", VM: "1"},
-		{Group: 0x0015, Element: 0x0013}: {VR: "OB", Name: "0015,0013", VM: "1"},
-		{Group: 0x0015, Element: 0x0014}: {VR: "OD", Name: "0015,0014", VM: "1"},
-		{Group: 0x0015, Element: 0x0015}: {VR: "OF", Name: "0015,0015", VM: "1"},
-		{Group: 0x0015, Element: 0x0016}: {VR: "OL", Name: "0015,0016", VM: "1"},
-		{Group: 0x0015, Element: 0x0017}: {VR: "OV", Name: "0015,0017", VM: "1"},
-		{Group: 0x0015, Element: 0x0018}: {VR: "OW", Name: "0015,0018", VM: "1"},
-		{Group: 0x0015, Element: 0x0019}: {VR: "PN", Name: "0015,0019", VM: "1"},
-		{Group: 0x0015, Element: 0x0020}: {VR: "SH", Name: "0015,0020", VM: "1"},
-		{Group: 0x0015, Element: 0x0021}: {VR: "SL", Name: "0015,0021", VM: "1"},
-		{Group: 0x0015, Element: 0x0022}: {VR: "SQ", Name: "0015,0022", VM: "1"},
-		{Group: 0x0015, Element: 0x0023}: {VR: "SS", Name: "0015,0023", VM: "1"},
-		{Group: 0x0015, Element: 0x0024}: {VR: "ST", Name: "0015,0024", VM: "1"},
-		{Group: 0x0015, Element: 0x0025}: {VR: "SV", Name: "0015,0025", VM: "1"},
-		{Group: 0x0015, Element: 0x0026}: {VR: "TM", Name: "0015,0026", VM: "1"},
-		{Group: 0x0015, Element: 0x0027}: {VR: "UC", Name: "0015,0027", VM: "1"},
-		{Group: 0x0015, Element: 0x0028}: {VR: "UI", Name: "0015,0028", VM: "1"},
-		{Group: 0x0015, Element: 0x0029}: {VR: "UL", Name: "0015,0029", VM: "1"},
-		{Group: 0x0015, Element: 0x0030}: {VR: "UR", Name: "0015,0030", VM: "1"},
-		{Group: 0x0015, Element: 0x0031}: {VR: "UN", Name: "0015,0031", VM: "1"},
-		{Group: 0x0015, Element: 0x0032}: {VR: "US", Name: "0015,0032", VM: "1"},
-		{Group: 0x0015, Element: 0x0033}: {VR: "UT", Name: "0015,0033", VM: "1"},
-		{Group: 0x0015, Element: 0x0034}: {VR: "UV", Name: "0015,0034", VM: "1"},
+	// dict is the private dictionary of privateCreator. Its elements are
+	// found in whichever block of privateGroup the creator reserved.
+	dict = map[tag.PrivateTag]tag.TagInfo{
+		{Creator: privateCreator, Group: privateGroup, Element: 0x01}: {VR: "AE", Name: "PrivateAE", VM: "1"},
+		{Creator: privateCreator, Group: privateGroup, Element: 0x02}: {VR: "AS", Name: "PrivateAS", VM: "1"},
+		{Creator: privateCreator, Group: privateGroup, Element: 0x03}: {VR: "AT", Name: "PrivateAT", VM: "1"},
+		{Creator: privateCreator, Group: privateGroup, Element: 0x04}: {VR: "CS", Name: "PrivateCS", VM: "1"},
+		{Creator: privateCreator, Group: privateGroup, Element: 0x05}: {VR: "DA", Name: "PrivateDA", VM: "1"},
+		{Creator: privateCreator, Group: privateGroup, Element: 0x06}: {VR: "DS", Name: "PrivateDS", VM: "1"},
+		{Creator: privateCreator, Group: privateGroup, Element: 0x07}: {VR: "DT", Name: "PrivateDT", VM: "1"},
+		{Creator: privateCreator, Group: privateGroup, Element: 0x08}: {VR: "FL", Name: "PrivateFL", VM: "1"},
+		{Creator: privateCreator, Group: privateGroup, Element: 0x09}: {VR: "FD", Name: "PrivateFD", VM: "1"},
+		{Creator: privateCreator, Group: privateGroup, Element: 0x10}: {VR: "IS", Name: "PrivateIS", VM: "1"},
+		{Creator: privateCreator, Group: privateGroup, Element: 0x11}: {VR: "LO", Name: "PrivateLO", VM: "1"},
+		{Creator: privateCreator, Group: privateGroup, Element: 0x12}: {VR: "LT", Name: "PrivateLT", VM: "1"},
+		{Creator: privateCreator, Group: privateGroup, Element: 0x13}: {VR: "OB", Name: "PrivateOB", VM: "1"},
+		{Creator: privateCreator, Group: privateGroup, Element: 0x14}: {VR: "OD", Name: "PrivateOD", VM: "1"},
+		{Creator: privateCreator, Group: privateGroup, Element: 0x15}: {VR: "OF", Name: "PrivateOF", VM: "1"},
+		{Creator: privateCreator, Group: privateGroup, Element: 0x16}: {VR: "OL", Name: "PrivateOL", VM: "1"},
+		{Creator: privateCreator, Group: privateGroup, Element: 0x17}: {VR: "OV", Name: "PrivateOV", VM: "1"},
+		{Creator: privateCreator, Group: privateGroup, Element: 0x18}: {VR: "OW", Name: "PrivateOW", VM: "1"},
+		{Creator: privateCreator, Group: privateGroup, Element: 0x19}: {VR: "PN", Name: "PrivatePN", VM: "1"},
+		{Creator: privateCreator, Group: privateGroup, Element: 0x20}: {VR: "SH", Name: "PrivateSH", VM: "1"},
+		{Creator: privateCreator, Group: privateGroup, Element: 0x21}: {VR: "SL", Name: "PrivateSL", VM: "1"},
+		{Creator: privateCreator, Group: privateGroup, Element: 0x22}: {VR: "SQ", Name: "PrivateSQ", VM: "1"},
+		{Creator: privateCreator, Group: privateGroup, Element: 0x23}: {VR: "SS", Name: "PrivateSS", VM: "1"},
+		{Creator: privateCreator, Group: privateGroup, Element: 0x24}: {VR: "ST", Name: "PrivateST", VM: "1"},
+		{Creator: privateCreator, Group: privateGroup, Element: 0x25}: {VR: "SV", Name: "PrivateSV", VM: "1"},
+		{Creator: privateCreator, Group: privateGroup, Element: 0x26}: {VR: "TM", Name: "PrivateTM", VM: "1"},
+		{Creator: privateCreator, Group: privateGroup, Element: 0x27}: {VR: "UC", Name: "PrivateUC", VM: "1"},
+		{Creator: privateCreator, Group: privateGroup, Element: 0x28}: {VR: "UI", Name: "PrivateUI", VM: "1"},
+		{Creator: privateCreator, Group: privateGroup, Element: 0x29}: {VR: "UL", Name: "PrivateUL", VM: "1"},
+		{Creator: privateCreator, Group: privateGroup, Element: 0x30}: {VR: "UR", Name: "PrivateUR", VM: "1"},
+		{Creator: privateCreator, Group: privateGroup, Element: 0x31}: {VR: "UN", Name: "PrivateUN", VM: "1"},
+		{Creator: privateCreator, Group: privateGroup, Element: 0x32}: {VR: "US", Name: "PrivateUS", VM: "1"},
+		{Creator: privateCreator, Group: privateGroup, Element: 0x33}: {VR: "UT", Name: "PrivateUT", VM: "1"},
+		{Creator: privateCreator, Group: privateGroup, Element: 0x34}: {VR: "UV", Name: "PrivateUV", VM: "1"},
 	}
 )
 
 func main() {
 	log.SetFlags(log.LstdFlags | log.Lshortfile)
 
-	tag.SetCustomDict(dict)
+	tag.SetPrivateDict(dict)
 
 	fname := "us_valid_pixel_aspect"
 	ds, err := dicom.ParseFile(fname+".dcm", nil)
@@ -105,15 +112,15 @@ func main() {
 }
 
 func encodeDataSet(ds dicom.Dataset) ([]byte, error) {
-	m := encodeElement(ds.Elements)
+	m := encodeElement(ds.Elements, ds.TagInfo)
 	return json.MarshalIndent(m, "", "  ")
 }
 
-func encodeElement(elements []*dicom.Element) map[string]*Element {
+func encodeElement(elements []*dicom.Element, tagInfo func(tag.Tag) (tag.TagInfo, error)) map[string]*Element {
 	em := make(map[string]*Element)
 
 	for _, e := range elements {
-		info, err := tag.Find(e.Tag)
+		info, err := tagInfo(e.Tag)
 		if err != nil {
 			log.Println("find Tag:", e.Tag, err)
 		}
@@ -161,7 +168,7 @@ func encodeElement(elements []*dicom.Element) map[string]*Element {
 			}
 		case dicom.Sequences:
 			for _, item := range e.Value.GetValue().([]*dicom.SequenceItemValue) {
-				el.Values = append(el.Values, encodeElement(item.GetValue().([]*dicom.Element)))
+				el.Values = append(el.Values, encodeElement(item.GetValue().([]*dicom.Element), item.TagInfo))
 			}
 		}
 
@@ -186,339 +193,50 @@ func extractPixelData(info dicom.PixelDataInfo) {
 }
 
 func addElement(ds *dicom.Dataset) {
-	t := tag.Tag{Group: 0x0015, Element: 0x0001}
-	v, _ := dicom.NewValue([]string{"AppEntity"})
-	ds.Elements = append(ds.Elements, &dicom.Element{
-		Tag:                    t,
-		RawValueRepresentation: "AE",
-		ValueRepresentation:    tag.GetVRKind(t, "AE"),
-		ValueLength:            10,
-		Value:                  v,
-	})
-
-	t = tag.Tag{Group: 0x0015, Element: 0x0002}
-	v, _ = dicom.NewValue([]string{"018M"})
-	ds.Elements = append(ds.Elements, &dicom.Element{
-		Tag:                    t,
-		RawValueRepresentation: "AS",
-		ValueRepresentation:    tag.GetVRKind(t, "AS"),
-		ValueLength:            4,
-		Value:                  v,
-	})
-
-	t = tag.Tag{Group: 0x0015, Element: 0x0003}
-	v, _ = dicom.NewValue([]uint64{0x12345678})
-	ds.Elements = append(ds.Elements, &dicom.Element{
-		Tag:                    t,
-		RawValueRepresentation: "AT",
-		ValueRepresentation:    tag.GetVRKind(t, "AT"),
-		ValueLength:            4,
-		Value:                  v,
-	})
-
-	t = tag.Tag{Group: 0x0015, Element: 0x0004}
-	v, _ = dicom.NewValue([]string{"CS_VR", "TEST"})
-	ds.Elements = append(ds.Elements, &dicom.Element{
-		Tag:                    t,
-		RawValueRepresentation: "CS",
-		ValueRepresentation:    tag.GetVRKind(t, "CS"),
-		ValueLength:            10,
-		Value:                  v,
-	})
-
-	t = tag.Tag{Group: 0x0015, Element: 0x0005}
-	v, _ = dicom.NewValue([]string{"20201112"})
-	ds.Elements = append(ds.Elements, &dicom.Element{
-		Tag:                    t,
-		RawValueRepresentation: "DA",
-		ValueRepresentation:    tag.GetVRKind(t, "DA"),
-		ValueLength:            8,
-		Value:                  v,
-	})
-
-	t = tag.Tag{Group: 0x0015, Element: 0x0006}
-	v, _ = dicom.NewValue([]string{"123.456"})
-	ds.Elements = append(ds.Elements, &dicom.Element{
-		Tag:                    t,
-		RawValueRepresentation: "DS",
-		ValueRepresentation:    tag.GetVRKind(t, "DS"),
-		ValueLength:            8,
-		Value:                  v,
-	})
-
-	t = tag.Tag{Group: 0x0015, Element: 0x0007}
-	v, _ = dicom.NewValue([]string{"20201112142400.999999+0800"})
-	ds.Elements = append(ds.Elements, &dicom.Element{
-		Tag:                    t,
-		RawValueRepresentation: "DT",
-		ValueRepresentation:    tag.GetVRKind(t, "DT"),
-		ValueLength:            16,
-		Value:                  v,
-	})
-
-	t = tag.Tag{Group: 0x0015, Element: 0x0008}
-	v, _ = dicom.NewValue([]float64{-123.456})
-	ds.Elements = append(ds.Elements, &dicom.Element{
-		Tag:                    t,
-		RawValueRepresentation: "FL",
-		ValueRepresentation:    tag.GetVRKind(t, "FL"),
-		ValueLength:            4,
-		Value:                  v,
-	})
-
-	t = tag.Tag{Group: 0x0015, Element: 0x0009}
-	v, _ = dicom.NewValue([]float64{-123.456789})
-	ds.Elements = append(ds.Elements, &dicom.Element{
-		Tag:                    t,
-		RawValueRepresentation: "FD",
-		ValueRepresentation:    tag.GetVRKind(t, "FD"),
-		ValueLength:            8,
-		Value:                  v,
-	})
-
-	t = tag.Tag{Group: 0x0015, Element: 0x0010}
-	v, _ = dicom.NewValue([]string{"-123456"})
-	ds.Elements = append(ds.Elements, &dicom.Element{
-		Tag:                    t,
-		RawValueRepresentation: "IS",
-		ValueRepresentation:    tag.GetVRKind(t, "IS"),
-		ValueLength:            8,
-		Value:                  v,
-	})
-
-	t = tag.Tag{Group: 0x0015, Element: 0x0011}
-	v, _ = dicom.NewValue([]string{"-LO123456"})
-	ds.Elements = append(ds.Elements, &dicom.Element{
-		Tag:                    t,
-		RawValueRepresentation: "LO",
-		ValueRepresentation:    tag.GetVRKind(t, "LO"),
-		ValueLength:            10,
-		Value:                  v,
-	})
-
-	t = tag.Tag{Group: 0x0015, Element: 0x0012}
-	v, _ = dicom.NewValue([]string{"+LT abcdefg 1234567"})
-	ds.Elements = append(ds.Elements, &dicom.Element{
-		Tag:                    t,
-		RawValueRepresentation: "LT",
-		ValueRepresentation:    tag.GetVRKind(t, "LT"),
-		ValueLength:            20,
-		Value:                  v,
-	})
-
-	t = tag.Tag{Group: 0x0015, Element: 0x0013}
-	v, _ = dicom.NewValue([]byte{'O', 'B', 'T', 'E', 'S', 'T', ','})
-	ds.Elements = append(ds.Elements, &dicom.Element{
-		Tag:                    t,
-		RawValueRepresentation: "OB",
-		ValueRepresentation:    tag.GetVRKind(t, "OB"),
-		ValueLength:            8,
-		Value:                  v,
-	})
-
-	t = tag.Tag{Group: 0x0015, Element: 0x0014}
-	v, _ = dicom.NewValue([]float64{-1234.56789, 1234.56789})
-	ds.Elements = append(ds.Elements, &dicom.Element{
-		Tag:                    t,
-		RawValueRepresentation: "OD",
-		ValueRepresentation:    tag.GetVRKind(t, "OD"),
-		ValueLength:            16,
-		Value:                  v,
-	})
-
-	t = tag.Tag{Group: 0x0015, Element: 0x0015}
-	v, _ = dicom.NewValue([]float64{-1234.5678, 1234.5678})
-	ds.Elements = append(ds.Elements, &dicom.Element{
-		Tag:                    t,
-		RawValueRepresentation: "OF",
-		ValueRepresentation:    tag.GetVRKind(t, "OF"),
-		ValueLength:            8,
-		Value:                  v,
-	})
-
-	t = tag.Tag{Group: 0x0015, Element: 0x0016}
-	v, _ = dicom.NewValue([]uint64{0x12345678, 0x87654321, 0xFFFFFFFF})
-	ds.Elements = append(ds.Elements, &dicom.Element{
-		Tag:                    t,
-		RawValueRepresentation: "OL",
-		ValueRepresentation:    tag.GetVRKind(t, "OL"),
-		ValueLength:            8,
-		Value:                  v,
-	})
-
-	t = tag.Tag{Group: 0x0015, Element: 0x0017}
-	v, _ = dicom.NewValue([]uint64{0x12345678ABCDEF, 0xFFFFFFFFFFFFFFFF})
-	ds.Elements = append(ds.Elements, &dicom.Element{
-		Tag:                    t,
-		RawValueRepresentation: "OV",
-		ValueRepresentation:    tag.GetVRKind(t, "OV"),
-		ValueLength:            16,
-		Value:                  v,
-	})
-
-	t = tag.Tag{Group: 0x0015, Element: 0x0018}
-	v, _ = dicom.NewValue([]byte{0x12, 0x34, 0x56, 0x78})
-	ds.Elements = append(ds.Elements, &dicom.Element{
-		Tag:                    t,
-		RawValueRepresentation: "OW",
-		ValueRepresentation:    tag.GetVRKind(t, "OW"),
-		ValueLength:            4,
-		Value:                  v,
-	})
-
-	t = tag.Tag{Group: 0x0015, Element: 0x0019}
-	v, _ = dicom.NewValue([]string{"PN TEST"})
-	ds.Elements = append(ds.Elements, &dicom.Element{
-		Tag:                    t,
-		RawValueRepresentation: "PN",
-		ValueRepresentation:    tag.GetVRKind(t, "PN"),
-		ValueLength:            8,
-		Value:                  v,
-	})
-
-	t = tag.Tag{Group: 0x0015, Element: 0x0020}
-	v, _ = dicom.NewValue([]string{"SH TEST"})
-	ds.Elements = append(ds.Elements, &dicom.Element{
-		Tag:                    t,
-		RawValueRepresentation: "SH",
-		ValueRepresentation:    tag.GetVRKind(t, "SH"),
-		ValueLength:            8,
-		Value:                  v,
-	})
-
-	t = tag.Tag{Group: 0x0015, Element: 0x0021}
-	v, _ = dicom.NewValue([]int64{-1})
-	ds.Elements = append(ds.Elements, &dicom.Element{
-		Tag:                    t,
-		RawValueRepresentation: "SL",
-		ValueRepresentation:    tag.GetVRKind(t, "SL"),
-		ValueLength:            4,
-		Value:                  v,
-	})
-
-	/*
-		t = tag.Tag{Group: 0x0015, Element: 0x0022}
-		v, _ = dicom.NewValue([]int{-0x12345678})
-		ds.Elements = append(ds.Elements, &dicom.Element{
-			Tag:                    t,
-			RawValueRepresentation: "SQ",
-			ValueRepresentation:    tag.GetVRKind(t, "SQ"),
-			ValueLength:            4,
-			Value:                  v,
-		})
-	*/
-
-	t = tag.Tag{Group: 0x0015, Element: 0x0023}
-	v, _ = dicom.NewValue([]int64{-1})
-	ds.Elements = append(ds.Elements, &dicom.Element{
-		Tag:                    t,
-		RawValueRepresentation: "SS",
-		ValueRepresentation:    tag.GetVRKind(t, "SS"),
-		ValueLength:            2,
-		Value:                  v,
-	})
-
-	t = tag.Tag{Group: 0x0015, Element: 0x0024}
-	v, _ = dicom.NewValue([]string{"ST TEST"})
-	ds.Elements = append(ds.Elements, &dicom.Element{
-		Tag:                    t,
-		RawValueRepresentation: "ST",
-		ValueRepresentation:    tag.GetVRKind(t, "ST"),
-		ValueLength:            8,
-		Value:                  v,
-	})
-
-	t = tag.Tag{Group: 0x0015, Element: 0x0025}
-	v, _ = dicom.NewValue([]int64{-1})
-	ds.Elements = append(ds.Elements, &dicom.Element{
-		Tag:                    t,
-		RawValueRepresentation: "SV",
-		ValueRepresentation:    tag.GetVRKind(t, "SV"),
-		ValueLength:            8,
-		Value:                  v,
-	})
-
-	t = tag.Tag{Group: 0x0015, Element: 0x0026}
-	v, _ = dicom.NewValue([]string{"160102.999999"})
-	ds.Elements = append(ds.Elements, &dicom.Element{
-		Tag:                    t,
-		RawValueRepresentation: "TM",
-		ValueRepresentation:    tag.GetVRKind(t, "TM"),
-		ValueLength:            14,
-		Value:                  v,
-	})
-
-	t = tag.Tag{Group: 0x0015, Element: 0x0027}
-	v, _ = dicom.NewValue([]string{"UC TEST"})
-	ds.Elements = append(ds.Elements, &dicom.Element{
-		Tag:                    t,
-		RawValueRepresentation: "UC",
-		ValueRepresentation:    tag.GetVRKind(t, "UC"),
-		ValueLength:            8,
-		Value:                  v,
-	})
-
-	t = tag.Tag{Group: 0x0015, Element: 0x0028}
-	v, _ = dicom.NewValue([]string{"1.2.3.4.5.6.7.8"})
-	ds.Elements = append(ds.Elements, &dicom.Element{
-		Tag:                    t,
-		RawValueRepresentation: "UI",
-		ValueRepresentation:    tag.GetVRKind(t, "UI"),
-		ValueLength:            16,
-		Value:                  v,
-	})
-
-	t = tag.Tag{Group: 0x0015, Element: 0x0029}
-	v, _ = dicom.NewValue([]uint64{0xffffffff})
-	ds.Elements = append(ds.Elements, &dicom.Element{
-		Tag:                    t,
-		RawValueRepresentation: "UL",
-		ValueRepresentation:    tag.GetVRKind(t, "UL"),
-		ValueLength:            4,
-		Value:                  v,
-	})
-
-	/*
-		UN
-	*/
-
-	t = tag.Tag{Group: 0x0015, Element: 0x0030}
-	v, _ = dicom.NewValue([]string{"https://www.dadax.cn"})
-	ds.Elements = append(ds.Elements, &dicom.Element{
-		Tag:                    t,
-		RawValueRepresentation: "UR",
-		ValueRepresentation:    tag.GetVRKind(t, "UR"),
-		ValueLength:            20,
-		Value:                  v,
-	})
-
-	t = tag.Tag{Group: 0x0015, Element: 0x0032}
-	v, _ = dicom.NewValue([]uint64{0xffff})
-	ds.Elements = append(ds.Elements, &dicom.Element{
-		Tag:                    t,
-		RawValueRepresentation: "US",
-		ValueRepresentation:    tag.GetVRKind(t, "US"),
-		ValueLength:            2,
-		Value:                  v,
-	})
-
-	t = tag.Tag{Group: 0x0015, Element: 0x0033}
-	v, _ = dicom.NewValue([]string{"UT TEST"})
-	ds.Elements = append(ds.Elements, &dicom.Element{
-		Tag:                    t,
-		RawValueRepresentation: "UT",
-		ValueRepresentation:    tag.GetVRKind(t, "UT"),
-		ValueLength:            8,
-		Value:                  v,
-	})
-
-	t = tag.Tag{Group: 0x0015, Element: 0x0034}
-	v, _ = dicom.NewValue([]uint64{0xFFFFFFFFFFFFFFFF})
-	ds.Elements = append(ds.Elements, &dicom.Element{
-		Tag:                    t,
-		RawValueRepresentation: "UV",
-		ValueRepresentation:    tag.GetVRKind(t, "UV"),
-		ValueLength:            8,
-		Value:                  v,
-	})
+	values := []struct {
+		offset uint8
+		vr     string
+		data   interface{}
+	}{
+		{0x01, "AE", []string{"AppEntity"}},
+		{0x02, "AS", []string{"018M"}},
+		{0x03, "AT", []uint64{0x12345678}},
+		{0x04, "CS", []string{"CS_VR", "TEST"}},
+		{0x05, "DA", []string{"20201112"}},
+		{0x06, "DS", []string{"123.456"}},
+		{0x07, "DT", []string{"20201112142400.999999+0800"}},
+		{0x08, "FL", []float64{-123.456}},
+		{0x09, "FD", []float64{-123.456789}},
+		{0x10, "IS", []string{"-123456"}},
+		{0x11, "LO", []string{"-LO123456"}},
+		{0x12, "LT", []string{"+LT abcdefg 1234567"}},
+		{0x13, "OB", []byte{'O', 'B', 'T', 'E', 'S', 'T', ','}},
+		{0x14, "OD", []float64{-1234.56789, 1234.56789}},
+		{0x15, "OF", []float64{-1234.5678, 1234.5678}},
+		{0x16, "OL", []uint64{0x12345678, 0x87654321, 0xFFFFFFFF}},
+		{0x17, "OV", []uint64{0x12345678ABCDEF, 0xFFFFFFFFFFFFFFFF}},
+		{0x18, "OW", []byte{0x12, 0x34, 0x56, 0x78}},
+		{0x19, "PN", []string{"PN TEST"}},
+		{0x20, "SH", []string{"SH TEST"}},
+		{0x21, "SL", []int64{-1}},
+		{0x23, "SS", []int64{-1}},
+		{0x24, "ST", []string{"ST TEST"}},
+		{0x25, "SV", []int64{-1}},
+		{0x26, "TM", []string{"160102.999999"}},
+		{0x27, "UC", []string{"UC TEST"}},
+		{0x28, "UI", []string{"1.2.3.4.5.6.7.8"}},
+		{0x29, "UL", []uint64{0xffffffff}},
+		{0x30, "UR", []string{"https://www.dadax.cn"}},
+		{0x32, "US", []uint64{0xffff}},
+		{0x33, "UT", []string{"UT TEST"}},
+		{0x34, "UV", []uint64{0xFFFFFFFFFFFFFFFF}},
+	}
+	for _, v := range values {
+		e, err := ds.NewPrivateElement(privateGroup, privateCreator, v.offset, v.vr, v.data)
+		if err != nil {
+			log.Println(v.vr, err)
+			continue
+		}
+		ds.Set(e)
+	}
 }
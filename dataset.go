@@ -4,6 +4,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/ginuerzh/dicom/pkg/tag"
@@ -21,14 +22,42 @@ var ErrorElementNotFound = errors.New("element not found")
 // (implements json.Marshaler) and will also pretty print as a string nicely (see String example).
 // This Dataset includes several helper methods to find Elements within this dataset or iterate over every Element
 // within this Dataset (including Elements nested within Sequences).
+//
+// Elements should preferably be modified through Set, Delete and Update, which
+// keep them in ascending tag order without duplicates and keep the internal
+// tag index used by FindElementByTag up to date. Elements appended to directly
+// are still found, but call Sort afterwards to restore ordering. After
+// replacing entries of Elements in place, call Sort before FindElementByTag,
+// as the index cannot detect it.
 type Dataset struct {
 	Preamble []byte     `json:"-"`
 	Elements []*Element `json:"elements"`
+
+	// index maps a tag to the position of its first occurrence in Elements.
+	// It is only trusted while Elements has the length and backing array it
+	// was built for, see indexed.
+	index     map[tag.Tag]int
+	indexLen  int
+	indexBase **Element
+
+	// raw holds the encodings retained when parsing with RetainRawValues.
+	raw *rawValues
 }
 
 // FindElementByTag searches through the dataset and returns a pointer to the matching element.
 // It DOES NOT search within Sequences as well.
 func (d *Dataset) FindElementByTag(tag tag.Tag) (*Element, error) {
+	if d.indexed() {
+		i, ok := d.index[tag]
+		if !ok {
+			return nil, ErrorElementNotFound
+		}
+		if d.Elements[i].Tag == tag {
+			return d.Elements[i], nil
+		}
+		// The entry is stale (Elements was modified in place), fall back to a
+		// linear scan below.
+	}
 	for _, e := range d.Elements {
 		if e.Tag == tag {
 			return e, nil
@@ -37,6 +66,197 @@ func (d *Dataset) FindElementByTag(tag tag.Tag) (*Element, error) {
 	return nil, ErrorElementNotFound
 }
 
+// Clone returns a deep copy of this Dataset. Elements, their values and any
+// nested sequence items are copied, so the clone can be modified (for example
+// de-identified) without affecting the original.
+func (d *Dataset) Clone() Dataset {
+	clone := Dataset{Elements: cloneElements(d.Elements)}
+	if d.Preamble != nil {
+		clone.Preamble = append([]byte{}, d.Preamble...)
+	}
+	if d.raw != nil {
+		clone.raw = &rawValues{bo: d.raw.bo, values: make(map[*Element]*rawValue)}
+		d.raw.cloneInto(clone.raw, d.Elements, clone.Elements)
+	}
+	return clone
+}
+
+// Set inserts elem into the Dataset at its position in ascending tag order,
+// replacing any existing element with the same tag. If the Dataset's
+// elements are not already in order, they are sorted first (see Sort).
+func (d *Dataset) Set(elem *Element) {
+	if !elementsSorted(d.Elements) {
+		d.Elements = sortElements(d.Elements)
+		d.reindex()
+	}
+	if old, err := d.FindElementByTag(elem.Tag); err == nil {
+		d.raw.replace(old, elem)
+	}
+	d.Elements = setElement(d.Elements, elem)
+	d.reindex()
+}
+
+// Delete removes the element with the provided tag from the Dataset. It
+// returns ErrorElementNotFound if there is no such element.
+func (d *Dataset) Delete(t tag.Tag) error {
+	if d.raw != nil {
+		for _, e := range d.Elements {
+			if e.Tag == t {
+				d.raw.replace(e, nil)
+			}
+		}
+	}
+	elems, ok := deleteElement(d.Elements, t)
+	if !ok {
+		return ErrorElementNotFound
+	}
+	d.Elements = elems
+	d.reindex()
+	return nil
+}
+
+// Update inserts or replaces all of the provided elements in the Dataset, as
+// if Set were called on each of them in order, and leaves the Dataset sorted.
+// The items of the provided sequence elements are sorted as well (see Sort).
+func (d *Dataset) Update(elems ...*Element) {
+	sortItems(elems)
+	if d.raw != nil {
+		for _, elem := range elems {
+			if old, err := d.FindElementByTag(elem.Tag); err == nil {
+				d.raw.replace(old, elem)
+			}
+		}
+	}
+	d.Elements = sortElements(append(d.Elements, elems...))
+	d.reindex()
+}
+
+// Sort puts the elements of the Dataset, and of every sequence item nested
+// within it, in ascending tag order. If a tag occurs more than once at the
+// same level, only the last occurrence is kept.
+func (d *Dataset) Sort() {
+	d.Elements = sortElementsNested(d.Elements)
+	d.reindex()
+	d.raw.prune(d.Elements)
+}
+
+// appendElement appends elem to the Dataset without reordering, keeping the
+// tag index up to date. It is used while parsing, where element order must be
+// preserved as read.
+func (d *Dataset) appendElement(elem *Element) {
+	fresh := d.indexed()
+	d.Elements = append(d.Elements, elem)
+	if !fresh {
+		d.reindex()
+		return
+	}
+	if _, ok := d.index[elem.Tag]; !ok {
+		d.index[elem.Tag] = len(d.Elements) - 1
+	}
+	d.indexLen, d.indexBase = len(d.Elements), &d.Elements[0]
+}
+
+// indexed reports whether the tag index was built for Elements as it is,
+// that is, Elements was not appended to, resliced or replaced since.
+func (d *Dataset) indexed() bool {
+	if d.index == nil || d.indexLen != len(d.Elements) {
+		return false
+	}
+	return len(d.Elements) == 0 || d.indexBase == &d.Elements[0]
+}
+
+func (d *Dataset) reindex() {
+	d.index = make(map[tag.Tag]int, len(d.Elements))
+	for i, e := range d.Elements {
+		if _, ok := d.index[e.Tag]; !ok {
+			d.index[e.Tag] = i
+		}
+	}
+	d.indexLen, d.indexBase = len(d.Elements), nil
+	if len(d.Elements) > 0 {
+		d.indexBase = &d.Elements[0]
+	}
+}
+
+func elementsSorted(elems []*Element) bool {
+	for i := 1; i < len(elems); i++ {
+		if elems[i-1].Tag.Compare(elems[i].Tag) >= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// sortElements returns elems in ascending tag order, keeping only the last
+// occurrence of any duplicated tag. The input slice may be reordered.
+func sortElements(elems []*Element) []*Element {
+	sort.SliceStable(elems, func(i, j int) bool {
+		return elems[i].Tag.Compare(elems[j].Tag) < 0
+	})
+	out := elems[:0]
+	for i, e := range elems {
+		if i+1 < len(elems) && elems[i+1].Tag == e.Tag {
+			continue
+		}
+		out = append(out, e)
+	}
+	for i := len(out); i < len(elems); i++ {
+		elems[i] = nil
+	}
+	return out
+}
+
+func sortElementsNested(elems []*Element) []*Element {
+	sortItems(elems)
+	return sortElements(elems)
+}
+
+// sortItems sorts the elements of the items of the sequences in elems, at
+// every nesting level.
+func sortItems(elems []*Element) {
+	for _, e := range elems {
+		if e.Value != nil && e.Value.ValueType() == Sequences {
+			for _, item := range e.Value.GetValue().([]*SequenceItemValue) {
+				item.elements = sortElementsNested(item.elements)
+			}
+		}
+	}
+}
+
+// setElement inserts elem into the sorted slice elems, replacing an element
+// with the same tag if present.
+func setElement(elems []*Element, elem *Element) []*Element {
+	i := sort.Search(len(elems), func(i int) bool {
+		return elems[i].Tag.Compare(elem.Tag) >= 0
+	})
+	if i < len(elems) && elems[i].Tag == elem.Tag {
+		elems[i] = elem
+		return elems
+	}
+	elems = append(elems, nil)
+	copy(elems[i+1:], elems[i:])
+	elems[i] = elem
+	return elems
+}
+
+// deleteElement removes every element with tag t from elems, reporting
+// whether any was found.
+func deleteElement(elems []*Element, t tag.Tag) ([]*Element, bool) {
+	found := false
+	out := elems[:0]
+	for _, e := range elems {
+		if e.Tag == t {
+			found = true
+			continue
+		}
+		out = append(out, e)
+	}
+	for i := len(out); i < len(elems); i++ {
+		elems[i] = nil
+	}
+	return out, found
+}
+
 func (d *Dataset) transferSyntax() (binary.ByteOrder, bool, error) {
 	elem, err := d.FindElementByTag(tag.TransferSyntaxUID)
 	if err != nil {
@@ -52,93 +272,64 @@ func (d *Dataset) transferSyntax() (binary.ByteOrder, bool, error) {
 
 // FindElementByTagNested searches through the dataset and returns a pointer to the matching element.
 // This call searches through a flat representation of the dataset, including within sequences.
-func (d *Dataset) FindElementByTagNested(tag tag.Tag) (*Element, error) {
-	for e := range d.FlatIterator() {
-		if e.Tag == tag {
-			return e, nil
+func (d *Dataset) FindElementByTagNested(t tag.Tag) (*Element, error) {
+	var found *Element
+	d.Walk(func(_ Path, e *Element) WalkAction {
+		if e.Tag == t {
+			found = e
+			return WalkStop
 		}
+		return WalkContinue
+	})
+	if found == nil {
+		return nil, ErrorElementNotFound
 	}
-	return nil, ErrorElementNotFound
+	return found, nil
 }
 
 // FlatIterator returns a channel upon which every element in this Dataset will be sent,
 // including elements nested inside sequences.
 // Note that the sequence element itself is sent on the channel in addition to the child elements in the sequence.
-// TODO(suyashkumar): decide if the sequence element itself should be sent or not
+//
+// The channel must be drained completely, otherwise the goroutine sending on
+// it is leaked. Prefer Walk, which is synchronous and can stop early.
 func (d *Dataset) FlatIterator() <-chan *Element {
 	elemChan := make(chan *Element)
 	go func() {
-		flatElementsIterator(d.Elements, elemChan)
+		d.Walk(func(_ Path, e *Element) WalkAction {
+			elemChan <- e
+			return WalkContinue
+		})
 		close(elemChan)
 	}()
 	return elemChan
 }
 
-func flatElementsIterator(elems []*Element, elemChan chan<- *Element) {
-	for _, elem := range elems {
-		if elem.Value.ValueType() == Sequences {
-			elemChan <- elem
-			for _, seqItem := range elem.Value.GetValue().([]*SequenceItemValue) {
-				flatElementsIterator(seqItem.elements, elemChan)
-			}
-			continue
-		}
-		elemChan <- elem
-	}
-}
-
 // String returns a printable representation of this dataset as a string, including printing out elements nested inside
 // sequence elements.
 func (d *Dataset) String() string {
 	var b strings.Builder
 	b.Grow(len(d.Elements) * 100) // Underestimate of the size of the final string in an attempt to limit buffer copying
-	for elem := range d.flatIteratorWithLevel() {
-		tabs := buildTabs(elem.l)
+	d.Walk(func(path Path, e *Element) WalkAction {
+		tabs := buildTabs(uint(path.Depth()))
 		var tagName string
-		if tagInfo, err := tag.Find(elem.e.Tag); err == nil {
+		if tagInfo, err := tag.Find(e.Tag); err == nil {
 			tagName = tagInfo.Name
 		}
 
 		b.WriteString(fmt.Sprintf("%s[\n", tabs))
-		b.WriteString(fmt.Sprintf("%s  Tag: %s\n", tabs, elem.e.Tag))
+		b.WriteString(fmt.Sprintf("%s  Tag: %s\n", tabs, e.Tag))
 		b.WriteString(fmt.Sprintf("%s  Tag Name: %s\n", tabs, tagName))
-		b.WriteString(fmt.Sprintf("%s  VR: %s\n", tabs, elem.e.ValueRepresentation))
-		b.WriteString(fmt.Sprintf("%s  VR Raw: %s\n", tabs, elem.e.RawValueRepresentation))
-		b.WriteString(fmt.Sprintf("%s  VL: %d\n", tabs, elem.e.ValueLength))
-		b.WriteString(fmt.Sprintf("%s  Value: %d\n", tabs, elem.e.Value))
+		b.WriteString(fmt.Sprintf("%s  VR: %s\n", tabs, e.ValueRepresentation))
+		b.WriteString(fmt.Sprintf("%s  VR Raw: %s\n", tabs, e.RawValueRepresentation))
+		b.WriteString(fmt.Sprintf("%s  VL: %d\n", tabs, e.ValueLength))
+		b.WriteString(fmt.Sprintf("%s  Value: %d\n", tabs, e.Value))
 		b.WriteString(fmt.Sprintf("%s]\n\n", tabs))
-	}
+		return WalkContinue
+	})
 	return b.String()
 }
 
-type elementWithLevel struct {
-	e *Element
-	// l represents the nesting level of the Element
-	l uint
-}
-
-func (d *Dataset) flatIteratorWithLevel() <-chan *elementWithLevel {
-	elemChan := make(chan *elementWithLevel)
-	go func() {
-		flatElementsIteratorWithLevel(d.Elements, 0, elemChan)
-		close(elemChan)
-	}()
-	return elemChan
-}
-
-func flatElementsIteratorWithLevel(elems []*Element, level uint, eWithLevelChan chan<- *elementWithLevel) {
-	for _, elem := range elems {
-		if elem.Value.ValueType() == Sequences {
-			eWithLevelChan <- &elementWithLevel{elem, level}
-			for _, seqItem := range elem.Value.GetValue().([]*SequenceItemValue) {
-				flatElementsIteratorWithLevel(seqItem.elements, level+1, eWithLevelChan)
-			}
-			continue
-		}
-		eWithLevelChan <- &elementWithLevel{elem, level}
-	}
-}
-
 func buildTabs(number uint) string {
 	var b strings.Builder
 	b.Grow(int(number))
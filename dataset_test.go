@@ -143,3 +143,166 @@ func ExampleDataset_String() {
 	// ]
 
 }
+
+func TestDataset_Set(t *testing.T) {
+	ds := Dataset{}
+	ds.Set(mustNewElement(tag.Columns, []uint64{200}))
+	ds.Set(mustNewElement(tag.ComponentName, []string{"Bob"}))
+	ds.Set(mustNewElement(tag.Rows, []uint64{100}))
+	ds.Set(mustNewElement(tag.Rows, []uint64{300}))
+
+	wantTags := []tag.Tag{tag.ComponentName, tag.Rows, tag.Columns}
+	if len(ds.Elements) != len(wantTags) {
+		t.Fatalf("Set: unexpected number of elements. got: %d, want: %d", len(ds.Elements), len(wantTags))
+	}
+	for i, want := range wantTags {
+		if ds.Elements[i].Tag != want {
+			t.Errorf("Set: unexpected tag at position %d. got: %v, want: %v", i, ds.Elements[i].Tag, want)
+		}
+	}
+
+	elem, err := ds.FindElementByTag(tag.Rows)
+	if err != nil {
+		t.Fatalf("FindElementByTag(%v): unexpected err: %v", tag.Rows, err)
+	}
+	if rows := MustGetUInts(elem.Value)[0]; rows != 300 {
+		t.Errorf("FindElementByTag(%v) after replace: got: %v, want: %v", tag.Rows, rows, 300)
+	}
+}
+
+func TestDataset_Delete(t *testing.T) {
+	ds := Dataset{}
+	ds.Update(
+		mustNewElement(tag.Rows, []uint64{100}),
+		mustNewElement(tag.Columns, []uint64{200}),
+	)
+
+	if err := ds.Delete(tag.Rows); err != nil {
+		t.Fatalf("Delete(%v): unexpected err: %v", tag.Rows, err)
+	}
+	if _, err := ds.FindElementByTag(tag.Rows); err != ErrorElementNotFound {
+		t.Errorf("FindElementByTag(%v) after Delete: got err: %v, want: %v", tag.Rows, err, ErrorElementNotFound)
+	}
+	if _, err := ds.FindElementByTag(tag.Columns); err != nil {
+		t.Errorf("FindElementByTag(%v) after Delete: unexpected err: %v", tag.Columns, err)
+	}
+	if err := ds.Delete(tag.Rows); err != ErrorElementNotFound {
+		t.Errorf("Delete(%v) of missing element: got err: %v, want: %v", tag.Rows, err, ErrorElementNotFound)
+	}
+}
+
+func TestDataset_Sort(t *testing.T) {
+	ds := Dataset{Elements: []*Element{
+		mustNewElement(tag.Rows, []uint64{100}),
+		makeSequenceElement(tag.AddOtherSequence, [][]*Element{
+			{
+				mustNewElement(tag.Rows, []uint64{1}),
+				mustNewElement(tag.ComponentName, []string{"Bob"}),
+			},
+		}),
+		mustNewElement(tag.ComponentName, []string{"Bob"}),
+		mustNewElement(tag.Rows, []uint64{300}),
+	}}
+	ds.Sort()
+
+	wantTags := []tag.Tag{tag.ComponentName, tag.Rows, tag.AddOtherSequence}
+	if len(ds.Elements) != len(wantTags) {
+		t.Fatalf("Sort: unexpected number of elements. got: %d, want: %d", len(ds.Elements), len(wantTags))
+	}
+	for i, want := range wantTags {
+		if ds.Elements[i].Tag != want {
+			t.Errorf("Sort: unexpected tag at position %d. got: %v, want: %v", i, ds.Elements[i].Tag, want)
+		}
+	}
+	if rows := MustGetUInts(ds.Elements[1].Value)[0]; rows != 300 {
+		t.Errorf("Sort: expected last duplicate to be kept. got: %v, want: %v", rows, 300)
+	}
+	item := ds.Elements[2].Value.GetValue().([]*SequenceItemValue)[0]
+	if got := item.elements[0].Tag; got != tag.ComponentName {
+		t.Errorf("Sort: sequence item not sorted. got first tag: %v, want: %v", got, tag.ComponentName)
+	}
+}
+
+func TestDataset_FindElementByTag_directAppend(t *testing.T) {
+	ds := Dataset{}
+	ds.Set(mustNewElement(tag.Rows, []uint64{100}))
+	ds.Elements = append(ds.Elements, mustNewElement(tag.Columns, []uint64{200}))
+
+	if _, err := ds.FindElementByTag(tag.Columns); err != nil {
+		t.Errorf("FindElementByTag(%v) after direct append: unexpected err: %v", tag.Columns, err)
+	}
+}
+
+func TestDataset_FindElementByTag_inPlaceReplace(t *testing.T) {
+	ds := Dataset{}
+	ds.Update(
+		mustNewElement(tag.Rows, []uint64{100}),
+		mustNewElement(tag.Columns, []uint64{200}),
+	)
+	ds.Elements[0] = mustNewElement(tag.ComponentName, []string{"Bob"})
+
+	// The stale index entry of the replaced element is not trusted.
+	if _, err := ds.FindElementByTag(tag.Rows); err != ErrorElementNotFound {
+		t.Errorf("FindElementByTag(%v) after in place replace: got err: %v, want: %v", tag.Rows, err, ErrorElementNotFound)
+	}
+	ds.Sort()
+	if _, err := ds.FindElementByTag(tag.ComponentName); err != nil {
+		t.Errorf("FindElementByTag(%v) after in place replace and Sort: unexpected err: %v", tag.ComponentName, err)
+	}
+}
+
+func TestDataset_FindElementByTag_resliced(t *testing.T) {
+	ds := Dataset{}
+	ds.Update(
+		mustNewElement(tag.ComponentName, []string{"Bob"}),
+		mustNewElement(tag.Rows, []uint64{100}),
+		mustNewElement(tag.Columns, []uint64{200}),
+	)
+	// Dropping the first element and appending another keeps the length.
+	ds.Elements = append(ds.Elements[1:], mustNewElement(tag.PixelData, []byte{0, 0}))
+
+	for _, want := range []tag.Tag{tag.Rows, tag.Columns, tag.PixelData} {
+		if _, err := ds.FindElementByTag(want); err != nil {
+			t.Errorf("FindElementByTag(%v) after reslicing: unexpected err: %v", want, err)
+		}
+	}
+}
+
+func TestDataset_Update_nested(t *testing.T) {
+	ds := Dataset{}
+	ds.Update(makeSequenceElement(tag.AddOtherSequence, [][]*Element{
+		{
+			mustNewElement(tag.Rows, []uint64{1}),
+			mustNewElement(tag.ComponentName, []string{"Bob"}),
+		},
+	}))
+	item := ds.Elements[0].Value.GetValue().([]*SequenceItemValue)[0]
+	if got := item.elements[0].Tag; got != tag.ComponentName {
+		t.Errorf("Update: sequence item not sorted. got first tag: %v, want: %v", got, tag.ComponentName)
+	}
+}
+
+func TestDataset_Clone(t *testing.T) {
+	ds := Dataset{Elements: []*Element{
+		mustNewElement(tag.Rows, []uint64{100}),
+		makeSequenceElement(tag.AddOtherSequence, [][]*Element{
+			{mustNewElement(tag.ComponentName, []string{"Bob"})},
+		}),
+	}}
+	clone := ds.Clone()
+	if !clone.Equal(&ds) {
+		t.Fatalf("Clone() is not Equal to the original dataset")
+	}
+
+	MustGetUInts(clone.Elements[0].Value)[0] = 200
+	item := clone.Elements[1].Value.GetValue().([]*SequenceItemValue)[0]
+	MustGetStrings(item.elements[0].Value)[0] = "Alice"
+
+	if rows := MustGetUInts(ds.Elements[0].Value)[0]; rows != 100 {
+		t.Errorf("modifying clone changed original Rows. got: %v, want: %v", rows, 100)
+	}
+	origItem := ds.Elements[1].Value.GetValue().([]*SequenceItemValue)[0]
+	if name := MustGetStrings(origItem.elements[0].Value)[0]; name != "Bob" {
+		t.Errorf("modifying clone changed original nested element. got: %v, want: %v", name, "Bob")
+	}
+}
@@ -0,0 +1,286 @@
+package dicom
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ginuerzh/dicom/pkg/tag"
+)
+
+// DiffKind describes how an element differs between two Datasets.
+type DiffKind string
+
+const (
+	// DiffAdded indicates an element (or sequence item) that is only present
+	// in the second Dataset.
+	DiffAdded DiffKind = "added"
+	// DiffRemoved indicates an element (or sequence item) that is only present
+	// in the first Dataset.
+	DiffRemoved DiffKind = "removed"
+	// DiffChanged indicates an element present in both Datasets whose VR or
+	// value differs.
+	DiffChanged DiffKind = "changed"
+)
+
+// Difference is a single difference between two Datasets found by Diff.
+type Difference struct {
+	// Path locates the element from the root of the Dataset, for example
+	// "(0040,a730)[0].(0008,0100)". Paths to a sequence item end in the item
+	// index, e.g. "(0040,a730)[2]".
+	Path string   `json:"path"`
+	Kind DiffKind `json:"kind"`
+	Tag  tag.Tag  `json:"tag"`
+	// Name is the dictionary name of Tag, if known.
+	Name     string `json:"name,omitempty"`
+	OldVR    string `json:"oldVR,omitempty"`
+	NewVR    string `json:"newVR,omitempty"`
+	OldValue Value  `json:"oldValue,omitempty"`
+	NewValue Value  `json:"newValue,omitempty"`
+}
+
+// String returns a one line description of this Difference, prefixed by "+",
+// "-" or "~" for added, removed and changed elements respectively.
+func (d Difference) String() string {
+	name := d.Name
+	if name == "" {
+		name = "?"
+	}
+	switch d.Kind {
+	case DiffAdded:
+		return fmt.Sprintf("+ %s %s: %s", d.Path, name, vrValueString(d.NewVR, d.NewValue))
+	case DiffRemoved:
+		return fmt.Sprintf("- %s %s: %s", d.Path, name, vrValueString(d.OldVR, d.OldValue))
+	default:
+		return fmt.Sprintf("~ %s %s: %s -> %s", d.Path, name,
+			vrValueString(d.OldVR, d.OldValue), vrValueString(d.NewVR, d.NewValue))
+	}
+}
+
+// MarshalJSON implements json.Marshaler. PixelData values are replaced by a
+// placeholder string rather than serializing every frame.
+func (d Difference) MarshalJSON() ([]byte, error) {
+	type difference Difference
+	out := struct {
+		difference
+		OldValue interface{} `json:"oldValue,omitempty"`
+		NewValue interface{} `json:"newValue,omitempty"`
+	}{difference: difference(d), OldValue: jsonValue(d.OldValue), NewValue: jsonValue(d.NewValue)}
+	return json.Marshal(out)
+}
+
+func jsonValue(v Value) interface{} {
+	if v == nil {
+		return nil
+	}
+	if v.ValueType() == PixelData {
+		return valueString(v)
+	}
+	return v
+}
+
+func vrValueString(vr string, v Value) string {
+	if vr == "" {
+		return valueString(v)
+	}
+	return vr + " " + valueString(v)
+}
+
+func valueString(v Value) string {
+	if v == nil {
+		return "<nil>"
+	}
+	if v.ValueType() == PixelData {
+		return "<pixel data>"
+	}
+	if v.ValueType() == SequenceItem {
+		return "<item>"
+	}
+	return v.String()
+}
+
+// DiffReport holds the result of Diff. It is JSON serializable out of the
+// box, and String renders it as human-readable text with one difference per
+// line.
+type DiffReport struct {
+	Differences []Difference `json:"differences"`
+}
+
+// Empty reports whether no differences were found.
+func (r DiffReport) Empty() bool {
+	return len(r.Differences) == 0
+}
+
+func (r DiffReport) String() string {
+	var b strings.Builder
+	for _, d := range r.Differences {
+		b.WriteString(d.String())
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// DiffOption represents an option that can be passed to Diff.
+type DiffOption func(*diffOptSet)
+
+// MatchItemsByKey returns a DiffOption that matches the items of sequence seq
+// by the value of their key element, instead of by index. This is useful when
+// items may be reordered, for example matching ReferencedSeriesSequence items
+// by SeriesInstanceUID.
+func MatchItemsByKey(seq, key tag.Tag) DiffOption {
+	return func(set *diffOptSet) {
+		set.itemKeys[seq] = key
+	}
+}
+
+// DiffEqualOptions returns a DiffOption that applies the provided
+// EqualOptions when deciding whether two elements differ.
+func DiffEqualOptions(opts ...EqualOption) DiffOption {
+	return func(set *diffOptSet) {
+		for _, opt := range opts {
+			opt(&set.equal)
+		}
+	}
+}
+
+// diffOptSet represents the flattened option set after all DiffOptions have been applied.
+type diffOptSet struct {
+	itemKeys map[tag.Tag]tag.Tag
+	equal    equalOptSet
+}
+
+// Diff walks both Datasets, including elements nested in sequence items, and
+// reports the elements added, removed or changed going from a to b. Sequence
+// items are matched by index unless MatchItemsByKey says otherwise.
+func Diff(a, b Dataset, opts ...DiffOption) DiffReport {
+	optSet := diffOptSet{itemKeys: map[tag.Tag]tag.Tag{}}
+	for _, opt := range opts {
+		opt(&optSet)
+	}
+	var report DiffReport
+	diffElements(&report, "", a.Elements, b.Elements, optSet)
+	return report
+}
+
+func diffElements(r *DiffReport, prefix string, a, b []*Element, opts diffOptSet) {
+	a, b = comparableElements(a, opts.equal), comparableElements(b, opts.equal)
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		var cmp int
+		switch {
+		case i == len(a):
+			cmp = 1
+		case j == len(b):
+			cmp = -1
+		default:
+			cmp = a[i].Tag.Compare(b[j].Tag)
+		}
+		switch {
+		case cmp < 0:
+			r.add(prefix+a[i].Tag.String(), DiffRemoved, a[i], nil)
+			i++
+		case cmp > 0:
+			r.add(prefix+b[j].Tag.String(), DiffAdded, nil, b[j])
+			j++
+		default:
+			diffElement(r, prefix+a[i].Tag.String(), a[i], b[j], opts)
+			i++
+			j++
+		}
+	}
+}
+
+func diffElement(r *DiffReport, path string, a, b *Element, opts diffOptSet) {
+	vrChanged := !opts.equal.ignoreVR && a.RawValueRepresentation != b.RawValueRepresentation
+	if !vrChanged && isSequence(a) && isSequence(b) {
+		diffSequence(r, path, a, b, opts)
+		return
+	}
+	if vrChanged || !valuesEqual(a.Value, b.Value, opts.equal) {
+		r.add(path, DiffChanged, a, b)
+	}
+}
+
+func diffSequence(r *DiffReport, path string, a, b *Element, opts diffOptSet) {
+	aItems := a.Value.GetValue().([]*SequenceItemValue)
+	bItems := b.Value.GetValue().([]*SequenceItemValue)
+	key, byKey := opts.itemKeys[a.Tag]
+	if !byKey {
+		for i := 0; i < len(aItems) || i < len(bItems); i++ {
+			itemPath := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= len(bItems):
+				r.addItem(itemPath, DiffRemoved, a.Tag, aItems[i])
+			case i >= len(aItems):
+				r.addItem(itemPath, DiffAdded, a.Tag, bItems[i])
+			default:
+				diffElements(r, itemPath+".", aItems[i].elements, bItems[i].elements, opts)
+			}
+		}
+		return
+	}
+
+	bByKey := make(map[string]int, len(bItems))
+	for i, item := range bItems {
+		if k, ok := itemKey(item, key); ok {
+			if _, dup := bByKey[k]; !dup {
+				bByKey[k] = i
+			}
+		}
+	}
+	matched := make([]bool, len(bItems))
+	for i, item := range aItems {
+		k, ok := itemKey(item, key)
+		j, found := bByKey[k]
+		if !ok || !found || matched[j] {
+			r.addItem(fmt.Sprintf("%s[%d]", path, i), DiffRemoved, a.Tag, item)
+			continue
+		}
+		matched[j] = true
+		diffElements(r, fmt.Sprintf("%s[%d].", path, j), item.elements, bItems[j].elements, opts)
+	}
+	for j, item := range bItems {
+		if !matched[j] {
+			r.addItem(fmt.Sprintf("%s[%d]", path, j), DiffAdded, a.Tag, item)
+		}
+	}
+}
+
+func itemKey(item *SequenceItemValue, key tag.Tag) (string, bool) {
+	e, err := item.FindElementByTag(key)
+	if err != nil || e.Value == nil {
+		return "", false
+	}
+	return e.Value.String(), true
+}
+
+func isSequence(e *Element) bool {
+	return e.Value != nil && e.Value.ValueType() == Sequences
+}
+
+func (r *DiffReport) add(path string, kind DiffKind, a, b *Element) {
+	d := Difference{Path: path, Kind: kind}
+	if a != nil {
+		d.Tag, d.OldVR, d.OldValue = a.Tag, a.RawValueRepresentation, a.Value
+	}
+	if b != nil {
+		d.Tag, d.NewVR, d.NewValue = b.Tag, b.RawValueRepresentation, b.Value
+	}
+	if info, err := tag.Find(d.Tag); err == nil {
+		d.Name = info.Name
+	}
+	r.Differences = append(r.Differences, d)
+}
+
+func (r *DiffReport) addItem(path string, kind DiffKind, seq tag.Tag, item *SequenceItemValue) {
+	d := Difference{Path: path, Kind: kind, Tag: seq}
+	if kind == DiffRemoved {
+		d.OldValue = item
+	} else {
+		d.NewValue = item
+	}
+	if info, err := tag.Find(seq); err == nil {
+		d.Name = info.Name
+	}
+	r.Differences = append(r.Differences, d)
+}
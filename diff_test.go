@@ -0,0 +1,120 @@
+package dicom
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ginuerzh/dicom/pkg/tag"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDiff(t *testing.T) {
+	a := Dataset{Elements: []*Element{
+		mustNewElement(tag.ComponentName, []string{"Bob"}),
+		mustNewElement(tag.Rows, []uint64{100}),
+		makeSequenceElement(tag.AddOtherSequence, [][]*Element{
+			{mustNewElement(tag.StudyInstanceUID, []string{"1.2.1"}), mustNewElement(tag.Rows, []uint64{1})},
+			{mustNewElement(tag.StudyInstanceUID, []string{"1.2.2"}), mustNewElement(tag.Rows, []uint64{2})},
+		}),
+	}}
+
+	cases := []struct {
+		name      string
+		b         Dataset
+		opts      []DiffOption
+		wantPaths []string
+		wantKinds []DiffKind
+	}{
+		{
+			name:      "identical",
+			b:         a.Clone(),
+			wantPaths: nil,
+			wantKinds: nil,
+		},
+		{
+			name: "added, removed and changed",
+			b: Dataset{Elements: []*Element{
+				mustNewElement(tag.ComponentName, []string{"Alice"}),
+				mustNewElement(tag.Columns, []uint64{200}),
+				makeSequenceElement(tag.AddOtherSequence, [][]*Element{
+					{mustNewElement(tag.StudyInstanceUID, []string{"1.2.1"}), mustNewElement(tag.Rows, []uint64{1})},
+					{mustNewElement(tag.StudyInstanceUID, []string{"1.2.2"}), mustNewElement(tag.Rows, []uint64{3})},
+				}),
+			}},
+			wantPaths: []string{"(0010,0010)", "(0028,0010)", "(0028,0011)", "(0046,0102)[1].(0028,0010)"},
+			wantKinds: []DiffKind{DiffChanged, DiffRemoved, DiffAdded, DiffChanged},
+		},
+		{
+			name: "reordered items by index",
+			b: Dataset{Elements: []*Element{
+				mustNewElement(tag.ComponentName, []string{"Bob"}),
+				mustNewElement(tag.Rows, []uint64{100}),
+				makeSequenceElement(tag.AddOtherSequence, [][]*Element{
+					{mustNewElement(tag.StudyInstanceUID, []string{"1.2.2"}), mustNewElement(tag.Rows, []uint64{2})},
+					{mustNewElement(tag.StudyInstanceUID, []string{"1.2.1"}), mustNewElement(tag.Rows, []uint64{1})},
+				}),
+			}},
+			wantPaths: []string{
+				"(0046,0102)[0].(0020,000d)", "(0046,0102)[0].(0028,0010)",
+				"(0046,0102)[1].(0020,000d)", "(0046,0102)[1].(0028,0010)",
+			},
+			wantKinds: []DiffKind{DiffChanged, DiffChanged, DiffChanged, DiffChanged},
+		},
+		{
+			name: "reordered items by key",
+			b: Dataset{Elements: []*Element{
+				mustNewElement(tag.ComponentName, []string{"Bob"}),
+				mustNewElement(tag.Rows, []uint64{100}),
+				makeSequenceElement(tag.AddOtherSequence, [][]*Element{
+					{mustNewElement(tag.StudyInstanceUID, []string{"1.2.3"}), mustNewElement(tag.Rows, []uint64{3})},
+					{mustNewElement(tag.StudyInstanceUID, []string{"1.2.2"}), mustNewElement(tag.Rows, []uint64{2})},
+				}),
+			}},
+			opts:      []DiffOption{MatchItemsByKey(tag.AddOtherSequence, tag.StudyInstanceUID)},
+			wantPaths: []string{"(0046,0102)[0]", "(0046,0102)[0]"},
+			wantKinds: []DiffKind{DiffRemoved, DiffAdded},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			report := Diff(a, tc.b, tc.opts...)
+			var gotPaths []string
+			var gotKinds []DiffKind
+			for _, d := range report.Differences {
+				gotPaths = append(gotPaths, d.Path)
+				gotKinds = append(gotKinds, d.Kind)
+			}
+			if diff := cmp.Diff(tc.wantPaths, gotPaths); diff != "" {
+				t.Errorf("Diff() unexpected paths. diff: %s", diff)
+			}
+			if diff := cmp.Diff(tc.wantKinds, gotKinds); diff != "" {
+				t.Errorf("Diff() unexpected kinds. diff: %s", diff)
+			}
+			if report.Empty() != (len(tc.wantPaths) == 0) {
+				t.Errorf("Empty() = %v, want: %v", report.Empty(), len(tc.wantPaths) == 0)
+			}
+		})
+	}
+}
+
+func TestDiffReport_String(t *testing.T) {
+	a := Dataset{Elements: []*Element{mustNewElement(tag.ComponentName, []string{"Bob"})}}
+	b := Dataset{Elements: []*Element{mustNewElement(tag.ComponentName, []string{"Alice"})}}
+	want := "~ (0010,0010) ComponentName: PN [Bob] -> PN [Alice]\n"
+	if got := Diff(a, b).String(); got != want {
+		t.Errorf("DiffReport.String() unexpected output. got: %q, want: %q", got, want)
+	}
+}
+
+func TestDiffReport_MarshalJSON(t *testing.T) {
+	a := Dataset{Elements: []*Element{mustNewElement(tag.PixelData, PixelDataInfo{})}}
+	b := Dataset{Elements: []*Element{mustNewElement(tag.PixelData, PixelDataInfo{IsEncapsulated: true})}}
+	j, err := json.Marshal(Diff(a, b))
+	if err != nil {
+		t.Fatalf("json.Marshal(DiffReport) unexpected error: %v", err)
+	}
+	if !strings.Contains(string(j), `"oldValue":"\u003cpixel data\u003e"`) {
+		t.Errorf("json.Marshal(DiffReport) did not summarize pixel data: %s", j)
+	}
+}
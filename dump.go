@@ -0,0 +1,465 @@
+package dicom
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ginuerzh/dicom/pkg/dicomio"
+	"github.com/ginuerzh/dicom/pkg/frame"
+	"github.com/ginuerzh/dicom/pkg/tag"
+)
+
+// ErrorMalformedDump indicates that the input to ParseDump is not in the
+// format produced by Dump.
+var ErrorMalformedDump = errors.New("malformed dataset dump")
+
+// DumpOption represents an option that can be passed to Dataset.Dump.
+type DumpOption func(*dumpOptSet)
+
+// DumpMaxValueLength returns a DumpOption that truncates rendered values to
+// at most n characters, marking them with a trailing "...". Truncated dumps
+// are easier to read, but cannot be parsed back faithfully by ParseDump.
+func DumpMaxValueLength(n int) DumpOption {
+	return func(set *dumpOptSet) {
+		set.maxValueLength = n
+	}
+}
+
+// dumpOptSet represents the flattened option set after all DumpOptions have been applied.
+type dumpOptSet struct {
+	maxValueLength int
+}
+
+const dumpIndent = "  "
+
+// Dump writes this Dataset to w in a compact text format modeled after
+// DCMTK's dcmdump, with one line per element:
+//
+//	(0010,0010) PN [Bob^Jones]  # 10, 1 ComponentName
+//	(0008,1115) SQ (Sequence #=1)  # u/l, 1 ReferencedSeriesSequence
+//	  (fffe,e000) na (Item #=1)
+//	    (0020,000e) UI [1.2.3]  # 6, 1 SeriesInstanceUID
+//	  (fffe,e00d) na (ItemDelimitationItem)
+//	(fffe,e0dd) na (SequenceDelimitationItem)
+//
+// Each line holds the tag, VR, value, and after the "#" the value length
+// ("u/l" for undefined length), the number of values and the tag name.
+// Nested elements are indented by their depth. Unless DumpMaxValueLength is
+// used, the output can be turned back into a Dataset with ParseDump.
+func (d *Dataset) Dump(w io.Writer, opts ...DumpOption) error {
+	var optSet dumpOptSet
+	for _, opt := range opts {
+		opt(&optSet)
+	}
+	bw := bufio.NewWriter(w)
+	if err := dumpElements(bw, d.Elements, 0, optSet); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+func dumpElements(w *bufio.Writer, elems []*Element, depth int, opts dumpOptSet) error {
+	indent := strings.Repeat(dumpIndent, depth)
+	for _, e := range elems {
+		if err := dumpElement(w, indent, e, privateCreator(elems, e.Tag), depth, opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func dumpElement(w *bufio.Writer, indent string, e *Element, creator string, depth int, opts dumpOptSet) error {
+	var name string
+	if info, err := findTagInfo(e.Tag, creator); err == nil {
+		name = info.Name
+	}
+	vr := e.RawValueRepresentation
+	if vr == "" {
+		vr = "??"
+	}
+	if e.Value == nil {
+		_, err := fmt.Fprintf(w, "%s%s %s (no value)  # %s, 0 %s\n", indent, e.Tag, vr, dumpLength(e.ValueLength), name)
+		return err
+	}
+
+	switch e.Value.ValueType() {
+	case Sequences:
+		items := e.Value.GetValue().([]*SequenceItemValue)
+		fmt.Fprintf(w, "%s%s %s (Sequence #=%d)  # %s, 1 %s\n", indent, e.Tag, vr, len(items), dumpLength(e.ValueLength), name)
+		for _, item := range items {
+			fmt.Fprintf(w, "%s%s%s na (Item #=%d)\n", indent, dumpIndent, tag.Item, len(item.elements))
+			if err := dumpElements(w, item.elements, depth+2, opts); err != nil {
+				return err
+			}
+			fmt.Fprintf(w, "%s%s%s na (ItemDelimitationItem)\n", indent, dumpIndent, tag.ItemDelimitationItem)
+		}
+		_, err := fmt.Fprintf(w, "%s%s na (SequenceDelimitationItem)\n", indent, tag.SequenceDelimitationItem)
+		return err
+	case PixelData:
+		info := MustGetPixelDataInfo(e.Value)
+		if !info.IsEncapsulated {
+			var data []byte
+			for _, f := range info.Frames {
+				data = append(data, f.NativeData.Data...)
+			}
+			_, err := fmt.Fprintf(w, "%s%s %s %s  # %s, 1 %s\n", indent, e.Tag, vr,
+				truncate(dumpBytes(data), opts), dumpLength(e.ValueLength), name)
+			return err
+		}
+		fmt.Fprintf(w, "%s%s %s (PixelSequence #=%d)  # u/l, 1 %s\n", indent, e.Tag, vr, len(info.Frames)+1, name)
+		offsets := make([]byte, 4*len(info.Offsets))
+		for i, o := range info.Offsets {
+			binary.LittleEndian.PutUint32(offsets[4*i:], o)
+		}
+		fmt.Fprintf(w, "%s%s%s pi %s  # %d, 1 Item\n", indent, dumpIndent, tag.Item, truncate(dumpBytes(offsets), opts), len(offsets))
+		for _, f := range info.Frames {
+			fmt.Fprintf(w, "%s%s%s pi %s  # %d, 1 Item\n", indent, dumpIndent, tag.Item,
+				truncate(dumpBytes(f.EncapsulatedData.Data), opts), len(f.EncapsulatedData.Data))
+		}
+		_, err := fmt.Fprintf(w, "%s%s na (SequenceDelimitationItem)\n", indent, tag.SequenceDelimitationItem)
+		return err
+	}
+
+	value, vm := dumpValue(e.Value, vr)
+	_, err := fmt.Fprintf(w, "%s%s %s %s  # %s, %d %s\n", indent, e.Tag, vr, truncate(value, opts),
+		dumpLength(e.ValueLength), vm, name)
+	return err
+}
+
+func dumpLength(vl uint32) string {
+	if vl == tag.VLUndefinedLength {
+		return "u/l"
+	}
+	return strconv.FormatUint(uint64(vl), 10)
+}
+
+// dumpValue renders a non-sequence, non-PixelData value and returns it along
+// with its number of values.
+func dumpValue(v Value, vr string) (string, int) {
+	var parts []string
+	switch v.ValueType() {
+	case Strings:
+		strs := MustGetStrings(v)
+		for _, s := range strs {
+			parts = append(parts, escapeDumpString(s))
+		}
+		return "[" + strings.Join(parts, "\\") + "]", len(strs)
+	case Bytes:
+		return dumpBytes(MustGetBytes(v)), 1
+	case Ints:
+		for _, i := range MustGetInts(v) {
+			parts = append(parts, strconv.FormatInt(i, 10))
+		}
+	case UInts:
+		for _, u := range MustGetUInts(v) {
+			if vr == "AT" {
+				parts = append(parts, tag.Tag{Group: uint16(u >> 16), Element: uint16(u)}.String())
+			} else {
+				parts = append(parts, strconv.FormatUint(u, 10))
+			}
+		}
+	case Floats:
+		bits := 64
+		if vr == "FL" || vr == "OF" {
+			bits = 32
+		}
+		for _, f := range MustGetFloats(v) {
+			parts = append(parts, strconv.FormatFloat(f, 'g', -1, bits))
+		}
+	}
+	return strings.Join(parts, "\\"), len(parts)
+}
+
+func dumpBytes(data []byte) string {
+	parts := make([]string, len(data))
+	for i, b := range data {
+		parts[i] = hex.EncodeToString([]byte{b})
+	}
+	return strings.Join(parts, "\\")
+}
+
+// escapeDumpString percent-encodes control characters and '%' so that every
+// value fits on a single line.
+func escapeDumpString(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c < 0x20 || c == 0x7f || c == '%' {
+			fmt.Fprintf(&b, "%%%02X", c)
+			continue
+		}
+		b.WriteByte(c)
+	}
+	return b.String()
+}
+
+func truncate(s string, opts dumpOptSet) string {
+	if opts.maxValueLength <= 0 || len(s) <= opts.maxValueLength {
+		return s
+	}
+	if strings.HasPrefix(s, "[") {
+		return s[:opts.maxValueLength] + "...]"
+	}
+	return s[:opts.maxValueLength] + "..."
+}
+
+var dumpLineRegexp = regexp.MustCompile(`^\(([0-9a-fA-F]{4}),([0-9a-fA-F]{4})\) (\S\S)(?: (.*?))?(?:\s+#\s+(u/l|\d+), \d+(?: \S*)?)?$`)
+
+// dumpFrame holds the state of a sequence (or encapsulated PixelData) being
+// parsed by ParseDump.
+type dumpFrame struct {
+	elem     *Element
+	elements *[]*Element
+	items    []*SequenceItemValue
+	inItem   bool
+	pixels   *PixelDataInfo
+	// offsetTableRead is set once the basic offset table item of an
+	// encapsulated PixelData element has been parsed.
+	offsetTableRead bool
+}
+
+// ParseDump parses the text format written by Dataset.Dump back into a
+// Dataset. Blank lines and lines starting with "#" are ignored, and
+// indentation is not significant.
+func ParseDump(r io.Reader) (Dataset, error) {
+	var ds Dataset
+	root := &dumpFrame{elements: &ds.Elements}
+	stack := []*dumpFrame{root}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1<<30)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		m := dumpLineRegexp.FindStringSubmatch(line)
+		if m == nil {
+			return ds, fmt.Errorf("%w: line %d: %q", ErrorMalformedDump, lineNum, line)
+		}
+		group, _ := strconv.ParseUint(m[1], 16, 16)
+		element, _ := strconv.ParseUint(m[2], 16, 16)
+		t := tag.Tag{Group: uint16(group), Element: uint16(element)}
+		vr, rawValue, rawLength := m[3], m[4], m[5]
+		top := stack[len(stack)-1]
+
+		var err error
+		switch {
+		case t == tag.Item && top.pixels != nil:
+			err = parseDumpPixelItem(top, rawValue)
+		case t == tag.Item:
+			if top.elem == nil || top.inItem {
+				err = errors.New("unexpected item")
+				break
+			}
+			item := &SequenceItemValue{}
+			top.items = append(top.items, item)
+			top.inItem = true
+			stack = append(stack, &dumpFrame{elements: &item.elements})
+		case t == tag.ItemDelimitationItem:
+			if len(stack) < 2 || !stack[len(stack)-2].inItem {
+				err = errors.New("unexpected item delimitation")
+				break
+			}
+			stack = stack[:len(stack)-1]
+			stack[len(stack)-1].inItem = false
+		case t == tag.SequenceDelimitationItem:
+			if top.elem == nil || top.inItem {
+				err = errors.New("unexpected sequence delimitation")
+				break
+			}
+			if top.pixels != nil {
+				top.elem.Value = &pixelDataValue{PixelDataInfo: *top.pixels}
+			} else {
+				top.elem.Value = &sequencesValue{value: top.items}
+			}
+			stack = stack[:len(stack)-1]
+		default:
+			if top.elem != nil && !top.inItem {
+				err = errors.New("element outside of item")
+				break
+			}
+			elem := &Element{
+				Tag:                    t,
+				ValueRepresentation:    tag.GetVRKind(t, vr),
+				RawValueRepresentation: vr,
+			}
+			if vr == "??" {
+				elem.RawValueRepresentation = ""
+			}
+			if rawLength == "u/l" {
+				elem.ValueLength = tag.VLUndefinedLength
+			} else if rawLength != "" {
+				vl, _ := strconv.ParseUint(rawLength, 10, 32)
+				elem.ValueLength = uint32(vl)
+			}
+			*top.elements = append(*top.elements, elem)
+
+			switch {
+			case strings.HasPrefix(rawValue, "(Sequence"):
+				stack = append(stack, &dumpFrame{elem: elem})
+			case strings.HasPrefix(rawValue, "(PixelSequence"):
+				stack = append(stack, &dumpFrame{elem: elem, pixels: &PixelDataInfo{IsEncapsulated: true}})
+			case rawValue == "(no value)":
+			default:
+				elem.Value, err = parseDumpValue(elem, rawValue, *top.elements)
+			}
+		}
+		if err != nil {
+			return ds, fmt.Errorf("%w: line %d: %v", ErrorMalformedDump, lineNum, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return ds, err
+	}
+	if len(stack) != 1 {
+		return ds, fmt.Errorf("%w: unterminated sequence", ErrorMalformedDump)
+	}
+	ds.reindex()
+	return ds, nil
+}
+
+func parseDumpPixelItem(f *dumpFrame, rawValue string) error {
+	data, err := parseDumpBytes(rawValue)
+	if err != nil {
+		return err
+	}
+	info := f.pixels
+	if !f.offsetTableRead {
+		// The first item is the basic offset table.
+		f.offsetTableRead = true
+		for i := 0; i+4 <= len(data); i += 4 {
+			info.Offsets = append(info.Offsets, binary.LittleEndian.Uint32(data[i:]))
+		}
+		return nil
+	}
+	info.Frames = append(info.Frames, frame.Frame{
+		Encapsulated:     true,
+		EncapsulatedData: frame.EncapsulatedFrame{Data: data},
+	})
+	return nil
+}
+
+func parseDumpValue(e *Element, raw string, parsed []*Element) (Value, error) {
+	switch e.ValueRepresentation {
+	case tag.VRPixelData:
+		data, err := parseDumpBytes(raw)
+		if err != nil {
+			return nil, err
+		}
+		// Reuse the native frame reader, which needs Rows, Columns etc. from
+		// the elements parsed so far.
+		r, err := dicomio.NewReader(bufio.NewReader(bytes.NewReader(data)), binary.LittleEndian, int64(len(data)))
+		if err != nil {
+			return nil, err
+		}
+		info, _, err := readNativeFrames(r, &Dataset{Elements: parsed}, int64(len(data)), nil)
+		if err != nil {
+			return nil, err
+		}
+		return &pixelDataValue{PixelDataInfo: *info}, nil
+	case tag.VRBytes:
+		data, err := parseDumpBytes(raw)
+		return &bytesValue{value: data}, err
+	case tag.VRInt16List, tag.VRInt32List, tag.VRInt64List:
+		v := &intsValue{}
+		for _, s := range splitDumpNumbers(raw) {
+			i, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			v.value = append(v.value, i)
+		}
+		return v, nil
+	case tag.VRUInt16List, tag.VRUInt32List, tag.VRUInt64List:
+		v := &uintsValue{}
+		for _, s := range splitDumpNumbers(raw) {
+			u, err := strconv.ParseUint(s, 10, 64)
+			if err != nil {
+				return nil, err
+			}
+			v.value = append(v.value, u)
+		}
+		return v, nil
+	case tag.VRTagList:
+		v := &uintsValue{}
+		for _, s := range splitDumpNumbers(raw) {
+			var group, element uint16
+			if _, err := fmt.Sscanf(s, "(%x,%x)", &group, &element); err != nil {
+				return nil, err
+			}
+			v.value = append(v.value, uint64(group)<<16|uint64(element))
+		}
+		return v, nil
+	case tag.VRFloat32List, tag.VRFloat64List:
+		v := &floatsValue{}
+		for _, s := range splitDumpNumbers(raw) {
+			f, err := strconv.ParseFloat(s, 64)
+			if err != nil {
+				return nil, err
+			}
+			v.value = append(v.value, f)
+		}
+		return v, nil
+	default:
+		if !strings.HasPrefix(raw, "[") || !strings.HasSuffix(raw, "]") {
+			return nil, fmt.Errorf("string value must be enclosed in []: %q", raw)
+		}
+		var strs []string
+		for _, s := range strings.Split(raw[1:len(raw)-1], "\\") {
+			unescaped, err := unescapeDumpString(s)
+			if err != nil {
+				return nil, err
+			}
+			strs = append(strs, unescaped)
+		}
+		return &stringsValue{value: strs}, nil
+	}
+}
+
+func splitDumpNumbers(raw string) []string {
+	if raw == "" {
+		return nil
+	}
+	return strings.Split(raw, "\\")
+}
+
+func parseDumpBytes(raw string) ([]byte, error) {
+	if raw == "" {
+		return []byte{}, nil
+	}
+	return hex.DecodeString(strings.Replace(raw, "\\", "", -1))
+}
+
+func unescapeDumpString(s string) (string, error) {
+	if !strings.Contains(s, "%") {
+		return s, nil
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '%' {
+			b.WriteByte(s[i])
+			continue
+		}
+		if i+2 >= len(s) {
+			return "", fmt.Errorf("invalid escape in %q", s)
+		}
+		c, err := strconv.ParseUint(s[i+1:i+3], 16, 8)
+		if err != nil {
+			return "", fmt.Errorf("invalid escape in %q", s)
+		}
+		b.WriteByte(byte(c))
+		i += 2
+	}
+	return b.String(), nil
+}
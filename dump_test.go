@@ -0,0 +1,135 @@
+package dicom
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/ginuerzh/dicom/pkg/tag"
+	"github.com/ginuerzh/dicom/pkg/uid"
+)
+
+func TestDataset_Dump(t *testing.T) {
+	ds := Dataset{Elements: []*Element{
+		mustNewElement(tag.ComponentName, []string{"Bob", "Jones"}),
+		mustNewElement(tag.Rows, []uint64{128}),
+		makeSequenceElement(tag.AddOtherSequence, [][]*Element{
+			{mustNewElement(tag.FloatingPointValue, []float64{1.5})},
+		}),
+	}}
+	want := "(0010,0010) PN [Bob\\Jones]  # 0, 2 ComponentName\n" +
+		"(0028,0010) US 128  # 0, 1 Rows\n" +
+		"(0046,0102) SQ (Sequence #=1)  # 0, 1 AddOtherSequence\n" +
+		"  (fffe,e000) na (Item #=1)\n" +
+		"    (0040,a161) FD 1.5  # 0, 1 FloatingPointValue\n" +
+		"  (fffe,e00d) na (ItemDelimitationItem)\n" +
+		"(fffe,e0dd) na (SequenceDelimitationItem)\n"
+
+	var b bytes.Buffer
+	if err := ds.Dump(&b); err != nil {
+		t.Fatalf("Dump() unexpected error: %v", err)
+	}
+	if got := b.String(); got != want {
+		t.Errorf("Dump() unexpected output. got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestDataset_Dump_truncate(t *testing.T) {
+	ds := Dataset{Elements: []*Element{
+		mustNewElement(tag.StudyDescription, []string{"a very long study description"}),
+	}}
+	var b bytes.Buffer
+	if err := ds.Dump(&b, DumpMaxValueLength(8)); err != nil {
+		t.Fatalf("Dump() unexpected error: %v", err)
+	}
+	if want := "[a very ...]"; !strings.Contains(b.String(), want) {
+		t.Errorf("Dump() with DumpMaxValueLength: got %q, want it to contain %q", b.String(), want)
+	}
+}
+
+func TestParseDump(t *testing.T) {
+	in := `# A fixture with a comment line.
+(0002,0010) UI [1.2.840.10008.1.2]  # 18, 1 TransferSyntaxUID
+(0010,0010) PN [Bob^Jones]
+(0018,1310) US 0\256\256\0
+(0020,5000) AT (0028,0010)\(0028,0011)
+(0040,a160) UT [line one%0Aline two]
+(0046,0102) SQ (Sequence #=1)  # u/l, 1 AddOtherSequence
+  (fffe,e000) na (Item #=1)
+    (0028,0010) US 100
+  (fffe,e00d) na (ItemDelimitationItem)
+(fffe,e0dd) na (SequenceDelimitationItem)
+`
+	ds, err := ParseDump(strings.NewReader(in))
+	if err != nil {
+		t.Fatalf("ParseDump() unexpected error: %v", err)
+	}
+	want := Dataset{Elements: []*Element{
+		mustNewElement(tag.TransferSyntaxUID, []string{uid.ImplicitVRLittleEndian}),
+		mustNewElement(tag.ComponentName, []string{"Bob^Jones"}),
+		mustNewElement(tag.AcquisitionMatrix, []uint64{0, 256, 256, 0}),
+		mustNewElement(tag.Tag{Group: 0x0020, Element: 0x5000}, []uint64{0x00280010, 0x00280011}),
+		mustNewElement(tag.TextValue, []string{"line one\nline two"}),
+		makeSequenceElement(tag.AddOtherSequence, [][]*Element{
+			{mustNewElement(tag.Rows, []uint64{100})},
+		}),
+	}}
+	if !ds.Equal(&want, IgnoreValueLength()) {
+		var got bytes.Buffer
+		_ = ds.Dump(&got)
+		t.Errorf("ParseDump() unexpected dataset:\n%s", got.String())
+	}
+	seq, _ := ds.FindElementByTag(tag.AddOtherSequence)
+	if seq.ValueLength != tag.VLUndefinedLength {
+		t.Errorf("ParseDump() did not keep undefined length. got: %v", seq.ValueLength)
+	}
+}
+
+func TestParseDump_malformed(t *testing.T) {
+	cases := []string{
+		"(0010,0010)",
+		"(0010,0010) PN Bob",
+		"(fffe,e000) na (Item #=1)",
+		"(0046,0102) SQ (Sequence #=1)\n",
+	}
+	for _, in := range cases {
+		if _, err := ParseDump(strings.NewReader(in)); err == nil {
+			t.Errorf("ParseDump(%q) expected an error", in)
+		}
+	}
+}
+
+// TestDump_roundTrip checks that dumping and parsing back every file in
+// testfiles/ reproduces the same Dataset.
+func TestDump_roundTrip(t *testing.T) {
+	files, err := ioutil.ReadDir("./testfiles")
+	if err != nil {
+		t.Fatalf("unable to read testfiles/: %v", err)
+	}
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), ".dcm") {
+			continue
+		}
+		t.Run(f.Name(), func(t *testing.T) {
+			ds, err := ParseFile("./testfiles/"+f.Name(), nil)
+			if err != nil {
+				t.Fatalf("ParseFile(%s) unexpected error: %v", f.Name(), err)
+			}
+			var b bytes.Buffer
+			if err := ds.Dump(&b); err != nil {
+				t.Fatalf("Dump() unexpected error: %v", err)
+			}
+			got, err := ParseDump(&b)
+			if err != nil {
+				t.Fatalf("ParseDump() unexpected error: %v", err)
+			}
+			if !got.Equal(&ds) {
+				t.Errorf("ParseDump(Dump()) differs from original:\n%s", Diff(ds, got))
+			}
+			if err := Write(ioutil.Discard, got, SkipVRVerification()); err != nil {
+				t.Errorf("Write(ParseDump(Dump())) unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/ginuerzh/dicom/pkg/frame"
 	"github.com/ginuerzh/dicom/pkg/tag"
@@ -24,6 +25,16 @@ type Element struct {
 	Value                  Value      `json:"value"`
 }
 
+// Clone returns a deep copy of this Element, including its Value and any
+// nested sequence items, that can be modified without affecting the original.
+func (e *Element) Clone() *Element {
+	clone := *e
+	if e.Value != nil {
+		clone.Value = e.Value.Clone()
+	}
+	return &clone
+}
+
 func (e *Element) String() string {
 	var tagName string
 	if tagInfo, err := tag.Find(e.Tag); err == nil {
@@ -75,6 +86,12 @@ type Value interface {
 	GetValue() interface{} // TODO: rename to Get to read cleaner
 	String() string
 	MarshalJSON() ([]byte, error)
+	// Clone returns a deep copy of this Value that shares no underlying data
+	// with the original.
+	Clone() Value
+	// Equal reports whether this Value holds the same ValueType and data as
+	// other, subject to the provided EqualOptions.
+	Equal(other Value, opts ...EqualOption) bool
 }
 
 // NewValue creates a new DICOM value for the supplied data. Likely most useful
@@ -149,6 +166,44 @@ func NewElement(t tag.Tag, data interface{}) (*Element, error) {
 	}, nil
 }
 
+// NewElementWithVR creates a new DICOM Element with the supplied tag and VR
+// and with a value built from the provided data, which can be one of the
+// types that is acceptable to NewValue. Unlike NewElement, the tag does not
+// need to be in the dictionary, which makes this useful for private and
+// unknown tags. An error is returned if data does not match vr.
+func NewElementWithVR(t tag.Tag, vr string, data interface{}) (*Element, error) {
+	if len(vr) != 2 {
+		return nil, fmt.Errorf("invalid VR %q for tag %v", vr, tag.DebugString(t))
+	}
+	value, err := NewValue(data)
+	if err != nil {
+		return nil, err
+	}
+	if err := verifyValueType(t, value, vr); err != nil {
+		return nil, err
+	}
+
+	return &Element{
+		Tag:                    t,
+		ValueRepresentation:    tag.GetVRKind(t, vr),
+		RawValueRepresentation: vr,
+		Value:                  value,
+	}, nil
+}
+
+// firstString returns the first value of a string element without trailing
+// padding, or "" if there is none.
+func firstString(e *Element) string {
+	if e.Value == nil {
+		return ""
+	}
+	values, ok := e.Value.GetValue().([]string)
+	if !ok || len(values) == 0 {
+		return ""
+	}
+	return strings.TrimRight(values[0], " \x00")
+}
+
 func mustNewElement(t tag.Tag, data interface{}) *Element {
 	elem, err := NewElement(t, data)
 	if err != nil {
@@ -198,6 +253,12 @@ func (b *bytesValue) String() string {
 func (b *bytesValue) MarshalJSON() ([]byte, error) {
 	return json.Marshal(b.value)
 }
+func (b *bytesValue) Clone() Value {
+	return &bytesValue{value: append(b.value[:0:0], b.value...)}
+}
+func (b *bytesValue) Equal(other Value, opts ...EqualOption) bool {
+	return valuesEqual(b, other, toEqualOptSet(opts...))
+}
 
 // stringsValue represents a value of []string.
 type stringsValue struct {
@@ -213,6 +274,12 @@ func (s *stringsValue) String() string {
 func (s *stringsValue) MarshalJSON() ([]byte, error) {
 	return json.Marshal(s.value)
 }
+func (s *stringsValue) Clone() Value {
+	return &stringsValue{value: append(s.value[:0:0], s.value...)}
+}
+func (s *stringsValue) Equal(other Value, opts ...EqualOption) bool {
+	return valuesEqual(s, other, toEqualOptSet(opts...))
+}
 
 // intsValue represents a value of []int64.
 type intsValue struct {
@@ -228,6 +295,12 @@ func (s *intsValue) String() string {
 func (s *intsValue) MarshalJSON() ([]byte, error) {
 	return json.Marshal(s.value)
 }
+func (s *intsValue) Clone() Value {
+	return &intsValue{value: append(s.value[:0:0], s.value...)}
+}
+func (s *intsValue) Equal(other Value, opts ...EqualOption) bool {
+	return valuesEqual(s, other, toEqualOptSet(opts...))
+}
 
 // uintsValue represents a value of []uint64.
 type uintsValue struct {
@@ -243,6 +316,12 @@ func (s *uintsValue) String() string {
 func (s *uintsValue) MarshalJSON() ([]byte, error) {
 	return json.Marshal(s.value)
 }
+func (s *uintsValue) Clone() Value {
+	return &uintsValue{value: append(s.value[:0:0], s.value...)}
+}
+func (s *uintsValue) Equal(other Value, opts ...EqualOption) bool {
+	return valuesEqual(s, other, toEqualOptSet(opts...))
+}
 
 // floatsValue represents a value of []float64.
 type floatsValue struct {
@@ -258,12 +337,22 @@ func (s *floatsValue) String() string {
 func (s *floatsValue) MarshalJSON() ([]byte, error) {
 	return json.Marshal(s.value)
 }
+func (s *floatsValue) Clone() Value {
+	return &floatsValue{value: append(s.value[:0:0], s.value...)}
+}
+func (s *floatsValue) Equal(other Value, opts ...EqualOption) bool {
+	return valuesEqual(s, other, toEqualOptSet(opts...))
+}
 
 // SequenceItemValue is a Value that represents a single Sequence Item. Learn
 // more about Sequences at
 // http://dicom.nema.org/medical/dicom/current/output/chtml/part05/sect_7.5.html.
 type SequenceItemValue struct {
 	elements []*Element
+	// definedLength records that this item was parsed with an explicit
+	// length rather than an Item Delimitation Item. It is used when writing
+	// with PreserveLengthEncoding.
+	definedLength bool
 }
 
 func (s *SequenceItemValue) isElementValue()       {}
@@ -276,6 +365,44 @@ func (s *SequenceItemValue) String() string {
 func (s *SequenceItemValue) MarshalJSON() ([]byte, error) {
 	return json.Marshal(s.elements)
 }
+func (s *SequenceItemValue) Clone() Value {
+	return &SequenceItemValue{elements: cloneElements(s.elements), definedLength: s.definedLength}
+}
+func (s *SequenceItemValue) Equal(other Value, opts ...EqualOption) bool {
+	return valuesEqual(s, other, toEqualOptSet(opts...))
+}
+
+// FindElementByTag searches the elements of this sequence item and returns a
+// pointer to the matching element. It DOES NOT search within nested
+// Sequences.
+func (s *SequenceItemValue) FindElementByTag(t tag.Tag) (*Element, error) {
+	for _, e := range s.elements {
+		if e.Tag == t {
+			return e, nil
+		}
+	}
+	return nil, ErrorElementNotFound
+}
+
+// Set inserts elem into this sequence item at its position in ascending tag
+// order, replacing any existing element with the same tag.
+func (s *SequenceItemValue) Set(elem *Element) {
+	if !elementsSorted(s.elements) {
+		s.elements = sortElements(s.elements)
+	}
+	s.elements = setElement(s.elements, elem)
+}
+
+// Delete removes the element with the provided tag from this sequence item.
+// It returns ErrorElementNotFound if there is no such element.
+func (s *SequenceItemValue) Delete(t tag.Tag) error {
+	elems, ok := deleteElement(s.elements, t)
+	if !ok {
+		return ErrorElementNotFound
+	}
+	s.elements = elems
+	return nil
+}
 
 // sequencesValue represents a set of items in a DICOM sequence.
 type sequencesValue struct {
@@ -292,6 +419,16 @@ func (s *sequencesValue) String() string {
 func (s *sequencesValue) MarshalJSON() ([]byte, error) {
 	return json.Marshal(s.value)
 }
+func (s *sequencesValue) Clone() Value {
+	items := make([]*SequenceItemValue, 0, len(s.value))
+	for _, item := range s.value {
+		items = append(items, &SequenceItemValue{elements: cloneElements(item.elements)})
+	}
+	return &sequencesValue{value: items}
+}
+func (s *sequencesValue) Equal(other Value, opts ...EqualOption) bool {
+	return valuesEqual(s, other, toEqualOptSet(opts...))
+}
 
 // PixelDataInfo is a representation of DICOM PixelData.
 type PixelDataInfo struct {
@@ -317,6 +454,26 @@ func (e *pixelDataValue) MarshalJSON() ([]byte, error) {
 	return json.Marshal(e.PixelDataInfo)
 }
 
+func (e *pixelDataValue) Clone() Value {
+	info := PixelDataInfo{
+		IsEncapsulated: e.IsEncapsulated,
+		Offsets:        append(e.Offsets[:0:0], e.Offsets...),
+	}
+	if e.Frames != nil {
+		info.Frames = make([]frame.Frame, 0, len(e.Frames))
+	}
+	for _, f := range e.Frames {
+		f.EncapsulatedData.Data = append(f.EncapsulatedData.Data[:0:0], f.EncapsulatedData.Data...)
+		f.NativeData.Data = append(f.NativeData.Data[:0:0], f.NativeData.Data...)
+		info.Frames = append(info.Frames, f)
+	}
+	return &pixelDataValue{PixelDataInfo: info}
+}
+
+func (e *pixelDataValue) Equal(other Value, opts ...EqualOption) bool {
+	return valuesEqual(e, other, toEqualOptSet(opts...))
+}
+
 // MustGetInts attempts to get an Ints value out of the provided value, and will
 // panic if it is unable to do so.
 func MustGetInts(v Value) []int64 {
@@ -149,3 +149,21 @@ func TestNewValue_UnexpectedType(t *testing.T) {
 		t.Errorf("NewValue(%v) expected an error. got: %v, want: %v", data, err, ErrorUnexpectedDataType)
 	}
 }
+
+func TestNewElementWithVR(t *testing.T) {
+	private := tag.Tag{Group: 0x0029, Element: 0x1010}
+	elem, err := NewElementWithVR(private, "DS", []string{"1.5"})
+	if err != nil {
+		t.Fatalf("NewElementWithVR(%v, DS) returned unexpected error: %v", private, err)
+	}
+	if elem.RawValueRepresentation != "DS" || elem.ValueRepresentation != tag.VRStringList {
+		t.Errorf("NewElementWithVR(%v, DS) has unexpected VR: %v, %v", private, elem.RawValueRepresentation, elem.ValueRepresentation)
+	}
+
+	if _, err := NewElementWithVR(private, "US", []string{"1.5"}); err == nil {
+		t.Errorf("NewElementWithVR(%v, US, []string) expected an error for mismatched data", private)
+	}
+	if _, err := NewElementWithVR(private, "LONG", []string{"1.5"}); err == nil {
+		t.Errorf("NewElementWithVR(%v, LONG) expected an error for invalid VR", private)
+	}
+}
@@ -0,0 +1,231 @@
+package dicom
+
+import (
+	"bytes"
+	"math"
+	"sort"
+
+	"github.com/ginuerzh/dicom/pkg/frame"
+)
+
+// EqualOption represents an option that can be passed to Dataset.Equal or
+// Element.Equal to relax the comparison.
+type EqualOption func(*equalOptSet)
+
+// IgnoreGroupLengths returns an EqualOption that skips group length elements
+// (gggg,0000), which are often present in one encoding of a Dataset but not
+// another.
+func IgnoreGroupLengths() EqualOption {
+	return func(set *equalOptSet) {
+		set.ignoreGroupLengths = true
+	}
+}
+
+// IgnoreVR returns an EqualOption that ignores differences in
+// ValueRepresentation and RawValueRepresentation between elements.
+func IgnoreVR() EqualOption {
+	return func(set *equalOptSet) {
+		set.ignoreVR = true
+	}
+}
+
+// IgnoreValueLength returns an EqualOption that ignores differences in
+// ValueLength between elements, for example between defined and undefined
+// length sequences.
+func IgnoreValueLength() EqualOption {
+	return func(set *equalOptSet) {
+		set.ignoreValueLength = true
+	}
+}
+
+// IgnorePixelData returns an EqualOption that treats any two PixelData values
+// as equal.
+func IgnorePixelData() EqualOption {
+	return func(set *equalOptSet) {
+		set.ignorePixelData = true
+	}
+}
+
+// equalOptSet represents the flattened option set after all EqualOptions have been applied.
+type equalOptSet struct {
+	ignoreGroupLengths bool
+	ignoreVR           bool
+	ignoreValueLength  bool
+	ignorePixelData    bool
+}
+
+func toEqualOptSet(opts ...EqualOption) equalOptSet {
+	var optSet equalOptSet
+	for _, opt := range opts {
+		opt(&optSet)
+	}
+	return optSet
+}
+
+// Equal reports whether this Dataset contains the same elements as other,
+// compared recursively with Element.Equal. Elements are matched in ascending
+// tag order, so the order in which they appear in Elements does not matter.
+// The Preamble is not compared.
+func (d *Dataset) Equal(other *Dataset, opts ...EqualOption) bool {
+	return elementsEqual(d.Elements, other.Elements, toEqualOptSet(opts...))
+}
+
+// Equal reports whether this Element has the same tag, VR, value length and
+// value as other, subject to the provided EqualOptions.
+func (e *Element) Equal(other *Element, opts ...EqualOption) bool {
+	return elementEqual(e, other, toEqualOptSet(opts...))
+}
+
+func elementEqual(a, b *Element, opts equalOptSet) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.Tag != b.Tag {
+		return false
+	}
+	if !opts.ignoreVR && (a.ValueRepresentation != b.ValueRepresentation ||
+		a.RawValueRepresentation != b.RawValueRepresentation) {
+		return false
+	}
+	if !opts.ignoreValueLength && a.ValueLength != b.ValueLength {
+		return false
+	}
+	return valuesEqual(a.Value, b.Value, opts)
+}
+
+func elementsEqual(a, b []*Element, opts equalOptSet) bool {
+	a, b = comparableElements(a, opts), comparableElements(b, opts)
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !elementEqual(a[i], b[i], opts) {
+			return false
+		}
+	}
+	return true
+}
+
+// comparableElements returns a copy of elems in ascending tag order, without
+// the elements the options say to ignore.
+func comparableElements(elems []*Element, opts equalOptSet) []*Element {
+	out := make([]*Element, 0, len(elems))
+	for _, e := range elems {
+		if opts.ignoreGroupLengths && e.Tag.Element == 0x0000 {
+			continue
+		}
+		out = append(out, e)
+	}
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Tag.Compare(out[j].Tag) < 0
+	})
+	return out
+}
+
+func valuesEqual(a, b Value, opts equalOptSet) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	if a.ValueType() != b.ValueType() {
+		return false
+	}
+	switch a.ValueType() {
+	case Strings:
+		x, y := MustGetStrings(a), MustGetStrings(b)
+		if len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if x[i] != y[i] {
+				return false
+			}
+		}
+	case Bytes:
+		return bytes.Equal(MustGetBytes(a), MustGetBytes(b))
+	case Ints:
+		x, y := MustGetInts(a), MustGetInts(b)
+		if len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if x[i] != y[i] {
+				return false
+			}
+		}
+	case UInts:
+		x, y := MustGetUInts(a), MustGetUInts(b)
+		if len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if x[i] != y[i] {
+				return false
+			}
+		}
+	case Floats:
+		x, y := MustGetFloats(a), MustGetFloats(b)
+		if len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if x[i] != y[i] && !(math.IsNaN(x[i]) && math.IsNaN(y[i])) {
+				return false
+			}
+		}
+	case PixelData:
+		return opts.ignorePixelData || pixelDataEqual(MustGetPixelDataInfo(a), MustGetPixelDataInfo(b))
+	case SequenceItem:
+		return elementsEqual(a.GetValue().([]*Element), b.GetValue().([]*Element), opts)
+	case Sequences:
+		x, y := a.GetValue().([]*SequenceItemValue), b.GetValue().([]*SequenceItemValue)
+		if len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if !elementsEqual(x[i].elements, y[i].elements, opts) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func pixelDataEqual(a, b PixelDataInfo) bool {
+	if a.IsEncapsulated != b.IsEncapsulated || len(a.Offsets) != len(b.Offsets) || len(a.Frames) != len(b.Frames) {
+		return false
+	}
+	for i := range a.Offsets {
+		if a.Offsets[i] != b.Offsets[i] {
+			return false
+		}
+	}
+	for i := range a.Frames {
+		if !frameEqual(a.Frames[i], b.Frames[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func frameEqual(a, b frame.Frame) bool {
+	if a.Encapsulated != b.Encapsulated {
+		return false
+	}
+	if a.Encapsulated {
+		return bytes.Equal(a.EncapsulatedData.Data, b.EncapsulatedData.Data)
+	}
+	x, y := a.NativeData, b.NativeData
+	return x.Rows == y.Rows && x.Cols == y.Cols && x.SamplesPerPixel == y.SamplesPerPixel &&
+		x.BitsPerSample == y.BitsPerSample && bytes.Equal(x.Data, y.Data)
+}
+
+func cloneElements(elems []*Element) []*Element {
+	if elems == nil {
+		return nil
+	}
+	out := make([]*Element, 0, len(elems))
+	for _, e := range elems {
+		out = append(out, e.Clone())
+	}
+	return out
+}
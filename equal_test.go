@@ -0,0 +1,133 @@
+package dicom
+
+import (
+	"testing"
+
+	"github.com/ginuerzh/dicom/pkg/frame"
+	"github.com/ginuerzh/dicom/pkg/tag"
+)
+
+func TestDataset_Equal(t *testing.T) {
+	base := func() Dataset {
+		return Dataset{Elements: []*Element{
+			mustNewElement(tag.Rows, []uint64{100}),
+			mustNewElement(tag.ComponentName, []string{"Bob"}),
+			makeSequenceElement(tag.AddOtherSequence, [][]*Element{
+				{mustNewElement(tag.FloatingPointValue, []float64{1.5})},
+			}),
+			mustNewElement(tag.PixelData, PixelDataInfo{Frames: []frame.Frame{
+				{NativeData: frame.NativeFrame{Rows: 1, Cols: 2, Data: []byte{1, 2}}},
+			}}),
+		}}
+	}
+
+	cases := []struct {
+		name   string
+		modify func(ds *Dataset)
+		opts   []EqualOption
+		want   bool
+	}{
+		{
+			name:   "identical",
+			modify: func(ds *Dataset) {},
+			want:   true,
+		},
+		{
+			name: "different order",
+			modify: func(ds *Dataset) {
+				ds.Elements[0], ds.Elements[1] = ds.Elements[1], ds.Elements[0]
+			},
+			want: true,
+		},
+		{
+			name: "different value",
+			modify: func(ds *Dataset) {
+				ds.Set(mustNewElement(tag.ComponentName, []string{"Alice"}))
+			},
+			want: false,
+		},
+		{
+			name: "different nested value",
+			modify: func(ds *Dataset) {
+				seq, _ := ds.FindElementByTag(tag.AddOtherSequence)
+				item := seq.Value.GetValue().([]*SequenceItemValue)[0]
+				item.Set(mustNewElement(tag.FloatingPointValue, []float64{2.5}))
+			},
+			want: false,
+		},
+		{
+			name: "extra group length",
+			modify: func(ds *Dataset) {
+				ds.Set(mustNewElement(tag.Tag{Group: 0x0028, Element: 0x0000}, []uint64{10}))
+			},
+			want: false,
+		},
+		{
+			name: "extra group length ignored",
+			modify: func(ds *Dataset) {
+				ds.Set(mustNewElement(tag.Tag{Group: 0x0028, Element: 0x0000}, []uint64{10}))
+			},
+			opts: []EqualOption{IgnoreGroupLengths()},
+			want: true,
+		},
+		{
+			name: "different VR",
+			modify: func(ds *Dataset) {
+				e, _ := ds.FindElementByTag(tag.Rows)
+				e.RawValueRepresentation = "UL"
+			},
+			want: false,
+		},
+		{
+			name: "different VR ignored",
+			modify: func(ds *Dataset) {
+				e, _ := ds.FindElementByTag(tag.Rows)
+				e.RawValueRepresentation = "UL"
+			},
+			opts: []EqualOption{IgnoreVR()},
+			want: true,
+		},
+		{
+			name: "different VL ignored",
+			modify: func(ds *Dataset) {
+				e, _ := ds.FindElementByTag(tag.AddOtherSequence)
+				e.ValueLength = tag.VLUndefinedLength
+			},
+			opts: []EqualOption{IgnoreValueLength()},
+			want: true,
+		},
+		{
+			name: "different pixel data",
+			modify: func(ds *Dataset) {
+				ds.Set(mustNewElement(tag.PixelData, PixelDataInfo{IsEncapsulated: true}))
+			},
+			want: false,
+		},
+		{
+			name: "different pixel data ignored",
+			modify: func(ds *Dataset) {
+				ds.Set(mustNewElement(tag.PixelData, PixelDataInfo{IsEncapsulated: true}))
+			},
+			opts: []EqualOption{IgnorePixelData()},
+			want: true,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			a, b := base(), base()
+			tc.modify(&b)
+			if got := a.Equal(&b, tc.opts...); got != tc.want {
+				t.Errorf("Equal() unexpected result. got: %v, want: %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestValue_Equal(t *testing.T) {
+	if !mustNewValue([]string{"a", "b"}).Equal(mustNewValue([]string{"a", "b"})) {
+		t.Errorf("Equal() of identical strings values returned false")
+	}
+	if mustNewValue([]uint64{1}).Equal(mustNewValue([]int64{1})) {
+		t.Errorf("Equal() of values with different ValueTypes returned true")
+	}
+}
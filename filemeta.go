@@ -0,0 +1,145 @@
+package dicom
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ginuerzh/dicom/pkg/tag"
+)
+
+// ErrorMetaUIDMismatch indicates that a UID in the File Meta Information
+// group disagrees with the corresponding UID in the body of the Dataset, for
+// example MediaStorageSOPInstanceUID and SOPInstanceUID.
+var ErrorMetaUIDMismatch = errors.New("file meta information UID does not match the dataset")
+
+var (
+	// DefaultImplementationClassUID is the ImplementationClassUID written by
+	// PopulateFileMetaInformation when none is set with ImplementationIdentity.
+	DefaultImplementationClassUID = "1.2.826.0.1.3680043.9.7133.1.1"
+	// DefaultImplementationVersionName is the ImplementationVersionName
+	// written by PopulateFileMetaInformation when none is set with
+	// ImplementationIdentity.
+	DefaultImplementationVersionName = "GODICOM_1_1"
+)
+
+// fileMetaInformationVersion is the only FileMetaInformationVersion defined
+// by the standard.
+var fileMetaInformationVersion = []byte{0x00, 0x01}
+
+// metaUIDs pairs File Meta Information UIDs with the body UIDs they must
+// agree with.
+var metaUIDs = []struct{ meta, body tag.Tag }{
+	{tag.MediaStorageSOPClassUID, tag.SOPClassUID},
+	{tag.MediaStorageSOPInstanceUID, tag.SOPInstanceUID},
+}
+
+// metaDefault is a File Meta Information element to add if it is missing.
+type metaDefault struct {
+	t    tag.Tag
+	data interface{}
+}
+
+// PopulateFileMetaInformation returns a WriteOption that fills in any missing
+// File Meta Information elements before writing:
+//
+//   - FileMetaInformationVersion is set to 00H 01H.
+//   - MediaStorageSOPClassUID and MediaStorageSOPInstanceUID are copied from
+//     SOPClassUID and SOPInstanceUID in the Dataset.
+//   - ImplementationClassUID and ImplementationVersionName are set from
+//     ImplementationIdentity, or DefaultImplementationClassUID and
+//     DefaultImplementationVersionName.
+//   - SourceApplicationEntityTitle is set from SourceApplicationEntityTitle,
+//     if provided.
+//
+// Elements already present in the Dataset are left unchanged.
+func PopulateFileMetaInformation() WriteOption {
+	return func(set *writeOptSet) {
+		set.populateFileMeta = true
+	}
+}
+
+// ImplementationIdentity returns a WriteOption that sets the
+// ImplementationClassUID and ImplementationVersionName written by
+// PopulateFileMetaInformation. An empty versionName omits
+// ImplementationVersionName.
+func ImplementationIdentity(classUID, versionName string) WriteOption {
+	return func(set *writeOptSet) {
+		set.implementationClassUID = &classUID
+		set.implementationVersionName = &versionName
+	}
+}
+
+// SourceApplicationEntityTitle returns a WriteOption that sets the
+// SourceApplicationEntityTitle written by PopulateFileMetaInformation.
+func SourceApplicationEntityTitle(title string) WriteOption {
+	return func(set *writeOptSet) {
+		set.sourceApplicationEntityTitle = title
+	}
+}
+
+// fileMetaElements returns the File Meta Information elements to write for
+// ds, given the metadata group elements already present in it. Missing
+// elements are filled in if PopulateFileMetaInformation was set, and an error
+// is returned if the result disagrees with the body of ds.
+func fileMetaElements(ds *Dataset, metaElems []*Element, opts writeOptSet) ([]*Element, error) {
+	meta := Dataset{Elements: metaElems}
+	if opts.populateFileMeta {
+		// Copy the elements so that the caller's slice is not modified.
+		meta.Elements = append([]*Element(nil), metaElems...)
+		classUID, versionName := DefaultImplementationClassUID, DefaultImplementationVersionName
+		if opts.implementationClassUID != nil {
+			classUID, versionName = *opts.implementationClassUID, *opts.implementationVersionName
+		}
+		missing := []metaDefault{
+			{tag.FileMetaInformationVersion, fileMetaInformationVersion},
+			{tag.ImplementationClassUID, []string{classUID}},
+		}
+		if versionName != "" {
+			missing = append(missing, metaDefault{tag.ImplementationVersionName, []string{versionName}})
+		}
+		if opts.sourceApplicationEntityTitle != "" {
+			missing = append(missing, metaDefault{tag.SourceApplicationEntityTitle, []string{opts.sourceApplicationEntityTitle}})
+		}
+		for _, m := range missing {
+			if _, err := meta.FindElementByTag(m.t); err != ErrorElementNotFound {
+				continue
+			}
+			elem, err := NewElement(m.t, m.data)
+			if err != nil {
+				return nil, err
+			}
+			meta.Set(elem)
+		}
+		for _, u := range metaUIDs {
+			if _, err := meta.FindElementByTag(u.meta); err != ErrorElementNotFound {
+				continue
+			}
+			if body, err := ds.FindElementByTag(u.body); err == nil {
+				if body.Value == nil {
+					return nil, fmt.Errorf("%s has no value to populate %s with", tag.DebugString(u.body), tag.DebugString(u.meta))
+				}
+				elem, err := NewElement(u.meta, body.Value.Clone().GetValue())
+				if err != nil {
+					return nil, err
+				}
+				meta.Set(elem)
+			}
+		}
+	}
+
+	for _, u := range metaUIDs {
+		metaElem, err := meta.FindElementByTag(u.meta)
+		if err != nil {
+			continue
+		}
+		bodyElem, err := ds.FindElementByTag(u.body)
+		if err != nil {
+			continue
+		}
+		if metaUID, bodyUID := firstString(metaElem), firstString(bodyElem); metaUID != bodyUID {
+			return nil, fmt.Errorf("%w: %s is %q but %s is %q", ErrorMetaUIDMismatch,
+				tag.DebugString(u.meta), metaUID, tag.DebugString(u.body), bodyUID)
+		}
+	}
+	return meta.Elements, nil
+}
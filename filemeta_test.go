@@ -0,0 +1,112 @@
+package dicom
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/ginuerzh/dicom/pkg/tag"
+	"github.com/ginuerzh/dicom/pkg/uid"
+)
+
+func TestWrite_PopulateFileMetaInformation(t *testing.T) {
+	ds := Dataset{Elements: []*Element{
+		mustNewElement(tag.TransferSyntaxUID, []string{uid.ExplicitVRLittleEndian}),
+		mustNewElement(tag.SOPClassUID, []string{"1.2.840.10008.5.1.4.1.1.7"}),
+		mustNewElement(tag.SOPInstanceUID, []string{"1.2.3.4.5.6.7"}),
+	}}
+
+	cases := []struct {
+		name string
+		opts []WriteOption
+		want map[tag.Tag]interface{}
+	}{
+		{
+			name: "library defaults",
+			opts: []WriteOption{PopulateFileMetaInformation()},
+			want: map[tag.Tag]interface{}{
+				tag.FileMetaInformationVersion: []byte{0x00, 0x01},
+				tag.MediaStorageSOPClassUID:    []string{"1.2.840.10008.5.1.4.1.1.7"},
+				tag.MediaStorageSOPInstanceUID: []string{"1.2.3.4.5.6.7"},
+				tag.ImplementationClassUID:     []string{DefaultImplementationClassUID},
+				tag.ImplementationVersionName:  []string{DefaultImplementationVersionName},
+			},
+		},
+		{
+			name: "configured identity and AE title",
+			opts: []WriteOption{
+				PopulateFileMetaInformation(),
+				ImplementationIdentity("1.2.3.4", "MYAPP_2"),
+				SourceApplicationEntityTitle("STORESCU"),
+			},
+			want: map[tag.Tag]interface{}{
+				tag.ImplementationClassUID:       []string{"1.2.3.4"},
+				tag.ImplementationVersionName:    []string{"MYAPP_2"},
+				tag.SourceApplicationEntityTitle: []string{"STORESCU"},
+			},
+		},
+		{
+			name: "not populated by default",
+			want: map[tag.Tag]interface{}{
+				tag.FileMetaInformationVersion: nil,
+				tag.MediaStorageSOPClassUID:    nil,
+				tag.ImplementationClassUID:     nil,
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			if err := Write(buf, ds, tc.opts...); err != nil {
+				t.Fatalf("Write: %v", err)
+			}
+			got, err := Parse(buf, int64(buf.Len()), nil)
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			for tg, want := range tc.want {
+				elem, err := got.FindElementByTag(tg)
+				if want == nil {
+					if err != ErrorElementNotFound {
+						t.Errorf("FindElementByTag(%v) = %v, want ErrorElementNotFound", tg, elem)
+					}
+					continue
+				}
+				if err != nil {
+					t.Errorf("FindElementByTag(%v): %v", tg, err)
+					continue
+				}
+				if wantElem := mustNewElement(tg, want); !elem.Value.Equal(wantElem.Value) {
+					t.Errorf("%v: got %v, want %v", tg, elem.Value, wantElem.Value)
+				}
+			}
+		})
+	}
+	if len(ds.Elements) != 3 {
+		t.Errorf("Write with PopulateFileMetaInformation modified the Dataset")
+	}
+}
+
+func TestWrite_FileMetaUIDMismatch(t *testing.T) {
+	ds := Dataset{Elements: []*Element{
+		mustNewElement(tag.MediaStorageSOPClassUID, []string{"1.2.840.10008.5.1.4.1.1.7"}),
+		mustNewElement(tag.MediaStorageSOPInstanceUID, []string{"1.2.3.4.5.6.7"}),
+		mustNewElement(tag.TransferSyntaxUID, []string{uid.ExplicitVRLittleEndian}),
+		mustNewElement(tag.SOPClassUID, []string{"1.2.840.10008.5.1.4.1.1.7"}),
+		mustNewElement(tag.SOPInstanceUID, []string{"1.2.3.4.5.6.8"}),
+	}}
+	if err := Write(&bytes.Buffer{}, ds); !errors.Is(err, ErrorMetaUIDMismatch) {
+		t.Errorf("Write with mismatched SOPInstanceUID: got %v, want %v", err, ErrorMetaUIDMismatch)
+	}
+}
+
+func TestWrite_PopulateFileMetaInformation_NilValue(t *testing.T) {
+	ds := Dataset{Elements: []*Element{
+		mustNewElement(tag.TransferSyntaxUID, []string{uid.ExplicitVRLittleEndian}),
+		mustNewElement(tag.SOPClassUID, []string{"1.2.840.10008.5.1.4.1.1.7"}),
+		{Tag: tag.SOPInstanceUID},
+	}}
+	if err := Write(&bytes.Buffer{}, ds, PopulateFileMetaInformation()); err == nil {
+		t.Errorf("Write with a SOPInstanceUID without value: got no error")
+	}
+}
@@ -0,0 +1,463 @@
+package dicom
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ginuerzh/dicom/pkg/tag"
+)
+
+// ErrorUnknownIOD indicates that a Dataset has no SOPClassUID, or that there
+// is no IOD definition for its SOPClassUID.
+var ErrorUnknownIOD = errors.New("no IOD definition for the SOP class of the dataset")
+
+// AttributeType is the type of an attribute in a module, which says whether
+// it has to be present and whether it may be empty. See PS3.5 7.4.
+type AttributeType string
+
+const (
+	// Type1 attributes must be present with a value.
+	Type1 AttributeType = "1"
+	// Type1C attributes must be present with a value if their condition holds.
+	Type1C AttributeType = "1C"
+	// Type2 attributes must be present, but may be empty.
+	Type2 AttributeType = "2"
+	// Type2C attributes must be present if their condition holds, but may be
+	// empty.
+	Type2C AttributeType = "2C"
+	// Type3 attributes are optional.
+	Type3 AttributeType = "3"
+)
+
+// ModuleUsage says whether a module has to be present in an IOD. See PS3.3
+// A.1.3.
+type ModuleUsage string
+
+const (
+	// UsageMandatory modules must be present.
+	UsageMandatory ModuleUsage = "M"
+	// UsageConditional modules must be present if their condition holds.
+	UsageConditional ModuleUsage = "C"
+	// UsageUserOption modules may be present.
+	UsageUserOption ModuleUsage = "U"
+)
+
+// Condition decides whether a Type 1C or 2C attribute, or a conditional
+// module, is required. It is called with the elements of the Dataset or
+// sequence item that holds the attribute.
+type Condition func(elems []*Element) bool
+
+// IfPresent returns a Condition that holds if the element with tag t is
+// present.
+func IfPresent(t tag.Tag) Condition {
+	return func(elems []*Element) bool {
+		return findElement(elems, t) != nil
+	}
+}
+
+// IfAbsent returns a Condition that holds if the element with tag t is not
+// present.
+func IfAbsent(t tag.Tag) Condition {
+	return func(elems []*Element) bool {
+		return findElement(elems, t) == nil
+	}
+}
+
+// IfValue returns a Condition that holds if any value of the element with
+// tag t is one of values.
+func IfValue(t tag.Tag, values ...string) Condition {
+	return func(elems []*Element) bool {
+		for _, v := range attributeValues(findElement(elems, t)) {
+			for _, want := range values {
+				if v == want {
+					return true
+				}
+			}
+		}
+		return false
+	}
+}
+
+// IfAll returns a Condition that holds if all of conds hold.
+func IfAll(conds ...Condition) Condition {
+	return func(elems []*Element) bool {
+		for _, c := range conds {
+			if !c(elems) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// IfNot returns a Condition that holds if cond does not.
+func IfNot(cond Condition) Condition {
+	return func(elems []*Element) bool {
+		return !cond(elems)
+	}
+}
+
+// Terms are the enumerated values or defined terms of an attribute.
+type Terms struct {
+	// Value is the 1-based index of the value the terms apply to, or 0 if
+	// they apply to every value.
+	Value int
+	// Values are the allowed values.
+	Values []string
+	// Defined marks defined terms, which may be extended by implementations.
+	// Other values are reported as warnings rather than errors.
+	Defined bool
+}
+
+// Attribute is the definition of an attribute in a module.
+type Attribute struct {
+	Tag tag.Tag
+	// Name is the keyword of the attribute in PS3.6, used in reports.
+	Name string
+	Type AttributeType
+	// Condition decides whether a Type 1C or 2C attribute is required. If it
+	// is nil, the condition cannot be evaluated from the Dataset, and the
+	// attribute is only checked if it is present.
+	Condition Condition
+	// Terms restrict the values of the attribute.
+	Terms []Terms
+	// Items are the attributes of the items of a sequence attribute.
+	Items []Attribute
+	// MaxItems is the maximum number of items of a sequence attribute, or 0
+	// if it is unbounded.
+	MaxItems int
+}
+
+// Module is a set of related attributes, like the Patient Module of PS3.3
+// C.7.1.1.
+type Module struct {
+	Name       string
+	Attributes []Attribute
+}
+
+// ModuleRef is the use of a Module in an IOD.
+type ModuleRef struct {
+	Module *Module
+	Usage  ModuleUsage
+	// Condition decides whether a conditional module is required. If it is
+	// nil, conditional modules are treated like user optional ones: they
+	// are only checked if any of their attributes is present.
+	Condition Condition
+}
+
+// IOD is the definition of an Information Object, the modules an instance
+// of a SOP class is made of. See PS3.3 A.
+type IOD struct {
+	Name        string
+	SOPClassUID string
+	Modules     []ModuleRef
+}
+
+// FindIOD returns the IOD definition for the SOP class sopClassUID. The CT
+// Image, MR Image, Secondary Capture Image, RT Structure Set and Enhanced SR
+// IODs are defined.
+func FindIOD(sopClassUID string) (*IOD, error) {
+	iod, ok := iods[sopClassUID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrorUnknownIOD, sopClassUID)
+	}
+	return iod, nil
+}
+
+// Severity is the severity of an IODViolation.
+type Severity string
+
+const (
+	// SeverityError marks violations that make the Dataset non-conformant.
+	SeverityError Severity = "error"
+	// SeverityWarning marks suspicious contents, like values outside the
+	// defined terms of an attribute.
+	SeverityWarning Severity = "warning"
+)
+
+// ViolationKind describes how an attribute violates its definition.
+type ViolationKind string
+
+const (
+	// ViolationMissing is reported for required attributes that are absent.
+	ViolationMissing ViolationKind = "missing"
+	// ViolationEmpty is reported for attributes that must have a value but
+	// are empty, including sequences without items.
+	ViolationEmpty ViolationKind = "empty"
+	// ViolationInvalid is reported for attributes whose value is not allowed,
+	// like values outside the enumerated values or violating the rules of
+	// their VR.
+	ViolationInvalid ViolationKind = "invalid"
+)
+
+// IODViolation is a single violation of an IOD definition found by Validate.
+type IODViolation struct {
+	// Path locates the attribute from the root of the Dataset, for example
+	// "(0040,a730)[0].(0008,0100)".
+	Path     string        `json:"path"`
+	Tag      tag.Tag       `json:"tag"`
+	Name     string        `json:"name,omitempty"`
+	Module   string        `json:"module,omitempty"`
+	Type     AttributeType `json:"type,omitempty"`
+	Kind     ViolationKind `json:"kind"`
+	Severity Severity      `json:"severity"`
+	Message  string        `json:"message"`
+}
+
+// String returns a one line description of this IODViolation in the style
+// of dciodvfy, e.g. "Error - (0010,0020) PatientID: missing Type 2 attribute
+// (module Patient)".
+func (v IODViolation) String() string {
+	severity := "Error"
+	if v.Severity == SeverityWarning {
+		severity = "Warning"
+	}
+	name := v.Name
+	if name == "" {
+		name = "?"
+	}
+	s := fmt.Sprintf("%s - %s %s: %s", severity, v.Path, name, v.Message)
+	if v.Module != "" {
+		s += fmt.Sprintf(" (module %s)", v.Module)
+	}
+	return s
+}
+
+// IODReport holds the result of Validate. Like DiffReport, it is JSON
+// serializable and String renders one violation per line.
+type IODReport struct {
+	SOPClassUID string         `json:"sopClassUID"`
+	IOD         string         `json:"iod"`
+	Violations  []IODViolation `json:"violations"`
+}
+
+// Valid reports whether no errors were found. Warnings are allowed.
+func (r IODReport) Valid() bool {
+	for _, v := range r.Violations {
+		if v.Severity == SeverityError {
+			return false
+		}
+	}
+	return true
+}
+
+func (r IODReport) String() string {
+	var b strings.Builder
+	for _, v := range r.Violations {
+		b.WriteString(v.String())
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// Validate checks ds against the IOD definition of its SOP class, found with
+// FindIOD from its SOPClassUID (or MediaStorageSOPClassUID). It reports
+// missing, empty and invalid attributes of the modules of the IOD, including
+// those nested in sequences, and the violations of the rules of their VRs
+// found by Dataset.ValidateValues. It returns an error wrapping
+// ErrorUnknownIOD if there is no definition for the SOP class of ds.
+func Validate(ds Dataset) (IODReport, error) {
+	var sopClassUID string
+	for _, t := range []tag.Tag{tag.SOPClassUID, tag.MediaStorageSOPClassUID} {
+		if e := findElement(ds.Elements, t); e != nil && sopClassUID == "" {
+			sopClassUID = firstString(e)
+		}
+	}
+	if sopClassUID == "" {
+		return IODReport{}, fmt.Errorf("%w: no SOPClassUID", ErrorUnknownIOD)
+	}
+	iod, err := FindIOD(sopClassUID)
+	if err != nil {
+		return IODReport{}, err
+	}
+	return iod.Validate(ds), nil
+}
+
+// Validate checks ds against this IOD, see the Validate function.
+func (iod *IOD) Validate(ds Dataset) IODReport {
+	report := IODReport{SOPClassUID: iod.SOPClassUID, IOD: iod.Name}
+	for _, ref := range iod.Modules {
+		required := ref.Usage == UsageMandatory ||
+			ref.Usage == UsageConditional && ref.Condition != nil && ref.Condition(ds.Elements)
+		if !required && !modulePresent(ref.Module, ds.Elements) {
+			continue
+		}
+		v := &iodValidator{report: &report, module: ref.Module.Name}
+		v.validateAttributes("", ds.Elements, ref.Module.Attributes)
+	}
+	for _, vv := range ds.ValidateValues() {
+		report.Violations = append(report.Violations, IODViolation{
+			Path:     vv.Path,
+			Tag:      vv.Tag,
+			Name:     tagName(vv.Tag),
+			Kind:     ViolationInvalid,
+			Severity: SeverityError,
+			Message:  valueViolationMessage(vv),
+		})
+	}
+	return report
+}
+
+// modulePresent reports whether any top-level attribute of m is in elems.
+func modulePresent(m *Module, elems []*Element) bool {
+	for _, a := range m.Attributes {
+		if findElement(elems, a.Tag) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// iodValidator collects the violations of the attributes of one module.
+type iodValidator struct {
+	report *IODReport
+	module string
+}
+
+func (v *iodValidator) add(path string, a Attribute, kind ViolationKind, severity Severity, format string, args ...interface{}) {
+	v.report.Violations = append(v.report.Violations, IODViolation{
+		Path:     path,
+		Tag:      a.Tag,
+		Name:     a.Name,
+		Module:   v.module,
+		Type:     a.Type,
+		Kind:     kind,
+		Severity: severity,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// validateAttributes checks elems, the elements of the Dataset or of a
+// sequence item at prefix, against attrs.
+func (v *iodValidator) validateAttributes(prefix string, elems []*Element, attrs []Attribute) {
+	for _, a := range attrs {
+		path := prefix + a.Tag.String()
+		e := findElement(elems, a.Tag)
+		required := a.Type == Type1 || a.Type == Type2 ||
+			(a.Type == Type1C || a.Type == Type2C) && a.Condition != nil && a.Condition(elems)
+		if e == nil {
+			if required {
+				v.add(path, a, ViolationMissing, SeverityError, "missing Type %s attribute", a.Type)
+			}
+			continue
+		}
+
+		empty := attributeEmpty(e)
+		mustHaveValue := a.Type == Type1 || a.Type == Type1C && (a.Condition == nil || required)
+		if empty && mustHaveValue {
+			v.add(path, a, ViolationEmpty, SeverityError, "empty Type %s attribute", a.Type)
+		}
+		v.validateTerms(path, a, e)
+
+		items, ok := e.Value.GetValue().([]*SequenceItemValue)
+		if !ok || e.Value.ValueType() != Sequences {
+			continue
+		}
+		if a.MaxItems > 0 && len(items) > a.MaxItems {
+			v.add(path, a, ViolationInvalid, SeverityError, "%d items, but at most %d are allowed", len(items), a.MaxItems)
+		}
+		for i, item := range items {
+			v.validateAttributes(fmt.Sprintf("%s[%d].", path, i), item.elements, a.Items)
+		}
+	}
+}
+
+// validateTerms checks the values of e against the enumerated values and
+// defined terms of a.
+func (v *iodValidator) validateTerms(path string, a Attribute, e *Element) {
+	values := attributeValues(e)
+	for _, terms := range a.Terms {
+		for i, value := range values {
+			if terms.Value > 0 && terms.Value != i+1 || value == "" || containsString(terms.Values, value) {
+				continue
+			}
+			if terms.Defined {
+				v.add(path, a, ViolationInvalid, SeverityWarning, "value %d %q is not a defined term", i+1, value)
+			} else {
+				v.add(path, a, ViolationInvalid, SeverityError, "value %d %q is not an enumerated value", i+1, value)
+			}
+		}
+	}
+}
+
+// findElement returns the element of elems with tag t, or nil.
+func findElement(elems []*Element, t tag.Tag) *Element {
+	for _, e := range elems {
+		if e.Tag == t {
+			return e
+		}
+	}
+	return nil
+}
+
+// attributeEmpty reports whether e has a zero length value, or is a
+// sequence without items.
+func attributeEmpty(e *Element) bool {
+	if e.Value == nil {
+		return true
+	}
+	switch value := e.Value.GetValue().(type) {
+	case []string:
+		return len(value) == 0 || len(value) == 1 && strings.TrimSpace(strings.TrimRight(value[0], "\x00")) == ""
+	case []byte:
+		return len(value) == 0
+	case []int64:
+		return len(value) == 0
+	case []uint64:
+		return len(value) == 0
+	case []float64:
+		return len(value) == 0
+	case []*SequenceItemValue:
+		return len(value) == 0
+	}
+	return false
+}
+
+// attributeValues returns the values of a string or numeric element as
+// strings, without padding. It returns nil for other elements.
+func attributeValues(e *Element) []string {
+	if e == nil || e.Value == nil {
+		return nil
+	}
+	var values []string
+	switch value := e.Value.GetValue().(type) {
+	case []string:
+		for _, s := range value {
+			values = append(values, strings.TrimSpace(strings.TrimRight(s, "\x00")))
+		}
+	case []int64:
+		for _, n := range value {
+			values = append(values, strconv.FormatInt(n, 10))
+		}
+	case []uint64:
+		for _, n := range value {
+			values = append(values, strconv.FormatUint(n, 10))
+		}
+	}
+	return values
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func valueViolationMessage(v ValueViolation) string {
+	if v.Index < 0 {
+		return fmt.Sprintf("%s: %s", v.Rule, v.Message)
+	}
+	return fmt.Sprintf("value %d: %s: %s", v.Index+1, v.Rule, v.Message)
+}
+
+func tagName(t tag.Tag) string {
+	if info, err := tag.Find(t); err == nil {
+		return info.Name
+	}
+	return ""
+}
@@ -0,0 +1,680 @@
+package dicom
+
+import (
+	"github.com/ginuerzh/dicom/pkg/tag"
+	"github.com/ginuerzh/dicom/pkg/uid"
+)
+
+// The module and IOD definitions of PS3.3 used by Validate. Only the
+// attributes that are required, conditional or have enumerated values or
+// defined terms are listed, along with a few common Type 3 attributes.
+
+// iods are the IOD definitions, keyed by SOP class UID.
+var iods = map[string]*IOD{
+	uid.CTImageStorage: {
+		Name:        "CT Image",
+		SOPClassUID: uid.CTImageStorage,
+		Modules: []ModuleRef{
+			{Module: patientModule, Usage: UsageMandatory},
+			{Module: generalStudyModule, Usage: UsageMandatory},
+			{Module: patientStudyModule, Usage: UsageUserOption},
+			{Module: generalSeriesModule, Usage: UsageMandatory},
+			{Module: frameOfReferenceModule, Usage: UsageMandatory},
+			{Module: generalEquipmentModule, Usage: UsageMandatory},
+			{Module: generalImageModule, Usage: UsageMandatory},
+			{Module: imagePlaneModule, Usage: UsageMandatory},
+			{Module: imagePixelModule, Usage: UsageMandatory},
+			{Module: contrastBolusModule, Usage: UsageConditional},
+			{Module: ctImageModule, Usage: UsageMandatory},
+			{Module: voiLUTModule, Usage: UsageUserOption},
+			{Module: sopCommonModule, Usage: UsageMandatory},
+		},
+	},
+	uid.MRImageStorage: {
+		Name:        "MR Image",
+		SOPClassUID: uid.MRImageStorage,
+		Modules: []ModuleRef{
+			{Module: patientModule, Usage: UsageMandatory},
+			{Module: generalStudyModule, Usage: UsageMandatory},
+			{Module: patientStudyModule, Usage: UsageUserOption},
+			{Module: generalSeriesModule, Usage: UsageMandatory},
+			{Module: frameOfReferenceModule, Usage: UsageMandatory},
+			{Module: generalEquipmentModule, Usage: UsageMandatory},
+			{Module: generalImageModule, Usage: UsageMandatory},
+			{Module: imagePlaneModule, Usage: UsageMandatory},
+			{Module: imagePixelModule, Usage: UsageMandatory},
+			{Module: contrastBolusModule, Usage: UsageConditional},
+			{Module: mrImageModule, Usage: UsageMandatory},
+			{Module: voiLUTModule, Usage: UsageUserOption},
+			{Module: sopCommonModule, Usage: UsageMandatory},
+		},
+	},
+	uid.SecondaryCaptureImageStorage: {
+		Name:        "Secondary Capture Image",
+		SOPClassUID: uid.SecondaryCaptureImageStorage,
+		Modules: []ModuleRef{
+			{Module: patientModule, Usage: UsageMandatory},
+			{Module: generalStudyModule, Usage: UsageMandatory},
+			{Module: patientStudyModule, Usage: UsageUserOption},
+			{Module: generalSeriesModule, Usage: UsageMandatory},
+			{Module: generalEquipmentModule, Usage: UsageUserOption},
+			{Module: scEquipmentModule, Usage: UsageMandatory},
+			{Module: generalImageModule, Usage: UsageMandatory},
+			{Module: imagePixelModule, Usage: UsageMandatory},
+			{Module: scImageModule, Usage: UsageMandatory},
+			{Module: voiLUTModule, Usage: UsageUserOption},
+			{Module: sopCommonModule, Usage: UsageMandatory},
+		},
+	},
+	uid.RTStructureSetStorage: {
+		Name:        "RT Structure Set",
+		SOPClassUID: uid.RTStructureSetStorage,
+		Modules: []ModuleRef{
+			{Module: patientModule, Usage: UsageMandatory},
+			{Module: generalStudyModule, Usage: UsageMandatory},
+			{Module: patientStudyModule, Usage: UsageUserOption},
+			{Module: rtSeriesModule, Usage: UsageMandatory},
+			{Module: generalEquipmentModule, Usage: UsageMandatory},
+			{Module: structureSetModule, Usage: UsageMandatory},
+			{Module: roiContourModule, Usage: UsageMandatory},
+			{Module: rtROIObservationsModule, Usage: UsageMandatory},
+			{Module: approvalModule, Usage: UsageUserOption},
+			{Module: sopCommonModule, Usage: UsageMandatory},
+		},
+	},
+	uid.EnhancedSRStorage: {
+		Name:        "Enhanced SR",
+		SOPClassUID: uid.EnhancedSRStorage,
+		Modules: []ModuleRef{
+			{Module: patientModule, Usage: UsageMandatory},
+			{Module: generalStudyModule, Usage: UsageMandatory},
+			{Module: patientStudyModule, Usage: UsageUserOption},
+			{Module: srDocumentSeriesModule, Usage: UsageMandatory},
+			{Module: generalEquipmentModule, Usage: UsageMandatory},
+			{Module: srDocumentGeneralModule, Usage: UsageMandatory},
+			{Module: srDocumentContentModule, Usage: UsageMandatory},
+			{Module: sopCommonModule, Usage: UsageMandatory},
+		},
+	},
+}
+
+func enumerated(values ...string) Terms {
+	return Terms{Values: values}
+}
+
+func defined(values ...string) Terms {
+	return Terms{Values: values, Defined: true}
+}
+
+// PS3.3 C.7.1.1
+var patientModule = &Module{
+	Name: "Patient",
+	Attributes: []Attribute{
+		{Tag: tag.Tag{Group: 0x0010, Element: 0x0010}, Name: "PatientName", Type: Type2},
+		{Tag: tag.Tag{Group: 0x0010, Element: 0x0020}, Name: "PatientID", Type: Type2},
+		{Tag: tag.Tag{Group: 0x0010, Element: 0x0030}, Name: "PatientBirthDate", Type: Type2},
+		{Tag: tag.Tag{Group: 0x0010, Element: 0x0040}, Name: "PatientSex", Type: Type2, Terms: []Terms{enumerated("M", "F", "O")}},
+	},
+}
+
+// PS3.3 C.7.2.1
+var generalStudyModule = &Module{
+	Name: "General Study",
+	Attributes: []Attribute{
+		{Tag: tag.Tag{Group: 0x0020, Element: 0x000D}, Name: "StudyInstanceUID", Type: Type1},
+		{Tag: tag.Tag{Group: 0x0008, Element: 0x0020}, Name: "StudyDate", Type: Type2},
+		{Tag: tag.Tag{Group: 0x0008, Element: 0x0030}, Name: "StudyTime", Type: Type2},
+		{Tag: tag.Tag{Group: 0x0008, Element: 0x0090}, Name: "ReferringPhysicianName", Type: Type2},
+		{Tag: tag.Tag{Group: 0x0020, Element: 0x0010}, Name: "StudyID", Type: Type2},
+		{Tag: tag.Tag{Group: 0x0008, Element: 0x0050}, Name: "AccessionNumber", Type: Type2},
+		{Tag: tag.Tag{Group: 0x0008, Element: 0x1030}, Name: "StudyDescription", Type: Type3},
+		{Tag: tag.Tag{Group: 0x0008, Element: 0x1032}, Name: "ProcedureCodeSequence", Type: Type3, Items: codeSequenceMacro},
+	},
+}
+
+// PS3.3 C.7.2.2
+var patientStudyModule = &Module{
+	Name: "Patient Study",
+	Attributes: []Attribute{
+		{Tag: tag.Tag{Group: 0x0010, Element: 0x1010}, Name: "PatientAge", Type: Type3},
+		{Tag: tag.Tag{Group: 0x0010, Element: 0x1020}, Name: "PatientSize", Type: Type3},
+		{Tag: tag.Tag{Group: 0x0010, Element: 0x1030}, Name: "PatientWeight", Type: Type3},
+	},
+}
+
+// PS3.3 C.7.3.1
+var generalSeriesModule = &Module{
+	Name: "General Series",
+	Attributes: []Attribute{
+		{Tag: tag.Tag{Group: 0x0008, Element: 0x0060}, Name: "Modality", Type: Type1},
+		{Tag: tag.Tag{Group: 0x0020, Element: 0x000E}, Name: "SeriesInstanceUID", Type: Type1},
+		{Tag: tag.Tag{Group: 0x0020, Element: 0x0011}, Name: "SeriesNumber", Type: Type2},
+		{Tag: tag.Tag{Group: 0x0020, Element: 0x0060}, Name: "Laterality", Type: Type2C, Terms: []Terms{enumerated("R", "L")}},
+		{Tag: tag.Tag{Group: 0x0008, Element: 0x0021}, Name: "SeriesDate", Type: Type3},
+		{Tag: tag.Tag{Group: 0x0008, Element: 0x0031}, Name: "SeriesTime", Type: Type3},
+		{Tag: tag.Tag{Group: 0x0008, Element: 0x103E}, Name: "SeriesDescription", Type: Type3},
+		{
+			Tag: tag.Tag{Group: 0x0018, Element: 0x5100}, Name: "PatientPosition", Type: Type2C,
+			Condition: IfValue(tag.Tag{Group: 0x0008, Element: 0x0016}, uid.CTImageStorage, uid.MRImageStorage),
+			Terms: []Terms{defined("HFP", "HFS", "HFDR", "HFDL", "FFDR", "FFDL", "FFP", "FFS",
+				"LFP", "LFS", "RFP", "RFS", "AFDR", "AFDL", "PFDR", "PFDL")},
+		},
+	},
+}
+
+// PS3.3 C.7.4.1
+var frameOfReferenceModule = &Module{
+	Name: "Frame of Reference",
+	Attributes: []Attribute{
+		{Tag: tag.Tag{Group: 0x0020, Element: 0x0052}, Name: "FrameOfReferenceUID", Type: Type1},
+		{Tag: tag.Tag{Group: 0x0020, Element: 0x1040}, Name: "PositionReferenceIndicator", Type: Type2},
+	},
+}
+
+// PS3.3 C.7.5.1
+var generalEquipmentModule = &Module{
+	Name: "General Equipment",
+	Attributes: []Attribute{
+		{Tag: tag.Tag{Group: 0x0008, Element: 0x0070}, Name: "Manufacturer", Type: Type2},
+		{Tag: tag.Tag{Group: 0x0008, Element: 0x0080}, Name: "InstitutionName", Type: Type3},
+		{Tag: tag.Tag{Group: 0x0008, Element: 0x1010}, Name: "StationName", Type: Type3},
+		{Tag: tag.Tag{Group: 0x0008, Element: 0x1090}, Name: "ManufacturerModelName", Type: Type3},
+		{Tag: tag.Tag{Group: 0x0018, Element: 0x1020}, Name: "SoftwareVersions", Type: Type3},
+		{
+			Tag: tag.Tag{Group: 0x0028, Element: 0x0120}, Name: "PixelPaddingValue", Type: Type1C,
+			Condition: IfPresent(tag.Tag{Group: 0x0028, Element: 0x0121}),
+		},
+	},
+}
+
+// PS3.3 C.7.6.1
+var generalImageModule = &Module{
+	Name: "General Image",
+	Attributes: []Attribute{
+		{Tag: tag.Tag{Group: 0x0020, Element: 0x0013}, Name: "InstanceNumber", Type: Type2},
+		{
+			Tag: tag.Tag{Group: 0x0020, Element: 0x0020}, Name: "PatientOrientation", Type: Type2C,
+			Condition: IfAbsent(tag.Tag{Group: 0x0020, Element: 0x0037}),
+		},
+		{Tag: tag.Tag{Group: 0x0008, Element: 0x0023}, Name: "ContentDate", Type: Type2C},
+		{Tag: tag.Tag{Group: 0x0008, Element: 0x0033}, Name: "ContentTime", Type: Type2C},
+		{Tag: tag.Tag{Group: 0x0008, Element: 0x0008}, Name: "ImageType", Type: Type3, Terms: imageTypeTerms},
+		{Tag: tag.Tag{Group: 0x0028, Element: 0x0301}, Name: "BurnedInAnnotation", Type: Type3, Terms: []Terms{enumerated("YES", "NO")}},
+		{Tag: tag.Tag{Group: 0x0028, Element: 0x2110}, Name: "LossyImageCompression", Type: Type3, Terms: []Terms{enumerated("00", "01")}},
+	},
+}
+
+var imageTypeTerms = []Terms{
+	{Value: 1, Values: []string{"ORIGINAL", "DERIVED"}},
+	{Value: 2, Values: []string{"PRIMARY", "SECONDARY"}},
+}
+
+// PS3.3 C.7.6.2
+var imagePlaneModule = &Module{
+	Name: "Image Plane",
+	Attributes: []Attribute{
+		{Tag: tag.Tag{Group: 0x0028, Element: 0x0030}, Name: "PixelSpacing", Type: Type1},
+		{Tag: tag.Tag{Group: 0x0020, Element: 0x0037}, Name: "ImageOrientationPatient", Type: Type1},
+		{Tag: tag.Tag{Group: 0x0020, Element: 0x0032}, Name: "ImagePositionPatient", Type: Type1},
+		{Tag: tag.Tag{Group: 0x0018, Element: 0x0050}, Name: "SliceThickness", Type: Type2},
+		{Tag: tag.Tag{Group: 0x0020, Element: 0x1041}, Name: "SliceLocation", Type: Type3},
+	},
+}
+
+var (
+	samplesPerPixel           = tag.Tag{Group: 0x0028, Element: 0x0002}
+	photometricInterpretation = tag.Tag{Group: 0x0028, Element: 0x0004}
+	isPaletteColor            = IfValue(photometricInterpretation, "PALETTE COLOR")
+)
+
+// PS3.3 C.7.6.3
+var imagePixelModule = &Module{
+	Name: "Image Pixel",
+	Attributes: []Attribute{
+		{Tag: samplesPerPixel, Name: "SamplesPerPixel", Type: Type1},
+		{
+			Tag: photometricInterpretation, Name: "PhotometricInterpretation", Type: Type1,
+			Terms: []Terms{defined("MONOCHROME1", "MONOCHROME2", "PALETTE COLOR", "RGB", "YBR_FULL",
+				"YBR_FULL_422", "YBR_PARTIAL_420", "YBR_ICT", "YBR_RCT")},
+		},
+		{Tag: tag.Tag{Group: 0x0028, Element: 0x0010}, Name: "Rows", Type: Type1},
+		{Tag: tag.Tag{Group: 0x0028, Element: 0x0011}, Name: "Columns", Type: Type1},
+		{Tag: tag.Tag{Group: 0x0028, Element: 0x0100}, Name: "BitsAllocated", Type: Type1},
+		{Tag: tag.Tag{Group: 0x0028, Element: 0x0101}, Name: "BitsStored", Type: Type1},
+		{Tag: tag.Tag{Group: 0x0028, Element: 0x0102}, Name: "HighBit", Type: Type1},
+		{Tag: tag.Tag{Group: 0x0028, Element: 0x0103}, Name: "PixelRepresentation", Type: Type1, Terms: []Terms{enumerated("0", "1")}},
+		{
+			Tag: tag.Tag{Group: 0x7FE0, Element: 0x0010}, Name: "PixelData", Type: Type1C,
+			Condition: IfAbsent(tag.Tag{Group: 0x0028, Element: 0x7FE0}),
+		},
+		{
+			Tag: tag.Tag{Group: 0x0028, Element: 0x0006}, Name: "PlanarConfiguration", Type: Type1C,
+			Condition: IfAll(IfPresent(samplesPerPixel), IfNot(IfValue(samplesPerPixel, "1"))),
+			Terms:     []Terms{enumerated("0", "1")},
+		},
+		{Tag: tag.Tag{Group: 0x0028, Element: 0x0034}, Name: "PixelAspectRatio", Type: Type1C},
+		{Tag: tag.Tag{Group: 0x0028, Element: 0x1101}, Name: "RedPaletteColorLookupTableDescriptor", Type: Type1C, Condition: isPaletteColor},
+		{Tag: tag.Tag{Group: 0x0028, Element: 0x1102}, Name: "GreenPaletteColorLookupTableDescriptor", Type: Type1C, Condition: isPaletteColor},
+		{Tag: tag.Tag{Group: 0x0028, Element: 0x1103}, Name: "BluePaletteColorLookupTableDescriptor", Type: Type1C, Condition: isPaletteColor},
+		{Tag: tag.Tag{Group: 0x0028, Element: 0x1201}, Name: "RedPaletteColorLookupTableData", Type: Type1C, Condition: isPaletteColor},
+		{Tag: tag.Tag{Group: 0x0028, Element: 0x1202}, Name: "GreenPaletteColorLookupTableData", Type: Type1C, Condition: isPaletteColor},
+		{Tag: tag.Tag{Group: 0x0028, Element: 0x1203}, Name: "BluePaletteColorLookupTableData", Type: Type1C, Condition: isPaletteColor},
+	},
+}
+
+// PS3.3 C.7.6.4. The module is required if contrast media was used, which
+// cannot be told from the Dataset.
+var contrastBolusModule = &Module{
+	Name: "Contrast/Bolus",
+	Attributes: []Attribute{
+		{Tag: tag.Tag{Group: 0x0018, Element: 0x0010}, Name: "ContrastBolusAgent", Type: Type2},
+		{Tag: tag.Tag{Group: 0x0018, Element: 0x1040}, Name: "ContrastBolusRoute", Type: Type3},
+		{Tag: tag.Tag{Group: 0x0018, Element: 0x1041}, Name: "ContrastBolusVolume", Type: Type3},
+	},
+}
+
+// PS3.3 C.8.2.1
+var ctImageModule = &Module{
+	Name: "CT Image",
+	Attributes: []Attribute{
+		{Tag: tag.Tag{Group: 0x0008, Element: 0x0008}, Name: "ImageType", Type: Type1, Terms: append([]Terms{
+			{Value: 3, Values: []string{"AXIAL", "LOCALIZER"}, Defined: true},
+		}, imageTypeTerms...)},
+		{Tag: samplesPerPixel, Name: "SamplesPerPixel", Type: Type1, Terms: []Terms{enumerated("1")}},
+		{Tag: photometricInterpretation, Name: "PhotometricInterpretation", Type: Type1, Terms: []Terms{enumerated("MONOCHROME1", "MONOCHROME2")}},
+		{Tag: tag.Tag{Group: 0x0028, Element: 0x0100}, Name: "BitsAllocated", Type: Type1, Terms: []Terms{enumerated("16")}},
+		{Tag: tag.Tag{Group: 0x0028, Element: 0x0101}, Name: "BitsStored", Type: Type1},
+		{Tag: tag.Tag{Group: 0x0028, Element: 0x0102}, Name: "HighBit", Type: Type1},
+		{Tag: tag.Tag{Group: 0x0028, Element: 0x1052}, Name: "RescaleIntercept", Type: Type1},
+		{Tag: tag.Tag{Group: 0x0028, Element: 0x1053}, Name: "RescaleSlope", Type: Type1},
+		{Tag: tag.Tag{Group: 0x0028, Element: 0x1054}, Name: "RescaleType", Type: Type1C},
+		{Tag: tag.Tag{Group: 0x0018, Element: 0x0060}, Name: "KVP", Type: Type2},
+		{Tag: tag.Tag{Group: 0x0020, Element: 0x0012}, Name: "AcquisitionNumber", Type: Type2},
+		{Tag: tag.Tag{Group: 0x0018, Element: 0x1100}, Name: "ReconstructionDiameter", Type: Type3},
+		{Tag: tag.Tag{Group: 0x0018, Element: 0x1210}, Name: "ConvolutionKernel", Type: Type3},
+	},
+}
+
+var scanningSequence = tag.Tag{Group: 0x0018, Element: 0x0020}
+
+// PS3.3 C.8.3.1
+var mrImageModule = &Module{
+	Name: "MR Image",
+	Attributes: []Attribute{
+		{Tag: tag.Tag{Group: 0x0008, Element: 0x0008}, Name: "ImageType", Type: Type1, Terms: imageTypeTerms},
+		{Tag: samplesPerPixel, Name: "SamplesPerPixel", Type: Type1, Terms: []Terms{enumerated("1")}},
+		{Tag: photometricInterpretation, Name: "PhotometricInterpretation", Type: Type1, Terms: []Terms{enumerated("MONOCHROME1", "MONOCHROME2")}},
+		{Tag: tag.Tag{Group: 0x0028, Element: 0x0100}, Name: "BitsAllocated", Type: Type1, Terms: []Terms{enumerated("16")}},
+		{Tag: scanningSequence, Name: "ScanningSequence", Type: Type1, Terms: []Terms{enumerated("SE", "IR", "GR", "EP", "RM")}},
+		{
+			Tag: tag.Tag{Group: 0x0018, Element: 0x0021}, Name: "SequenceVariant", Type: Type1,
+			Terms: []Terms{enumerated("SK", "MTC", "SS", "TRSS", "SP", "MP", "OSP", "NONE")},
+		},
+		{
+			Tag: tag.Tag{Group: 0x0018, Element: 0x0022}, Name: "ScanOptions", Type: Type2,
+			Terms: []Terms{defined("PER", "RG", "CG", "PPG", "FC", "PFF", "PFP", "SP", "FS")},
+		},
+		{Tag: tag.Tag{Group: 0x0018, Element: 0x0023}, Name: "MRAcquisitionType", Type: Type2, Terms: []Terms{enumerated("2D", "3D")}},
+		{Tag: tag.Tag{Group: 0x0018, Element: 0x0080}, Name: "RepetitionTime", Type: Type2C},
+		{Tag: tag.Tag{Group: 0x0018, Element: 0x0081}, Name: "EchoTime", Type: Type2},
+		{Tag: tag.Tag{Group: 0x0018, Element: 0x0091}, Name: "EchoTrainLength", Type: Type2},
+		{Tag: tag.Tag{Group: 0x0018, Element: 0x0082}, Name: "InversionTime", Type: Type2C, Condition: IfValue(scanningSequence, "IR")},
+		{Tag: tag.Tag{Group: 0x0018, Element: 0x1060}, Name: "TriggerTime", Type: Type2C},
+		{Tag: tag.Tag{Group: 0x0018, Element: 0x0024}, Name: "SequenceName", Type: Type3},
+		{Tag: tag.Tag{Group: 0x0018, Element: 0x0025}, Name: "AngioFlag", Type: Type3, Terms: []Terms{enumerated("Y", "N")}},
+		{Tag: tag.Tag{Group: 0x0018, Element: 0x0087}, Name: "MagneticFieldStrength", Type: Type3},
+	},
+}
+
+// PS3.3 C.8.6.1
+var scEquipmentModule = &Module{
+	Name: "SC Equipment",
+	Attributes: []Attribute{
+		{
+			Tag: tag.Tag{Group: 0x0008, Element: 0x0064}, Name: "ConversionType", Type: Type1,
+			Terms: []Terms{defined("DV", "DI", "DF", "WSD", "SD", "SI", "DRW", "SYN")},
+		},
+		{Tag: tag.Tag{Group: 0x0008, Element: 0x0060}, Name: "Modality", Type: Type3},
+		{Tag: tag.Tag{Group: 0x0018, Element: 0x1010}, Name: "SecondaryCaptureDeviceID", Type: Type3},
+		{Tag: tag.Tag{Group: 0x0018, Element: 0x1016}, Name: "SecondaryCaptureDeviceManufacturer", Type: Type3},
+		{Tag: tag.Tag{Group: 0x0018, Element: 0x1018}, Name: "SecondaryCaptureDeviceManufacturerModelName", Type: Type3},
+	},
+}
+
+// PS3.3 C.8.6.2
+var scImageModule = &Module{
+	Name: "SC Image",
+	Attributes: []Attribute{
+		{Tag: tag.Tag{Group: 0x0018, Element: 0x1012}, Name: "DateOfSecondaryCapture", Type: Type3},
+		{Tag: tag.Tag{Group: 0x0018, Element: 0x1014}, Name: "TimeOfSecondaryCapture", Type: Type3},
+	},
+}
+
+var windowCenter = tag.Tag{Group: 0x0028, Element: 0x1050}
+
+// PS3.3 C.11.2
+var voiLUTModule = &Module{
+	Name: "VOI LUT",
+	Attributes: []Attribute{
+		{
+			Tag: tag.Tag{Group: 0x0028, Element: 0x3010}, Name: "VOILUTSequence", Type: Type1C,
+			Items: []Attribute{
+				{Tag: tag.Tag{Group: 0x0028, Element: 0x3002}, Name: "LUTDescriptor", Type: Type1},
+				{Tag: tag.Tag{Group: 0x0028, Element: 0x3006}, Name: "LUTData", Type: Type1},
+			},
+		},
+		{Tag: windowCenter, Name: "WindowCenter", Type: Type1C, Condition: IfAbsent(tag.Tag{Group: 0x0028, Element: 0x3010})},
+		{Tag: tag.Tag{Group: 0x0028, Element: 0x1051}, Name: "WindowWidth", Type: Type1C, Condition: IfPresent(windowCenter)},
+		{Tag: tag.Tag{Group: 0x0028, Element: 0x1056}, Name: "VOILUTFunction", Type: Type3, Terms: []Terms{defined("LINEAR", "LINEAR_EXACT", "SIGMOID")}},
+	},
+}
+
+// PS3.3 C.12.1
+var sopCommonModule = &Module{
+	Name: "SOP Common",
+	Attributes: []Attribute{
+		{Tag: tag.Tag{Group: 0x0008, Element: 0x0016}, Name: "SOPClassUID", Type: Type1},
+		{Tag: tag.Tag{Group: 0x0008, Element: 0x0018}, Name: "SOPInstanceUID", Type: Type1},
+		{Tag: tag.Tag{Group: 0x0008, Element: 0x0005}, Name: "SpecificCharacterSet", Type: Type1C},
+		{Tag: tag.Tag{Group: 0x0008, Element: 0x0012}, Name: "InstanceCreationDate", Type: Type3},
+		{Tag: tag.Tag{Group: 0x0008, Element: 0x0013}, Name: "InstanceCreationTime", Type: Type3},
+		{Tag: tag.Tag{Group: 0x0008, Element: 0x0014}, Name: "InstanceCreatorUID", Type: Type3},
+	},
+}
+
+// PS3.3 C.8.8.1
+var rtSeriesModule = &Module{
+	Name: "RT Series",
+	Attributes: []Attribute{
+		{
+			Tag: tag.Tag{Group: 0x0008, Element: 0x0060}, Name: "Modality", Type: Type1,
+			Terms: []Terms{enumerated("RTIMAGE", "RTDOSE", "RTSTRUCT", "RTPLAN", "RTRECORD")},
+		},
+		{Tag: tag.Tag{Group: 0x0020, Element: 0x000E}, Name: "SeriesInstanceUID", Type: Type1},
+		{Tag: tag.Tag{Group: 0x0020, Element: 0x0011}, Name: "SeriesNumber", Type: Type2},
+		{Tag: tag.Tag{Group: 0x0008, Element: 0x1070}, Name: "OperatorsName", Type: Type2},
+		{Tag: tag.Tag{Group: 0x0008, Element: 0x103E}, Name: "SeriesDescription", Type: Type3},
+	},
+}
+
+var referencedSOPAttributes = []Attribute{
+	{Tag: tag.Tag{Group: 0x0008, Element: 0x1150}, Name: "ReferencedSOPClassUID", Type: Type1},
+	{Tag: tag.Tag{Group: 0x0008, Element: 0x1155}, Name: "ReferencedSOPInstanceUID", Type: Type1},
+}
+
+// PS3.3 C.8.8.5
+var structureSetModule = &Module{
+	Name: "Structure Set",
+	Attributes: []Attribute{
+		{Tag: tag.Tag{Group: 0x3006, Element: 0x0002}, Name: "StructureSetLabel", Type: Type1},
+		{Tag: tag.Tag{Group: 0x3006, Element: 0x0004}, Name: "StructureSetName", Type: Type3},
+		{Tag: tag.Tag{Group: 0x3006, Element: 0x0008}, Name: "StructureSetDate", Type: Type2},
+		{Tag: tag.Tag{Group: 0x3006, Element: 0x0009}, Name: "StructureSetTime", Type: Type2},
+		{
+			Tag: tag.Tag{Group: 0x3006, Element: 0x0010}, Name: "ReferencedFrameOfReferenceSequence", Type: Type3,
+			Items: []Attribute{
+				{Tag: tag.Tag{Group: 0x0020, Element: 0x0052}, Name: "FrameOfReferenceUID", Type: Type1},
+				{
+					Tag: tag.Tag{Group: 0x3006, Element: 0x0012}, Name: "RTReferencedStudySequence", Type: Type3,
+					Items: append([]Attribute{
+						{
+							Tag: tag.Tag{Group: 0x3006, Element: 0x0014}, Name: "RTReferencedSeriesSequence", Type: Type1,
+							Items: []Attribute{
+								{Tag: tag.Tag{Group: 0x0020, Element: 0x000E}, Name: "SeriesInstanceUID", Type: Type1},
+								{Tag: tag.Tag{Group: 0x3006, Element: 0x0016}, Name: "ContourImageSequence", Type: Type1, Items: referencedSOPAttributes},
+							},
+						},
+					}, referencedSOPAttributes...),
+				},
+			},
+		},
+		{
+			Tag: tag.Tag{Group: 0x3006, Element: 0x0020}, Name: "StructureSetROISequence", Type: Type1,
+			Items: []Attribute{
+				{Tag: tag.Tag{Group: 0x3006, Element: 0x0022}, Name: "ROINumber", Type: Type1},
+				{Tag: tag.Tag{Group: 0x3006, Element: 0x0024}, Name: "ReferencedFrameOfReferenceUID", Type: Type1},
+				{Tag: tag.Tag{Group: 0x3006, Element: 0x0026}, Name: "ROIName", Type: Type2},
+				{
+					Tag: tag.Tag{Group: 0x3006, Element: 0x0036}, Name: "ROIGenerationAlgorithm", Type: Type2,
+					Terms: []Terms{defined("AUTOMATIC", "SEMIAUTOMATIC", "MANUAL")},
+				},
+			},
+		},
+	},
+}
+
+// PS3.3 C.8.8.6
+var roiContourModule = &Module{
+	Name: "ROI Contour",
+	Attributes: []Attribute{
+		{
+			Tag: tag.Tag{Group: 0x3006, Element: 0x0039}, Name: "ROIContourSequence", Type: Type1,
+			Items: []Attribute{
+				{Tag: tag.Tag{Group: 0x3006, Element: 0x0084}, Name: "ReferencedROINumber", Type: Type1},
+				{Tag: tag.Tag{Group: 0x3006, Element: 0x002A}, Name: "ROIDisplayColor", Type: Type3},
+				{
+					Tag: tag.Tag{Group: 0x3006, Element: 0x0040}, Name: "ContourSequence", Type: Type3,
+					Items: []Attribute{
+						{Tag: tag.Tag{Group: 0x3006, Element: 0x0016}, Name: "ContourImageSequence", Type: Type3, Items: referencedSOPAttributes},
+						{
+							Tag: tag.Tag{Group: 0x3006, Element: 0x0042}, Name: "ContourGeometricType", Type: Type1,
+							Terms: []Terms{enumerated("POINT", "OPEN_PLANAR", "OPEN_NONPLANAR", "CLOSED_PLANAR")},
+						},
+						{Tag: tag.Tag{Group: 0x3006, Element: 0x0046}, Name: "NumberOfContourPoints", Type: Type1},
+						{Tag: tag.Tag{Group: 0x3006, Element: 0x0050}, Name: "ContourData", Type: Type1},
+						{Tag: tag.Tag{Group: 0x3006, Element: 0x0048}, Name: "ContourNumber", Type: Type3},
+					},
+				},
+			},
+		},
+	},
+}
+
+// PS3.3 C.8.8.8
+var rtROIObservationsModule = &Module{
+	Name: "RT ROI Observations",
+	Attributes: []Attribute{
+		{
+			Tag: tag.Tag{Group: 0x3006, Element: 0x0080}, Name: "RTROIObservationsSequence", Type: Type1,
+			Items: []Attribute{
+				{Tag: tag.Tag{Group: 0x3006, Element: 0x0082}, Name: "ObservationNumber", Type: Type1},
+				{Tag: tag.Tag{Group: 0x3006, Element: 0x0084}, Name: "ReferencedROINumber", Type: Type1},
+				{
+					Tag: tag.Tag{Group: 0x3006, Element: 0x00A4}, Name: "RTROIInterpretedType", Type: Type2,
+					Terms: []Terms{defined("EXTERNAL", "PTV", "CTV", "GTV", "TREATED_VOLUME", "IRRAD_VOLUME",
+						"BOLUS", "AVOIDANCE", "ORGAN", "MARKER", "REGISTRATION", "ISOCENTER", "CONTRAST_AGENT",
+						"CAVITY", "BRACHY_CHANNEL", "BRACHY_ACCESSORY", "BRACHY_SRC_APP", "BRACHY_CHNL_SHLD",
+						"SUPPORT", "FIXATION", "DOSE_REGION", "CONTROL")},
+				},
+				{Tag: tag.Tag{Group: 0x3006, Element: 0x00A6}, Name: "ROIInterpreter", Type: Type2},
+				{Tag: tag.Tag{Group: 0x3006, Element: 0x0085}, Name: "ROIObservationLabel", Type: Type3},
+			},
+		},
+	},
+}
+
+var (
+	approvalStatus = tag.Tag{Group: 0x300E, Element: 0x0002}
+	isReviewed     = IfValue(approvalStatus, "APPROVED", "REJECTED")
+)
+
+// PS3.3 C.8.8.16
+var approvalModule = &Module{
+	Name: "Approval",
+	Attributes: []Attribute{
+		{Tag: approvalStatus, Name: "ApprovalStatus", Type: Type1, Terms: []Terms{enumerated("APPROVED", "UNAPPROVED", "REJECTED")}},
+		{Tag: tag.Tag{Group: 0x300E, Element: 0x0004}, Name: "ReviewDate", Type: Type2C, Condition: isReviewed},
+		{Tag: tag.Tag{Group: 0x300E, Element: 0x0005}, Name: "ReviewTime", Type: Type2C, Condition: isReviewed},
+		{Tag: tag.Tag{Group: 0x300E, Element: 0x0008}, Name: "ReviewerName", Type: Type2C, Condition: isReviewed},
+	},
+}
+
+var codeValue = tag.Tag{Group: 0x0008, Element: 0x0100}
+
+// codeSequenceMacro are the attributes of the items of code sequences, PS3.3
+// Table 8.8-1.
+var codeSequenceMacro = []Attribute{
+	{
+		Tag: codeValue, Name: "CodeValue", Type: Type1C,
+		Condition: IfAll(IfAbsent(tag.Tag{Group: 0x0008, Element: 0x0119}), IfAbsent(tag.Tag{Group: 0x0008, Element: 0x0120})),
+	},
+	{Tag: tag.Tag{Group: 0x0008, Element: 0x0102}, Name: "CodingSchemeDesignator", Type: Type1C, Condition: IfPresent(codeValue)},
+	{Tag: tag.Tag{Group: 0x0008, Element: 0x0103}, Name: "CodingSchemeVersion", Type: Type1C},
+	{Tag: tag.Tag{Group: 0x0008, Element: 0x0104}, Name: "CodeMeaning", Type: Type1},
+	{Tag: tag.Tag{Group: 0x0008, Element: 0x0119}, Name: "LongCodeValue", Type: Type1C},
+	{Tag: tag.Tag{Group: 0x0008, Element: 0x0120}, Name: "URNCodeValue", Type: Type1C},
+}
+
+// PS3.3 C.17.1
+var srDocumentSeriesModule = &Module{
+	Name: "SR Document Series",
+	Attributes: []Attribute{
+		{Tag: tag.Tag{Group: 0x0008, Element: 0x0060}, Name: "Modality", Type: Type1, Terms: []Terms{enumerated("SR")}},
+		{Tag: tag.Tag{Group: 0x0020, Element: 0x000E}, Name: "SeriesInstanceUID", Type: Type1},
+		{Tag: tag.Tag{Group: 0x0020, Element: 0x0011}, Name: "SeriesNumber", Type: Type1},
+		{
+			Tag: tag.Tag{Group: 0x0008, Element: 0x1111}, Name: "ReferencedPerformedProcedureStepSequence", Type: Type2,
+			Items: referencedSOPAttributes, MaxItems: 1,
+		},
+	},
+}
+
+var verificationFlag = tag.Tag{Group: 0x0040, Element: 0xA493}
+
+// PS3.3 C.17.2
+var srDocumentGeneralModule = &Module{
+	Name: "SR Document General",
+	Attributes: []Attribute{
+		{Tag: tag.Tag{Group: 0x0020, Element: 0x0013}, Name: "InstanceNumber", Type: Type1},
+		{Tag: tag.Tag{Group: 0x0040, Element: 0xA491}, Name: "CompletionFlag", Type: Type1, Terms: []Terms{enumerated("PARTIAL", "COMPLETE")}},
+		{Tag: verificationFlag, Name: "VerificationFlag", Type: Type1, Terms: []Terms{enumerated("UNVERIFIED", "VERIFIED")}},
+		{Tag: tag.Tag{Group: 0x0008, Element: 0x0023}, Name: "ContentDate", Type: Type1},
+		{Tag: tag.Tag{Group: 0x0008, Element: 0x0033}, Name: "ContentTime", Type: Type1},
+		{
+			Tag: tag.Tag{Group: 0x0040, Element: 0xA073}, Name: "VerifyingObserverSequence", Type: Type1C,
+			Condition: IfValue(verificationFlag, "VERIFIED"),
+			Items: []Attribute{
+				{Tag: tag.Tag{Group: 0x0040, Element: 0xA075}, Name: "VerifyingObserverName", Type: Type1},
+				{
+					Tag: tag.Tag{Group: 0x0040, Element: 0xA088}, Name: "VerifyingObserverIdentificationCodeSequence", Type: Type2,
+					Items: codeSequenceMacro, MaxItems: 1,
+				},
+				{Tag: tag.Tag{Group: 0x0040, Element: 0xA027}, Name: "VerifyingOrganization", Type: Type1},
+				{Tag: tag.Tag{Group: 0x0040, Element: 0xA030}, Name: "VerificationDateTime", Type: Type1},
+			},
+		},
+		{Tag: tag.Tag{Group: 0x0040, Element: 0xA372}, Name: "PerformedProcedureCodeSequence", Type: Type2, Items: codeSequenceMacro},
+		{Tag: tag.Tag{Group: 0x0040, Element: 0xA375}, Name: "CurrentRequestedProcedureEvidenceSequence", Type: Type1C, Items: hierarchicalSOPInstanceReference},
+		{Tag: tag.Tag{Group: 0x0040, Element: 0xA385}, Name: "PertinentOtherEvidenceSequence", Type: Type1C, Items: hierarchicalSOPInstanceReference},
+		{Tag: tag.Tag{Group: 0x0040, Element: 0xA496}, Name: "PreliminaryFlag", Type: Type3, Terms: []Terms{enumerated("PRELIMINARY", "FINAL")}},
+	},
+}
+
+// hierarchicalSOPInstanceReference are the attributes of the items of
+// evidence sequences, PS3.3 Table C.17-3.
+var hierarchicalSOPInstanceReference = []Attribute{
+	{Tag: tag.Tag{Group: 0x0020, Element: 0x000D}, Name: "StudyInstanceUID", Type: Type1},
+	{
+		Tag: tag.Tag{Group: 0x0008, Element: 0x1115}, Name: "ReferencedSeriesSequence", Type: Type1,
+		Items: []Attribute{
+			{Tag: tag.Tag{Group: 0x0020, Element: 0x000E}, Name: "SeriesInstanceUID", Type: Type1},
+			{Tag: tag.Tag{Group: 0x0008, Element: 0x1199}, Name: "ReferencedSOPSequence", Type: Type1, Items: referencedSOPAttributes},
+		},
+	},
+}
+
+var (
+	valueType       = tag.Tag{Group: 0x0040, Element: 0xA040}
+	contentSequence = tag.Tag{Group: 0x0040, Element: 0xA730}
+)
+
+// PS3.3 C.17.3
+var srDocumentContentModule = &Module{
+	Name: "SR Document Content",
+	Attributes: append([]Attribute{
+		{Tag: valueType, Name: "ValueType", Type: Type1, Terms: []Terms{enumerated("CONTAINER")}},
+		{Tag: tag.Tag{Group: 0x0040, Element: 0xA043}, Name: "ConceptNameCodeSequence", Type: Type1, Items: codeSequenceMacro, MaxItems: 1},
+	}, srContainerAttributes...),
+}
+
+// srContainerAttributes are the attributes of CONTAINER content items,
+// including the root of the content tree. Their ContentSequence items are
+// set to srContentItem by init.
+var srContainerAttributes = []Attribute{
+	{
+		Tag: tag.Tag{Group: 0x0040, Element: 0xA050}, Name: "ContinuityOfContent", Type: Type1C,
+		Condition: IfValue(valueType, "CONTAINER"), Terms: []Terms{enumerated("SEPARATE", "CONTINUOUS")},
+	},
+	{
+		Tag: tag.Tag{Group: 0x0040, Element: 0xA504}, Name: "ContentTemplateSequence", Type: Type1C, MaxItems: 1,
+		Items: []Attribute{
+			{Tag: tag.Tag{Group: 0x0008, Element: 0x0105}, Name: "MappingResource", Type: Type1},
+			{Tag: tag.Tag{Group: 0x0040, Element: 0xDB00}, Name: "TemplateIdentifier", Type: Type1},
+		},
+	},
+	{Tag: contentSequence, Name: "ContentSequence", Type: Type1C},
+}
+
+// srContentItem are the attributes of the items of ContentSequence, PS3.3
+// C.17.3 with the value types allowed in Enhanced SR.
+var srContentItem = append([]Attribute{
+	{
+		Tag: tag.Tag{Group: 0x0040, Element: 0xA010}, Name: "RelationshipType", Type: Type1,
+		Terms: []Terms{enumerated("CONTAINS", "HAS PROPERTIES", "HAS OBS CONTEXT", "HAS ACQ CONTEXT",
+			"INFERRED FROM", "SELECTED FROM", "HAS CONCEPT MOD")},
+	},
+	{
+		Tag: valueType, Name: "ValueType", Type: Type1C,
+		Condition: IfAbsent(tag.Tag{Group: 0x0040, Element: 0xDB73}),
+		Terms: []Terms{enumerated("TEXT", "NUM", "CODE", "DATETIME", "DATE", "TIME", "UIDREF", "PNAME",
+			"SCOORD", "TCOORD", "COMPOSITE", "IMAGE", "WAVEFORM", "CONTAINER")},
+	},
+	{
+		Tag: tag.Tag{Group: 0x0040, Element: 0xA043}, Name: "ConceptNameCodeSequence", Type: Type1C,
+		Condition: IfValue(valueType, "TEXT", "NUM", "CODE", "DATETIME", "DATE", "TIME", "UIDREF", "PNAME"),
+		Items:     codeSequenceMacro, MaxItems: 1,
+	},
+	{Tag: tag.Tag{Group: 0x0040, Element: 0xA160}, Name: "TextValue", Type: Type1C, Condition: IfValue(valueType, "TEXT")},
+	{Tag: tag.Tag{Group: 0x0040, Element: 0xA120}, Name: "DateTime", Type: Type1C, Condition: IfValue(valueType, "DATETIME")},
+	{Tag: tag.Tag{Group: 0x0040, Element: 0xA121}, Name: "Date", Type: Type1C, Condition: IfValue(valueType, "DATE")},
+	{Tag: tag.Tag{Group: 0x0040, Element: 0xA122}, Name: "Time", Type: Type1C, Condition: IfValue(valueType, "TIME")},
+	{Tag: tag.Tag{Group: 0x0040, Element: 0xA123}, Name: "PersonName", Type: Type1C, Condition: IfValue(valueType, "PNAME")},
+	{Tag: tag.Tag{Group: 0x0040, Element: 0xA124}, Name: "UID", Type: Type1C, Condition: IfValue(valueType, "UIDREF")},
+	{
+		Tag: tag.Tag{Group: 0x0040, Element: 0xA168}, Name: "ConceptCodeSequence", Type: Type1C,
+		Condition: IfValue(valueType, "CODE"), Items: codeSequenceMacro, MaxItems: 1,
+	},
+	{
+		Tag: tag.Tag{Group: 0x0040, Element: 0xA300}, Name: "MeasuredValueSequence", Type: Type2C,
+		Condition: IfValue(valueType, "NUM"), MaxItems: 1,
+		Items: []Attribute{
+			{Tag: tag.Tag{Group: 0x0040, Element: 0xA30A}, Name: "NumericValue", Type: Type1},
+			{Tag: tag.Tag{Group: 0x0040, Element: 0x08EA}, Name: "MeasurementUnitsCodeSequence", Type: Type1, Items: codeSequenceMacro, MaxItems: 1},
+		},
+	},
+	{
+		Tag: tag.Tag{Group: 0x0008, Element: 0x1199}, Name: "ReferencedSOPSequence", Type: Type1C,
+		Condition: IfValue(valueType, "COMPOSITE", "IMAGE", "WAVEFORM"), Items: referencedSOPAttributes, MaxItems: 1,
+	},
+	{Tag: tag.Tag{Group: 0x0070, Element: 0x0022}, Name: "GraphicData", Type: Type1C, Condition: IfValue(valueType, "SCOORD")},
+	{
+		Tag: tag.Tag{Group: 0x0070, Element: 0x0023}, Name: "GraphicType", Type: Type1C,
+		Condition: IfValue(valueType, "SCOORD"), Terms: []Terms{enumerated("POINT", "MULTIPOINT", "POLYLINE", "CIRCLE", "ELLIPSE")},
+	},
+	{
+		Tag: tag.Tag{Group: 0x0040, Element: 0xA130}, Name: "TemporalRangeType", Type: Type1C,
+		Condition: IfValue(valueType, "TCOORD"), Terms: []Terms{enumerated("POINT", "MULTIPOINT", "SEGMENT", "MULTISEGMENT", "BEGIN", "END")},
+	},
+}, srContainerAttributes...)
+
+func init() {
+	// Content items nest to any depth, which cannot be expressed in the
+	// initializers of srContentItem.
+	for _, attrs := range [][]Attribute{srDocumentContentModule.Attributes, srContentItem} {
+		for i := range attrs {
+			if attrs[i].Tag == contentSequence {
+				attrs[i].Items = srContentItem
+			}
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package dicom
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ginuerzh/dicom/pkg/tag"
+	"github.com/ginuerzh/dicom/pkg/uid"
+)
+
+func TestValidate(t *testing.T) {
+	cases := []struct {
+		name   string
+		modify func(ds *Dataset)
+		want   []IODViolation
+		valid  bool
+	}{
+		{
+			name:  "unmodified",
+			valid: true,
+		},
+		{
+			name: "missing Type 1",
+			modify: func(ds *Dataset) {
+				ds.Delete(tag.Tag{Group: 0x0020, Element: 0x000E})
+			},
+			want: []IODViolation{{Path: "(0020,000e)", Name: "SeriesInstanceUID", Module: "General Series", Type: Type1, Kind: ViolationMissing, Severity: SeverityError}},
+		},
+		{
+			name: "missing Type 2",
+			modify: func(ds *Dataset) {
+				ds.Delete(tag.Tag{Group: 0x0010, Element: 0x0040})
+			},
+			want: []IODViolation{{Path: "(0010,0040)", Name: "PatientSex", Module: "Patient", Type: Type2, Kind: ViolationMissing, Severity: SeverityError}},
+		},
+		{
+			name: "empty Type 2",
+			modify: func(ds *Dataset) {
+				ds.Set(mustNewElement(tag.Tag{Group: 0x0010, Element: 0x0040}, []string{""}))
+			},
+			valid: true,
+		},
+		{
+			name: "empty Type 1",
+			modify: func(ds *Dataset) {
+				ds.Set(mustNewElement(tag.Tag{Group: 0x0020, Element: 0x000D}, []string{""}))
+			},
+			want: []IODViolation{{Path: "(0020,000d)", Name: "StudyInstanceUID", Module: "General Study", Type: Type1, Kind: ViolationEmpty, Severity: SeverityError}},
+		},
+		{
+			name: "not an enumerated value",
+			modify: func(ds *Dataset) {
+				ds.Set(mustNewElement(tag.Tag{Group: 0x0018, Element: 0x0020}, []string{"SE", "XX"}))
+			},
+			want: []IODViolation{{Path: "(0018,0020)", Name: "ScanningSequence", Module: "MR Image", Type: Type1, Kind: ViolationInvalid, Severity: SeverityError}},
+		},
+		{
+			name: "not a defined term",
+			modify: func(ds *Dataset) {
+				ds.Set(mustNewElement(tag.Tag{Group: 0x0018, Element: 0x0022}, []string{"XX"}))
+			},
+			want:  []IODViolation{{Path: "(0018,0022)", Name: "ScanOptions", Module: "MR Image", Type: Type2, Kind: ViolationInvalid, Severity: SeverityWarning}},
+			valid: true,
+		},
+		{
+			name: "condition holds",
+			modify: func(ds *Dataset) {
+				ds.Set(mustNewElement(tag.Tag{Group: 0x0018, Element: 0x0020}, []string{"IR"}))
+				ds.Delete(tag.Tag{Group: 0x0018, Element: 0x0082})
+			},
+			want: []IODViolation{{Path: "(0018,0082)", Name: "InversionTime", Module: "MR Image", Type: Type2C, Kind: ViolationMissing, Severity: SeverityError}},
+		},
+		{
+			name: "condition does not hold",
+			modify: func(ds *Dataset) {
+				ds.Set(mustNewElement(tag.Tag{Group: 0x0018, Element: 0x0020}, []string{"SE"}))
+				ds.Delete(tag.Tag{Group: 0x0018, Element: 0x0082})
+			},
+			valid: true,
+		},
+		{
+			name: "invalid value",
+			modify: func(ds *Dataset) {
+				ds.Set(mustNewElement(tag.Tag{Group: 0x0008, Element: 0x0018}, []string{"1.2.x"}))
+			},
+			want: []IODViolation{{Path: "(0008,0018)", Name: "SOPInstanceUID", Kind: ViolationInvalid, Severity: SeverityError}},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ds, err := ParseFile("./testfiles/3.dcm", nil)
+			if err != nil {
+				t.Fatalf("ParseFile: %v", err)
+			}
+			if tc.modify != nil {
+				tc.modify(&ds)
+			}
+			report, err := Validate(ds)
+			if err != nil {
+				t.Fatalf("Validate: %v", err)
+			}
+			if report.IOD != "MR Image" || report.SOPClassUID != uid.MRImageStorage {
+				t.Errorf("Validate: got IOD %q (%s), want MR Image", report.IOD, report.SOPClassUID)
+			}
+			if report.Valid() != tc.valid {
+				t.Errorf("Validate: got Valid() %v, want %v:\n%s", report.Valid(), tc.valid, report)
+			}
+			if len(report.Violations) != len(tc.want) {
+				t.Fatalf("Validate: got violations\n%s, want %d", report, len(tc.want))
+			}
+			for i, want := range tc.want {
+				got := report.Violations[i]
+				got.Tag, got.Message = tag.Tag{}, ""
+				if got != want {
+					t.Errorf("Validate: got violation %+v, want %+v", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestValidate_NestedSequences(t *testing.T) {
+	code := func(value, meaning string) [][]*Element {
+		return [][]*Element{{
+			mustNewElementWithVR(tag.Tag{Group: 0x0008, Element: 0x0100}, "SH", []string{value}),
+			mustNewElementWithVR(tag.Tag{Group: 0x0008, Element: 0x0102}, "SH", []string{"DCM"}),
+			mustNewElementWithVR(tag.Tag{Group: 0x0008, Element: 0x0104}, "LO", []string{meaning}),
+		}}
+	}
+	ds := Dataset{Elements: []*Element{
+		mustNewElementWithVR(tag.Tag{Group: 0x0008, Element: 0x0016}, "UI", []string{uid.EnhancedSRStorage}),
+		mustNewElementWithVR(tag.Tag{Group: 0x0040, Element: 0xA040}, "CS", []string{"CONTAINER"}),
+		makeSequenceElement(tag.Tag{Group: 0x0040, Element: 0xA043}, code("126000", "Imaging Measurement Report")),
+		mustNewElementWithVR(tag.Tag{Group: 0x0040, Element: 0xA050}, "CS", []string{"SEPARATE"}),
+		makeSequenceElement(tag.Tag{Group: 0x0040, Element: 0xA730}, [][]*Element{
+			{
+				mustNewElementWithVR(tag.Tag{Group: 0x0040, Element: 0xA010}, "CS", []string{"CONTAINS"}),
+				mustNewElementWithVR(tag.Tag{Group: 0x0040, Element: 0xA040}, "CS", []string{"TEXT"}),
+				makeSequenceElement(tag.Tag{Group: 0x0040, Element: 0xA043}, code("121071", "Finding")),
+				mustNewElementWithVR(tag.Tag{Group: 0x0040, Element: 0xA160}, "UT", []string{"normal"}),
+			},
+			{
+				mustNewElementWithVR(tag.Tag{Group: 0x0040, Element: 0xA010}, "CS", []string{"CONTAINS"}),
+				mustNewElementWithVR(tag.Tag{Group: 0x0040, Element: 0xA040}, "CS", []string{"CONTAINER"}),
+				mustNewElementWithVR(tag.Tag{Group: 0x0040, Element: 0xA050}, "CS", []string{"SEPARATE"}),
+				makeSequenceElement(tag.Tag{Group: 0x0040, Element: 0xA730}, [][]*Element{{
+					mustNewElementWithVR(tag.Tag{Group: 0x0040, Element: 0xA010}, "CS", []string{"CONTAINS"}),
+					mustNewElementWithVR(tag.Tag{Group: 0x0040, Element: 0xA040}, "CS", []string{"CODE"}),
+					makeSequenceElement(tag.Tag{Group: 0x0040, Element: 0xA043}, code("121071", "Finding")),
+				}}),
+			},
+		}),
+	}}
+
+	report, err := Validate(ds)
+	if err != nil {
+		t.Fatalf("Validate: %v", err)
+	}
+	var got []IODViolation
+	for _, v := range report.Violations {
+		if v.Module == "SR Document Content" {
+			got = append(got, v)
+		}
+	}
+	if len(got) != 1 {
+		t.Fatalf("Validate: got SR Document Content violations %v, want 1", got)
+	}
+	if v := got[0]; v.Path != "(0040,a730)[1].(0040,a730)[0].(0040,a168)" || v.Kind != ViolationMissing || v.Name != "ConceptCodeSequence" {
+		t.Errorf("Validate: unexpected violation %v", v)
+	}
+}
+
+func TestValidate_UnknownIOD(t *testing.T) {
+	for _, ds := range []Dataset{
+		{},
+		{Elements: []*Element{mustNewElement(tag.SOPClassUID, []string{"1.2.3"})}},
+	} {
+		if _, err := Validate(ds); !errors.Is(err, ErrorUnknownIOD) {
+			t.Errorf("Validate(%v): got %v, want %v", ds.Elements, err, ErrorUnknownIOD)
+		}
+	}
+}
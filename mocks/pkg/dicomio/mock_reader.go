@@ -199,6 +199,21 @@ func (mr *MockReaderMockRecorder) ReadString(n interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadString", reflect.TypeOf((*MockReader)(nil).ReadString), n)
 }
 
+// ReadPersonName mocks base method
+func (m *MockReader) ReadPersonName(n uint32) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadPersonName", n)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadPersonName indicates an expected call of ReadPersonName
+func (mr *MockReaderMockRecorder) ReadPersonName(n interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPersonName", reflect.TypeOf((*MockReader)(nil).ReadPersonName), n)
+}
+
 // Skip mocks base method
 func (m *MockReader) Skip(n int64) error {
 	m.ctrl.T.Helper()
@@ -319,3 +334,29 @@ func (mr *MockReaderMockRecorder) SetCodingSystem(cs interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCodingSystem", reflect.TypeOf((*MockReader)(nil).SetCodingSystem), cs)
 }
+
+// StartRecording mocks base method
+func (m *MockReader) StartRecording() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "StartRecording")
+}
+
+// StartRecording indicates an expected call of StartRecording
+func (mr *MockReaderMockRecorder) StartRecording() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartRecording", reflect.TypeOf((*MockReader)(nil).StartRecording))
+}
+
+// StopRecording mocks base method
+func (m *MockReader) StopRecording() []byte {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopRecording")
+	ret0, _ := ret[0].([]byte)
+	return ret0
+}
+
+// StopRecording indicates an expected call of StopRecording
+func (mr *MockReaderMockRecorder) StopRecording() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopRecording", reflect.TypeOf((*MockReader)(nil).StopRecording))
+}
@@ -0,0 +1,174 @@
+package dicom
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ginuerzh/dicom/pkg/tag"
+)
+
+// Defined Terms of the Reason for the Attribute Modification (0400,0565),
+// see PS3.3 C.12.1.1.9.2.
+const (
+	// ReasonCoerce indicates that values were replaced to make them
+	// consistent with another source, such as a Modality Worklist or a
+	// master patient index, without the originals being wrong.
+	ReasonCoerce = "COERCE"
+	// ReasonCorrect indicates that erroneous values were corrected.
+	ReasonCorrect = "CORRECT"
+)
+
+// ModifyOption represents an option that can be passed to Dataset.Modify.
+type ModifyOption func(*modifyOptSet)
+
+// modifyOptSet represents the flattened option set after all ModifyOptions have been applied.
+type modifyOptSet struct {
+	system string
+	source string
+	now    time.Time
+}
+
+// ModifyingSystem returns a ModifyOption that records name as the system
+// that made the modification, instead of DefaultImplementationVersionName.
+func ModifyingSystem(name string) ModifyOption {
+	return func(set *modifyOptSet) {
+		set.system = name
+	}
+}
+
+// SourceOfPreviousValues returns a ModifyOption that records source as the
+// Source of Previous Values (0400,0564), the entity that provided the values
+// being replaced. It is left empty by default, meaning the source is unknown.
+func SourceOfPreviousValues(source string) ModifyOption {
+	return func(set *modifyOptSet) {
+		set.source = source
+	}
+}
+
+// ModificationTime returns a ModifyOption that records t as the time of the
+// modification, instead of the current time.
+func ModificationTime(t time.Time) ModifyOption {
+	return func(set *modifyOptSet) {
+		set.now = t
+	}
+}
+
+// Modify sets the elements in set and removes the elements with the tags in
+// remove at the top level of d, and records the values they replaced or
+// removed as a new item of the Original Attributes Sequence (0400,0561), see
+// PS3.3 C.12.1.1.9. reason is the Reason for the Attribute Modification,
+// usually ReasonCoerce or ReasonCorrect. The Modified Attributes Sequence
+// only holds the attributes that were modified or removed: elements in set
+// equal to the ones they replace, or that were not present before, are not
+// recorded, and no item is added if nothing was recorded. A tag both in set
+// and in remove is recorded once. It returns ErrorElementNotFound, without
+// modifying d, if a tag in remove is not in d.
+func (d *Dataset) Modify(reason string, set []*Element, remove []tag.Tag, opts ...ModifyOption) error {
+	optSet := modifyOptSet{system: DefaultImplementationVersionName, now: time.Now()}
+	for _, opt := range opts {
+		opt(&optSet)
+	}
+	if reason == "" {
+		return errors.New("dicom: modification without a reason")
+	}
+	for _, e := range set {
+		if err := checkModifiable(e.Tag); err != nil {
+			return err
+		}
+	}
+	var originals []*Element
+	recorded := map[tag.Tag]bool{}
+	for _, t := range remove {
+		if err := checkModifiable(t); err != nil {
+			return err
+		}
+		e, err := d.FindElementByTag(t)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrorElementNotFound, t)
+		}
+		if !recorded[t] {
+			recorded[t] = true
+			originals = append(originals, e)
+		}
+	}
+	for _, e := range set {
+		if recorded[e.Tag] {
+			continue
+		}
+		if current, err := d.FindElementByTag(e.Tag); err == nil && !current.Equal(e, IgnoreValueLength()) {
+			recorded[e.Tag] = true
+			originals = append(originals, current)
+		}
+	}
+
+	for _, t := range remove {
+		d.Delete(t)
+	}
+	d.Update(set...)
+	if len(originals) == 0 {
+		return nil
+	}
+	history, err := sequenceItems(d, tag.OriginalAttributesSequence)
+	if err != nil {
+		return err
+	}
+	history = append(history, []*Element{
+		mustNewElement(tag.SourceOfPreviousValues, []string{optSet.source}),
+		mustNewElement(tag.AttributeModificationDateTime, []string{optSet.now.Format("20060102150405.000000-0700")}),
+		mustNewElement(tag.ModifyingSystem, []string{optSet.system}),
+		mustNewElement(tag.ReasonForTheAttributeModification, []string{reason}),
+		mustNewElement(tag.ModifiedAttributesSequence, [][]*Element{sortElements(originals)}),
+	})
+	d.Set(mustNewElement(tag.OriginalAttributesSequence, history))
+	return nil
+}
+
+// checkModifiable returns an error for the tags Modify cannot record.
+func checkModifiable(t tag.Tag) error {
+	if t.Group == tag.MetadataGroup || t == tag.OriginalAttributesSequence {
+		return fmt.Errorf("dicom: %v cannot be modified with Modify", t)
+	}
+	return nil
+}
+
+// Modification is one item of the Original Attributes Sequence, recording
+// the values replaced or removed by a modification of the Dataset.
+type Modification struct {
+	// DateTime is the DT value of the time of the modification.
+	DateTime               string
+	ModifyingSystem        string
+	SourceOfPreviousValues string
+	Reason                 string
+	// Originals are the elements as they were before the modification.
+	Originals []*Element
+}
+
+// ModificationHistory returns the modifications recorded in the Original
+// Attributes Sequence (0400,0561) of d, in the order they were recorded.
+// It returns no modifications if d has no Original Attributes Sequence.
+func (d *Dataset) ModificationHistory() ([]Modification, error) {
+	items, err := sequenceItems(d, tag.OriginalAttributesSequence)
+	if err != nil {
+		return nil, err
+	}
+	history := make([]Modification, 0, len(items))
+	for _, item := range items {
+		m := Modification{
+			DateTime:               itemString(item, tag.AttributeModificationDateTime),
+			ModifyingSystem:        itemString(item, tag.ModifyingSystem),
+			SourceOfPreviousValues: itemString(item, tag.SourceOfPreviousValues),
+			Reason:                 itemString(item, tag.ReasonForTheAttributeModification),
+		}
+		if e, err := findItemElement(item, tag.ModifiedAttributesSequence); err == nil {
+			if e.Value.ValueType() != Sequences {
+				return nil, fmt.Errorf("%w: %v is not a sequence", ErrorUnexpectedValueType, tag.ModifiedAttributesSequence)
+			}
+			for _, modified := range e.Value.GetValue().([]*SequenceItemValue) {
+				m.Originals = append(m.Originals, modified.elements...)
+			}
+		}
+		history = append(history, m)
+	}
+	return history, nil
+}
@@ -0,0 +1,160 @@
+package dicom
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ginuerzh/dicom/pkg/tag"
+	"github.com/ginuerzh/dicom/pkg/uid"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestModify(t *testing.T) {
+	studyDescription := tag.Tag{Group: 0x0008, Element: 0x1030}
+	seriesNumber := tag.Tag{Group: 0x0020, Element: 0x0011}
+	first := time.Date(2020, 3, 4, 5, 6, 7, 0, time.UTC)
+	second := first.Add(time.Hour)
+
+	ds := Dataset{Elements: []*Element{
+		mustNewElement(tag.MediaStorageSOPClassUID, []string{uid.SecondaryCaptureImageStorage}),
+		mustNewElement(tag.MediaStorageSOPInstanceUID, []string{"1.2.3.4"}),
+		mustNewElement(tag.TransferSyntaxUID, []string{uid.ExplicitVRLittleEndian}),
+		mustNewElement(tag.SOPInstanceUID, []string{"1.2.3.4"}),
+		mustNewElement(studyDescription, []string{"Report"}),
+		mustNewElement(tag.ComponentName, []string{"Doe^John"}),
+		mustNewElement(tag.ComponentIDNumber, []string{"LOCAL-1"}),
+	}}
+	err := ds.Modify(ReasonCoerce,
+		[]*Element{
+			mustNewElement(tag.ComponentIDNumber, []string{"MPI-1"}),
+			mustNewElement(tag.ComponentName, []string{"Doe^John"}), // Unchanged
+			mustNewElement(seriesNumber, []string{"1"}),             // Added
+		},
+		nil,
+		ModifyingSystem("Reconciler"), SourceOfPreviousValues("LOCAL"), ModificationTime(first),
+	)
+	if err != nil {
+		t.Fatalf("Modify: %v", err)
+	}
+	if err := ds.Modify(ReasonCorrect, nil, []tag.Tag{studyDescription}, ModificationTime(second)); err != nil {
+		t.Fatalf("Modify: %v", err)
+	}
+	// Modifications that replace nothing are not recorded.
+	if err := ds.Modify(ReasonCoerce, []*Element{mustNewElement(tag.ComponentIDNumber, []string{"MPI-1"})}, nil); err != nil {
+		t.Fatalf("Modify: %v", err)
+	}
+
+	// The history must survive writing and parsing.
+	buf := &bytes.Buffer{}
+	if err := Write(buf, ds); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	parsed, err := Parse(bytes.NewReader(buf.Bytes()), int64(buf.Len()), nil)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	for _, c := range []struct {
+		tag  tag.Tag
+		want []string
+	}{
+		{tag.ComponentIDNumber, []string{"MPI-1"}},
+		{seriesNumber, []string{"1"}},
+	} {
+		e, err := parsed.FindElementByTag(c.tag)
+		if err != nil {
+			t.Fatalf("FindElementByTag(%v): %v", c.tag, err)
+		}
+		if diff := cmp.Diff(c.want, MustGetStrings(e.Value)); diff != "" {
+			t.Errorf("Modify: unexpected %v (-want +got):\n%s", c.tag, diff)
+		}
+	}
+	if _, err := parsed.FindElementByTag(studyDescription); err != ErrorElementNotFound {
+		t.Errorf("Modify: %v not removed", studyDescription)
+	}
+
+	history, err := parsed.ModificationHistory()
+	if err != nil {
+		t.Fatalf("ModificationHistory: %v", err)
+	}
+	type modification struct {
+		DateTime, ModifyingSystem, SourceOfPreviousValues, Reason string
+		Originals                                                 map[tag.Tag][]string
+	}
+	var got []modification
+	for _, m := range history {
+		originals := map[tag.Tag][]string{}
+		for _, e := range m.Originals {
+			originals[e.Tag] = MustGetStrings(e.Value)
+		}
+		got = append(got, modification{m.DateTime, m.ModifyingSystem, m.SourceOfPreviousValues, m.Reason, originals})
+	}
+	want := []modification{
+		{
+			DateTime:               "20200304050607.000000+0000",
+			ModifyingSystem:        "Reconciler",
+			SourceOfPreviousValues: "LOCAL",
+			Reason:                 ReasonCoerce,
+			Originals:              map[tag.Tag][]string{tag.ComponentIDNumber: {"LOCAL-1"}},
+		},
+		{
+			DateTime:        "20200304060607.000000+0000",
+			ModifyingSystem: DefaultImplementationVersionName,
+			Reason:          ReasonCorrect,
+			Originals:       map[tag.Tag][]string{studyDescription: {"Report"}},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ModificationHistory: unexpected history (-want +got):\n%s", diff)
+	}
+}
+
+func TestModify_RemoveAndSet(t *testing.T) {
+	ds := Dataset{Elements: []*Element{
+		mustNewElement(tag.ComponentIDNumber, []string{"LOCAL-1"}),
+	}}
+	set := []*Element{mustNewElement(tag.ComponentIDNumber, []string{"MPI-1"})}
+	if err := ds.Modify(ReasonCoerce, set, []tag.Tag{tag.ComponentIDNumber}); err != nil {
+		t.Fatalf("Modify: %v", err)
+	}
+	history, err := ds.ModificationHistory()
+	if err != nil {
+		t.Fatalf("ModificationHistory: %v", err)
+	}
+	if len(history) != 1 || len(history[0].Originals) != 1 {
+		t.Fatalf("ModificationHistory: got %v, want a single original", history)
+	}
+	if diff := cmp.Diff([]string{"LOCAL-1"}, MustGetStrings(history[0].Originals[0].Value)); diff != "" {
+		t.Errorf("ModificationHistory: unexpected original (-want +got):\n%s", diff)
+	}
+}
+
+func TestModify_Errors(t *testing.T) {
+	ds := Dataset{Elements: []*Element{
+		mustNewElement(tag.TransferSyntaxUID, []string{uid.ExplicitVRLittleEndian}),
+		mustNewElement(tag.ComponentIDNumber, []string{"LOCAL-1"}),
+	}}
+	want := ds.Clone()
+	set := []*Element{mustNewElement(tag.ComponentIDNumber, []string{"MPI-1"})}
+	if err := ds.Modify(ReasonCoerce, set, []tag.Tag{tag.ComponentName}); !errors.Is(err, ErrorElementNotFound) {
+		t.Errorf("Modify removing a missing element: got %v, want %v", err, ErrorElementNotFound)
+	}
+	if err := ds.Modify(ReasonCoerce, []*Element{mustNewElement(tag.TransferSyntaxUID, []string{uid.ImplicitVRLittleEndian})}, nil); err == nil {
+		t.Errorf("Modify of File Meta Information: got no error")
+	}
+	if err := ds.Modify("", set, nil); err == nil {
+		t.Errorf("Modify without a reason: got no error")
+	}
+	if !ds.Equal(&want) {
+		t.Errorf("Modify: dataset changed by failed modifications")
+	}
+
+	history, err := ds.ModificationHistory()
+	if err != nil {
+		t.Fatalf("ModificationHistory: %v", err)
+	}
+	if len(history) != 0 {
+		t.Errorf("ModificationHistory: got %d modifications, want none", len(history))
+	}
+}
@@ -54,8 +54,8 @@ var (
 
 // Parse parses the entire DICOM at the input io.Reader into a Dataset of DICOM Elements. Use this if you are
 // looking to parse the DICOM all at once, instead of element-by-element.
-func Parse(in io.Reader, bytesToRead int64, frameChan chan *frame.Frame) (Dataset, error) {
-	p, err := NewParser(in, bytesToRead, frameChan)
+func Parse(in io.Reader, bytesToRead int64, frameChan chan *frame.Frame, opts ...ParseOption) (Dataset, error) {
+	p, err := NewParser(in, bytesToRead, frameChan, opts...)
 	if err != nil {
 		return Dataset{}, err
 	}
@@ -76,7 +76,7 @@ func Parse(in io.Reader, bytesToRead int64, frameChan chan *frame.Frame) (Datase
 
 // ParseFile parses the entire DICOM at the given filepath. See dicom.Parse as
 // well for a more generic io.Reader based API.
-func ParseFile(filepath string, frameChan chan *frame.Frame) (Dataset, error) {
+func ParseFile(filepath string, frameChan chan *frame.Frame, opts ...ParseOption) (Dataset, error) {
 	f, err := os.Open(filepath)
 	if err != nil {
 		return Dataset{}, err
@@ -88,7 +88,34 @@ func ParseFile(filepath string, frameChan chan *frame.Frame) (Dataset, error) {
 		return Dataset{}, err
 	}
 
-	return Parse(f, info.Size(), frameChan)
+	return Parse(f, info.Size(), frameChan, opts...)
+}
+
+// ParseDataset parses the bytesToRead bytes of elements at in, encoded in the
+// transfer syntax transferSyntaxUID without a preamble or File Meta
+// Information header, as written by WriteDataset.
+func ParseDataset(in io.Reader, bytesToRead int64, transferSyntaxUID string, opts ...ParseOption) (Dataset, error) {
+	bo, implicit, err := uid.ParseTransferSyntaxUID(transferSyntaxUID)
+	if err != nil {
+		return Dataset{}, err
+	}
+	reader, err := dicomio.NewReader(bufio.NewReader(in), bo, bytesToRead)
+	if err != nil {
+		return Dataset{}, err
+	}
+	reader.SetTransferSyntax(bo, implicit)
+
+	p := Parser{reader: reader, opts: toParseOptSet(opts...)}
+	p.dataset.raw = p.opts.raw
+	if p.opts.raw != nil {
+		p.opts.raw.bo = bo
+	}
+	for !p.reader.IsLimitExhausted() {
+		if _, err := p.Next(); err != nil {
+			return p.dataset, err
+		}
+	}
+	return p.dataset, nil
 }
 
 // Parser is a struct that allows a user to parse Elements from a DICOM element-by-element using Next(), which may be
@@ -101,6 +128,7 @@ type Parser struct {
 	// file is optional, might be populated if reading from an underlying file
 	file         *os.File
 	frameChannel chan *frame.Frame
+	opts         parseOptSet
 }
 
 // NewParser returns a new Parser that points to the provided io.Reader, with bytesToRead bytes left to read. NewParser
@@ -108,7 +136,7 @@ type Parser struct {
 //
 // frameChannel is an optional channel (can be nil) upon which DICOM image frames will be sent as they are parsed (if
 // provided).
-func NewParser(in io.Reader, bytesToRead int64, frameChannel chan *frame.Frame) (*Parser, error) {
+func NewParser(in io.Reader, bytesToRead int64, frameChannel chan *frame.Frame, opts ...ParseOption) (*Parser, error) {
 	reader, err := dicomio.NewReader(bufio.NewReader(in), binary.LittleEndian, bytesToRead)
 	if err != nil {
 		return nil, err
@@ -117,6 +145,7 @@ func NewParser(in io.Reader, bytesToRead int64, frameChannel chan *frame.Frame)
 	p := Parser{
 		reader:       reader,
 		frameChannel: frameChannel,
+		opts:         toParseOptSet(opts...),
 	}
 
 	preamble, err := p.readPreamble()
@@ -132,6 +161,7 @@ func NewParser(in io.Reader, bytesToRead int64, frameChannel chan *frame.Frame)
 	p.dataset = Dataset{
 		Preamble: preamble,
 		Elements: elems,
+		raw:      p.opts.raw,
 	}
 	// TODO(suyashkumar): avoid storing the metadata pointers twice (though not that expensive)
 	p.metadata = Dataset{Elements: elems}
@@ -153,6 +183,9 @@ func NewParser(in io.Reader, bytesToRead int64, frameChannel chan *frame.Frame)
 		}
 	}
 	p.reader.SetTransferSyntax(bo, implicit)
+	if p.opts.raw != nil {
+		p.opts.raw.bo = bo
+	}
 
 	return &p, nil
 }
@@ -166,7 +199,7 @@ func (p *Parser) Next() (*Element, error) {
 		}
 		return nil, ErrorEndOfDICOM
 	}
-	elem, err := readElement(p.reader, &p.dataset, p.frameChannel)
+	elem, err := readElement(p.reader, &p.dataset, p.frameChannel, p.opts)
 	if err != nil {
 		// TODO: tolerate some kinds of errors and continue parsing
 		return nil, err
@@ -185,11 +218,46 @@ func (p *Parser) Next() (*Element, error) {
 		p.reader.SetCodingSystem(cs)
 	}
 
-	p.dataset.Elements = append(p.dataset.Elements, elem)
+	p.dataset.appendElement(elem)
 	return elem, nil
 
 }
 
+// ParseOption represents an option that can be passed to Parse, ParseFile or
+// NewParser.
+type ParseOption func(*parseOptSet)
+
+// RetainRawValues returns a ParseOption that keeps the exact bytes, VR and
+// length encoding each element was read with. Write re-emits them unchanged
+// for every element that has not been modified since it was parsed, as long
+// as the transfer syntax is unchanged, so that a parsed DICOM can be written
+// back byte for byte. The retained bytes add roughly the encoded size of the
+// DICOM to the memory used by the Dataset; modification is detected with a
+// SHA-256 digest of each parsed value, so no copy of the parsed values is
+// kept. The encodings of elements replaced or deleted through Set, Delete or
+// Update are dropped.
+func RetainRawValues() ParseOption {
+	return func(set *parseOptSet) {
+		set.raw = newRawValues()
+	}
+}
+
+// parseOptSet represents the flattened option set after all ParseOptions
+// have been applied.
+type parseOptSet struct {
+	// raw collects the retained encodings of parsed elements, if
+	// RetainRawValues was set.
+	raw *rawValues
+}
+
+func toParseOptSet(opts ...ParseOption) parseOptSet {
+	optSet := parseOptSet{}
+	for _, opt := range opts {
+		opt(&optSet)
+	}
+	return optSet
+}
+
 // GetMetadata returns just the set of metadata elements that have been parsed
 // so far.
 func (p *Parser) GetMetadata() Dataset {
@@ -230,7 +298,7 @@ func (p *Parser) readHeader() ([]*Element, error) {
 
 	// Must read metadata as LittleEndian explicit VR
 	// Read the length of the metadata elements: (0002,0000) MetaElementGroupLength
-	maybeMetaLen, err := readElement(p.reader, nil, nil)
+	maybeMetaLen, err := readElement(p.reader, nil, nil, p.opts)
 	if err != nil {
 		return nil, err
 	}
@@ -250,7 +318,7 @@ func (p *Parser) readHeader() ([]*Element, error) {
 	}
 	defer p.reader.PopLimit()
 	for !p.reader.IsLimitExhausted() {
-		elem, err := readElement(p.reader, nil, nil)
+		elem, err := readElement(p.reader, nil, nil, p.opts)
 		if err != nil {
 			// TODO: see if we can skip over malformed elements somehow
 			return nil, err
@@ -1,6 +1,8 @@
 package charset
 
 import (
+	"strings"
+
 	"golang.org/x/text/encoding"
 	"golang.org/x/text/encoding/htmlindex"
 )
@@ -75,9 +77,31 @@ var htmlEncodingNames = map[string]string{
 // "ISO-IR 100" to encoding.Decoder(s). It will return nil, nil for the default (7bit
 // ASCII) encoding. Cf. P3.2
 // D.6.2. http://dicom.nema.org/medical/dicom/2016d/output/chtml/part02/sect_D.6.2.html
+//
+// If there is more than one name, or the name is a multi-byte character set
+// like "ISO 2022 IR 87", the values use code extensions, and all three
+// decoders switch between the character sets at the ISO 2022 escape
+// sequences within each value. ISO_IR 192, GB18030 and GBK cannot be used
+// with code extensions; if one of them is among several names, the values are
+// decoded with it alone.
 func ParseSpecificCharacterSet(encodingNames []string) (CodingSystem, error) {
+	names := make([]string, len(encodingNames))
+	for i, name := range encodingNames {
+		names[i] = strings.TrimSpace(name)
+	}
+	for _, name := range names {
+		if d, ok := definedTerms[name]; ok && d.g0 != nil && d.g0.escape == nil {
+			names = []string{name}
+			break
+		}
+	}
+	if len(names) > 1 || len(names) == 1 && isMultiByte(definedTerms[names[0]]) {
+		d := newISO2022Decoder(names)
+		return CodingSystem{d, d, d}, nil
+	}
+
 	var decoders []*encoding.Decoder
-	for _, name := range encodingNames {
+	for _, name := range names {
 		var c *encoding.Decoder
 		/*
 			if htmlName, ok := htmlEncodingNames[name]; !ok {
@@ -107,11 +131,5 @@ func ParseSpecificCharacterSet(encodingNames []string) (CodingSystem, error) {
 	if len(decoders) == 0 {
 		return CodingSystem{nil, nil, nil}, nil
 	}
-	if len(decoders) == 1 {
-		return CodingSystem{decoders[0], decoders[0], decoders[0]}, nil
-	}
-	if len(decoders) == 2 {
-		return CodingSystem{decoders[0], decoders[1], decoders[1]}, nil
-	}
-	return CodingSystem{decoders[0], decoders[1], decoders[2]}, nil
+	return CodingSystem{decoders[0], decoders[0], decoders[0]}, nil
 }
@@ -0,0 +1,213 @@
+package charset
+
+import (
+	"bytes"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/transform"
+)
+
+// codeExtensionSets holds the character sets that escape sequences can
+// designate.
+var codeExtensionSets = func() []*characterSet {
+	seen := map[*characterSet]bool{}
+	var sets []*characterSet
+	for _, d := range definedTerms {
+		for _, cs := range []*characterSet{d.g0, d.g1} {
+			if cs != nil && cs.escape != nil && !seen[cs] {
+				seen[cs] = true
+				sets = append(sets, cs)
+			}
+		}
+	}
+	return sets
+}()
+
+// designatedSet returns the character set designated by the escape sequence
+// at the start of b, or nil if there is none.
+func designatedSet(b []byte) *characterSet {
+	for _, cs := range codeExtensionSets {
+		if bytes.HasPrefix(b, cs.escape) {
+			return cs
+		}
+	}
+	return nil
+}
+
+// iso2022 is a transform.Transformer decoding values that use ISO 2022 code
+// extensions, see PS3.5 6.1.2.5. The escape sequences within a value switch
+// between character sets, and the character sets of value 1 of the Specific
+// Character Set are designated again at control characters and at the "\"
+// delimiter between values. Each value must be transformed as a whole.
+type iso2022 struct {
+	transform.NopResetter
+	initial designation
+}
+
+// newISO2022Decoder returns a decoder for the terms of a Specific Character
+// Set with code extensions. Unknown terms are ignored.
+func newISO2022Decoder(terms []string) *encoding.Decoder {
+	initial := designation{g0: ascii}
+	if d, ok := definedTerms[terms[0]]; ok && d.g0 != nil && d.g0.escape != nil && !isMultiByte(d) {
+		initial = d
+	}
+	return &encoding.Decoder{Transformer: iso2022{initial: initial}}
+}
+
+// Transform implements transform.Transformer.
+func (t iso2022) Transform(dst, src []byte, atEOF bool) (int, int, error) {
+	if !atEOF {
+		return 0, 0, transform.ErrShortSrc
+	}
+	decoded := t.decode(make([]byte, 0, len(src)), src)
+	if len(decoded) > len(dst) {
+		return 0, 0, transform.ErrShortDst
+	}
+	return copy(dst, decoded), len(src), nil
+}
+
+// decode appends the UTF-8 encoding of src to dst. Bytes that are not
+// characters of the designated character sets are decoded as U+FFFD.
+func (t iso2022) decode(dst, src []byte) []byte {
+	g0, g1 := t.initial.g0, t.initial.g1
+	for i := 0; i < len(src); {
+		b := src[i]
+		if b == esc {
+			if cs := designatedSet(src[i:]); cs != nil {
+				if cs.g1 {
+					g1 = cs
+				} else {
+					g0 = cs
+				}
+				i += len(cs.escape)
+				continue
+			}
+		}
+		if b < 0x20 || b == '\\' && g0.width == 1 {
+			g0, g1 = t.initial.g0, t.initial.g1
+			dst = append(dst, b)
+			i++
+			continue
+		}
+
+		cs := g0
+		if b >= 0x80 {
+			cs = g1
+		}
+		if cs != nil && i+cs.width <= len(src) {
+			if r := cs.decode(src[i : i+cs.width]); r != utf8.RuneError {
+				dst = appendRune(dst, r)
+				i += cs.width
+				continue
+			}
+		}
+		if b < 0x80 {
+			// Like spaces within multi-byte characters.
+			dst = append(dst, b)
+		} else {
+			dst = appendRune(dst, utf8.RuneError)
+		}
+		i++
+	}
+	return dst
+}
+
+// DecodePersonName decodes a PN value of one or more names. Each component
+// group is decoded with the decoder of its CodingSystemType, alphabetic,
+// ideographic and phonetic in turn. With code extensions, each component
+// starts with the character sets of value 1 of the Specific Character Set
+// designated, as the "^" and "=" delimiters reset them, see PS3.5
+// 6.1.2.5.3.
+func (c CodingSystem) DecodePersonName(data []byte) (string, error) {
+	if c.Alphabetic == c.Ideographic && c.Phonetic == c.Ideographic && !isISO2022(c.Ideographic) {
+		// Splitting is not needed, and could split multi-byte characters of
+		// encodings like GB18030.
+		return decode(data, c.Ideographic)
+	}
+	var names []string
+	for _, name := range splitDelimited(data, '\\') {
+		var groups []string
+		for i, group := range splitDelimited(name, '=') {
+			d := c.Phonetic
+			switch CodingSystemType(i) {
+			case AlphabeticCodingSystem:
+				d = c.Alphabetic
+			case IdeographicCodingSystem:
+				d = c.Ideographic
+			}
+			var components []string
+			for _, component := range splitDelimited(group, '^') {
+				decoded, err := decode(component, d)
+				if err != nil {
+					return "", err
+				}
+				components = append(components, decoded)
+			}
+			groups = append(groups, strings.Join(components, "^"))
+		}
+		names = append(names, strings.Join(groups, "="))
+	}
+	return strings.Join(names, "\\"), nil
+}
+
+// splitDelimited splits data at the delimiter bytes that are not part of
+// multi-byte characters designated to G0 by escape sequences.
+func splitDelimited(data []byte, delimiter byte) [][]byte {
+	var parts [][]byte
+	width, start := 1, 0
+	for i := 0; i < len(data); {
+		b := data[i]
+		if b == esc {
+			if cs := designatedSet(data[i:]); cs != nil {
+				if !cs.g1 {
+					width = cs.width
+				}
+				i += len(cs.escape)
+				continue
+			}
+		}
+		if b < 0x20 {
+			width = 1
+		}
+		if width > 1 && isGraphic(b) {
+			i += width
+			continue
+		}
+		if b == delimiter {
+			parts = append(parts, data[start:i])
+			start = i + 1
+		}
+		i++
+	}
+	return append(parts, data[start:])
+}
+
+// decode returns data decoded with d, or as is if d is nil.
+func decode(data []byte, d *encoding.Decoder) (string, error) {
+	if len(data) == 0 {
+		return "", nil
+	}
+	if d == nil {
+		return string(data), nil
+	}
+	decoded, err := d.Bytes(data)
+	if err != nil {
+		return "", err
+	}
+	return string(decoded), nil
+}
+
+func isISO2022(d *encoding.Decoder) bool {
+	if d == nil {
+		return false
+	}
+	_, ok := d.Transformer.(iso2022)
+	return ok
+}
+
+func appendRune(dst []byte, r rune) []byte {
+	var buf [utf8.UTFMax]byte
+	return append(dst, buf[:utf8.EncodeRune(buf[:], r)]...)
+}
@@ -0,0 +1,204 @@
+package charset
+
+import (
+	"testing"
+
+	"golang.org/x/text/encoding/charmap"
+)
+
+func TestCodingSystem_DecodePersonName(t *testing.T) {
+	cases := []struct {
+		name                 string
+		specificCharacterSet []string
+		in                   string
+		want                 string
+	}{
+		{
+			name:                 "Latin-1",
+			specificCharacterSet: []string{"ISO_IR 100"},
+			in:                   "Buc^J\xe9r\xf4me",
+			want:                 "Buc^Jérôme",
+		},
+		{
+			// PS3.5 H.3.1
+			name:                 "Japanese with ISO 2022 IR 87",
+			specificCharacterSet: []string{"", "ISO 2022 IR 87"},
+			in:                   "Yamada^Tarou=\x1b$B;3ED\x1b(B^\x1b$BB@O:\x1b(B=\x1b$B$d$^$@\x1b(B^\x1b$B$?$m$&\x1b(B",
+			want:                 "Yamada^Tarou=山田^太郎=やまだ^たろう",
+		},
+		{
+			// PS3.5 H.3.2
+			name:                 "Japanese with ISO 2022 IR 13 and ISO 2022 IR 87",
+			specificCharacterSet: []string{"ISO 2022 IR 13", "ISO 2022 IR 87"},
+			in:                   "\xd4\xcf\xc0\xde^\xc0\xdb\xb3=\x1b$B;3ED\x1b(J^\x1b$BB@O:\x1b(J=\x1b$B$d$^$@\x1b(J^\x1b$B$?$m$&\x1b(J",
+			want:                 "ﾔﾏﾀﾞ^ﾀﾛｳ=山田^太郎=やまだ^たろう",
+		},
+		{
+			// PS3.5 I.2
+			name:                 "Korean",
+			specificCharacterSet: []string{"", "ISO 2022 IR 149"},
+			in:                   "Hong^Gildong=\x1b$)C\xfb\xf3^\x1b$)C\xd1\xce\xd4\xd7=\x1b$)C\xc8\xab^\x1b$)C\xb1\xe6\xb5\xbf",
+			want:                 "Hong^Gildong=洪^吉洞=홍^길동",
+		},
+		{
+			name:                 "Korean without value 1",
+			specificCharacterSet: []string{"ISO 2022 IR 149"},
+			in:                   "\x1b$)C\xfb\xf3",
+			want:                 "洪",
+		},
+		{
+			name:                 "multiple values",
+			specificCharacterSet: []string{"", "ISO 2022 IR 87"},
+			in:                   "\x1b$B;3ED\x1b(B^\x1b$BB@O:\x1b(B\\Yamada^Tarou",
+			want:                 "山田^太郎\\Yamada^Tarou",
+		},
+		{
+			// The second bytes of 表 and ソ are "=".
+			name:                 "delimiter bytes within characters",
+			specificCharacterSet: []string{"", "ISO 2022 IR 87"},
+			in:                   "\x1b$BI=%=\x1b(B=\x1b$B%=\x1b(B",
+			want:                 "表ソ=ソ",
+		},
+		{
+			// Each component group starts with the character sets of
+			// value 1, so the G1 designation does not carry over.
+			name:                 "reset at delimiters",
+			specificCharacterSet: []string{"", "ISO 2022 IR 149"},
+			in:                   "\x1b$)C\xfb\xf3=\xfb\xf3",
+			want:                 "洪=\ufffd\ufffd",
+		},
+		{
+			// PS3.5 J.3
+			name:                 "GB18030",
+			specificCharacterSet: []string{"GB18030"},
+			in:                   "Wang^XiaoDong=\xcd\xf5^\xd0\xa1\x96\x7c=",
+			want:                 "Wang^XiaoDong=王^小東=",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cs, err := ParseSpecificCharacterSet(tc.specificCharacterSet)
+			if err != nil {
+				t.Fatalf("ParseSpecificCharacterSet(%q): %v", tc.specificCharacterSet, err)
+			}
+			got, err := cs.DecodePersonName([]byte(tc.in))
+			if err != nil {
+				t.Fatalf("DecodePersonName(%q): %v", tc.in, err)
+			}
+			if got != tc.want {
+				t.Errorf("DecodePersonName(%q): got %q, want %q", tc.in, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCodingSystem_DecodePersonName_ComponentGroups(t *testing.T) {
+	cs := CodingSystem{
+		Alphabetic:  charmap.ISO8859_1.NewDecoder(),
+		Ideographic: charmap.ISO8859_5.NewDecoder(),
+		Phonetic:    charmap.ISO8859_7.NewDecoder(),
+	}
+	got, err := cs.DecodePersonName([]byte("\xe9^\xe9=\xe9^\xe9=\xe9\\\xe9=\xe9"))
+	if err != nil {
+		t.Fatalf("DecodePersonName: %v", err)
+	}
+	if want := "é^é=щ^щ=ι\\é=щ"; got != want {
+		t.Errorf("DecodePersonName: got %q, want %q", got, want)
+	}
+}
+
+func TestISO2022_Text(t *testing.T) {
+	cases := []struct {
+		name                 string
+		specificCharacterSet []string
+		in                   string
+		want                 string
+	}{
+		{
+			name:                 "Japanese",
+			specificCharacterSet: []string{"", "ISO 2022 IR 87"},
+			in:                   "\x1b$B;3ED\x1b(B\\\x1b$BB@O:",
+			want:                 "山田\\太郎",
+		},
+		{
+			// Only Person Names reset the code extensions at "=" and "^".
+			name:                 "Korean",
+			specificCharacterSet: []string{"", "ISO 2022 IR 149"},
+			in:                   "\x1b$)C\xfb\xf3=\xfb\xf3\r\n\xfb\xf3",
+			want:                 "洪=洪\r\n\ufffd\ufffd",
+		},
+		{
+			name:                 "Latin-1 and Cyrillic",
+			specificCharacterSet: []string{"ISO 2022 IR 100", "ISO 2022 IR 144"},
+			in:                   "J\xe9r\xf4me \x1b-L\xb8\xd2\xd0\xdd\x1b-A\\\xe9",
+			want:                 "Jérôme Иван\\é",
+		},
+		{
+			name:                 "repeated UTF-8",
+			specificCharacterSet: []string{"ISO_IR 192", "ISO_IR 192"},
+			in:                   "Müller^Jörg",
+			want:                 "Müller^Jörg",
+		},
+		{
+			name:                 "GB18030 with other values",
+			specificCharacterSet: []string{"GB18030", "ISO 2022 IR 87"},
+			in:                   "\xc9\xbd\xcc\xef",
+			want:                 "山田",
+		},
+		{
+			name:                 "unknown escape sequence",
+			specificCharacterSet: []string{"", "ISO 2022 IR 87"},
+			in:                   "\x1b$Z",
+			want:                 "\x1b$Z",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cs, err := ParseSpecificCharacterSet(tc.specificCharacterSet)
+			if err != nil {
+				t.Fatalf("ParseSpecificCharacterSet(%q): %v", tc.specificCharacterSet, err)
+			}
+			got, err := cs.Ideographic.String(tc.in)
+			if err != nil {
+				t.Fatalf("Decode(%q): %v", tc.in, err)
+			}
+			if got != tc.want {
+				t.Errorf("Decode(%q): got %q, want %q", tc.in, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestEncoder_RoundTrip(t *testing.T) {
+	for _, tc := range []struct {
+		specificCharacterSet []string
+		name                 string
+	}{
+		{[]string{"", "ISO 2022 IR 87"}, "Yamada^Tarou=山田^太郎=やまだ^たろう"},
+		{[]string{"ISO 2022 IR 13", "ISO 2022 IR 87"}, "ﾔﾏﾀﾞ^ﾀﾛｳ=山田^太郎=やまだ^たろう"},
+		{[]string{"", "ISO 2022 IR 87", "ISO 2022 IR 159"}, "表ソ^丂=ソ"},
+		{[]string{"", "ISO 2022 IR 149"}, "Hong^Gildong=洪^吉洞=홍^길동"},
+		{[]string{"", "ISO 2022 IR 58"}, "Zhang^SanFeng=章^三丰=ZHANG^SANFENG"},
+		{[]string{"ISO 2022 IR 100", "ISO 2022 IR 144", "ISO 2022 IR 126"}, "Jérôme^Иван=Διονυσιος"},
+	} {
+		e, err := NewEncoder(tc.specificCharacterSet)
+		if err != nil {
+			t.Fatalf("NewEncoder(%q): %v", tc.specificCharacterSet, err)
+		}
+		encoded, err := e.Encode(tc.name)
+		if err != nil {
+			t.Fatalf("Encode(%q): %v", tc.name, err)
+		}
+		cs, err := ParseSpecificCharacterSet(tc.specificCharacterSet)
+		if err != nil {
+			t.Fatalf("ParseSpecificCharacterSet(%q): %v", tc.specificCharacterSet, err)
+		}
+		got, err := cs.DecodePersonName([]byte(encoded))
+		if err != nil {
+			t.Fatalf("DecodePersonName(%q): %v", encoded, err)
+		}
+		if got != tc.name {
+			t.Errorf("DecodePersonName(Encode(%q)) with %q: got %q", tc.name, tc.specificCharacterSet, got)
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package charset
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+var (
+	// ErrorUnknownCharacterSet is returned for Specific Character Set values
+	// that are not Defined Terms, or that cannot be combined.
+	ErrorUnknownCharacterSet = errors.New("unknown specific character set")
+	// ErrorUnrepresentable is returned when a character cannot be encoded in
+	// any of the character sets of the Specific Character Set.
+	ErrorUnrepresentable = errors.New("character not representable in the specific character set")
+)
+
+// Encoder encodes UTF-8 strings in the character sets of a Specific
+// Character Set (0008,0005). It is the counterpart of the decoders of a
+// CodingSystem.
+//
+// If the Specific Character Set has more than one value, code extensions
+// are used: characters that the current character sets cannot represent are
+// encoded in the first character set that can, after the ISO 2022 escape
+// sequence designating it. The character sets of value 1 are designated
+// again before the end of the value, and before control characters and the
+// "\", "^" and "=" delimiters, see PS3.5 6.1.2.5.3.
+type Encoder struct {
+	initial        designation
+	codeExtensions bool
+	// extensions holds the character sets of all values, in order.
+	extensions []*characterSet
+	// replacement, if not nil, is encoded in place of unrepresentable
+	// characters.
+	replacement []rune
+}
+
+// EncoderOption represents an option that can be passed to NewEncoder.
+type EncoderOption func(*encoderOptSet)
+
+// encoderOptSet represents the flattened option set after all EncoderOptions have been applied.
+type encoderOptSet struct {
+	replacement *string
+}
+
+// ReplaceUnrepresentable returns an EncoderOption that encodes replacement,
+// for example "?", in place of characters that cannot be represented,
+// instead of failing with ErrorUnrepresentable. replacement itself must be
+// representable.
+func ReplaceUnrepresentable(replacement string) EncoderOption {
+	return func(set *encoderOptSet) {
+		set.replacement = &replacement
+	}
+}
+
+// NewEncoder returns an Encoder for the values of a Specific Character Set.
+// No values, or an empty value 1, select the default character repertoire
+// (ISO-IR 6). It returns ErrorUnknownCharacterSet if a value is not a Defined
+// Term of PS3.3 C.12.1.1.2, or if ISO_IR 192, GB18030 or GBK is combined
+// with other values.
+func NewEncoder(specificCharacterSet []string, opts ...EncoderOption) (*Encoder, error) {
+	set := encoderOptSet{}
+	for _, opt := range opts {
+		opt(&set)
+	}
+	terms := make([]string, len(specificCharacterSet))
+	for i, name := range specificCharacterSet {
+		terms[i] = strings.TrimSpace(name)
+	}
+	// Multi-byte character sets are only used with code extensions, after
+	// the default character repertoire.
+	if len(terms) > 0 && isMultiByte(definedTerms[terms[0]]) {
+		terms = append([]string{""}, terms...)
+	}
+	if len(terms) == 0 {
+		terms = []string{""}
+	}
+
+	e := &Encoder{codeExtensions: len(terms) > 1}
+	for i, term := range terms {
+		d, ok := definedTerms[term]
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrorUnknownCharacterSet, term)
+		}
+		if i == 0 {
+			e.initial = d
+		}
+		for _, cs := range []*characterSet{d.g0, d.g1} {
+			if cs == nil {
+				continue
+			}
+			if cs.escape == nil && e.codeExtensions {
+				return nil, fmt.Errorf("%w: %q cannot be used with code extensions", ErrorUnknownCharacterSet, term)
+			}
+			e.extensions = append(e.extensions, cs)
+		}
+	}
+	if set.replacement != nil {
+		e.replacement = []rune(*set.replacement)
+	}
+	return e, nil
+}
+
+// Encode returns s encoded in the character sets of the Encoder. It returns
+// ErrorUnrepresentable if s has a character that none of them can
+// represent, unless ReplaceUnrepresentable was used. Strings in the default
+// character repertoire are returned unchanged.
+func (e *Encoder) Encode(s string) (string, error) {
+	if e.initial.g0 == utf8Set || isASCII(s) {
+		return s, nil
+	}
+	g0, g1 := e.initial.g0, e.initial.g1
+	// reset designates the character sets of value 1 again, if needed.
+	reset := func(dst []byte) []byte {
+		if g0 != e.initial.g0 {
+			dst = append(dst, e.initial.g0.escape...)
+		}
+		if g1 != e.initial.g1 && e.initial.g1 != nil {
+			dst = append(dst, e.initial.g1.escape...)
+		}
+		g0, g1 = e.initial.g0, e.initial.g1
+		return dst
+	}
+	encode := func(dst []byte, r rune) ([]byte, bool) {
+		if r < 0x20 || r == '\\' || r == '^' || r == '=' {
+			dst = reset(dst)
+		}
+		for _, cs := range []*characterSet{g0, g1} {
+			if cs == nil {
+				continue
+			}
+			if out, ok := cs.encode(dst, r); ok {
+				return out, true
+			}
+		}
+		if e.codeExtensions {
+			for _, cs := range e.extensions {
+				out, ok := cs.encode(append(dst, cs.escape...), r)
+				if !ok {
+					continue
+				}
+				if cs.g1 {
+					g1 = cs
+				} else {
+					g0 = cs
+				}
+				return out, true
+			}
+		}
+		return dst, false
+	}
+
+	dst := make([]byte, 0, len(s)+8)
+	for i := 0; i < len(s); {
+		r, size := utf8.DecodeRuneInString(s[i:])
+		i += size
+		var ok bool
+		// Invalid UTF-8 is never representable.
+		if r != utf8.RuneError || size > 1 {
+			dst, ok = encode(dst, r)
+		}
+		if ok {
+			continue
+		}
+		if e.replacement == nil {
+			return "", fmt.Errorf("%w: %q", ErrorUnrepresentable, r)
+		}
+		for _, c := range e.replacement {
+			if dst, ok = encode(dst, c); !ok {
+				return "", fmt.Errorf("%w: replacement %q", ErrorUnrepresentable, c)
+			}
+		}
+	}
+	return string(reset(dst)), nil
+}
+
+// isMultiByte reports whether d designates a multi-byte character set, which
+// can only be used with code extensions.
+func isMultiByte(d designation) bool {
+	return d.g0 == jisX0208 || d.g0 == jisX0212 || d.g1 == ksX1001 || d.g1 == gb2312
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
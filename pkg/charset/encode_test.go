@@ -0,0 +1,133 @@
+package charset
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEncoder_Encode(t *testing.T) {
+	cases := []struct {
+		name                 string
+		specificCharacterSet []string
+		opts                 []EncoderOption
+		in                   string
+		want                 string
+	}{
+		{
+			name: "default character repertoire",
+			in:   "Wang^XiaoDong",
+			want: "Wang^XiaoDong",
+		},
+		{
+			name:                 "Latin-1",
+			specificCharacterSet: []string{"ISO_IR 100"},
+			in:                   "Buc^Jérôme",
+			want:                 "Buc^J\xe9r\xf4me",
+		},
+		{
+			name:                 "Greek",
+			specificCharacterSet: []string{"ISO_IR 126"},
+			in:                   "Διονυσιος",
+			want:                 "\xc4\xe9\xef\xed\xf5\xf3\xe9\xef\xf2",
+		},
+		{
+			// PS3.5 H.3.1
+			name:                 "Japanese with ISO 2022 IR 87",
+			specificCharacterSet: []string{"", "ISO 2022 IR 87"},
+			in:                   "Yamada^Tarou=山田^太郎=やまだ^たろう",
+			want:                 "Yamada^Tarou=\x1b$B;3ED\x1b(B^\x1b$BB@O:\x1b(B=\x1b$B$d$^$@\x1b(B^\x1b$B$?$m$&\x1b(B",
+		},
+		{
+			// PS3.5 H.3.2
+			name:                 "Japanese with ISO 2022 IR 13 and ISO 2022 IR 87",
+			specificCharacterSet: []string{"ISO 2022 IR 13", "ISO 2022 IR 87"},
+			in:                   "ﾔﾏﾀﾞ^ﾀﾛｳ=山田^太郎=やまだ^たろう",
+			want:                 "\xd4\xcf\xc0\xde^\xc0\xdb\xb3=\x1b$B;3ED\x1b(J^\x1b$BB@O:\x1b(J=\x1b$B$d$^$@\x1b(J^\x1b$B$?$m$&\x1b(J",
+		},
+		{
+			// PS3.5 I.2
+			name:                 "Korean",
+			specificCharacterSet: []string{"", "ISO 2022 IR 149"},
+			in:                   "Hong^Gildong=洪^吉洞=홍^길동",
+			want:                 "Hong^Gildong=\x1b$)C\xfb\xf3^\x1b$)C\xd1\xce\xd4\xd7=\x1b$)C\xc8\xab^\x1b$)C\xb1\xe6\xb5\xbf",
+		},
+		{
+			name:                 "Korean without value 1",
+			specificCharacterSet: []string{"ISO 2022 IR 149"},
+			in:                   "洪",
+			want:                 "\x1b$)C\xfb\xf3",
+		},
+		{
+			name:                 "Latin-1 and Cyrillic",
+			specificCharacterSet: []string{"ISO 2022 IR 100", "ISO 2022 IR 144"},
+			in:                   "Jérôme^Иван",
+			want:                 "J\xe9r\xf4me^\x1b-L\xb8\xd2\xd0\xdd\x1b-A",
+		},
+		{
+			// PS3.5 J.3
+			name:                 "GB18030",
+			specificCharacterSet: []string{"GB18030"},
+			in:                   "Wang^XiaoDong=王^小東=",
+			want:                 "Wang^XiaoDong=\xcd\xf5^\xd0\xa1\x96\x7c=",
+		},
+		{
+			name:                 "UTF-8",
+			specificCharacterSet: []string{"ISO_IR 192"},
+			in:                   "Wang^XiaoDong=王^小東=",
+			want:                 "Wang^XiaoDong=王^小東=",
+		},
+		{
+			name:                 "replacement",
+			specificCharacterSet: []string{"ISO_IR 100"},
+			opts:                 []EncoderOption{ReplaceUnrepresentable("?")},
+			in:                   "Jérôme^山田\xff",
+			want:                 "J\xe9r\xf4me^???",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e, err := NewEncoder(tc.specificCharacterSet, tc.opts...)
+			if err != nil {
+				t.Fatalf("NewEncoder(%q): %v", tc.specificCharacterSet, err)
+			}
+			got, err := e.Encode(tc.in)
+			if err != nil {
+				t.Fatalf("Encode(%q): %v", tc.in, err)
+			}
+			if diff := cmp.Diff([]byte(tc.want), []byte(got)); diff != "" {
+				t.Errorf("Encode(%q): unexpected bytes (-want +got):\n%s", tc.in, diff)
+			}
+		})
+	}
+}
+
+func TestEncoder_Errors(t *testing.T) {
+	cases := []struct {
+		name                 string
+		specificCharacterSet []string
+		opts                 []EncoderOption
+		in                   string
+		want                 error
+	}{
+		{name: "default character repertoire", in: "Jérôme", want: ErrorUnrepresentable},
+		{name: "not in Latin-1", specificCharacterSet: []string{"ISO_IR 100"}, in: "山田", want: ErrorUnrepresentable},
+		{name: "no code extensions", specificCharacterSet: []string{"ISO_IR 100"}, in: "Иван", want: ErrorUnrepresentable},
+		{name: "invalid UTF-8", specificCharacterSet: []string{"ISO_IR 100"}, in: "J\xe9r\xf4me", want: ErrorUnrepresentable},
+		{name: "unrepresentable replacement", specificCharacterSet: []string{"ISO_IR 100"}, opts: []EncoderOption{ReplaceUnrepresentable("〓")}, in: "山田", want: ErrorUnrepresentable},
+		{name: "unknown term", specificCharacterSet: []string{"ISO_IR 999"}, want: ErrorUnknownCharacterSet},
+		{name: "UTF-8 with code extensions", specificCharacterSet: []string{"ISO_IR 192", "ISO 2022 IR 87"}, want: ErrorUnknownCharacterSet},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			e, err := NewEncoder(tc.specificCharacterSet, tc.opts...)
+			if err == nil {
+				_, err = e.Encode(tc.in)
+			}
+			if !errors.Is(err, tc.want) {
+				t.Errorf("Encode(%q) with %q: got %v, want %v", tc.in, tc.specificCharacterSet, err, tc.want)
+			}
+		})
+	}
+}
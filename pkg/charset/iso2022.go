@@ -0,0 +1,249 @@
+package charset
+
+import (
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/korean"
+	"golang.org/x/text/encoding/simplifiedchinese"
+)
+
+// esc is the ISO 2022 escape character that starts a designation.
+const esc = 0x1b
+
+// characterSet is a coded character set of PS3.3 C.12.1.1.2, that is
+// designated to the G0 or G1 code element, or used on its own.
+type characterSet struct {
+	// escape is the ISO 2022 escape sequence designating the set, or nil
+	// for sets that cannot be used with code extensions.
+	escape []byte
+	// g1 reports whether the set is designated to G1, the bytes with the
+	// high bit set, instead of G0.
+	g1 bool
+	// width is the number of bytes of each character of the set.
+	width int
+	// encode appends the encoding of r to dst, and reports whether the set
+	// can represent r.
+	encode func(dst []byte, r rune) ([]byte, bool)
+	// decode returns the character encoded by the width bytes of b, or
+	// utf8.RuneError if they are not a character of the set.
+	decode func(b []byte) rune
+}
+
+// designation is the pair of character sets a Defined Term of the Specific
+// Character Set designates to G0 and G1. Either may be nil.
+type designation struct {
+	g0, g1 *characterSet
+}
+
+var (
+	ascii = &characterSet{escape: []byte{esc, '(', 'B'}, width: 1, encode: encodeASCII, decode: decodeASCII}
+	// JIS X 0201 Romaji differs from ASCII only in the glyphs of 0x5C and
+	// 0x7E, which are decoded as ASCII as well.
+	jisX0201Romaji   = &characterSet{escape: []byte{esc, '(', 'J'}, width: 1, encode: encodeASCII, decode: decodeASCII}
+	jisX0201Katakana = &characterSet{escape: []byte{esc, ')', 'I'}, g1: true, width: 1, encode: encodeKatakana, decode: decodeKatakana}
+	jisX0208         = &characterSet{escape: []byte{esc, '$', 'B'}, width: 2, encode: encodeJISX0208, decode: decodeJISX0208}
+	jisX0212         = &characterSet{escape: []byte{esc, '$', '(', 'D'}, width: 2, encode: encodeJISX0212, decode: decodeJISX0212}
+	ksX1001          = &characterSet{escape: []byte{esc, '$', ')', 'C'}, g1: true, width: 2, encode: encodeEUC(korean.EUCKR), decode: decodeEUC(korean.EUCKR)}
+	gb2312           = &characterSet{escape: []byte{esc, '$', ')', 'A'}, g1: true, width: 2, encode: encodeEUC(simplifiedchinese.GBK), decode: decodeEUC(simplifiedchinese.GBK)}
+
+	// The character sets without code extensions are only used to encode.
+	utf8Set    = &characterSet{encode: encodeUTF8}
+	gb18030Set = &characterSet{encode: encodeWith(simplifiedchinese.GB18030)}
+	gbkSet     = &characterSet{encode: encodeWith(simplifiedchinese.GBK)}
+)
+
+// definedTerms maps the Defined Terms of the Specific Character Set to the
+// character sets they designate, see PS3.3 C.12.1.1.2.
+var definedTerms = map[string]designation{
+	"":                {g0: ascii},
+	"ISO_IR 6":        {g0: ascii},
+	"ISO 2022 IR 6":   {g0: ascii},
+	"ISO_IR 100":      {g0: ascii, g1: latin('A', charmap.ISO8859_1)},
+	"ISO 2022 IR 100": {g0: ascii, g1: latin('A', charmap.ISO8859_1)},
+	"ISO_IR 101":      {g0: ascii, g1: latin('B', charmap.ISO8859_2)},
+	"ISO 2022 IR 101": {g0: ascii, g1: latin('B', charmap.ISO8859_2)},
+	"ISO_IR 109":      {g0: ascii, g1: latin('C', charmap.ISO8859_3)},
+	"ISO 2022 IR 109": {g0: ascii, g1: latin('C', charmap.ISO8859_3)},
+	"ISO_IR 110":      {g0: ascii, g1: latin('D', charmap.ISO8859_4)},
+	"ISO 2022 IR 110": {g0: ascii, g1: latin('D', charmap.ISO8859_4)},
+	"ISO_IR 144":      {g0: ascii, g1: latin('L', charmap.ISO8859_5)},
+	"ISO 2022 IR 144": {g0: ascii, g1: latin('L', charmap.ISO8859_5)},
+	"ISO_IR 127":      {g0: ascii, g1: latin('G', charmap.ISO8859_6)},
+	"ISO 2022 IR 127": {g0: ascii, g1: latin('G', charmap.ISO8859_6)},
+	"ISO_IR 126":      {g0: ascii, g1: latin('F', charmap.ISO8859_7)},
+	"ISO 2022 IR 126": {g0: ascii, g1: latin('F', charmap.ISO8859_7)},
+	"ISO_IR 138":      {g0: ascii, g1: latin('H', charmap.ISO8859_8)},
+	"ISO 2022 IR 138": {g0: ascii, g1: latin('H', charmap.ISO8859_8)},
+	"ISO_IR 148":      {g0: ascii, g1: latin('M', charmap.ISO8859_9)},
+	"ISO 2022 IR 148": {g0: ascii, g1: latin('M', charmap.ISO8859_9)},
+	"ISO_IR 203":      {g0: ascii, g1: latin('b', charmap.ISO8859_15)},
+	"ISO 2022 IR 203": {g0: ascii, g1: latin('b', charmap.ISO8859_15)},
+	// TIS 620-2533 is the upper half of Windows-874, without its additions
+	// in 0x80-0x9F.
+	"ISO_IR 166":      {g0: ascii, g1: latin('T', charmap.Windows874)},
+	"ISO 2022 IR 166": {g0: ascii, g1: latin('T', charmap.Windows874)},
+	"ISO_IR 13":       {g0: jisX0201Romaji, g1: jisX0201Katakana},
+	"ISO 2022 IR 13":  {g0: jisX0201Romaji, g1: jisX0201Katakana},
+	"ISO 2022 IR 87":  {g0: jisX0208},
+	"ISO 2022 IR 159": {g0: jisX0212},
+	"ISO 2022 IR 149": {g1: ksX1001},
+	"ISO 2022 IR 58":  {g1: gb2312},
+	"ISO_IR 192":      {g0: utf8Set},
+	"GB18030":         {g0: gb18030Set},
+	"GBK":             {g0: gbkSet},
+}
+
+// latin returns the G1 character set of the upper half of the single byte
+// character set m, designated by ESC - final.
+func latin(final byte, m *charmap.Charmap) *characterSet {
+	return &characterSet{
+		escape: []byte{esc, '-', final},
+		g1:     true,
+		width:  1,
+		encode: func(dst []byte, r rune) ([]byte, bool) {
+			b, ok := m.EncodeRune(r)
+			if !ok || b < 0xa0 {
+				return dst, false
+			}
+			return append(dst, b), true
+		},
+		decode: func(b []byte) rune {
+			if b[0] < 0xa0 {
+				return utf8.RuneError
+			}
+			return m.DecodeByte(b[0])
+		},
+	}
+}
+
+func encodeASCII(dst []byte, r rune) ([]byte, bool) {
+	if r >= utf8.RuneSelf {
+		return dst, false
+	}
+	return append(dst, byte(r)), true
+}
+
+func encodeUTF8(dst []byte, r rune) ([]byte, bool) {
+	return appendRune(dst, r), true
+}
+
+// encodeKatakana encodes the halfwidth katakana of JIS X 0201.
+func encodeKatakana(dst []byte, r rune) ([]byte, bool) {
+	if r < 0xff61 || r > 0xff9f {
+		return dst, false
+	}
+	return append(dst, byte(r-0xff61+0xa1)), true
+}
+
+// encodeJISX0208 encodes r in JIS X 0208, which EUC-JP encodes as the two
+// bytes with their high bits set.
+func encodeJISX0208(dst []byte, r rune) ([]byte, bool) {
+	b, ok := encodeRune(japanese.EUCJP, r)
+	if !ok || len(b) != 2 || b[0] < 0xa1 || b[1] < 0xa1 {
+		return dst, false
+	}
+	return append(dst, b[0]&0x7f, b[1]&0x7f), true
+}
+
+// encodeJISX0212 encodes r in JIS X 0212, which EUC-JP encodes as the two
+// bytes with their high bits set, following 0x8F.
+func encodeJISX0212(dst []byte, r rune) ([]byte, bool) {
+	b, ok := encodeRune(japanese.EUCJP, r)
+	if !ok || len(b) != 3 || b[0] != 0x8f {
+		return dst, false
+	}
+	return append(dst, b[1]&0x7f, b[2]&0x7f), true
+}
+
+// encodeEUC returns an encode function for the two byte characters of the
+// EUC encoding e, which are the characters of its G1 set. Extensions of e
+// outside of 0xA1-0xFE, like the ones of GBK, are not part of the set.
+func encodeEUC(e encoding.Encoding) func([]byte, rune) ([]byte, bool) {
+	return func(dst []byte, r rune) ([]byte, bool) {
+		b, ok := encodeRune(e, r)
+		if !ok || len(b) != 2 || b[0] < 0xa1 || b[0] > 0xfe || b[1] < 0xa1 || b[1] > 0xfe {
+			return dst, false
+		}
+		return append(dst, b...), true
+	}
+}
+
+// encodeWith returns an encode function for all the characters of e.
+func encodeWith(e encoding.Encoding) func([]byte, rune) ([]byte, bool) {
+	return func(dst []byte, r rune) ([]byte, bool) {
+		b, ok := encodeRune(e, r)
+		if !ok {
+			return dst, false
+		}
+		return append(dst, b...), true
+	}
+}
+
+func encodeRune(e encoding.Encoding, r rune) ([]byte, bool) {
+	if r == utf8.RuneError {
+		return nil, false
+	}
+	var buf [utf8.UTFMax]byte
+	b, err := e.NewEncoder().Bytes(buf[:utf8.EncodeRune(buf[:], r)])
+	return b, err == nil
+}
+
+func decodeASCII(b []byte) rune {
+	if b[0] >= utf8.RuneSelf {
+		return utf8.RuneError
+	}
+	return rune(b[0])
+}
+
+func decodeKatakana(b []byte) rune {
+	if b[0] < 0xa1 || b[0] > 0xdf {
+		return utf8.RuneError
+	}
+	return rune(b[0]-0xa1) + 0xff61
+}
+
+func decodeJISX0208(b []byte) rune {
+	if !isGraphic(b[0]) || !isGraphic(b[1]) {
+		return utf8.RuneError
+	}
+	return decodeRune(japanese.EUCJP, []byte{b[0] | 0x80, b[1] | 0x80})
+}
+
+func decodeJISX0212(b []byte) rune {
+	if !isGraphic(b[0]) || !isGraphic(b[1]) {
+		return utf8.RuneError
+	}
+	return decodeRune(japanese.EUCJP, []byte{0x8f, b[0] | 0x80, b[1] | 0x80})
+}
+
+// decodeEUC returns a decode function for the two byte characters of the
+// EUC encoding e.
+func decodeEUC(e encoding.Encoding) func([]byte) rune {
+	return func(b []byte) rune {
+		if !isGraphic(b[0]&0x7f) || !isGraphic(b[1]&0x7f) || b[0] < 0x80 || b[1] < 0x80 {
+			return utf8.RuneError
+		}
+		return decodeRune(e, b)
+	}
+}
+
+// isGraphic reports whether b is in the 94 character graphic range 0x21-0x7E
+// used by multi-byte character sets.
+func isGraphic(b byte) bool {
+	return b > 0x20 && b < 0x7f
+}
+
+func decodeRune(e encoding.Encoding, b []byte) rune {
+	decoded, err := e.NewDecoder().Bytes(b)
+	if err != nil {
+		return utf8.RuneError
+	}
+	r, size := utf8.DecodeRune(decoded)
+	if size != len(decoded) {
+		return utf8.RuneError
+	}
+	return r
+}
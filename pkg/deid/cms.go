@@ -0,0 +1,195 @@
+package deid
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// ErrorNoRecipient is returned when an Encrypted Content was not encrypted
+// to the certificate of the private key used to decrypt it.
+var ErrorNoRecipient = errors.New("not encrypted to the certificate")
+
+// ErrorUnsupportedEncryption is returned for CMS EnvelopedData using
+// algorithms other than RSA key transport and AES-CBC content encryption.
+var ErrorUnsupportedEncryption = errors.New("unsupported encryption")
+
+// Object identifiers of RFC 5652, RFC 3560 and RFC 3565.
+var (
+	oidData          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 1}
+	oidEnvelopedData = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 7, 3}
+	oidRSAEncryption = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 1}
+	oidRSAESOAEP     = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 7}
+	oidAES128CBC     = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 2}
+	oidAES192CBC     = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 22}
+	oidAES256CBC     = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}
+)
+
+type contentInfo struct {
+	ContentType asn1.ObjectIdentifier
+	Content     asn1.RawValue `asn1:"explicit,tag:0"`
+}
+
+type envelopedData struct {
+	Version              int
+	RecipientInfos       []keyTransRecipientInfo `asn1:"set"`
+	EncryptedContentInfo encryptedContentInfo
+}
+
+type keyTransRecipientInfo struct {
+	Version                int
+	Recipient              issuerAndSerialNumber
+	KeyEncryptionAlgorithm pkix.AlgorithmIdentifier
+	EncryptedKey           []byte
+}
+
+type issuerAndSerialNumber struct {
+	Issuer       asn1.RawValue
+	SerialNumber *big.Int
+}
+
+type encryptedContentInfo struct {
+	ContentType                asn1.ObjectIdentifier
+	ContentEncryptionAlgorithm pkix.AlgorithmIdentifier
+	EncryptedContent           []byte `asn1:"optional,tag:0"`
+}
+
+// envelope returns content encrypted with AES-256-CBC to the RSA key of
+// recipient as a DER encoded CMS EnvelopedData, see RFC 5652 section 6. The
+// content encryption key is transported with RSAES-OAEP.
+func envelope(content []byte, recipient *x509.Certificate) ([]byte, error) {
+	pub, ok := recipient.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("%w: %T recipient key", ErrorUnsupportedEncryption, recipient.PublicKey)
+	}
+	key := make([]byte, 32)
+	iv := make([]byte, aes.BlockSize)
+	for _, b := range [][]byte{key, iv} {
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	padding := aes.BlockSize - len(content)%aes.BlockSize
+	encrypted := append(append([]byte(nil), content...), bytes.Repeat([]byte{byte(padding)}, padding)...)
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(encrypted, encrypted)
+
+	// Empty OAEP parameters select SHA-1 and MGF1 with SHA-1, see RFC 4055.
+	encryptedKey, err := rsa.EncryptOAEP(sha1.New(), rand.Reader, pub, key, nil)
+	if err != nil {
+		return nil, err
+	}
+	ivParam, err := asn1.Marshal(iv)
+	if err != nil {
+		return nil, err
+	}
+	enveloped, err := asn1.Marshal(envelopedData{
+		RecipientInfos: []keyTransRecipientInfo{{
+			Recipient: issuerAndSerialNumber{
+				Issuer:       asn1.RawValue{FullBytes: recipient.RawIssuer},
+				SerialNumber: recipient.SerialNumber,
+			},
+			KeyEncryptionAlgorithm: pkix.AlgorithmIdentifier{Algorithm: oidRSAESOAEP, Parameters: asn1.RawValue{FullBytes: emptySequence}},
+			EncryptedKey:           encryptedKey,
+		}},
+		EncryptedContentInfo: encryptedContentInfo{
+			ContentType:                oidData,
+			ContentEncryptionAlgorithm: pkix.AlgorithmIdentifier{Algorithm: oidAES256CBC, Parameters: asn1.RawValue{FullBytes: ivParam}},
+			EncryptedContent:           encrypted,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(contentInfo{
+		ContentType: oidEnvelopedData,
+		Content:     asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: enveloped},
+	})
+}
+
+// openEnvelope returns the content of the DER encoded CMS EnvelopedData der,
+// decrypted with the RSA private key of cert. It returns ErrorNoRecipient if
+// der was not encrypted to cert.
+func openEnvelope(der []byte, cert *x509.Certificate, key crypto.Decrypter) ([]byte, error) {
+	var info contentInfo
+	if rest, err := asn1.Unmarshal(der, &info); err != nil {
+		return nil, err
+	} else if len(rest) > 0 {
+		return nil, errors.New("trailing data after CMS content info")
+	}
+	if !info.ContentType.Equal(oidEnvelopedData) {
+		return nil, fmt.Errorf("%w: content type %v", ErrorUnsupportedEncryption, info.ContentType)
+	}
+	var enveloped envelopedData
+	if _, err := asn1.Unmarshal(info.Content.Bytes, &enveloped); err != nil {
+		return nil, err
+	}
+
+	var contentKey []byte
+	for _, r := range enveloped.RecipientInfos {
+		if !bytes.Equal(r.Recipient.Issuer.FullBytes, cert.RawIssuer) || r.Recipient.SerialNumber.Cmp(cert.SerialNumber) != 0 {
+			continue
+		}
+		var opts crypto.DecrypterOpts
+		switch alg := r.KeyEncryptionAlgorithm.Algorithm; {
+		case alg.Equal(oidRSAESOAEP):
+			if len(r.KeyEncryptionAlgorithm.Parameters.FullBytes) > 0 && !bytes.Equal(r.KeyEncryptionAlgorithm.Parameters.FullBytes, emptySequence) {
+				return nil, fmt.Errorf("%w: RSAES-OAEP parameters", ErrorUnsupportedEncryption)
+			}
+			opts = &rsa.OAEPOptions{Hash: crypto.SHA1}
+		case alg.Equal(oidRSAEncryption):
+			opts = &rsa.PKCS1v15DecryptOptions{}
+		default:
+			return nil, fmt.Errorf("%w: key encryption algorithm %v", ErrorUnsupportedEncryption, alg)
+		}
+		var err error
+		if contentKey, err = key.Decrypt(rand.Reader, r.EncryptedKey, opts); err != nil {
+			return nil, err
+		}
+		break
+	}
+	if contentKey == nil {
+		return nil, ErrorNoRecipient
+	}
+
+	content := enveloped.EncryptedContentInfo
+	alg := content.ContentEncryptionAlgorithm.Algorithm
+	if !alg.Equal(oidAES128CBC) && !alg.Equal(oidAES192CBC) && !alg.Equal(oidAES256CBC) {
+		return nil, fmt.Errorf("%w: content encryption algorithm %v", ErrorUnsupportedEncryption, alg)
+	}
+	var iv []byte
+	if _, err := asn1.Unmarshal(content.ContentEncryptionAlgorithm.Parameters.FullBytes, &iv); err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(contentKey)
+	if err != nil {
+		return nil, err
+	}
+	encrypted := content.EncryptedContent
+	if len(iv) != aes.BlockSize || len(encrypted) == 0 || len(encrypted)%aes.BlockSize != 0 {
+		return nil, errors.New("malformed AES-CBC encrypted content")
+	}
+	decrypted := make([]byte, len(encrypted))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(decrypted, encrypted)
+	padding := int(decrypted[len(decrypted)-1])
+	if padding == 0 || padding > aes.BlockSize || !bytes.Equal(decrypted[len(decrypted)-padding:], bytes.Repeat([]byte{byte(padding)}, padding)) {
+		return nil, errors.New("malformed AES-CBC padding")
+	}
+	return decrypted[:len(decrypted)-padding], nil
+}
+
+// emptySequence is the DER encoding of the default RSAES-OAEP parameters.
+var emptySequence = []byte{0x30, 0x00}
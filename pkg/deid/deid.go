@@ -0,0 +1,605 @@
+// Package deid de-identifies DICOM Datasets with the Basic Application Level
+// Confidentiality Profile of PS3.15 Annex E and its options.
+//
+//	d := deid.New(deid.RetainLongitudinalTemporal(-42), deid.CleanDescriptors())
+//	for _, ds := range study {
+//		if err := d.Deidentify(&ds); err != nil {
+//			...
+//		}
+//	}
+//
+// A Deidentifier replaces UIDs consistently across all the Datasets it
+// de-identifies, so the references between the instances of a study are
+// preserved. To keep them consistent across Deidentifiers or runs, pass a
+// Remapper with a persistent UIDStore or deterministic hashing to RemapUIDs.
+//
+// For reversible pseudonymization, EncryptOriginals keeps the original values
+// encrypted to an honest broker, who can restore them with Reidentify.
+package deid
+
+import (
+	"crypto/x509"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/ginuerzh/dicom"
+	"github.com/ginuerzh/dicom/pkg/tag"
+)
+
+// Action is an action of PS3.15 Table E.1-1, applied to an attribute.
+// Compound actions like "X/Z" let the application choose depending on the
+// type of the attribute in the IOD. The Deidentifier always chooses the last
+// one, which keeps the Dataset conformant whatever the type.
+type Action string
+
+const (
+	// ActionDummy replaces the value with a non-zero length dummy value
+	// consistent with the VR.
+	ActionDummy Action = "D"
+	// ActionZero replaces the value with a zero length value.
+	ActionZero Action = "Z"
+	// ActionRemove removes the attribute.
+	ActionRemove Action = "X"
+	// ActionKeep keeps the attribute unchanged, or cleans the items of a
+	// sequence.
+	ActionKeep Action = "K"
+	// ActionClean replaces the value with one of similar meaning that does not
+	// contain identifying information.
+	ActionClean Action = "C"
+	// ActionUID replaces the UID with a different one, consistently within
+	// the set of de-identified Datasets.
+	ActionUID Action = "U"
+)
+
+// resolve returns the action to apply for a, which may be compound.
+func (a Action) resolve() Action {
+	if i := strings.LastIndex(string(a), "/"); i >= 0 {
+		return a[i+1:]
+	}
+	return a
+}
+
+// Option represents an option of the Basic Profile that can be passed to
+// New.
+type Option func(*optSet)
+
+// optSet represents the flattened option set after all Options have been applied.
+type optSet struct {
+	safePrivate      map[tag.PrivateTag]bool
+	retainUIDs       bool
+	remapper         *Remapper
+	retainDates      bool
+	dateShift        int
+	cleanDescriptors bool
+	retainDevice     bool
+	pseudonyms       map[tag.Tag]string
+	recipient        *x509.Certificate
+}
+
+// RetainSafePrivate returns an Option that keeps the private attributes in
+// safe, which the caller has established to contain no identifying
+// information (see PS3.15 E.3.10), instead of removing all private
+// attributes. The Element of a PrivateTag is the offset within the block of
+// the private creator.
+func RetainSafePrivate(safe ...tag.PrivateTag) Option {
+	return func(set *optSet) {
+		if set.safePrivate == nil {
+			set.safePrivate = map[tag.PrivateTag]bool{}
+		}
+		for _, t := range safe {
+			set.safePrivate[t] = true
+		}
+	}
+}
+
+// RetainUIDs returns an Option that keeps all UIDs instead of replacing them.
+func RetainUIDs() Option {
+	return func(set *optSet) {
+		set.retainUIDs = true
+	}
+}
+
+// RemapUIDs returns an Option that replaces UIDs with r, instead of with a
+// new Remapper of the Deidentifier. Share r between Deidentifiers to replace
+// UIDs consistently across them.
+func RemapUIDs(r *Remapper) Option {
+	return func(set *optSet) {
+		set.remapper = r
+	}
+}
+
+// RetainLongitudinalTemporal returns an Option that keeps the dates of the
+// Dataset, shifted by days, instead of removing them. This is the Retain
+// Longitudinal Temporal Information with Modified Dates Option. Use the
+// same shift for all the Datasets of a patient to keep the intervals between
+// them. Times are kept unchanged.
+func RetainLongitudinalTemporal(days int) Option {
+	return func(set *optSet) {
+		set.retainDates = true
+		set.dateShift = days
+	}
+}
+
+// CleanDescriptors returns an Option that keeps descriptive text attributes
+// like StudyDescription and SeriesDescription, with the identifying values
+// of the Dataset, like the patient's name and ID, removed from them.
+func CleanDescriptors() Option {
+	return func(set *optSet) {
+		set.cleanDescriptors = true
+	}
+}
+
+// RetainDeviceIdentity returns an Option that keeps the attributes
+// identifying the equipment, like StationName and DeviceSerialNumber.
+func RetainDeviceIdentity() Option {
+	return func(set *optSet) {
+		set.retainDevice = true
+	}
+}
+
+// Deidentifier de-identifies Datasets. It is safe for concurrent use.
+type Deidentifier struct {
+	opts optSet
+}
+
+// New returns a Deidentifier applying the Basic Profile with opts.
+func New(opts ...Option) *Deidentifier {
+	d := &Deidentifier{}
+	for _, opt := range opts {
+		opt(&d.opts)
+	}
+	if d.opts.remapper == nil {
+		d.opts.remapper = NewRemapper()
+	}
+	return d
+}
+
+// Deidentify de-identifies ds in place with a new Deidentifier, see
+// Deidentifier.Deidentify. UIDs are only replaced consistently within ds.
+func Deidentify(ds *dicom.Dataset, opts ...Option) error {
+	return New(opts...).Deidentify(ds)
+}
+
+// Deidentify applies the actions of PS3.15 Table E.1-1 to every attribute
+// of ds, including those nested in sequences, and removes private attributes
+// unless they are retained by RetainSafePrivate. It then sets
+// PatientIdentityRemoved to "YES" and DeidentificationMethodCodeSequence to
+// the codes of the profile and options applied, and finally sets the
+// Pseudonyms and stores the originals of EncryptOriginals.
+func (d *Deidentifier) Deidentify(ds *dicom.Dataset) error {
+	var original dicom.Dataset
+	if d.opts.recipient != nil {
+		original = ds.Clone()
+	}
+
+	creators := map[string]string{}
+	var identifiers []string
+	ds.Walk(func(path dicom.Path, e *dicom.Element) dicom.WalkAction {
+		if tag.IsPrivateCreator(e.Tag) {
+			creators[path.String()] = firstString(e)
+		}
+		if r, ok := findRule(e.Tag); ok && r.options&cleanDescriptor == 0 {
+			identifiers = append(identifiers, identifyingValues(e)...)
+		}
+		return dicom.WalkContinue
+	})
+
+	a := &applier{Deidentifier: d, creators: creators, identifiers: sortIdentifiers(identifiers), retainedBlocks: map[string]bool{}}
+	ds.Walk(a.apply)
+	if a.err != nil {
+		return a.err
+	}
+
+	// Remove the private creators of the blocks without retained attributes.
+	ds.Walk(func(path dicom.Path, e *dicom.Element) dicom.WalkAction {
+		if tag.IsPrivateCreator(e.Tag) && !a.retainedBlocks[path.String()] {
+			return dicom.WalkDelete
+		}
+		return dicom.WalkContinue
+	})
+	if err := d.setPseudonyms(ds); err != nil {
+		return err
+	}
+	if err := d.setMethod(ds, a.shifted); err != nil {
+		return err
+	}
+	if d.opts.recipient != nil {
+		return d.encryptOriginals(ds, &original)
+	}
+	return nil
+}
+
+var patientBirthDate = tag.Tag{Group: 0x0010, Element: 0x0030}
+
+// applier applies the actions to the elements of one Dataset.
+type applier struct {
+	*Deidentifier
+	// creators are the private creators by the path of their element.
+	creators    map[string]string
+	identifiers []string
+	// retainedBlocks are the paths of the private creators of the retained
+	// private attributes.
+	retainedBlocks map[string]bool
+	shifted        bool
+	err            error
+}
+
+func (a *applier) apply(path dicom.Path, e *dicom.Element) dicom.WalkAction {
+	if tag.IsPrivate(e.Tag.Group) {
+		return a.applyPrivate(path, e)
+	}
+	r, ok := findRule(e.Tag)
+	if !ok {
+		return dicom.WalkContinue
+	}
+	vr := elementVR(e)
+	action := r.action.resolve()
+	switch {
+	case action == ActionUID && a.opts.retainUIDs,
+		r.options&retainDevice != 0 && a.opts.retainDevice:
+		action = ActionKeep
+	case r.options&cleanDescriptor != 0 && a.opts.cleanDescriptors:
+		action = ActionClean
+	case (vr == "DA" || vr == "DT") && a.opts.retainDates && e.Tag != patientBirthDate:
+		// The birth date is a patient characteristic, not a temporal one.
+		action = ActionClean
+	case vr == "TM" && a.opts.retainDates:
+		action = ActionKeep
+	}
+
+	var replacement *dicom.Element
+	var err error
+	switch action {
+	case ActionRemove:
+		return dicom.WalkDelete
+	case ActionKeep:
+		return dicom.WalkContinue
+	case ActionZero:
+		replacement, err = dicom.NewElementWithVR(e.Tag, vr, emptyValue(vr))
+	case ActionDummy:
+		if vr == "SQ" {
+			return dicom.WalkContinue
+		}
+		if vr == "UI" {
+			return a.replaceUIDs(e)
+		}
+		replacement, err = dicom.NewElementWithVR(e.Tag, vr, dummyValue(vr))
+	case ActionUID:
+		if vr == "SQ" {
+			// The UIDs in the items are replaced by their own rules.
+			return dicom.WalkContinue
+		}
+		return a.replaceUIDs(e)
+	case ActionClean:
+		replacement, err = a.clean(e, vr)
+	}
+	if err != nil {
+		a.err = fmt.Errorf("deid: %s %v: %w", r.name, e.Tag, err)
+		return dicom.WalkStop
+	}
+	if replacement == nil {
+		return dicom.WalkDelete
+	}
+	*e = *replacement
+	if action == ActionZero && vr == "SQ" {
+		return dicom.WalkSkipChildren
+	}
+	return dicom.WalkContinue
+}
+
+// applyPrivate removes private attributes, except for the private creators
+// and the attributes retained by RetainSafePrivate.
+func (a *applier) applyPrivate(path dicom.Path, e *dicom.Element) dicom.WalkAction {
+	if tag.IsPrivateCreator(e.Tag) {
+		return dicom.WalkContinue
+	}
+	creatorPath := dicom.Path{Items: path.Items, Tag: tag.PrivateCreatorTag(e.Tag)}.String()
+	creator, ok := a.creators[creatorPath]
+	if ok && e.Tag.Element >= 0x1000 &&
+		a.opts.safePrivate[tag.PrivateTag{Creator: creator, Group: e.Tag.Group, Element: uint8(e.Tag.Element)}] {
+		a.retainedBlocks[creatorPath] = true
+		return dicom.WalkContinue
+	}
+	return dicom.WalkDelete
+}
+
+// replaceUIDs replaces every value of the UI element e.
+func (a *applier) replaceUIDs(e *dicom.Element) dicom.WalkAction {
+	if _, ok := e.Value.GetValue().([]string); !ok {
+		return dicom.WalkDelete
+	}
+	if err := a.opts.remapper.apply(e); err != nil {
+		a.err = fmt.Errorf("deid: %v: %w", e.Tag, err)
+		return dicom.WalkStop
+	}
+	return dicom.WalkContinue
+}
+
+// clean returns e with its dates shifted or its text cleaned of identifying
+// values. It returns nil if e cannot be cleaned and must be removed.
+func (a *applier) clean(e *dicom.Element, vr string) (*dicom.Element, error) {
+	values, ok := e.Value.GetValue().([]string)
+	if !ok {
+		// Sequences are cleaned item by item.
+		return e, nil
+	}
+	cleaned := make([]string, len(values))
+	for i, v := range values {
+		v = strings.TrimRight(v, " \x00")
+		switch vr {
+		case "DA", "DT":
+			if v == "" {
+				break
+			}
+			if len(v) < 8 {
+				return nil, nil
+			}
+			t, err := time.Parse("20060102", v[:8])
+			if err != nil {
+				return nil, nil
+			}
+			v = t.AddDate(0, 0, a.opts.dateShift).Format("20060102") + v[8:]
+			a.shifted = true
+		default:
+			v = cleanText(v, a.identifiers)
+		}
+		cleaned[i] = v
+	}
+	return dicom.NewElementWithVR(e.Tag, vr, cleaned)
+}
+
+// identifyingValues returns the values of the PN, LO and SH element e, and
+// the components of its person names.
+func identifyingValues(e *dicom.Element) []string {
+	vr := elementVR(e)
+	if vr != "PN" && vr != "LO" && vr != "SH" {
+		return nil
+	}
+	values, _ := e.Value.GetValue().([]string)
+	var out []string
+	for _, v := range values {
+		out = append(out, strings.TrimSpace(v))
+		if vr == "PN" {
+			out = append(out, strings.FieldsFunc(v, func(r rune) bool {
+				return r == '^' || r == '='
+			})...)
+		}
+	}
+	return out
+}
+
+// sortIdentifiers returns the distinct identifiers of at least two
+// characters, longest first so that full names are removed before their
+// components.
+func sortIdentifiers(identifiers []string) []string {
+	seen := map[string]bool{}
+	var out []string
+	for _, s := range identifiers {
+		s = strings.ToUpper(strings.TrimSpace(s))
+		if len(s) >= 2 && !seen[s] {
+			seen[s] = true
+			out = append(out, s)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if len(out[i]) != len(out[j]) {
+			return len(out[i]) > len(out[j])
+		}
+		return out[i] < out[j]
+	})
+	return out
+}
+
+// cleanText removes identifiers from s, ignoring case. Only whole words are
+// removed, so that a short name component does not cut longer words apart.
+func cleanText(s string, identifiers []string) string {
+	words, seps := splitWords(s)
+	removed := make([]bool, len(words))
+	for _, id := range identifiers {
+		idWords, _ := splitWords(id)
+		if len(idWords) == 0 {
+			continue
+		}
+		for i := 0; i+len(idWords) <= len(words); i++ {
+			if matchWords(words[i:i+len(idWords)], removed[i:i+len(idWords)], idWords) {
+				for j := range idWords {
+					removed[i+j] = true
+				}
+				i += len(idWords) - 1
+			}
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString(seps[0])
+	for i, w := range words {
+		if !removed[i] {
+			b.WriteString(w)
+		}
+		// The separators within a removed identifier are removed with it.
+		if !removed[i] || i+1 == len(words) || !removed[i+1] {
+			b.WriteString(seps[i+1])
+		}
+	}
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+// splitWords splits s into its words, the runs of letters and digits, and
+// the separators around them. seps has one more entry than words: seps[i]
+// precedes words[i], and the last entry follows the last word.
+func splitWords(s string) (words, seps []string) {
+	start, inWord := 0, false
+	for i, r := range s {
+		if isWordRune(r) == inWord {
+			continue
+		}
+		if inWord {
+			words = append(words, s[start:i])
+		} else {
+			seps = append(seps, s[start:i])
+		}
+		start, inWord = i, !inWord
+	}
+	if inWord {
+		words = append(words, s[start:])
+		seps = append(seps, "")
+	} else {
+		seps = append(seps, s[start:])
+	}
+	return words, seps
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// matchWords reports whether words, none of which is removed yet, are equal
+// to want, ignoring case.
+func matchWords(words []string, removed []bool, want []string) bool {
+	for i, w := range want {
+		if removed[i] || !strings.EqualFold(words[i], w) {
+			return false
+		}
+	}
+	return true
+}
+
+// Codes of CID 7050 De-identification Method.
+var (
+	codeBasicProfile      = methodCode{"113100", "Basic Application Confidentiality Profile"}
+	codeCleanDescriptors  = methodCode{"113105", "Clean Descriptors Option"}
+	codeModifiedDates     = methodCode{"113107", "Retain Longitudinal Temporal Information Modified Dates Option"}
+	codeRetainDevice      = methodCode{"113109", "Retain Device Identity Option"}
+	codeRetainUIDs        = methodCode{"113110", "Retain UIDs Option"}
+	codeRetainSafePrivate = methodCode{"113111", "Retain Safe Private Option"}
+)
+
+type methodCode struct {
+	value, meaning string
+}
+
+// setMethod records the de-identification in ds.
+func (d *Deidentifier) setMethod(ds *dicom.Dataset, shifted bool) error {
+	codes := []methodCode{codeBasicProfile}
+	if d.opts.safePrivate != nil {
+		codes = append(codes, codeRetainSafePrivate)
+	}
+	if d.opts.retainUIDs {
+		codes = append(codes, codeRetainUIDs)
+	}
+	if d.opts.retainDates {
+		codes = append(codes, codeModifiedDates)
+	}
+	if d.opts.cleanDescriptors {
+		codes = append(codes, codeCleanDescriptors)
+	}
+	if d.opts.retainDevice {
+		codes = append(codes, codeRetainDevice)
+	}
+
+	items := make([][]*dicom.Element, len(codes))
+	for i, c := range codes {
+		item, err := newElements(
+			tag.CodeValue, []string{c.value},
+			tag.CodingSchemeDesignator, []string{"DCM"},
+			tag.CodeMeaning, []string{c.meaning},
+		)
+		if err != nil {
+			return err
+		}
+		items[i] = item
+	}
+	elems, err := newElements(
+		tag.PatientIdentityRemoved, []string{"YES"},
+		tag.DeidentificationMethodCodeSequence, items,
+	)
+	if err != nil {
+		return err
+	}
+	if shifted {
+		modified, err := dicom.NewElement(tag.LongitudinalTemporalInformationModified, []string{"MODIFIED"})
+		if err != nil {
+			return err
+		}
+		elems = append(elems, modified)
+	}
+	ds.Update(elems...)
+	return nil
+}
+
+// newElements creates elements from pairs of tags and data.
+func newElements(pairs ...interface{}) ([]*dicom.Element, error) {
+	var elems []*dicom.Element
+	for i := 0; i < len(pairs); i += 2 {
+		e, err := dicom.NewElement(pairs[i].(tag.Tag), pairs[i+1])
+		if err != nil {
+			return nil, fmt.Errorf("deid: %w", err)
+		}
+		elems = append(elems, e)
+	}
+	return elems, nil
+}
+
+// elementVR returns the VR of e, or the dictionary VR of its tag.
+func elementVR(e *dicom.Element) string {
+	if e.RawValueRepresentation != "" {
+		return e.RawValueRepresentation
+	}
+	if info, err := tag.Find(e.Tag); err == nil {
+		return info.VR
+	}
+	return "UN"
+}
+
+func firstString(e *dicom.Element) string {
+	values, ok := e.Value.GetValue().([]string)
+	if !ok || len(values) == 0 {
+		return ""
+	}
+	return strings.TrimRight(values[0], " \x00")
+}
+
+// emptyValue returns the zero length value of vr.
+func emptyValue(vr string) interface{} {
+	switch vr {
+	case "SQ":
+		return [][]*dicom.Element{}
+	case "SL", "SS", "SV":
+		return []int64{}
+	case "AT", "OL", "OV", "UL", "US", "UV":
+		return []uint64{}
+	case "FL", "FD", "OD", "OF":
+		return []float64{}
+	case "OB", "OW", "UN":
+		return []byte{}
+	}
+	return []string{""}
+}
+
+// dummyValue returns a non-zero length dummy value of vr.
+func dummyValue(vr string) interface{} {
+	switch vr {
+	case "DA":
+		return []string{"19000101"}
+	case "TM":
+		return []string{"000000"}
+	case "DT":
+		return []string{"19000101000000"}
+	case "AS":
+		return []string{"000D"}
+	case "IS", "DS":
+		return []string{"0"}
+	case "SL", "SS", "SV":
+		return []int64{0}
+	case "AT", "OL", "OV", "UL", "US", "UV":
+		return []uint64{0}
+	case "FL", "FD", "OD", "OF":
+		return []float64{0}
+	case "OB", "OW", "UN":
+		return []byte{0, 0}
+	}
+	return []string{"ANONYMIZED"}
+}
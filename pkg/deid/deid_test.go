@@ -0,0 +1,252 @@
+package deid
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ginuerzh/dicom"
+	"github.com/ginuerzh/dicom/pkg/tag"
+	"github.com/google/go-cmp/cmp"
+)
+
+var (
+	patientName       = tag.Tag{Group: 0x0010, Element: 0x0010}
+	patientID         = tag.Tag{Group: 0x0010, Element: 0x0020}
+	otherPatientIDs   = tag.Tag{Group: 0x0010, Element: 0x1000}
+	studyDate         = tag.Tag{Group: 0x0008, Element: 0x0020}
+	seriesDate        = tag.Tag{Group: 0x0008, Element: 0x0021}
+	studyDescription  = tag.Tag{Group: 0x0008, Element: 0x1030}
+	stationName       = tag.Tag{Group: 0x0008, Element: 0x1010}
+	operatorsName     = tag.Tag{Group: 0x0008, Element: 0x1070}
+	studyInstanceUID  = tag.Tag{Group: 0x0020, Element: 0x000D}
+	referencedImages  = tag.Tag{Group: 0x0008, Element: 0x1140}
+	referencedSOPUID  = tag.Tag{Group: 0x0008, Element: 0x1155}
+	privateCreator    = tag.Tag{Group: 0x0009, Element: 0x0010}
+	privateSecret     = tag.Tag{Group: 0x0009, Element: 0x1001}
+	privateSafe       = tag.Tag{Group: 0x0009, Element: 0x1002}
+	unlistedSequence  = tag.Tag{Group: 0x0046, Element: 0x0102}
+	curveData         = tag.Tag{Group: 0x5000, Element: 0x3000}
+	deidentifiedCodes = tag.DeidentificationMethodCodeSequence
+)
+
+func newDataset(t *testing.T, sopInstanceUID, referencedUID string) dicom.Dataset {
+	t.Helper()
+	elem := func(tg tag.Tag, vr string, data interface{}) *dicom.Element {
+		e, err := dicom.NewElementWithVR(tg, vr, data)
+		if err != nil {
+			t.Fatalf("NewElementWithVR(%v): %v", tg, err)
+		}
+		return e
+	}
+	return dicom.Dataset{Elements: []*dicom.Element{
+		elem(tag.MediaStorageSOPInstanceUID, "UI", []string{sopInstanceUID}),
+		elem(tag.SOPInstanceUID, "UI", []string{sopInstanceUID}),
+		elem(studyDate, "DA", []string{"20200115"}),
+		elem(seriesDate, "DA", []string{"20200115"}),
+		elem(tag.StudyTime, "TM", []string{"142400"}),
+		elem(tag.SeriesTime, "TM", []string{"142500"}),
+		elem(tag.Modality, "CS", []string{"CT"}),
+		elem(referencedImages, "SQ", [][]*dicom.Element{
+			{elem(referencedSOPUID, "UI", []string{referencedUID})},
+		}),
+		elem(stationName, "SH", []string{"CT01"}),
+		elem(studyDescription, "LO", []string{"CT head for John Doe"}),
+		elem(operatorsName, "PN", []string{"Smith"}),
+		elem(privateCreator, "LO", []string{"ACME 1.0"}),
+		elem(privateSecret, "LO", []string{"secret"}),
+		elem(privateSafe, "DS", []string{"1.5"}),
+		elem(patientName, "PN", []string{"Doe^John"}),
+		elem(patientID, "LO", []string{"12345"}),
+		elem(tag.Tag{Group: 0x0010, Element: 0x0030}, "DA", []string{"19800101"}),
+		elem(otherPatientIDs, "LO", []string{"54321"}),
+		elem(studyInstanceUID, "UI", []string{"1.2.3.4"}),
+		elem(unlistedSequence, "SQ", [][]*dicom.Element{
+			{elem(patientID, "LO", []string{"12345"})},
+		}),
+		elem(curveData, "OW", []byte{1, 2}),
+	}}
+}
+
+// values returns the values of the elements of ds by path, leaving out the
+// items of the sequences in skip.
+func values(ds *dicom.Dataset, skip ...tag.Tag) map[string]string {
+	out := map[string]string{}
+	ds.Walk(func(path dicom.Path, e *dicom.Element) dicom.WalkAction {
+		for _, t := range skip {
+			if e.Tag == t {
+				return dicom.WalkSkipChildren
+			}
+		}
+		if e.Value.ValueType() != dicom.Sequences {
+			out[path.String()] = e.Value.String()
+		} else {
+			out[path.String()] = "<sequence>"
+		}
+		return dicom.WalkContinue
+	})
+	return out
+}
+
+func TestDeidentify(t *testing.T) {
+	cases := []struct {
+		name  string
+		opts  []Option
+		want  map[string]string
+		codes []string
+	}{
+		{
+			name: "basic profile",
+			want: map[string]string{
+				"(0002,0003)":                "<uid>",
+				"(0008,0018)":                "<uid>",
+				"(0008,0020)":                "[]",
+				"(0008,0021)":                "[19000101]",
+				"(0008,0030)":                "[]",
+				"(0008,0031)":                "[000000]",
+				"(0008,0060)":                "[CT]",
+				"(0008,1010)":                "[ANONYMIZED]",
+				"(0008,1070)":                "[ANONYMIZED]",
+				"(0008,1140)":                "<sequence>",
+				"(0008,1140)[0].(0008,1155)": "<uid>",
+				"(0010,0010)":                "[]",
+				"(0010,0020)":                "[]",
+				"(0010,0030)":                "[]",
+				"(0012,0062)":                "[YES]",
+				"(0020,000d)":                "<uid>",
+				"(0046,0102)":                "<sequence>",
+				"(0046,0102)[0].(0010,0020)": "[]",
+			},
+			codes: []string{"113100"},
+		},
+		{
+			name: "all options",
+			opts: []Option{
+				RetainSafePrivate(tag.PrivateTag{Creator: "ACME 1.0", Group: 0x0009, Element: 0x02}),
+				RetainUIDs(),
+				RetainLongitudinalTemporal(-10),
+				CleanDescriptors(),
+				RetainDeviceIdentity(),
+			},
+			want: map[string]string{
+				"(0002,0003)":                "[1.2.3.5]",
+				"(0008,0018)":                "[1.2.3.5]",
+				"(0008,0020)":                "[20200105]",
+				"(0008,0021)":                "[20200105]",
+				"(0008,0030)":                "[142400]",
+				"(0008,0031)":                "[142500]",
+				"(0008,0060)":                "[CT]",
+				"(0008,1010)":                "[CT01]",
+				"(0008,1030)":                "[CT head for]",
+				"(0008,1070)":                "[ANONYMIZED]",
+				"(0008,1140)":                "<sequence>",
+				"(0008,1140)[0].(0008,1155)": "[1.2.3.6]",
+				"(0009,0010)":                "[ACME 1.0]",
+				"(0009,1002)":                "[1.5]",
+				"(0010,0010)":                "[]",
+				"(0010,0020)":                "[]",
+				"(0010,0030)":                "[]",
+				"(0012,0062)":                "[YES]",
+				"(0020,000d)":                "[1.2.3.4]",
+				"(0028,0303)":                "[MODIFIED]",
+				"(0046,0102)":                "<sequence>",
+				"(0046,0102)[0].(0010,0020)": "[]",
+			},
+			codes: []string{"113100", "113111", "113110", "113107", "113105", "113109"},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ds := newDataset(t, "1.2.3.5", "1.2.3.6")
+			if err := Deidentify(&ds, tc.opts...); err != nil {
+				t.Fatalf("Deidentify: %v", err)
+			}
+			got := values(&ds, deidentifiedCodes)
+			for path, v := range got {
+				if strings.HasPrefix(v, "[2.25.") {
+					got[path] = "<uid>"
+				}
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Deidentify: unexpected elements (-want +got):\n%s", diff)
+			}
+
+			codes, err := ds.FindElementByTag(deidentifiedCodes)
+			if err != nil {
+				t.Fatalf("FindElementByTag(DeidentificationMethodCodeSequence): %v", err)
+			}
+			var gotCodes []string
+			for _, item := range codes.Value.GetValue().([]*dicom.SequenceItemValue) {
+				code, err := item.FindElementByTag(tag.CodeValue)
+				if err != nil {
+					t.Fatalf("FindElementByTag(CodeValue): %v", err)
+				}
+				gotCodes = append(gotCodes, code.Value.GetValue().([]string)[0])
+			}
+			if diff := cmp.Diff(tc.codes, gotCodes); diff != "" {
+				t.Errorf("Deidentify: unexpected method codes (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDeidentifier_ConsistentUIDs(t *testing.T) {
+	a := newDataset(t, "1.2.3.5", "1.2.3.6")
+	b := newDataset(t, "1.2.3.6", "1.2.3.5")
+	d := New()
+	for _, ds := range []*dicom.Dataset{&a, &b} {
+		if err := d.Deidentify(ds); err != nil {
+			t.Fatalf("Deidentify: %v", err)
+		}
+	}
+	aValues, bValues := values(&a), values(&b)
+	if aValues["(0008,0018)"] != bValues["(0008,1140)[0].(0008,1155)"] ||
+		bValues["(0008,0018)"] != aValues["(0008,1140)[0].(0008,1155)"] {
+		t.Errorf("Deidentify: references between datasets were not preserved: %v, %v", aValues, bValues)
+	}
+	if aValues["(0002,0003)"] != aValues["(0008,0018)"] || aValues["(0020,000d)"] != bValues["(0020,000d)"] {
+		t.Errorf("Deidentify: UIDs were not replaced consistently: %v, %v", aValues, bValues)
+	}
+	if aValues["(0008,0018)"] == bValues["(0008,0018)"] {
+		t.Errorf("Deidentify: different UIDs were replaced with the same one")
+	}
+}
+
+func TestDeidentify_RetainLongitudinalTemporal(t *testing.T) {
+	ds := newDataset(t, "1.2.3.5", "1.2.3.6")
+	if err := Deidentify(&ds, RetainLongitudinalTemporal(3)); err != nil {
+		t.Fatalf("Deidentify: %v", err)
+	}
+	got := values(&ds)
+	for path, want := range map[string]string{
+		"(0008,0020)": "[20200118]",
+		"(0008,0021)": "[20200118]",
+		"(0008,0030)": "[142400]",
+		"(0008,0031)": "[142500]",
+	} {
+		if got[path] != want {
+			t.Errorf("Deidentify: got %s %s, want %s", path, got[path], want)
+		}
+	}
+}
+
+func TestCleanText(t *testing.T) {
+	cases := []struct {
+		name        string
+		s           string
+		identifiers []string
+		want        string
+	}{
+		{name: "full name", s: "CT head for John Doe", identifiers: sortIdentifiers([]string{"Doe^John", "Doe", "John"}), want: "CT head for"},
+		{name: "name in other case", s: "follow-up of DOE^JOHN, 2nd", identifiers: sortIdentifiers([]string{"Doe^John", "Doe", "John"}), want: "follow-up of , 2nd"},
+		{name: "short name component", s: "CLINICAL ABDOMEN LI", identifiers: sortIdentifiers([]string{"LI^NA", "LI", "NA"}), want: "CLINICAL ABDOMEN"},
+		{name: "non-ASCII text", s: "ɐɐɐɐAB AB", identifiers: []string{"AB"}, want: "ɐɐɐɐAB"},
+		{name: "non-ASCII name", s: "Befund Müller JÖRG", identifiers: sortIdentifiers([]string{"Müller^Jörg", "Müller", "Jörg"}), want: "Befund"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := cleanText(tc.s, tc.identifiers); got != tc.want {
+				t.Errorf("cleanText(%q, %q): got %q, want %q", tc.s, tc.identifiers, got, tc.want)
+			}
+		})
+	}
+}
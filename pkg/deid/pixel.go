@@ -0,0 +1,221 @@
+package deid
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/jpeg"
+	"strings"
+
+	"github.com/ginuerzh/dicom"
+	"github.com/ginuerzh/dicom/pkg/frame"
+	"github.com/ginuerzh/dicom/pkg/tag"
+	"github.com/ginuerzh/dicom/pkg/uid"
+)
+
+// ErrorUnsupportedPixelData is returned when the pixel data cannot be
+// decoded to blank regions of it, e.g. because it is compressed with a
+// transfer syntax other than JPEG Baseline.
+var ErrorUnsupportedPixelData = errors.New("unsupported pixel data")
+
+// jpegQuality is the quality encapsulated JPEG Baseline frames are encoded
+// with after blanking.
+const jpegQuality = 95
+
+// PixelRule blanks Regions of the images it matches, like the signatures of
+// the DicomPixelAnonymizer scripts of the RSNA CTP. The empty values of
+// Modality, Manufacturer and ModelName and the zero values of Rows and
+// Columns match any image.
+type PixelRule struct {
+	// Modality matches the Modality of the image, ignoring case.
+	Modality string
+	// Manufacturer matches the images whose Manufacturer contains it,
+	// ignoring case.
+	Manufacturer string
+	// ModelName matches the images whose ManufacturerModelName contains it,
+	// ignoring case.
+	ModelName string
+	// Rows and Columns match the size of the image.
+	Rows, Columns int
+	// Regions are the rectangles to blank, in pixels from the top left
+	// corner of the image.
+	Regions []image.Rectangle
+}
+
+// Matches reports whether r applies to the image of ds.
+func (r PixelRule) Matches(ds *dicom.Dataset) bool {
+	contains := func(t tag.Tag, s string) bool {
+		return strings.Contains(strings.ToUpper(findString(ds, t)), strings.ToUpper(s))
+	}
+	return (r.Modality == "" || strings.EqualFold(findString(ds, tag.Modality), r.Modality)) &&
+		(r.Manufacturer == "" || contains(tag.Manufacturer, r.Manufacturer)) &&
+		(r.ModelName == "" || contains(tag.ManufacturerModelName, r.ModelName)) &&
+		(r.Rows == 0 || findInt(ds, tag.Rows) == r.Rows) &&
+		(r.Columns == 0 || findInt(ds, tag.Columns) == r.Columns)
+}
+
+// BlankPixels blanks the Regions of all the rules matching ds with
+// BlankRegions. It reports whether any rule matched; ds is left unchanged
+// if none did.
+func BlankPixels(ds *dicom.Dataset, rules []PixelRule) (bool, error) {
+	var regions []image.Rectangle
+	matched := false
+	for _, r := range rules {
+		if r.Matches(ds) {
+			matched = true
+			regions = append(regions, r.Regions...)
+		}
+	}
+	if !matched {
+		return false, nil
+	}
+	return true, BlankRegions(ds, regions)
+}
+
+// BlankRegions sets the pixels of regions to zero on every frame of the
+// PixelData of ds, and sets BurnedInAnnotation to "NO". Encapsulated frames
+// are decoded and encoded again, which is only supported for the JPEG
+// Baseline transfer syntax. Regions are
+// clipped to the image.
+func BlankRegions(ds *dicom.Dataset, regions []image.Rectangle) error {
+	e, err := ds.FindElementByTag(tag.PixelData)
+	if err != nil {
+		return err
+	}
+	info, ok := e.Value.GetValue().(dicom.PixelDataInfo)
+	if !ok {
+		return fmt.Errorf("%w: PixelData is not parsed", ErrorUnsupportedPixelData)
+	}
+	blanked := dicom.PixelDataInfo{IsEncapsulated: info.IsEncapsulated, Offsets: info.Offsets}
+	var isColor bool
+	for i, f := range info.Frames {
+		if info.IsEncapsulated {
+			if ts := findString(ds, tag.TransferSyntaxUID); ts != uid.JPEGBaseline8Bit {
+				return fmt.Errorf("%w: transfer syntax %s", ErrorUnsupportedPixelData, uid.UIDString(ts))
+			}
+			f, isColor, err = blankEncapsulated(f, regions)
+			// The offsets of the frames change with their lengths.
+			blanked.Offsets = nil
+		} else {
+			f, err = blankNative(f, findInt(ds, tag.PlanarConfiguration), regions)
+		}
+		if err != nil {
+			return fmt.Errorf("deid: blanking frame %d: %w", i, err)
+		}
+		blanked.Frames = append(blanked.Frames, f)
+	}
+	if e.Value, err = dicom.NewValue(blanked); err != nil {
+		return err
+	}
+
+	pairs := []interface{}{tag.BurnedInAnnotation, []string{"NO"}}
+	if isColor {
+		// The JPEG encoder converts color images to YCbCr.
+		pairs = append(pairs, tag.PhotometricInterpretation, []string{"YBR_FULL_422"})
+	}
+	elems, err := newElements(pairs...)
+	if err != nil {
+		return err
+	}
+	ds.Update(elems...)
+	return nil
+}
+
+// blankNative returns a copy of the native frame f with regions set to zero.
+// Color samples are stored by pixel or, if planarConfiguration is 1, by
+// plane.
+func blankNative(f frame.Frame, planarConfiguration int, regions []image.Rectangle) (frame.Frame, error) {
+	n := f.NativeData
+	if n.BitsPerSample%8 != 0 || n.SamplesPerPixel < 1 {
+		return f, fmt.Errorf("%w: %d bits allocated", ErrorUnsupportedPixelData, n.BitsPerSample)
+	}
+	bytesPerSample := n.BitsPerSample / 8
+	if len(n.Data) < n.Rows*n.Cols*n.SamplesPerPixel*bytesPerSample {
+		return f, fmt.Errorf("%w: frame has %d bytes", ErrorUnsupportedPixelData, len(n.Data))
+	}
+	n.Data = append([]byte(nil), n.Data...)
+	bounds := image.Rect(0, 0, n.Cols, n.Rows)
+	for _, r := range regions {
+		r = r.Intersect(bounds)
+		for y := r.Min.Y; y < r.Max.Y; y++ {
+			for x := r.Min.X; x < r.Max.X; x++ {
+				for s := 0; s < n.SamplesPerPixel; s++ {
+					i := (y*n.Cols+x)*n.SamplesPerPixel + s
+					if planarConfiguration == 1 {
+						i = s*n.Rows*n.Cols + y*n.Cols + x
+					}
+					zero(n.Data[i*bytesPerSample : (i+1)*bytesPerSample])
+				}
+			}
+		}
+	}
+	f.NativeData = n
+	return f, nil
+}
+
+// blankEncapsulated returns the JPEG Baseline frame f with regions set to
+// black, and whether it is a color image.
+func blankEncapsulated(f frame.Frame, regions []image.Rectangle) (frame.Frame, bool, error) {
+	src, err := jpeg.Decode(bytes.NewReader(f.EncapsulatedData.Data))
+	if err != nil {
+		return f, false, fmt.Errorf("%w: %v", ErrorUnsupportedPixelData, err)
+	}
+	var dst draw.Image
+	gray, isGray := src.(*image.Gray)
+	if isGray {
+		dst = gray
+	} else {
+		dst = image.NewRGBA(src.Bounds())
+		draw.Draw(dst, dst.Bounds(), src, src.Bounds().Min, draw.Src)
+	}
+	black := image.NewUniform(color.Black)
+	for _, r := range regions {
+		r = r.Add(dst.Bounds().Min).Intersect(dst.Bounds())
+		draw.Draw(dst, r, black, image.Point{}, draw.Src)
+	}
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return f, false, err
+	}
+	f.EncapsulatedData = frame.EncapsulatedFrame{Data: buf.Bytes()}
+	return f, !isGray, nil
+}
+
+func zero(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
+
+// findString returns the first value of the string element t of ds, or ""
+// if there is none.
+func findString(ds *dicom.Dataset, t tag.Tag) string {
+	e, err := ds.FindElementByTag(t)
+	if err != nil {
+		return ""
+	}
+	return firstString(e)
+}
+
+// findInt returns the first value of the integer element t of ds, or 0 if
+// there is none.
+func findInt(ds *dicom.Dataset, t tag.Tag) int {
+	e, err := ds.FindElementByTag(t)
+	if err != nil {
+		return 0
+	}
+	switch v := e.Value.GetValue().(type) {
+	case []uint64:
+		if len(v) > 0 {
+			return int(v[0])
+		}
+	case []int64:
+		if len(v) > 0 {
+			return int(v[0])
+		}
+	}
+	return 0
+}
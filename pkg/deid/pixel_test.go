@@ -0,0 +1,199 @@
+package deid
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"testing"
+
+	"github.com/ginuerzh/dicom"
+	"github.com/ginuerzh/dicom/pkg/frame"
+	"github.com/ginuerzh/dicom/pkg/tag"
+	"github.com/ginuerzh/dicom/pkg/uid"
+	"github.com/google/go-cmp/cmp"
+)
+
+// newImage returns a Dataset with the image attributes and pixel data info.
+func newImage(t *testing.T, transferSyntax string, rows, cols, planar int, info dicom.PixelDataInfo) dicom.Dataset {
+	t.Helper()
+	var elems []*dicom.Element
+	for _, p := range []struct {
+		tag  tag.Tag
+		vr   string
+		data interface{}
+	}{
+		{tag.TransferSyntaxUID, "UI", []string{transferSyntax}},
+		{tag.Modality, "CS", []string{"US"}},
+		{tag.Manufacturer, "LO", []string{"ACME Medical"}},
+		{tag.ManufacturerModelName, "LO", []string{"Sono 3000"}},
+		{tag.PlanarConfiguration, "US", []uint64{uint64(planar)}},
+		{tag.Rows, "US", []uint64{uint64(rows)}},
+		{tag.Columns, "US", []uint64{uint64(cols)}},
+		{tag.BurnedInAnnotation, "CS", []string{"YES"}},
+		{tag.PixelData, "OW", info},
+	} {
+		e, err := dicom.NewElementWithVR(p.tag, p.vr, p.data)
+		if err != nil {
+			t.Fatalf("NewElementWithVR(%v): %v", p.tag, err)
+		}
+		elems = append(elems, e)
+	}
+	return dicom.Dataset{Elements: elems}
+}
+
+func nativeFrame(rows, cols, samples, bits int, data []byte) frame.Frame {
+	return frame.Frame{NativeData: frame.NativeFrame{Rows: rows, Cols: cols, SamplesPerPixel: samples, BitsPerSample: bits, Data: data}}
+}
+
+func pixelData(t *testing.T, ds *dicom.Dataset) dicom.PixelDataInfo {
+	t.Helper()
+	e, err := ds.FindElementByTag(tag.PixelData)
+	if err != nil {
+		t.Fatalf("FindElementByTag(PixelData): %v", err)
+	}
+	return e.Value.GetValue().(dicom.PixelDataInfo)
+}
+
+func TestBlankRegions_Native(t *testing.T) {
+	cases := []struct {
+		name    string
+		planar  int
+		frames  []frame.Frame
+		regions []image.Rectangle
+		want    [][]byte
+	}{
+		{
+			name: "16 bit, multiple frames",
+			frames: []frame.Frame{
+				nativeFrame(2, 3, 1, 16, []byte{1, 1, 2, 2, 3, 3, 4, 4, 5, 5, 6, 6}),
+				nativeFrame(2, 3, 1, 16, []byte{7, 7, 8, 8, 9, 9, 10, 10, 11, 11, 12, 12}),
+			},
+			regions: []image.Rectangle{image.Rect(1, 0, 3, 1)},
+			want: [][]byte{
+				{1, 1, 0, 0, 0, 0, 4, 4, 5, 5, 6, 6},
+				{7, 7, 0, 0, 0, 0, 10, 10, 11, 11, 12, 12},
+			},
+		},
+		{
+			name:    "clipped to the image",
+			frames:  []frame.Frame{nativeFrame(2, 2, 1, 8, []byte{1, 2, 3, 4})},
+			regions: []image.Rectangle{image.Rect(1, 1, 10, 10)},
+			want:    [][]byte{{1, 2, 3, 0}},
+		},
+		{
+			name:    "color by pixel",
+			frames:  []frame.Frame{nativeFrame(1, 2, 3, 8, []byte{1, 2, 3, 4, 5, 6})},
+			regions: []image.Rectangle{image.Rect(1, 0, 2, 1)},
+			want:    [][]byte{{1, 2, 3, 0, 0, 0}},
+		},
+		{
+			name:    "color by plane",
+			planar:  1,
+			frames:  []frame.Frame{nativeFrame(1, 2, 3, 8, []byte{1, 2, 3, 4, 5, 6})},
+			regions: []image.Rectangle{image.Rect(1, 0, 2, 1)},
+			want:    [][]byte{{1, 0, 3, 0, 5, 0}},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			n := tc.frames[0].NativeData
+			ds := newImage(t, uid.ExplicitVRLittleEndian, n.Rows, n.Cols, tc.planar, dicom.PixelDataInfo{Frames: tc.frames})
+			if err := BlankRegions(&ds, tc.regions); err != nil {
+				t.Fatalf("BlankRegions: %v", err)
+			}
+			var got [][]byte
+			for _, f := range pixelData(t, &ds).Frames {
+				got = append(got, f.NativeData.Data)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("BlankRegions: unexpected pixels (-want +got):\n%s", diff)
+			}
+			if got := findString(&ds, tag.BurnedInAnnotation); got != "NO" {
+				t.Errorf("BlankRegions: got BurnedInAnnotation %q, want NO", got)
+			}
+		})
+	}
+}
+
+func TestBlankRegions_Encapsulated(t *testing.T) {
+	img := image.NewGray(image.Rect(0, 0, 32, 16))
+	for i := range img.Pix {
+		img.Pix[i] = 200
+	}
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, img, nil); err != nil {
+		t.Fatalf("jpeg.Encode: %v", err)
+	}
+	info := dicom.PixelDataInfo{
+		IsEncapsulated: true,
+		Frames:         []frame.Frame{{Encapsulated: true, EncapsulatedData: frame.EncapsulatedFrame{Data: buf.Bytes()}}},
+	}
+
+	ds := newImage(t, uid.JPEGBaseline8Bit, 16, 32, 0, info)
+	if err := BlankRegions(&ds, []image.Rectangle{image.Rect(0, 0, 16, 8)}); err != nil {
+		t.Fatalf("BlankRegions: %v", err)
+	}
+	got, err := jpeg.Decode(bytes.NewReader(pixelData(t, &ds).Frames[0].EncapsulatedData.Data))
+	if err != nil {
+		t.Fatalf("jpeg.Decode: %v", err)
+	}
+	for _, p := range []struct {
+		pt   image.Point
+		want int
+	}{
+		{image.Pt(2, 2), 0},
+		{image.Pt(28, 12), 200},
+	} {
+		// Allow for JPEG compression artifacts.
+		if y := int(color.GrayModel.Convert(got.At(p.pt.X, p.pt.Y)).(color.Gray).Y); y < p.want-8 || y > p.want+8 {
+			t.Errorf("BlankRegions: got pixel %v of %d, want %d", p.pt, y, p.want)
+		}
+	}
+
+	ds = newImage(t, "1.2.840.10008.1.2.4.70", 16, 32, 0, info)
+	if err := BlankRegions(&ds, nil); !errors.Is(err, ErrorUnsupportedPixelData) {
+		t.Errorf("BlankRegions with JPEG Lossless: got %v, want %v", err, ErrorUnsupportedPixelData)
+	}
+}
+
+func TestBlankPixels(t *testing.T) {
+	cases := []struct {
+		name string
+		rule PixelRule
+		want bool
+	}{
+		{name: "any image", rule: PixelRule{}, want: true},
+		{name: "all criteria", rule: PixelRule{Modality: "us", Manufacturer: "acme", ModelName: "3000", Rows: 1, Columns: 2}, want: true},
+		{name: "other modality", rule: PixelRule{Modality: "CT"}},
+		{name: "other manufacturer", rule: PixelRule{Manufacturer: "Other"}},
+		{name: "other model", rule: PixelRule{ModelName: "Sono 4000"}},
+		{name: "other size", rule: PixelRule{Rows: 1, Columns: 3}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.rule.Regions = []image.Rectangle{image.Rect(0, 0, 1, 1)}
+			ds := newImage(t, uid.ExplicitVRLittleEndian, 1, 2, 0, dicom.PixelDataInfo{
+				Frames: []frame.Frame{nativeFrame(1, 2, 1, 8, []byte{1, 2})},
+			})
+			matched, err := BlankPixels(&ds, []PixelRule{tc.rule})
+			if err != nil {
+				t.Fatalf("BlankPixels: %v", err)
+			}
+			want, burnedIn := []byte{1, 2}, "YES"
+			if tc.want {
+				want, burnedIn = []byte{0, 2}, "NO"
+			}
+			if matched != tc.want {
+				t.Errorf("BlankPixels: got matched %v, want %v", matched, tc.want)
+			}
+			if got := pixelData(t, &ds).Frames[0].NativeData.Data; !bytes.Equal(got, want) {
+				t.Errorf("BlankPixels: got pixels %v, want %v", got, want)
+			}
+			if got := findString(&ds, tag.BurnedInAnnotation); got != burnedIn {
+				t.Errorf("BlankPixels: got BurnedInAnnotation %q, want %q", got, burnedIn)
+			}
+		})
+	}
+}
@@ -0,0 +1,307 @@
+package deid
+
+import "github.com/ginuerzh/dicom/pkg/tag"
+
+// ruleOptions are the options of PS3.15 Table E.1-1 that change the action
+// of an attribute.
+type ruleOptions uint8
+
+const (
+	// retainDevice attributes are kept by the Retain Device Identity Option.
+	retainDevice ruleOptions = 1 << iota
+	// cleanDescriptor attributes are cleaned by the Clean Descriptors Option.
+	cleanDescriptor
+)
+
+// rule is a row of PS3.15 Table E.1-1.
+type rule struct {
+	tag     tag.Tag
+	name    string
+	action  Action
+	options ruleOptions
+}
+
+// basicProfile are the rows of PS3.15 Table E.1-1, Application Level
+// Confidentiality Profile Attributes. Attributes of the Retain Longitudinal
+// Temporal Information Option are recognized by their DA and DT VRs, and
+// those of the Retain UIDs Option by their U action.
+var basicProfile = []rule{
+	{tag.Tag{Group: 0x0000, Element: 0x1001}, "RequestedSOPInstanceUID", ActionUID, 0},
+	{tag.Tag{Group: 0x0002, Element: 0x0003}, "MediaStorageSOPInstanceUID", ActionUID, 0},
+	{tag.Tag{Group: 0x0004, Element: 0x1511}, "ReferencedSOPInstanceUIDInFile", ActionUID, 0},
+	{tag.Tag{Group: 0x0008, Element: 0x0012}, "InstanceCreationDate", "X/D", 0},
+	{tag.Tag{Group: 0x0008, Element: 0x0013}, "InstanceCreationTime", "X/Z/D", 0},
+	{tag.Tag{Group: 0x0008, Element: 0x0014}, "InstanceCreatorUID", ActionUID, 0},
+	{tag.Tag{Group: 0x0008, Element: 0x0015}, "InstanceCoercionDateTime", ActionRemove, 0},
+	{tag.Tag{Group: 0x0008, Element: 0x0018}, "SOPInstanceUID", ActionUID, 0},
+	{tag.Tag{Group: 0x0008, Element: 0x0020}, "StudyDate", ActionZero, 0},
+	{tag.Tag{Group: 0x0008, Element: 0x0021}, "SeriesDate", "X/D", 0},
+	{tag.Tag{Group: 0x0008, Element: 0x0022}, "AcquisitionDate", "X/Z", 0},
+	{tag.Tag{Group: 0x0008, Element: 0x0023}, "ContentDate", "Z/D", 0},
+	{tag.Tag{Group: 0x0008, Element: 0x0024}, "OverlayDate", ActionRemove, 0},
+	{tag.Tag{Group: 0x0008, Element: 0x0025}, "CurveDate", ActionRemove, 0},
+	{tag.Tag{Group: 0x0008, Element: 0x002A}, "AcquisitionDateTime", "X/D", 0},
+	{tag.Tag{Group: 0x0008, Element: 0x0030}, "StudyTime", ActionZero, 0},
+	{tag.Tag{Group: 0x0008, Element: 0x0031}, "SeriesTime", "X/D", 0},
+	{tag.Tag{Group: 0x0008, Element: 0x0032}, "AcquisitionTime", "X/Z", 0},
+	{tag.Tag{Group: 0x0008, Element: 0x0033}, "ContentTime", "Z/D", 0},
+	{tag.Tag{Group: 0x0008, Element: 0x0034}, "OverlayTime", ActionRemove, 0},
+	{tag.Tag{Group: 0x0008, Element: 0x0035}, "CurveTime", ActionRemove, 0},
+	{tag.Tag{Group: 0x0008, Element: 0x0050}, "AccessionNumber", ActionZero, 0},
+	{tag.Tag{Group: 0x0008, Element: 0x0058}, "FailedSOPInstanceUIDList", ActionUID, 0},
+	{tag.Tag{Group: 0x0008, Element: 0x0080}, "InstitutionName", "X/Z/D", 0},
+	{tag.Tag{Group: 0x0008, Element: 0x0081}, "InstitutionAddress", ActionRemove, 0},
+	{tag.Tag{Group: 0x0008, Element: 0x0082}, "InstitutionCodeSequence", "X/Z/D", 0},
+	{tag.Tag{Group: 0x0008, Element: 0x0090}, "ReferringPhysicianName", ActionZero, 0},
+	{tag.Tag{Group: 0x0008, Element: 0x0092}, "ReferringPhysicianAddress", ActionRemove, 0},
+	{tag.Tag{Group: 0x0008, Element: 0x0094}, "ReferringPhysicianTelephoneNumbers", ActionRemove, 0},
+	{tag.Tag{Group: 0x0008, Element: 0x0096}, "ReferringPhysicianIdentificationSequence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0008, Element: 0x010D}, "ContextGroupExtensionCreatorUID", ActionUID, 0},
+	{tag.Tag{Group: 0x0008, Element: 0x0201}, "TimezoneOffsetFromUTC", ActionRemove, 0},
+	{tag.Tag{Group: 0x0008, Element: 0x1010}, "StationName", "X/Z/D", retainDevice},
+	{tag.Tag{Group: 0x0008, Element: 0x1030}, "StudyDescription", ActionRemove, cleanDescriptor},
+	{tag.Tag{Group: 0x0008, Element: 0x103E}, "SeriesDescription", ActionRemove, cleanDescriptor},
+	{tag.Tag{Group: 0x0008, Element: 0x1040}, "InstitutionalDepartmentName", ActionRemove, 0},
+	{tag.Tag{Group: 0x0008, Element: 0x1048}, "PhysiciansOfRecord", ActionRemove, 0},
+	{tag.Tag{Group: 0x0008, Element: 0x1049}, "PhysiciansOfRecordIdentificationSequence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0008, Element: 0x1050}, "PerformingPhysicianName", ActionRemove, 0},
+	{tag.Tag{Group: 0x0008, Element: 0x1052}, "PerformingPhysicianIdentificationSequence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0008, Element: 0x1060}, "NameOfPhysiciansReadingStudy", ActionRemove, 0},
+	{tag.Tag{Group: 0x0008, Element: 0x1062}, "PhysiciansReadingStudyIdentificationSequence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0008, Element: 0x1070}, "OperatorsName", "X/Z/D", 0},
+	{tag.Tag{Group: 0x0008, Element: 0x1072}, "OperatorIdentificationSequence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0008, Element: 0x1080}, "AdmittingDiagnosesDescription", ActionRemove, cleanDescriptor},
+	{tag.Tag{Group: 0x0008, Element: 0x1084}, "AdmittingDiagnosesCodeSequence", ActionRemove, cleanDescriptor},
+	{tag.Tag{Group: 0x0008, Element: 0x1110}, "ReferencedStudySequence", "X/Z", 0},
+	{tag.Tag{Group: 0x0008, Element: 0x1111}, "ReferencedPerformedProcedureStepSequence", "X/Z/D", 0},
+	{tag.Tag{Group: 0x0008, Element: 0x1120}, "ReferencedPatientSequence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0008, Element: 0x1140}, "ReferencedImageSequence", "X/Z/U", 0},
+	{tag.Tag{Group: 0x0008, Element: 0x1155}, "ReferencedSOPInstanceUID", ActionUID, 0},
+	{tag.Tag{Group: 0x0008, Element: 0x1195}, "TransactionUID", ActionUID, 0},
+	{tag.Tag{Group: 0x0008, Element: 0x2112}, "SourceImageSequence", "X/Z/U", 0},
+	{tag.Tag{Group: 0x0008, Element: 0x3010}, "IrradiationEventUID", ActionUID, 0},
+	{tag.Tag{Group: 0x0008, Element: 0x4000}, "IdentifyingComments", ActionRemove, cleanDescriptor},
+	{tag.Tag{Group: 0x0008, Element: 0x9123}, "CreatorVersionUID", ActionUID, 0},
+	{tag.Tag{Group: 0x0010, Element: 0x0010}, "PatientName", ActionZero, 0},
+	{tag.Tag{Group: 0x0010, Element: 0x0020}, "PatientID", ActionZero, 0},
+	{tag.Tag{Group: 0x0010, Element: 0x0021}, "IssuerOfPatientID", ActionRemove, 0},
+	{tag.Tag{Group: 0x0010, Element: 0x0030}, "PatientBirthDate", ActionZero, 0},
+	{tag.Tag{Group: 0x0010, Element: 0x0032}, "PatientBirthTime", ActionRemove, 0},
+	{tag.Tag{Group: 0x0010, Element: 0x0040}, "PatientSex", ActionZero, 0},
+	{tag.Tag{Group: 0x0010, Element: 0x0050}, "PatientInsurancePlanCodeSequence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0010, Element: 0x0101}, "PatientPrimaryLanguageCodeSequence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0010, Element: 0x0102}, "PatientPrimaryLanguageModifierCodeSequence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0010, Element: 0x1000}, "OtherPatientIDs", ActionRemove, 0},
+	{tag.Tag{Group: 0x0010, Element: 0x1001}, "OtherPatientNames", ActionRemove, 0},
+	{tag.Tag{Group: 0x0010, Element: 0x1002}, "OtherPatientIDsSequence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0010, Element: 0x1005}, "PatientBirthName", ActionRemove, 0},
+	{tag.Tag{Group: 0x0010, Element: 0x1010}, "PatientAge", ActionRemove, 0},
+	{tag.Tag{Group: 0x0010, Element: 0x1020}, "PatientSize", ActionRemove, 0},
+	{tag.Tag{Group: 0x0010, Element: 0x1030}, "PatientWeight", ActionRemove, 0},
+	{tag.Tag{Group: 0x0010, Element: 0x1040}, "PatientAddress", ActionRemove, 0},
+	{tag.Tag{Group: 0x0010, Element: 0x1050}, "InsurancePlanIdentification", ActionRemove, 0},
+	{tag.Tag{Group: 0x0010, Element: 0x1060}, "PatientMotherBirthName", ActionRemove, 0},
+	{tag.Tag{Group: 0x0010, Element: 0x1080}, "MilitaryRank", ActionRemove, 0},
+	{tag.Tag{Group: 0x0010, Element: 0x1081}, "BranchOfService", ActionRemove, 0},
+	{tag.Tag{Group: 0x0010, Element: 0x1090}, "MedicalRecordLocator", ActionRemove, 0},
+	{tag.Tag{Group: 0x0010, Element: 0x2000}, "MedicalAlerts", ActionRemove, 0},
+	{tag.Tag{Group: 0x0010, Element: 0x2110}, "Allergies", ActionRemove, cleanDescriptor},
+	{tag.Tag{Group: 0x0010, Element: 0x2150}, "CountryOfResidence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0010, Element: 0x2152}, "RegionOfResidence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0010, Element: 0x2154}, "PatientTelephoneNumbers", ActionRemove, 0},
+	{tag.Tag{Group: 0x0010, Element: 0x2160}, "EthnicGroup", ActionRemove, 0},
+	{tag.Tag{Group: 0x0010, Element: 0x2180}, "Occupation", ActionRemove, cleanDescriptor},
+	{tag.Tag{Group: 0x0010, Element: 0x21A0}, "SmokingStatus", ActionRemove, 0},
+	{tag.Tag{Group: 0x0010, Element: 0x21B0}, "AdditionalPatientHistory", ActionRemove, cleanDescriptor},
+	{tag.Tag{Group: 0x0010, Element: 0x21C0}, "PregnancyStatus", ActionRemove, 0},
+	{tag.Tag{Group: 0x0010, Element: 0x21D0}, "LastMenstrualDate", ActionRemove, 0},
+	{tag.Tag{Group: 0x0010, Element: 0x21F0}, "PatientReligiousPreference", ActionRemove, 0},
+	{tag.Tag{Group: 0x0010, Element: 0x2203}, "PatientSexNeutered", "X/Z", 0},
+	{tag.Tag{Group: 0x0010, Element: 0x2297}, "ResponsiblePerson", ActionRemove, 0},
+	{tag.Tag{Group: 0x0010, Element: 0x2299}, "ResponsibleOrganization", ActionRemove, 0},
+	{tag.Tag{Group: 0x0010, Element: 0x4000}, "PatientComments", ActionRemove, cleanDescriptor},
+	{tag.Tag{Group: 0x0018, Element: 0x0010}, "ContrastBolusAgent", "Z/D", cleanDescriptor},
+	{tag.Tag{Group: 0x0018, Element: 0x1000}, "DeviceSerialNumber", "X/Z/D", retainDevice},
+	{tag.Tag{Group: 0x0018, Element: 0x1002}, "DeviceUID", ActionUID, retainDevice},
+	{tag.Tag{Group: 0x0018, Element: 0x1004}, "PlateID", ActionRemove, retainDevice},
+	{tag.Tag{Group: 0x0018, Element: 0x1005}, "GeneratorID", ActionRemove, retainDevice},
+	{tag.Tag{Group: 0x0018, Element: 0x1007}, "CassetteID", ActionRemove, retainDevice},
+	{tag.Tag{Group: 0x0018, Element: 0x1008}, "GantryID", ActionRemove, retainDevice},
+	{tag.Tag{Group: 0x0018, Element: 0x1030}, "ProtocolName", "X/D", cleanDescriptor},
+	{tag.Tag{Group: 0x0018, Element: 0x1400}, "AcquisitionDeviceProcessingDescription", "X/D", cleanDescriptor},
+	{tag.Tag{Group: 0x0018, Element: 0x4000}, "AcquisitionComments", ActionRemove, cleanDescriptor},
+	{tag.Tag{Group: 0x0018, Element: 0x700A}, "DetectorID", ActionRemove, retainDevice},
+	{tag.Tag{Group: 0x0018, Element: 0x9424}, "AcquisitionProtocolDescription", ActionRemove, cleanDescriptor},
+	{tag.Tag{Group: 0x0018, Element: 0xA003}, "ContributionDescription", ActionRemove, cleanDescriptor},
+	{tag.Tag{Group: 0x0020, Element: 0x000D}, "StudyInstanceUID", ActionUID, 0},
+	{tag.Tag{Group: 0x0020, Element: 0x000E}, "SeriesInstanceUID", ActionUID, 0},
+	{tag.Tag{Group: 0x0020, Element: 0x0010}, "StudyID", ActionZero, 0},
+	{tag.Tag{Group: 0x0020, Element: 0x0052}, "FrameOfReferenceUID", ActionUID, 0},
+	{tag.Tag{Group: 0x0020, Element: 0x0200}, "SynchronizationFrameOfReferenceUID", ActionUID, 0},
+	{tag.Tag{Group: 0x0020, Element: 0x3401}, "ModifyingDeviceID", ActionRemove, 0},
+	{tag.Tag{Group: 0x0020, Element: 0x3404}, "ModifyingDeviceManufacturer", ActionRemove, 0},
+	{tag.Tag{Group: 0x0020, Element: 0x3406}, "ModifiedImageDescription", ActionRemove, 0},
+	{tag.Tag{Group: 0x0020, Element: 0x4000}, "ImageComments", ActionRemove, cleanDescriptor},
+	{tag.Tag{Group: 0x0020, Element: 0x9158}, "FrameComments", ActionRemove, cleanDescriptor},
+	{tag.Tag{Group: 0x0020, Element: 0x9161}, "ConcatenationUID", ActionUID, 0},
+	{tag.Tag{Group: 0x0020, Element: 0x9164}, "DimensionOrganizationUID", ActionUID, 0},
+	{tag.Tag{Group: 0x0028, Element: 0x1214}, "LargePaletteColorLookupTableUID", ActionUID, 0},
+	{tag.Tag{Group: 0x0028, Element: 0x4000}, "ImagePresentationComments", ActionRemove, 0},
+	{tag.Tag{Group: 0x0032, Element: 0x0012}, "StudyIDIssuer", ActionRemove, 0},
+	{tag.Tag{Group: 0x0032, Element: 0x0032}, "StudyVerifiedDate", ActionRemove, 0},
+	{tag.Tag{Group: 0x0032, Element: 0x0033}, "StudyVerifiedTime", ActionRemove, 0},
+	{tag.Tag{Group: 0x0032, Element: 0x0034}, "StudyReadDate", ActionRemove, 0},
+	{tag.Tag{Group: 0x0032, Element: 0x0035}, "StudyReadTime", ActionRemove, 0},
+	{tag.Tag{Group: 0x0032, Element: 0x1020}, "ScheduledStudyLocation", ActionRemove, 0},
+	{tag.Tag{Group: 0x0032, Element: 0x1021}, "ScheduledStudyLocationAETitle", ActionRemove, 0},
+	{tag.Tag{Group: 0x0032, Element: 0x1030}, "ReasonForStudy", ActionRemove, cleanDescriptor},
+	{tag.Tag{Group: 0x0032, Element: 0x1032}, "RequestingPhysician", ActionRemove, 0},
+	{tag.Tag{Group: 0x0032, Element: 0x1033}, "RequestingService", ActionRemove, 0},
+	{tag.Tag{Group: 0x0032, Element: 0x1040}, "StudyArrivalDate", ActionRemove, 0},
+	{tag.Tag{Group: 0x0032, Element: 0x1041}, "StudyArrivalTime", ActionRemove, 0},
+	{tag.Tag{Group: 0x0032, Element: 0x1050}, "StudyCompletionDate", ActionRemove, 0},
+	{tag.Tag{Group: 0x0032, Element: 0x1051}, "StudyCompletionTime", ActionRemove, 0},
+	{tag.Tag{Group: 0x0032, Element: 0x1060}, "RequestedProcedureDescription", "X/Z", cleanDescriptor},
+	{tag.Tag{Group: 0x0032, Element: 0x1070}, "RequestedContrastAgent", ActionRemove, cleanDescriptor},
+	{tag.Tag{Group: 0x0032, Element: 0x4000}, "StudyComments", ActionRemove, cleanDescriptor},
+	{tag.Tag{Group: 0x0038, Element: 0x0010}, "AdmissionID", ActionRemove, 0},
+	{tag.Tag{Group: 0x0038, Element: 0x0011}, "IssuerOfAdmissionID", ActionRemove, 0},
+	{tag.Tag{Group: 0x0038, Element: 0x001E}, "ScheduledPatientInstitutionResidence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0038, Element: 0x0020}, "AdmittingDate", ActionRemove, 0},
+	{tag.Tag{Group: 0x0038, Element: 0x0021}, "AdmittingTime", ActionRemove, 0},
+	{tag.Tag{Group: 0x0038, Element: 0x0040}, "DischargeDiagnosisDescription", ActionRemove, cleanDescriptor},
+	{tag.Tag{Group: 0x0038, Element: 0x0050}, "SpecialNeeds", ActionRemove, 0},
+	{tag.Tag{Group: 0x0038, Element: 0x0060}, "ServiceEpisodeID", ActionRemove, 0},
+	{tag.Tag{Group: 0x0038, Element: 0x0061}, "IssuerOfServiceEpisodeID", ActionRemove, 0},
+	{tag.Tag{Group: 0x0038, Element: 0x0062}, "ServiceEpisodeDescription", ActionRemove, cleanDescriptor},
+	{tag.Tag{Group: 0x0038, Element: 0x0300}, "CurrentPatientLocation", ActionRemove, 0},
+	{tag.Tag{Group: 0x0038, Element: 0x0400}, "PatientInstitutionResidence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0038, Element: 0x0500}, "PatientState", ActionRemove, cleanDescriptor},
+	{tag.Tag{Group: 0x0038, Element: 0x1234}, "ReferencedPatientAliasSequence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0038, Element: 0x4000}, "VisitComments", ActionRemove, cleanDescriptor},
+	{tag.Tag{Group: 0x0040, Element: 0x0001}, "ScheduledStationAETitle", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x0002}, "ScheduledProcedureStepStartDate", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x0003}, "ScheduledProcedureStepStartTime", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x0004}, "ScheduledProcedureStepEndDate", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x0005}, "ScheduledProcedureStepEndTime", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x0006}, "ScheduledPerformingPhysicianName", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x0007}, "ScheduledProcedureStepDescription", ActionRemove, cleanDescriptor},
+	{tag.Tag{Group: 0x0040, Element: 0x000B}, "ScheduledPerformingPhysicianIdentificationSequence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x0010}, "ScheduledStationName", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x0011}, "ScheduledProcedureStepLocation", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x0012}, "PreMedication", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x0241}, "PerformedStationAETitle", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x0242}, "PerformedStationName", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x0243}, "PerformedLocation", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x0244}, "PerformedProcedureStepStartDate", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x0245}, "PerformedProcedureStepStartTime", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x0248}, "PerformedStationNameCodeSequence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x0253}, "PerformedProcedureStepID", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x0254}, "PerformedProcedureStepDescription", ActionRemove, cleanDescriptor},
+	{tag.Tag{Group: 0x0040, Element: 0x0275}, "RequestAttributesSequence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x0280}, "CommentsOnThePerformedProcedureStep", ActionRemove, cleanDescriptor},
+	{tag.Tag{Group: 0x0040, Element: 0x0555}, "AcquisitionContextSequence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x1001}, "RequestedProcedureID", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x1004}, "PatientTransportArrangements", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x1005}, "RequestedProcedureLocation", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x1010}, "NamesOfIntendedRecipientsOfResults", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x1011}, "IntendedRecipientsOfResultsIdentificationSequence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x1101}, "PersonIdentificationCodeSequence", ActionDummy, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x1102}, "PersonAddress", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x1103}, "PersonTelephoneNumbers", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x1400}, "RequestedProcedureComments", ActionRemove, cleanDescriptor},
+	{tag.Tag{Group: 0x0040, Element: 0x2001}, "ReasonForImagingServiceRequest", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x2008}, "OrderEnteredBy", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x2009}, "OrderEntererLocation", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x2010}, "OrderCallbackPhoneNumber", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x2016}, "PlacerOrderNumberImagingServiceRequest", ActionZero, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x2017}, "FillerOrderNumberImagingServiceRequest", ActionZero, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x2400}, "ImagingServiceRequestComments", ActionRemove, cleanDescriptor},
+	{tag.Tag{Group: 0x0040, Element: 0x3001}, "ConfidentialityConstraintOnPatientDataDescription", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x4023}, "ReferencedGeneralPurposeScheduledProcedureStepTransactionUID", ActionUID, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x4025}, "ScheduledStationNameCodeSequence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x4027}, "ScheduledStationGeographicLocationCodeSequence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x4030}, "PerformedStationGeographicLocationCodeSequence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x4034}, "ScheduledHumanPerformersSequence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x4035}, "ActualHumanPerformersSequence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x4036}, "HumanPerformerOrganization", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0x4037}, "HumanPerformerName", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0xA027}, "VerifyingOrganization", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0xA073}, "VerifyingObserverSequence", ActionDummy, 0},
+	{tag.Tag{Group: 0x0040, Element: 0xA075}, "VerifyingObserverName", ActionDummy, 0},
+	{tag.Tag{Group: 0x0040, Element: 0xA078}, "AuthorObserverSequence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0xA07A}, "ParticipantSequence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0xA07C}, "CustodialOrganizationSequence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0xA088}, "VerifyingObserverIdentificationCodeSequence", ActionZero, 0},
+	{tag.Tag{Group: 0x0040, Element: 0xA123}, "PersonName", ActionDummy, 0},
+	{tag.Tag{Group: 0x0040, Element: 0xA124}, "UID", ActionUID, 0},
+	{tag.Tag{Group: 0x0040, Element: 0xA730}, "ContentSequence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0040, Element: 0xDB0C}, "TemplateExtensionOrganizationUID", ActionUID, 0},
+	{tag.Tag{Group: 0x0040, Element: 0xDB0D}, "TemplateExtensionCreatorUID", ActionUID, 0},
+	{tag.Tag{Group: 0x0044, Element: 0x0010}, "SubstanceAdministrationDateTime", ActionRemove, 0},
+	{tag.Tag{Group: 0x0070, Element: 0x0001}, "GraphicAnnotationSequence", ActionDummy, 0},
+	{tag.Tag{Group: 0x0070, Element: 0x0084}, "ContentCreatorName", ActionZero, 0},
+	{tag.Tag{Group: 0x0070, Element: 0x0086}, "ContentCreatorIdentificationCodeSequence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0070, Element: 0x031A}, "FiducialUID", ActionUID, 0},
+	{tag.Tag{Group: 0x0088, Element: 0x0140}, "StorageMediaFileSetUID", ActionUID, 0},
+	{tag.Tag{Group: 0x0088, Element: 0x0200}, "IconImageSequence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0088, Element: 0x0904}, "TopicTitle", ActionRemove, 0},
+	{tag.Tag{Group: 0x0088, Element: 0x0906}, "TopicSubject", ActionRemove, 0},
+	{tag.Tag{Group: 0x0088, Element: 0x0910}, "TopicAuthor", ActionRemove, 0},
+	{tag.Tag{Group: 0x0088, Element: 0x0912}, "TopicKeywords", ActionRemove, 0},
+	{tag.Tag{Group: 0x0400, Element: 0x0100}, "DigitalSignatureUID", ActionRemove, 0},
+	{tag.Tag{Group: 0x0400, Element: 0x0402}, "ReferencedDigitalSignatureSequence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0400, Element: 0x0403}, "ReferencedSOPInstanceMACSequence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0400, Element: 0x0404}, "MAC", ActionRemove, 0},
+	{tag.Tag{Group: 0x0400, Element: 0x0500}, "EncryptedAttributesSequence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0400, Element: 0x0550}, "ModifiedAttributesSequence", ActionRemove, 0},
+	{tag.Tag{Group: 0x0400, Element: 0x0561}, "OriginalAttributesSequence", ActionRemove, 0},
+	{tag.Tag{Group: 0x2030, Element: 0x0020}, "TextString", ActionRemove, 0},
+	{tag.Tag{Group: 0x3006, Element: 0x0008}, "StructureSetDate", "X/Z", 0},
+	{tag.Tag{Group: 0x3006, Element: 0x0024}, "ReferencedFrameOfReferenceUID", ActionUID, 0},
+	{tag.Tag{Group: 0x3006, Element: 0x00C2}, "RelatedFrameOfReferenceUID", ActionUID, 0},
+	{tag.Tag{Group: 0x300E, Element: 0x0008}, "ReviewerName", "X/Z", 0},
+	{tag.Tag{Group: 0x4000, Element: 0x0010}, "Arbitrary", ActionRemove, 0},
+	{tag.Tag{Group: 0x4000, Element: 0x4000}, "TextComments", ActionRemove, 0},
+	{tag.Tag{Group: 0x4008, Element: 0x0042}, "ResultsIDIssuer", ActionRemove, 0},
+	{tag.Tag{Group: 0x4008, Element: 0x0102}, "InterpretationRecorder", ActionRemove, 0},
+	{tag.Tag{Group: 0x4008, Element: 0x010A}, "InterpretationTranscriber", ActionRemove, 0},
+	{tag.Tag{Group: 0x4008, Element: 0x010B}, "InterpretationText", ActionRemove, cleanDescriptor},
+	{tag.Tag{Group: 0x4008, Element: 0x010C}, "InterpretationAuthor", ActionRemove, 0},
+	{tag.Tag{Group: 0x4008, Element: 0x0111}, "InterpretationApproverSequence", ActionRemove, 0},
+	{tag.Tag{Group: 0x4008, Element: 0x0114}, "PhysicianApprovingInterpretation", ActionRemove, 0},
+	{tag.Tag{Group: 0x4008, Element: 0x0115}, "InterpretationDiagnosisDescription", ActionRemove, cleanDescriptor},
+	{tag.Tag{Group: 0x4008, Element: 0x0118}, "ResultsDistributionListSequence", ActionRemove, 0},
+	{tag.Tag{Group: 0x4008, Element: 0x0119}, "DistributionName", ActionRemove, 0},
+	{tag.Tag{Group: 0x4008, Element: 0x011A}, "DistributionAddress", ActionRemove, 0},
+	{tag.Tag{Group: 0x4008, Element: 0x0202}, "InterpretationIDIssuer", ActionRemove, 0},
+	{tag.Tag{Group: 0x4008, Element: 0x0300}, "Impressions", ActionRemove, cleanDescriptor},
+	{tag.Tag{Group: 0x4008, Element: 0x4000}, "ResultsComments", ActionRemove, cleanDescriptor},
+	{tag.Tag{Group: 0xFFFA, Element: 0xFFFA}, "DigitalSignaturesSequence", ActionRemove, 0},
+}
+
+var basicProfileIndex = func() map[tag.Tag]rule {
+	index := make(map[tag.Tag]rule, len(basicProfile))
+	for _, r := range basicProfile {
+		index[r.tag] = r
+	}
+	return index
+}()
+
+// findRule returns the row of Table E.1-1 for t. The repeating groups of
+// curves (50xx,xxxx) and of overlay data and comments (60xx,3000) and
+// (60xx,4000) are removed.
+func findRule(t tag.Tag) (rule, bool) {
+	if r, ok := basicProfileIndex[t]; ok {
+		return r, true
+	}
+	switch {
+	case t.Group&0xFF00 == 0x5000:
+		return rule{tag: t, name: "CurveData", action: ActionRemove}, true
+	case t.Group&0xFF00 == 0x6000 && t.Group%2 == 0 && t.Element == 0x3000:
+		return rule{tag: t, name: "OverlayData", action: ActionRemove}, true
+	case t.Group&0xFF00 == 0x6000 && t.Group%2 == 0 && t.Element == 0x4000:
+		return rule{tag: t, name: "OverlayComments", action: ActionRemove}, true
+	}
+	return rule{}, false
+}
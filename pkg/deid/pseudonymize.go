@@ -0,0 +1,241 @@
+package deid
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/ginuerzh/dicom"
+	"github.com/ginuerzh/dicom/pkg/tag"
+	"github.com/ginuerzh/dicom/pkg/uid"
+)
+
+// methodTags are the attributes set by Deidentify to record the
+// de-identification, which Reidentify removes again.
+var methodTags = []tag.Tag{
+	tag.PatientIdentityRemoved,
+	tag.DeidentificationMethod,
+	tag.DeidentificationMethodCodeSequence,
+	tag.LongitudinalTemporalInformationModified,
+}
+
+// The Modified Attributes Sequence only holds the attributes that were
+// modified or removed, as in the Original Attributes Sequence (see PS3.3
+// C.12.1.1.9). The tags of the pseudonyms that were inserted are listed in a
+// private element of the encrypted dataset instead, so that Reidentify can
+// remove them again.
+var (
+	insertedCreator     = tag.Tag{Group: 0x0011, Element: 0x0010}
+	insertedTags        = tag.Tag{Group: 0x0011, Element: 0x1001}
+	insertedCreatorName = "GINUERZH DICOM DEID"
+)
+
+// Pseudonyms returns an Option that sets the top level attributes in values,
+// like PatientName and PatientID, to the provided pseudonyms instead of
+// applying the actions of the profile to them. Attributes missing from the
+// Dataset are added, and with EncryptOriginals removed again by Reidentify.
+func Pseudonyms(values map[tag.Tag]string) Option {
+	return func(set *optSet) {
+		if set.pseudonyms == nil {
+			set.pseudonyms = map[tag.Tag]string{}
+		}
+		for t, v := range values {
+			set.pseudonyms[t] = v
+		}
+	}
+}
+
+// EncryptOriginals returns an Option that keeps the original values of the
+// top level attributes modified or removed by Deidentify, so that the
+// Dataset can be re-identified with Reidentify by the holder of the private
+// key of recipient. The originals are stored in a Modified Attributes
+// Sequence, encrypted to recipient as a CMS EnvelopedData in the Encrypted
+// Attributes Sequence, see PS3.15 Annex C. recipient must have an RSA key.
+func EncryptOriginals(recipient *x509.Certificate) Option {
+	return func(set *optSet) {
+		set.recipient = recipient
+	}
+}
+
+// setPseudonyms sets the pseudonyms of the top level attributes of ds.
+func (d *Deidentifier) setPseudonyms(ds *dicom.Dataset) error {
+	for t, v := range d.opts.pseudonyms {
+		e, err := dicom.NewElement(t, []string{v})
+		if err != nil {
+			return fmt.Errorf("deid: pseudonym of %v: %w", t, err)
+		}
+		ds.Set(e)
+	}
+	return nil
+}
+
+// encryptOriginals adds the Encrypted Attributes Sequence holding the
+// elements of original that were modified or removed in ds.
+func (d *Deidentifier) encryptOriginals(ds, original *dicom.Dataset) error {
+	var modified []*dicom.Element
+	for _, e := range original.Elements {
+		if e.Tag.Group == tag.MetadataGroup {
+			continue
+		}
+		current, err := ds.FindElementByTag(e.Tag)
+		// The attributes recording the de-identification are always kept, so
+		// that Reidentify can tell their original values from the added ones.
+		if err != nil || !current.Equal(e, dicom.IgnoreValueLength()) || isMethodTag(e.Tag) {
+			modified = append(modified, e)
+		}
+	}
+	var inserted []uint64
+	for t := range d.opts.pseudonyms {
+		if _, err := original.FindElementByTag(t); err != nil {
+			inserted = append(inserted, uint64(t.Group)<<16|uint64(t.Element))
+		}
+	}
+	sort.Slice(modified, func(i, j int) bool {
+		return modified[i].Tag.Compare(modified[j].Tag) < 0
+	})
+	sequence, err := dicom.NewElement(tag.ModifiedAttributesSequence, [][]*dicom.Element{modified})
+	if err != nil {
+		return err
+	}
+	payload := dicom.Dataset{}
+	payload.Set(sequence)
+	// The originals are encoded in their own Specific Character Set.
+	if e, err := original.FindElementByTag(tag.SpecificCharacterSet); err == nil {
+		payload.Set(e)
+	}
+	if len(inserted) > 0 {
+		sort.Slice(inserted, func(i, j int) bool { return inserted[i] < inserted[j] })
+		creator, err := dicom.NewElementWithVR(insertedCreator, "LO", []string{insertedCreatorName})
+		if err != nil {
+			return err
+		}
+		tags, err := dicom.NewElementWithVR(insertedTags, "AT", inserted)
+		if err != nil {
+			return err
+		}
+		payload.Update(creator, tags)
+	}
+	var buf bytes.Buffer
+	// The originals are stored as they are, even if their VRs are unusual.
+	if err := dicom.WriteDataset(&buf, payload, uid.ExplicitVRLittleEndian, dicom.SkipVRVerification()); err != nil {
+		return fmt.Errorf("deid: encoding original attributes: %w", err)
+	}
+	content, err := envelope(buf.Bytes(), d.opts.recipient)
+	if err != nil {
+		return fmt.Errorf("deid: encrypting original attributes: %w", err)
+	}
+	item, err := newElements(
+		tag.EncryptedContentTransferSyntaxUID, []string{uid.ExplicitVRLittleEndian},
+		tag.EncryptedContent, content,
+	)
+	if err != nil {
+		return err
+	}
+	encrypted, err := dicom.NewElement(tag.EncryptedAttributesSequence, [][]*dicom.Element{item})
+	if err != nil {
+		return err
+	}
+	ds.Set(encrypted)
+	return nil
+}
+
+// Reidentify restores the original attributes that a Deidentifier with
+// EncryptOriginals stored in ds, decrypting them with the private key of
+// cert. It removes the Encrypted Attributes Sequence and the attributes that
+// recorded the de-identification, like PatientIdentityRemoved, unless they
+// were present originally, as well as the pseudonyms of attributes that were
+// missing. It returns ErrorNoRecipient if ds has no Encrypted Content
+// encrypted to cert.
+func Reidentify(ds *dicom.Dataset, cert *x509.Certificate, key crypto.Decrypter) error {
+	encrypted, err := ds.FindElementByTag(tag.EncryptedAttributesSequence)
+	if err != nil {
+		return fmt.Errorf("deid: %w", ErrorNoRecipient)
+	}
+	items, _ := encrypted.Value.GetValue().([]*dicom.SequenceItemValue)
+	var originals []*dicom.Element
+	var inserted []tag.Tag
+	found := false
+	for _, item := range items {
+		content, err := item.FindElementByTag(tag.EncryptedContent)
+		if err != nil {
+			continue
+		}
+		der, _ := content.Value.GetValue().([]byte)
+		decrypted, err := openEnvelope(der, cert, key)
+		if errors.Is(err, ErrorNoRecipient) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("deid: decrypting original attributes: %w", err)
+		}
+		ts := uid.ExplicitVRLittleEndian
+		if e, err := item.FindElementByTag(tag.EncryptedContentTransferSyntaxUID); err == nil && firstString(e) != "" {
+			ts = firstString(e)
+		}
+		parsed, err := dicom.ParseDataset(bytes.NewReader(decrypted), int64(len(decrypted)), ts)
+		if err != nil {
+			return fmt.Errorf("deid: decoding original attributes: %w", err)
+		}
+		if modified, err := parsed.FindElementByTag(tag.ModifiedAttributesSequence); err == nil {
+			modifiedItems, _ := modified.Value.GetValue().([]*dicom.SequenceItemValue)
+			for _, item := range modifiedItems {
+				originals = append(originals, item.GetValue().([]*dicom.Element)...)
+			}
+		}
+		inserted = append(inserted, insertedPseudonyms(&parsed)...)
+		found = true
+	}
+	if !found {
+		return fmt.Errorf("deid: %w", ErrorNoRecipient)
+	}
+
+	ds.Delete(tag.EncryptedAttributesSequence)
+	for _, t := range methodTags {
+		ds.Delete(t)
+	}
+	for _, t := range inserted {
+		ds.Delete(t)
+	}
+	ds.Update(originals...)
+	if sop, err := ds.FindElementByTag(tag.SOPInstanceUID); err == nil {
+		if _, err := ds.FindElementByTag(tag.MediaStorageSOPInstanceUID); err == nil {
+			meta, err := dicom.NewElement(tag.MediaStorageSOPInstanceUID, []string{firstString(sop)})
+			if err != nil {
+				return err
+			}
+			ds.Set(meta)
+		}
+	}
+	return nil
+}
+
+// insertedPseudonyms returns the tags of the inserted pseudonyms listed in
+// the decrypted dataset ds.
+func insertedPseudonyms(ds *dicom.Dataset) []tag.Tag {
+	creator, err := ds.FindElementByTag(insertedCreator)
+	if err != nil || firstString(creator) != insertedCreatorName {
+		return nil
+	}
+	e, err := ds.FindElementByTag(insertedTags)
+	if err != nil {
+		return nil
+	}
+	values, _ := e.Value.GetValue().([]uint64)
+	tags := make([]tag.Tag, len(values))
+	for i, v := range values {
+		tags[i] = tag.Tag{Group: uint16(v >> 16), Element: uint16(v)}
+	}
+	return tags
+}
+
+func isMethodTag(t tag.Tag) bool {
+	for _, m := range methodTags {
+		if t == m {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,161 @@
+package deid
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ginuerzh/dicom"
+	"github.com/ginuerzh/dicom/pkg/tag"
+	"github.com/google/go-cmp/cmp"
+)
+
+// newRecipient returns a self-signed certificate and its private key.
+func newRecipient(t *testing.T, serial int64) (*x509.Certificate, *rsa.PrivateKey) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("rsa.GenerateKey: %v", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "Honest Broker"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageKeyEncipherment,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("x509.CreateCertificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("x509.ParseCertificate: %v", err)
+	}
+	return cert, key
+}
+
+func TestReidentify(t *testing.T) {
+	cert, key := newRecipient(t, 1)
+	ds := newDataset(t, "1.2.3.5", "1.2.3.6")
+	want := values(&ds)
+
+	err := Deidentify(&ds,
+		Pseudonyms(map[tag.Tag]string{patientName: "SUBJECT^001", patientID: "TRIAL-001"}),
+		EncryptOriginals(cert),
+	)
+	if err != nil {
+		t.Fatalf("Deidentify: %v", err)
+	}
+	got := values(&ds)
+	if got["(0010,0010)"] != "[SUBJECT^001]" || got["(0010,0020)"] != "[TRIAL-001]" {
+		t.Errorf("Deidentify: got pseudonyms %s and %s, want [SUBJECT^001] and [TRIAL-001]", got["(0010,0010)"], got["(0010,0020)"])
+	}
+	if _, ok := got["(0400,0500)[0].(0400,0520)"]; !ok {
+		t.Fatalf("Deidentify: no Encrypted Content in %v", got)
+	}
+
+	if err := Reidentify(&ds, cert, key); err != nil {
+		t.Fatalf("Reidentify: %v", err)
+	}
+	if diff := cmp.Diff(want, values(&ds)); diff != "" {
+		t.Errorf("Reidentify: unexpected elements (-want +got):\n%s", diff)
+	}
+}
+
+func TestReidentify_AddedPseudonym(t *testing.T) {
+	cert, key := newRecipient(t, 1)
+	ds := newDataset(t, "1.2.3.5", "1.2.3.6")
+	want := values(&ds)
+	clinicalTrialSubjectID := tag.Tag{Group: 0x0012, Element: 0x0040}
+	if _, ok := want["(0012,0040)"]; ok {
+		t.Fatalf("test dataset already has ClinicalTrialSubjectID")
+	}
+
+	err := Deidentify(&ds,
+		Pseudonyms(map[tag.Tag]string{patientID: "TRIAL-001", clinicalTrialSubjectID: "001"}),
+		EncryptOriginals(cert),
+	)
+	if err != nil {
+		t.Fatalf("Deidentify: %v", err)
+	}
+	if got := values(&ds)["(0012,0040)"]; got != "[001]" {
+		t.Fatalf("Deidentify: got ClinicalTrialSubjectID %s, want [001]", got)
+	}
+
+	if err := Reidentify(&ds, cert, key); err != nil {
+		t.Fatalf("Reidentify: %v", err)
+	}
+	if diff := cmp.Diff(want, values(&ds)); diff != "" {
+		t.Errorf("Reidentify: unexpected elements (-want +got):\n%s", diff)
+	}
+}
+
+func TestReidentify_EmptyOriginal(t *testing.T) {
+	cert, key := newRecipient(t, 1)
+	ds := newDataset(t, "1.2.3.5", "1.2.3.6")
+	for _, e := range []struct {
+		t     tag.Tag
+		vr    string
+		value string
+	}{
+		{tag.SpecificCharacterSet, "CS", "ISO_IR 100"},
+		{patientName, "PN", "Müller^Jörg"},
+		{patientID, "LO", ""},
+	} {
+		elem, err := dicom.NewElementWithVR(e.t, e.vr, []string{e.value})
+		if err != nil {
+			t.Fatalf("NewElementWithVR(%v): %v", e.t, err)
+		}
+		ds.Set(elem)
+	}
+	want := values(&ds)
+
+	err := Deidentify(&ds, Pseudonyms(map[tag.Tag]string{patientID: "TRIAL-001"}), EncryptOriginals(cert))
+	if err != nil {
+		t.Fatalf("Deidentify: %v", err)
+	}
+	if err := Reidentify(&ds, cert, key); err != nil {
+		t.Fatalf("Reidentify: %v", err)
+	}
+	if diff := cmp.Diff(want, values(&ds)); diff != "" {
+		t.Errorf("Reidentify: unexpected elements (-want +got):\n%s", diff)
+	}
+}
+
+func TestReidentify_WrongRecipient(t *testing.T) {
+	cert, _ := newRecipient(t, 1)
+	other, otherKey := newRecipient(t, 2)
+	ds := newDataset(t, "1.2.3.5", "1.2.3.6")
+	if err := Reidentify(&ds, other, otherKey); !errors.Is(err, ErrorNoRecipient) {
+		t.Errorf("Reidentify without Encrypted Attributes Sequence: got %v, want %v", err, ErrorNoRecipient)
+	}
+	if err := Deidentify(&ds, EncryptOriginals(cert)); err != nil {
+		t.Fatalf("Deidentify: %v", err)
+	}
+	if err := Reidentify(&ds, other, otherKey); !errors.Is(err, ErrorNoRecipient) {
+		t.Errorf("Reidentify with another certificate: got %v, want %v", err, ErrorNoRecipient)
+	}
+}
+
+func TestEnvelope(t *testing.T) {
+	cert, key := newRecipient(t, 1)
+	for _, content := range [][]byte{{}, []byte("0123456789abcdef"), []byte("original attributes")} {
+		der, err := envelope(content, cert)
+		if err != nil {
+			t.Fatalf("envelope: %v", err)
+		}
+		got, err := openEnvelope(der, cert, key)
+		if err != nil {
+			t.Fatalf("openEnvelope: %v", err)
+		}
+		if string(got) != string(content) {
+			t.Errorf("openEnvelope: got %q, want %q", got, content)
+		}
+	}
+}
@@ -0,0 +1,256 @@
+package deid
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/ginuerzh/dicom"
+	"github.com/ginuerzh/dicom/pkg/tag"
+	"github.com/ginuerzh/dicom/pkg/uid"
+)
+
+// dicomRoot is the root of the UIDs defined by the DICOM standard, like SOP
+// classes and transfer syntaxes, which are never remapped.
+const dicomRoot = "1.2.840.10008."
+
+// typeUIDNames are the names of UI attributes, besides those of SOP classes
+// and transfer syntaxes, that identify a kind of object or an implementation
+// rather than an instance. Their values may be private UIDs outside
+// dicomRoot, but are never remapped.
+var typeUIDNames = map[string]bool{
+	"CodingSchemeUID":                  true,
+	"ContextUID":                       true,
+	"ContextGroupExtensionCreatorUID":  true,
+	"CreatorVersionUID":                true,
+	"PrivateInformationCreatorUID":     true,
+	"TemplateExtensionCreatorUID":      true,
+	"TemplateExtensionOrganizationUID": true,
+}
+
+// identifiesType reports whether the UI attribute t identifies a kind of
+// object, like a SOP class, transfer syntax or coding scheme, or an
+// implementation, rather than an instance.
+func identifiesType(t tag.Tag) bool {
+	info, err := tag.Find(t)
+	if err != nil {
+		return false
+	}
+	name := strings.TrimPrefix(info.Name, "RETIRED_")
+	return strings.Contains(name, "ClassUID") || strings.Contains(name, "TransferSyntaxUID") || typeUIDNames[name]
+}
+
+// UIDStore stores the replacements of UIDs chosen by a Remapper, so that
+// the same UID is replaced consistently, including across runs if the store
+// is persistent. Implementations must be safe for concurrent use.
+type UIDStore interface {
+	// Load returns the replacement of uid, if any.
+	Load(uid string) (replacement string, ok bool, err error)
+	// Store records replacement as the replacement of uid.
+	Store(uid, replacement string) error
+}
+
+// MemoryStore is a UIDStore that keeps the replacements in memory.
+type MemoryStore struct {
+	mu   sync.RWMutex
+	uids map[string]string
+}
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{uids: map[string]string{}}
+}
+
+// Load implements UIDStore.
+func (s *MemoryStore) Load(uid string) (string, bool, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	replacement, ok := s.uids[uid]
+	return replacement, ok, nil
+}
+
+// Store implements UIDStore.
+func (s *MemoryStore) Store(uid, replacement string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.uids[uid] = replacement
+	return nil
+}
+
+// Mappings returns a copy of the replacements, keyed by original UID.
+func (s *MemoryStore) Mappings() map[string]string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	out := make(map[string]string, len(s.uids))
+	for k, v := range s.uids {
+		out[k] = v
+	}
+	return out
+}
+
+// JSONFileStore is a UIDStore backed by a JSON file holding an object of
+// original to replacement UIDs. Replacements are kept in memory until Save
+// is called.
+type JSONFileStore struct {
+	*MemoryStore
+	path string
+}
+
+// OpenJSONFileStore returns a JSONFileStore with the replacements read from
+// the file at path. The file does not need to exist yet.
+func OpenJSONFileStore(path string) (*JSONFileStore, error) {
+	s := &JSONFileStore{MemoryStore: NewMemoryStore(), path: path}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &s.uids); err != nil {
+		return nil, fmt.Errorf("deid: reading UID mappings from %s: %w", path, err)
+	}
+	return s, nil
+}
+
+// Save writes the replacements to the file. The file is replaced
+// atomically, so it is never left partially written.
+func (s *JSONFileStore) Save() error {
+	data, err := json.MarshalIndent(s.Mappings(), "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path)
+}
+
+// RemapOption represents an option that can be passed to NewRemapper.
+type RemapOption func(*remapOptSet)
+
+// remapOptSet represents the flattened option set after all RemapOptions have been applied.
+type remapOptSet struct {
+	store UIDStore
+	salt  []byte
+}
+
+// StoreMappings returns a RemapOption that records the replacements in
+// store, instead of in a new MemoryStore.
+func StoreMappings(store UIDStore) RemapOption {
+	return func(set *remapOptSet) {
+		set.store = store
+	}
+}
+
+// HashUIDs returns a RemapOption that derives the replacement of a UID from
+// the UID and salt with HMAC-SHA256, instead of choosing it at random. The
+// same UID is then replaced with the same UID by all Remappers with the same
+// salt, without sharing a UIDStore. Keep salt secret, or the original UIDs
+// can be confirmed by hashing them.
+func HashUIDs(salt []byte) RemapOption {
+	return func(set *remapOptSet) {
+		set.salt = salt
+	}
+}
+
+// Remapper replaces UIDs consistently: every occurrence of a UID is replaced
+// with the same new UID, so the references between instances, series and
+// studies are preserved. It is safe for concurrent use.
+type Remapper struct {
+	opts remapOptSet
+	mu   sync.Mutex
+}
+
+// NewRemapper returns a Remapper that replaces UIDs with random UIDs of the
+// form 2.25.<uuid as decimal>, unless HashUIDs is used.
+func NewRemapper(opts ...RemapOption) *Remapper {
+	r := &Remapper{}
+	for _, opt := range opts {
+		opt(&r.opts)
+	}
+	if r.opts.store == nil {
+		r.opts.store = NewMemoryStore()
+	}
+	return r
+}
+
+// Map returns the replacement of original. UIDs defined by the DICOM standard,
+// like SOP class UIDs, are returned unchanged, but private UIDs are always
+// replaced, so only pass it UIDs of instances.
+func (r *Remapper) Map(original string) (string, error) {
+	original = strings.TrimRight(original, " \x00")
+	if original == "" || strings.HasPrefix(original, dicomRoot) {
+		return original, nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	replacement, ok, err := r.opts.store.Load(original)
+	if err != nil || ok {
+		return replacement, err
+	}
+	if r.opts.salt != nil {
+		replacement = uid.DeriveUID(original, r.opts.salt)
+	} else {
+		replacement = uid.NewUUIDUID()
+	}
+	if err := r.opts.store.Store(original, replacement); err != nil {
+		return "", err
+	}
+	return replacement, nil
+}
+
+// Apply replaces the values of the UI elements of ds that identify instances,
+// such as studies, series, SOP instances and frames of reference, including
+// those nested in sequences and MediaStorageSOPInstanceUID in the meta group.
+// UIDs of SOP classes, transfer syntaxes, implementations and coding schemes
+// are left unchanged, even if they are private.
+func (r *Remapper) Apply(ds *dicom.Dataset) error {
+	var err error
+	ds.Walk(func(path dicom.Path, e *dicom.Element) dicom.WalkAction {
+		if elementVR(e) != "UI" || identifiesType(e.Tag) {
+			return dicom.WalkContinue
+		}
+		if err = r.apply(e); err != nil {
+			err = fmt.Errorf("deid: remapping %v: %w", path, err)
+			return dicom.WalkStop
+		}
+		return dicom.WalkContinue
+	})
+	return err
+}
+
+// apply replaces the values of the UI element e.
+func (r *Remapper) apply(e *dicom.Element) error {
+	values, ok := e.Value.GetValue().([]string)
+	if !ok {
+		return nil
+	}
+	uids := make([]string, len(values))
+	for i, v := range values {
+		replacement, err := r.Map(v)
+		if err != nil {
+			return err
+		}
+		uids[i] = replacement
+	}
+	replacement, err := dicom.NewElementWithVR(e.Tag, "UI", uids)
+	if err != nil {
+		return err
+	}
+	*e = *replacement
+	return nil
+}
@@ -0,0 +1,168 @@
+package deid
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ginuerzh/dicom"
+	"github.com/ginuerzh/dicom/pkg/tag"
+	"github.com/ginuerzh/dicom/pkg/uid"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRemapper_Apply(t *testing.T) {
+	a := newDataset(t, "1.2.3.5", "1.2.3.6")
+	b := newDataset(t, "1.2.3.6", "1.2.3.5")
+	sopClass, err := dicom.NewElementWithVR(tag.SOPClassUID, "UI", []string{uid.CTImageStorage})
+	if err != nil {
+		t.Fatalf("NewElementWithVR: %v", err)
+	}
+	a.Elements = append(a.Elements, sopClass)
+
+	r := NewRemapper()
+	for _, ds := range []*dicom.Dataset{&a, &b} {
+		if err := r.Apply(ds); err != nil {
+			t.Fatalf("Apply: %v", err)
+		}
+	}
+	aValues, bValues := values(&a), values(&b)
+	for _, path := range []string{"(0002,0003)", "(0008,0018)", "(0008,1140)[0].(0008,1155)", "(0020,000d)"} {
+		if !strings.HasPrefix(aValues[path], "[2.25.") {
+			t.Errorf("Apply: got %s %s, want a replaced UID", path, aValues[path])
+		}
+	}
+	if aValues["(0002,0003)"] != aValues["(0008,0018)"] {
+		t.Errorf("Apply: MediaStorageSOPInstanceUID %s does not match SOPInstanceUID %s", aValues["(0002,0003)"], aValues["(0008,0018)"])
+	}
+	if aValues["(0008,0018)"] != bValues["(0008,1140)[0].(0008,1155)"] || aValues["(0020,000d)"] != bValues["(0020,000d)"] {
+		t.Errorf("Apply: UIDs were not replaced consistently: %v, %v", aValues, bValues)
+	}
+	if got := aValues["(0008,0016)"]; got != "["+uid.CTImageStorage+"]" {
+		t.Errorf("Apply: got SOPClassUID %s, want it unchanged", got)
+	}
+	if got := aValues["(0010,0020)"]; got != "[12345]" {
+		t.Errorf("Apply: got PatientID %s, want it unchanged", got)
+	}
+}
+
+func TestRemapper_Apply_PrivateTypeUIDs(t *testing.T) {
+	const (
+		privateSOPClass       = "1.3.6.1.4.1.9590.100.1.2.1"
+		privateTransferSyntax = "1.2.276.0.7230010.3.1.4.1"
+		implementationClass   = "1.2.276.0.7230010.3.0.3.6.4"
+		codingScheme          = "2.16.840.1.113883.6.96"
+	)
+	ds := newDataset(t, "1.2.3.5", "1.2.3.6")
+	for _, e := range []struct {
+		t     tag.Tag
+		value string
+	}{
+		{tag.MediaStorageSOPClassUID, privateSOPClass},
+		{tag.TransferSyntaxUID, privateTransferSyntax},
+		{tag.ImplementationClassUID, implementationClass},
+		{tag.SOPClassUID, privateSOPClass},
+		{tag.CodingSchemeUID, codingScheme},
+		{tag.ReferencedSOPClassUID, privateSOPClass},
+	} {
+		elem, err := dicom.NewElementWithVR(e.t, "UI", []string{e.value})
+		if err != nil {
+			t.Fatalf("NewElementWithVR(%v): %v", e.t, err)
+		}
+		ds.Elements = append(ds.Elements, elem)
+	}
+
+	if err := NewRemapper().Apply(&ds); err != nil {
+		t.Fatalf("Apply: %v", err)
+	}
+	got := values(&ds)
+	for path, want := range map[string]string{
+		"(0002,0002)": privateSOPClass,
+		"(0002,0010)": privateTransferSyntax,
+		"(0002,0012)": implementationClass,
+		"(0008,0016)": privateSOPClass,
+		"(0008,010c)": codingScheme,
+		"(0008,1150)": privateSOPClass,
+	} {
+		if got[path] != "["+want+"]" {
+			t.Errorf("Apply: got %s %s, want it unchanged", path, got[path])
+		}
+	}
+	if !strings.HasPrefix(got["(0008,0018)"], "[2.25.") {
+		t.Errorf("Apply: got SOPInstanceUID %s, want a replaced UID", got["(0008,0018)"])
+	}
+}
+
+func TestRemapper_HashUIDs(t *testing.T) {
+	salt := []byte("salt")
+	a, err := NewRemapper(HashUIDs(salt)).Map("1.2.3.4")
+	if err != nil {
+		t.Fatalf("Map: %v", err)
+	}
+	b, err := NewRemapper(HashUIDs(salt)).Map("1.2.3.4")
+	if err != nil {
+		t.Fatalf("Map: %v", err)
+	}
+	if a != b || !strings.HasPrefix(a, "2.25.") || len(a) > 64 {
+		t.Errorf("Map: got %q and %q, want the same 2.25 UID", a, b)
+	}
+	for _, c := range []struct {
+		uid  string
+		salt []byte
+	}{
+		{"1.2.3.5", salt},
+		{"1.2.3.4", []byte("pepper")},
+	} {
+		if got, _ := NewRemapper(HashUIDs(c.salt)).Map(c.uid); got == a {
+			t.Errorf("Map(%q) with salt %q: got %q, want a different UID", c.uid, c.salt, got)
+		}
+	}
+}
+
+func TestJSONFileStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "uids.json")
+	store, err := OpenJSONFileStore(path)
+	if err != nil {
+		t.Fatalf("OpenJSONFileStore: %v", err)
+	}
+	want := map[string]string{}
+	r := NewRemapper(StoreMappings(store))
+	for _, u := range []string{"1.2.3.4", "1.2.3.5"} {
+		if want[u], err = r.Map(u); err != nil {
+			t.Fatalf("Map: %v", err)
+		}
+	}
+	if err := store.Save(); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	store, err = OpenJSONFileStore(path)
+	if err != nil {
+		t.Fatalf("OpenJSONFileStore: %v", err)
+	}
+	if diff := cmp.Diff(want, store.Mappings()); diff != "" {
+		t.Errorf("OpenJSONFileStore: unexpected mappings (-want +got):\n%s", diff)
+	}
+	got, err := NewRemapper(StoreMappings(store)).Map("1.2.3.4")
+	if err != nil {
+		t.Fatalf("Map: %v", err)
+	}
+	if got != want["1.2.3.4"] {
+		t.Errorf("Map: got %q, want the stored %q", got, want["1.2.3.4"])
+	}
+}
+
+func TestDeidentify_RemapUIDs(t *testing.T) {
+	r := NewRemapper(HashUIDs([]byte("salt")))
+	a := newDataset(t, "1.2.3.5", "1.2.3.6")
+	if err := Deidentify(&a, RemapUIDs(r)); err != nil {
+		t.Fatalf("Deidentify: %v", err)
+	}
+	want, err := NewRemapper(HashUIDs([]byte("salt"))).Map("1.2.3.5")
+	if err != nil {
+		t.Fatalf("Map: %v", err)
+	}
+	if got := values(&a)["(0008,0018)"]; got != "["+want+"]" {
+		t.Errorf("Deidentify: got SOPInstanceUID %s, want [%s]", got, want)
+	}
+}
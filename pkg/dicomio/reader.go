@@ -2,6 +2,7 @@ package dicomio
 
 import (
 	"bufio"
+	"bytes"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -40,6 +41,10 @@ type Reader interface {
 	// ReadString reads an n byte string from the underlying reader. Uses the charset.CodingSystem encoding.
 	// Decoders to read the string, if set.
 	ReadString(n uint32) (string, error)
+	// ReadPersonName reads an n byte PN value from the underlying reader,
+	// decoding each component group with its decoder of the
+	// charset.CodingSystem, see charset.CodingSystem.DecodePersonName.
+	ReadPersonName(n uint32) (string, error)
 	// Skip skips the reader ahead by n bytes.
 	Skip(n int64) error
 	// Peek returns the next n bytes without advancing the reader. This will
@@ -60,6 +65,11 @@ type Reader interface {
 	IsImplicit() bool
 	// SetCodingSystem sets the charset.CodingSystem to be used when ReadString is called.
 	SetCodingSystem(cs charset.CodingSystem)
+	// StartRecording starts keeping a copy of every byte read from the underlying reader, until StopRecording is
+	// called.
+	StartRecording()
+	// StopRecording stops recording and returns the bytes read since StartRecording was called.
+	StopRecording() []byte
 }
 
 type reader struct {
@@ -72,6 +82,8 @@ type reader struct {
 	// cs represents the CodingSystem to use when reading the string. If a particular encoding.
 	// Decoder within this CodingSystem is nil, assume ASCII
 	cs charset.CodingSystem
+	// recording holds the bytes read since StartRecording, if recording.
+	recording *bytes.Buffer
 }
 
 func NewReader(in *bufio.Reader, bo binary.ByteOrder, limit int64) (Reader, error) {
@@ -105,6 +117,9 @@ func (r *reader) Read(p []byte) (int, error) {
 	if n >= 0 {
 		r.bytesRead += int64(n)
 	}
+	if r.recording != nil && n > 0 {
+		r.recording.Write(p[:n])
+	}
 	return n, err
 }
 
@@ -185,6 +200,16 @@ func (r *reader) ReadString(n uint32) (string, error) {
 	}
 	return internalReadString(data, r.cs.Ideographic)
 }
+
+func (r *reader) ReadPersonName(n uint32) (string, error) {
+	data := make([]byte, n)
+	_, err := io.ReadFull(r, data)
+	if err != nil {
+		return "", err
+	}
+	return r.cs.DecodePersonName(data)
+}
+
 func (r *reader) Skip(n int64) error {
 	if r.BytesLeftUntilLimit() < n {
 		// not enough left to skip
@@ -237,3 +262,16 @@ func (r *reader) SetCodingSystem(cs charset.CodingSystem) {
 func (r *reader) Peek(n int) ([]byte, error) {
 	return r.in.Peek(n)
 }
+
+func (r *reader) StartRecording() {
+	r.recording = &bytes.Buffer{}
+}
+
+func (r *reader) StopRecording() []byte {
+	if r.recording == nil {
+		return nil
+	}
+	data := r.recording.Bytes()
+	r.recording = nil
+	return data
+}
@@ -3,14 +3,29 @@ package dicomio
 import (
 	"encoding/binary"
 	"io"
+	"math"
+
+	"github.com/ginuerzh/dicom/pkg/charset"
 )
 
+// zeros is a block of zero bytes used to write padding without allocating.
+var zeros [128]byte
+
 // Writer is a lower level encoder that manages writing out entities to an
 // io.Reader.
 type Writer struct {
 	out      io.Writer
 	bo       binary.ByteOrder
 	implicit bool
+	// scratch is used to encode numeric values without allocating. It is a
+	// pointer so that copies of a Writer share it.
+	scratch *[8]byte
+	// encoder encodes text values in the Specific Character Set. If nil,
+	// they are written unchanged.
+	encoder *charset.Encoder
+	// encoderErr is the reason the Specific Character Set has no encoder, if
+	// it is not supported.
+	encoderErr error
 }
 
 // NewWriter initializes and returns a Writer.
@@ -19,6 +34,7 @@ func NewWriter(out io.Writer, bo binary.ByteOrder, implicit bool) Writer {
 		out:      out,
 		bo:       bo,
 		implicit: implicit,
+		scratch:  new([8]byte),
 	}
 }
 
@@ -31,19 +47,60 @@ func (w *Writer) GetTransferSyntax() (binary.ByteOrder, bool) {
 	return w.bo, w.implicit
 }
 
+// SetEncoder sets the charset.Encoder used by EncodeString, or nil to write
+// strings unchanged. If err is not nil, it is the reason the Specific
+// Character Set has no encoder, and EncodeString fails with it for strings
+// outside the default character repertoire instead of writing them
+// unchanged.
+func (w *Writer) SetEncoder(e *charset.Encoder, err error) {
+	w.encoder, w.encoderErr = e, err
+}
+
+// EncodeString returns v encoded with the charset.Encoder set with
+// SetEncoder, or v itself if there is none.
+func (w *Writer) EncodeString(v string) (string, error) {
+	if w.encoder == nil {
+		if w.encoderErr != nil && !isASCII(v) {
+			return "", w.encoderErr
+		}
+		return v, nil
+	}
+	return w.encoder.Encode(v)
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= 0x80 {
+			return false
+		}
+	}
+	return true
+}
+
 func (w *Writer) WriteZeros(len int) error {
-	zeros := make([]byte, len)
-	_, err := w.out.Write(zeros)
-	return err
+	for len > 0 {
+		n := len
+		if n > cap(zeros) {
+			n = cap(zeros)
+		}
+		if _, err := w.out.Write(zeros[:n]); err != nil {
+			return err
+		}
+		len -= n
+	}
+	return nil
 }
 
 func (w *Writer) WriteString(v string) error {
-	_, err := w.out.Write([]byte(v))
+	_, err := io.WriteString(w.out, v)
 	return err
 }
 
 func (w *Writer) WriteByte(v byte) error {
-	return binary.Write(w.out, w.bo, &v)
+	b := w.buf(1)
+	b[0] = v
+	_, err := w.out.Write(b)
+	return err
 }
 
 func (w *Writer) WriteBytes(v []byte) error {
@@ -52,21 +109,38 @@ func (w *Writer) WriteBytes(v []byte) error {
 }
 
 func (w *Writer) WriteUInt16(v uint16) error {
-	return binary.Write(w.out, w.bo, &v)
+	b := w.buf(2)
+	w.bo.PutUint16(b, v)
+	_, err := w.out.Write(b)
+	return err
 }
 
 func (w *Writer) WriteUInt32(v uint32) error {
-	return binary.Write(w.out, w.bo, &v)
+	b := w.buf(4)
+	w.bo.PutUint32(b, v)
+	_, err := w.out.Write(b)
+	return err
 }
 
 func (w *Writer) WriteUInt64(v uint64) error {
-	return binary.Write(w.out, w.bo, &v)
+	b := w.buf(8)
+	w.bo.PutUint64(b, v)
+	_, err := w.out.Write(b)
+	return err
 }
 
 func (w *Writer) WriteFloat32(v float32) error {
-	return binary.Write(w.out, w.bo, &v)
+	return w.WriteUInt32(math.Float32bits(v))
 }
 
 func (w *Writer) WriteFloat64(v float64) error {
-	return binary.Write(w.out, w.bo, &v)
+	return w.WriteUInt64(math.Float64bits(v))
+}
+
+// buf returns the first n bytes of the scratch buffer.
+func (w *Writer) buf(n int) []byte {
+	if w.scratch == nil {
+		w.scratch = new([8]byte)
+	}
+	return w.scratch[:n]
 }
@@ -1,10 +1,127 @@
 package tag
 
+import (
+	"fmt"
+	"sync"
+)
+
 var (
-	customDict map[Tag]TagInfo
+	customDict  map[Tag]TagInfo
+	privateDict map[PrivateTag]TagInfo
+
+	// keywordIndex maps the names of the standard dictionary to their tags.
+	keywordIndex     map[string]Tag
+	keywordIndexOnce sync.Once
+	// customKeywordIndex maps the names of the custom dictionary to their
+	// tags.
+	customKeywordIndex map[string]Tag
+
+	// overrideDict holds entries that take precedence over the built-in
+	// dictionary, see Dict.RegisterOverride.
+	overrideDict         map[Tag]TagInfo
+	overrideKeywordIndex map[string]Tag
 )
 
 // SetCustomDict sets the custom dictionary.
 func SetCustomDict(dict map[Tag]TagInfo) {
 	customDict = dict
+	customKeywordIndex = make(map[string]Tag, len(dict))
+	for t, info := range dict {
+		customKeywordIndex[info.Name] = t
+	}
+}
+
+// SetOverrideDict sets the override dictionary, whose entries take precedence
+// over the built-in dictionary. Passing nil removes all overrides.
+func SetOverrideDict(dict map[Tag]TagInfo) {
+	overrideDict = dict
+	overrideKeywordIndex = make(map[string]Tag, len(dict))
+	for t, info := range dict {
+		overrideKeywordIndex[info.Name] = t
+	}
+}
+
+// tagRange is a range of group or element numbers of repeating tags, such as
+// the even groups 6000-60FF.
+type tagRange struct {
+	first, last, step uint16
+}
+
+func (r tagRange) contains(v uint16) bool {
+	return v >= r.first && v <= r.last && (v-r.first)%r.step == 0
+}
+
+// repeatingTagInfo is an entry of the standard dictionary for a repeating
+// group or element range.
+type repeatingTagInfo struct {
+	group, element tagRange
+	info           TagInfo
+}
+
+// buildKeywordIndex indexes the names of the standard dictionary. If a name
+// is used by several tags, the tag that is not retired, or else the lowest
+// tag, is used.
+func buildKeywordIndex() {
+	maybeInitTagDict()
+	keywordIndex = make(map[string]Tag, len(tagDict)+len(repeatingDict))
+	for t, info := range tagDict {
+		if other, ok := keywordIndex[info.Name]; ok {
+			otherInfo := tagDict[other]
+			if info.Retired && !otherInfo.Retired || info.Retired == otherInfo.Retired && other.Compare(t) < 0 {
+				continue
+			}
+		}
+		keywordIndex[info.Name] = t
+	}
+	for _, r := range repeatingDict {
+		if _, ok := keywordIndex[r.info.Name]; !ok {
+			keywordIndex[r.info.Name] = r.info.Tag
+		}
+	}
+}
+
+// PrivateTag identifies a private element in a private dictionary. Private
+// element numbers only have meaning relative to the block their Private
+// Creator (gggg,00xx) reserved in a particular dataset, so a private element
+// is identified by its creator, its group, and the low byte of its element
+// number.
+type PrivateTag struct {
+	Creator string
+	Group   uint16
+	Element uint8
+}
+
+// SetPrivateDict sets the private dictionary used by FindPrivate, replacing
+// any entries added before.
+func SetPrivateDict(dict map[PrivateTag]TagInfo) {
+	privateDict = dict
+}
+
+// AddPrivateDict adds entries to the private dictionary used by FindPrivate.
+// Existing entries with the same PrivateTag are replaced.
+func AddPrivateDict(dict map[PrivateTag]TagInfo) {
+	if privateDict == nil {
+		privateDict = make(map[PrivateTag]TagInfo, len(dict))
+	}
+	for k, v := range dict {
+		privateDict[k] = v
+	}
+}
+
+// FindPrivate finds information about the private element t in the block
+// reserved by creator. The returned TagInfo has its Tag set to t and its
+// Creator set to creator. Tags that are not private elements are looked up
+// with Find.
+func FindPrivate(t Tag, creator string) (TagInfo, error) {
+	if !IsPrivate(t.Group) || t.Element < 0x1000 {
+		return Find(t)
+	}
+	entry, ok := privateDict[PrivateTag{Creator: creator, Group: t.Group, Element: uint8(t.Element)}]
+	if !ok {
+		return TagInfo{}, fmt.Errorf("Could not find private tag (0x%x, 0x%x) of creator %q in dictionary", t.Group, t.Element, creator)
+	}
+	entry.Tag = t
+	entry.Source = SourcePrivate
+	entry.Creator = creator
+	return entry, nil
 }
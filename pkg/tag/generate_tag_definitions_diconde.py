@@ -7,11 +7,29 @@ from typing import IO, NamedTuple, List
 logging.basicConfig(level=logging.DEBUG)
 
 Tag = NamedTuple('Tag', [
-    ('group', int),
-    ('elem', int),
+    ('group', str),
+    ('elem', str),
     ('vr', str),
+    ('vrs', List[str]),
     ('name', str),
-    ('vm', str)])
+    ('vm', str),
+    ('retired', bool)])
+
+# DCMTK uses lowercase pseudo VRs for elements whose VR depends on the context
+# they are used in. The first VR is the one used when the context is unknown,
+# e.g. in implicit VR transfer syntaxes.
+PSEUDO_VRS = {
+    # Its generally safe to treat XS as unsigned.  See
+    # https://github.com/dgobbi/vtk-dicom/issues/38 for
+    # some discussions.
+    "xs": ["US", "SS"],
+    # OW is the VR of these elements in implicit VR transfer syntaxes, see
+    # PS3.5 Annex A.1.
+    "ox": ["OW", "OB"],
+    "lt": ["OW", "US"],
+    "up": ["UL"],
+    "na": ["NA"],
+}
 
 def list_tags() -> List[Tag]:
     global DATA
@@ -21,38 +39,53 @@ def list_tags() -> List[Tag]:
     for line in DATA.split("\n"):
         if re.match(r'\s*#', line) or re.match(r'^\s*$', line):
             continue
-        m = re.match(r'\(([^,]+),([^,]+)\)\s+(\w\w)\s+([^\t]+)\s+([^\t]+)', line)
+        m = re.match(r'\(([^,]+),([^,]+)\)\s+(\w\w)\s+([^\t]+)\s+([^\t\s]+)\s+(\S+)', line)
         if not m:
             logging.error("Invalid line: %s", line)
             ok = False
             continue
 
-        vr=m.group(3).upper()
-        if vr == "XS":
-            # Its generally safe to treat XS as unsigned.  See
-            # https://github.com/dgobbi/vtk-dicom/issues/38 for
-	    # some discussions.
-            vr = "US"
-        elif vr == "OX":
-	    # TODO(saito) I'm less sure about the OX rule. Where is
-	    # this crap defined in the standard??
-            vr = "OW"
+        vrs = PSEUDO_VRS.get(m.group(3), [m.group(3).upper()])
+        name = m.group(4).strip()
+        version = m.group(6)
+        if version in ("PRIVATE", "ILLEGAL", "GENERIC"):
+            # Handled by tag.Find.
+            continue
 
         tag = Tag(group=m.group(1),
                   elem=m.group(2),
-                  vr=vr,
-                  name=m.group(4),
-                  vm=m.group(5))
-
-
-        if not re.match('^[0-9A-Fa-f]+$', tag.group) or not re.match('^[0-9A-Fa-f]+$', tag.elem):
-            continue
+                  vr=vrs[0],
+                  vrs=vrs if len(vrs) > 1 else [],
+                  name=name,
+                  vm=m.group(5),
+                  retired=name.find("RETIRED") >= 0 or version.endswith("retired"))
         tags.append(tag)
     if not ok:
         sys.exit(1)
 
     return tags
 
+def is_repeating(t: Tag) -> bool:
+    return not re.match('^[0-9A-Fa-f]+$', t.group) or not re.match('^[0-9A-Fa-f]+$', t.elem)
+
+def tag_range(r: str) -> str:
+    """Converts a DCMTK range such as 6000-60FF or 0010-u-00FF to a tagRange."""
+    parts = r.split("-")
+    if len(parts) == 1:
+        return f'tagRange{{0x{r}, 0x{r}, 1}}'
+    step = 1 if len(parts) == 3 and parts[1] == "u" else 2
+    return f'tagRange{{0x{parts[0].upper()}, 0x{parts[-1].upper()}, {step}}}'
+
+def tag_info(t: Tag, tag: str) -> str:
+    fields = [f'Tag: {tag}', f'VR: "{t.vr}"']
+    if t.vrs:
+        vrs = ", ".join(f'"{vr}"' for vr in t.vrs)
+        fields.append(f'VRs: []string{{{vrs}}}')
+    fields += [f'Name: "{t.name}"', f'VM: "{t.vm}"']
+    if t.retired:
+        fields.append('Retired: true')
+    return f'TagInfo{{{", ".join(fields)}}}'
+
 def generate(out: IO[str]):
     tags = list_tags()
 
@@ -60,7 +93,7 @@ def generate(out: IO[str]):
     print("", file=out)
     print("// Code generated from generate_tag_definitions.py. DO NOT EDIT.", file=out)
     for t in tags:
-        if t.name.find("RETIRED") >= 0:
+        if t.retired or is_repeating(t):
             continue
         print(f'var {t.name} = Tag{{0x{t.group}, 0x{t.elem}}}', file=out)
 
@@ -75,7 +108,19 @@ def generate(out: IO[str]):
     print("	}", file=out)
     print("	tagDict = make(map[Tag]TagInfo)", file=out)
     for t in tags:
-        print(f'	tagDict[Tag{{0x{t.group}, 0x{t.elem}}}] = TagInfo{{Tag{{0x{t.group}, 0x{t.elem}}}, "{t.vr}", "{t.name}", "{t.vm}"}}', file=out)
+        if is_repeating(t):
+            continue
+        tag = f'Tag{{0x{t.group}, 0x{t.elem}}}'
+        print(f'	tagDict[{tag}] = {tag_info(t, tag)}', file=out)
+    print("}", file=out)
+    print("", file=out)
+    print("var repeatingDict = []repeatingTagInfo{", file=out)
+    for t in tags:
+        if not is_repeating(t):
+            continue
+        group, elem = t.group.split("-")[0].upper(), t.elem.split("-")[0].upper()
+        tag = f'Tag{{0x{group}, 0x{elem}}}'
+        print(f'	{{{tag_range(t.group)}, {tag_range(t.elem)}, {tag_info(t, tag)}}},', file=out)
     print("}", file=out)
 
 
@@ -0,0 +1,471 @@
+package tag
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ErrorInvalidDictEntry indicates that an entry of a dictionary file could not
+// be parsed.
+var ErrorInvalidDictEntry = errors.New("invalid dictionary entry")
+
+// Dict is a tag dictionary loaded from a data file with one of the Load*
+// functions. Entries of private elements are keyed by their Private Creator
+// (see FindPrivate), all others by their tag.
+type Dict struct {
+	Tags    map[Tag]TagInfo
+	Private map[PrivateTag]TagInfo
+}
+
+// Register adds the entries of d to the custom and private dictionaries used
+// by Find and FindPrivate. Entries of the built-in dictionary take precedence
+// over custom entries with the same tag, so Register only adds tags the
+// built-in dictionary lacks. Use RegisterOverride to update existing tags.
+func (d Dict) Register() {
+	AddCustomDict(d.Tags)
+	AddPrivateDict(d.Private)
+}
+
+// RegisterOverride is like Register, but the entries of d take precedence
+// over the built-in dictionary, so that loading a newer edition of PS3.6
+// updates the VR, VM, name or retirement of existing tags. Use
+// SetOverrideDict(nil) to remove the overrides again.
+func (d Dict) RegisterOverride() {
+	if overrideDict == nil {
+		overrideDict = make(map[Tag]TagInfo, len(d.Tags))
+		overrideKeywordIndex = make(map[string]Tag, len(d.Tags))
+	}
+	for k, v := range d.Tags {
+		overrideDict[k] = v
+		overrideKeywordIndex[v.Name] = k
+	}
+	AddPrivateDict(d.Private)
+}
+
+// AddCustomDict adds entries to the custom dictionary. Existing entries with
+// the same Tag are replaced.
+func AddCustomDict(dict map[Tag]TagInfo) {
+	if customDict == nil {
+		customDict = make(map[Tag]TagInfo, len(dict))
+		customKeywordIndex = make(map[string]Tag, len(dict))
+	}
+	for k, v := range dict {
+		customDict[k] = v
+		customKeywordIndex[v.Name] = k
+	}
+}
+
+func newDict() Dict {
+	return Dict{Tags: make(map[Tag]TagInfo), Private: make(map[PrivateTag]TagInfo)}
+}
+
+// dcmtkPrivateTag matches the DCMTK notation of private tags,
+// (gggg,"creator",ee).
+var dcmtkPrivateTag = regexp.MustCompile(`^\(([^,]+),"([^"]*)",([0-9A-Fa-f]{2})\)$`)
+
+// LoadDCMTKDict loads a dictionary in the text format of DCMTK's dicom.dic and
+// private.dic: one tab separated entry per line, with the tag, VR, name, VM
+// and version columns, and # comments. Repeating groups and elements such as
+// (6000-60FF,3000) or (0028,04x0) are expanded, and private entries written as
+// (gggg,"creator",ee) are keyed by their creator. Entries of the GENERIC,
+// PRIVATE and ILLEGAL versions, which describe group lengths and private
+// creators of whole ranges of groups that Find already handles, are skipped.
+func LoadDCMTKDict(r io.Reader) (Dict, error) {
+	d := newDict()
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.Split(text, "\t")
+		if len(fields) < 4 {
+			return Dict{}, fmt.Errorf("%w: line %d: expected at least 4 tab separated fields, got %q", ErrorInvalidDictEntry, line, text)
+		}
+
+		if len(fields) > 4 {
+			switch strings.TrimSpace(fields[4]) {
+			case "GENERIC", "PRIVATE", "ILLEGAL":
+				continue
+			}
+		}
+
+		t, creator := strings.TrimSpace(fields[0]), ""
+		if m := dcmtkPrivateTag.FindStringSubmatch(t); m != nil {
+			t, creator = "("+m[1]+","+m[3]+")", m[2]
+		}
+		info := TagInfo{Name: strings.TrimSpace(fields[2]), VM: strings.TrimSpace(fields[3])}
+		info.VR, info.VRs = dcmtkVR(strings.TrimSpace(fields[1]))
+		info.Retired = strings.HasPrefix(info.Name, "RETIRED_") || len(fields) > 4 && strings.HasSuffix(strings.TrimSpace(fields[4]), "retired")
+		if err := d.add(t, creator, info); err != nil {
+			return Dict{}, fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return Dict{}, err
+	}
+	return d, nil
+}
+
+// dcmtkVR maps the lowercase pseudo VRs DCMTK uses for elements whose VR
+// depends on context to the VRs the built-in dictionary uses for them.
+func dcmtkVR(vr string) (string, []string) {
+	switch vr {
+	case "xs":
+		return "US", []string{"US", "SS"}
+	case "ox", "px":
+		return "OW", []string{"OW", "OB"}
+	case "lt":
+		return "OW", []string{"OW", "US"}
+	case "up":
+		return "UL", nil
+	case "na":
+		return "NA", nil
+	}
+	return vr, nil
+}
+
+// LoadPart6XML loads the data dictionary tables of the DocBook XML edition of
+// DICOM PS3.6 (part06.xml). Every table row with a tag, name, keyword, VR and
+// VM column is loaded, so the registry of data elements as well as the file
+// meta, directory structuring and command element tables are picked up.
+// Retired elements are marked Retired and get the RETIRED_ name prefix the
+// built-in dictionary uses, and rows without a keyword or a usable VR are
+// skipped.
+func LoadPart6XML(r io.Reader) (Dict, error) {
+	d := newDict()
+	decoder := xml.NewDecoder(r)
+	var (
+		row    []string
+		cell   *strings.Builder
+		inRow  bool
+		inCell int
+	)
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return Dict{}, err
+		}
+		switch token := token.(type) {
+		case xml.StartElement:
+			switch token.Name.Local {
+			case "tr":
+				row, inRow = row[:0], true
+			case "td", "th":
+				if inRow {
+					if inCell == 0 {
+						cell = &strings.Builder{}
+					}
+					inCell++
+				}
+			case "para":
+				// Paragraphs of a cell are separate words.
+				if inCell > 0 && cell.Len() > 0 {
+					cell.WriteByte(' ')
+				}
+			}
+		case xml.CharData:
+			if inCell > 0 {
+				cell.Write(token)
+			}
+		case xml.EndElement:
+			switch token.Name.Local {
+			case "td", "th":
+				if inCell > 0 {
+					inCell--
+					if inCell == 0 {
+						row = append(row, cleanPart6Text(cell.String()))
+					}
+				}
+			case "tr":
+				if inRow {
+					if err := d.addPart6Row(row); err != nil {
+						return Dict{}, err
+					}
+				}
+				inRow = false
+			}
+		}
+	}
+	return d, nil
+}
+
+// cleanPart6Text removes the zero width spaces PS3.6 inserts into long
+// keywords and collapses white space.
+func cleanPart6Text(s string) string {
+	s = strings.ReplaceAll(s, "\u200b", "")
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func (d Dict) addPart6Row(row []string) error {
+	if len(row) < 5 || !strings.HasPrefix(row[0], "(") {
+		return nil
+	}
+	t, vr := row[0], row[3]
+	info := TagInfo{Name: row[2], VM: row[4]}
+	if info.Name == "" {
+		return nil
+	}
+	if strings.HasPrefix(strings.ToUpper(t), "(FFFE,") {
+		// Item and delimitation items have no VR of their own.
+		vr = "NA"
+	}
+	info.VR, info.VRs = normalizeVR(vr)
+	if !isValidVR(info.VR) {
+		return nil
+	}
+	if len(row) > 5 && strings.HasPrefix(row[5], "RET") {
+		info.Name = "RETIRED_" + info.Name
+		info.Retired = true
+	}
+	return d.add(t, "", info)
+}
+
+// jsonDictEntry is an entry of a JSON dictionary, see LoadJSONDict.
+type jsonDictEntry struct {
+	Tag     string `json:"tag"`
+	Creator string `json:"creator"`
+	VR      string `json:"vr"`
+	Name    string `json:"name"`
+	VM      string `json:"vm"`
+	Retired bool   `json:"retired"`
+}
+
+// LoadJSONDict loads a dictionary from a JSON array of entries of the form
+//
+//	{"tag": "(0029,1010)", "creator": "ACME 1.0", "vr": "DS", "name": "AcmeScale", "vm": "1"}
+//
+// The creator is only set for private elements, whose entries apply to any
+// block the creator reserved. Tags may use the repeating notations of
+// LoadDCMTKDict.
+func LoadJSONDict(r io.Reader) (Dict, error) {
+	var entries []jsonDictEntry
+	if err := json.NewDecoder(r).Decode(&entries); err != nil {
+		return Dict{}, err
+	}
+	d := newDict()
+	for i, e := range entries {
+		info := TagInfo{Name: e.Name, VM: e.VM, Retired: e.Retired}
+		info.VR, info.VRs = normalizeVR(e.VR)
+		if err := d.add(e.Tag, e.Creator, info); err != nil {
+			return Dict{}, fmt.Errorf("entry %d: %w", i, err)
+		}
+	}
+	return d, nil
+}
+
+// LoadCSVDict loads a dictionary from CSV with a header row naming the tag,
+// vr, name and vm columns, and optionally creator and retired columns, in any
+// order.
+// The columns have the same meaning as the fields of LoadJSONDict; tags are
+// either quoted, as in "(0029,1010)", or written as 00291010.
+func LoadCSVDict(r io.Reader) (Dict, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		return Dict{}, err
+	}
+	columns := map[string]int{"creator": -1, "retired": -1}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range []string{"tag", "vr", "name", "vm"} {
+		if _, ok := columns[name]; !ok {
+			return Dict{}, fmt.Errorf("%w: missing %q column", ErrorInvalidDictEntry, name)
+		}
+	}
+
+	d := newDict()
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return Dict{}, err
+		}
+		field := func(name string) string {
+			if i := columns[name]; i >= 0 && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		info := TagInfo{Name: field("name"), VM: field("vm")}
+		info.VR, info.VRs = normalizeVR(field("vr"))
+		info.Retired, _ = strconv.ParseBool(field("retired"))
+		if err := d.add(field("tag"), field("creator"), info); err != nil {
+			return Dict{}, fmt.Errorf("line %d: %w", line, err)
+		}
+	}
+	return d, nil
+}
+
+// add adds the entries described by the tag pattern t to d.
+func (d Dict) add(t, creator string, info TagInfo) error {
+	for _, vr := range append([]string{info.VR}, info.VRs...) {
+		if !isValidVR(vr) {
+			return fmt.Errorf("%w: %s has invalid VR %q", ErrorInvalidDictEntry, t, vr)
+		}
+	}
+	if info.Name == "" {
+		return fmt.Errorf("%w: %s has no name", ErrorInvalidDictEntry, t)
+	}
+	groupPattern, elementPattern, err := splitTagPattern(t)
+	if err != nil {
+		return err
+	}
+	groups, err := expandTagPattern(groupPattern, true)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrorInvalidDictEntry, t, err)
+	}
+
+	if creator != "" {
+		// Only the low byte of a private element is fixed, the high byte is
+		// the block its creator reserved.
+		if len(elementPattern) < 2 {
+			return fmt.Errorf("%w: %s: invalid private element", ErrorInvalidDictEntry, t)
+		}
+		element, err := strconv.ParseUint(elementPattern[len(elementPattern)-2:], 16, 8)
+		if err != nil {
+			return fmt.Errorf("%w: %s: %v", ErrorInvalidDictEntry, t, err)
+		}
+		for _, group := range groups {
+			d.Private[PrivateTag{Creator: creator, Group: group, Element: uint8(element)}] = info
+		}
+		return nil
+	}
+
+	elements, err := expandTagPattern(elementPattern, false)
+	if err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrorInvalidDictEntry, t, err)
+	}
+	for _, group := range groups {
+		for _, element := range elements {
+			info.Tag = Tag{Group: group, Element: element}
+			d.Tags[info.Tag] = info
+		}
+	}
+	return nil
+}
+
+// splitTagPattern splits "(gggg,eeee)" or "ggggeeee" into its group and
+// element parts.
+func splitTagPattern(t string) (string, string, error) {
+	t = strings.Trim(strings.TrimSpace(t), "()")
+	if len(t) == 8 && !strings.ContainsAny(t, ",-") {
+		return t[:4], t[4:], nil
+	}
+	parts := strings.Split(t, ",")
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("%w: invalid tag %q", ErrorInvalidDictEntry, t)
+	}
+	return strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1]), nil
+}
+
+// expandTagPattern returns the group or element numbers matched by p, which
+// is either four hex digits where x stands for any digit ("60xx"), or a range
+// "aaaa-bbbb" of even numbers, "aaaa-o-bbbb" of odd numbers or "aaaa-u-bbbb"
+// of all numbers. If group is set, wildcards only match even numbers, as
+// repeating groups are always even.
+func expandTagPattern(p string, group bool) ([]uint16, error) {
+	if parts := strings.Split(p, "-"); len(parts) > 1 {
+		step, first, last := uint64(2), parts[0], parts[len(parts)-1]
+		switch {
+		case len(parts) == 3 && strings.EqualFold(parts[1], "u"):
+			step = 1
+		case len(parts) == 3 && strings.EqualFold(parts[1], "o"):
+		case len(parts) != 2:
+			return nil, fmt.Errorf("invalid range %q", p)
+		}
+		from, err := strconv.ParseUint(first, 16, 16)
+		if err != nil {
+			return nil, err
+		}
+		to, err := strconv.ParseUint(last, 16, 16)
+		if err != nil {
+			return nil, err
+		}
+		var values []uint16
+		for v := from; v <= to; v += step {
+			values = append(values, uint16(v))
+		}
+		return values, nil
+	}
+
+	if len(p) != 4 {
+		return nil, fmt.Errorf("invalid tag part %q", p)
+	}
+	wildcards := strings.Count(strings.ToLower(p), "x")
+	if wildcards == 0 {
+		v, err := strconv.ParseUint(p, 16, 16)
+		if err != nil {
+			return nil, err
+		}
+		return []uint16{uint16(v)}, nil
+	}
+	values := make([]uint16, 0, 1<<(4*wildcards))
+	step := 1
+	if group {
+		step = 2
+	}
+	for n := 0; n < 1<<(4*wildcards); n += step {
+		digits, next := []byte(p), n
+		for i := len(digits) - 1; i >= 0; i-- {
+			if digits[i] == 'x' || digits[i] == 'X' {
+				digits[i] = "0123456789abcdef"[next&0xf]
+				next >>= 4
+			}
+		}
+		v, err := strconv.ParseUint(string(digits), 16, 16)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, uint16(v))
+	}
+	return values, nil
+}
+
+// normalizeVR parses the VR column of a dictionary. Elements that may have
+// one of several VRs ("US or SS", "OB or OW") get all of them as VRs, and the
+// VR the built-in dictionary uses for them as VR: OW if it is allowed, as
+// required in implicit VR transfer syntaxes, or else US.
+func normalizeVR(vr string) (string, []string) {
+	vr = strings.ToUpper(strings.TrimSpace(vr))
+	switch vr {
+	case "XS":
+		return "US", []string{"US", "SS"}
+	case "OX":
+		return "OW", []string{"OW", "OB"}
+	}
+	alternatives := strings.Split(vr, " OR ")
+	if len(alternatives) == 1 {
+		return vr, nil
+	}
+	primary := 0
+	for i := range alternatives {
+		alternatives[i] = strings.TrimSpace(alternatives[i])
+		if alternatives[i] == "OW" || alternatives[i] == "US" && alternatives[primary] != "OW" {
+			primary = i
+		}
+	}
+	alternatives[0], alternatives[primary] = alternatives[primary], alternatives[0]
+	return alternatives[0], alternatives
+}
+
+// isValidVR reports whether vr is a two letter VR.
+func isValidVR(vr string) bool {
+	return len(vr) == 2 && vr[0] >= 'A' && vr[0] <= 'Z' && vr[1] >= 'A' && vr[1] <= 'Z'
+}
@@ -0,0 +1,191 @@
+package tag
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLoadDCMTKDict(t *testing.T) {
+	data := `# comment
+(0009,0010)	LO	AcmeBlock	1	ACME
+(6000-60ff,3000)	ox	OverlayData	1	DICOM
+(0028,04x0)	US	RowsForNthOrderCoefficients	1	DICOM/retired
+(0029,"ACME 1.0",10)	DS	AcmeScale	1	PrivateTag
+(0029,"ACME 1.0",11)	xs	AcmeCount	1-n	PrivateTag
+(0009-o-ffff,0000)	UL	PrivateGroupLength	1	PRIVATE
+(0009-o-ffff,0010-u-00ff)	LO	PrivateCreator	1	PRIVATE
+(0001-o-0007,0000)	UL	IllegalGroupLength	1	ILLEGAL
+(0000-u-ffff,0000)	UL	GenericGroupLength	1	GENERIC
+`
+	d, err := LoadDCMTKDict(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("LoadDCMTKDict: %v", err)
+	}
+
+	if got, want := len(d.Tags), 1+128+16; got != want {
+		t.Errorf("LoadDCMTKDict: got %d tags, want %d", got, want)
+	}
+	for tg, want := range map[Tag]TagInfo{
+		{0x0009, 0x0010}: {Tag: Tag{0x0009, 0x0010}, VR: "LO", Name: "AcmeBlock", VM: "1"},
+		{0x6000, 0x3000}: {Tag: Tag{0x6000, 0x3000}, VR: "OW", VRs: []string{"OW", "OB"}, Name: "OverlayData", VM: "1"},
+		{0x60fe, 0x3000}: {Tag: Tag{0x60fe, 0x3000}, VR: "OW", VRs: []string{"OW", "OB"}, Name: "OverlayData", VM: "1"},
+		{0x0028, 0x04f0}: {Tag: Tag{0x0028, 0x04f0}, VR: "US", Name: "RowsForNthOrderCoefficients", VM: "1", Retired: true},
+	} {
+		if diff := cmp.Diff(want, d.Tags[tg]); diff != "" {
+			t.Errorf("LoadDCMTKDict %v: unexpected entry (-want +got):\n%s", tg, diff)
+		}
+	}
+	if _, ok := d.Tags[Tag{0x6001, 0x3000}]; ok {
+		t.Errorf("LoadDCMTKDict: odd group of an even range was loaded")
+	}
+	want := map[PrivateTag]TagInfo{
+		{Creator: "ACME 1.0", Group: 0x0029, Element: 0x10}: {VR: "DS", Name: "AcmeScale", VM: "1"},
+		{Creator: "ACME 1.0", Group: 0x0029, Element: 0x11}: {VR: "US", VRs: []string{"US", "SS"}, Name: "AcmeCount", VM: "1-n"},
+	}
+	if diff := cmp.Diff(want, d.Private); diff != "" {
+		t.Errorf("LoadDCMTKDict: unexpected private entries (-want +got):\n%s", diff)
+	}
+
+	if _, err := LoadDCMTKDict(strings.NewReader("(0009,0010)\tLO\n")); !errors.Is(err, ErrorInvalidDictEntry) {
+		t.Errorf("LoadDCMTKDict of a short line: got %v, want %v", err, ErrorInvalidDictEntry)
+	}
+}
+
+func TestLoadPart6XML(t *testing.T) {
+	data := `<?xml version="1.0" encoding="utf-8"?>
+<book xmlns="http://docbook.org/ns/docbook">
+<table><thead><tr><th><para>Tag</para></th><th><para>Name</para></th><th><para>Keyword</para></th><th><para>VR</para></th><th><para>VM</para></th><th/></tr></thead>
+<tbody>
+<tr><td><para>(0008,0005)</para></td><td><para>Specific Character Set</para></td><td><para>SpecificCharacter&#8203;Set</para></td><td><para>CS</para></td><td><para>1-n</para></td><td/></tr>
+<tr><td><para>(0008,0010)</para></td><td><para>Recognition Code</para></td><td><para>RecognitionCode</para></td><td><para>SH</para></td><td><para>1</para></td><td><para>RET</para></td></tr>
+<tr><td><para>(0028,1101)</para></td><td><para>Red Palette Color Lookup Table Descriptor</para></td><td><para>RedPaletteColorLookupTableDescriptor</para></td><td><para>US or SS</para></td><td><para>3</para></td><td/></tr>
+<tr><td><para>(60xx,3000)</para></td><td><para>Overlay Data</para></td><td><para>OverlayData</para></td><td><para>OB or OW</para></td><td><para>1</para></td><td/></tr>
+<tr><td><para>(FFFE,E000)</para></td><td><para>Item</para></td><td><para>Item</para></td><td><para>See Note</para></td><td><para>1</para></td><td/></tr>
+<tr><td><para>(0018,9445)</para></td><td><para/></td><td><para/></td><td><para/></td><td><para/></td><td><para>RET</para></td></tr>
+</tbody></table>
+</book>`
+	d, err := LoadPart6XML(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("LoadPart6XML: %v", err)
+	}
+	if got, want := len(d.Tags), 4+128; got != want {
+		t.Errorf("LoadPart6XML: got %d tags, want %d", got, want)
+	}
+	for tg, want := range map[Tag]TagInfo{
+		{0x0008, 0x0005}: {Tag: Tag{0x0008, 0x0005}, VR: "CS", Name: "SpecificCharacterSet", VM: "1-n"},
+		{0x0008, 0x0010}: {Tag: Tag{0x0008, 0x0010}, VR: "SH", Name: "RETIRED_RecognitionCode", VM: "1", Retired: true},
+		{0x0028, 0x1101}: {Tag: Tag{0x0028, 0x1101}, VR: "US", VRs: []string{"US", "SS"}, Name: "RedPaletteColorLookupTableDescriptor", VM: "3"},
+		{0x60a2, 0x3000}: {Tag: Tag{0x60a2, 0x3000}, VR: "OW", VRs: []string{"OW", "OB"}, Name: "OverlayData", VM: "1"},
+		{0xfffe, 0xe000}: {Tag: Tag{0xfffe, 0xe000}, VR: "NA", Name: "Item", VM: "1"},
+	} {
+		if diff := cmp.Diff(want, d.Tags[tg]); diff != "" {
+			t.Errorf("LoadPart6XML %v: unexpected entry (-want +got):\n%s", tg, diff)
+		}
+	}
+}
+
+func TestLoadJSONDict(t *testing.T) {
+	data := `[
+		{"tag": "(0009,0010)", "vr": "LO", "name": "AcmeBlock", "vm": "1"},
+		{"tag": "(0029,xx10)", "creator": "ACME 1.0", "vr": "ds", "name": "AcmeScale", "vm": "1"}
+	]`
+	d, err := LoadJSONDict(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("LoadJSONDict: %v", err)
+	}
+	want := Dict{
+		Tags: map[Tag]TagInfo{
+			{0x0009, 0x0010}: {Tag: Tag{0x0009, 0x0010}, VR: "LO", Name: "AcmeBlock", VM: "1"},
+		},
+		Private: map[PrivateTag]TagInfo{
+			{Creator: "ACME 1.0", Group: 0x0029, Element: 0x10}: {VR: "DS", Name: "AcmeScale", VM: "1"},
+		},
+	}
+	if diff := cmp.Diff(want, d); diff != "" {
+		t.Errorf("LoadJSONDict: unexpected dict (-want +got):\n%s", diff)
+	}
+
+	if _, err := LoadJSONDict(strings.NewReader(`[{"tag": "(0009,0010)", "vr": "LONG", "name": "x"}]`)); !errors.Is(err, ErrorInvalidDictEntry) {
+		t.Errorf("LoadJSONDict with an invalid VR: got %v, want %v", err, ErrorInvalidDictEntry)
+	}
+}
+
+func TestLoadCSVDict(t *testing.T) {
+	data := `name,tag,vr,vm,creator
+AcmeBlock,"(0009,0010)",LO,1,
+AcmeScale,00291010,DS,1,ACME 1.0
+`
+	d, err := LoadCSVDict(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("LoadCSVDict: %v", err)
+	}
+	want := Dict{
+		Tags: map[Tag]TagInfo{
+			{0x0009, 0x0010}: {Tag: Tag{0x0009, 0x0010}, VR: "LO", Name: "AcmeBlock", VM: "1"},
+		},
+		Private: map[PrivateTag]TagInfo{
+			{Creator: "ACME 1.0", Group: 0x0029, Element: 0x10}: {VR: "DS", Name: "AcmeScale", VM: "1"},
+		},
+	}
+	if diff := cmp.Diff(want, d); diff != "" {
+		t.Errorf("LoadCSVDict: unexpected dict (-want +got):\n%s", diff)
+	}
+
+	if _, err := LoadCSVDict(strings.NewReader("tag,vr,vm\n")); !errors.Is(err, ErrorInvalidDictEntry) {
+		t.Errorf("LoadCSVDict without a name column: got %v, want %v", err, ErrorInvalidDictEntry)
+	}
+}
+
+func TestDict_Register(t *testing.T) {
+	d, err := LoadJSONDict(strings.NewReader(`[
+		{"tag": "(0009,1001)", "vr": "LO", "name": "AcmeBlock", "vm": "1"},
+		{"tag": "(0029,1010)", "creator": "ACME 1.0", "vr": "DS", "name": "AcmeScale", "vm": "1"}
+	]`))
+	if err != nil {
+		t.Fatalf("LoadJSONDict: %v", err)
+	}
+	d.Register()
+	defer SetCustomDict(nil)
+	defer SetPrivateDict(nil)
+
+	if elem, err := Find(Tag{0x0009, 0x1001}); err != nil || elem.Name != "AcmeBlock" {
+		t.Errorf("Find after Register: got %+v, %v", elem, err)
+	}
+	if elem, err := FindPrivate(Tag{0x0029, 0x1210}, "ACME 1.0"); err != nil || elem.Name != "AcmeScale" {
+		t.Errorf("FindPrivate after Register: got %+v, %v", elem, err)
+	}
+}
+
+func TestDict_RegisterOverride(t *testing.T) {
+	d, err := LoadJSONDict(strings.NewReader(`[
+		{"tag": "(0028,0010)", "vr": "UL", "name": "Rows", "vm": "1", "retired": true},
+		{"tag": "(0009,1001)", "vr": "LO", "name": "AcmeBlock", "vm": "1"}
+	]`))
+	if err != nil {
+		t.Fatalf("LoadJSONDict: %v", err)
+	}
+
+	d.Register()
+	if elem, err := Find(Rows); err != nil || elem.VR != "US" || elem.Source != SourceStandard {
+		t.Errorf("Find(Rows) after Register: got %+v, %v, want the built-in entry", elem, err)
+	}
+	SetCustomDict(nil)
+
+	d.RegisterOverride()
+	defer SetOverrideDict(nil)
+	want := TagInfo{Tag: Rows, VR: "UL", Name: "Rows", VM: "1", Retired: true, Source: SourceCustom}
+	if elem, err := Find(Rows); err != nil || !cmp.Equal(elem, want) {
+		t.Errorf("Find(Rows) after RegisterOverride: got %+v, %v, want %+v", elem, err, want)
+	}
+	if elem, err := FindByName("AcmeBlock"); err != nil || elem.Tag != (Tag{0x0009, 0x1001}) {
+		t.Errorf("FindByName(AcmeBlock) after RegisterOverride: got %+v, %v", elem, err)
+	}
+
+	SetOverrideDict(nil)
+	if elem, err := Find(Rows); err != nil || elem.VR != "US" {
+		t.Errorf("Find(Rows) after SetOverrideDict(nil): got %+v, %v", elem, err)
+	}
+}
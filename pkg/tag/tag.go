@@ -51,6 +51,19 @@ func IsPrivate(group uint16) bool {
 	return group%2 == 1
 }
 
+// IsPrivateCreator reports whether t is a Private Creator element
+// (gggg,0010-00FF), which reserves the block of private elements
+// (gggg,xx00-xxFF) of its group for a particular creator.
+func IsPrivateCreator(t Tag) bool {
+	return IsPrivate(t.Group) && t.Group > 0x0007 && t.Group != 0xFFFF && t.Element >= 0x0010 && t.Element <= 0x00FF
+}
+
+// PrivateCreatorTag returns the tag of the Private Creator element that
+// reserves the block holding the private element t.
+func PrivateCreatorTag(t Tag) Tag {
+	return Tag{Group: t.Group, Element: t.Element >> 8}
+}
+
 // String returns a string of form "(0008,1234)", where 0x0008 is t.Group,
 // 0x1234 is t.Element.
 func (t Tag) String() string {
@@ -63,10 +76,71 @@ type TagInfo struct {
 	Tag Tag
 	// Data encoding "UL", "CS", etc.
 	VR string
+	// VRs lists all VRs of elements whose VR depends on the context they are
+	// used in, e.g. "US or SS", with VR first. It is nil for elements with a
+	// single VR.
+	VRs []string
 	// Human-readable name of the tag, e.g., "CommandDataSetType"
 	Name string
 	// Cardinality (# of values expected in the element)
 	VM string
+	// Retired is set for elements retired from the standard.
+	Retired bool
+	// Source is the dictionary the tag was found in.
+	Source Source
+	// Creator is the Private Creator that defines a private tag, as found by
+	// FindPrivate. It is empty for standard tags.
+	Creator string
+}
+
+// HasVR reports whether vr is a valid VR for the element.
+func (t TagInfo) HasVR(vr string) bool {
+	if len(t.VRs) == 0 {
+		return t.VR == vr
+	}
+	for _, v := range t.VRs {
+		if v == vr {
+			return true
+		}
+	}
+	return false
+}
+
+// Multiplicity returns the parsed VM of the element, see ParseVM.
+func (t TagInfo) Multiplicity() (Multiplicity, error) {
+	return ParseVM(t.VM)
+}
+
+// Source identifies the dictionary a TagInfo was found in.
+type Source int
+
+const (
+	// SourceStandard means the tag is defined by the DICOM standard.
+	SourceStandard Source = iota
+	// SourceRepeating means the tag is defined by the DICOM standard as part
+	// of a repeating group or element range, such as (60xx,3000) or
+	// (gggg,0010-00FF).
+	SourceRepeating
+	// SourcePrivate means the tag was found in the private dictionary of its
+	// Private Creator.
+	SourcePrivate
+	// SourceCustom means the tag was found in the custom dictionary, or in
+	// the override dictionary (see Dict.RegisterOverride).
+	SourceCustom
+)
+
+func (s Source) String() string {
+	switch s {
+	case SourceStandard:
+		return "standard"
+	case SourceRepeating:
+		return "repeating"
+	case SourcePrivate:
+		return "private"
+	case SourceCustom:
+		return "custom"
+	}
+	return "Source(" + strconv.Itoa(int(s)) + ")"
 }
 
 // MetadataGroup is the value of Tag.Group for metadata tags.
@@ -150,21 +224,41 @@ func GetVRKind(tag Tag, vr string) VRKind {
 	}
 }
 
-// Find finds information about the given tag. If the tag is not part of
-// the DICOM standard, or is retired from the standard, it returns an error.
+// Find finds information about the given tag. If the tag is not part of the
+// DICOM standard or a registered dictionary, it returns an error. Retired tags
+// are found as well, with Retired set.
 func Find(tag Tag) (TagInfo, error) {
 	maybeInitTagDict()
+	if entry, ok := overrideDict[tag]; ok {
+		entry.Source = SourceCustom
+		return entry, nil
+	}
 	if entry, ok := tagDict[tag]; ok {
 		return entry, nil
 	}
 
 	// (0000-u-ffff,0000)	UL	GenericGroupLength	1	GENERIC
 	if tag.Element == 0x0000 {
-		return TagInfo{tag, "UL", "GenericGroupLength", "1"}, nil
+		return TagInfo{Tag: tag, VR: "UL", Name: "GenericGroupLength", VM: "1", Source: SourceRepeating}, nil
+	}
+
+	// (gggg,0010-00ff)	LO	PrivateCreator	1
+	if IsPrivateCreator(tag) {
+		return TagInfo{Tag: tag, VR: "LO", Name: "PrivateCreator", VM: "1", Source: SourceRepeating}, nil
+	}
+
+	for _, r := range repeatingDict {
+		if r.group.contains(tag.Group) && r.element.contains(tag.Element) {
+			entry := r.info
+			entry.Tag = tag
+			entry.Source = SourceRepeating
+			return entry, nil
+		}
 	}
 
 	if len(customDict) > 0 {
 		if entry, ok := customDict[tag]; ok {
+			entry.Source = SourceCustom
 			return entry, nil
 		}
 	}
@@ -181,21 +275,41 @@ func MustFind(tag Tag) TagInfo {
 	return e
 }
 
-// FindByName finds information about the tag with the given name. If the tag is
-// not part of the DICOM standard, or is retired from the standard, it returns
-// an error.
+// FindByName finds information about the tag with the given name. If no tag
+// has that name, it returns an error. Retired tags are found as well, by
+// their RETIRED_ prefixed name, with Retired set. Names of the override and
+// custom dictionaries are found too. For repeating groups, the tag of the
+// first group is returned.
 //
 //   Example: FindTagByName("TransferSyntaxUID")
 func FindByName(name string) (TagInfo, error) {
-	maybeInitTagDict()
-	for _, ent := range tagDict {
-		if ent.Name == name {
-			return ent, nil
-		}
+	keywordIndexOnce.Do(buildKeywordIndex)
+	if t, ok := overrideKeywordIndex[name]; ok {
+		return Find(t)
+	}
+	if t, ok := keywordIndex[name]; ok {
+		return Find(t)
+	}
+	if t, ok := customKeywordIndex[name]; ok {
+		return Find(t)
 	}
 	return TagInfo{}, fmt.Errorf("Could not find tag with name %s", name)
 }
 
+// PrivateDebugString is like DebugString, but looks up private tags in the
+// private dictionary of the provided Private Creator, and includes the creator
+// in the format "(group, elem)[creator:name]".
+func PrivateDebugString(tag Tag, creator string) string {
+	if creator == "" {
+		return DebugString(tag)
+	}
+	e, err := FindPrivate(tag, creator)
+	if err != nil {
+		return fmt.Sprintf("(%04x,%04x)[%s:private]", tag.Group, tag.Element, creator)
+	}
+	return fmt.Sprintf("(%04x,%04x)[%s:%s]", tag.Group, tag.Element, creator, e.Name)
+}
+
 // DebugString returns a human-readable diagnostic string for the tag, in format
 // "(group, elem)[name]".
 func DebugString(tag Tag) string {
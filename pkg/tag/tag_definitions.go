@@ -3380,3748 +3380,3844 @@ func maybeInitTagDict() {
 		return
 	}
 	tagDict = make(map[Tag]TagInfo)
-	tagDict[Tag{0x0000, 0x0000}] = TagInfo{Tag{0x0000, 0x0000}, "UL", "CommandGroupLength", "1"}
-	tagDict[Tag{0x0000, 0x0002}] = TagInfo{Tag{0x0000, 0x0002}, "UI", "AffectedSOPClassUID", "1"}
-	tagDict[Tag{0x0000, 0x0003}] = TagInfo{Tag{0x0000, 0x0003}, "UI", "RequestedSOPClassUID", "1"}
-	tagDict[Tag{0x0000, 0x0100}] = TagInfo{Tag{0x0000, 0x0100}, "US", "CommandField", "1"}
-	tagDict[Tag{0x0000, 0x0110}] = TagInfo{Tag{0x0000, 0x0110}, "US", "MessageID", "1"}
-	tagDict[Tag{0x0000, 0x0120}] = TagInfo{Tag{0x0000, 0x0120}, "US", "MessageIDBeingRespondedTo", "1"}
-	tagDict[Tag{0x0000, 0x0600}] = TagInfo{Tag{0x0000, 0x0600}, "AE", "MoveDestination", "1"}
-	tagDict[Tag{0x0000, 0x0700}] = TagInfo{Tag{0x0000, 0x0700}, "US", "Priority", "1"}
-	tagDict[Tag{0x0000, 0x0800}] = TagInfo{Tag{0x0000, 0x0800}, "US", "CommandDataSetType", "1"}
-	tagDict[Tag{0x0000, 0x0900}] = TagInfo{Tag{0x0000, 0x0900}, "US", "Status", "1"}
-	tagDict[Tag{0x0000, 0x0901}] = TagInfo{Tag{0x0000, 0x0901}, "AT", "OffendingElement", "1-n"}
-	tagDict[Tag{0x0000, 0x0902}] = TagInfo{Tag{0x0000, 0x0902}, "LO", "ErrorComment", "1"}
-	tagDict[Tag{0x0000, 0x0903}] = TagInfo{Tag{0x0000, 0x0903}, "US", "ErrorID", "1"}
-	tagDict[Tag{0x0000, 0x1000}] = TagInfo{Tag{0x0000, 0x1000}, "UI", "AffectedSOPInstanceUID", "1"}
-	tagDict[Tag{0x0000, 0x1001}] = TagInfo{Tag{0x0000, 0x1001}, "UI", "RequestedSOPInstanceUID", "1"}
-	tagDict[Tag{0x0000, 0x1002}] = TagInfo{Tag{0x0000, 0x1002}, "US", "EventTypeID", "1"}
-	tagDict[Tag{0x0000, 0x1005}] = TagInfo{Tag{0x0000, 0x1005}, "AT", "AttributeIdentifierList", "1-n"}
-	tagDict[Tag{0x0000, 0x1008}] = TagInfo{Tag{0x0000, 0x1008}, "US", "ActionTypeID", "1"}
-	tagDict[Tag{0x0000, 0x1020}] = TagInfo{Tag{0x0000, 0x1020}, "US", "NumberOfRemainingSuboperations", "1"}
-	tagDict[Tag{0x0000, 0x1021}] = TagInfo{Tag{0x0000, 0x1021}, "US", "NumberOfCompletedSuboperations", "1"}
-	tagDict[Tag{0x0000, 0x1022}] = TagInfo{Tag{0x0000, 0x1022}, "US", "NumberOfFailedSuboperations", "1"}
-	tagDict[Tag{0x0000, 0x1023}] = TagInfo{Tag{0x0000, 0x1023}, "US", "NumberOfWarningSuboperations", "1"}
-	tagDict[Tag{0x0000, 0x1030}] = TagInfo{Tag{0x0000, 0x1030}, "AE", "MoveOriginatorApplicationEntityTitle", "1"}
-	tagDict[Tag{0x0000, 0x1031}] = TagInfo{Tag{0x0000, 0x1031}, "US", "MoveOriginatorMessageID", "1"}
-	tagDict[Tag{0x0002, 0x0000}] = TagInfo{Tag{0x0002, 0x0000}, "UL", "FileMetaInformationGroupLength", "1"}
-	tagDict[Tag{0x0002, 0x0001}] = TagInfo{Tag{0x0002, 0x0001}, "OB", "FileMetaInformationVersion", "1"}
-	tagDict[Tag{0x0002, 0x0002}] = TagInfo{Tag{0x0002, 0x0002}, "UI", "MediaStorageSOPClassUID", "1"}
-	tagDict[Tag{0x0002, 0x0003}] = TagInfo{Tag{0x0002, 0x0003}, "UI", "MediaStorageSOPInstanceUID", "1"}
-	tagDict[Tag{0x0002, 0x0010}] = TagInfo{Tag{0x0002, 0x0010}, "UI", "TransferSyntaxUID", "1"}
-	tagDict[Tag{0x0002, 0x0012}] = TagInfo{Tag{0x0002, 0x0012}, "UI", "ImplementationClassUID", "1"}
-	tagDict[Tag{0x0002, 0x0013}] = TagInfo{Tag{0x0002, 0x0013}, "SH", "ImplementationVersionName", "1"}
-	tagDict[Tag{0x0002, 0x0016}] = TagInfo{Tag{0x0002, 0x0016}, "AE", "SourceApplicationEntityTitle", "1"}
-	tagDict[Tag{0x0002, 0x0100}] = TagInfo{Tag{0x0002, 0x0100}, "UI", "PrivateInformationCreatorUID", "1"}
-	tagDict[Tag{0x0002, 0x0102}] = TagInfo{Tag{0x0002, 0x0102}, "OB", "PrivateInformation", "1"}
-	tagDict[Tag{0x0004, 0x1130}] = TagInfo{Tag{0x0004, 0x1130}, "CS", "FileSetID", "1"}
-	tagDict[Tag{0x0004, 0x1141}] = TagInfo{Tag{0x0004, 0x1141}, "CS", "FileSetDescriptorFileID", "1-8"}
-	tagDict[Tag{0x0004, 0x1142}] = TagInfo{Tag{0x0004, 0x1142}, "CS", "SpecificCharacterSetOfFileSetDescriptorFile", "1"}
-	tagDict[Tag{0x0004, 0x1200}] = TagInfo{Tag{0x0004, 0x1200}, "UP", "OffsetOfTheFirstDirectoryRecordOfTheRootDirectoryEntity", "1"}
-	tagDict[Tag{0x0004, 0x1202}] = TagInfo{Tag{0x0004, 0x1202}, "UP", "OffsetOfTheLastDirectoryRecordOfTheRootDirectoryEntity", "1"}
-	tagDict[Tag{0x0004, 0x1212}] = TagInfo{Tag{0x0004, 0x1212}, "US", "FileSetConsistencyFlag", "1"}
-	tagDict[Tag{0x0004, 0x1220}] = TagInfo{Tag{0x0004, 0x1220}, "SQ", "DirectoryRecordSequence", "1"}
-	tagDict[Tag{0x0004, 0x1400}] = TagInfo{Tag{0x0004, 0x1400}, "UP", "OffsetOfTheNextDirectoryRecord", "1"}
-	tagDict[Tag{0x0004, 0x1410}] = TagInfo{Tag{0x0004, 0x1410}, "US", "RecordInUseFlag", "1"}
-	tagDict[Tag{0x0004, 0x1420}] = TagInfo{Tag{0x0004, 0x1420}, "UP", "OffsetOfReferencedLowerLevelDirectoryEntity", "1"}
-	tagDict[Tag{0x0004, 0x1430}] = TagInfo{Tag{0x0004, 0x1430}, "CS", "DirectoryRecordType", "1"}
-	tagDict[Tag{0x0004, 0x1432}] = TagInfo{Tag{0x0004, 0x1432}, "UI", "PrivateRecordUID", "1"}
-	tagDict[Tag{0x0004, 0x1500}] = TagInfo{Tag{0x0004, 0x1500}, "CS", "ReferencedFileID", "1-8"}
-	tagDict[Tag{0x0004, 0x1510}] = TagInfo{Tag{0x0004, 0x1510}, "UI", "ReferencedSOPClassUIDInFile", "1"}
-	tagDict[Tag{0x0004, 0x1511}] = TagInfo{Tag{0x0004, 0x1511}, "UI", "ReferencedSOPInstanceUIDInFile", "1"}
-	tagDict[Tag{0x0004, 0x1512}] = TagInfo{Tag{0x0004, 0x1512}, "UI", "ReferencedTransferSyntaxUIDInFile", "1"}
-	tagDict[Tag{0x0004, 0x151A}] = TagInfo{Tag{0x0004, 0x151A}, "UI", "ReferencedRelatedGeneralSOPClassUIDInFile", "1-n"}
-	tagDict[Tag{0x0008, 0x0005}] = TagInfo{Tag{0x0008, 0x0005}, "CS", "SpecificCharacterSet", "1-n"}
-	tagDict[Tag{0x0008, 0x0006}] = TagInfo{Tag{0x0008, 0x0006}, "SQ", "LanguageCodeSequence", "1"}
-	tagDict[Tag{0x0008, 0x0008}] = TagInfo{Tag{0x0008, 0x0008}, "CS", "ImageType", "2-n"}
-	tagDict[Tag{0x0008, 0x0012}] = TagInfo{Tag{0x0008, 0x0012}, "DA", "InstanceCreationDate", "1"}
-	tagDict[Tag{0x0008, 0x0013}] = TagInfo{Tag{0x0008, 0x0013}, "TM", "InstanceCreationTime", "1"}
-	tagDict[Tag{0x0008, 0x0014}] = TagInfo{Tag{0x0008, 0x0014}, "UI", "InstanceCreatorUID", "1"}
-	tagDict[Tag{0x0008, 0x0016}] = TagInfo{Tag{0x0008, 0x0016}, "UI", "SOPClassUID", "1"}
-	tagDict[Tag{0x0008, 0x0018}] = TagInfo{Tag{0x0008, 0x0018}, "UI", "SOPInstanceUID", "1"}
-	tagDict[Tag{0x0008, 0x001A}] = TagInfo{Tag{0x0008, 0x001A}, "UI", "RelatedGeneralSOPClassUID", "1-n"}
-	tagDict[Tag{0x0008, 0x001B}] = TagInfo{Tag{0x0008, 0x001B}, "UI", "OriginalSpecializedSOPClassUID", "1"}
-	tagDict[Tag{0x0008, 0x0020}] = TagInfo{Tag{0x0008, 0x0020}, "DA", "StudyDate", "1"}
-	tagDict[Tag{0x0008, 0x0021}] = TagInfo{Tag{0x0008, 0x0021}, "DA", "SeriesDate", "1"}
-	tagDict[Tag{0x0008, 0x0022}] = TagInfo{Tag{0x0008, 0x0022}, "DA", "AcquisitionDate", "1"}
-	tagDict[Tag{0x0008, 0x0023}] = TagInfo{Tag{0x0008, 0x0023}, "DA", "ContentDate", "1"}
-	tagDict[Tag{0x0008, 0x002A}] = TagInfo{Tag{0x0008, 0x002A}, "DT", "AcquisitionDateTime", "1"}
-	tagDict[Tag{0x0008, 0x0030}] = TagInfo{Tag{0x0008, 0x0030}, "TM", "StudyTime", "1"}
-	tagDict[Tag{0x0008, 0x0031}] = TagInfo{Tag{0x0008, 0x0031}, "TM", "SeriesTime", "1"}
-	tagDict[Tag{0x0008, 0x0032}] = TagInfo{Tag{0x0008, 0x0032}, "TM", "AcquisitionTime", "1"}
-	tagDict[Tag{0x0008, 0x0033}] = TagInfo{Tag{0x0008, 0x0033}, "TM", "ContentTime", "1"}
-	tagDict[Tag{0x0008, 0x0050}] = TagInfo{Tag{0x0008, 0x0050}, "SH", "AccessionNumber", "1"}
-	tagDict[Tag{0x0008, 0x0051}] = TagInfo{Tag{0x0008, 0x0051}, "SQ", "IssuerOfAccessionNumberSequence", "1"}
-	tagDict[Tag{0x0008, 0x0052}] = TagInfo{Tag{0x0008, 0x0052}, "CS", "QueryRetrieveLevel", "1"}
-	tagDict[Tag{0x0008, 0x0054}] = TagInfo{Tag{0x0008, 0x0054}, "AE", "RetrieveAETitle", "1-n"}
-	tagDict[Tag{0x0008, 0x0056}] = TagInfo{Tag{0x0008, 0x0056}, "CS", "InstanceAvailability", "1"}
-	tagDict[Tag{0x0008, 0x0058}] = TagInfo{Tag{0x0008, 0x0058}, "UI", "FailedSOPInstanceUIDList", "1-n"}
-	tagDict[Tag{0x0008, 0x0060}] = TagInfo{Tag{0x0008, 0x0060}, "CS", "Modality", "1"}
-	tagDict[Tag{0x0008, 0x0061}] = TagInfo{Tag{0x0008, 0x0061}, "CS", "ModalitiesInStudy", "1-n"}
-	tagDict[Tag{0x0008, 0x0062}] = TagInfo{Tag{0x0008, 0x0062}, "UI", "SOPClassesInStudy", "1-n"}
-	tagDict[Tag{0x0008, 0x0064}] = TagInfo{Tag{0x0008, 0x0064}, "CS", "ConversionType", "1"}
-	tagDict[Tag{0x0008, 0x0068}] = TagInfo{Tag{0x0008, 0x0068}, "CS", "PresentationIntentType", "1"}
-	tagDict[Tag{0x0008, 0x0070}] = TagInfo{Tag{0x0008, 0x0070}, "LO", "Manufacturer", "1"}
-	tagDict[Tag{0x0008, 0x0080}] = TagInfo{Tag{0x0008, 0x0080}, "LO", "CompanyName", "1"}
-	tagDict[Tag{0x0008, 0x0081}] = TagInfo{Tag{0x0008, 0x0081}, "ST", "CompanyAddress", "1"}
-	tagDict[Tag{0x0008, 0x0082}] = TagInfo{Tag{0x0008, 0x0082}, "SQ", "InstitutionCodeSequence", "1"}
-	tagDict[Tag{0x0008, 0x0090}] = TagInfo{Tag{0x0008, 0x0090}, "PN", "ComponentOwnerName", "1"}
-	tagDict[Tag{0x0008, 0x0092}] = TagInfo{Tag{0x0008, 0x0092}, "ST", "ReferringPhysicianAddress", "1"}
-	tagDict[Tag{0x0008, 0x0094}] = TagInfo{Tag{0x0008, 0x0094}, "SH", "ReferringPhysicianTelephoneNumbers", "1-n"}
-	tagDict[Tag{0x0008, 0x0096}] = TagInfo{Tag{0x0008, 0x0096}, "SQ", "ReferringPhysicianIdentificationSequence", "1"}
-	tagDict[Tag{0x0008, 0x0100}] = TagInfo{Tag{0x0008, 0x0100}, "SH", "CodeValue", "1"}
-	tagDict[Tag{0x0008, 0x0102}] = TagInfo{Tag{0x0008, 0x0102}, "SH", "CodingSchemeDesignator", "1"}
-	tagDict[Tag{0x0008, 0x0103}] = TagInfo{Tag{0x0008, 0x0103}, "SH", "CodingSchemeVersion", "1"}
-	tagDict[Tag{0x0008, 0x0104}] = TagInfo{Tag{0x0008, 0x0104}, "LO", "CodeMeaning", "1"}
-	tagDict[Tag{0x0008, 0x0105}] = TagInfo{Tag{0x0008, 0x0105}, "CS", "MappingResource", "1"}
-	tagDict[Tag{0x0008, 0x0106}] = TagInfo{Tag{0x0008, 0x0106}, "DT", "ContextGroupVersion", "1"}
-	tagDict[Tag{0x0008, 0x0107}] = TagInfo{Tag{0x0008, 0x0107}, "DT", "ContextGroupLocalVersion", "1"}
-	tagDict[Tag{0x0008, 0x010B}] = TagInfo{Tag{0x0008, 0x010B}, "CS", "ContextGroupExtensionFlag", "1"}
-	tagDict[Tag{0x0008, 0x010C}] = TagInfo{Tag{0x0008, 0x010C}, "UI", "CodingSchemeUID", "1"}
-	tagDict[Tag{0x0008, 0x010D}] = TagInfo{Tag{0x0008, 0x010D}, "UI", "ContextGroupExtensionCreatorUID", "1"}
-	tagDict[Tag{0x0008, 0x010F}] = TagInfo{Tag{0x0008, 0x010F}, "CS", "ContextIdentifier", "1"}
-	tagDict[Tag{0x0008, 0x0110}] = TagInfo{Tag{0x0008, 0x0110}, "SQ", "CodingSchemeIdentificationSequence", "1"}
-	tagDict[Tag{0x0008, 0x0112}] = TagInfo{Tag{0x0008, 0x0112}, "LO", "CodingSchemeRegistry", "1"}
-	tagDict[Tag{0x0008, 0x0114}] = TagInfo{Tag{0x0008, 0x0114}, "ST", "CodingSchemeExternalID", "1"}
-	tagDict[Tag{0x0008, 0x0115}] = TagInfo{Tag{0x0008, 0x0115}, "ST", "CodingSchemeName", "1"}
-	tagDict[Tag{0x0008, 0x0116}] = TagInfo{Tag{0x0008, 0x0116}, "ST", "CodingSchemeResponsibleOrganization", "1"}
-	tagDict[Tag{0x0008, 0x0117}] = TagInfo{Tag{0x0008, 0x0117}, "UI", "ContextUID", "1"}
-	tagDict[Tag{0x0008, 0x0201}] = TagInfo{Tag{0x0008, 0x0201}, "SH", "TimezoneOffsetFromUTC", "1"}
-	tagDict[Tag{0x0008, 0x1010}] = TagInfo{Tag{0x0008, 0x1010}, "SH", "StationName", "1"}
-	tagDict[Tag{0x0008, 0x1030}] = TagInfo{Tag{0x0008, 0x1030}, "LO", "StudyDescription", "1"}
-	tagDict[Tag{0x0008, 0x1032}] = TagInfo{Tag{0x0008, 0x1032}, "SQ", "ProcedureCodeSequence", "1"}
-	tagDict[Tag{0x0008, 0x103E}] = TagInfo{Tag{0x0008, 0x103E}, "LO", "SeriesDescription", "1"}
-	tagDict[Tag{0x0008, 0x103F}] = TagInfo{Tag{0x0008, 0x103F}, "SQ", "SeriesDescriptionCodeSequence", "1"}
-	tagDict[Tag{0x0008, 0x1040}] = TagInfo{Tag{0x0008, 0x1040}, "LO", "DepartmentName", "1"}
-	tagDict[Tag{0x0008, 0x1048}] = TagInfo{Tag{0x0008, 0x1048}, "PN", "InspectingCompanyName", "1-n"}
-	tagDict[Tag{0x0008, 0x1049}] = TagInfo{Tag{0x0008, 0x1049}, "SQ", "PhysiciansOfRecordIdentificationSequence", "1"}
-	tagDict[Tag{0x0008, 0x1050}] = TagInfo{Tag{0x0008, 0x1050}, "PN", "InspectorName", "1-n"}
-	tagDict[Tag{0x0008, 0x1052}] = TagInfo{Tag{0x0008, 0x1052}, "SQ", "PerformingPhysicianIdentificationSequence", "1"}
-	tagDict[Tag{0x0008, 0x1060}] = TagInfo{Tag{0x0008, 0x1060}, "PN", "CertifyingInspectorName", "1-n"}
-	tagDict[Tag{0x0008, 0x1062}] = TagInfo{Tag{0x0008, 0x1062}, "SQ", "PhysiciansReadingStudyIdentificationSequence", "1"}
-	tagDict[Tag{0x0008, 0x1070}] = TagInfo{Tag{0x0008, 0x1070}, "PN", "OperatorsName", "1-n"}
-	tagDict[Tag{0x0008, 0x1072}] = TagInfo{Tag{0x0008, 0x1072}, "SQ", "OperatorIdentificationSequence", "1"}
-	tagDict[Tag{0x0008, 0x1080}] = TagInfo{Tag{0x0008, 0x1080}, "LO", "AdmittingDiagnosesDescription", "1-n"}
-	tagDict[Tag{0x0008, 0x1084}] = TagInfo{Tag{0x0008, 0x1084}, "SQ", "AdmittingDiagnosesCodeSequence", "1"}
-	tagDict[Tag{0x0008, 0x1090}] = TagInfo{Tag{0x0008, 0x1090}, "LO", "ManufacturerModelName", "1"}
-	tagDict[Tag{0x0008, 0x1110}] = TagInfo{Tag{0x0008, 0x1110}, "SQ", "ReferencedStudySequence", "1"}
-	tagDict[Tag{0x0008, 0x1111}] = TagInfo{Tag{0x0008, 0x1111}, "SQ", "ReferencedPerformedProcedureStepSequence", "1"}
-	tagDict[Tag{0x0008, 0x1115}] = TagInfo{Tag{0x0008, 0x1115}, "SQ", "ReferencedSeriesSequence", "1"}
-	tagDict[Tag{0x0008, 0x1120}] = TagInfo{Tag{0x0008, 0x1120}, "SQ", "ReferencedPatientSequence", "1"}
-	tagDict[Tag{0x0008, 0x1125}] = TagInfo{Tag{0x0008, 0x1125}, "SQ", "ReferencedVisitSequence", "1"}
-	tagDict[Tag{0x0008, 0x1134}] = TagInfo{Tag{0x0008, 0x1134}, "SQ", "ReferencedStereometricInstanceSequence", "1"}
-	tagDict[Tag{0x0008, 0x113A}] = TagInfo{Tag{0x0008, 0x113A}, "SQ", "ReferencedWaveformSequence", "1"}
-	tagDict[Tag{0x0008, 0x1140}] = TagInfo{Tag{0x0008, 0x1140}, "SQ", "ReferencedImageSequence", "1"}
-	tagDict[Tag{0x0008, 0x114A}] = TagInfo{Tag{0x0008, 0x114A}, "SQ", "ReferencedInstanceSequence", "1"}
-	tagDict[Tag{0x0008, 0x114B}] = TagInfo{Tag{0x0008, 0x114B}, "SQ", "ReferencedRealWorldValueMappingInstanceSequence", "1"}
-	tagDict[Tag{0x0008, 0x1150}] = TagInfo{Tag{0x0008, 0x1150}, "UI", "ReferencedSOPClassUID", "1"}
-	tagDict[Tag{0x0008, 0x1155}] = TagInfo{Tag{0x0008, 0x1155}, "UI", "ReferencedSOPInstanceUID", "1"}
-	tagDict[Tag{0x0008, 0x115A}] = TagInfo{Tag{0x0008, 0x115A}, "UI", "SOPClassesSupported", "1-n"}
-	tagDict[Tag{0x0008, 0x1160}] = TagInfo{Tag{0x0008, 0x1160}, "IS", "ReferencedFrameNumber", "1-n"}
-	tagDict[Tag{0x0008, 0x1161}] = TagInfo{Tag{0x0008, 0x1161}, "UL", "SimpleFrameList", "1-n"}
-	tagDict[Tag{0x0008, 0x1162}] = TagInfo{Tag{0x0008, 0x1162}, "UL", "CalculatedFrameList", "3-3n"}
-	tagDict[Tag{0x0008, 0x1163}] = TagInfo{Tag{0x0008, 0x1163}, "FD", "TimeRange", "2"}
-	tagDict[Tag{0x0008, 0x1164}] = TagInfo{Tag{0x0008, 0x1164}, "SQ", "FrameExtractionSequence", "1"}
-	tagDict[Tag{0x0008, 0x1167}] = TagInfo{Tag{0x0008, 0x1167}, "UI", "MultiFrameSourceSOPInstanceUID", "1"}
-	tagDict[Tag{0x0008, 0x1195}] = TagInfo{Tag{0x0008, 0x1195}, "UI", "TransactionUID", "1"}
-	tagDict[Tag{0x0008, 0x1197}] = TagInfo{Tag{0x0008, 0x1197}, "US", "FailureReason", "1"}
-	tagDict[Tag{0x0008, 0x1198}] = TagInfo{Tag{0x0008, 0x1198}, "SQ", "FailedSOPSequence", "1"}
-	tagDict[Tag{0x0008, 0x1199}] = TagInfo{Tag{0x0008, 0x1199}, "SQ", "ReferencedSOPSequence", "1"}
-	tagDict[Tag{0x0008, 0x1200}] = TagInfo{Tag{0x0008, 0x1200}, "SQ", "StudiesContainingOtherReferencedInstancesSequence", "1"}
-	tagDict[Tag{0x0008, 0x1250}] = TagInfo{Tag{0x0008, 0x1250}, "SQ", "RelatedSeriesSequence", "1"}
-	tagDict[Tag{0x0008, 0x2111}] = TagInfo{Tag{0x0008, 0x2111}, "ST", "DerivationDescription", "1"}
-	tagDict[Tag{0x0008, 0x2112}] = TagInfo{Tag{0x0008, 0x2112}, "SQ", "SourceImageSequence", "1"}
-	tagDict[Tag{0x0008, 0x2120}] = TagInfo{Tag{0x0008, 0x2120}, "SH", "StageName", "1"}
-	tagDict[Tag{0x0008, 0x2122}] = TagInfo{Tag{0x0008, 0x2122}, "IS", "StageNumber", "1"}
-	tagDict[Tag{0x0008, 0x2124}] = TagInfo{Tag{0x0008, 0x2124}, "IS", "NumberOfStages", "1"}
-	tagDict[Tag{0x0008, 0x2127}] = TagInfo{Tag{0x0008, 0x2127}, "SH", "ViewName", "1"}
-	tagDict[Tag{0x0008, 0x2128}] = TagInfo{Tag{0x0008, 0x2128}, "IS", "ViewNumber", "1"}
-	tagDict[Tag{0x0008, 0x2129}] = TagInfo{Tag{0x0008, 0x2129}, "IS", "NumberOfEventTimers", "1"}
-	tagDict[Tag{0x0008, 0x212A}] = TagInfo{Tag{0x0008, 0x212A}, "IS", "NumberOfViewsInStage", "1"}
-	tagDict[Tag{0x0008, 0x2130}] = TagInfo{Tag{0x0008, 0x2130}, "DS", "EventElapsedTimes", "1-n"}
-	tagDict[Tag{0x0008, 0x2132}] = TagInfo{Tag{0x0008, 0x2132}, "LO", "EventTimerNames", "1-n"}
-	tagDict[Tag{0x0008, 0x2133}] = TagInfo{Tag{0x0008, 0x2133}, "SQ", "EventTimerSequence", "1"}
-	tagDict[Tag{0x0008, 0x2134}] = TagInfo{Tag{0x0008, 0x2134}, "FD", "EventTimeOffset", "1"}
-	tagDict[Tag{0x0008, 0x2135}] = TagInfo{Tag{0x0008, 0x2135}, "SQ", "EventCodeSequence", "1"}
-	tagDict[Tag{0x0008, 0x2142}] = TagInfo{Tag{0x0008, 0x2142}, "IS", "StartTrim", "1"}
-	tagDict[Tag{0x0008, 0x2143}] = TagInfo{Tag{0x0008, 0x2143}, "IS", "StopTrim", "1"}
-	tagDict[Tag{0x0008, 0x2144}] = TagInfo{Tag{0x0008, 0x2144}, "IS", "RecommendedDisplayFrameRate", "1"}
-	tagDict[Tag{0x0008, 0x2218}] = TagInfo{Tag{0x0008, 0x2218}, "SQ", "AnatomicRegionSequence", "1"}
-	tagDict[Tag{0x0008, 0x2220}] = TagInfo{Tag{0x0008, 0x2220}, "SQ", "AnatomicRegionModifierSequence", "1"}
-	tagDict[Tag{0x0008, 0x2228}] = TagInfo{Tag{0x0008, 0x2228}, "SQ", "PrimaryAnatomicStructureSequence", "1"}
-	tagDict[Tag{0x0008, 0x2229}] = TagInfo{Tag{0x0008, 0x2229}, "SQ", "AnatomicStructureSpaceOrRegionSequence", "1"}
-	tagDict[Tag{0x0008, 0x2230}] = TagInfo{Tag{0x0008, 0x2230}, "SQ", "PrimaryAnatomicStructureModifierSequence", "1"}
-	tagDict[Tag{0x0008, 0x3001}] = TagInfo{Tag{0x0008, 0x3001}, "SQ", "AlternateRepresentationSequence", "1"}
-	tagDict[Tag{0x0008, 0x3010}] = TagInfo{Tag{0x0008, 0x3010}, "UI", "IrradiationEventUID", "1"}
-	tagDict[Tag{0x0008, 0x9007}] = TagInfo{Tag{0x0008, 0x9007}, "CS", "FrameType", "4"}
-	tagDict[Tag{0x0008, 0x9092}] = TagInfo{Tag{0x0008, 0x9092}, "SQ", "ReferencedImageEvidenceSequence", "1"}
-	tagDict[Tag{0x0008, 0x9121}] = TagInfo{Tag{0x0008, 0x9121}, "SQ", "ReferencedRawDataSequence", "1"}
-	tagDict[Tag{0x0008, 0x9123}] = TagInfo{Tag{0x0008, 0x9123}, "UI", "CreatorVersionUID", "1"}
-	tagDict[Tag{0x0008, 0x9124}] = TagInfo{Tag{0x0008, 0x9124}, "SQ", "DerivationImageSequence", "1"}
-	tagDict[Tag{0x0008, 0x9154}] = TagInfo{Tag{0x0008, 0x9154}, "SQ", "SourceImageEvidenceSequence", "1"}
-	tagDict[Tag{0x0008, 0x9205}] = TagInfo{Tag{0x0008, 0x9205}, "CS", "PixelPresentation", "1"}
-	tagDict[Tag{0x0008, 0x9206}] = TagInfo{Tag{0x0008, 0x9206}, "CS", "VolumetricProperties", "1"}
-	tagDict[Tag{0x0008, 0x9207}] = TagInfo{Tag{0x0008, 0x9207}, "CS", "VolumeBasedCalculationTechnique", "1"}
-	tagDict[Tag{0x0008, 0x9208}] = TagInfo{Tag{0x0008, 0x9208}, "CS", "ComplexImageComponent", "1"}
-	tagDict[Tag{0x0008, 0x9209}] = TagInfo{Tag{0x0008, 0x9209}, "CS", "AcquisitionContrast", "1"}
-	tagDict[Tag{0x0008, 0x9215}] = TagInfo{Tag{0x0008, 0x9215}, "SQ", "DerivationCodeSequence", "1"}
-	tagDict[Tag{0x0008, 0x9237}] = TagInfo{Tag{0x0008, 0x9237}, "SQ", "ReferencedPresentationStateSequence", "1"}
-	tagDict[Tag{0x0008, 0x9410}] = TagInfo{Tag{0x0008, 0x9410}, "SQ", "ReferencedOtherPlaneSequence", "1"}
-	tagDict[Tag{0x0008, 0x9458}] = TagInfo{Tag{0x0008, 0x9458}, "SQ", "FrameDisplaySequence", "1"}
-	tagDict[Tag{0x0008, 0x9459}] = TagInfo{Tag{0x0008, 0x9459}, "FL", "RecommendedDisplayFrameRateInFloat", "1"}
-	tagDict[Tag{0x0008, 0x9460}] = TagInfo{Tag{0x0008, 0x9460}, "CS", "SkipFrameRangeFlag", "1"}
-	tagDict[Tag{0x0010, 0x0010}] = TagInfo{Tag{0x0010, 0x0010}, "PN", "ComponentName", "1"}
-	tagDict[Tag{0x0010, 0x0020}] = TagInfo{Tag{0x0010, 0x0020}, "LO", "ComponentIDNumber", "1-n"}
-	tagDict[Tag{0x0010, 0x0021}] = TagInfo{Tag{0x0010, 0x0021}, "LO", "IssuerOfPatientID", "1"}
-	tagDict[Tag{0x0010, 0x0022}] = TagInfo{Tag{0x0010, 0x0022}, "CS", "TypeOfPatientID", "1"}
-	tagDict[Tag{0x0010, 0x0024}] = TagInfo{Tag{0x0010, 0x0024}, "SQ", "IssuerOfPatientIDQualifiersSequence", "1"}
-	tagDict[Tag{0x0010, 0x0030}] = TagInfo{Tag{0x0010, 0x0030}, "DA", "ComponentManufacturingDate", "1"}
-	tagDict[Tag{0x0010, 0x0032}] = TagInfo{Tag{0x0010, 0x0032}, "TM", "PatientBirthTime", "1"}
-	tagDict[Tag{0x0010, 0x0040}] = TagInfo{Tag{0x0010, 0x0040}, "CS", "PatientSex", "1"}
-	tagDict[Tag{0x0010, 0x0050}] = TagInfo{Tag{0x0010, 0x0050}, "SQ", "PatientInsurancePlanCodeSequence", "1"}
-	tagDict[Tag{0x0010, 0x0101}] = TagInfo{Tag{0x0010, 0x0101}, "SQ", "PatientPrimaryLanguageCodeSequence", "1"}
-	tagDict[Tag{0x0010, 0x0102}] = TagInfo{Tag{0x0010, 0x0102}, "SQ", "PatientPrimaryLanguageModifierCodeSequence", "1"}
-	tagDict[Tag{0x0010, 0x1000}] = TagInfo{Tag{0x0010, 0x1000}, "LO", "OtherComponentIDs", "1-n DICONDE_E2339"}
-	tagDict[Tag{0x0010, 0x1001}] = TagInfo{Tag{0x0010, 0x1001}, "PN", "OtherComponentNames", "1"}
-	tagDict[Tag{0x0010, 0x1002}] = TagInfo{Tag{0x0010, 0x1002}, "SQ", "OtherPatientIDsSequence", "1"}
-	tagDict[Tag{0x0010, 0x1005}] = TagInfo{Tag{0x0010, 0x1005}, "PN", "PatientBirthName", "1"}
-	tagDict[Tag{0x0010, 0x1010}] = TagInfo{Tag{0x0010, 0x1010}, "AS", "PatientAge", "1"}
-	tagDict[Tag{0x0010, 0x1020}] = TagInfo{Tag{0x0010, 0x1020}, "DS", "PatientSize", "1"}
-	tagDict[Tag{0x0010, 0x1021}] = TagInfo{Tag{0x0010, 0x1021}, "SQ", "PatientSizeCodeSequence", "1"}
-	tagDict[Tag{0x0010, 0x1030}] = TagInfo{Tag{0x0010, 0x1030}, "DS", "PatientWeight", "1"}
-	tagDict[Tag{0x0010, 0x1040}] = TagInfo{Tag{0x0010, 0x1040}, "LO", "PatientAddress", "1"}
-	tagDict[Tag{0x0010, 0x1060}] = TagInfo{Tag{0x0010, 0x1060}, "PN", "PatientMotherBirthName", "1"}
-	tagDict[Tag{0x0010, 0x1080}] = TagInfo{Tag{0x0010, 0x1080}, "LO", "MilitaryRank", "1"}
-	tagDict[Tag{0x0010, 0x1081}] = TagInfo{Tag{0x0010, 0x1081}, "LO", "BranchOfService", "1"}
-	tagDict[Tag{0x0010, 0x1090}] = TagInfo{Tag{0x0010, 0x1090}, "LO", "MedicalRecordLocator", "1"}
-	tagDict[Tag{0x0010, 0x2000}] = TagInfo{Tag{0x0010, 0x2000}, "LO", "MedicalAlerts", "1-n"}
-	tagDict[Tag{0x0010, 0x2110}] = TagInfo{Tag{0x0010, 0x2110}, "LO", "Allergies", "1-n"}
-	tagDict[Tag{0x0010, 0x2150}] = TagInfo{Tag{0x0010, 0x2150}, "LO", "CountryOfResidence", "1"}
-	tagDict[Tag{0x0010, 0x2152}] = TagInfo{Tag{0x0010, 0x2152}, "LO", "RegionOfResidence", "1"}
-	tagDict[Tag{0x0010, 0x2154}] = TagInfo{Tag{0x0010, 0x2154}, "SH", "PatientTelephoneNumbers", "1-n"}
-	tagDict[Tag{0x0010, 0x2160}] = TagInfo{Tag{0x0010, 0x2160}, "SH", "MaterialName", "1"}
-	tagDict[Tag{0x0010, 0x2180}] = TagInfo{Tag{0x0010, 0x2180}, "SH", "Occupation", "1"}
-	tagDict[Tag{0x0010, 0x21A0}] = TagInfo{Tag{0x0010, 0x21A0}, "CS", "SmokingStatus", "1"}
-	tagDict[Tag{0x0010, 0x21B0}] = TagInfo{Tag{0x0010, 0x21B0}, "LT", "AdditionalPatientHistory", "1"}
-	tagDict[Tag{0x0010, 0x21C0}] = TagInfo{Tag{0x0010, 0x21C0}, "US", "PregnancyStatus", "1"}
-	tagDict[Tag{0x0010, 0x21D0}] = TagInfo{Tag{0x0010, 0x21D0}, "DA", "LastMenstrualDate", "1"}
-	tagDict[Tag{0x0010, 0x21F0}] = TagInfo{Tag{0x0010, 0x21F0}, "LO", "PatientReligiousPreference", "1"}
-	tagDict[Tag{0x0010, 0x2201}] = TagInfo{Tag{0x0010, 0x2201}, "LO", "PatientSpeciesDescription", "1"}
-	tagDict[Tag{0x0010, 0x2202}] = TagInfo{Tag{0x0010, 0x2202}, "SQ", "PatientSpeciesCodeSequence", "1"}
-	tagDict[Tag{0x0010, 0x2203}] = TagInfo{Tag{0x0010, 0x2203}, "CS", "PatientSexNeutered", "1"}
-	tagDict[Tag{0x0010, 0x2210}] = TagInfo{Tag{0x0010, 0x2210}, "CS", "AnatomicalOrientationType", "1"}
-	tagDict[Tag{0x0010, 0x2292}] = TagInfo{Tag{0x0010, 0x2292}, "LO", "PatientBreedDescription", "1"}
-	tagDict[Tag{0x0010, 0x2293}] = TagInfo{Tag{0x0010, 0x2293}, "SQ", "PatientBreedCodeSequence", "1"}
-	tagDict[Tag{0x0010, 0x2294}] = TagInfo{Tag{0x0010, 0x2294}, "SQ", "BreedRegistrationSequence", "1"}
-	tagDict[Tag{0x0010, 0x2295}] = TagInfo{Tag{0x0010, 0x2295}, "LO", "BreedRegistrationNumber", "1"}
-	tagDict[Tag{0x0010, 0x2296}] = TagInfo{Tag{0x0010, 0x2296}, "SQ", "BreedRegistryCodeSequence", "1"}
-	tagDict[Tag{0x0010, 0x2297}] = TagInfo{Tag{0x0010, 0x2297}, "PN", "ResponsiblePerson", "1"}
-	tagDict[Tag{0x0010, 0x2298}] = TagInfo{Tag{0x0010, 0x2298}, "CS", "ResponsiblePersonRole", "1"}
-	tagDict[Tag{0x0010, 0x2299}] = TagInfo{Tag{0x0010, 0x2299}, "LO", "ResponsibleOrganization", "1"}
-	tagDict[Tag{0x0010, 0x4000}] = TagInfo{Tag{0x0010, 0x4000}, "LT", "ComponentNotes", "1"}
-	tagDict[Tag{0x0010, 0x9431}] = TagInfo{Tag{0x0010, 0x9431}, "FL", "ExaminedBodyThickness", "1"}
-	tagDict[Tag{0x0012, 0x0010}] = TagInfo{Tag{0x0012, 0x0010}, "LO", "ClinicalTrialSponsorName", "1"}
-	tagDict[Tag{0x0012, 0x0020}] = TagInfo{Tag{0x0012, 0x0020}, "LO", "ClinicalTrialProtocolID", "1"}
-	tagDict[Tag{0x0012, 0x0021}] = TagInfo{Tag{0x0012, 0x0021}, "LO", "ClinicalTrialProtocolName", "1"}
-	tagDict[Tag{0x0012, 0x0030}] = TagInfo{Tag{0x0012, 0x0030}, "LO", "ClinicalTrialSiteID", "1"}
-	tagDict[Tag{0x0012, 0x0031}] = TagInfo{Tag{0x0012, 0x0031}, "LO", "ClinicalTrialSiteName", "1"}
-	tagDict[Tag{0x0012, 0x0040}] = TagInfo{Tag{0x0012, 0x0040}, "LO", "ClinicalTrialSubjectID", "1"}
-	tagDict[Tag{0x0012, 0x0042}] = TagInfo{Tag{0x0012, 0x0042}, "LO", "ClinicalTrialSubjectReadingID", "1"}
-	tagDict[Tag{0x0012, 0x0050}] = TagInfo{Tag{0x0012, 0x0050}, "LO", "ClinicalTrialTimePointID", "1"}
-	tagDict[Tag{0x0012, 0x0051}] = TagInfo{Tag{0x0012, 0x0051}, "ST", "ClinicalTrialTimePointDescription", "1"}
-	tagDict[Tag{0x0012, 0x0060}] = TagInfo{Tag{0x0012, 0x0060}, "LO", "ClinicalTrialCoordinatingCenterName", "1"}
-	tagDict[Tag{0x0012, 0x0062}] = TagInfo{Tag{0x0012, 0x0062}, "CS", "PatientIdentityRemoved", "1"}
-	tagDict[Tag{0x0012, 0x0063}] = TagInfo{Tag{0x0012, 0x0063}, "LO", "DeidentificationMethod", "1-n"}
-	tagDict[Tag{0x0012, 0x0064}] = TagInfo{Tag{0x0012, 0x0064}, "SQ", "DeidentificationMethodCodeSequence", "1"}
-	tagDict[Tag{0x0012, 0x0071}] = TagInfo{Tag{0x0012, 0x0071}, "LO", "ClinicalTrialSeriesID", "1"}
-	tagDict[Tag{0x0012, 0x0072}] = TagInfo{Tag{0x0012, 0x0072}, "LO", "ClinicalTrialSeriesDescription", "1"}
-	tagDict[Tag{0x0012, 0x0081}] = TagInfo{Tag{0x0012, 0x0081}, "LO", "ClinicalTrialProtocolEthicsCommitteeName", "1"}
-	tagDict[Tag{0x0012, 0x0082}] = TagInfo{Tag{0x0012, 0x0082}, "LO", "ClinicalTrialProtocolEthicsCommitteeApprovalNumber", "1"}
-	tagDict[Tag{0x0012, 0x0083}] = TagInfo{Tag{0x0012, 0x0083}, "SQ", "ConsentForClinicalTrialUseSequence", "1"}
-	tagDict[Tag{0x0012, 0x0084}] = TagInfo{Tag{0x0012, 0x0084}, "CS", "DistributionType", "1"}
-	tagDict[Tag{0x0012, 0x0085}] = TagInfo{Tag{0x0012, 0x0085}, "CS", "ConsentForDistributionFlag", "1"}
-	tagDict[Tag{0x0014, 0x0023}] = TagInfo{Tag{0x0014, 0x0023}, "ST", "CADFileFormat", "1-n"}
-	tagDict[Tag{0x0014, 0x0024}] = TagInfo{Tag{0x0014, 0x0024}, "ST", "ComponentReferenceSystem", "1-n"}
-	tagDict[Tag{0x0014, 0x0025}] = TagInfo{Tag{0x0014, 0x0025}, "ST", "ComponentManufacturingProcedure", "1-n"}
-	tagDict[Tag{0x0014, 0x0028}] = TagInfo{Tag{0x0014, 0x0028}, "ST", "ComponentManufacturer", "1-n"}
-	tagDict[Tag{0x0014, 0x0030}] = TagInfo{Tag{0x0014, 0x0030}, "DS", "MaterialThickness", "1-n"}
-	tagDict[Tag{0x0014, 0x0032}] = TagInfo{Tag{0x0014, 0x0032}, "DS", "MaterialPipeDiameter", "1-n"}
-	tagDict[Tag{0x0014, 0x0034}] = TagInfo{Tag{0x0014, 0x0034}, "DS", "MaterialIsolationDiameter", "1-n"}
-	tagDict[Tag{0x0014, 0x0042}] = TagInfo{Tag{0x0014, 0x0042}, "ST", "MaterialGrade", "1"}
-	tagDict[Tag{0x0014, 0x0044}] = TagInfo{Tag{0x0014, 0x0044}, "ST", "MaterialPropertiesDescription", "1-n"}
-	tagDict[Tag{0x0014, 0x0045}] = TagInfo{Tag{0x0014, 0x0045}, "ST", "MaterialPropertiesFileFormat", "1-n"}
-	tagDict[Tag{0x0014, 0x0046}] = TagInfo{Tag{0x0014, 0x0046}, "LT", "MaterialNotes", "1"}
-	tagDict[Tag{0x0014, 0x0050}] = TagInfo{Tag{0x0014, 0x0050}, "CS", "ComponentShape", "1"}
-	tagDict[Tag{0x0014, 0x0052}] = TagInfo{Tag{0x0014, 0x0052}, "CS", "CurvatureType", "1"}
-	tagDict[Tag{0x0014, 0x0054}] = TagInfo{Tag{0x0014, 0x0054}, "DS", "OuterDiameter", "1"}
-	tagDict[Tag{0x0014, 0x0056}] = TagInfo{Tag{0x0014, 0x0056}, "DS", "InnerDiameter", "1"}
-	tagDict[Tag{0x0014, 0x0100}] = TagInfo{Tag{0x0014, 0x0100}, "LO", "ComponentWelderIDs", "1-n"}
-	tagDict[Tag{0x0014, 0x0101}] = TagInfo{Tag{0x0014, 0x0101}, "CS", "SecondaryApprovaStatus", "1"}
-	tagDict[Tag{0x0014, 0x0102}] = TagInfo{Tag{0x0014, 0x0102}, "DA", "SecondaryReviewDate", "1"}
-	tagDict[Tag{0x0014, 0x0103}] = TagInfo{Tag{0x0014, 0x0103}, "TM", "SecondaryReviewTime", "1"}
-	tagDict[Tag{0x0014, 0x0104}] = TagInfo{Tag{0x0014, 0x0104}, "PN", "SecondaryReviewName", "1"}
-	tagDict[Tag{0x0014, 0x0105}] = TagInfo{Tag{0x0014, 0x0105}, "ST", "RepairID", "1"}
-	tagDict[Tag{0x0014, 0x0106}] = TagInfo{Tag{0x0014, 0x0106}, "SQ", "MultipleComponentApprovalSequence", "1"}
-	tagDict[Tag{0x0014, 0x0107}] = TagInfo{Tag{0x0014, 0x0107}, "CS", "OtherApprovalStatus", "1-n"}
-	tagDict[Tag{0x0014, 0x0108}] = TagInfo{Tag{0x0014, 0x0108}, "CS", "OtherSecondaryApprovalStatus", "1-n"}
-	tagDict[Tag{0x0014, 0x1010}] = TagInfo{Tag{0x0014, 0x1010}, "ST", "ActualEnvironmentalConditions", "1"}
-	tagDict[Tag{0x0014, 0x1020}] = TagInfo{Tag{0x0014, 0x1020}, "DA", "ExpiryDate", "1"}
-	tagDict[Tag{0x0014, 0x1040}] = TagInfo{Tag{0x0014, 0x1040}, "ST", "EnvironmentalConditions", "1"}
-	tagDict[Tag{0x0014, 0x2002}] = TagInfo{Tag{0x0014, 0x2002}, "SQ", "EvaluatorSequence", "1"}
-	tagDict[Tag{0x0014, 0x2004}] = TagInfo{Tag{0x0014, 0x2004}, "IS", "EvaluatorNumber", "1"}
-	tagDict[Tag{0x0014, 0x2006}] = TagInfo{Tag{0x0014, 0x2006}, "PN", "EvaluatorName", "1"}
-	tagDict[Tag{0x0014, 0x2008}] = TagInfo{Tag{0x0014, 0x2008}, "IS", "EvaluationAttempt", "1"}
-	tagDict[Tag{0x0014, 0x2012}] = TagInfo{Tag{0x0014, 0x2012}, "SQ", "IndicationSequence", "1"}
-	tagDict[Tag{0x0014, 0x2014}] = TagInfo{Tag{0x0014, 0x2014}, "IS", "IndicationNumber", "1"}
-	tagDict[Tag{0x0014, 0x2016}] = TagInfo{Tag{0x0014, 0x2016}, "SH", "IndicationLabel", "1"}
-	tagDict[Tag{0x0014, 0x2018}] = TagInfo{Tag{0x0014, 0x2018}, "ST", "IndicationDescription", "1"}
-	tagDict[Tag{0x0014, 0x201A}] = TagInfo{Tag{0x0014, 0x201A}, "CS", "IndicationType", "1-n"}
-	tagDict[Tag{0x0014, 0x201C}] = TagInfo{Tag{0x0014, 0x201C}, "CS", "IndicationDisposition", "1"}
-	tagDict[Tag{0x0014, 0x201E}] = TagInfo{Tag{0x0014, 0x201E}, "SQ", "IndicationROISequence", "1"}
-	tagDict[Tag{0x0014, 0x2030}] = TagInfo{Tag{0x0014, 0x2030}, "SQ", "IndicationPhysicalPropertySequence", "1"}
-	tagDict[Tag{0x0014, 0x2032}] = TagInfo{Tag{0x0014, 0x2032}, "SH", "PropertyLabel", "1"}
-	tagDict[Tag{0x0014, 0x2202}] = TagInfo{Tag{0x0014, 0x2202}, "IS", "CoordinateSystemNumberOfAxes", "1"}
-	tagDict[Tag{0x0014, 0x2204}] = TagInfo{Tag{0x0014, 0x2204}, "SQ", "CoordinateSystemAxesSequence", "1"}
-	tagDict[Tag{0x0014, 0x2206}] = TagInfo{Tag{0x0014, 0x2206}, "ST", "CoordinateSystemAxisDescription", "1"}
-	tagDict[Tag{0x0014, 0x2208}] = TagInfo{Tag{0x0014, 0x2208}, "CS", "CoordinateSystemDataSetMapping", "1"}
-	tagDict[Tag{0x0014, 0x220A}] = TagInfo{Tag{0x0014, 0x220A}, "IS", "CoordinateSystemAxisNumber", "1"}
-	tagDict[Tag{0x0014, 0x220C}] = TagInfo{Tag{0x0014, 0x220C}, "CS", "CoordinateSystemAxisType", "1"}
-	tagDict[Tag{0x0014, 0x220E}] = TagInfo{Tag{0x0014, 0x220E}, "CS", "CoordinateSystemAxisUnits", "1"}
-	tagDict[Tag{0x0014, 0x2210}] = TagInfo{Tag{0x0014, 0x2210}, "OB", "CoordinateSystemAxisValues", "1"}
-	tagDict[Tag{0x0014, 0x2220}] = TagInfo{Tag{0x0014, 0x2220}, "SQ", "CoordinateSystemTransformSequence", "1"}
-	tagDict[Tag{0x0014, 0x2222}] = TagInfo{Tag{0x0014, 0x2222}, "ST", "TransformDescription", "1"}
-	tagDict[Tag{0x0014, 0x2224}] = TagInfo{Tag{0x0014, 0x2224}, "IS", "TransformNumberOfAxes", "1"}
-	tagDict[Tag{0x0014, 0x2226}] = TagInfo{Tag{0x0014, 0x2226}, "IS", "TransformOrderOfAxes", "1-n"}
-	tagDict[Tag{0x0014, 0x2228}] = TagInfo{Tag{0x0014, 0x2228}, "CS", "TransformedAxisUnits", "1"}
-	tagDict[Tag{0x0014, 0x222A}] = TagInfo{Tag{0x0014, 0x222A}, "DS", "CoordinateSystemRotationAndScaleMatrix", "1-n"}
-	tagDict[Tag{0x0014, 0x222C}] = TagInfo{Tag{0x0014, 0x222C}, "DS", "CoordinateSystemTranslationMatrix", "1-n"}
-	tagDict[Tag{0x0014, 0x3011}] = TagInfo{Tag{0x0014, 0x3011}, "DS", "InternalDetectorFrameTime", "1"}
-	tagDict[Tag{0x0014, 0x3012}] = TagInfo{Tag{0x0014, 0x3012}, "DS", "NumberOfFramesIntegrated", "1"}
-	tagDict[Tag{0x0014, 0x3020}] = TagInfo{Tag{0x0014, 0x3020}, "SQ", "DetectorTemperatureSequence", "1"}
-	tagDict[Tag{0x0014, 0x3022}] = TagInfo{Tag{0x0014, 0x3022}, "ST", "SensorName", "1"}
-	tagDict[Tag{0x0014, 0x3024}] = TagInfo{Tag{0x0014, 0x3024}, "DS", "HorizontalOffsetOfSensor", "1"}
-	tagDict[Tag{0x0014, 0x3026}] = TagInfo{Tag{0x0014, 0x3026}, "DS", "VerticalOffsetOfSensor", "1"}
-	tagDict[Tag{0x0014, 0x3028}] = TagInfo{Tag{0x0014, 0x3028}, "DS", "SensorTemperature", "1"}
-	tagDict[Tag{0x0014, 0x3040}] = TagInfo{Tag{0x0014, 0x3040}, "SQ", "DarkCurrentSequence", "1"}
-	tagDict[Tag{0x0014, 0x3050}] = TagInfo{Tag{0x0014, 0x3050}, "OW", "DarkCurrentCounts", "1"}
-	tagDict[Tag{0x0014, 0x3060}] = TagInfo{Tag{0x0014, 0x3060}, "SQ", "GainCorrectionReferenceSequence", "1"}
-	tagDict[Tag{0x0014, 0x3070}] = TagInfo{Tag{0x0014, 0x3070}, "OW", "AirCounts", "1"}
-	tagDict[Tag{0x0014, 0x3071}] = TagInfo{Tag{0x0014, 0x3071}, "DS", "KVUsedInGainCalibration", "1"}
-	tagDict[Tag{0x0014, 0x3072}] = TagInfo{Tag{0x0014, 0x3072}, "DS", "MAUsedInGainCalibration", "1"}
-	tagDict[Tag{0x0014, 0x3073}] = TagInfo{Tag{0x0014, 0x3073}, "DS", "NumberOfFramesUsedForIntegration", "1"}
-	tagDict[Tag{0x0014, 0x3074}] = TagInfo{Tag{0x0014, 0x3074}, "LO", "FilterMaterialUsedInGainCalibration", "1"}
-	tagDict[Tag{0x0014, 0x3075}] = TagInfo{Tag{0x0014, 0x3075}, "DS", "FilterThicknessUsedInGainCalibration", "1"}
-	tagDict[Tag{0x0014, 0x3076}] = TagInfo{Tag{0x0014, 0x3076}, "DA", "DateOfGainCalibration", "1"}
-	tagDict[Tag{0x0014, 0x3077}] = TagInfo{Tag{0x0014, 0x3077}, "TM", "TimeOfGainCalibration", "1"}
-	tagDict[Tag{0x0014, 0x3080}] = TagInfo{Tag{0x0014, 0x3080}, "OB", "BadPixelImage", "1"}
-	tagDict[Tag{0x0014, 0x3099}] = TagInfo{Tag{0x0014, 0x3099}, "LT", "CalibrationNotes", "1"}
-	tagDict[Tag{0x0014, 0x4002}] = TagInfo{Tag{0x0014, 0x4002}, "SQ", "PulserEquipmentSequence", "1"}
-	tagDict[Tag{0x0014, 0x4004}] = TagInfo{Tag{0x0014, 0x4004}, "CS", "PulserType", "1"}
-	tagDict[Tag{0x0014, 0x4006}] = TagInfo{Tag{0x0014, 0x4006}, "LT", "PulserNotes", "1"}
-	tagDict[Tag{0x0014, 0x4008}] = TagInfo{Tag{0x0014, 0x4008}, "SQ", "ReceiverEquipmentSequence", "1"}
-	tagDict[Tag{0x0014, 0x400A}] = TagInfo{Tag{0x0014, 0x400A}, "CS", "AmplifierType", "1"}
-	tagDict[Tag{0x0014, 0x400C}] = TagInfo{Tag{0x0014, 0x400C}, "LT", "ReceiverNotes", "1"}
-	tagDict[Tag{0x0014, 0x400E}] = TagInfo{Tag{0x0014, 0x400E}, "SQ", "PreAmplifierEquipmentSequence", "1"}
-	tagDict[Tag{0x0014, 0x400F}] = TagInfo{Tag{0x0014, 0x400F}, "LT", "PreAmplifierNotes", "1"}
-	tagDict[Tag{0x0014, 0x4010}] = TagInfo{Tag{0x0014, 0x4010}, "SQ", "TransmitTransducerSequence", "1"}
-	tagDict[Tag{0x0014, 0x4011}] = TagInfo{Tag{0x0014, 0x4011}, "SQ", "ReceiveTransducerSequence", "1"}
-	tagDict[Tag{0x0014, 0x4012}] = TagInfo{Tag{0x0014, 0x4012}, "US", "NumberOfElements", "1"}
-	tagDict[Tag{0x0014, 0x4013}] = TagInfo{Tag{0x0014, 0x4013}, "CS", "ElementShape", "1"}
-	tagDict[Tag{0x0014, 0x4014}] = TagInfo{Tag{0x0014, 0x4014}, "DS", "ElementDimensionA", "1"}
-	tagDict[Tag{0x0014, 0x4015}] = TagInfo{Tag{0x0014, 0x4015}, "DS", "ElementDimensionB", "1"}
-	tagDict[Tag{0x0014, 0x4016}] = TagInfo{Tag{0x0014, 0x4016}, "DS", "ElementPitch", "1"}
-	tagDict[Tag{0x0014, 0x4017}] = TagInfo{Tag{0x0014, 0x4017}, "DS", "MeasuredBeamDimensionA", "1"}
-	tagDict[Tag{0x0014, 0x4018}] = TagInfo{Tag{0x0014, 0x4018}, "DS", "MeasuredBeamDimensionB", "1"}
-	tagDict[Tag{0x0014, 0x4019}] = TagInfo{Tag{0x0014, 0x4019}, "DS", "LocationOfMeasuredBeamDiameter", "1"}
-	tagDict[Tag{0x0014, 0x401A}] = TagInfo{Tag{0x0014, 0x401A}, "DS", "NominalFrequency", "1"}
-	tagDict[Tag{0x0014, 0x401B}] = TagInfo{Tag{0x0014, 0x401B}, "DS", "MeasuredCenterFrequency", "1"}
-	tagDict[Tag{0x0014, 0x401C}] = TagInfo{Tag{0x0014, 0x401C}, "DS", "MeasuredBandwidth", "1"}
-	tagDict[Tag{0x0014, 0x4020}] = TagInfo{Tag{0x0014, 0x4020}, "SQ", "PulserSettingsSequence", "1"}
-	tagDict[Tag{0x0014, 0x4022}] = TagInfo{Tag{0x0014, 0x4022}, "DS", "PulseWidth", "1"}
-	tagDict[Tag{0x0014, 0x4024}] = TagInfo{Tag{0x0014, 0x4024}, "DS", "ExcitationFrequency", "1"}
-	tagDict[Tag{0x0014, 0x4026}] = TagInfo{Tag{0x0014, 0x4026}, "CS", "ModulationType", "1"}
-	tagDict[Tag{0x0014, 0x4028}] = TagInfo{Tag{0x0014, 0x4028}, "DS", "Damping", "1"}
-	tagDict[Tag{0x0014, 0x4030}] = TagInfo{Tag{0x0014, 0x4030}, "SQ", "ReceiverSettingsSequence", "1"}
-	tagDict[Tag{0x0014, 0x4031}] = TagInfo{Tag{0x0014, 0x4031}, "DS", "AcquiredSoundpathLength", "1"}
-	tagDict[Tag{0x0014, 0x4032}] = TagInfo{Tag{0x0014, 0x4032}, "CS", "AcquisitionCompressionType", "1"}
-	tagDict[Tag{0x0014, 0x4033}] = TagInfo{Tag{0x0014, 0x4033}, "IS", "AcquisitionSampleSize", "1"}
-	tagDict[Tag{0x0014, 0x4034}] = TagInfo{Tag{0x0014, 0x4034}, "DS", "RectifierSmoothing", "1"}
-	tagDict[Tag{0x0014, 0x4035}] = TagInfo{Tag{0x0014, 0x4035}, "SQ", "DACSequence", "1"}
-	tagDict[Tag{0x0014, 0x4036}] = TagInfo{Tag{0x0014, 0x4036}, "CS", "DACType", "1"}
-	tagDict[Tag{0x0014, 0x4038}] = TagInfo{Tag{0x0014, 0x4038}, "DS", "DACGainPoints", "1-n"}
-	tagDict[Tag{0x0014, 0x403A}] = TagInfo{Tag{0x0014, 0x403A}, "DS", "DACTimePoints", "1-n"}
-	tagDict[Tag{0x0014, 0x403C}] = TagInfo{Tag{0x0014, 0x403C}, "DS", "DACAmplitude", "1-n"}
-	tagDict[Tag{0x0014, 0x4040}] = TagInfo{Tag{0x0014, 0x4040}, "SQ", "PreAmplifierSettingsSequence", "1"}
-	tagDict[Tag{0x0014, 0x4050}] = TagInfo{Tag{0x0014, 0x4050}, "SQ", "TransmitTransducerSettingsSequence", "1"}
-	tagDict[Tag{0x0014, 0x4051}] = TagInfo{Tag{0x0014, 0x4051}, "SQ", "ReceiveTransducerSettingsSequence", "1"}
-	tagDict[Tag{0x0014, 0x4052}] = TagInfo{Tag{0x0014, 0x4052}, "DS", "IncidentAngle", "1"}
-	tagDict[Tag{0x0014, 0x4054}] = TagInfo{Tag{0x0014, 0x4054}, "ST", "CouplingTechnique", "1"}
-	tagDict[Tag{0x0014, 0x4056}] = TagInfo{Tag{0x0014, 0x4056}, "ST", "CouplingMedium", "1"}
-	tagDict[Tag{0x0014, 0x4057}] = TagInfo{Tag{0x0014, 0x4057}, "DS", "CouplingVelocity", "1"}
-	tagDict[Tag{0x0014, 0x4058}] = TagInfo{Tag{0x0014, 0x4058}, "DS", "CrystalCenterLocationX", "1"}
-	tagDict[Tag{0x0014, 0x4059}] = TagInfo{Tag{0x0014, 0x4059}, "DS", "CrystalCenterLocationZ", "1"}
-	tagDict[Tag{0x0014, 0x405A}] = TagInfo{Tag{0x0014, 0x405A}, "DS", "SoundPathLength", "1"}
-	tagDict[Tag{0x0014, 0x405C}] = TagInfo{Tag{0x0014, 0x405C}, "ST", "DelayLawIdentifier", "1"}
-	tagDict[Tag{0x0014, 0x4060}] = TagInfo{Tag{0x0014, 0x4060}, "SQ", "GateSettingsSequence", "1"}
-	tagDict[Tag{0x0014, 0x4062}] = TagInfo{Tag{0x0014, 0x4062}, "DS", "GateThreshold", "1"}
-	tagDict[Tag{0x0014, 0x4064}] = TagInfo{Tag{0x0014, 0x4064}, "DS", "VelocityOfSound", "1"}
-	tagDict[Tag{0x0014, 0x4070}] = TagInfo{Tag{0x0014, 0x4070}, "SQ", "CalibrationSettingsSequence", "1"}
-	tagDict[Tag{0x0014, 0x4072}] = TagInfo{Tag{0x0014, 0x4072}, "ST", "CalibrationProcedure", "1"}
-	tagDict[Tag{0x0014, 0x4074}] = TagInfo{Tag{0x0014, 0x4074}, "SH", "ProcedureVersion", "1"}
-	tagDict[Tag{0x0014, 0x4076}] = TagInfo{Tag{0x0014, 0x4076}, "DA", "ProcedureCreationDate", "1"}
-	tagDict[Tag{0x0014, 0x4078}] = TagInfo{Tag{0x0014, 0x4078}, "DA", "ProcedureExpirationDate", "1"}
-	tagDict[Tag{0x0014, 0x407A}] = TagInfo{Tag{0x0014, 0x407A}, "DA", "ProcedureLastModifiedDate", "1"}
-	tagDict[Tag{0x0014, 0x407C}] = TagInfo{Tag{0x0014, 0x407C}, "TM", "CalibrationTime", "1-n"}
-	tagDict[Tag{0x0014, 0x407E}] = TagInfo{Tag{0x0014, 0x407E}, "DA", "CalibrationDate", "1-n"}
-	tagDict[Tag{0x0014, 0x40A0}] = TagInfo{Tag{0x0014, 0x40A0}, "LO", "ImageQualityIndicatorType", "1-n"}
-	tagDict[Tag{0x0014, 0x40A1}] = TagInfo{Tag{0x0014, 0x40A1}, "LO", "ImageQualityIndicatorMaterial", "1-n"}
-	tagDict[Tag{0x0014, 0x40A2}] = TagInfo{Tag{0x0014, 0x40A2}, "LO", "ImageQualityIndicatorSize", "1-n"}
-	tagDict[Tag{0x0014, 0x5002}] = TagInfo{Tag{0x0014, 0x5002}, "IS", "LINACEnergy", "1"}
-	tagDict[Tag{0x0014, 0x5004}] = TagInfo{Tag{0x0014, 0x5004}, "IS", "LINACOutput", "1"}
-	tagDict[Tag{0x0018, 0x0010}] = TagInfo{Tag{0x0018, 0x0010}, "LO", "ContrastBolusAgent", "1"}
-	tagDict[Tag{0x0018, 0x0012}] = TagInfo{Tag{0x0018, 0x0012}, "SQ", "ContrastBolusAgentSequence", "1"}
-	tagDict[Tag{0x0018, 0x0014}] = TagInfo{Tag{0x0018, 0x0014}, "SQ", "ContrastBolusAdministrationRouteSequence", "1"}
-	tagDict[Tag{0x0018, 0x0015}] = TagInfo{Tag{0x0018, 0x0015}, "CS", "BodyPartExamined", "1"}
-	tagDict[Tag{0x0018, 0x0020}] = TagInfo{Tag{0x0018, 0x0020}, "CS", "ScanningSequence", "1-n"}
-	tagDict[Tag{0x0018, 0x0021}] = TagInfo{Tag{0x0018, 0x0021}, "CS", "SequenceVariant", "1-n"}
-	tagDict[Tag{0x0018, 0x0022}] = TagInfo{Tag{0x0018, 0x0022}, "CS", "ScanOptions", "1-n"}
-	tagDict[Tag{0x0018, 0x0023}] = TagInfo{Tag{0x0018, 0x0023}, "CS", "MRAcquisitionType", "1"}
-	tagDict[Tag{0x0018, 0x0024}] = TagInfo{Tag{0x0018, 0x0024}, "SH", "SequenceName", "1"}
-	tagDict[Tag{0x0018, 0x0025}] = TagInfo{Tag{0x0018, 0x0025}, "CS", "AngioFlag", "1"}
-	tagDict[Tag{0x0018, 0x0026}] = TagInfo{Tag{0x0018, 0x0026}, "SQ", "InterventionDrugInformationSequence", "1"}
-	tagDict[Tag{0x0018, 0x0027}] = TagInfo{Tag{0x0018, 0x0027}, "TM", "InterventionDrugStopTime", "1"}
-	tagDict[Tag{0x0018, 0x0028}] = TagInfo{Tag{0x0018, 0x0028}, "DS", "InterventionDrugDose", "1"}
-	tagDict[Tag{0x0018, 0x0029}] = TagInfo{Tag{0x0018, 0x0029}, "SQ", "InterventionDrugCodeSequence", "1"}
-	tagDict[Tag{0x0018, 0x002A}] = TagInfo{Tag{0x0018, 0x002A}, "SQ", "AdditionalDrugSequence", "1"}
-	tagDict[Tag{0x0018, 0x0031}] = TagInfo{Tag{0x0018, 0x0031}, "LO", "Radiopharmaceutical", "1"}
-	tagDict[Tag{0x0018, 0x0034}] = TagInfo{Tag{0x0018, 0x0034}, "LO", "InterventionDrugName", "1"}
-	tagDict[Tag{0x0018, 0x0035}] = TagInfo{Tag{0x0018, 0x0035}, "TM", "InterventionDrugStartTime", "1"}
-	tagDict[Tag{0x0018, 0x0036}] = TagInfo{Tag{0x0018, 0x0036}, "SQ", "InterventionSequence", "1"}
-	tagDict[Tag{0x0018, 0x0038}] = TagInfo{Tag{0x0018, 0x0038}, "CS", "InterventionStatus", "1"}
-	tagDict[Tag{0x0018, 0x003A}] = TagInfo{Tag{0x0018, 0x003A}, "ST", "InterventionDescription", "1"}
-	tagDict[Tag{0x0018, 0x0040}] = TagInfo{Tag{0x0018, 0x0040}, "IS", "CineRate", "1"}
-	tagDict[Tag{0x0018, 0x0042}] = TagInfo{Tag{0x0018, 0x0042}, "CS", "InitialCineRunState", "1"}
-	tagDict[Tag{0x0018, 0x0050}] = TagInfo{Tag{0x0018, 0x0050}, "DS", "SliceThickness", "1"}
-	tagDict[Tag{0x0018, 0x0060}] = TagInfo{Tag{0x0018, 0x0060}, "DS", "KVP", "1"}
-	tagDict[Tag{0x0018, 0x0070}] = TagInfo{Tag{0x0018, 0x0070}, "IS", "CountsAccumulated", "1"}
-	tagDict[Tag{0x0018, 0x0071}] = TagInfo{Tag{0x0018, 0x0071}, "CS", "AcquisitionTerminationCondition", "1"}
-	tagDict[Tag{0x0018, 0x0072}] = TagInfo{Tag{0x0018, 0x0072}, "DS", "EffectiveDuration", "1"}
-	tagDict[Tag{0x0018, 0x0073}] = TagInfo{Tag{0x0018, 0x0073}, "CS", "AcquisitionStartCondition", "1"}
-	tagDict[Tag{0x0018, 0x0074}] = TagInfo{Tag{0x0018, 0x0074}, "IS", "AcquisitionStartConditionData", "1"}
-	tagDict[Tag{0x0018, 0x0075}] = TagInfo{Tag{0x0018, 0x0075}, "IS", "AcquisitionTerminationConditionData", "1"}
-	tagDict[Tag{0x0018, 0x0080}] = TagInfo{Tag{0x0018, 0x0080}, "DS", "RepetitionTime", "1"}
-	tagDict[Tag{0x0018, 0x0081}] = TagInfo{Tag{0x0018, 0x0081}, "DS", "EchoTime", "1"}
-	tagDict[Tag{0x0018, 0x0082}] = TagInfo{Tag{0x0018, 0x0082}, "DS", "InversionTime", "1"}
-	tagDict[Tag{0x0018, 0x0083}] = TagInfo{Tag{0x0018, 0x0083}, "DS", "NumberOfAverages", "1"}
-	tagDict[Tag{0x0018, 0x0084}] = TagInfo{Tag{0x0018, 0x0084}, "DS", "ImagingFrequency", "1"}
-	tagDict[Tag{0x0018, 0x0085}] = TagInfo{Tag{0x0018, 0x0085}, "SH", "ImagedNucleus", "1"}
-	tagDict[Tag{0x0018, 0x0086}] = TagInfo{Tag{0x0018, 0x0086}, "IS", "EchoNumbers", "1-n"}
-	tagDict[Tag{0x0018, 0x0087}] = TagInfo{Tag{0x0018, 0x0087}, "DS", "MagneticFieldStrength", "1"}
-	tagDict[Tag{0x0018, 0x0088}] = TagInfo{Tag{0x0018, 0x0088}, "DS", "SpacingBetweenSlices", "1"}
-	tagDict[Tag{0x0018, 0x0089}] = TagInfo{Tag{0x0018, 0x0089}, "IS", "NumberOfPhaseEncodingSteps", "1"}
-	tagDict[Tag{0x0018, 0x0090}] = TagInfo{Tag{0x0018, 0x0090}, "DS", "DataCollectionDiameter", "1"}
-	tagDict[Tag{0x0018, 0x0091}] = TagInfo{Tag{0x0018, 0x0091}, "IS", "EchoTrainLength", "1"}
-	tagDict[Tag{0x0018, 0x0093}] = TagInfo{Tag{0x0018, 0x0093}, "DS", "PercentSampling", "1"}
-	tagDict[Tag{0x0018, 0x0094}] = TagInfo{Tag{0x0018, 0x0094}, "DS", "PercentPhaseFieldOfView", "1"}
-	tagDict[Tag{0x0018, 0x0095}] = TagInfo{Tag{0x0018, 0x0095}, "DS", "PixelBandwidth", "1"}
-	tagDict[Tag{0x0018, 0x1000}] = TagInfo{Tag{0x0018, 0x1000}, "LO", "DeviceSerialNumber", "1"}
-	tagDict[Tag{0x0018, 0x1002}] = TagInfo{Tag{0x0018, 0x1002}, "UI", "DeviceUID", "1"}
-	tagDict[Tag{0x0018, 0x1003}] = TagInfo{Tag{0x0018, 0x1003}, "LO", "DeviceID", "1"}
-	tagDict[Tag{0x0018, 0x1004}] = TagInfo{Tag{0x0018, 0x1004}, "LO", "PlateID", "1"}
-	tagDict[Tag{0x0018, 0x1005}] = TagInfo{Tag{0x0018, 0x1005}, "LO", "GeneratorID", "1"}
-	tagDict[Tag{0x0018, 0x1006}] = TagInfo{Tag{0x0018, 0x1006}, "LO", "GridID", "1"}
-	tagDict[Tag{0x0018, 0x1007}] = TagInfo{Tag{0x0018, 0x1007}, "LO", "CassetteID", "1"}
-	tagDict[Tag{0x0018, 0x1008}] = TagInfo{Tag{0x0018, 0x1008}, "LO", "ScannerID", "1"}
-	tagDict[Tag{0x0018, 0x1010}] = TagInfo{Tag{0x0018, 0x1010}, "LO", "SecondaryCaptureDeviceID", "1"}
-	tagDict[Tag{0x0018, 0x1012}] = TagInfo{Tag{0x0018, 0x1012}, "DA", "DateOfSecondaryCapture", "1"}
-	tagDict[Tag{0x0018, 0x1014}] = TagInfo{Tag{0x0018, 0x1014}, "TM", "TimeOfSecondaryCapture", "1"}
-	tagDict[Tag{0x0018, 0x1016}] = TagInfo{Tag{0x0018, 0x1016}, "LO", "SecondaryCaptureDeviceManufacturer", "1"}
-	tagDict[Tag{0x0018, 0x1018}] = TagInfo{Tag{0x0018, 0x1018}, "LO", "SecondaryCaptureDeviceManufacturerModelName", "1"}
-	tagDict[Tag{0x0018, 0x1019}] = TagInfo{Tag{0x0018, 0x1019}, "LO", "SecondaryCaptureDeviceSoftwareVersions", "1-n"}
-	tagDict[Tag{0x0018, 0x1020}] = TagInfo{Tag{0x0018, 0x1020}, "LO", "SoftwareVersions", "1-n"}
-	tagDict[Tag{0x0018, 0x1022}] = TagInfo{Tag{0x0018, 0x1022}, "SH", "VideoImageFormatAcquired", "1"}
-	tagDict[Tag{0x0018, 0x1023}] = TagInfo{Tag{0x0018, 0x1023}, "LO", "DigitalImageFormatAcquired", "1"}
-	tagDict[Tag{0x0018, 0x1030}] = TagInfo{Tag{0x0018, 0x1030}, "LO", "ProtocolName", "1"}
-	tagDict[Tag{0x0018, 0x1040}] = TagInfo{Tag{0x0018, 0x1040}, "LO", "ContrastBolusRoute", "1"}
-	tagDict[Tag{0x0018, 0x1041}] = TagInfo{Tag{0x0018, 0x1041}, "DS", "ContrastBolusVolume", "1"}
-	tagDict[Tag{0x0018, 0x1042}] = TagInfo{Tag{0x0018, 0x1042}, "TM", "ContrastBolusStartTime", "1"}
-	tagDict[Tag{0x0018, 0x1043}] = TagInfo{Tag{0x0018, 0x1043}, "TM", "ContrastBolusStopTime", "1"}
-	tagDict[Tag{0x0018, 0x1044}] = TagInfo{Tag{0x0018, 0x1044}, "DS", "ContrastBolusTotalDose", "1"}
-	tagDict[Tag{0x0018, 0x1045}] = TagInfo{Tag{0x0018, 0x1045}, "IS", "SyringeCounts", "1"}
-	tagDict[Tag{0x0018, 0x1046}] = TagInfo{Tag{0x0018, 0x1046}, "DS", "ContrastFlowRate", "1-n"}
-	tagDict[Tag{0x0018, 0x1047}] = TagInfo{Tag{0x0018, 0x1047}, "DS", "ContrastFlowDuration", "1-n"}
-	tagDict[Tag{0x0018, 0x1048}] = TagInfo{Tag{0x0018, 0x1048}, "CS", "ContrastBolusIngredient", "1"}
-	tagDict[Tag{0x0018, 0x1049}] = TagInfo{Tag{0x0018, 0x1049}, "DS", "ContrastBolusIngredientConcentration", "1"}
-	tagDict[Tag{0x0018, 0x1050}] = TagInfo{Tag{0x0018, 0x1050}, "DS", "SpatialResolution", "1"}
-	tagDict[Tag{0x0018, 0x1060}] = TagInfo{Tag{0x0018, 0x1060}, "DS", "TriggerTime", "1"}
-	tagDict[Tag{0x0018, 0x1061}] = TagInfo{Tag{0x0018, 0x1061}, "LO", "TriggerSourceOrType", "1"}
-	tagDict[Tag{0x0018, 0x1062}] = TagInfo{Tag{0x0018, 0x1062}, "IS", "NominalInterval", "1"}
-	tagDict[Tag{0x0018, 0x1063}] = TagInfo{Tag{0x0018, 0x1063}, "DS", "FrameTime", "1"}
-	tagDict[Tag{0x0018, 0x1064}] = TagInfo{Tag{0x0018, 0x1064}, "LO", "CardiacFramingType", "1"}
-	tagDict[Tag{0x0018, 0x1065}] = TagInfo{Tag{0x0018, 0x1065}, "DS", "FrameTimeVector", "1-n"}
-	tagDict[Tag{0x0018, 0x1066}] = TagInfo{Tag{0x0018, 0x1066}, "DS", "FrameDelay", "1"}
-	tagDict[Tag{0x0018, 0x1067}] = TagInfo{Tag{0x0018, 0x1067}, "DS", "ImageTriggerDelay", "1"}
-	tagDict[Tag{0x0018, 0x1068}] = TagInfo{Tag{0x0018, 0x1068}, "DS", "MultiplexGroupTimeOffset", "1"}
-	tagDict[Tag{0x0018, 0x1069}] = TagInfo{Tag{0x0018, 0x1069}, "DS", "TriggerTimeOffset", "1"}
-	tagDict[Tag{0x0018, 0x106A}] = TagInfo{Tag{0x0018, 0x106A}, "CS", "SynchronizationTrigger", "1"}
-	tagDict[Tag{0x0018, 0x106C}] = TagInfo{Tag{0x0018, 0x106C}, "US", "SynchronizationChannel", "2"}
-	tagDict[Tag{0x0018, 0x106E}] = TagInfo{Tag{0x0018, 0x106E}, "UL", "TriggerSamplePosition", "1"}
-	tagDict[Tag{0x0018, 0x1070}] = TagInfo{Tag{0x0018, 0x1070}, "LO", "RadiopharmaceuticalRoute", "1"}
-	tagDict[Tag{0x0018, 0x1071}] = TagInfo{Tag{0x0018, 0x1071}, "DS", "RadiopharmaceuticalVolume", "1"}
-	tagDict[Tag{0x0018, 0x1072}] = TagInfo{Tag{0x0018, 0x1072}, "TM", "RadiopharmaceuticalStartTime", "1"}
-	tagDict[Tag{0x0018, 0x1073}] = TagInfo{Tag{0x0018, 0x1073}, "TM", "RadiopharmaceuticalStopTime", "1"}
-	tagDict[Tag{0x0018, 0x1074}] = TagInfo{Tag{0x0018, 0x1074}, "DS", "RadionuclideTotalDose", "1"}
-	tagDict[Tag{0x0018, 0x1075}] = TagInfo{Tag{0x0018, 0x1075}, "DS", "RadionuclideHalfLife", "1"}
-	tagDict[Tag{0x0018, 0x1076}] = TagInfo{Tag{0x0018, 0x1076}, "DS", "RadionuclidePositronFraction", "1"}
-	tagDict[Tag{0x0018, 0x1077}] = TagInfo{Tag{0x0018, 0x1077}, "DS", "RadiopharmaceuticalSpecificActivity", "1"}
-	tagDict[Tag{0x0018, 0x1078}] = TagInfo{Tag{0x0018, 0x1078}, "DT", "RadiopharmaceuticalStartDateTime", "1"}
-	tagDict[Tag{0x0018, 0x1079}] = TagInfo{Tag{0x0018, 0x1079}, "DT", "RadiopharmaceuticalStopDateTime", "1"}
-	tagDict[Tag{0x0018, 0x1080}] = TagInfo{Tag{0x0018, 0x1080}, "CS", "BeatRejectionFlag", "1"}
-	tagDict[Tag{0x0018, 0x1081}] = TagInfo{Tag{0x0018, 0x1081}, "IS", "LowRRValue", "1"}
-	tagDict[Tag{0x0018, 0x1082}] = TagInfo{Tag{0x0018, 0x1082}, "IS", "HighRRValue", "1"}
-	tagDict[Tag{0x0018, 0x1083}] = TagInfo{Tag{0x0018, 0x1083}, "IS", "IntervalsAcquired", "1"}
-	tagDict[Tag{0x0018, 0x1084}] = TagInfo{Tag{0x0018, 0x1084}, "IS", "IntervalsRejected", "1"}
-	tagDict[Tag{0x0018, 0x1085}] = TagInfo{Tag{0x0018, 0x1085}, "LO", "PVCRejection", "1"}
-	tagDict[Tag{0x0018, 0x1086}] = TagInfo{Tag{0x0018, 0x1086}, "IS", "SkipBeats", "1"}
-	tagDict[Tag{0x0018, 0x1088}] = TagInfo{Tag{0x0018, 0x1088}, "IS", "HeartRate", "1"}
-	tagDict[Tag{0x0018, 0x1090}] = TagInfo{Tag{0x0018, 0x1090}, "IS", "CardiacNumberOfImages", "1"}
-	tagDict[Tag{0x0018, 0x1094}] = TagInfo{Tag{0x0018, 0x1094}, "IS", "TriggerWindow", "1"}
-	tagDict[Tag{0x0018, 0x1100}] = TagInfo{Tag{0x0018, 0x1100}, "DS", "ReconstructionDiameter", "1"}
-	tagDict[Tag{0x0018, 0x1110}] = TagInfo{Tag{0x0018, 0x1110}, "DS", "DistanceSourceToDetector", "1"}
-	tagDict[Tag{0x0018, 0x1111}] = TagInfo{Tag{0x0018, 0x1111}, "DS", "DistanceSourceToPatient", "1"}
-	tagDict[Tag{0x0018, 0x1114}] = TagInfo{Tag{0x0018, 0x1114}, "DS", "EstimatedRadiographicMagnificationFactor", "1"}
-	tagDict[Tag{0x0018, 0x1120}] = TagInfo{Tag{0x0018, 0x1120}, "DS", "GantryDetectorTilt", "1"}
-	tagDict[Tag{0x0018, 0x1121}] = TagInfo{Tag{0x0018, 0x1121}, "DS", "GantryDetectorSlew", "1"}
-	tagDict[Tag{0x0018, 0x1130}] = TagInfo{Tag{0x0018, 0x1130}, "DS", "TableHeight", "1"}
-	tagDict[Tag{0x0018, 0x1131}] = TagInfo{Tag{0x0018, 0x1131}, "DS", "TableTraverse", "1"}
-	tagDict[Tag{0x0018, 0x1134}] = TagInfo{Tag{0x0018, 0x1134}, "CS", "TableMotion", "1"}
-	tagDict[Tag{0x0018, 0x1135}] = TagInfo{Tag{0x0018, 0x1135}, "DS", "TableVerticalIncrement", "1-n"}
-	tagDict[Tag{0x0018, 0x1136}] = TagInfo{Tag{0x0018, 0x1136}, "DS", "TableLateralIncrement", "1-n"}
-	tagDict[Tag{0x0018, 0x1137}] = TagInfo{Tag{0x0018, 0x1137}, "DS", "TableLongitudinalIncrement", "1-n"}
-	tagDict[Tag{0x0018, 0x1138}] = TagInfo{Tag{0x0018, 0x1138}, "DS", "TableAngle", "1"}
-	tagDict[Tag{0x0018, 0x113A}] = TagInfo{Tag{0x0018, 0x113A}, "CS", "TableType", "1"}
-	tagDict[Tag{0x0018, 0x1140}] = TagInfo{Tag{0x0018, 0x1140}, "CS", "RotationDirection", "1"}
-	tagDict[Tag{0x0018, 0x1142}] = TagInfo{Tag{0x0018, 0x1142}, "DS", "RadialPosition", "1-n"}
-	tagDict[Tag{0x0018, 0x1143}] = TagInfo{Tag{0x0018, 0x1143}, "DS", "ScanArc", "1"}
-	tagDict[Tag{0x0018, 0x1144}] = TagInfo{Tag{0x0018, 0x1144}, "DS", "AngularStep", "1"}
-	tagDict[Tag{0x0018, 0x1145}] = TagInfo{Tag{0x0018, 0x1145}, "DS", "CenterOfRotationOffset", "1"}
-	tagDict[Tag{0x0018, 0x1147}] = TagInfo{Tag{0x0018, 0x1147}, "CS", "FieldOfViewShape", "1"}
-	tagDict[Tag{0x0018, 0x1149}] = TagInfo{Tag{0x0018, 0x1149}, "IS", "FieldOfViewDimensions", "1-2"}
-	tagDict[Tag{0x0018, 0x1150}] = TagInfo{Tag{0x0018, 0x1150}, "IS", "ExposureTime", "1"}
-	tagDict[Tag{0x0018, 0x1151}] = TagInfo{Tag{0x0018, 0x1151}, "IS", "XRayTubeCurrent", "1"}
-	tagDict[Tag{0x0018, 0x1152}] = TagInfo{Tag{0x0018, 0x1152}, "IS", "Exposure", "1"}
-	tagDict[Tag{0x0018, 0x1153}] = TagInfo{Tag{0x0018, 0x1153}, "IS", "ExposureInuAs", "1"}
-	tagDict[Tag{0x0018, 0x1154}] = TagInfo{Tag{0x0018, 0x1154}, "DS", "AveragePulseWidth", "1"}
-	tagDict[Tag{0x0018, 0x1155}] = TagInfo{Tag{0x0018, 0x1155}, "CS", "RadiationSetting", "1"}
-	tagDict[Tag{0x0018, 0x1156}] = TagInfo{Tag{0x0018, 0x1156}, "CS", "RectificationType", "1"}
-	tagDict[Tag{0x0018, 0x115A}] = TagInfo{Tag{0x0018, 0x115A}, "CS", "RadiationMode", "1"}
-	tagDict[Tag{0x0018, 0x115E}] = TagInfo{Tag{0x0018, 0x115E}, "DS", "ImageAndFluoroscopyAreaDoseProduct", "1"}
-	tagDict[Tag{0x0018, 0x1160}] = TagInfo{Tag{0x0018, 0x1160}, "SH", "FilterType", "1"}
-	tagDict[Tag{0x0018, 0x1161}] = TagInfo{Tag{0x0018, 0x1161}, "LO", "TypeOfFilters", "1-n"}
-	tagDict[Tag{0x0018, 0x1162}] = TagInfo{Tag{0x0018, 0x1162}, "DS", "IntensifierSize", "1"}
-	tagDict[Tag{0x0018, 0x1164}] = TagInfo{Tag{0x0018, 0x1164}, "DS", "ImagerPixelSpacing", "2"}
-	tagDict[Tag{0x0018, 0x1166}] = TagInfo{Tag{0x0018, 0x1166}, "CS", "Grid", "1-n"}
-	tagDict[Tag{0x0018, 0x1170}] = TagInfo{Tag{0x0018, 0x1170}, "IS", "GeneratorPower", "1"}
-	tagDict[Tag{0x0018, 0x1180}] = TagInfo{Tag{0x0018, 0x1180}, "SH", "CollimatorGridName", "1"}
-	tagDict[Tag{0x0018, 0x1181}] = TagInfo{Tag{0x0018, 0x1181}, "CS", "CollimatorType", "1"}
-	tagDict[Tag{0x0018, 0x1182}] = TagInfo{Tag{0x0018, 0x1182}, "IS", "FocalDistance", "1-2"}
-	tagDict[Tag{0x0018, 0x1183}] = TagInfo{Tag{0x0018, 0x1183}, "DS", "XFocusCenter", "1-2"}
-	tagDict[Tag{0x0018, 0x1184}] = TagInfo{Tag{0x0018, 0x1184}, "DS", "YFocusCenter", "1-2"}
-	tagDict[Tag{0x0018, 0x1190}] = TagInfo{Tag{0x0018, 0x1190}, "DS", "FocalSpots", "1-n"}
-	tagDict[Tag{0x0018, 0x1191}] = TagInfo{Tag{0x0018, 0x1191}, "CS", "AnodeTargetMaterial", "1"}
-	tagDict[Tag{0x0018, 0x11A0}] = TagInfo{Tag{0x0018, 0x11A0}, "DS", "BodyPartThickness", "1"}
-	tagDict[Tag{0x0018, 0x11A2}] = TagInfo{Tag{0x0018, 0x11A2}, "DS", "CompressionForce", "1"}
-	tagDict[Tag{0x0018, 0x1200}] = TagInfo{Tag{0x0018, 0x1200}, "DA", "DateOfLastCalibration", "1-n"}
-	tagDict[Tag{0x0018, 0x1201}] = TagInfo{Tag{0x0018, 0x1201}, "TM", "TimeOfLastCalibration", "1-n"}
-	tagDict[Tag{0x0018, 0x1210}] = TagInfo{Tag{0x0018, 0x1210}, "SH", "ConvolutionKernel", "1-n"}
-	tagDict[Tag{0x0018, 0x1242}] = TagInfo{Tag{0x0018, 0x1242}, "IS", "ActualFrameDuration", "1"}
-	tagDict[Tag{0x0018, 0x1243}] = TagInfo{Tag{0x0018, 0x1243}, "IS", "CountRate", "1"}
-	tagDict[Tag{0x0018, 0x1244}] = TagInfo{Tag{0x0018, 0x1244}, "US", "PreferredPlaybackSequencing", "1"}
-	tagDict[Tag{0x0018, 0x1250}] = TagInfo{Tag{0x0018, 0x1250}, "SH", "ReceiveCoilName", "1"}
-	tagDict[Tag{0x0018, 0x1251}] = TagInfo{Tag{0x0018, 0x1251}, "SH", "TransmitCoilName", "1"}
-	tagDict[Tag{0x0018, 0x1260}] = TagInfo{Tag{0x0018, 0x1260}, "SH", "PlateType", "1"}
-	tagDict[Tag{0x0018, 0x1261}] = TagInfo{Tag{0x0018, 0x1261}, "LO", "PhosphorType", "1"}
-	tagDict[Tag{0x0018, 0x1300}] = TagInfo{Tag{0x0018, 0x1300}, "DS", "ScanVelocity", "1"}
-	tagDict[Tag{0x0018, 0x1301}] = TagInfo{Tag{0x0018, 0x1301}, "CS", "WholeBodyTechnique", "1-n"}
-	tagDict[Tag{0x0018, 0x1302}] = TagInfo{Tag{0x0018, 0x1302}, "IS", "ScanLength", "1"}
-	tagDict[Tag{0x0018, 0x1310}] = TagInfo{Tag{0x0018, 0x1310}, "US", "AcquisitionMatrix", "4"}
-	tagDict[Tag{0x0018, 0x1312}] = TagInfo{Tag{0x0018, 0x1312}, "CS", "InPlanePhaseEncodingDirection", "1"}
-	tagDict[Tag{0x0018, 0x1314}] = TagInfo{Tag{0x0018, 0x1314}, "DS", "FlipAngle", "1"}
-	tagDict[Tag{0x0018, 0x1315}] = TagInfo{Tag{0x0018, 0x1315}, "CS", "VariableFlipAngleFlag", "1"}
-	tagDict[Tag{0x0018, 0x1316}] = TagInfo{Tag{0x0018, 0x1316}, "DS", "SAR", "1"}
-	tagDict[Tag{0x0018, 0x1318}] = TagInfo{Tag{0x0018, 0x1318}, "DS", "dBdt", "1"}
-	tagDict[Tag{0x0018, 0x1400}] = TagInfo{Tag{0x0018, 0x1400}, "LO", "AcquisitionDeviceProcessingDescription", "1"}
-	tagDict[Tag{0x0018, 0x1401}] = TagInfo{Tag{0x0018, 0x1401}, "LO", "AcquisitionDeviceProcessingCode", "1"}
-	tagDict[Tag{0x0018, 0x1402}] = TagInfo{Tag{0x0018, 0x1402}, "CS", "CassetteOrientation", "1"}
-	tagDict[Tag{0x0018, 0x1403}] = TagInfo{Tag{0x0018, 0x1403}, "CS", "CassetteSize", "1"}
-	tagDict[Tag{0x0018, 0x1404}] = TagInfo{Tag{0x0018, 0x1404}, "US", "ExposuresOnPlate", "1"}
-	tagDict[Tag{0x0018, 0x1405}] = TagInfo{Tag{0x0018, 0x1405}, "IS", "RelativeXRayExposure", "1"}
-	tagDict[Tag{0x0018, 0x1411}] = TagInfo{Tag{0x0018, 0x1411}, "DS", "ExposureIndex", "1"}
-	tagDict[Tag{0x0018, 0x1412}] = TagInfo{Tag{0x0018, 0x1412}, "DS", "TargetExposureIndex", "1"}
-	tagDict[Tag{0x0018, 0x1413}] = TagInfo{Tag{0x0018, 0x1413}, "DS", "DeviationIndex", "1"}
-	tagDict[Tag{0x0018, 0x1450}] = TagInfo{Tag{0x0018, 0x1450}, "DS", "ColumnAngulation", "1"}
-	tagDict[Tag{0x0018, 0x1460}] = TagInfo{Tag{0x0018, 0x1460}, "DS", "TomoLayerHeight", "1"}
-	tagDict[Tag{0x0018, 0x1470}] = TagInfo{Tag{0x0018, 0x1470}, "DS", "TomoAngle", "1"}
-	tagDict[Tag{0x0018, 0x1480}] = TagInfo{Tag{0x0018, 0x1480}, "DS", "TomoTime", "1"}
-	tagDict[Tag{0x0018, 0x1490}] = TagInfo{Tag{0x0018, 0x1490}, "CS", "TomoType", "1"}
-	tagDict[Tag{0x0018, 0x1491}] = TagInfo{Tag{0x0018, 0x1491}, "CS", "TomoClass", "1"}
-	tagDict[Tag{0x0018, 0x1495}] = TagInfo{Tag{0x0018, 0x1495}, "IS", "NumberOfTomosynthesisSourceImages", "1"}
-	tagDict[Tag{0x0018, 0x1500}] = TagInfo{Tag{0x0018, 0x1500}, "CS", "PositionerMotion", "1"}
-	tagDict[Tag{0x0018, 0x1508}] = TagInfo{Tag{0x0018, 0x1508}, "CS", "PositionerType", "1"}
-	tagDict[Tag{0x0018, 0x1510}] = TagInfo{Tag{0x0018, 0x1510}, "DS", "PositionerPrimaryAngle", "1"}
-	tagDict[Tag{0x0018, 0x1511}] = TagInfo{Tag{0x0018, 0x1511}, "DS", "PositionerSecondaryAngle", "1"}
-	tagDict[Tag{0x0018, 0x1520}] = TagInfo{Tag{0x0018, 0x1520}, "DS", "PositionerPrimaryAngleIncrement", "1-n"}
-	tagDict[Tag{0x0018, 0x1521}] = TagInfo{Tag{0x0018, 0x1521}, "DS", "PositionerSecondaryAngleIncrement", "1-n"}
-	tagDict[Tag{0x0018, 0x1530}] = TagInfo{Tag{0x0018, 0x1530}, "DS", "DetectorPrimaryAngle", "1"}
-	tagDict[Tag{0x0018, 0x1531}] = TagInfo{Tag{0x0018, 0x1531}, "DS", "DetectorSecondaryAngle", "1"}
-	tagDict[Tag{0x0018, 0x1600}] = TagInfo{Tag{0x0018, 0x1600}, "CS", "ShutterShape", "1-3"}
-	tagDict[Tag{0x0018, 0x1602}] = TagInfo{Tag{0x0018, 0x1602}, "IS", "ShutterLeftVerticalEdge", "1"}
-	tagDict[Tag{0x0018, 0x1604}] = TagInfo{Tag{0x0018, 0x1604}, "IS", "ShutterRightVerticalEdge", "1"}
-	tagDict[Tag{0x0018, 0x1606}] = TagInfo{Tag{0x0018, 0x1606}, "IS", "ShutterUpperHorizontalEdge", "1"}
-	tagDict[Tag{0x0018, 0x1608}] = TagInfo{Tag{0x0018, 0x1608}, "IS", "ShutterLowerHorizontalEdge", "1"}
-	tagDict[Tag{0x0018, 0x1610}] = TagInfo{Tag{0x0018, 0x1610}, "IS", "CenterOfCircularShutter", "2"}
-	tagDict[Tag{0x0018, 0x1612}] = TagInfo{Tag{0x0018, 0x1612}, "IS", "RadiusOfCircularShutter", "1"}
-	tagDict[Tag{0x0018, 0x1620}] = TagInfo{Tag{0x0018, 0x1620}, "IS", "VerticesOfThePolygonalShutter", "2-2n"}
-	tagDict[Tag{0x0018, 0x1622}] = TagInfo{Tag{0x0018, 0x1622}, "US", "ShutterPresentationValue", "1"}
-	tagDict[Tag{0x0018, 0x1623}] = TagInfo{Tag{0x0018, 0x1623}, "US", "ShutterOverlayGroup", "1"}
-	tagDict[Tag{0x0018, 0x1624}] = TagInfo{Tag{0x0018, 0x1624}, "US", "ShutterPresentationColorCIELabValue", "3"}
-	tagDict[Tag{0x0018, 0x1700}] = TagInfo{Tag{0x0018, 0x1700}, "CS", "CollimatorShape", "1-3"}
-	tagDict[Tag{0x0018, 0x1702}] = TagInfo{Tag{0x0018, 0x1702}, "IS", "CollimatorLeftVerticalEdge", "1"}
-	tagDict[Tag{0x0018, 0x1704}] = TagInfo{Tag{0x0018, 0x1704}, "IS", "CollimatorRightVerticalEdge", "1"}
-	tagDict[Tag{0x0018, 0x1706}] = TagInfo{Tag{0x0018, 0x1706}, "IS", "CollimatorUpperHorizontalEdge", "1"}
-	tagDict[Tag{0x0018, 0x1708}] = TagInfo{Tag{0x0018, 0x1708}, "IS", "CollimatorLowerHorizontalEdge", "1"}
-	tagDict[Tag{0x0018, 0x1710}] = TagInfo{Tag{0x0018, 0x1710}, "IS", "CenterOfCircularCollimator", "2"}
-	tagDict[Tag{0x0018, 0x1712}] = TagInfo{Tag{0x0018, 0x1712}, "IS", "RadiusOfCircularCollimator", "1"}
-	tagDict[Tag{0x0018, 0x1720}] = TagInfo{Tag{0x0018, 0x1720}, "IS", "VerticesOfThePolygonalCollimator", "2-2n"}
-	tagDict[Tag{0x0018, 0x1800}] = TagInfo{Tag{0x0018, 0x1800}, "CS", "AcquisitionTimeSynchronized", "1"}
-	tagDict[Tag{0x0018, 0x1801}] = TagInfo{Tag{0x0018, 0x1801}, "SH", "TimeSource", "1"}
-	tagDict[Tag{0x0018, 0x1802}] = TagInfo{Tag{0x0018, 0x1802}, "CS", "TimeDistributionProtocol", "1"}
-	tagDict[Tag{0x0018, 0x1803}] = TagInfo{Tag{0x0018, 0x1803}, "LO", "NTPSourceAddress", "1"}
-	tagDict[Tag{0x0018, 0x2001}] = TagInfo{Tag{0x0018, 0x2001}, "IS", "PageNumberVector", "1-n"}
-	tagDict[Tag{0x0018, 0x2002}] = TagInfo{Tag{0x0018, 0x2002}, "SH", "FrameLabelVector", "1-n"}
-	tagDict[Tag{0x0018, 0x2003}] = TagInfo{Tag{0x0018, 0x2003}, "DS", "FramePrimaryAngleVector", "1-n"}
-	tagDict[Tag{0x0018, 0x2004}] = TagInfo{Tag{0x0018, 0x2004}, "DS", "FrameSecondaryAngleVector", "1-n"}
-	tagDict[Tag{0x0018, 0x2005}] = TagInfo{Tag{0x0018, 0x2005}, "DS", "SliceLocationVector", "1-n"}
-	tagDict[Tag{0x0018, 0x2006}] = TagInfo{Tag{0x0018, 0x2006}, "SH", "DisplayWindowLabelVector", "1-n"}
-	tagDict[Tag{0x0018, 0x2010}] = TagInfo{Tag{0x0018, 0x2010}, "DS", "NominalScannedPixelSpacing", "2"}
-	tagDict[Tag{0x0018, 0x2020}] = TagInfo{Tag{0x0018, 0x2020}, "CS", "DigitizingDeviceTransportDirection", "1"}
-	tagDict[Tag{0x0018, 0x2030}] = TagInfo{Tag{0x0018, 0x2030}, "DS", "RotationOfScannedFilm", "1"}
-	tagDict[Tag{0x0018, 0x3100}] = TagInfo{Tag{0x0018, 0x3100}, "CS", "IVUSAcquisition", "1"}
-	tagDict[Tag{0x0018, 0x3101}] = TagInfo{Tag{0x0018, 0x3101}, "DS", "IVUSPullbackRate", "1"}
-	tagDict[Tag{0x0018, 0x3102}] = TagInfo{Tag{0x0018, 0x3102}, "DS", "IVUSGatedRate", "1"}
-	tagDict[Tag{0x0018, 0x3103}] = TagInfo{Tag{0x0018, 0x3103}, "IS", "IVUSPullbackStartFrameNumber", "1"}
-	tagDict[Tag{0x0018, 0x3104}] = TagInfo{Tag{0x0018, 0x3104}, "IS", "IVUSPullbackStopFrameNumber", "1"}
-	tagDict[Tag{0x0018, 0x3105}] = TagInfo{Tag{0x0018, 0x3105}, "IS", "LesionNumber", "1-n"}
-	tagDict[Tag{0x0018, 0x5000}] = TagInfo{Tag{0x0018, 0x5000}, "SH", "OutputPower", "1-n"}
-	tagDict[Tag{0x0018, 0x5010}] = TagInfo{Tag{0x0018, 0x5010}, "LO", "TransducerData", "1-n"}
-	tagDict[Tag{0x0018, 0x5012}] = TagInfo{Tag{0x0018, 0x5012}, "DS", "FocusDepth", "1"}
-	tagDict[Tag{0x0018, 0x5020}] = TagInfo{Tag{0x0018, 0x5020}, "LO", "ProcessingFunction", "1"}
-	tagDict[Tag{0x0018, 0x5022}] = TagInfo{Tag{0x0018, 0x5022}, "DS", "MechanicalIndex", "1"}
-	tagDict[Tag{0x0018, 0x5024}] = TagInfo{Tag{0x0018, 0x5024}, "DS", "BoneThermalIndex", "1"}
-	tagDict[Tag{0x0018, 0x5026}] = TagInfo{Tag{0x0018, 0x5026}, "DS", "CranialThermalIndex", "1"}
-	tagDict[Tag{0x0018, 0x5027}] = TagInfo{Tag{0x0018, 0x5027}, "DS", "SoftTissueThermalIndex", "1"}
-	tagDict[Tag{0x0018, 0x5028}] = TagInfo{Tag{0x0018, 0x5028}, "DS", "SoftTissueFocusThermalIndex", "1"}
-	tagDict[Tag{0x0018, 0x5029}] = TagInfo{Tag{0x0018, 0x5029}, "DS", "SoftTissueSurfaceThermalIndex", "1"}
-	tagDict[Tag{0x0018, 0x5050}] = TagInfo{Tag{0x0018, 0x5050}, "IS", "DepthOfScanField", "1"}
-	tagDict[Tag{0x0018, 0x5100}] = TagInfo{Tag{0x0018, 0x5100}, "CS", "PatientPosition", "1"}
-	tagDict[Tag{0x0018, 0x5101}] = TagInfo{Tag{0x0018, 0x5101}, "CS", "ViewPosition", "1"}
-	tagDict[Tag{0x0018, 0x5104}] = TagInfo{Tag{0x0018, 0x5104}, "SQ", "ProjectionEponymousNameCodeSequence", "1"}
-	tagDict[Tag{0x0018, 0x6000}] = TagInfo{Tag{0x0018, 0x6000}, "DS", "Sensitivity", "1"}
-	tagDict[Tag{0x0018, 0x6011}] = TagInfo{Tag{0x0018, 0x6011}, "SQ", "SequenceOfUltrasoundRegions", "1"}
-	tagDict[Tag{0x0018, 0x6012}] = TagInfo{Tag{0x0018, 0x6012}, "US", "RegionSpatialFormat", "1"}
-	tagDict[Tag{0x0018, 0x6014}] = TagInfo{Tag{0x0018, 0x6014}, "US", "RegionDataType", "1"}
-	tagDict[Tag{0x0018, 0x6016}] = TagInfo{Tag{0x0018, 0x6016}, "UL", "RegionFlags", "1"}
-	tagDict[Tag{0x0018, 0x6018}] = TagInfo{Tag{0x0018, 0x6018}, "UL", "RegionLocationMinX0", "1"}
-	tagDict[Tag{0x0018, 0x601A}] = TagInfo{Tag{0x0018, 0x601A}, "UL", "RegionLocationMinY0", "1"}
-	tagDict[Tag{0x0018, 0x601C}] = TagInfo{Tag{0x0018, 0x601C}, "UL", "RegionLocationMaxX1", "1"}
-	tagDict[Tag{0x0018, 0x601E}] = TagInfo{Tag{0x0018, 0x601E}, "UL", "RegionLocationMaxY1", "1"}
-	tagDict[Tag{0x0018, 0x6020}] = TagInfo{Tag{0x0018, 0x6020}, "SL", "ReferencePixelX0", "1"}
-	tagDict[Tag{0x0018, 0x6022}] = TagInfo{Tag{0x0018, 0x6022}, "SL", "ReferencePixelY0", "1"}
-	tagDict[Tag{0x0018, 0x6024}] = TagInfo{Tag{0x0018, 0x6024}, "US", "PhysicalUnitsXDirection", "1"}
-	tagDict[Tag{0x0018, 0x6026}] = TagInfo{Tag{0x0018, 0x6026}, "US", "PhysicalUnitsYDirection", "1"}
-	tagDict[Tag{0x0018, 0x6028}] = TagInfo{Tag{0x0018, 0x6028}, "FD", "ReferencePixelPhysicalValueX", "1"}
-	tagDict[Tag{0x0018, 0x602A}] = TagInfo{Tag{0x0018, 0x602A}, "FD", "ReferencePixelPhysicalValueY", "1"}
-	tagDict[Tag{0x0018, 0x602C}] = TagInfo{Tag{0x0018, 0x602C}, "FD", "PhysicalDeltaX", "1"}
-	tagDict[Tag{0x0018, 0x602E}] = TagInfo{Tag{0x0018, 0x602E}, "FD", "PhysicalDeltaY", "1"}
-	tagDict[Tag{0x0018, 0x6030}] = TagInfo{Tag{0x0018, 0x6030}, "UL", "TransducerFrequency", "1"}
-	tagDict[Tag{0x0018, 0x6031}] = TagInfo{Tag{0x0018, 0x6031}, "CS", "TransducerType", "1"}
-	tagDict[Tag{0x0018, 0x6032}] = TagInfo{Tag{0x0018, 0x6032}, "UL", "PulseRepetitionFrequency", "1"}
-	tagDict[Tag{0x0018, 0x6034}] = TagInfo{Tag{0x0018, 0x6034}, "FD", "DopplerCorrectionAngle", "1"}
-	tagDict[Tag{0x0018, 0x6036}] = TagInfo{Tag{0x0018, 0x6036}, "FD", "SteeringAngle", "1"}
-	tagDict[Tag{0x0018, 0x6039}] = TagInfo{Tag{0x0018, 0x6039}, "SL", "DopplerSampleVolumeXPosition", "1"}
-	tagDict[Tag{0x0018, 0x603B}] = TagInfo{Tag{0x0018, 0x603B}, "SL", "DopplerSampleVolumeYPosition", "1"}
-	tagDict[Tag{0x0018, 0x603D}] = TagInfo{Tag{0x0018, 0x603D}, "SL", "TMLinePositionX0", "1"}
-	tagDict[Tag{0x0018, 0x603F}] = TagInfo{Tag{0x0018, 0x603F}, "SL", "TMLinePositionY0", "1"}
-	tagDict[Tag{0x0018, 0x6041}] = TagInfo{Tag{0x0018, 0x6041}, "SL", "TMLinePositionX1", "1"}
-	tagDict[Tag{0x0018, 0x6043}] = TagInfo{Tag{0x0018, 0x6043}, "SL", "TMLinePositionY1", "1"}
-	tagDict[Tag{0x0018, 0x6044}] = TagInfo{Tag{0x0018, 0x6044}, "US", "PixelComponentOrganization", "1"}
-	tagDict[Tag{0x0018, 0x6046}] = TagInfo{Tag{0x0018, 0x6046}, "UL", "PixelComponentMask", "1"}
-	tagDict[Tag{0x0018, 0x6048}] = TagInfo{Tag{0x0018, 0x6048}, "UL", "PixelComponentRangeStart", "1"}
-	tagDict[Tag{0x0018, 0x604A}] = TagInfo{Tag{0x0018, 0x604A}, "UL", "PixelComponentRangeStop", "1"}
-	tagDict[Tag{0x0018, 0x604C}] = TagInfo{Tag{0x0018, 0x604C}, "US", "PixelComponentPhysicalUnits", "1"}
-	tagDict[Tag{0x0018, 0x604E}] = TagInfo{Tag{0x0018, 0x604E}, "US", "PixelComponentDataType", "1"}
-	tagDict[Tag{0x0018, 0x6050}] = TagInfo{Tag{0x0018, 0x6050}, "UL", "NumberOfTableBreakPoints", "1"}
-	tagDict[Tag{0x0018, 0x6052}] = TagInfo{Tag{0x0018, 0x6052}, "UL", "TableOfXBreakPoints", "1-n"}
-	tagDict[Tag{0x0018, 0x6054}] = TagInfo{Tag{0x0018, 0x6054}, "FD", "TableOfYBreakPoints", "1-n"}
-	tagDict[Tag{0x0018, 0x6056}] = TagInfo{Tag{0x0018, 0x6056}, "UL", "NumberOfTableEntries", "1"}
-	tagDict[Tag{0x0018, 0x6058}] = TagInfo{Tag{0x0018, 0x6058}, "UL", "TableOfPixelValues", "1-n"}
-	tagDict[Tag{0x0018, 0x605A}] = TagInfo{Tag{0x0018, 0x605A}, "FL", "TableOfParameterValues", "1-n"}
-	tagDict[Tag{0x0018, 0x6060}] = TagInfo{Tag{0x0018, 0x6060}, "FL", "RWaveTimeVector", "1-n"}
-	tagDict[Tag{0x0018, 0x7000}] = TagInfo{Tag{0x0018, 0x7000}, "CS", "DetectorConditionsNominalFlag", "1"}
-	tagDict[Tag{0x0018, 0x7001}] = TagInfo{Tag{0x0018, 0x7001}, "DS", "DetectorTemperature", "1"}
-	tagDict[Tag{0x0018, 0x7004}] = TagInfo{Tag{0x0018, 0x7004}, "CS", "DetectorType", "1"}
-	tagDict[Tag{0x0018, 0x7005}] = TagInfo{Tag{0x0018, 0x7005}, "CS", "DetectorConfiguration", "1"}
-	tagDict[Tag{0x0018, 0x7006}] = TagInfo{Tag{0x0018, 0x7006}, "LT", "DetectorDescription", "1"}
-	tagDict[Tag{0x0018, 0x7008}] = TagInfo{Tag{0x0018, 0x7008}, "LT", "DetectorMode", "1"}
-	tagDict[Tag{0x0018, 0x700A}] = TagInfo{Tag{0x0018, 0x700A}, "SH", "DetectorID", "1"}
-	tagDict[Tag{0x0018, 0x700C}] = TagInfo{Tag{0x0018, 0x700C}, "DA", "DateOfLastDetectorCalibration", "1"}
-	tagDict[Tag{0x0018, 0x700E}] = TagInfo{Tag{0x0018, 0x700E}, "TM", "TimeOfLastDetectorCalibration", "1"}
-	tagDict[Tag{0x0018, 0x7010}] = TagInfo{Tag{0x0018, 0x7010}, "IS", "ExposuresOnDetectorSinceLastCalibration", "1"}
-	tagDict[Tag{0x0018, 0x7011}] = TagInfo{Tag{0x0018, 0x7011}, "IS", "ExposuresOnDetectorSinceManufactured", "1"}
-	tagDict[Tag{0x0018, 0x7012}] = TagInfo{Tag{0x0018, 0x7012}, "DS", "DetectorTimeSinceLastExposure", "1"}
-	tagDict[Tag{0x0018, 0x7014}] = TagInfo{Tag{0x0018, 0x7014}, "DS", "DetectorActiveTime", "1"}
-	tagDict[Tag{0x0018, 0x7016}] = TagInfo{Tag{0x0018, 0x7016}, "DS", "DetectorActivationOffsetFromExposure", "1"}
-	tagDict[Tag{0x0018, 0x701A}] = TagInfo{Tag{0x0018, 0x701A}, "DS", "DetectorBinning", "2"}
-	tagDict[Tag{0x0018, 0x7020}] = TagInfo{Tag{0x0018, 0x7020}, "DS", "DetectorElementPhysicalSize", "2"}
-	tagDict[Tag{0x0018, 0x7022}] = TagInfo{Tag{0x0018, 0x7022}, "DS", "DetectorElementSpacing", "2"}
-	tagDict[Tag{0x0018, 0x7024}] = TagInfo{Tag{0x0018, 0x7024}, "CS", "DetectorActiveShape", "1"}
-	tagDict[Tag{0x0018, 0x7026}] = TagInfo{Tag{0x0018, 0x7026}, "DS", "DetectorActiveDimensions", "1-2"}
-	tagDict[Tag{0x0018, 0x7028}] = TagInfo{Tag{0x0018, 0x7028}, "DS", "DetectorActiveOrigin", "2"}
-	tagDict[Tag{0x0018, 0x702A}] = TagInfo{Tag{0x0018, 0x702A}, "LO", "DetectorManufacturerName", "1"}
-	tagDict[Tag{0x0018, 0x702B}] = TagInfo{Tag{0x0018, 0x702B}, "LO", "DetectorManufacturerModelName", "1"}
-	tagDict[Tag{0x0018, 0x7030}] = TagInfo{Tag{0x0018, 0x7030}, "DS", "FieldOfViewOrigin", "2"}
-	tagDict[Tag{0x0018, 0x7032}] = TagInfo{Tag{0x0018, 0x7032}, "DS", "FieldOfViewRotation", "1"}
-	tagDict[Tag{0x0018, 0x7034}] = TagInfo{Tag{0x0018, 0x7034}, "CS", "FieldOfViewHorizontalFlip", "1"}
-	tagDict[Tag{0x0018, 0x7036}] = TagInfo{Tag{0x0018, 0x7036}, "FL", "PixelDataAreaOriginRelativeToFOV", "2"}
-	tagDict[Tag{0x0018, 0x7038}] = TagInfo{Tag{0x0018, 0x7038}, "FL", "PixelDataAreaRotationAngleRelativeToFOV", "1"}
-	tagDict[Tag{0x0018, 0x7040}] = TagInfo{Tag{0x0018, 0x7040}, "LT", "GridAbsorbingMaterial", "1"}
-	tagDict[Tag{0x0018, 0x7041}] = TagInfo{Tag{0x0018, 0x7041}, "LT", "GridSpacingMaterial", "1"}
-	tagDict[Tag{0x0018, 0x7042}] = TagInfo{Tag{0x0018, 0x7042}, "DS", "GridThickness", "1"}
-	tagDict[Tag{0x0018, 0x7044}] = TagInfo{Tag{0x0018, 0x7044}, "DS", "GridPitch", "1"}
-	tagDict[Tag{0x0018, 0x7046}] = TagInfo{Tag{0x0018, 0x7046}, "IS", "GridAspectRatio", "2"}
-	tagDict[Tag{0x0018, 0x7048}] = TagInfo{Tag{0x0018, 0x7048}, "DS", "GridPeriod", "1"}
-	tagDict[Tag{0x0018, 0x704C}] = TagInfo{Tag{0x0018, 0x704C}, "DS", "GridFocalDistance", "1"}
-	tagDict[Tag{0x0018, 0x7050}] = TagInfo{Tag{0x0018, 0x7050}, "CS", "FilterMaterial", "1-n"}
-	tagDict[Tag{0x0018, 0x7052}] = TagInfo{Tag{0x0018, 0x7052}, "DS", "FilterThicknessMinimum", "1-n"}
-	tagDict[Tag{0x0018, 0x7054}] = TagInfo{Tag{0x0018, 0x7054}, "DS", "FilterThicknessMaximum", "1-n"}
-	tagDict[Tag{0x0018, 0x7056}] = TagInfo{Tag{0x0018, 0x7056}, "FL", "FilterBeamPathLengthMinimum", "1-n"}
-	tagDict[Tag{0x0018, 0x7058}] = TagInfo{Tag{0x0018, 0x7058}, "FL", "FilterBeamPathLengthMaximum", "1-n"}
-	tagDict[Tag{0x0018, 0x7060}] = TagInfo{Tag{0x0018, 0x7060}, "CS", "ExposureControlMode", "1"}
-	tagDict[Tag{0x0018, 0x7062}] = TagInfo{Tag{0x0018, 0x7062}, "LT", "ExposureControlModeDescription", "1"}
-	tagDict[Tag{0x0018, 0x7064}] = TagInfo{Tag{0x0018, 0x7064}, "CS", "ExposureStatus", "1"}
-	tagDict[Tag{0x0018, 0x7065}] = TagInfo{Tag{0x0018, 0x7065}, "DS", "PhototimerSetting", "1"}
-	tagDict[Tag{0x0018, 0x8150}] = TagInfo{Tag{0x0018, 0x8150}, "DS", "ExposureTimeInuS", "1"}
-	tagDict[Tag{0x0018, 0x8151}] = TagInfo{Tag{0x0018, 0x8151}, "DS", "XRayTubeCurrentInuA", "1"}
-	tagDict[Tag{0x0018, 0x9004}] = TagInfo{Tag{0x0018, 0x9004}, "CS", "ContentQualification", "1"}
-	tagDict[Tag{0x0018, 0x9005}] = TagInfo{Tag{0x0018, 0x9005}, "SH", "PulseSequenceName", "1"}
-	tagDict[Tag{0x0018, 0x9006}] = TagInfo{Tag{0x0018, 0x9006}, "SQ", "MRImagingModifierSequence", "1"}
-	tagDict[Tag{0x0018, 0x9008}] = TagInfo{Tag{0x0018, 0x9008}, "CS", "EchoPulseSequence", "1"}
-	tagDict[Tag{0x0018, 0x9009}] = TagInfo{Tag{0x0018, 0x9009}, "CS", "InversionRecovery", "1"}
-	tagDict[Tag{0x0018, 0x9010}] = TagInfo{Tag{0x0018, 0x9010}, "CS", "FlowCompensation", "1"}
-	tagDict[Tag{0x0018, 0x9011}] = TagInfo{Tag{0x0018, 0x9011}, "CS", "MultipleSpinEcho", "1"}
-	tagDict[Tag{0x0018, 0x9012}] = TagInfo{Tag{0x0018, 0x9012}, "CS", "MultiPlanarExcitation", "1"}
-	tagDict[Tag{0x0018, 0x9014}] = TagInfo{Tag{0x0018, 0x9014}, "CS", "PhaseContrast", "1"}
-	tagDict[Tag{0x0018, 0x9015}] = TagInfo{Tag{0x0018, 0x9015}, "CS", "TimeOfFlightContrast", "1"}
-	tagDict[Tag{0x0018, 0x9016}] = TagInfo{Tag{0x0018, 0x9016}, "CS", "Spoiling", "1"}
-	tagDict[Tag{0x0018, 0x9017}] = TagInfo{Tag{0x0018, 0x9017}, "CS", "SteadyStatePulseSequence", "1"}
-	tagDict[Tag{0x0018, 0x9018}] = TagInfo{Tag{0x0018, 0x9018}, "CS", "EchoPlanarPulseSequence", "1"}
-	tagDict[Tag{0x0018, 0x9019}] = TagInfo{Tag{0x0018, 0x9019}, "FD", "TagAngleFirstAxis", "1"}
-	tagDict[Tag{0x0018, 0x9020}] = TagInfo{Tag{0x0018, 0x9020}, "CS", "MagnetizationTransfer", "1"}
-	tagDict[Tag{0x0018, 0x9021}] = TagInfo{Tag{0x0018, 0x9021}, "CS", "T2Preparation", "1"}
-	tagDict[Tag{0x0018, 0x9022}] = TagInfo{Tag{0x0018, 0x9022}, "CS", "BloodSignalNulling", "1"}
-	tagDict[Tag{0x0018, 0x9024}] = TagInfo{Tag{0x0018, 0x9024}, "CS", "SaturationRecovery", "1"}
-	tagDict[Tag{0x0018, 0x9025}] = TagInfo{Tag{0x0018, 0x9025}, "CS", "SpectrallySelectedSuppression", "1"}
-	tagDict[Tag{0x0018, 0x9026}] = TagInfo{Tag{0x0018, 0x9026}, "CS", "SpectrallySelectedExcitation", "1"}
-	tagDict[Tag{0x0018, 0x9027}] = TagInfo{Tag{0x0018, 0x9027}, "CS", "SpatialPresaturation", "1"}
-	tagDict[Tag{0x0018, 0x9028}] = TagInfo{Tag{0x0018, 0x9028}, "CS", "Tagging", "1"}
-	tagDict[Tag{0x0018, 0x9029}] = TagInfo{Tag{0x0018, 0x9029}, "CS", "OversamplingPhase", "1"}
-	tagDict[Tag{0x0018, 0x9030}] = TagInfo{Tag{0x0018, 0x9030}, "FD", "TagSpacingFirstDimension", "1"}
-	tagDict[Tag{0x0018, 0x9032}] = TagInfo{Tag{0x0018, 0x9032}, "CS", "GeometryOfKSpaceTraversal", "1"}
-	tagDict[Tag{0x0018, 0x9033}] = TagInfo{Tag{0x0018, 0x9033}, "CS", "SegmentedKSpaceTraversal", "1"}
-	tagDict[Tag{0x0018, 0x9034}] = TagInfo{Tag{0x0018, 0x9034}, "CS", "RectilinearPhaseEncodeReordering", "1"}
-	tagDict[Tag{0x0018, 0x9035}] = TagInfo{Tag{0x0018, 0x9035}, "FD", "TagThickness", "1"}
-	tagDict[Tag{0x0018, 0x9036}] = TagInfo{Tag{0x0018, 0x9036}, "CS", "PartialFourierDirection", "1"}
-	tagDict[Tag{0x0018, 0x9037}] = TagInfo{Tag{0x0018, 0x9037}, "CS", "CardiacSynchronizationTechnique", "1"}
-	tagDict[Tag{0x0018, 0x9041}] = TagInfo{Tag{0x0018, 0x9041}, "LO", "ReceiveCoilManufacturerName", "1"}
-	tagDict[Tag{0x0018, 0x9042}] = TagInfo{Tag{0x0018, 0x9042}, "SQ", "MRReceiveCoilSequence", "1"}
-	tagDict[Tag{0x0018, 0x9043}] = TagInfo{Tag{0x0018, 0x9043}, "CS", "ReceiveCoilType", "1"}
-	tagDict[Tag{0x0018, 0x9044}] = TagInfo{Tag{0x0018, 0x9044}, "CS", "QuadratureReceiveCoil", "1"}
-	tagDict[Tag{0x0018, 0x9045}] = TagInfo{Tag{0x0018, 0x9045}, "SQ", "MultiCoilDefinitionSequence", "1"}
-	tagDict[Tag{0x0018, 0x9046}] = TagInfo{Tag{0x0018, 0x9046}, "LO", "MultiCoilConfiguration", "1"}
-	tagDict[Tag{0x0018, 0x9047}] = TagInfo{Tag{0x0018, 0x9047}, "SH", "MultiCoilElementName", "1"}
-	tagDict[Tag{0x0018, 0x9048}] = TagInfo{Tag{0x0018, 0x9048}, "CS", "MultiCoilElementUsed", "1"}
-	tagDict[Tag{0x0018, 0x9049}] = TagInfo{Tag{0x0018, 0x9049}, "SQ", "MRTransmitCoilSequence", "1"}
-	tagDict[Tag{0x0018, 0x9050}] = TagInfo{Tag{0x0018, 0x9050}, "LO", "TransmitCoilManufacturerName", "1"}
-	tagDict[Tag{0x0018, 0x9051}] = TagInfo{Tag{0x0018, 0x9051}, "CS", "TransmitCoilType", "1"}
-	tagDict[Tag{0x0018, 0x9052}] = TagInfo{Tag{0x0018, 0x9052}, "FD", "SpectralWidth", "1-2"}
-	tagDict[Tag{0x0018, 0x9053}] = TagInfo{Tag{0x0018, 0x9053}, "FD", "ChemicalShiftReference", "1-2"}
-	tagDict[Tag{0x0018, 0x9054}] = TagInfo{Tag{0x0018, 0x9054}, "CS", "VolumeLocalizationTechnique", "1"}
-	tagDict[Tag{0x0018, 0x9058}] = TagInfo{Tag{0x0018, 0x9058}, "US", "MRAcquisitionFrequencyEncodingSteps", "1"}
-	tagDict[Tag{0x0018, 0x9059}] = TagInfo{Tag{0x0018, 0x9059}, "CS", "Decoupling", "1"}
-	tagDict[Tag{0x0018, 0x9060}] = TagInfo{Tag{0x0018, 0x9060}, "CS", "DecoupledNucleus", "1-2"}
-	tagDict[Tag{0x0018, 0x9061}] = TagInfo{Tag{0x0018, 0x9061}, "FD", "DecouplingFrequency", "1-2"}
-	tagDict[Tag{0x0018, 0x9062}] = TagInfo{Tag{0x0018, 0x9062}, "CS", "DecouplingMethod", "1"}
-	tagDict[Tag{0x0018, 0x9063}] = TagInfo{Tag{0x0018, 0x9063}, "FD", "DecouplingChemicalShiftReference", "1-2"}
-	tagDict[Tag{0x0018, 0x9064}] = TagInfo{Tag{0x0018, 0x9064}, "CS", "KSpaceFiltering", "1"}
-	tagDict[Tag{0x0018, 0x9065}] = TagInfo{Tag{0x0018, 0x9065}, "CS", "TimeDomainFiltering", "1-2"}
-	tagDict[Tag{0x0018, 0x9066}] = TagInfo{Tag{0x0018, 0x9066}, "US", "NumberOfZeroFills", "1-2"}
-	tagDict[Tag{0x0018, 0x9067}] = TagInfo{Tag{0x0018, 0x9067}, "CS", "BaselineCorrection", "1"}
-	tagDict[Tag{0x0018, 0x9069}] = TagInfo{Tag{0x0018, 0x9069}, "FD", "ParallelReductionFactorInPlane", "1"}
-	tagDict[Tag{0x0018, 0x9070}] = TagInfo{Tag{0x0018, 0x9070}, "FD", "CardiacRRIntervalSpecified", "1"}
-	tagDict[Tag{0x0018, 0x9073}] = TagInfo{Tag{0x0018, 0x9073}, "FD", "AcquisitionDuration", "1"}
-	tagDict[Tag{0x0018, 0x9074}] = TagInfo{Tag{0x0018, 0x9074}, "DT", "FrameAcquisitionDateTime", "1"}
-	tagDict[Tag{0x0018, 0x9075}] = TagInfo{Tag{0x0018, 0x9075}, "CS", "DiffusionDirectionality", "1"}
-	tagDict[Tag{0x0018, 0x9076}] = TagInfo{Tag{0x0018, 0x9076}, "SQ", "DiffusionGradientDirectionSequence", "1"}
-	tagDict[Tag{0x0018, 0x9077}] = TagInfo{Tag{0x0018, 0x9077}, "CS", "ParallelAcquisition", "1"}
-	tagDict[Tag{0x0018, 0x9078}] = TagInfo{Tag{0x0018, 0x9078}, "CS", "ParallelAcquisitionTechnique", "1"}
-	tagDict[Tag{0x0018, 0x9079}] = TagInfo{Tag{0x0018, 0x9079}, "FD", "InversionTimes", "1-n"}
-	tagDict[Tag{0x0018, 0x9080}] = TagInfo{Tag{0x0018, 0x9080}, "ST", "MetaboliteMapDescription", "1"}
-	tagDict[Tag{0x0018, 0x9081}] = TagInfo{Tag{0x0018, 0x9081}, "CS", "PartialFourier", "1"}
-	tagDict[Tag{0x0018, 0x9082}] = TagInfo{Tag{0x0018, 0x9082}, "FD", "EffectiveEchoTime", "1"}
-	tagDict[Tag{0x0018, 0x9083}] = TagInfo{Tag{0x0018, 0x9083}, "SQ", "MetaboliteMapCodeSequence", "1"}
-	tagDict[Tag{0x0018, 0x9084}] = TagInfo{Tag{0x0018, 0x9084}, "SQ", "ChemicalShiftSequence", "1"}
-	tagDict[Tag{0x0018, 0x9085}] = TagInfo{Tag{0x0018, 0x9085}, "CS", "CardiacSignalSource", "1"}
-	tagDict[Tag{0x0018, 0x9087}] = TagInfo{Tag{0x0018, 0x9087}, "FD", "DiffusionBValue", "1"}
-	tagDict[Tag{0x0018, 0x9089}] = TagInfo{Tag{0x0018, 0x9089}, "FD", "DiffusionGradientOrientation", "3"}
-	tagDict[Tag{0x0018, 0x9090}] = TagInfo{Tag{0x0018, 0x9090}, "FD", "VelocityEncodingDirection", "3"}
-	tagDict[Tag{0x0018, 0x9091}] = TagInfo{Tag{0x0018, 0x9091}, "FD", "VelocityEncodingMinimumValue", "1"}
-	tagDict[Tag{0x0018, 0x9092}] = TagInfo{Tag{0x0018, 0x9092}, "SQ", "VelocityEncodingAcquisitionSequence", "1"}
-	tagDict[Tag{0x0018, 0x9093}] = TagInfo{Tag{0x0018, 0x9093}, "US", "NumberOfKSpaceTrajectories", "1"}
-	tagDict[Tag{0x0018, 0x9094}] = TagInfo{Tag{0x0018, 0x9094}, "CS", "CoverageOfKSpace", "1"}
-	tagDict[Tag{0x0018, 0x9095}] = TagInfo{Tag{0x0018, 0x9095}, "UL", "SpectroscopyAcquisitionPhaseRows", "1"}
-	tagDict[Tag{0x0018, 0x9098}] = TagInfo{Tag{0x0018, 0x9098}, "FD", "TransmitterFrequency", "1-2"}
-	tagDict[Tag{0x0018, 0x9100}] = TagInfo{Tag{0x0018, 0x9100}, "CS", "ResonantNucleus", "1-2"}
-	tagDict[Tag{0x0018, 0x9101}] = TagInfo{Tag{0x0018, 0x9101}, "CS", "FrequencyCorrection", "1"}
-	tagDict[Tag{0x0018, 0x9103}] = TagInfo{Tag{0x0018, 0x9103}, "SQ", "MRSpectroscopyFOVGeometrySequence", "1"}
-	tagDict[Tag{0x0018, 0x9104}] = TagInfo{Tag{0x0018, 0x9104}, "FD", "SlabThickness", "1"}
-	tagDict[Tag{0x0018, 0x9105}] = TagInfo{Tag{0x0018, 0x9105}, "FD", "SlabOrientation", "3"}
-	tagDict[Tag{0x0018, 0x9106}] = TagInfo{Tag{0x0018, 0x9106}, "FD", "MidSlabPosition", "3"}
-	tagDict[Tag{0x0018, 0x9107}] = TagInfo{Tag{0x0018, 0x9107}, "SQ", "MRSpatialSaturationSequence", "1"}
-	tagDict[Tag{0x0018, 0x9112}] = TagInfo{Tag{0x0018, 0x9112}, "SQ", "MRTimingAndRelatedParametersSequence", "1"}
-	tagDict[Tag{0x0018, 0x9114}] = TagInfo{Tag{0x0018, 0x9114}, "SQ", "MREchoSequence", "1"}
-	tagDict[Tag{0x0018, 0x9115}] = TagInfo{Tag{0x0018, 0x9115}, "SQ", "MRModifierSequence", "1"}
-	tagDict[Tag{0x0018, 0x9117}] = TagInfo{Tag{0x0018, 0x9117}, "SQ", "MRDiffusionSequence", "1"}
-	tagDict[Tag{0x0018, 0x9118}] = TagInfo{Tag{0x0018, 0x9118}, "SQ", "CardiacSynchronizationSequence", "1"}
-	tagDict[Tag{0x0018, 0x9119}] = TagInfo{Tag{0x0018, 0x9119}, "SQ", "MRAveragesSequence", "1"}
-	tagDict[Tag{0x0018, 0x9125}] = TagInfo{Tag{0x0018, 0x9125}, "SQ", "MRFOVGeometrySequence", "1"}
-	tagDict[Tag{0x0018, 0x9126}] = TagInfo{Tag{0x0018, 0x9126}, "SQ", "VolumeLocalizationSequence", "1"}
-	tagDict[Tag{0x0018, 0x9127}] = TagInfo{Tag{0x0018, 0x9127}, "UL", "SpectroscopyAcquisitionDataColumns", "1"}
-	tagDict[Tag{0x0018, 0x9147}] = TagInfo{Tag{0x0018, 0x9147}, "CS", "DiffusionAnisotropyType", "1"}
-	tagDict[Tag{0x0018, 0x9151}] = TagInfo{Tag{0x0018, 0x9151}, "DT", "FrameReferenceDateTime", "1"}
-	tagDict[Tag{0x0018, 0x9152}] = TagInfo{Tag{0x0018, 0x9152}, "SQ", "MRMetaboliteMapSequence", "1"}
-	tagDict[Tag{0x0018, 0x9155}] = TagInfo{Tag{0x0018, 0x9155}, "FD", "ParallelReductionFactorOutOfPlane", "1"}
-	tagDict[Tag{0x0018, 0x9159}] = TagInfo{Tag{0x0018, 0x9159}, "UL", "SpectroscopyAcquisitionOutOfPlanePhaseSteps", "1"}
-	tagDict[Tag{0x0018, 0x9168}] = TagInfo{Tag{0x0018, 0x9168}, "FD", "ParallelReductionFactorSecondInPlane", "1"}
-	tagDict[Tag{0x0018, 0x9169}] = TagInfo{Tag{0x0018, 0x9169}, "CS", "CardiacBeatRejectionTechnique", "1"}
-	tagDict[Tag{0x0018, 0x9170}] = TagInfo{Tag{0x0018, 0x9170}, "CS", "RespiratoryMotionCompensationTechnique", "1"}
-	tagDict[Tag{0x0018, 0x9171}] = TagInfo{Tag{0x0018, 0x9171}, "CS", "RespiratorySignalSource", "1"}
-	tagDict[Tag{0x0018, 0x9172}] = TagInfo{Tag{0x0018, 0x9172}, "CS", "BulkMotionCompensationTechnique", "1"}
-	tagDict[Tag{0x0018, 0x9173}] = TagInfo{Tag{0x0018, 0x9173}, "CS", "BulkMotionSignalSource", "1"}
-	tagDict[Tag{0x0018, 0x9174}] = TagInfo{Tag{0x0018, 0x9174}, "CS", "ApplicableSafetyStandardAgency", "1"}
-	tagDict[Tag{0x0018, 0x9175}] = TagInfo{Tag{0x0018, 0x9175}, "LO", "ApplicableSafetyStandardDescription", "1"}
-	tagDict[Tag{0x0018, 0x9176}] = TagInfo{Tag{0x0018, 0x9176}, "SQ", "OperatingModeSequence", "1"}
-	tagDict[Tag{0x0018, 0x9177}] = TagInfo{Tag{0x0018, 0x9177}, "CS", "OperatingModeType", "1"}
-	tagDict[Tag{0x0018, 0x9178}] = TagInfo{Tag{0x0018, 0x9178}, "CS", "OperatingMode", "1"}
-	tagDict[Tag{0x0018, 0x9179}] = TagInfo{Tag{0x0018, 0x9179}, "CS", "SpecificAbsorptionRateDefinition", "1"}
-	tagDict[Tag{0x0018, 0x9180}] = TagInfo{Tag{0x0018, 0x9180}, "CS", "GradientOutputType", "1"}
-	tagDict[Tag{0x0018, 0x9181}] = TagInfo{Tag{0x0018, 0x9181}, "FD", "SpecificAbsorptionRateValue", "1"}
-	tagDict[Tag{0x0018, 0x9182}] = TagInfo{Tag{0x0018, 0x9182}, "FD", "GradientOutput", "1"}
-	tagDict[Tag{0x0018, 0x9183}] = TagInfo{Tag{0x0018, 0x9183}, "CS", "FlowCompensationDirection", "1"}
-	tagDict[Tag{0x0018, 0x9184}] = TagInfo{Tag{0x0018, 0x9184}, "FD", "TaggingDelay", "1"}
-	tagDict[Tag{0x0018, 0x9185}] = TagInfo{Tag{0x0018, 0x9185}, "ST", "RespiratoryMotionCompensationTechniqueDescription", "1"}
-	tagDict[Tag{0x0018, 0x9186}] = TagInfo{Tag{0x0018, 0x9186}, "SH", "RespiratorySignalSourceID", "1"}
-	tagDict[Tag{0x0018, 0x9197}] = TagInfo{Tag{0x0018, 0x9197}, "SQ", "MRVelocityEncodingSequence", "1"}
-	tagDict[Tag{0x0018, 0x9198}] = TagInfo{Tag{0x0018, 0x9198}, "CS", "FirstOrderPhaseCorrection", "1"}
-	tagDict[Tag{0x0018, 0x9199}] = TagInfo{Tag{0x0018, 0x9199}, "CS", "WaterReferencedPhaseCorrection", "1"}
-	tagDict[Tag{0x0018, 0x9200}] = TagInfo{Tag{0x0018, 0x9200}, "CS", "MRSpectroscopyAcquisitionType", "1"}
-	tagDict[Tag{0x0018, 0x9214}] = TagInfo{Tag{0x0018, 0x9214}, "CS", "RespiratoryCyclePosition", "1"}
-	tagDict[Tag{0x0018, 0x9217}] = TagInfo{Tag{0x0018, 0x9217}, "FD", "VelocityEncodingMaximumValue", "1"}
-	tagDict[Tag{0x0018, 0x9218}] = TagInfo{Tag{0x0018, 0x9218}, "FD", "TagSpacingSecondDimension", "1"}
-	tagDict[Tag{0x0018, 0x9219}] = TagInfo{Tag{0x0018, 0x9219}, "SS", "TagAngleSecondAxis", "1"}
-	tagDict[Tag{0x0018, 0x9220}] = TagInfo{Tag{0x0018, 0x9220}, "FD", "FrameAcquisitionDuration", "1"}
-	tagDict[Tag{0x0018, 0x9226}] = TagInfo{Tag{0x0018, 0x9226}, "SQ", "MRImageFrameTypeSequence", "1"}
-	tagDict[Tag{0x0018, 0x9227}] = TagInfo{Tag{0x0018, 0x9227}, "SQ", "MRSpectroscopyFrameTypeSequence", "1"}
-	tagDict[Tag{0x0018, 0x9231}] = TagInfo{Tag{0x0018, 0x9231}, "US", "MRAcquisitionPhaseEncodingStepsInPlane", "1"}
-	tagDict[Tag{0x0018, 0x9232}] = TagInfo{Tag{0x0018, 0x9232}, "US", "MRAcquisitionPhaseEncodingStepsOutOfPlane", "1"}
-	tagDict[Tag{0x0018, 0x9234}] = TagInfo{Tag{0x0018, 0x9234}, "UL", "SpectroscopyAcquisitionPhaseColumns", "1"}
-	tagDict[Tag{0x0018, 0x9236}] = TagInfo{Tag{0x0018, 0x9236}, "CS", "CardiacCyclePosition", "1"}
-	tagDict[Tag{0x0018, 0x9239}] = TagInfo{Tag{0x0018, 0x9239}, "SQ", "SpecificAbsorptionRateSequence", "1"}
-	tagDict[Tag{0x0018, 0x9240}] = TagInfo{Tag{0x0018, 0x9240}, "US", "RFEchoTrainLength", "1"}
-	tagDict[Tag{0x0018, 0x9241}] = TagInfo{Tag{0x0018, 0x9241}, "US", "GradientEchoTrainLength", "1"}
-	tagDict[Tag{0x0018, 0x9250}] = TagInfo{Tag{0x0018, 0x9250}, "CS", "ArterialSpinLabelingContrast", "1"}
-	tagDict[Tag{0x0018, 0x9251}] = TagInfo{Tag{0x0018, 0x9251}, "SQ", "MRArterialSpinLabelingSequence", "1"}
-	tagDict[Tag{0x0018, 0x9252}] = TagInfo{Tag{0x0018, 0x9252}, "LO", "ASLTechniqueDescription", "1"}
-	tagDict[Tag{0x0018, 0x9253}] = TagInfo{Tag{0x0018, 0x9253}, "US", "ASLSlabNumber", "1"}
-	tagDict[Tag{0x0018, 0x9254}] = TagInfo{Tag{0x0018, 0x9254}, "FD", "ASLSlabThickness", "1"}
-	tagDict[Tag{0x0018, 0x9255}] = TagInfo{Tag{0x0018, 0x9255}, "FD", "ASLSlabOrientation", "3"}
-	tagDict[Tag{0x0018, 0x9256}] = TagInfo{Tag{0x0018, 0x9256}, "FD", "ASLMidSlabPosition", "3"}
-	tagDict[Tag{0x0018, 0x9257}] = TagInfo{Tag{0x0018, 0x9257}, "CS", "ASLContext", "1"}
-	tagDict[Tag{0x0018, 0x9258}] = TagInfo{Tag{0x0018, 0x9258}, "UL", "ASLPulseTrainDuration", "1"}
-	tagDict[Tag{0x0018, 0x9259}] = TagInfo{Tag{0x0018, 0x9259}, "CS", "ASLCrusherFlag", "1"}
-	tagDict[Tag{0x0018, 0x925A}] = TagInfo{Tag{0x0018, 0x925A}, "FD", "ASLCrusherFlow", "1"}
-	tagDict[Tag{0x0018, 0x925B}] = TagInfo{Tag{0x0018, 0x925B}, "LO", "ASLCrusherDescription", "1"}
-	tagDict[Tag{0x0018, 0x925C}] = TagInfo{Tag{0x0018, 0x925C}, "CS", "ASLBolusCutoffFlag", "1"}
-	tagDict[Tag{0x0018, 0x925D}] = TagInfo{Tag{0x0018, 0x925D}, "SQ", "ASLBolusCutoffTimingSequence", "1"}
-	tagDict[Tag{0x0018, 0x925E}] = TagInfo{Tag{0x0018, 0x925E}, "LO", "ASLBolusCutoffTechnique", "1"}
-	tagDict[Tag{0x0018, 0x925F}] = TagInfo{Tag{0x0018, 0x925F}, "UL", "ASLBolusCutoffDelayTime", "1"}
-	tagDict[Tag{0x0018, 0x9260}] = TagInfo{Tag{0x0018, 0x9260}, "SQ", "ASLSlabSequence", "1"}
-	tagDict[Tag{0x0018, 0x9295}] = TagInfo{Tag{0x0018, 0x9295}, "FD", "ChemicalShiftMinimumIntegrationLimitInppm", "1"}
-	tagDict[Tag{0x0018, 0x9296}] = TagInfo{Tag{0x0018, 0x9296}, "FD", "ChemicalShiftMaximumIntegrationLimitInppm", "1"}
-	tagDict[Tag{0x0018, 0x9301}] = TagInfo{Tag{0x0018, 0x9301}, "SQ", "CTAcquisitionTypeSequence", "1"}
-	tagDict[Tag{0x0018, 0x9302}] = TagInfo{Tag{0x0018, 0x9302}, "CS", "AcquisitionType", "1"}
-	tagDict[Tag{0x0018, 0x9303}] = TagInfo{Tag{0x0018, 0x9303}, "FD", "TubeAngle", "1"}
-	tagDict[Tag{0x0018, 0x9304}] = TagInfo{Tag{0x0018, 0x9304}, "SQ", "CTAcquisitionDetailsSequence", "1"}
-	tagDict[Tag{0x0018, 0x9305}] = TagInfo{Tag{0x0018, 0x9305}, "FD", "RevolutionTime", "1"}
-	tagDict[Tag{0x0018, 0x9306}] = TagInfo{Tag{0x0018, 0x9306}, "FD", "SingleCollimationWidth", "1"}
-	tagDict[Tag{0x0018, 0x9307}] = TagInfo{Tag{0x0018, 0x9307}, "FD", "TotalCollimationWidth", "1"}
-	tagDict[Tag{0x0018, 0x9308}] = TagInfo{Tag{0x0018, 0x9308}, "SQ", "CTTableDynamicsSequence", "1"}
-	tagDict[Tag{0x0018, 0x9309}] = TagInfo{Tag{0x0018, 0x9309}, "FD", "TableSpeed", "1"}
-	tagDict[Tag{0x0018, 0x9310}] = TagInfo{Tag{0x0018, 0x9310}, "FD", "TableFeedPerRotation", "1"}
-	tagDict[Tag{0x0018, 0x9311}] = TagInfo{Tag{0x0018, 0x9311}, "FD", "SpiralPitchFactor", "1"}
-	tagDict[Tag{0x0018, 0x9312}] = TagInfo{Tag{0x0018, 0x9312}, "SQ", "CTGeometrySequence", "1"}
-	tagDict[Tag{0x0018, 0x9313}] = TagInfo{Tag{0x0018, 0x9313}, "FD", "DataCollectionCenterPatient", "3"}
-	tagDict[Tag{0x0018, 0x9314}] = TagInfo{Tag{0x0018, 0x9314}, "SQ", "CTReconstructionSequence", "1"}
-	tagDict[Tag{0x0018, 0x9315}] = TagInfo{Tag{0x0018, 0x9315}, "CS", "ReconstructionAlgorithm", "1"}
-	tagDict[Tag{0x0018, 0x9316}] = TagInfo{Tag{0x0018, 0x9316}, "CS", "ConvolutionKernelGroup", "1"}
-	tagDict[Tag{0x0018, 0x9317}] = TagInfo{Tag{0x0018, 0x9317}, "FD", "ReconstructionFieldOfView", "2"}
-	tagDict[Tag{0x0018, 0x9318}] = TagInfo{Tag{0x0018, 0x9318}, "FD", "ReconstructionTargetCenterPatient", "3"}
-	tagDict[Tag{0x0018, 0x9319}] = TagInfo{Tag{0x0018, 0x9319}, "FD", "ReconstructionAngle", "1"}
-	tagDict[Tag{0x0018, 0x9320}] = TagInfo{Tag{0x0018, 0x9320}, "SH", "ImageFilter", "1"}
-	tagDict[Tag{0x0018, 0x9321}] = TagInfo{Tag{0x0018, 0x9321}, "SQ", "CTExposureSequence", "1"}
-	tagDict[Tag{0x0018, 0x9322}] = TagInfo{Tag{0x0018, 0x9322}, "FD", "ReconstructionPixelSpacing", "2"}
-	tagDict[Tag{0x0018, 0x9323}] = TagInfo{Tag{0x0018, 0x9323}, "CS", "ExposureModulationType", "1"}
-	tagDict[Tag{0x0018, 0x9324}] = TagInfo{Tag{0x0018, 0x9324}, "FD", "EstimatedDoseSaving", "1"}
-	tagDict[Tag{0x0018, 0x9325}] = TagInfo{Tag{0x0018, 0x9325}, "SQ", "CTXRayDetailsSequence", "1"}
-	tagDict[Tag{0x0018, 0x9326}] = TagInfo{Tag{0x0018, 0x9326}, "SQ", "CTPositionSequence", "1"}
-	tagDict[Tag{0x0018, 0x9327}] = TagInfo{Tag{0x0018, 0x9327}, "FD", "TablePosition", "1"}
-	tagDict[Tag{0x0018, 0x9328}] = TagInfo{Tag{0x0018, 0x9328}, "FD", "ExposureTimeInms", "1"}
-	tagDict[Tag{0x0018, 0x9329}] = TagInfo{Tag{0x0018, 0x9329}, "SQ", "CTImageFrameTypeSequence", "1"}
-	tagDict[Tag{0x0018, 0x9330}] = TagInfo{Tag{0x0018, 0x9330}, "FD", "XRayTubeCurrentInmA", "1"}
-	tagDict[Tag{0x0018, 0x9332}] = TagInfo{Tag{0x0018, 0x9332}, "FD", "ExposureInmAs", "1"}
-	tagDict[Tag{0x0018, 0x9333}] = TagInfo{Tag{0x0018, 0x9333}, "CS", "ConstantVolumeFlag", "1"}
-	tagDict[Tag{0x0018, 0x9334}] = TagInfo{Tag{0x0018, 0x9334}, "CS", "FluoroscopyFlag", "1"}
-	tagDict[Tag{0x0018, 0x9335}] = TagInfo{Tag{0x0018, 0x9335}, "FD", "DistanceSourceToDataCollectionCenter", "1"}
-	tagDict[Tag{0x0018, 0x9337}] = TagInfo{Tag{0x0018, 0x9337}, "US", "ContrastBolusAgentNumber", "1"}
-	tagDict[Tag{0x0018, 0x9338}] = TagInfo{Tag{0x0018, 0x9338}, "SQ", "ContrastBolusIngredientCodeSequence", "1"}
-	tagDict[Tag{0x0018, 0x9340}] = TagInfo{Tag{0x0018, 0x9340}, "SQ", "ContrastAdministrationProfileSequence", "1"}
-	tagDict[Tag{0x0018, 0x9341}] = TagInfo{Tag{0x0018, 0x9341}, "SQ", "ContrastBolusUsageSequence", "1"}
-	tagDict[Tag{0x0018, 0x9342}] = TagInfo{Tag{0x0018, 0x9342}, "CS", "ContrastBolusAgentAdministered", "1"}
-	tagDict[Tag{0x0018, 0x9343}] = TagInfo{Tag{0x0018, 0x9343}, "CS", "ContrastBolusAgentDetected", "1"}
-	tagDict[Tag{0x0018, 0x9344}] = TagInfo{Tag{0x0018, 0x9344}, "CS", "ContrastBolusAgentPhase", "1"}
-	tagDict[Tag{0x0018, 0x9345}] = TagInfo{Tag{0x0018, 0x9345}, "FD", "CTDIvol", "1"}
-	tagDict[Tag{0x0018, 0x9346}] = TagInfo{Tag{0x0018, 0x9346}, "SQ", "CTDIPhantomTypeCodeSequence", "1"}
-	tagDict[Tag{0x0018, 0x9351}] = TagInfo{Tag{0x0018, 0x9351}, "FL", "CalciumScoringMassFactorPatient", "1"}
-	tagDict[Tag{0x0018, 0x9352}] = TagInfo{Tag{0x0018, 0x9352}, "FL", "CalciumScoringMassFactorDevice", "3"}
-	tagDict[Tag{0x0018, 0x9353}] = TagInfo{Tag{0x0018, 0x9353}, "FL", "EnergyWeightingFactor", "1"}
-	tagDict[Tag{0x0018, 0x9360}] = TagInfo{Tag{0x0018, 0x9360}, "SQ", "CTAdditionalXRaySourceSequence", "1"}
-	tagDict[Tag{0x0018, 0x9401}] = TagInfo{Tag{0x0018, 0x9401}, "SQ", "ProjectionPixelCalibrationSequence", "1"}
-	tagDict[Tag{0x0018, 0x9402}] = TagInfo{Tag{0x0018, 0x9402}, "FL", "DistanceSourceToIsocenter", "1"}
-	tagDict[Tag{0x0018, 0x9403}] = TagInfo{Tag{0x0018, 0x9403}, "FL", "DistanceObjectToTableTop", "1"}
-	tagDict[Tag{0x0018, 0x9404}] = TagInfo{Tag{0x0018, 0x9404}, "FL", "ObjectPixelSpacingInCenterOfBeam", "2"}
-	tagDict[Tag{0x0018, 0x9405}] = TagInfo{Tag{0x0018, 0x9405}, "SQ", "PositionerPositionSequence", "1"}
-	tagDict[Tag{0x0018, 0x9406}] = TagInfo{Tag{0x0018, 0x9406}, "SQ", "TablePositionSequence", "1"}
-	tagDict[Tag{0x0018, 0x9407}] = TagInfo{Tag{0x0018, 0x9407}, "SQ", "CollimatorShapeSequence", "1"}
-	tagDict[Tag{0x0018, 0x9410}] = TagInfo{Tag{0x0018, 0x9410}, "CS", "PlanesInAcquisition", "1"}
-	tagDict[Tag{0x0018, 0x9412}] = TagInfo{Tag{0x0018, 0x9412}, "SQ", "XAXRFFrameCharacteristicsSequence", "1"}
-	tagDict[Tag{0x0018, 0x9417}] = TagInfo{Tag{0x0018, 0x9417}, "SQ", "FrameAcquisitionSequence", "1"}
-	tagDict[Tag{0x0018, 0x9420}] = TagInfo{Tag{0x0018, 0x9420}, "CS", "XRayReceptorType", "1"}
-	tagDict[Tag{0x0018, 0x9423}] = TagInfo{Tag{0x0018, 0x9423}, "LO", "AcquisitionProtocolName", "1"}
-	tagDict[Tag{0x0018, 0x9424}] = TagInfo{Tag{0x0018, 0x9424}, "LT", "AcquisitionProtocolDescription", "1"}
-	tagDict[Tag{0x0018, 0x9425}] = TagInfo{Tag{0x0018, 0x9425}, "CS", "ContrastBolusIngredientOpaque", "1"}
-	tagDict[Tag{0x0018, 0x9426}] = TagInfo{Tag{0x0018, 0x9426}, "FL", "DistanceReceptorPlaneToDetectorHousing", "1"}
-	tagDict[Tag{0x0018, 0x9427}] = TagInfo{Tag{0x0018, 0x9427}, "CS", "IntensifierActiveShape", "1"}
-	tagDict[Tag{0x0018, 0x9428}] = TagInfo{Tag{0x0018, 0x9428}, "FL", "IntensifierActiveDimensions", "1-2"}
-	tagDict[Tag{0x0018, 0x9429}] = TagInfo{Tag{0x0018, 0x9429}, "FL", "PhysicalDetectorSize", "2"}
-	tagDict[Tag{0x0018, 0x9430}] = TagInfo{Tag{0x0018, 0x9430}, "FL", "PositionOfIsocenterProjection", "2"}
-	tagDict[Tag{0x0018, 0x9432}] = TagInfo{Tag{0x0018, 0x9432}, "SQ", "FieldOfViewSequence", "1"}
-	tagDict[Tag{0x0018, 0x9433}] = TagInfo{Tag{0x0018, 0x9433}, "LO", "FieldOfViewDescription", "1"}
-	tagDict[Tag{0x0018, 0x9434}] = TagInfo{Tag{0x0018, 0x9434}, "SQ", "ExposureControlSensingRegionsSequence", "1"}
-	tagDict[Tag{0x0018, 0x9435}] = TagInfo{Tag{0x0018, 0x9435}, "CS", "ExposureControlSensingRegionShape", "1"}
-	tagDict[Tag{0x0018, 0x9436}] = TagInfo{Tag{0x0018, 0x9436}, "SS", "ExposureControlSensingRegionLeftVerticalEdge", "1"}
-	tagDict[Tag{0x0018, 0x9437}] = TagInfo{Tag{0x0018, 0x9437}, "SS", "ExposureControlSensingRegionRightVerticalEdge", "1"}
-	tagDict[Tag{0x0018, 0x9438}] = TagInfo{Tag{0x0018, 0x9438}, "SS", "ExposureControlSensingRegionUpperHorizontalEdge", "1"}
-	tagDict[Tag{0x0018, 0x9439}] = TagInfo{Tag{0x0018, 0x9439}, "SS", "ExposureControlSensingRegionLowerHorizontalEdge", "1"}
-	tagDict[Tag{0x0018, 0x9440}] = TagInfo{Tag{0x0018, 0x9440}, "SS", "CenterOfCircularExposureControlSensingRegion", "2"}
-	tagDict[Tag{0x0018, 0x9441}] = TagInfo{Tag{0x0018, 0x9441}, "US", "RadiusOfCircularExposureControlSensingRegion", "1"}
-	tagDict[Tag{0x0018, 0x9442}] = TagInfo{Tag{0x0018, 0x9442}, "SS", "VerticesOfThePolygonalExposureControlSensingRegion", "2-n"}
-	tagDict[Tag{0x0018, 0x9447}] = TagInfo{Tag{0x0018, 0x9447}, "FL", "ColumnAngulationPatient", "1"}
-	tagDict[Tag{0x0018, 0x9449}] = TagInfo{Tag{0x0018, 0x9449}, "FL", "BeamAngle", "1"}
-	tagDict[Tag{0x0018, 0x9451}] = TagInfo{Tag{0x0018, 0x9451}, "SQ", "FrameDetectorParametersSequence", "1"}
-	tagDict[Tag{0x0018, 0x9452}] = TagInfo{Tag{0x0018, 0x9452}, "FL", "CalculatedAnatomyThickness", "1"}
-	tagDict[Tag{0x0018, 0x9455}] = TagInfo{Tag{0x0018, 0x9455}, "SQ", "CalibrationSequence", "1"}
-	tagDict[Tag{0x0018, 0x9456}] = TagInfo{Tag{0x0018, 0x9456}, "SQ", "ObjectThicknessSequence", "1"}
-	tagDict[Tag{0x0018, 0x9457}] = TagInfo{Tag{0x0018, 0x9457}, "CS", "PlaneIdentification", "1"}
-	tagDict[Tag{0x0018, 0x9461}] = TagInfo{Tag{0x0018, 0x9461}, "FL", "FieldOfViewDimensionsInFloat", "1-2"}
-	tagDict[Tag{0x0018, 0x9462}] = TagInfo{Tag{0x0018, 0x9462}, "SQ", "IsocenterReferenceSystemSequence", "1"}
-	tagDict[Tag{0x0018, 0x9463}] = TagInfo{Tag{0x0018, 0x9463}, "FL", "PositionerIsocenterPrimaryAngle", "1"}
-	tagDict[Tag{0x0018, 0x9464}] = TagInfo{Tag{0x0018, 0x9464}, "FL", "PositionerIsocenterSecondaryAngle", "1"}
-	tagDict[Tag{0x0018, 0x9465}] = TagInfo{Tag{0x0018, 0x9465}, "FL", "PositionerIsocenterDetectorRotationAngle", "1"}
-	tagDict[Tag{0x0018, 0x9466}] = TagInfo{Tag{0x0018, 0x9466}, "FL", "TableXPositionToIsocenter", "1"}
-	tagDict[Tag{0x0018, 0x9467}] = TagInfo{Tag{0x0018, 0x9467}, "FL", "TableYPositionToIsocenter", "1"}
-	tagDict[Tag{0x0018, 0x9468}] = TagInfo{Tag{0x0018, 0x9468}, "FL", "TableZPositionToIsocenter", "1"}
-	tagDict[Tag{0x0018, 0x9469}] = TagInfo{Tag{0x0018, 0x9469}, "FL", "TableHorizontalRotationAngle", "1"}
-	tagDict[Tag{0x0018, 0x9470}] = TagInfo{Tag{0x0018, 0x9470}, "FL", "TableHeadTiltAngle", "1"}
-	tagDict[Tag{0x0018, 0x9471}] = TagInfo{Tag{0x0018, 0x9471}, "FL", "TableCradleTiltAngle", "1"}
-	tagDict[Tag{0x0018, 0x9472}] = TagInfo{Tag{0x0018, 0x9472}, "SQ", "FrameDisplayShutterSequence", "1"}
-	tagDict[Tag{0x0018, 0x9473}] = TagInfo{Tag{0x0018, 0x9473}, "FL", "AcquiredImageAreaDoseProduct", "1"}
-	tagDict[Tag{0x0018, 0x9474}] = TagInfo{Tag{0x0018, 0x9474}, "CS", "CArmPositionerTabletopRelationship", "1"}
-	tagDict[Tag{0x0018, 0x9476}] = TagInfo{Tag{0x0018, 0x9476}, "SQ", "XRayGeometrySequence", "1"}
-	tagDict[Tag{0x0018, 0x9477}] = TagInfo{Tag{0x0018, 0x9477}, "SQ", "IrradiationEventIdentificationSequence", "1"}
-	tagDict[Tag{0x0018, 0x9504}] = TagInfo{Tag{0x0018, 0x9504}, "SQ", "XRay3DFrameTypeSequence", "1"}
-	tagDict[Tag{0x0018, 0x9506}] = TagInfo{Tag{0x0018, 0x9506}, "SQ", "ContributingSourcesSequence", "1"}
-	tagDict[Tag{0x0018, 0x9507}] = TagInfo{Tag{0x0018, 0x9507}, "SQ", "XRay3DAcquisitionSequence", "1"}
-	tagDict[Tag{0x0018, 0x9508}] = TagInfo{Tag{0x0018, 0x9508}, "FL", "PrimaryPositionerScanArc", "1"}
-	tagDict[Tag{0x0018, 0x9509}] = TagInfo{Tag{0x0018, 0x9509}, "FL", "SecondaryPositionerScanArc", "1"}
-	tagDict[Tag{0x0018, 0x9510}] = TagInfo{Tag{0x0018, 0x9510}, "FL", "PrimaryPositionerScanStartAngle", "1"}
-	tagDict[Tag{0x0018, 0x9511}] = TagInfo{Tag{0x0018, 0x9511}, "FL", "SecondaryPositionerScanStartAngle", "1"}
-	tagDict[Tag{0x0018, 0x9514}] = TagInfo{Tag{0x0018, 0x9514}, "FL", "PrimaryPositionerIncrement", "1"}
-	tagDict[Tag{0x0018, 0x9515}] = TagInfo{Tag{0x0018, 0x9515}, "FL", "SecondaryPositionerIncrement", "1"}
-	tagDict[Tag{0x0018, 0x9516}] = TagInfo{Tag{0x0018, 0x9516}, "DT", "StartAcquisitionDateTime", "1"}
-	tagDict[Tag{0x0018, 0x9517}] = TagInfo{Tag{0x0018, 0x9517}, "DT", "EndAcquisitionDateTime", "1"}
-	tagDict[Tag{0x0018, 0x9524}] = TagInfo{Tag{0x0018, 0x9524}, "LO", "ApplicationName", "1"}
-	tagDict[Tag{0x0018, 0x9525}] = TagInfo{Tag{0x0018, 0x9525}, "LO", "ApplicationVersion", "1"}
-	tagDict[Tag{0x0018, 0x9526}] = TagInfo{Tag{0x0018, 0x9526}, "LO", "ApplicationManufacturer", "1"}
-	tagDict[Tag{0x0018, 0x9527}] = TagInfo{Tag{0x0018, 0x9527}, "CS", "AlgorithmType", "1"}
-	tagDict[Tag{0x0018, 0x9528}] = TagInfo{Tag{0x0018, 0x9528}, "LO", "AlgorithmDescription", "1"}
-	tagDict[Tag{0x0018, 0x9530}] = TagInfo{Tag{0x0018, 0x9530}, "SQ", "XRay3DReconstructionSequence", "1"}
-	tagDict[Tag{0x0018, 0x9531}] = TagInfo{Tag{0x0018, 0x9531}, "LO", "ReconstructionDescription", "1"}
-	tagDict[Tag{0x0018, 0x9538}] = TagInfo{Tag{0x0018, 0x9538}, "SQ", "PerProjectionAcquisitionSequence", "1"}
-	tagDict[Tag{0x0018, 0x9601}] = TagInfo{Tag{0x0018, 0x9601}, "SQ", "DiffusionBMatrixSequence", "1"}
-	tagDict[Tag{0x0018, 0x9602}] = TagInfo{Tag{0x0018, 0x9602}, "FD", "DiffusionBValueXX", "1"}
-	tagDict[Tag{0x0018, 0x9603}] = TagInfo{Tag{0x0018, 0x9603}, "FD", "DiffusionBValueXY", "1"}
-	tagDict[Tag{0x0018, 0x9604}] = TagInfo{Tag{0x0018, 0x9604}, "FD", "DiffusionBValueXZ", "1"}
-	tagDict[Tag{0x0018, 0x9605}] = TagInfo{Tag{0x0018, 0x9605}, "FD", "DiffusionBValueYY", "1"}
-	tagDict[Tag{0x0018, 0x9606}] = TagInfo{Tag{0x0018, 0x9606}, "FD", "DiffusionBValueYZ", "1"}
-	tagDict[Tag{0x0018, 0x9607}] = TagInfo{Tag{0x0018, 0x9607}, "FD", "DiffusionBValueZZ", "1"}
-	tagDict[Tag{0x0018, 0x9701}] = TagInfo{Tag{0x0018, 0x9701}, "DT", "DecayCorrectionDateTime", "1"}
-	tagDict[Tag{0x0018, 0x9715}] = TagInfo{Tag{0x0018, 0x9715}, "FD", "StartDensityThreshold", "1"}
-	tagDict[Tag{0x0018, 0x9716}] = TagInfo{Tag{0x0018, 0x9716}, "FD", "StartRelativeDensityDifferenceThreshold", "1"}
-	tagDict[Tag{0x0018, 0x9717}] = TagInfo{Tag{0x0018, 0x9717}, "FD", "StartCardiacTriggerCountThreshold", "1"}
-	tagDict[Tag{0x0018, 0x9718}] = TagInfo{Tag{0x0018, 0x9718}, "FD", "StartRespiratoryTriggerCountThreshold", "1"}
-	tagDict[Tag{0x0018, 0x9719}] = TagInfo{Tag{0x0018, 0x9719}, "FD", "TerminationCountsThreshold", "1"}
-	tagDict[Tag{0x0018, 0x9720}] = TagInfo{Tag{0x0018, 0x9720}, "FD", "TerminationDensityThreshold", "1"}
-	tagDict[Tag{0x0018, 0x9721}] = TagInfo{Tag{0x0018, 0x9721}, "FD", "TerminationRelativeDensityThreshold", "1"}
-	tagDict[Tag{0x0018, 0x9722}] = TagInfo{Tag{0x0018, 0x9722}, "FD", "TerminationTimeThreshold", "1"}
-	tagDict[Tag{0x0018, 0x9723}] = TagInfo{Tag{0x0018, 0x9723}, "FD", "TerminationCardiacTriggerCountThreshold", "1"}
-	tagDict[Tag{0x0018, 0x9724}] = TagInfo{Tag{0x0018, 0x9724}, "FD", "TerminationRespiratoryTriggerCountThreshold", "1"}
-	tagDict[Tag{0x0018, 0x9725}] = TagInfo{Tag{0x0018, 0x9725}, "CS", "DetectorGeometry", "1"}
-	tagDict[Tag{0x0018, 0x9726}] = TagInfo{Tag{0x0018, 0x9726}, "FD", "TransverseDetectorSeparation", "1"}
-	tagDict[Tag{0x0018, 0x9727}] = TagInfo{Tag{0x0018, 0x9727}, "FD", "AxialDetectorDimension", "1"}
-	tagDict[Tag{0x0018, 0x9729}] = TagInfo{Tag{0x0018, 0x9729}, "US", "RadiopharmaceuticalAgentNumber", "1"}
-	tagDict[Tag{0x0018, 0x9732}] = TagInfo{Tag{0x0018, 0x9732}, "SQ", "PETFrameAcquisitionSequence", "1"}
-	tagDict[Tag{0x0018, 0x9733}] = TagInfo{Tag{0x0018, 0x9733}, "SQ", "PETDetectorMotionDetailsSequence", "1"}
-	tagDict[Tag{0x0018, 0x9734}] = TagInfo{Tag{0x0018, 0x9734}, "SQ", "PETTableDynamicsSequence", "1"}
-	tagDict[Tag{0x0018, 0x9735}] = TagInfo{Tag{0x0018, 0x9735}, "SQ", "PETPositionSequence", "1"}
-	tagDict[Tag{0x0018, 0x9736}] = TagInfo{Tag{0x0018, 0x9736}, "SQ", "PETFrameCorrectionFactorsSequence", "1"}
-	tagDict[Tag{0x0018, 0x9737}] = TagInfo{Tag{0x0018, 0x9737}, "SQ", "RadiopharmaceuticalUsageSequence", "1"}
-	tagDict[Tag{0x0018, 0x9738}] = TagInfo{Tag{0x0018, 0x9738}, "CS", "AttenuationCorrectionSource", "1"}
-	tagDict[Tag{0x0018, 0x9739}] = TagInfo{Tag{0x0018, 0x9739}, "US", "NumberOfIterations", "1"}
-	tagDict[Tag{0x0018, 0x9740}] = TagInfo{Tag{0x0018, 0x9740}, "US", "NumberOfSubsets", "1"}
-	tagDict[Tag{0x0018, 0x9749}] = TagInfo{Tag{0x0018, 0x9749}, "SQ", "PETReconstructionSequence", "1"}
-	tagDict[Tag{0x0018, 0x9751}] = TagInfo{Tag{0x0018, 0x9751}, "SQ", "PETFrameTypeSequence", "1"}
-	tagDict[Tag{0x0018, 0x9755}] = TagInfo{Tag{0x0018, 0x9755}, "CS", "TimeOfFlightInformationUsed", "1"}
-	tagDict[Tag{0x0018, 0x9756}] = TagInfo{Tag{0x0018, 0x9756}, "CS", "ReconstructionType", "1"}
-	tagDict[Tag{0x0018, 0x9758}] = TagInfo{Tag{0x0018, 0x9758}, "CS", "DecayCorrected", "1"}
-	tagDict[Tag{0x0018, 0x9759}] = TagInfo{Tag{0x0018, 0x9759}, "CS", "AttenuationCorrected", "1"}
-	tagDict[Tag{0x0018, 0x9760}] = TagInfo{Tag{0x0018, 0x9760}, "CS", "ScatterCorrected", "1"}
-	tagDict[Tag{0x0018, 0x9761}] = TagInfo{Tag{0x0018, 0x9761}, "CS", "DeadTimeCorrected", "1"}
-	tagDict[Tag{0x0018, 0x9762}] = TagInfo{Tag{0x0018, 0x9762}, "CS", "GantryMotionCorrected", "1"}
-	tagDict[Tag{0x0018, 0x9763}] = TagInfo{Tag{0x0018, 0x9763}, "CS", "PatientMotionCorrected", "1"}
-	tagDict[Tag{0x0018, 0x9764}] = TagInfo{Tag{0x0018, 0x9764}, "CS", "CountLossNormalizationCorrected", "1"}
-	tagDict[Tag{0x0018, 0x9765}] = TagInfo{Tag{0x0018, 0x9765}, "CS", "RandomsCorrected", "1"}
-	tagDict[Tag{0x0018, 0x9766}] = TagInfo{Tag{0x0018, 0x9766}, "CS", "NonUniformRadialSamplingCorrected", "1"}
-	tagDict[Tag{0x0018, 0x9767}] = TagInfo{Tag{0x0018, 0x9767}, "CS", "SensitivityCalibrated", "1"}
-	tagDict[Tag{0x0018, 0x9768}] = TagInfo{Tag{0x0018, 0x9768}, "CS", "DetectorNormalizationCorrection", "1"}
-	tagDict[Tag{0x0018, 0x9769}] = TagInfo{Tag{0x0018, 0x9769}, "CS", "IterativeReconstructionMethod", "1"}
-	tagDict[Tag{0x0018, 0x9770}] = TagInfo{Tag{0x0018, 0x9770}, "CS", "AttenuationCorrectionTemporalRelationship", "1"}
-	tagDict[Tag{0x0018, 0x9771}] = TagInfo{Tag{0x0018, 0x9771}, "SQ", "PatientPhysiologicalStateSequence", "1"}
-	tagDict[Tag{0x0018, 0x9772}] = TagInfo{Tag{0x0018, 0x9772}, "SQ", "PatientPhysiologicalStateCodeSequence", "1"}
-	tagDict[Tag{0x0018, 0x9801}] = TagInfo{Tag{0x0018, 0x9801}, "FD", "DepthsOfFocus", "1-n"}
-	tagDict[Tag{0x0018, 0x9803}] = TagInfo{Tag{0x0018, 0x9803}, "SQ", "ExcludedIntervalsSequence", "1"}
-	tagDict[Tag{0x0018, 0x9804}] = TagInfo{Tag{0x0018, 0x9804}, "DT", "ExclusionStartDatetime", "1"}
-	tagDict[Tag{0x0018, 0x9805}] = TagInfo{Tag{0x0018, 0x9805}, "FD", "ExclusionDuration", "1"}
-	tagDict[Tag{0x0018, 0x9806}] = TagInfo{Tag{0x0018, 0x9806}, "SQ", "USImageDescriptionSequence", "1"}
-	tagDict[Tag{0x0018, 0x9807}] = TagInfo{Tag{0x0018, 0x9807}, "SQ", "ImageDataTypeSequence", "1"}
-	tagDict[Tag{0x0018, 0x9808}] = TagInfo{Tag{0x0018, 0x9808}, "CS", "DataType", "1"}
-	tagDict[Tag{0x0018, 0x9809}] = TagInfo{Tag{0x0018, 0x9809}, "SQ", "TransducerScanPatternCodeSequence", "1"}
-	tagDict[Tag{0x0018, 0x980B}] = TagInfo{Tag{0x0018, 0x980B}, "CS", "AliasedDataType", "1"}
-	tagDict[Tag{0x0018, 0x980C}] = TagInfo{Tag{0x0018, 0x980C}, "CS", "PositionMeasuringDeviceUsed", "1"}
-	tagDict[Tag{0x0018, 0x980D}] = TagInfo{Tag{0x0018, 0x980D}, "SQ", "TransducerGeometryCodeSequence", "1"}
-	tagDict[Tag{0x0018, 0x980E}] = TagInfo{Tag{0x0018, 0x980E}, "SQ", "TransducerBeamSteeringCodeSequence", "1"}
-	tagDict[Tag{0x0018, 0x980F}] = TagInfo{Tag{0x0018, 0x980F}, "SQ", "TransducerApplicationCodeSequence", "1"}
-	tagDict[Tag{0x0018, 0xA001}] = TagInfo{Tag{0x0018, 0xA001}, "SQ", "ContributingEquipmentSequence", "1"}
-	tagDict[Tag{0x0018, 0xA002}] = TagInfo{Tag{0x0018, 0xA002}, "DT", "ContributionDateTime", "1"}
-	tagDict[Tag{0x0018, 0xA003}] = TagInfo{Tag{0x0018, 0xA003}, "ST", "ContributionDescription", "1"}
-	tagDict[Tag{0x0020, 0x000D}] = TagInfo{Tag{0x0020, 0x000D}, "UI", "StudyInstanceUID", "1"}
-	tagDict[Tag{0x0020, 0x000E}] = TagInfo{Tag{0x0020, 0x000E}, "UI", "SeriesInstanceUID", "1"}
-	tagDict[Tag{0x0020, 0x0010}] = TagInfo{Tag{0x0020, 0x0010}, "SH", "StudyID", "1"}
-	tagDict[Tag{0x0020, 0x0011}] = TagInfo{Tag{0x0020, 0x0011}, "IS", "SeriesNumber", "1"}
-	tagDict[Tag{0x0020, 0x0012}] = TagInfo{Tag{0x0020, 0x0012}, "IS", "AcquisitionNumber", "1"}
-	tagDict[Tag{0x0020, 0x0013}] = TagInfo{Tag{0x0020, 0x0013}, "IS", "InstanceNumber", "1"}
-	tagDict[Tag{0x0020, 0x0019}] = TagInfo{Tag{0x0020, 0x0019}, "IS", "ItemNumber", "1"}
-	tagDict[Tag{0x0020, 0x0020}] = TagInfo{Tag{0x0020, 0x0020}, "CS", "PatientOrientation", "2"}
-	tagDict[Tag{0x0020, 0x0032}] = TagInfo{Tag{0x0020, 0x0032}, "DS", "ImagePositionPatient", "3"}
-	tagDict[Tag{0x0020, 0x0037}] = TagInfo{Tag{0x0020, 0x0037}, "DS", "ImageOrientationPatient", "6"}
-	tagDict[Tag{0x0020, 0x0052}] = TagInfo{Tag{0x0020, 0x0052}, "UI", "FrameOfReferenceUID", "1"}
-	tagDict[Tag{0x0020, 0x0060}] = TagInfo{Tag{0x0020, 0x0060}, "CS", "Laterality", "1"}
-	tagDict[Tag{0x0020, 0x0062}] = TagInfo{Tag{0x0020, 0x0062}, "CS", "ImageLaterality", "1"}
-	tagDict[Tag{0x0020, 0x0100}] = TagInfo{Tag{0x0020, 0x0100}, "IS", "TemporalPositionIdentifier", "1"}
-	tagDict[Tag{0x0020, 0x0105}] = TagInfo{Tag{0x0020, 0x0105}, "IS", "NumberOfTemporalPositions", "1"}
-	tagDict[Tag{0x0020, 0x0110}] = TagInfo{Tag{0x0020, 0x0110}, "DS", "TemporalResolution", "1"}
-	tagDict[Tag{0x0020, 0x0200}] = TagInfo{Tag{0x0020, 0x0200}, "UI", "SynchronizationFrameOfReferenceUID", "1"}
-	tagDict[Tag{0x0020, 0x0242}] = TagInfo{Tag{0x0020, 0x0242}, "UI", "SOPInstanceUIDOfConcatenationSource", "1"}
-	tagDict[Tag{0x0020, 0x1002}] = TagInfo{Tag{0x0020, 0x1002}, "IS", "ImagesInAcquisition", "1"}
-	tagDict[Tag{0x0020, 0x1040}] = TagInfo{Tag{0x0020, 0x1040}, "LO", "PositionReferenceIndicator", "1"}
-	tagDict[Tag{0x0020, 0x1041}] = TagInfo{Tag{0x0020, 0x1041}, "DS", "SliceLocation", "1"}
-	tagDict[Tag{0x0020, 0x1200}] = TagInfo{Tag{0x0020, 0x1200}, "IS", "NumberOfPatientRelatedStudies", "1"}
-	tagDict[Tag{0x0020, 0x1202}] = TagInfo{Tag{0x0020, 0x1202}, "IS", "NumberOfPatientRelatedSeries", "1"}
-	tagDict[Tag{0x0020, 0x1204}] = TagInfo{Tag{0x0020, 0x1204}, "IS", "NumberOfPatientRelatedInstances", "1"}
-	tagDict[Tag{0x0020, 0x1206}] = TagInfo{Tag{0x0020, 0x1206}, "IS", "NumberOfStudyRelatedSeries", "1"}
-	tagDict[Tag{0x0020, 0x1208}] = TagInfo{Tag{0x0020, 0x1208}, "IS", "NumberOfStudyRelatedInstances", "1"}
-	tagDict[Tag{0x0020, 0x1209}] = TagInfo{Tag{0x0020, 0x1209}, "IS", "NumberOfSeriesRelatedInstances", "1"}
-	tagDict[Tag{0x0020, 0x4000}] = TagInfo{Tag{0x0020, 0x4000}, "LT", "ImageComments", "1"}
-	tagDict[Tag{0x0020, 0x9056}] = TagInfo{Tag{0x0020, 0x9056}, "SH", "StackID", "1"}
-	tagDict[Tag{0x0020, 0x9057}] = TagInfo{Tag{0x0020, 0x9057}, "UL", "InStackPositionNumber", "1"}
-	tagDict[Tag{0x0020, 0x9071}] = TagInfo{Tag{0x0020, 0x9071}, "SQ", "FrameAnatomySequence", "1"}
-	tagDict[Tag{0x0020, 0x9072}] = TagInfo{Tag{0x0020, 0x9072}, "CS", "FrameLaterality", "1"}
-	tagDict[Tag{0x0020, 0x9111}] = TagInfo{Tag{0x0020, 0x9111}, "SQ", "FrameContentSequence", "1"}
-	tagDict[Tag{0x0020, 0x9113}] = TagInfo{Tag{0x0020, 0x9113}, "SQ", "PlanePositionSequence", "1"}
-	tagDict[Tag{0x0020, 0x9116}] = TagInfo{Tag{0x0020, 0x9116}, "SQ", "PlaneOrientationSequence", "1"}
-	tagDict[Tag{0x0020, 0x9128}] = TagInfo{Tag{0x0020, 0x9128}, "UL", "TemporalPositionIndex", "1"}
-	tagDict[Tag{0x0020, 0x9153}] = TagInfo{Tag{0x0020, 0x9153}, "FD", "NominalCardiacTriggerDelayTime", "1"}
-	tagDict[Tag{0x0020, 0x9154}] = TagInfo{Tag{0x0020, 0x9154}, "FL", "NominalCardiacTriggerTimePriorToRPeak", "1"}
-	tagDict[Tag{0x0020, 0x9155}] = TagInfo{Tag{0x0020, 0x9155}, "FL", "ActualCardiacTriggerTimePriorToRPeak", "1"}
-	tagDict[Tag{0x0020, 0x9156}] = TagInfo{Tag{0x0020, 0x9156}, "US", "FrameAcquisitionNumber", "1"}
-	tagDict[Tag{0x0020, 0x9157}] = TagInfo{Tag{0x0020, 0x9157}, "UL", "DimensionIndexValues", "1-n"}
-	tagDict[Tag{0x0020, 0x9158}] = TagInfo{Tag{0x0020, 0x9158}, "LT", "FrameComments", "1"}
-	tagDict[Tag{0x0020, 0x9161}] = TagInfo{Tag{0x0020, 0x9161}, "UI", "ConcatenationUID", "1"}
-	tagDict[Tag{0x0020, 0x9162}] = TagInfo{Tag{0x0020, 0x9162}, "US", "InConcatenationNumber", "1"}
-	tagDict[Tag{0x0020, 0x9163}] = TagInfo{Tag{0x0020, 0x9163}, "US", "InConcatenationTotalNumber", "1"}
-	tagDict[Tag{0x0020, 0x9164}] = TagInfo{Tag{0x0020, 0x9164}, "UI", "DimensionOrganizationUID", "1"}
-	tagDict[Tag{0x0020, 0x9165}] = TagInfo{Tag{0x0020, 0x9165}, "AT", "DimensionIndexPointer", "1"}
-	tagDict[Tag{0x0020, 0x9167}] = TagInfo{Tag{0x0020, 0x9167}, "AT", "FunctionalGroupPointer", "1"}
-	tagDict[Tag{0x0020, 0x9213}] = TagInfo{Tag{0x0020, 0x9213}, "LO", "DimensionIndexPrivateCreator", "1"}
-	tagDict[Tag{0x0020, 0x9221}] = TagInfo{Tag{0x0020, 0x9221}, "SQ", "DimensionOrganizationSequence", "1"}
-	tagDict[Tag{0x0020, 0x9222}] = TagInfo{Tag{0x0020, 0x9222}, "SQ", "DimensionIndexSequence", "1"}
-	tagDict[Tag{0x0020, 0x9228}] = TagInfo{Tag{0x0020, 0x9228}, "UL", "ConcatenationFrameOffsetNumber", "1"}
-	tagDict[Tag{0x0020, 0x9238}] = TagInfo{Tag{0x0020, 0x9238}, "LO", "FunctionalGroupPrivateCreator", "1"}
-	tagDict[Tag{0x0020, 0x9241}] = TagInfo{Tag{0x0020, 0x9241}, "FL", "NominalPercentageOfCardiacPhase", "1"}
-	tagDict[Tag{0x0020, 0x9245}] = TagInfo{Tag{0x0020, 0x9245}, "FL", "NominalPercentageOfRespiratoryPhase", "1"}
-	tagDict[Tag{0x0020, 0x9246}] = TagInfo{Tag{0x0020, 0x9246}, "FL", "StartingRespiratoryAmplitude", "1"}
-	tagDict[Tag{0x0020, 0x9247}] = TagInfo{Tag{0x0020, 0x9247}, "CS", "StartingRespiratoryPhase", "1"}
-	tagDict[Tag{0x0020, 0x9248}] = TagInfo{Tag{0x0020, 0x9248}, "FL", "EndingRespiratoryAmplitude", "1"}
-	tagDict[Tag{0x0020, 0x9249}] = TagInfo{Tag{0x0020, 0x9249}, "CS", "EndingRespiratoryPhase", "1"}
-	tagDict[Tag{0x0020, 0x9250}] = TagInfo{Tag{0x0020, 0x9250}, "CS", "RespiratoryTriggerType", "1"}
-	tagDict[Tag{0x0020, 0x9251}] = TagInfo{Tag{0x0020, 0x9251}, "FD", "RRIntervalTimeNominal", "1"}
-	tagDict[Tag{0x0020, 0x9252}] = TagInfo{Tag{0x0020, 0x9252}, "FD", "ActualCardiacTriggerDelayTime", "1"}
-	tagDict[Tag{0x0020, 0x9253}] = TagInfo{Tag{0x0020, 0x9253}, "SQ", "RespiratorySynchronizationSequence", "1"}
-	tagDict[Tag{0x0020, 0x9254}] = TagInfo{Tag{0x0020, 0x9254}, "FD", "RespiratoryIntervalTime", "1"}
-	tagDict[Tag{0x0020, 0x9255}] = TagInfo{Tag{0x0020, 0x9255}, "FD", "NominalRespiratoryTriggerDelayTime", "1"}
-	tagDict[Tag{0x0020, 0x9256}] = TagInfo{Tag{0x0020, 0x9256}, "FD", "RespiratoryTriggerDelayThreshold", "1"}
-	tagDict[Tag{0x0020, 0x9257}] = TagInfo{Tag{0x0020, 0x9257}, "FD", "ActualRespiratoryTriggerDelayTime", "1"}
-	tagDict[Tag{0x0020, 0x9301}] = TagInfo{Tag{0x0020, 0x9301}, "FD", "ImagePositionVolume", "3"}
-	tagDict[Tag{0x0020, 0x9302}] = TagInfo{Tag{0x0020, 0x9302}, "FD", "ImageOrientationVolume", "6"}
-	tagDict[Tag{0x0020, 0x9307}] = TagInfo{Tag{0x0020, 0x9307}, "CS", "UltrasoundAcquisitionGeometry", "1"}
-	tagDict[Tag{0x0020, 0x9308}] = TagInfo{Tag{0x0020, 0x9308}, "FD", "ApexPosition", "3"}
-	tagDict[Tag{0x0020, 0x9309}] = TagInfo{Tag{0x0020, 0x9309}, "FD", "VolumeToTransducerMappingMatrix", "16"}
-	tagDict[Tag{0x0020, 0x930A}] = TagInfo{Tag{0x0020, 0x930A}, "FD", "VolumeToTableMappingMatrix", "16"}
-	tagDict[Tag{0x0020, 0x930C}] = TagInfo{Tag{0x0020, 0x930C}, "CS", "PatientFrameOfReferenceSource", "1"}
-	tagDict[Tag{0x0020, 0x930D}] = TagInfo{Tag{0x0020, 0x930D}, "FD", "TemporalPositionTimeOffset", "1"}
-	tagDict[Tag{0x0020, 0x930E}] = TagInfo{Tag{0x0020, 0x930E}, "SQ", "PlanePositionVolumeSequence", "1"}
-	tagDict[Tag{0x0020, 0x930F}] = TagInfo{Tag{0x0020, 0x930F}, "SQ", "PlaneOrientationVolumeSequence", "1"}
-	tagDict[Tag{0x0020, 0x9310}] = TagInfo{Tag{0x0020, 0x9310}, "SQ", "TemporalPositionSequence", "1"}
-	tagDict[Tag{0x0020, 0x9311}] = TagInfo{Tag{0x0020, 0x9311}, "CS", "DimensionOrganizationType", "1"}
-	tagDict[Tag{0x0020, 0x9312}] = TagInfo{Tag{0x0020, 0x9312}, "UI", "VolumeFrameOfReferenceUID", "1"}
-	tagDict[Tag{0x0020, 0x9313}] = TagInfo{Tag{0x0020, 0x9313}, "UI", "TableFrameOfReferenceUID", "1"}
-	tagDict[Tag{0x0020, 0x9421}] = TagInfo{Tag{0x0020, 0x9421}, "LO", "DimensionDescriptionLabel", "1"}
-	tagDict[Tag{0x0020, 0x9450}] = TagInfo{Tag{0x0020, 0x9450}, "SQ", "PatientOrientationInFrameSequence", "1"}
-	tagDict[Tag{0x0020, 0x9453}] = TagInfo{Tag{0x0020, 0x9453}, "LO", "FrameLabel", "1"}
-	tagDict[Tag{0x0020, 0x9518}] = TagInfo{Tag{0x0020, 0x9518}, "US", "AcquisitionIndex", "1-n"}
-	tagDict[Tag{0x0020, 0x9529}] = TagInfo{Tag{0x0020, 0x9529}, "SQ", "ContributingSOPInstancesReferenceSequence", "1"}
-	tagDict[Tag{0x0020, 0x9536}] = TagInfo{Tag{0x0020, 0x9536}, "US", "ReconstructionIndex", "1"}
-	tagDict[Tag{0x0022, 0x0001}] = TagInfo{Tag{0x0022, 0x0001}, "US", "LightPathFilterPassThroughWavelength", "1"}
-	tagDict[Tag{0x0022, 0x0002}] = TagInfo{Tag{0x0022, 0x0002}, "US", "LightPathFilterPassBand", "2"}
-	tagDict[Tag{0x0022, 0x0003}] = TagInfo{Tag{0x0022, 0x0003}, "US", "ImagePathFilterPassThroughWavelength", "1"}
-	tagDict[Tag{0x0022, 0x0004}] = TagInfo{Tag{0x0022, 0x0004}, "US", "ImagePathFilterPassBand", "2"}
-	tagDict[Tag{0x0022, 0x0005}] = TagInfo{Tag{0x0022, 0x0005}, "CS", "PatientEyeMovementCommanded", "1"}
-	tagDict[Tag{0x0022, 0x0006}] = TagInfo{Tag{0x0022, 0x0006}, "SQ", "PatientEyeMovementCommandCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x0007}] = TagInfo{Tag{0x0022, 0x0007}, "FL", "SphericalLensPower", "1"}
-	tagDict[Tag{0x0022, 0x0008}] = TagInfo{Tag{0x0022, 0x0008}, "FL", "CylinderLensPower", "1"}
-	tagDict[Tag{0x0022, 0x0009}] = TagInfo{Tag{0x0022, 0x0009}, "FL", "CylinderAxis", "1"}
-	tagDict[Tag{0x0022, 0x000A}] = TagInfo{Tag{0x0022, 0x000A}, "FL", "EmmetropicMagnification", "1"}
-	tagDict[Tag{0x0022, 0x000B}] = TagInfo{Tag{0x0022, 0x000B}, "FL", "IntraOcularPressure", "1"}
-	tagDict[Tag{0x0022, 0x000C}] = TagInfo{Tag{0x0022, 0x000C}, "FL", "HorizontalFieldOfView", "1"}
-	tagDict[Tag{0x0022, 0x000D}] = TagInfo{Tag{0x0022, 0x000D}, "CS", "PupilDilated", "1"}
-	tagDict[Tag{0x0022, 0x000E}] = TagInfo{Tag{0x0022, 0x000E}, "FL", "DegreeOfDilation", "1"}
-	tagDict[Tag{0x0022, 0x0010}] = TagInfo{Tag{0x0022, 0x0010}, "FL", "StereoBaselineAngle", "1"}
-	tagDict[Tag{0x0022, 0x0011}] = TagInfo{Tag{0x0022, 0x0011}, "FL", "StereoBaselineDisplacement", "1"}
-	tagDict[Tag{0x0022, 0x0012}] = TagInfo{Tag{0x0022, 0x0012}, "FL", "StereoHorizontalPixelOffset", "1"}
-	tagDict[Tag{0x0022, 0x0013}] = TagInfo{Tag{0x0022, 0x0013}, "FL", "StereoVerticalPixelOffset", "1"}
-	tagDict[Tag{0x0022, 0x0014}] = TagInfo{Tag{0x0022, 0x0014}, "FL", "StereoRotation", "1"}
-	tagDict[Tag{0x0022, 0x0015}] = TagInfo{Tag{0x0022, 0x0015}, "SQ", "AcquisitionDeviceTypeCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x0016}] = TagInfo{Tag{0x0022, 0x0016}, "SQ", "IlluminationTypeCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x0017}] = TagInfo{Tag{0x0022, 0x0017}, "SQ", "LightPathFilterTypeStackCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x0018}] = TagInfo{Tag{0x0022, 0x0018}, "SQ", "ImagePathFilterTypeStackCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x0019}] = TagInfo{Tag{0x0022, 0x0019}, "SQ", "LensesCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x001A}] = TagInfo{Tag{0x0022, 0x001A}, "SQ", "ChannelDescriptionCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x001B}] = TagInfo{Tag{0x0022, 0x001B}, "SQ", "RefractiveStateSequence", "1"}
-	tagDict[Tag{0x0022, 0x001C}] = TagInfo{Tag{0x0022, 0x001C}, "SQ", "MydriaticAgentCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x001D}] = TagInfo{Tag{0x0022, 0x001D}, "SQ", "RelativeImagePositionCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x001E}] = TagInfo{Tag{0x0022, 0x001E}, "FL", "CameraAngleOfView", "1"}
-	tagDict[Tag{0x0022, 0x0020}] = TagInfo{Tag{0x0022, 0x0020}, "SQ", "StereoPairsSequence", "1"}
-	tagDict[Tag{0x0022, 0x0021}] = TagInfo{Tag{0x0022, 0x0021}, "SQ", "LeftImageSequence", "1"}
-	tagDict[Tag{0x0022, 0x0022}] = TagInfo{Tag{0x0022, 0x0022}, "SQ", "RightImageSequence", "1"}
-	tagDict[Tag{0x0022, 0x0030}] = TagInfo{Tag{0x0022, 0x0030}, "FL", "AxialLengthOfTheEye", "1"}
-	tagDict[Tag{0x0022, 0x0031}] = TagInfo{Tag{0x0022, 0x0031}, "SQ", "OphthalmicFrameLocationSequence", "1"}
-	tagDict[Tag{0x0022, 0x0032}] = TagInfo{Tag{0x0022, 0x0032}, "FL", "ReferenceCoordinates", "2-2n"}
-	tagDict[Tag{0x0022, 0x0035}] = TagInfo{Tag{0x0022, 0x0035}, "FL", "DepthSpatialResolution", "1"}
-	tagDict[Tag{0x0022, 0x0036}] = TagInfo{Tag{0x0022, 0x0036}, "FL", "MaximumDepthDistortion", "1"}
-	tagDict[Tag{0x0022, 0x0037}] = TagInfo{Tag{0x0022, 0x0037}, "FL", "AlongScanSpatialResolution", "1"}
-	tagDict[Tag{0x0022, 0x0038}] = TagInfo{Tag{0x0022, 0x0038}, "FL", "MaximumAlongScanDistortion", "1"}
-	tagDict[Tag{0x0022, 0x0039}] = TagInfo{Tag{0x0022, 0x0039}, "CS", "OphthalmicImageOrientation", "1"}
-	tagDict[Tag{0x0022, 0x0041}] = TagInfo{Tag{0x0022, 0x0041}, "FL", "DepthOfTransverseImage", "1"}
-	tagDict[Tag{0x0022, 0x0042}] = TagInfo{Tag{0x0022, 0x0042}, "SQ", "MydriaticAgentConcentrationUnitsSequence", "1"}
-	tagDict[Tag{0x0022, 0x0048}] = TagInfo{Tag{0x0022, 0x0048}, "FL", "AcrossScanSpatialResolution", "1"}
-	tagDict[Tag{0x0022, 0x0049}] = TagInfo{Tag{0x0022, 0x0049}, "FL", "MaximumAcrossScanDistortion", "1"}
-	tagDict[Tag{0x0022, 0x004E}] = TagInfo{Tag{0x0022, 0x004E}, "DS", "MydriaticAgentConcentration", "1"}
-	tagDict[Tag{0x0022, 0x0055}] = TagInfo{Tag{0x0022, 0x0055}, "FL", "IlluminationWaveLength", "1"}
-	tagDict[Tag{0x0022, 0x0056}] = TagInfo{Tag{0x0022, 0x0056}, "FL", "IlluminationPower", "1"}
-	tagDict[Tag{0x0022, 0x0057}] = TagInfo{Tag{0x0022, 0x0057}, "FL", "IlluminationBandwidth", "1"}
-	tagDict[Tag{0x0022, 0x0058}] = TagInfo{Tag{0x0022, 0x0058}, "SQ", "MydriaticAgentSequence", "1"}
-	tagDict[Tag{0x0022, 0x1007}] = TagInfo{Tag{0x0022, 0x1007}, "SQ", "OphthalmicAxialMeasurementsRightEyeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1008}] = TagInfo{Tag{0x0022, 0x1008}, "SQ", "OphthalmicAxialMeasurementsLeftEyeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1009}] = TagInfo{Tag{0x0022, 0x1009}, "CS", "OphthalmicAxialMeasurementsDeviceType", "1"}
-	tagDict[Tag{0x0022, 0x1010}] = TagInfo{Tag{0x0022, 0x1010}, "CS", "OphthalmicAxialLengthMeasurementsType", "1"}
-	tagDict[Tag{0x0022, 0x1012}] = TagInfo{Tag{0x0022, 0x1012}, "SQ", "OphthalmicAxialLengthSequence", "1"}
-	tagDict[Tag{0x0022, 0x1019}] = TagInfo{Tag{0x0022, 0x1019}, "FL", "OphthalmicAxialLength", "1"}
-	tagDict[Tag{0x0022, 0x1024}] = TagInfo{Tag{0x0022, 0x1024}, "SQ", "LensStatusCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1025}] = TagInfo{Tag{0x0022, 0x1025}, "SQ", "VitreousStatusCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1028}] = TagInfo{Tag{0x0022, 0x1028}, "SQ", "IOLFormulaCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1029}] = TagInfo{Tag{0x0022, 0x1029}, "LO", "IOLFormulaDetail", "1"}
-	tagDict[Tag{0x0022, 0x1033}] = TagInfo{Tag{0x0022, 0x1033}, "FL", "KeratometerIndex", "1"}
-	tagDict[Tag{0x0022, 0x1035}] = TagInfo{Tag{0x0022, 0x1035}, "SQ", "SourceOfOphthalmicAxialLengthCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1037}] = TagInfo{Tag{0x0022, 0x1037}, "FL", "TargetRefraction", "1"}
-	tagDict[Tag{0x0022, 0x1039}] = TagInfo{Tag{0x0022, 0x1039}, "CS", "RefractiveProcedureOccurred", "1"}
-	tagDict[Tag{0x0022, 0x1040}] = TagInfo{Tag{0x0022, 0x1040}, "SQ", "RefractiveSurgeryTypeCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1044}] = TagInfo{Tag{0x0022, 0x1044}, "SQ", "OphthalmicUltrasoundMethodCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1050}] = TagInfo{Tag{0x0022, 0x1050}, "SQ", "OphthalmicAxialLengthMeasurementsSequence", "1"}
-	tagDict[Tag{0x0022, 0x1053}] = TagInfo{Tag{0x0022, 0x1053}, "FL", "IOLPower", "1"}
-	tagDict[Tag{0x0022, 0x1054}] = TagInfo{Tag{0x0022, 0x1054}, "FL", "PredictedRefractiveError", "1"}
-	tagDict[Tag{0x0022, 0x1059}] = TagInfo{Tag{0x0022, 0x1059}, "FL", "OphthalmicAxialLengthVelocity", "1"}
-	tagDict[Tag{0x0022, 0x1065}] = TagInfo{Tag{0x0022, 0x1065}, "LO", "LensStatusDescription", "1"}
-	tagDict[Tag{0x0022, 0x1066}] = TagInfo{Tag{0x0022, 0x1066}, "LO", "VitreousStatusDescription", "1"}
-	tagDict[Tag{0x0022, 0x1090}] = TagInfo{Tag{0x0022, 0x1090}, "SQ", "IOLPowerSequence", "1"}
-	tagDict[Tag{0x0022, 0x1092}] = TagInfo{Tag{0x0022, 0x1092}, "SQ", "LensConstantSequence", "1"}
-	tagDict[Tag{0x0022, 0x1093}] = TagInfo{Tag{0x0022, 0x1093}, "LO", "IOLManufacturer", "1"}
-	tagDict[Tag{0x0022, 0x1094}] = TagInfo{Tag{0x0022, 0x1094}, "LO", "LensConstantDescription", "1"}
-	tagDict[Tag{0x0022, 0x1095}] = TagInfo{Tag{0x0022, 0x1095}, "LO", "ImplantName", "1"}
-	tagDict[Tag{0x0022, 0x1096}] = TagInfo{Tag{0x0022, 0x1096}, "SQ", "KeratometryMeasurementTypeCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1097}] = TagInfo{Tag{0x0022, 0x1097}, "LO", "ImplantPartNumber", "1"}
-	tagDict[Tag{0x0022, 0x1100}] = TagInfo{Tag{0x0022, 0x1100}, "SQ", "ReferencedOphthalmicAxialMeasurementsSequence", "1"}
-	tagDict[Tag{0x0022, 0x1101}] = TagInfo{Tag{0x0022, 0x1101}, "SQ", "OphthalmicAxialLengthMeasurementsSegmentNameCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1103}] = TagInfo{Tag{0x0022, 0x1103}, "SQ", "RefractiveErrorBeforeRefractiveSurgeryCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1121}] = TagInfo{Tag{0x0022, 0x1121}, "FL", "IOLPowerForExactEmmetropia", "1"}
-	tagDict[Tag{0x0022, 0x1122}] = TagInfo{Tag{0x0022, 0x1122}, "FL", "IOLPowerForExactTargetRefraction", "1"}
-	tagDict[Tag{0x0022, 0x1125}] = TagInfo{Tag{0x0022, 0x1125}, "SQ", "AnteriorChamberDepthDefinitionCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1127}] = TagInfo{Tag{0x0022, 0x1127}, "SQ", "LensThicknessSequence", "1"}
-	tagDict[Tag{0x0022, 0x1128}] = TagInfo{Tag{0x0022, 0x1128}, "SQ", "AnteriorChamberDepthSequence", "1"}
-	tagDict[Tag{0x0022, 0x1130}] = TagInfo{Tag{0x0022, 0x1130}, "FL", "LensThickness", "1"}
-	tagDict[Tag{0x0022, 0x1131}] = TagInfo{Tag{0x0022, 0x1131}, "FL", "AnteriorChamberDepth", "1"}
-	tagDict[Tag{0x0022, 0x1132}] = TagInfo{Tag{0x0022, 0x1132}, "SQ", "SourceOfLensThicknessDataCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1133}] = TagInfo{Tag{0x0022, 0x1133}, "SQ", "SourceOfAnteriorChamberDepthDataCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1134}] = TagInfo{Tag{0x0022, 0x1134}, "SQ", "SourceOfRefractiveMeasurementsSequence", "1"}
-	tagDict[Tag{0x0022, 0x1135}] = TagInfo{Tag{0x0022, 0x1135}, "SQ", "SourceOfRefractiveMeasurementsCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1140}] = TagInfo{Tag{0x0022, 0x1140}, "CS", "OphthalmicAxialLengthMeasurementModified", "1"}
-	tagDict[Tag{0x0022, 0x1150}] = TagInfo{Tag{0x0022, 0x1150}, "SQ", "OphthalmicAxialLengthDataSourceCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1153}] = TagInfo{Tag{0x0022, 0x1153}, "SQ", "OphthalmicAxialLengthAcquisitionMethodCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1155}] = TagInfo{Tag{0x0022, 0x1155}, "FL", "SignalToNoiseRatio", "1"}
-	tagDict[Tag{0x0022, 0x1159}] = TagInfo{Tag{0x0022, 0x1159}, "LO", "OphthalmicAxialLengthDataSourceDescription", "1"}
-	tagDict[Tag{0x0022, 0x1210}] = TagInfo{Tag{0x0022, 0x1210}, "SQ", "OphthalmicAxialLengthMeasurementsTotalLengthSequence", "1"}
-	tagDict[Tag{0x0022, 0x1211}] = TagInfo{Tag{0x0022, 0x1211}, "SQ", "OphthalmicAxialLengthMeasurementsSegmentalLengthSequence", "1"}
-	tagDict[Tag{0x0022, 0x1212}] = TagInfo{Tag{0x0022, 0x1212}, "SQ", "OphthalmicAxialLengthMeasurementsLengthSummationSequence", "1"}
-	tagDict[Tag{0x0022, 0x1220}] = TagInfo{Tag{0x0022, 0x1220}, "SQ", "UltrasoundOphthalmicAxialLengthMeasurementsSequence", "1"}
-	tagDict[Tag{0x0022, 0x1225}] = TagInfo{Tag{0x0022, 0x1225}, "SQ", "OpticalOphthalmicAxialLengthMeasurementsSequence", "1"}
-	tagDict[Tag{0x0022, 0x1230}] = TagInfo{Tag{0x0022, 0x1230}, "SQ", "UltrasoundSelectedOphthalmicAxialLengthSequence", "1"}
-	tagDict[Tag{0x0022, 0x1250}] = TagInfo{Tag{0x0022, 0x1250}, "SQ", "OphthalmicAxialLengthSelectionMethodCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1255}] = TagInfo{Tag{0x0022, 0x1255}, "SQ", "OpticalSelectedOphthalmicAxialLengthSequence", "1"}
-	tagDict[Tag{0x0022, 0x1257}] = TagInfo{Tag{0x0022, 0x1257}, "SQ", "SelectedSegmentalOphthalmicAxialLengthSequence", "1"}
-	tagDict[Tag{0x0022, 0x1260}] = TagInfo{Tag{0x0022, 0x1260}, "SQ", "SelectedTotalOphthalmicAxialLengthSequence", "1"}
-	tagDict[Tag{0x0022, 0x1262}] = TagInfo{Tag{0x0022, 0x1262}, "SQ", "OphthalmicAxialLengthQualityMetricSequence", "1"}
-	tagDict[Tag{0x0022, 0x1265}] = TagInfo{Tag{0x0022, 0x1265}, "SQ", "OphthalmicAxialLengthQualityMetricTypeCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1273}] = TagInfo{Tag{0x0022, 0x1273}, "LO", "OphthalmicAxialLengthQualityMetricTypeDescription", "1"}
-	tagDict[Tag{0x0022, 0x1300}] = TagInfo{Tag{0x0022, 0x1300}, "SQ", "IntraocularLensCalculationsRightEyeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1310}] = TagInfo{Tag{0x0022, 0x1310}, "SQ", "IntraocularLensCalculationsLeftEyeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1330}] = TagInfo{Tag{0x0022, 0x1330}, "SQ", "ReferencedOphthalmicAxialLengthMeasurementQCImageSequence", "1"}
-	tagDict[Tag{0x0024, 0x0010}] = TagInfo{Tag{0x0024, 0x0010}, "FL", "VisualFieldHorizontalExtent", "1"}
-	tagDict[Tag{0x0024, 0x0011}] = TagInfo{Tag{0x0024, 0x0011}, "FL", "VisualFieldVerticalExtent", "1"}
-	tagDict[Tag{0x0024, 0x0012}] = TagInfo{Tag{0x0024, 0x0012}, "CS", "VisualFieldShape", "1"}
-	tagDict[Tag{0x0024, 0x0016}] = TagInfo{Tag{0x0024, 0x0016}, "SQ", "ScreeningTestModeCodeSequence", "1"}
-	tagDict[Tag{0x0024, 0x0018}] = TagInfo{Tag{0x0024, 0x0018}, "FL", "MaximumStimulusLuminance", "1"}
-	tagDict[Tag{0x0024, 0x0020}] = TagInfo{Tag{0x0024, 0x0020}, "FL", "BackgroundLuminance", "1"}
-	tagDict[Tag{0x0024, 0x0021}] = TagInfo{Tag{0x0024, 0x0021}, "SQ", "StimulusColorCodeSequence", "1"}
-	tagDict[Tag{0x0024, 0x0024}] = TagInfo{Tag{0x0024, 0x0024}, "SQ", "BackgroundIlluminationColorCodeSequence", "1"}
-	tagDict[Tag{0x0024, 0x0025}] = TagInfo{Tag{0x0024, 0x0025}, "FL", "StimulusArea", "1"}
-	tagDict[Tag{0x0024, 0x0028}] = TagInfo{Tag{0x0024, 0x0028}, "FL", "StimulusPresentationTime", "1"}
-	tagDict[Tag{0x0024, 0x0032}] = TagInfo{Tag{0x0024, 0x0032}, "SQ", "FixationSequence", "1"}
-	tagDict[Tag{0x0024, 0x0033}] = TagInfo{Tag{0x0024, 0x0033}, "SQ", "FixationMonitoringCodeSequence", "1"}
-	tagDict[Tag{0x0024, 0x0034}] = TagInfo{Tag{0x0024, 0x0034}, "SQ", "VisualFieldCatchTrialSequence", "1"}
-	tagDict[Tag{0x0024, 0x0035}] = TagInfo{Tag{0x0024, 0x0035}, "US", "FixationCheckedQuantity", "1"}
-	tagDict[Tag{0x0024, 0x0036}] = TagInfo{Tag{0x0024, 0x0036}, "US", "PatientNotProperlyFixatedQuantity", "1"}
-	tagDict[Tag{0x0024, 0x0037}] = TagInfo{Tag{0x0024, 0x0037}, "CS", "PresentedVisualStimuliDataFlag", "1"}
-	tagDict[Tag{0x0024, 0x0038}] = TagInfo{Tag{0x0024, 0x0038}, "US", "NumberOfVisualStimuli", "1"}
-	tagDict[Tag{0x0024, 0x0039}] = TagInfo{Tag{0x0024, 0x0039}, "CS", "ExcessiveFixationLossesDataFlag", "1"}
-	tagDict[Tag{0x0024, 0x0040}] = TagInfo{Tag{0x0024, 0x0040}, "CS", "ExcessiveFixationLosses", "1"}
-	tagDict[Tag{0x0024, 0x0042}] = TagInfo{Tag{0x0024, 0x0042}, "US", "StimuliRetestingQuantity", "1"}
-	tagDict[Tag{0x0024, 0x0044}] = TagInfo{Tag{0x0024, 0x0044}, "LT", "CommentsOnPatientPerformanceOfVisualField", "1"}
-	tagDict[Tag{0x0024, 0x0045}] = TagInfo{Tag{0x0024, 0x0045}, "CS", "FalseNegativesEstimateFlag", "1"}
-	tagDict[Tag{0x0024, 0x0046}] = TagInfo{Tag{0x0024, 0x0046}, "FL", "FalseNegativesEstimate", "1"}
-	tagDict[Tag{0x0024, 0x0048}] = TagInfo{Tag{0x0024, 0x0048}, "US", "NegativeCatchTrialsQuantity", "1"}
-	tagDict[Tag{0x0024, 0x0050}] = TagInfo{Tag{0x0024, 0x0050}, "US", "FalseNegativesQuantity", "1"}
-	tagDict[Tag{0x0024, 0x0051}] = TagInfo{Tag{0x0024, 0x0051}, "CS", "ExcessiveFalseNegativesDataFlag", "1"}
-	tagDict[Tag{0x0024, 0x0052}] = TagInfo{Tag{0x0024, 0x0052}, "CS", "ExcessiveFalseNegatives", "1"}
-	tagDict[Tag{0x0024, 0x0053}] = TagInfo{Tag{0x0024, 0x0053}, "CS", "FalsePositivesEstimateFlag", "1"}
-	tagDict[Tag{0x0024, 0x0054}] = TagInfo{Tag{0x0024, 0x0054}, "FL", "FalsePositivesEstimate", "1"}
-	tagDict[Tag{0x0024, 0x0055}] = TagInfo{Tag{0x0024, 0x0055}, "CS", "CatchTrialsDataFlag", "1"}
-	tagDict[Tag{0x0024, 0x0056}] = TagInfo{Tag{0x0024, 0x0056}, "US", "PositiveCatchTrialsQuantity", "1"}
-	tagDict[Tag{0x0024, 0x0057}] = TagInfo{Tag{0x0024, 0x0057}, "CS", "TestPointNormalsDataFlag", "1"}
-	tagDict[Tag{0x0024, 0x0058}] = TagInfo{Tag{0x0024, 0x0058}, "SQ", "TestPointNormalsSequence", "1"}
-	tagDict[Tag{0x0024, 0x0059}] = TagInfo{Tag{0x0024, 0x0059}, "CS", "GlobalDeviationProbabilityNormalsFlag", "1"}
-	tagDict[Tag{0x0024, 0x0060}] = TagInfo{Tag{0x0024, 0x0060}, "US", "FalsePositivesQuantity", "1"}
-	tagDict[Tag{0x0024, 0x0061}] = TagInfo{Tag{0x0024, 0x0061}, "CS", "ExcessiveFalsePositivesDataFlag", "1"}
-	tagDict[Tag{0x0024, 0x0062}] = TagInfo{Tag{0x0024, 0x0062}, "CS", "ExcessiveFalsePositives", "1"}
-	tagDict[Tag{0x0024, 0x0063}] = TagInfo{Tag{0x0024, 0x0063}, "CS", "VisualFieldTestNormalsFlag", "1"}
-	tagDict[Tag{0x0024, 0x0064}] = TagInfo{Tag{0x0024, 0x0064}, "SQ", "ResultsNormalsSequence", "1"}
-	tagDict[Tag{0x0024, 0x0065}] = TagInfo{Tag{0x0024, 0x0065}, "SQ", "AgeCorrectedSensitivityDeviationAlgorithmSequence", "1"}
-	tagDict[Tag{0x0024, 0x0066}] = TagInfo{Tag{0x0024, 0x0066}, "FL", "GlobalDeviationFromNormal", "1"}
-	tagDict[Tag{0x0024, 0x0067}] = TagInfo{Tag{0x0024, 0x0067}, "SQ", "GeneralizedDefectSensitivityDeviationAlgorithmSequence", "1"}
-	tagDict[Tag{0x0024, 0x0068}] = TagInfo{Tag{0x0024, 0x0068}, "FL", "LocalizedDeviationfromNormal", "1"}
-	tagDict[Tag{0x0024, 0x0069}] = TagInfo{Tag{0x0024, 0x0069}, "LO", "PatientReliabilityIndicator", "1"}
-	tagDict[Tag{0x0024, 0x0070}] = TagInfo{Tag{0x0024, 0x0070}, "FL", "VisualFieldMeanSensitivity", "1"}
-	tagDict[Tag{0x0024, 0x0071}] = TagInfo{Tag{0x0024, 0x0071}, "FL", "GlobalDeviationProbability", "1"}
-	tagDict[Tag{0x0024, 0x0072}] = TagInfo{Tag{0x0024, 0x0072}, "CS", "LocalDeviationProbabilityNormalsFlag", "1"}
-	tagDict[Tag{0x0024, 0x0073}] = TagInfo{Tag{0x0024, 0x0073}, "FL", "LocalizedDeviationProbability", "1"}
-	tagDict[Tag{0x0024, 0x0074}] = TagInfo{Tag{0x0024, 0x0074}, "CS", "ShortTermFluctuationCalculated", "1"}
-	tagDict[Tag{0x0024, 0x0075}] = TagInfo{Tag{0x0024, 0x0075}, "FL", "ShortTermFluctuation", "1"}
-	tagDict[Tag{0x0024, 0x0076}] = TagInfo{Tag{0x0024, 0x0076}, "CS", "ShortTermFluctuationProbabilityCalculated", "1"}
-	tagDict[Tag{0x0024, 0x0077}] = TagInfo{Tag{0x0024, 0x0077}, "FL", "ShortTermFluctuationProbability", "1"}
-	tagDict[Tag{0x0024, 0x0078}] = TagInfo{Tag{0x0024, 0x0078}, "CS", "CorrectedLocalizedDeviationFromNormalCalculated", "1"}
-	tagDict[Tag{0x0024, 0x0079}] = TagInfo{Tag{0x0024, 0x0079}, "FL", "CorrectedLocalizedDeviationFromNormal", "1"}
-	tagDict[Tag{0x0024, 0x0080}] = TagInfo{Tag{0x0024, 0x0080}, "CS", "CorrectedLocalizedDeviationFromNormalProbabilityCalculated", "1"}
-	tagDict[Tag{0x0024, 0x0081}] = TagInfo{Tag{0x0024, 0x0081}, "FL", "CorrectedLocalizedDeviationFromNormalProbability", "1"}
-	tagDict[Tag{0x0024, 0x0083}] = TagInfo{Tag{0x0024, 0x0083}, "SQ", "GlobalDeviationProbabilitySequence", "1"}
-	tagDict[Tag{0x0024, 0x0085}] = TagInfo{Tag{0x0024, 0x0085}, "SQ", "LocalizedDeviationProbabilitySequence", "1"}
-	tagDict[Tag{0x0024, 0x0086}] = TagInfo{Tag{0x0024, 0x0086}, "CS", "FovealSensitivityMeasured", "1"}
-	tagDict[Tag{0x0024, 0x0087}] = TagInfo{Tag{0x0024, 0x0087}, "FL", "FovealSensitivity", "1"}
-	tagDict[Tag{0x0024, 0x0088}] = TagInfo{Tag{0x0024, 0x0088}, "FL", "VisualFieldTestDuration", "1"}
-	tagDict[Tag{0x0024, 0x0089}] = TagInfo{Tag{0x0024, 0x0089}, "SQ", "VisualFieldTestPointSequence", "1"}
-	tagDict[Tag{0x0024, 0x0090}] = TagInfo{Tag{0x0024, 0x0090}, "FL", "VisualFieldTestPointXCoordinate", "1"}
-	tagDict[Tag{0x0024, 0x0091}] = TagInfo{Tag{0x0024, 0x0091}, "FL", "VisualFieldTestPointYCoordinate", "1"}
-	tagDict[Tag{0x0024, 0x0092}] = TagInfo{Tag{0x0024, 0x0092}, "FL", "AgeCorrectedSensitivityDeviationValue", "1"}
-	tagDict[Tag{0x0024, 0x0093}] = TagInfo{Tag{0x0024, 0x0093}, "CS", "StimulusResults", "1"}
-	tagDict[Tag{0x0024, 0x0094}] = TagInfo{Tag{0x0024, 0x0094}, "FL", "SensitivityValue", "1"}
-	tagDict[Tag{0x0024, 0x0095}] = TagInfo{Tag{0x0024, 0x0095}, "CS", "RetestStimulusSeen", "1"}
-	tagDict[Tag{0x0024, 0x0096}] = TagInfo{Tag{0x0024, 0x0096}, "FL", "RetestSensitivityValue", "1"}
-	tagDict[Tag{0x0024, 0x0097}] = TagInfo{Tag{0x0024, 0x0097}, "SQ", "VisualFieldTestPointNormalsSequence", "1"}
-	tagDict[Tag{0x0024, 0x0098}] = TagInfo{Tag{0x0024, 0x0098}, "FL", "QuantifiedDefect", "1"}
-	tagDict[Tag{0x0024, 0x0100}] = TagInfo{Tag{0x0024, 0x0100}, "FL", "AgeCorrectedSensitivityDeviationProbabilityValue", "1"}
-	tagDict[Tag{0x0024, 0x0102}] = TagInfo{Tag{0x0024, 0x0102}, "CS", "GeneralizedDefectCorrectedSensitivityDeviationFlag", "1"}
-	tagDict[Tag{0x0024, 0x0103}] = TagInfo{Tag{0x0024, 0x0103}, "FL", "GeneralizedDefectCorrectedSensitivityDeviationValue", "1"}
-	tagDict[Tag{0x0024, 0x0104}] = TagInfo{Tag{0x0024, 0x0104}, "FL", "GeneralizedDefectCorrectedSensitivityDeviationProbabilityValue", "1"}
-	tagDict[Tag{0x0024, 0x0105}] = TagInfo{Tag{0x0024, 0x0105}, "FL", "MinimumSensitivityValue", "1"}
-	tagDict[Tag{0x0024, 0x0106}] = TagInfo{Tag{0x0024, 0x0106}, "CS", "BlindSpotLocalized", "1"}
-	tagDict[Tag{0x0024, 0x0107}] = TagInfo{Tag{0x0024, 0x0107}, "FL", "BlindSpotXCoordinate", "1"}
-	tagDict[Tag{0x0024, 0x0108}] = TagInfo{Tag{0x0024, 0x0108}, "FL", "BlindSpotYCoordinate", "1"}
-	tagDict[Tag{0x0024, 0x0110}] = TagInfo{Tag{0x0024, 0x0110}, "SQ", "VisualAcuityMeasurementSequence", "1"}
-	tagDict[Tag{0x0024, 0x0112}] = TagInfo{Tag{0x0024, 0x0112}, "SQ", "RefractiveParametersUsedOnPatientSequence", "1"}
-	tagDict[Tag{0x0024, 0x0113}] = TagInfo{Tag{0x0024, 0x0113}, "CS", "MeasurementLaterality", "1"}
-	tagDict[Tag{0x0024, 0x0114}] = TagInfo{Tag{0x0024, 0x0114}, "SQ", "OphthalmicPatientClinicalInformationLeftEyeSequence", "1"}
-	tagDict[Tag{0x0024, 0x0115}] = TagInfo{Tag{0x0024, 0x0115}, "SQ", "OphthalmicPatientClinicalInformationRightEyeSequence", "1"}
-	tagDict[Tag{0x0024, 0x0117}] = TagInfo{Tag{0x0024, 0x0117}, "CS", "FovealPointNormativeDataFlag", "1"}
-	tagDict[Tag{0x0024, 0x0118}] = TagInfo{Tag{0x0024, 0x0118}, "FL", "FovealPointProbabilityValue", "1"}
-	tagDict[Tag{0x0024, 0x0120}] = TagInfo{Tag{0x0024, 0x0120}, "CS", "ScreeningBaselineMeasured", "1"}
-	tagDict[Tag{0x0024, 0x0122}] = TagInfo{Tag{0x0024, 0x0122}, "SQ", "ScreeningBaselineMeasuredSequence", "1"}
-	tagDict[Tag{0x0024, 0x0124}] = TagInfo{Tag{0x0024, 0x0124}, "CS", "ScreeningBaselineType", "1"}
-	tagDict[Tag{0x0024, 0x0126}] = TagInfo{Tag{0x0024, 0x0126}, "FL", "ScreeningBaselineValue", "1"}
-	tagDict[Tag{0x0024, 0x0202}] = TagInfo{Tag{0x0024, 0x0202}, "LO", "AlgorithmSource", "1"}
-	tagDict[Tag{0x0024, 0x0306}] = TagInfo{Tag{0x0024, 0x0306}, "LO", "DataSetName", "1"}
-	tagDict[Tag{0x0024, 0x0307}] = TagInfo{Tag{0x0024, 0x0307}, "LO", "DataSetVersion", "1"}
-	tagDict[Tag{0x0024, 0x0308}] = TagInfo{Tag{0x0024, 0x0308}, "LO", "DataSetSource", "1"}
-	tagDict[Tag{0x0024, 0x0309}] = TagInfo{Tag{0x0024, 0x0309}, "LO", "DataSetDescription", "1"}
-	tagDict[Tag{0x0024, 0x0317}] = TagInfo{Tag{0x0024, 0x0317}, "SQ", "VisualFieldTestReliabilityGlobalIndexSequence", "1"}
-	tagDict[Tag{0x0024, 0x0320}] = TagInfo{Tag{0x0024, 0x0320}, "SQ", "VisualFieldGlobalResultsIndexSequence", "1"}
-	tagDict[Tag{0x0024, 0x0325}] = TagInfo{Tag{0x0024, 0x0325}, "SQ", "DataObservationSequence", "1"}
-	tagDict[Tag{0x0024, 0x0338}] = TagInfo{Tag{0x0024, 0x0338}, "CS", "IndexNormalsFlag", "1"}
-	tagDict[Tag{0x0024, 0x0341}] = TagInfo{Tag{0x0024, 0x0341}, "FL", "IndexProbability", "1"}
-	tagDict[Tag{0x0024, 0x0344}] = TagInfo{Tag{0x0024, 0x0344}, "SQ", "IndexProbabilitySequence", "1"}
-	tagDict[Tag{0x0028, 0x0002}] = TagInfo{Tag{0x0028, 0x0002}, "US", "SamplesPerPixel", "1"}
-	tagDict[Tag{0x0028, 0x0003}] = TagInfo{Tag{0x0028, 0x0003}, "US", "SamplesPerPixelUsed", "1"}
-	tagDict[Tag{0x0028, 0x0004}] = TagInfo{Tag{0x0028, 0x0004}, "CS", "PhotometricInterpretation", "1"}
-	tagDict[Tag{0x0028, 0x0006}] = TagInfo{Tag{0x0028, 0x0006}, "US", "PlanarConfiguration", "1"}
-	tagDict[Tag{0x0028, 0x0008}] = TagInfo{Tag{0x0028, 0x0008}, "IS", "NumberOfFrames", "1"}
-	tagDict[Tag{0x0028, 0x0009}] = TagInfo{Tag{0x0028, 0x0009}, "AT", "FrameIncrementPointer", "1-n"}
-	tagDict[Tag{0x0028, 0x000A}] = TagInfo{Tag{0x0028, 0x000A}, "AT", "FrameDimensionPointer", "1-n"}
-	tagDict[Tag{0x0028, 0x0010}] = TagInfo{Tag{0x0028, 0x0010}, "US", "Rows", "1"}
-	tagDict[Tag{0x0028, 0x0011}] = TagInfo{Tag{0x0028, 0x0011}, "US", "Columns", "1"}
-	tagDict[Tag{0x0028, 0x0014}] = TagInfo{Tag{0x0028, 0x0014}, "US", "UltrasoundColorDataPresent", "1"}
-	tagDict[Tag{0x0028, 0x0030}] = TagInfo{Tag{0x0028, 0x0030}, "DS", "PixelSpacing", "2"}
-	tagDict[Tag{0x0028, 0x0031}] = TagInfo{Tag{0x0028, 0x0031}, "DS", "ZoomFactor", "2"}
-	tagDict[Tag{0x0028, 0x0032}] = TagInfo{Tag{0x0028, 0x0032}, "DS", "ZoomCenter", "2"}
-	tagDict[Tag{0x0028, 0x0034}] = TagInfo{Tag{0x0028, 0x0034}, "IS", "PixelAspectRatio", "2"}
-	tagDict[Tag{0x0028, 0x0051}] = TagInfo{Tag{0x0028, 0x0051}, "CS", "CorrectedImage", "1-n"}
-	tagDict[Tag{0x0028, 0x0100}] = TagInfo{Tag{0x0028, 0x0100}, "US", "BitsAllocated", "1"}
-	tagDict[Tag{0x0028, 0x0101}] = TagInfo{Tag{0x0028, 0x0101}, "US", "BitsStored", "1"}
-	tagDict[Tag{0x0028, 0x0102}] = TagInfo{Tag{0x0028, 0x0102}, "US", "HighBit", "1"}
-	tagDict[Tag{0x0028, 0x0103}] = TagInfo{Tag{0x0028, 0x0103}, "US", "PhotometricInterpretation2", "1"}
-	tagDict[Tag{0x0028, 0x0106}] = TagInfo{Tag{0x0028, 0x0106}, "US", "SmallestImagePixelValue", "1"}
-	tagDict[Tag{0x0028, 0x0107}] = TagInfo{Tag{0x0028, 0x0107}, "US", "LargestImagePixelValue", "1"}
-	tagDict[Tag{0x0028, 0x0108}] = TagInfo{Tag{0x0028, 0x0108}, "US", "SmallestPixelValueInSeries", "1"}
-	tagDict[Tag{0x0028, 0x0109}] = TagInfo{Tag{0x0028, 0x0109}, "US", "LargestPixelValueInSeries", "1"}
-	tagDict[Tag{0x0028, 0x0120}] = TagInfo{Tag{0x0028, 0x0120}, "US", "PixelPaddingValue", "1"}
-	tagDict[Tag{0x0028, 0x0121}] = TagInfo{Tag{0x0028, 0x0121}, "US", "PixelPaddingRangeLimit", "1"}
-	tagDict[Tag{0x0028, 0x0300}] = TagInfo{Tag{0x0028, 0x0300}, "CS", "QualityControlImage", "1"}
-	tagDict[Tag{0x0028, 0x0301}] = TagInfo{Tag{0x0028, 0x0301}, "CS", "BurnedInAnnotation", "1"}
-	tagDict[Tag{0x0028, 0x0302}] = TagInfo{Tag{0x0028, 0x0302}, "CS", "RecognizableVisualFeatures", "1"}
-	tagDict[Tag{0x0028, 0x0303}] = TagInfo{Tag{0x0028, 0x0303}, "CS", "LongitudinalTemporalInformationModified", "1"}
-	tagDict[Tag{0x0028, 0x0304}] = TagInfo{Tag{0x0028, 0x0304}, "UI", "ReferencedColorPaletteInstanceUID", "1"}
-	tagDict[Tag{0x0028, 0x0A02}] = TagInfo{Tag{0x0028, 0x0A02}, "CS", "PixelSpacingCalibrationType", "1"}
-	tagDict[Tag{0x0028, 0x0A04}] = TagInfo{Tag{0x0028, 0x0A04}, "LO", "PixelSpacingCalibrationDescription", "1"}
-	tagDict[Tag{0x0028, 0x1040}] = TagInfo{Tag{0x0028, 0x1040}, "CS", "PixelIntensityRelationship", "1"}
-	tagDict[Tag{0x0028, 0x1041}] = TagInfo{Tag{0x0028, 0x1041}, "SS", "PixelIntensityRelationshipSign", "1"}
-	tagDict[Tag{0x0028, 0x1050}] = TagInfo{Tag{0x0028, 0x1050}, "DS", "WindowCenter", "1-n"}
-	tagDict[Tag{0x0028, 0x1051}] = TagInfo{Tag{0x0028, 0x1051}, "DS", "WindowWidth", "1-n"}
-	tagDict[Tag{0x0028, 0x1052}] = TagInfo{Tag{0x0028, 0x1052}, "DS", "RescaleIntercept", "1"}
-	tagDict[Tag{0x0028, 0x1053}] = TagInfo{Tag{0x0028, 0x1053}, "DS", "RescaleSlope", "1"}
-	tagDict[Tag{0x0028, 0x1054}] = TagInfo{Tag{0x0028, 0x1054}, "LO", "RescaleType", "1"}
-	tagDict[Tag{0x0028, 0x1055}] = TagInfo{Tag{0x0028, 0x1055}, "LO", "WindowCenterWidthExplanation", "1-n"}
-	tagDict[Tag{0x0028, 0x1056}] = TagInfo{Tag{0x0028, 0x1056}, "CS", "VOILUTFunction", "1"}
-	tagDict[Tag{0x0028, 0x1090}] = TagInfo{Tag{0x0028, 0x1090}, "CS", "RecommendedViewingMode", "1"}
-	tagDict[Tag{0x0028, 0x1101}] = TagInfo{Tag{0x0028, 0x1101}, "US", "RedPaletteColorLookupTableDescriptor", "3"}
-	tagDict[Tag{0x0028, 0x1102}] = TagInfo{Tag{0x0028, 0x1102}, "US", "GreenPaletteColorLookupTableDescriptor", "3"}
-	tagDict[Tag{0x0028, 0x1103}] = TagInfo{Tag{0x0028, 0x1103}, "US", "BluePaletteColorLookupTableDescriptor", "3"}
-	tagDict[Tag{0x0028, 0x1104}] = TagInfo{Tag{0x0028, 0x1104}, "US", "AlphaPaletteColorLookupTableDescriptor", "3"}
-	tagDict[Tag{0x0028, 0x1199}] = TagInfo{Tag{0x0028, 0x1199}, "UI", "PaletteColorLookupTableUID", "1"}
-	tagDict[Tag{0x0028, 0x1201}] = TagInfo{Tag{0x0028, 0x1201}, "OW", "RedPaletteColorLookupTableData", "1"}
-	tagDict[Tag{0x0028, 0x1202}] = TagInfo{Tag{0x0028, 0x1202}, "OW", "GreenPaletteColorLookupTableData", "1"}
-	tagDict[Tag{0x0028, 0x1203}] = TagInfo{Tag{0x0028, 0x1203}, "OW", "BluePaletteColorLookupTableData", "1"}
-	tagDict[Tag{0x0028, 0x1204}] = TagInfo{Tag{0x0028, 0x1204}, "OW", "AlphaPaletteColorLookupTableData", "1"}
-	tagDict[Tag{0x0028, 0x1221}] = TagInfo{Tag{0x0028, 0x1221}, "OW", "SegmentedRedPaletteColorLookupTableData", "1"}
-	tagDict[Tag{0x0028, 0x1222}] = TagInfo{Tag{0x0028, 0x1222}, "OW", "SegmentedGreenPaletteColorLookupTableData", "1"}
-	tagDict[Tag{0x0028, 0x1223}] = TagInfo{Tag{0x0028, 0x1223}, "OW", "SegmentedBluePaletteColorLookupTableData", "1"}
-	tagDict[Tag{0x0028, 0x1300}] = TagInfo{Tag{0x0028, 0x1300}, "CS", "BreastImplantPresent", "1"}
-	tagDict[Tag{0x0028, 0x1350}] = TagInfo{Tag{0x0028, 0x1350}, "CS", "PartialView", "1"}
-	tagDict[Tag{0x0028, 0x1351}] = TagInfo{Tag{0x0028, 0x1351}, "ST", "PartialViewDescription", "1"}
-	tagDict[Tag{0x0028, 0x1352}] = TagInfo{Tag{0x0028, 0x1352}, "SQ", "PartialViewCodeSequence", "1"}
-	tagDict[Tag{0x0028, 0x135A}] = TagInfo{Tag{0x0028, 0x135A}, "CS", "SpatialLocationsPreserved", "1"}
-	tagDict[Tag{0x0028, 0x1401}] = TagInfo{Tag{0x0028, 0x1401}, "SQ", "DataFrameAssignmentSequence", "1"}
-	tagDict[Tag{0x0028, 0x1402}] = TagInfo{Tag{0x0028, 0x1402}, "CS", "DataPathAssignment", "1"}
-	tagDict[Tag{0x0028, 0x1403}] = TagInfo{Tag{0x0028, 0x1403}, "US", "BitsMappedToColorLookupTable", "1"}
-	tagDict[Tag{0x0028, 0x1404}] = TagInfo{Tag{0x0028, 0x1404}, "SQ", "BlendingLUT1Sequence", "1"}
-	tagDict[Tag{0x0028, 0x1405}] = TagInfo{Tag{0x0028, 0x1405}, "CS", "BlendingLUT1TransferFunction", "1"}
-	tagDict[Tag{0x0028, 0x1406}] = TagInfo{Tag{0x0028, 0x1406}, "FD", "BlendingWeightConstant", "1"}
-	tagDict[Tag{0x0028, 0x1407}] = TagInfo{Tag{0x0028, 0x1407}, "US", "BlendingLookupTableDescriptor", "3"}
-	tagDict[Tag{0x0028, 0x1408}] = TagInfo{Tag{0x0028, 0x1408}, "OW", "BlendingLookupTableData", "1"}
-	tagDict[Tag{0x0028, 0x140B}] = TagInfo{Tag{0x0028, 0x140B}, "SQ", "EnhancedPaletteColorLookupTableSequence", "1"}
-	tagDict[Tag{0x0028, 0x140C}] = TagInfo{Tag{0x0028, 0x140C}, "SQ", "BlendingLUT2Sequence", "1"}
-	tagDict[Tag{0x0028, 0x140D}] = TagInfo{Tag{0x0028, 0x140D}, "CS", "BlendingLUT2TransferFunction", "1"}
-	tagDict[Tag{0x0028, 0x140E}] = TagInfo{Tag{0x0028, 0x140E}, "CS", "DataPathID", "1"}
-	tagDict[Tag{0x0028, 0x140F}] = TagInfo{Tag{0x0028, 0x140F}, "CS", "RGBLUTTransferFunction", "1"}
-	tagDict[Tag{0x0028, 0x1410}] = TagInfo{Tag{0x0028, 0x1410}, "CS", "AlphaLUTTransferFunction", "1"}
-	tagDict[Tag{0x0028, 0x2000}] = TagInfo{Tag{0x0028, 0x2000}, "OB", "ICCProfile", "1"}
-	tagDict[Tag{0x0028, 0x2110}] = TagInfo{Tag{0x0028, 0x2110}, "CS", "LossyImageCompression", "1"}
-	tagDict[Tag{0x0028, 0x2112}] = TagInfo{Tag{0x0028, 0x2112}, "DS", "LossyImageCompressionRatio", "1-n"}
-	tagDict[Tag{0x0028, 0x2114}] = TagInfo{Tag{0x0028, 0x2114}, "CS", "LossyImageCompressionMethod", "1-n"}
-	tagDict[Tag{0x0028, 0x3000}] = TagInfo{Tag{0x0028, 0x3000}, "SQ", "ModalityLUTSequence", "1"}
-	tagDict[Tag{0x0028, 0x3002}] = TagInfo{Tag{0x0028, 0x3002}, "US", "LUTDescriptor", "3"}
-	tagDict[Tag{0x0028, 0x3003}] = TagInfo{Tag{0x0028, 0x3003}, "LO", "LUTExplanation", "1"}
-	tagDict[Tag{0x0028, 0x3004}] = TagInfo{Tag{0x0028, 0x3004}, "LO", "ModalityLUTType", "1"}
-	tagDict[Tag{0x0028, 0x3006}] = TagInfo{Tag{0x0028, 0x3006}, "LT", "LUTData", "1-n"}
-	tagDict[Tag{0x0028, 0x3010}] = TagInfo{Tag{0x0028, 0x3010}, "SQ", "VOILUTSequence", "1"}
-	tagDict[Tag{0x0028, 0x3110}] = TagInfo{Tag{0x0028, 0x3110}, "SQ", "SoftcopyVOILUTSequence", "1"}
-	tagDict[Tag{0x0028, 0x6010}] = TagInfo{Tag{0x0028, 0x6010}, "US", "RepresentativeFrameNumber", "1"}
-	tagDict[Tag{0x0028, 0x6020}] = TagInfo{Tag{0x0028, 0x6020}, "US", "FrameNumbersOfInterest", "1-n"}
-	tagDict[Tag{0x0028, 0x6022}] = TagInfo{Tag{0x0028, 0x6022}, "LO", "FrameOfInterestDescription", "1-n"}
-	tagDict[Tag{0x0028, 0x6023}] = TagInfo{Tag{0x0028, 0x6023}, "CS", "FrameOfInterestType", "1-n"}
-	tagDict[Tag{0x0028, 0x6040}] = TagInfo{Tag{0x0028, 0x6040}, "US", "RWavePointer", "1-n"}
-	tagDict[Tag{0x0028, 0x6100}] = TagInfo{Tag{0x0028, 0x6100}, "SQ", "MaskSubtractionSequence", "1"}
-	tagDict[Tag{0x0028, 0x6101}] = TagInfo{Tag{0x0028, 0x6101}, "CS", "MaskOperation", "1"}
-	tagDict[Tag{0x0028, 0x6102}] = TagInfo{Tag{0x0028, 0x6102}, "US", "ApplicableFrameRange", "2-2n"}
-	tagDict[Tag{0x0028, 0x6110}] = TagInfo{Tag{0x0028, 0x6110}, "US", "MaskFrameNumbers", "1-n"}
-	tagDict[Tag{0x0028, 0x6112}] = TagInfo{Tag{0x0028, 0x6112}, "US", "ContrastFrameAveraging", "1"}
-	tagDict[Tag{0x0028, 0x6114}] = TagInfo{Tag{0x0028, 0x6114}, "FL", "MaskSubPixelShift", "2"}
-	tagDict[Tag{0x0028, 0x6120}] = TagInfo{Tag{0x0028, 0x6120}, "SS", "TIDOffset", "1"}
-	tagDict[Tag{0x0028, 0x6190}] = TagInfo{Tag{0x0028, 0x6190}, "ST", "MaskOperationExplanation", "1"}
-	tagDict[Tag{0x0028, 0x7FE0}] = TagInfo{Tag{0x0028, 0x7FE0}, "UT", "PixelDataProviderURL", "1"}
-	tagDict[Tag{0x0028, 0x9001}] = TagInfo{Tag{0x0028, 0x9001}, "UL", "DataPointRows", "1"}
-	tagDict[Tag{0x0028, 0x9002}] = TagInfo{Tag{0x0028, 0x9002}, "UL", "DataPointColumns", "1"}
-	tagDict[Tag{0x0028, 0x9003}] = TagInfo{Tag{0x0028, 0x9003}, "CS", "SignalDomainColumns", "1"}
-	tagDict[Tag{0x0028, 0x9108}] = TagInfo{Tag{0x0028, 0x9108}, "CS", "DataRepresentation", "1"}
-	tagDict[Tag{0x0028, 0x9110}] = TagInfo{Tag{0x0028, 0x9110}, "SQ", "PixelMeasuresSequence", "1"}
-	tagDict[Tag{0x0028, 0x9132}] = TagInfo{Tag{0x0028, 0x9132}, "SQ", "FrameVOILUTSequence", "1"}
-	tagDict[Tag{0x0028, 0x9145}] = TagInfo{Tag{0x0028, 0x9145}, "SQ", "PixelValueTransformationSequence", "1"}
-	tagDict[Tag{0x0028, 0x9235}] = TagInfo{Tag{0x0028, 0x9235}, "CS", "SignalDomainRows", "1"}
-	tagDict[Tag{0x0028, 0x9411}] = TagInfo{Tag{0x0028, 0x9411}, "FL", "DisplayFilterPercentage", "1"}
-	tagDict[Tag{0x0028, 0x9415}] = TagInfo{Tag{0x0028, 0x9415}, "SQ", "FramePixelShiftSequence", "1"}
-	tagDict[Tag{0x0028, 0x9416}] = TagInfo{Tag{0x0028, 0x9416}, "US", "SubtractionItemID", "1"}
-	tagDict[Tag{0x0028, 0x9422}] = TagInfo{Tag{0x0028, 0x9422}, "SQ", "PixelIntensityRelationshipLUTSequence", "1"}
-	tagDict[Tag{0x0028, 0x9443}] = TagInfo{Tag{0x0028, 0x9443}, "SQ", "FramePixelDataPropertiesSequence", "1"}
-	tagDict[Tag{0x0028, 0x9444}] = TagInfo{Tag{0x0028, 0x9444}, "CS", "GeometricalProperties", "1"}
-	tagDict[Tag{0x0028, 0x9445}] = TagInfo{Tag{0x0028, 0x9445}, "FL", "GeometricMaximumDistortion", "1"}
-	tagDict[Tag{0x0028, 0x9446}] = TagInfo{Tag{0x0028, 0x9446}, "CS", "ImageProcessingApplied", "1-n"}
-	tagDict[Tag{0x0028, 0x9454}] = TagInfo{Tag{0x0028, 0x9454}, "CS", "MaskSelectionMode", "1"}
-	tagDict[Tag{0x0028, 0x9474}] = TagInfo{Tag{0x0028, 0x9474}, "CS", "LUTFunction", "1"}
-	tagDict[Tag{0x0028, 0x9478}] = TagInfo{Tag{0x0028, 0x9478}, "FL", "MaskVisibilityPercentage", "1"}
-	tagDict[Tag{0x0028, 0x9501}] = TagInfo{Tag{0x0028, 0x9501}, "SQ", "PixelShiftSequence", "1"}
-	tagDict[Tag{0x0028, 0x9502}] = TagInfo{Tag{0x0028, 0x9502}, "SQ", "RegionPixelShiftSequence", "1"}
-	tagDict[Tag{0x0028, 0x9503}] = TagInfo{Tag{0x0028, 0x9503}, "SS", "VerticesOfTheRegion", "2-2n"}
-	tagDict[Tag{0x0028, 0x9505}] = TagInfo{Tag{0x0028, 0x9505}, "SQ", "MultiFramePresentationSequence", "1"}
-	tagDict[Tag{0x0028, 0x9506}] = TagInfo{Tag{0x0028, 0x9506}, "US", "PixelShiftFrameRange", "2-2n"}
-	tagDict[Tag{0x0028, 0x9507}] = TagInfo{Tag{0x0028, 0x9507}, "US", "LUTFrameRange", "2-2n"}
-	tagDict[Tag{0x0028, 0x9520}] = TagInfo{Tag{0x0028, 0x9520}, "DS", "ImageToEquipmentMappingMatrix", "16"}
-	tagDict[Tag{0x0028, 0x9537}] = TagInfo{Tag{0x0028, 0x9537}, "CS", "EquipmentCoordinateSystemIdentification", "1"}
-	tagDict[Tag{0x0032, 0x1031}] = TagInfo{Tag{0x0032, 0x1031}, "SQ", "RequestingPhysicianIdentificationSequence", "1"}
-	tagDict[Tag{0x0032, 0x1032}] = TagInfo{Tag{0x0032, 0x1032}, "PN", "RequestingPhysician", "1"}
-	tagDict[Tag{0x0032, 0x1033}] = TagInfo{Tag{0x0032, 0x1033}, "LO", "RequestingService", "1"}
-	tagDict[Tag{0x0032, 0x1034}] = TagInfo{Tag{0x0032, 0x1034}, "SQ", "RequestingServiceCodeSequence", "1"}
-	tagDict[Tag{0x0032, 0x1060}] = TagInfo{Tag{0x0032, 0x1060}, "LO", "RequestedProcedureDescription", "1"}
-	tagDict[Tag{0x0032, 0x1064}] = TagInfo{Tag{0x0032, 0x1064}, "SQ", "RequestedProcedureCodeSequence", "1"}
-	tagDict[Tag{0x0032, 0x1070}] = TagInfo{Tag{0x0032, 0x1070}, "LO", "RequestedContrastAgent", "1"}
-	tagDict[Tag{0x0032, 0x4000}] = TagInfo{Tag{0x0032, 0x4000}, "LT", "ExaminationNotes", "1"}
-	tagDict[Tag{0x0038, 0x0004}] = TagInfo{Tag{0x0038, 0x0004}, "SQ", "ReferencedPatientAliasSequence", "1"}
-	tagDict[Tag{0x0038, 0x0008}] = TagInfo{Tag{0x0038, 0x0008}, "CS", "VisitStatusID", "1"}
-	tagDict[Tag{0x0038, 0x0010}] = TagInfo{Tag{0x0038, 0x0010}, "LO", "AdmissionID", "1"}
-	tagDict[Tag{0x0038, 0x0014}] = TagInfo{Tag{0x0038, 0x0014}, "SQ", "IssuerOfAdmissionIDSequence", "1"}
-	tagDict[Tag{0x0038, 0x0016}] = TagInfo{Tag{0x0038, 0x0016}, "LO", "RouteOfAdmissions", "1"}
-	tagDict[Tag{0x0038, 0x0020}] = TagInfo{Tag{0x0038, 0x0020}, "DA", "AdmittingDate", "1"}
-	tagDict[Tag{0x0038, 0x0021}] = TagInfo{Tag{0x0038, 0x0021}, "TM", "AdmittingTime", "1"}
-	tagDict[Tag{0x0038, 0x0050}] = TagInfo{Tag{0x0038, 0x0050}, "LO", "SpecialNeeds", "1"}
-	tagDict[Tag{0x0038, 0x0060}] = TagInfo{Tag{0x0038, 0x0060}, "LO", "ServiceEpisodeID", "1"}
-	tagDict[Tag{0x0038, 0x0062}] = TagInfo{Tag{0x0038, 0x0062}, "LO", "ServiceEpisodeDescription", "1"}
-	tagDict[Tag{0x0038, 0x0064}] = TagInfo{Tag{0x0038, 0x0064}, "SQ", "IssuerOfServiceEpisodeIDSequence", "1"}
-	tagDict[Tag{0x0038, 0x0100}] = TagInfo{Tag{0x0038, 0x0100}, "SQ", "PertinentDocumentsSequence", "1"}
-	tagDict[Tag{0x0038, 0x0300}] = TagInfo{Tag{0x0038, 0x0300}, "LO", "CurrentPatientLocation", "1"}
-	tagDict[Tag{0x0038, 0x0400}] = TagInfo{Tag{0x0038, 0x0400}, "LO", "PatientInstitutionResidence", "1"}
-	tagDict[Tag{0x0038, 0x0500}] = TagInfo{Tag{0x0038, 0x0500}, "LO", "PatientState", "1"}
-	tagDict[Tag{0x0038, 0x0502}] = TagInfo{Tag{0x0038, 0x0502}, "SQ", "PatientClinicalTrialParticipationSequence", "1"}
-	tagDict[Tag{0x0038, 0x4000}] = TagInfo{Tag{0x0038, 0x4000}, "LT", "VisitComments", "1"}
-	tagDict[Tag{0x003A, 0x0004}] = TagInfo{Tag{0x003A, 0x0004}, "CS", "WaveformOriginality", "1"}
-	tagDict[Tag{0x003A, 0x0005}] = TagInfo{Tag{0x003A, 0x0005}, "US", "NumberOfWaveformChannels", "1"}
-	tagDict[Tag{0x003A, 0x0010}] = TagInfo{Tag{0x003A, 0x0010}, "UL", "NumberOfWaveformSamples", "1"}
-	tagDict[Tag{0x003A, 0x001A}] = TagInfo{Tag{0x003A, 0x001A}, "DS", "SamplingFrequency", "1"}
-	tagDict[Tag{0x003A, 0x0020}] = TagInfo{Tag{0x003A, 0x0020}, "SH", "MultiplexGroupLabel", "1"}
-	tagDict[Tag{0x003A, 0x0200}] = TagInfo{Tag{0x003A, 0x0200}, "SQ", "ChannelDefinitionSequence", "1"}
-	tagDict[Tag{0x003A, 0x0202}] = TagInfo{Tag{0x003A, 0x0202}, "IS", "WaveformChannelNumber", "1"}
-	tagDict[Tag{0x003A, 0x0203}] = TagInfo{Tag{0x003A, 0x0203}, "SH", "ChannelLabel", "1"}
-	tagDict[Tag{0x003A, 0x0205}] = TagInfo{Tag{0x003A, 0x0205}, "CS", "ChannelStatus", "1-n"}
-	tagDict[Tag{0x003A, 0x0208}] = TagInfo{Tag{0x003A, 0x0208}, "SQ", "ChannelSourceSequence", "1"}
-	tagDict[Tag{0x003A, 0x0209}] = TagInfo{Tag{0x003A, 0x0209}, "SQ", "ChannelSourceModifiersSequence", "1"}
-	tagDict[Tag{0x003A, 0x020A}] = TagInfo{Tag{0x003A, 0x020A}, "SQ", "SourceWaveformSequence", "1"}
-	tagDict[Tag{0x003A, 0x020C}] = TagInfo{Tag{0x003A, 0x020C}, "LO", "ChannelDerivationDescription", "1"}
-	tagDict[Tag{0x003A, 0x0210}] = TagInfo{Tag{0x003A, 0x0210}, "DS", "ChannelSensitivity", "1"}
-	tagDict[Tag{0x003A, 0x0211}] = TagInfo{Tag{0x003A, 0x0211}, "SQ", "ChannelSensitivityUnitsSequence", "1"}
-	tagDict[Tag{0x003A, 0x0212}] = TagInfo{Tag{0x003A, 0x0212}, "DS", "ChannelSensitivityCorrectionFactor", "1"}
-	tagDict[Tag{0x003A, 0x0213}] = TagInfo{Tag{0x003A, 0x0213}, "DS", "ChannelBaseline", "1"}
-	tagDict[Tag{0x003A, 0x0214}] = TagInfo{Tag{0x003A, 0x0214}, "DS", "ChannelTimeSkew", "1"}
-	tagDict[Tag{0x003A, 0x0215}] = TagInfo{Tag{0x003A, 0x0215}, "DS", "ChannelSampleSkew", "1"}
-	tagDict[Tag{0x003A, 0x0218}] = TagInfo{Tag{0x003A, 0x0218}, "DS", "ChannelOffset", "1"}
-	tagDict[Tag{0x003A, 0x021A}] = TagInfo{Tag{0x003A, 0x021A}, "US", "WaveformBitsStored", "1"}
-	tagDict[Tag{0x003A, 0x0220}] = TagInfo{Tag{0x003A, 0x0220}, "DS", "FilterLowFrequency", "1"}
-	tagDict[Tag{0x003A, 0x0221}] = TagInfo{Tag{0x003A, 0x0221}, "DS", "FilterHighFrequency", "1"}
-	tagDict[Tag{0x003A, 0x0222}] = TagInfo{Tag{0x003A, 0x0222}, "DS", "NotchFilterFrequency", "1"}
-	tagDict[Tag{0x003A, 0x0223}] = TagInfo{Tag{0x003A, 0x0223}, "DS", "NotchFilterBandwidth", "1"}
-	tagDict[Tag{0x003A, 0x0230}] = TagInfo{Tag{0x003A, 0x0230}, "FL", "WaveformDataDisplayScale", "1"}
-	tagDict[Tag{0x003A, 0x0231}] = TagInfo{Tag{0x003A, 0x0231}, "US", "WaveformDisplayBackgroundCIELabValue", "3"}
-	tagDict[Tag{0x003A, 0x0240}] = TagInfo{Tag{0x003A, 0x0240}, "SQ", "WaveformPresentationGroupSequence", "1"}
-	tagDict[Tag{0x003A, 0x0241}] = TagInfo{Tag{0x003A, 0x0241}, "US", "PresentationGroupNumber", "1"}
-	tagDict[Tag{0x003A, 0x0242}] = TagInfo{Tag{0x003A, 0x0242}, "SQ", "ChannelDisplaySequence", "1"}
-	tagDict[Tag{0x003A, 0x0244}] = TagInfo{Tag{0x003A, 0x0244}, "US", "ChannelRecommendedDisplayCIELabValue", "3"}
-	tagDict[Tag{0x003A, 0x0245}] = TagInfo{Tag{0x003A, 0x0245}, "FL", "ChannelPosition", "1"}
-	tagDict[Tag{0x003A, 0x0246}] = TagInfo{Tag{0x003A, 0x0246}, "CS", "DisplayShadingFlag", "1"}
-	tagDict[Tag{0x003A, 0x0247}] = TagInfo{Tag{0x003A, 0x0247}, "FL", "FractionalChannelDisplayScale", "1"}
-	tagDict[Tag{0x003A, 0x0248}] = TagInfo{Tag{0x003A, 0x0248}, "FL", "AbsoluteChannelDisplayScale", "1"}
-	tagDict[Tag{0x003A, 0x0300}] = TagInfo{Tag{0x003A, 0x0300}, "SQ", "MultiplexedAudioChannelsDescriptionCodeSequence", "1"}
-	tagDict[Tag{0x003A, 0x0301}] = TagInfo{Tag{0x003A, 0x0301}, "IS", "ChannelIdentificationCode", "1"}
-	tagDict[Tag{0x003A, 0x0302}] = TagInfo{Tag{0x003A, 0x0302}, "CS", "ChannelMode", "1"}
-	tagDict[Tag{0x0040, 0x0001}] = TagInfo{Tag{0x0040, 0x0001}, "AE", "ScheduledStationAETitle", "1-n"}
-	tagDict[Tag{0x0040, 0x0002}] = TagInfo{Tag{0x0040, 0x0002}, "DA", "ScheduledProcedureStepStartDate", "1"}
-	tagDict[Tag{0x0040, 0x0003}] = TagInfo{Tag{0x0040, 0x0003}, "TM", "ScheduledProcedureStepStartTime", "1"}
-	tagDict[Tag{0x0040, 0x0004}] = TagInfo{Tag{0x0040, 0x0004}, "DA", "ScheduledProcedureStepEndDate", "1"}
-	tagDict[Tag{0x0040, 0x0005}] = TagInfo{Tag{0x0040, 0x0005}, "TM", "ScheduledProcedureStepEndTime", "1"}
-	tagDict[Tag{0x0040, 0x0006}] = TagInfo{Tag{0x0040, 0x0006}, "PN", "ScheduledPerformingPhysicianName", "1"}
-	tagDict[Tag{0x0040, 0x0007}] = TagInfo{Tag{0x0040, 0x0007}, "LO", "ScheduledProcedureStepDescription", "1"}
-	tagDict[Tag{0x0040, 0x0008}] = TagInfo{Tag{0x0040, 0x0008}, "SQ", "ScheduledProtocolCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x0009}] = TagInfo{Tag{0x0040, 0x0009}, "SH", "ScheduledProcedureStepID", "1"}
-	tagDict[Tag{0x0040, 0x000A}] = TagInfo{Tag{0x0040, 0x000A}, "SQ", "StageCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x000B}] = TagInfo{Tag{0x0040, 0x000B}, "SQ", "ScheduledPerformingPhysicianIdentificationSequence", "1"}
-	tagDict[Tag{0x0040, 0x0010}] = TagInfo{Tag{0x0040, 0x0010}, "SH", "ScheduledStationName", "1-n"}
-	tagDict[Tag{0x0040, 0x0011}] = TagInfo{Tag{0x0040, 0x0011}, "SH", "ScheduledProcedureStepLocation", "1"}
-	tagDict[Tag{0x0040, 0x0012}] = TagInfo{Tag{0x0040, 0x0012}, "LO", "PreMedication", "1"}
-	tagDict[Tag{0x0040, 0x0020}] = TagInfo{Tag{0x0040, 0x0020}, "CS", "ScheduledProcedureStepStatus", "1"}
-	tagDict[Tag{0x0040, 0x0026}] = TagInfo{Tag{0x0040, 0x0026}, "SQ", "OrderPlacerIdentifierSequence", "1"}
-	tagDict[Tag{0x0040, 0x0027}] = TagInfo{Tag{0x0040, 0x0027}, "SQ", "OrderFillerIdentifierSequence", "1"}
-	tagDict[Tag{0x0040, 0x0031}] = TagInfo{Tag{0x0040, 0x0031}, "UT", "LocalNamespaceEntityID", "1"}
-	tagDict[Tag{0x0040, 0x0032}] = TagInfo{Tag{0x0040, 0x0032}, "UT", "UniversalEntityID", "1"}
-	tagDict[Tag{0x0040, 0x0033}] = TagInfo{Tag{0x0040, 0x0033}, "CS", "UniversalEntityIDType", "1"}
-	tagDict[Tag{0x0040, 0x0035}] = TagInfo{Tag{0x0040, 0x0035}, "CS", "IdentifierTypeCode", "1"}
-	tagDict[Tag{0x0040, 0x0036}] = TagInfo{Tag{0x0040, 0x0036}, "SQ", "AssigningFacilitySequence", "1"}
-	tagDict[Tag{0x0040, 0x0039}] = TagInfo{Tag{0x0040, 0x0039}, "SQ", "AssigningJurisdictionCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x003A}] = TagInfo{Tag{0x0040, 0x003A}, "SQ", "AssigningAgencyOrDepartmentCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x0100}] = TagInfo{Tag{0x0040, 0x0100}, "SQ", "ScheduledProcedureStepSequence", "1"}
-	tagDict[Tag{0x0040, 0x0220}] = TagInfo{Tag{0x0040, 0x0220}, "SQ", "ReferencedNonImageCompositeSOPInstanceSequence", "1"}
-	tagDict[Tag{0x0040, 0x0241}] = TagInfo{Tag{0x0040, 0x0241}, "AE", "PerformedStationAETitle", "1"}
-	tagDict[Tag{0x0040, 0x0242}] = TagInfo{Tag{0x0040, 0x0242}, "SH", "PerformedStationName", "1"}
-	tagDict[Tag{0x0040, 0x0243}] = TagInfo{Tag{0x0040, 0x0243}, "SH", "PerformedLocation", "1"}
-	tagDict[Tag{0x0040, 0x0244}] = TagInfo{Tag{0x0040, 0x0244}, "DA", "PerformedProcedureStepStartDate", "1"}
-	tagDict[Tag{0x0040, 0x0245}] = TagInfo{Tag{0x0040, 0x0245}, "TM", "PerformedProcedureStepStartTime", "1"}
-	tagDict[Tag{0x0040, 0x0250}] = TagInfo{Tag{0x0040, 0x0250}, "DA", "PerformedProcedureStepEndDate", "1"}
-	tagDict[Tag{0x0040, 0x0251}] = TagInfo{Tag{0x0040, 0x0251}, "TM", "PerformedProcedureStepEndTime", "1"}
-	tagDict[Tag{0x0040, 0x0252}] = TagInfo{Tag{0x0040, 0x0252}, "CS", "PerformedProcedureStepStatus", "1"}
-	tagDict[Tag{0x0040, 0x0253}] = TagInfo{Tag{0x0040, 0x0253}, "SH", "PerformedProcedureStepID", "1"}
-	tagDict[Tag{0x0040, 0x0254}] = TagInfo{Tag{0x0040, 0x0254}, "LO", "PerformedProcedureStepDescription", "1"}
-	tagDict[Tag{0x0040, 0x0255}] = TagInfo{Tag{0x0040, 0x0255}, "LO", "PerformedProcedureTypeDescription", "1"}
-	tagDict[Tag{0x0040, 0x0260}] = TagInfo{Tag{0x0040, 0x0260}, "SQ", "PerformedProtocolCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x0261}] = TagInfo{Tag{0x0040, 0x0261}, "CS", "PerformedProtocolType", "1"}
-	tagDict[Tag{0x0040, 0x0270}] = TagInfo{Tag{0x0040, 0x0270}, "SQ", "ScheduledStepAttributesSequence", "1"}
-	tagDict[Tag{0x0040, 0x0275}] = TagInfo{Tag{0x0040, 0x0275}, "SQ", "RequestAttributesSequence", "1"}
-	tagDict[Tag{0x0040, 0x0280}] = TagInfo{Tag{0x0040, 0x0280}, "ST", "CommentsOnThePerformedProcedureStep", "1"}
-	tagDict[Tag{0x0040, 0x0281}] = TagInfo{Tag{0x0040, 0x0281}, "SQ", "PerformedProcedureStepDiscontinuationReasonCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x0293}] = TagInfo{Tag{0x0040, 0x0293}, "SQ", "QuantitySequence", "1"}
-	tagDict[Tag{0x0040, 0x0294}] = TagInfo{Tag{0x0040, 0x0294}, "DS", "Quantity", "1"}
-	tagDict[Tag{0x0040, 0x0295}] = TagInfo{Tag{0x0040, 0x0295}, "SQ", "MeasuringUnitsSequence", "1"}
-	tagDict[Tag{0x0040, 0x0296}] = TagInfo{Tag{0x0040, 0x0296}, "SQ", "BillingItemSequence", "1"}
-	tagDict[Tag{0x0040, 0x0300}] = TagInfo{Tag{0x0040, 0x0300}, "US", "TotalTimeOfFluoroscopy", "1"}
-	tagDict[Tag{0x0040, 0x0301}] = TagInfo{Tag{0x0040, 0x0301}, "US", "TotalNumberOfExposures", "1"}
-	tagDict[Tag{0x0040, 0x0302}] = TagInfo{Tag{0x0040, 0x0302}, "US", "EntranceDose", "1"}
-	tagDict[Tag{0x0040, 0x0303}] = TagInfo{Tag{0x0040, 0x0303}, "US", "ExposedArea", "1-2"}
-	tagDict[Tag{0x0040, 0x0306}] = TagInfo{Tag{0x0040, 0x0306}, "DS", "DistanceSourceToEntrance", "1"}
-	tagDict[Tag{0x0040, 0x030E}] = TagInfo{Tag{0x0040, 0x030E}, "SQ", "ExposureDoseSequence", "1"}
-	tagDict[Tag{0x0040, 0x0310}] = TagInfo{Tag{0x0040, 0x0310}, "ST", "CommentsOnRadiationDose", "1"}
-	tagDict[Tag{0x0040, 0x0312}] = TagInfo{Tag{0x0040, 0x0312}, "DS", "XRayOutput", "1"}
-	tagDict[Tag{0x0040, 0x0314}] = TagInfo{Tag{0x0040, 0x0314}, "DS", "HalfValueLayer", "1"}
-	tagDict[Tag{0x0040, 0x0316}] = TagInfo{Tag{0x0040, 0x0316}, "DS", "OrganDose", "1"}
-	tagDict[Tag{0x0040, 0x0318}] = TagInfo{Tag{0x0040, 0x0318}, "CS", "OrganExposed", "1"}
-	tagDict[Tag{0x0040, 0x0320}] = TagInfo{Tag{0x0040, 0x0320}, "SQ", "BillingProcedureStepSequence", "1"}
-	tagDict[Tag{0x0040, 0x0321}] = TagInfo{Tag{0x0040, 0x0321}, "SQ", "FilmConsumptionSequence", "1"}
-	tagDict[Tag{0x0040, 0x0324}] = TagInfo{Tag{0x0040, 0x0324}, "SQ", "BillingSuppliesAndDevicesSequence", "1"}
-	tagDict[Tag{0x0040, 0x0340}] = TagInfo{Tag{0x0040, 0x0340}, "SQ", "PerformedSeriesSequence", "1"}
-	tagDict[Tag{0x0040, 0x0400}] = TagInfo{Tag{0x0040, 0x0400}, "LT", "CommentsOnTheScheduledProcedureStep", "1"}
-	tagDict[Tag{0x0040, 0x0440}] = TagInfo{Tag{0x0040, 0x0440}, "SQ", "ProtocolContextSequence", "1"}
-	tagDict[Tag{0x0040, 0x0441}] = TagInfo{Tag{0x0040, 0x0441}, "SQ", "ContentItemModifierSequence", "1"}
-	tagDict[Tag{0x0040, 0x0500}] = TagInfo{Tag{0x0040, 0x0500}, "SQ", "ScheduledSpecimenSequence", "1"}
-	tagDict[Tag{0x0040, 0x0512}] = TagInfo{Tag{0x0040, 0x0512}, "LO", "ContainerIdentifier", "1"}
-	tagDict[Tag{0x0040, 0x0513}] = TagInfo{Tag{0x0040, 0x0513}, "SQ", "IssuerOfTheContainerIdentifierSequence", "1"}
-	tagDict[Tag{0x0040, 0x0515}] = TagInfo{Tag{0x0040, 0x0515}, "SQ", "AlternateContainerIdentifierSequence", "1"}
-	tagDict[Tag{0x0040, 0x0518}] = TagInfo{Tag{0x0040, 0x0518}, "SQ", "ContainerTypeCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x051A}] = TagInfo{Tag{0x0040, 0x051A}, "LO", "ContainerDescription", "1"}
-	tagDict[Tag{0x0040, 0x0520}] = TagInfo{Tag{0x0040, 0x0520}, "SQ", "ContainerComponentSequence", "1"}
-	tagDict[Tag{0x0040, 0x0551}] = TagInfo{Tag{0x0040, 0x0551}, "LO", "SpecimenIdentifier", "1"}
-	tagDict[Tag{0x0040, 0x0554}] = TagInfo{Tag{0x0040, 0x0554}, "UI", "SpecimenUID", "1"}
-	tagDict[Tag{0x0040, 0x0555}] = TagInfo{Tag{0x0040, 0x0555}, "SQ", "AcquisitionContextSequence", "1"}
-	tagDict[Tag{0x0040, 0x0556}] = TagInfo{Tag{0x0040, 0x0556}, "ST", "AcquisitionContextDescription", "1"}
-	tagDict[Tag{0x0040, 0x0560}] = TagInfo{Tag{0x0040, 0x0560}, "SQ", "SpecimenDescriptionSequence", "1"}
-	tagDict[Tag{0x0040, 0x0562}] = TagInfo{Tag{0x0040, 0x0562}, "SQ", "IssuerOfTheSpecimenIdentifierSequence", "1"}
-	tagDict[Tag{0x0040, 0x059A}] = TagInfo{Tag{0x0040, 0x059A}, "SQ", "SpecimenTypeCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x0600}] = TagInfo{Tag{0x0040, 0x0600}, "LO", "SpecimenShortDescription", "1"}
-	tagDict[Tag{0x0040, 0x0602}] = TagInfo{Tag{0x0040, 0x0602}, "UT", "SpecimenDetailedDescription", "1"}
-	tagDict[Tag{0x0040, 0x0610}] = TagInfo{Tag{0x0040, 0x0610}, "SQ", "SpecimenPreparationSequence", "1"}
-	tagDict[Tag{0x0040, 0x0612}] = TagInfo{Tag{0x0040, 0x0612}, "SQ", "SpecimenPreparationStepContentItemSequence", "1"}
-	tagDict[Tag{0x0040, 0x0620}] = TagInfo{Tag{0x0040, 0x0620}, "SQ", "SpecimenLocalizationContentItemSequence", "1"}
-	tagDict[Tag{0x0040, 0x071A}] = TagInfo{Tag{0x0040, 0x071A}, "SQ", "ImageCenterPointCoordinatesSequence", "1"}
-	tagDict[Tag{0x0040, 0x072A}] = TagInfo{Tag{0x0040, 0x072A}, "DS", "XOffsetInSlideCoordinateSystem", "1"}
-	tagDict[Tag{0x0040, 0x073A}] = TagInfo{Tag{0x0040, 0x073A}, "DS", "YOffsetInSlideCoordinateSystem", "1"}
-	tagDict[Tag{0x0040, 0x074A}] = TagInfo{Tag{0x0040, 0x074A}, "DS", "ZOffsetInSlideCoordinateSystem", "1"}
-	tagDict[Tag{0x0040, 0x08EA}] = TagInfo{Tag{0x0040, 0x08EA}, "SQ", "PropertyUnitsCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x1001}] = TagInfo{Tag{0x0040, 0x1001}, "SH", "RequestedProcedureID", "1"}
-	tagDict[Tag{0x0040, 0x1002}] = TagInfo{Tag{0x0040, 0x1002}, "LO", "ReasonForTheRequestedProcedure", "1"}
-	tagDict[Tag{0x0040, 0x1003}] = TagInfo{Tag{0x0040, 0x1003}, "SH", "RequestedProcedurePriority", "1"}
-	tagDict[Tag{0x0040, 0x1004}] = TagInfo{Tag{0x0040, 0x1004}, "LO", "PatientTransportArrangements", "1"}
-	tagDict[Tag{0x0040, 0x1005}] = TagInfo{Tag{0x0040, 0x1005}, "LO", "RequestedProcedureLocation", "1"}
-	tagDict[Tag{0x0040, 0x1008}] = TagInfo{Tag{0x0040, 0x1008}, "LO", "ConfidentialityCode", "1"}
-	tagDict[Tag{0x0040, 0x1009}] = TagInfo{Tag{0x0040, 0x1009}, "SH", "ReportingPriority", "1"}
-	tagDict[Tag{0x0040, 0x100A}] = TagInfo{Tag{0x0040, 0x100A}, "SQ", "ReasonForRequestedProcedureCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x1010}] = TagInfo{Tag{0x0040, 0x1010}, "PN", "NamesOfIntendedRecipientsOfResults", "1-n"}
-	tagDict[Tag{0x0040, 0x1011}] = TagInfo{Tag{0x0040, 0x1011}, "SQ", "IntendedRecipientsOfResultsIdentificationSequence", "1"}
-	tagDict[Tag{0x0040, 0x1012}] = TagInfo{Tag{0x0040, 0x1012}, "SQ", "ReasonForPerformedProcedureCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x1101}] = TagInfo{Tag{0x0040, 0x1101}, "SQ", "PersonIdentificationCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x1102}] = TagInfo{Tag{0x0040, 0x1102}, "ST", "PersonAddress", "1"}
-	tagDict[Tag{0x0040, 0x1103}] = TagInfo{Tag{0x0040, 0x1103}, "LO", "PersonTelephoneNumbers", "1-n"}
-	tagDict[Tag{0x0040, 0x1400}] = TagInfo{Tag{0x0040, 0x1400}, "LT", "RequestedProcedureComments", "1"}
-	tagDict[Tag{0x0040, 0x2004}] = TagInfo{Tag{0x0040, 0x2004}, "DA", "IssueDateOfImagingServiceRequest", "1"}
-	tagDict[Tag{0x0040, 0x2005}] = TagInfo{Tag{0x0040, 0x2005}, "TM", "IssueTimeOfImagingServiceRequest", "1"}
-	tagDict[Tag{0x0040, 0x2008}] = TagInfo{Tag{0x0040, 0x2008}, "PN", "OrderEnteredBy", "1"}
-	tagDict[Tag{0x0040, 0x2009}] = TagInfo{Tag{0x0040, 0x2009}, "SH", "OrderEntererLocation", "1"}
-	tagDict[Tag{0x0040, 0x2010}] = TagInfo{Tag{0x0040, 0x2010}, "SH", "OrderCallbackPhoneNumber", "1"}
-	tagDict[Tag{0x0040, 0x2016}] = TagInfo{Tag{0x0040, 0x2016}, "LO", "PlacerOrderNumberImagingServiceRequest", "1"}
-	tagDict[Tag{0x0040, 0x2017}] = TagInfo{Tag{0x0040, 0x2017}, "LO", "FillerOrderNumberImagingServiceRequest", "1"}
-	tagDict[Tag{0x0040, 0x2400}] = TagInfo{Tag{0x0040, 0x2400}, "LT", "ImagingServiceRequestComments", "1"}
-	tagDict[Tag{0x0040, 0x3001}] = TagInfo{Tag{0x0040, 0x3001}, "LO", "ConfidentialityConstraintOnPatientDataDescription", "1"}
-	tagDict[Tag{0x0040, 0x4001}] = TagInfo{Tag{0x0040, 0x4001}, "CS", "GeneralPurposeScheduledProcedureStepStatus", "1"}
-	tagDict[Tag{0x0040, 0x4002}] = TagInfo{Tag{0x0040, 0x4002}, "CS", "GeneralPurposePerformedProcedureStepStatus", "1"}
-	tagDict[Tag{0x0040, 0x4003}] = TagInfo{Tag{0x0040, 0x4003}, "CS", "GeneralPurposeScheduledProcedureStepPriority", "1"}
-	tagDict[Tag{0x0040, 0x4004}] = TagInfo{Tag{0x0040, 0x4004}, "SQ", "ScheduledProcessingApplicationsCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x4005}] = TagInfo{Tag{0x0040, 0x4005}, "DT", "ScheduledProcedureStepStartDateTime", "1"}
-	tagDict[Tag{0x0040, 0x4006}] = TagInfo{Tag{0x0040, 0x4006}, "CS", "MultipleCopiesFlag", "1"}
-	tagDict[Tag{0x0040, 0x4007}] = TagInfo{Tag{0x0040, 0x4007}, "SQ", "PerformedProcessingApplicationsCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x4009}] = TagInfo{Tag{0x0040, 0x4009}, "SQ", "HumanPerformerCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x4010}] = TagInfo{Tag{0x0040, 0x4010}, "DT", "ScheduledProcedureStepModificationDateTime", "1"}
-	tagDict[Tag{0x0040, 0x4011}] = TagInfo{Tag{0x0040, 0x4011}, "DT", "ExpectedCompletionDateTime", "1"}
-	tagDict[Tag{0x0040, 0x4015}] = TagInfo{Tag{0x0040, 0x4015}, "SQ", "ResultingGeneralPurposePerformedProcedureStepsSequence", "1"}
-	tagDict[Tag{0x0040, 0x4016}] = TagInfo{Tag{0x0040, 0x4016}, "SQ", "ReferencedGeneralPurposeScheduledProcedureStepSequence", "1"}
-	tagDict[Tag{0x0040, 0x4018}] = TagInfo{Tag{0x0040, 0x4018}, "SQ", "ScheduledWorkitemCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x4019}] = TagInfo{Tag{0x0040, 0x4019}, "SQ", "PerformedWorkitemCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x4020}] = TagInfo{Tag{0x0040, 0x4020}, "CS", "InputAvailabilityFlag", "1"}
-	tagDict[Tag{0x0040, 0x4021}] = TagInfo{Tag{0x0040, 0x4021}, "SQ", "InputInformationSequence", "1"}
-	tagDict[Tag{0x0040, 0x4022}] = TagInfo{Tag{0x0040, 0x4022}, "SQ", "RelevantInformationSequence", "1"}
-	tagDict[Tag{0x0040, 0x4023}] = TagInfo{Tag{0x0040, 0x4023}, "UI", "ReferencedGeneralPurposeScheduledProcedureStepTransactionUID", "1"}
-	tagDict[Tag{0x0040, 0x4025}] = TagInfo{Tag{0x0040, 0x4025}, "SQ", "ScheduledStationNameCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x4026}] = TagInfo{Tag{0x0040, 0x4026}, "SQ", "ScheduledStationClassCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x4027}] = TagInfo{Tag{0x0040, 0x4027}, "SQ", "ScheduledStationGeographicLocationCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x4028}] = TagInfo{Tag{0x0040, 0x4028}, "SQ", "PerformedStationNameCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x4029}] = TagInfo{Tag{0x0040, 0x4029}, "SQ", "PerformedStationClassCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x4030}] = TagInfo{Tag{0x0040, 0x4030}, "SQ", "PerformedStationGeographicLocationCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x4031}] = TagInfo{Tag{0x0040, 0x4031}, "SQ", "RequestedSubsequentWorkitemCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x4032}] = TagInfo{Tag{0x0040, 0x4032}, "SQ", "NonDICOMOutputCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x4033}] = TagInfo{Tag{0x0040, 0x4033}, "SQ", "OutputInformationSequence", "1"}
-	tagDict[Tag{0x0040, 0x4034}] = TagInfo{Tag{0x0040, 0x4034}, "SQ", "ScheduledHumanPerformersSequence", "1"}
-	tagDict[Tag{0x0040, 0x4035}] = TagInfo{Tag{0x0040, 0x4035}, "SQ", "ActualHumanPerformersSequence", "1"}
-	tagDict[Tag{0x0040, 0x4036}] = TagInfo{Tag{0x0040, 0x4036}, "LO", "HumanPerformerOrganization", "1"}
-	tagDict[Tag{0x0040, 0x4037}] = TagInfo{Tag{0x0040, 0x4037}, "PN", "HumanPerformerName", "1"}
-	tagDict[Tag{0x0040, 0x4040}] = TagInfo{Tag{0x0040, 0x4040}, "CS", "RawDataHandling", "1"}
-	tagDict[Tag{0x0040, 0x4041}] = TagInfo{Tag{0x0040, 0x4041}, "CS", "InputReadinessState", "1"}
-	tagDict[Tag{0x0040, 0x4050}] = TagInfo{Tag{0x0040, 0x4050}, "DT", "PerformedProcedureStepStartDateTime", "1"}
-	tagDict[Tag{0x0040, 0x4051}] = TagInfo{Tag{0x0040, 0x4051}, "DT", "PerformedProcedureStepEndDateTime", "1"}
-	tagDict[Tag{0x0040, 0x4052}] = TagInfo{Tag{0x0040, 0x4052}, "DT", "ProcedureStepCancellationDateTime", "1"}
-	tagDict[Tag{0x0040, 0x8302}] = TagInfo{Tag{0x0040, 0x8302}, "DS", "EntranceDoseInmGy", "1"}
-	tagDict[Tag{0x0040, 0x9094}] = TagInfo{Tag{0x0040, 0x9094}, "SQ", "ReferencedImageRealWorldValueMappingSequence", "1"}
-	tagDict[Tag{0x0040, 0x9096}] = TagInfo{Tag{0x0040, 0x9096}, "SQ", "RealWorldValueMappingSequence", "1"}
-	tagDict[Tag{0x0040, 0x9098}] = TagInfo{Tag{0x0040, 0x9098}, "SQ", "PixelValueMappingCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x9210}] = TagInfo{Tag{0x0040, 0x9210}, "SH", "LUTLabel", "1"}
-	tagDict[Tag{0x0040, 0x9211}] = TagInfo{Tag{0x0040, 0x9211}, "US", "RealWorldValueLastValueMapped", "1"}
-	tagDict[Tag{0x0040, 0x9212}] = TagInfo{Tag{0x0040, 0x9212}, "FD", "RealWorldValueLUTData", "1-n"}
-	tagDict[Tag{0x0040, 0x9216}] = TagInfo{Tag{0x0040, 0x9216}, "US", "RealWorldValueFirstValueMapped", "1"}
-	tagDict[Tag{0x0040, 0x9224}] = TagInfo{Tag{0x0040, 0x9224}, "FD", "RealWorldValueIntercept", "1"}
-	tagDict[Tag{0x0040, 0x9225}] = TagInfo{Tag{0x0040, 0x9225}, "FD", "RealWorldValueSlope", "1"}
-	tagDict[Tag{0x0040, 0xA010}] = TagInfo{Tag{0x0040, 0xA010}, "CS", "RelationshipType", "1"}
-	tagDict[Tag{0x0040, 0xA027}] = TagInfo{Tag{0x0040, 0xA027}, "LO", "VerifyingOrganization", "1"}
-	tagDict[Tag{0x0040, 0xA030}] = TagInfo{Tag{0x0040, 0xA030}, "DT", "VerificationDateTime", "1"}
-	tagDict[Tag{0x0040, 0xA032}] = TagInfo{Tag{0x0040, 0xA032}, "DT", "ObservationDateTime", "1"}
-	tagDict[Tag{0x0040, 0xA040}] = TagInfo{Tag{0x0040, 0xA040}, "CS", "ValueType", "1"}
-	tagDict[Tag{0x0040, 0xA043}] = TagInfo{Tag{0x0040, 0xA043}, "SQ", "ConceptNameCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0xA050}] = TagInfo{Tag{0x0040, 0xA050}, "CS", "ContinuityOfContent", "1"}
-	tagDict[Tag{0x0040, 0xA073}] = TagInfo{Tag{0x0040, 0xA073}, "SQ", "VerifyingObserverSequence", "1"}
-	tagDict[Tag{0x0040, 0xA075}] = TagInfo{Tag{0x0040, 0xA075}, "PN", "VerifyingObserverName", "1"}
-	tagDict[Tag{0x0040, 0xA078}] = TagInfo{Tag{0x0040, 0xA078}, "SQ", "AuthorObserverSequence", "1"}
-	tagDict[Tag{0x0040, 0xA07A}] = TagInfo{Tag{0x0040, 0xA07A}, "SQ", "ParticipantSequence", "1"}
-	tagDict[Tag{0x0040, 0xA07C}] = TagInfo{Tag{0x0040, 0xA07C}, "SQ", "CustodialOrganizationSequence", "1"}
-	tagDict[Tag{0x0040, 0xA080}] = TagInfo{Tag{0x0040, 0xA080}, "CS", "ParticipationType", "1"}
-	tagDict[Tag{0x0040, 0xA082}] = TagInfo{Tag{0x0040, 0xA082}, "DT", "ParticipationDateTime", "1"}
-	tagDict[Tag{0x0040, 0xA084}] = TagInfo{Tag{0x0040, 0xA084}, "CS", "ObserverType", "1"}
-	tagDict[Tag{0x0040, 0xA088}] = TagInfo{Tag{0x0040, 0xA088}, "SQ", "VerifyingObserverIdentificationCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0xA0B0}] = TagInfo{Tag{0x0040, 0xA0B0}, "US", "ReferencedWaveformChannels", "2-2n"}
-	tagDict[Tag{0x0040, 0xA120}] = TagInfo{Tag{0x0040, 0xA120}, "DT", "DateTime", "1"}
-	tagDict[Tag{0x0040, 0xA121}] = TagInfo{Tag{0x0040, 0xA121}, "DA", "Date", "1"}
-	tagDict[Tag{0x0040, 0xA122}] = TagInfo{Tag{0x0040, 0xA122}, "TM", "Time", "1"}
-	tagDict[Tag{0x0040, 0xA123}] = TagInfo{Tag{0x0040, 0xA123}, "PN", "PersonName", "1"}
-	tagDict[Tag{0x0040, 0xA124}] = TagInfo{Tag{0x0040, 0xA124}, "UI", "UID", "1"}
-	tagDict[Tag{0x0040, 0xA130}] = TagInfo{Tag{0x0040, 0xA130}, "CS", "TemporalRangeType", "1"}
-	tagDict[Tag{0x0040, 0xA132}] = TagInfo{Tag{0x0040, 0xA132}, "UL", "ReferencedSamplePositions", "1-n"}
-	tagDict[Tag{0x0040, 0xA136}] = TagInfo{Tag{0x0040, 0xA136}, "US", "ReferencedFrameNumbers", "1-n"}
-	tagDict[Tag{0x0040, 0xA138}] = TagInfo{Tag{0x0040, 0xA138}, "DS", "ReferencedTimeOffsets", "1-n"}
-	tagDict[Tag{0x0040, 0xA13A}] = TagInfo{Tag{0x0040, 0xA13A}, "DT", "ReferencedDateTime", "1-n"}
-	tagDict[Tag{0x0040, 0xA160}] = TagInfo{Tag{0x0040, 0xA160}, "UT", "TextValue", "1"}
-	tagDict[Tag{0x0040, 0xA168}] = TagInfo{Tag{0x0040, 0xA168}, "SQ", "ConceptCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0xA170}] = TagInfo{Tag{0x0040, 0xA170}, "SQ", "PurposeOfReferenceCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0xA180}] = TagInfo{Tag{0x0040, 0xA180}, "US", "AnnotationGroupNumber", "1"}
-	tagDict[Tag{0x0040, 0xA195}] = TagInfo{Tag{0x0040, 0xA195}, "SQ", "ModifierCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0xA300}] = TagInfo{Tag{0x0040, 0xA300}, "SQ", "MeasuredValueSequence", "1"}
-	tagDict[Tag{0x0040, 0xA301}] = TagInfo{Tag{0x0040, 0xA301}, "SQ", "NumericValueQualifierCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0xA30A}] = TagInfo{Tag{0x0040, 0xA30A}, "DS", "PropertyValue", "1-n"}
-	tagDict[Tag{0x0040, 0xA360}] = TagInfo{Tag{0x0040, 0xA360}, "SQ", "PredecessorDocumentsSequence", "1"}
-	tagDict[Tag{0x0040, 0xA370}] = TagInfo{Tag{0x0040, 0xA370}, "SQ", "ReferencedRequestSequence", "1"}
-	tagDict[Tag{0x0040, 0xA372}] = TagInfo{Tag{0x0040, 0xA372}, "SQ", "PerformedProcedureCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0xA375}] = TagInfo{Tag{0x0040, 0xA375}, "SQ", "CurrentRequestedProcedureEvidenceSequence", "1"}
-	tagDict[Tag{0x0040, 0xA385}] = TagInfo{Tag{0x0040, 0xA385}, "SQ", "PertinentOtherEvidenceSequence", "1"}
-	tagDict[Tag{0x0040, 0xA390}] = TagInfo{Tag{0x0040, 0xA390}, "SQ", "HL7StructuredDocumentReferenceSequence", "1"}
-	tagDict[Tag{0x0040, 0xA491}] = TagInfo{Tag{0x0040, 0xA491}, "CS", "CompletionFlag", "1"}
-	tagDict[Tag{0x0040, 0xA492}] = TagInfo{Tag{0x0040, 0xA492}, "LO", "CompletionFlagDescription", "1"}
-	tagDict[Tag{0x0040, 0xA493}] = TagInfo{Tag{0x0040, 0xA493}, "CS", "VerificationFlag", "1"}
-	tagDict[Tag{0x0040, 0xA494}] = TagInfo{Tag{0x0040, 0xA494}, "CS", "ArchiveRequested", "1"}
-	tagDict[Tag{0x0040, 0xA496}] = TagInfo{Tag{0x0040, 0xA496}, "CS", "PreliminaryFlag", "1"}
-	tagDict[Tag{0x0040, 0xA504}] = TagInfo{Tag{0x0040, 0xA504}, "SQ", "ContentTemplateSequence", "1"}
-	tagDict[Tag{0x0040, 0xA525}] = TagInfo{Tag{0x0040, 0xA525}, "SQ", "IdenticalDocumentsSequence", "1"}
-	tagDict[Tag{0x0040, 0xA730}] = TagInfo{Tag{0x0040, 0xA730}, "SQ", "ContentSequence", "1"}
-	tagDict[Tag{0x0040, 0xB020}] = TagInfo{Tag{0x0040, 0xB020}, "SQ", "WaveformAnnotationSequence", "1"}
-	tagDict[Tag{0x0040, 0xDB00}] = TagInfo{Tag{0x0040, 0xDB00}, "CS", "TemplateIdentifier", "1"}
-	tagDict[Tag{0x0040, 0xDB73}] = TagInfo{Tag{0x0040, 0xDB73}, "UL", "ReferencedContentItemIdentifier", "1-n"}
-	tagDict[Tag{0x0040, 0xE001}] = TagInfo{Tag{0x0040, 0xE001}, "ST", "HL7InstanceIdentifier", "1"}
-	tagDict[Tag{0x0040, 0xE004}] = TagInfo{Tag{0x0040, 0xE004}, "DT", "HL7DocumentEffectiveTime", "1"}
-	tagDict[Tag{0x0040, 0xE006}] = TagInfo{Tag{0x0040, 0xE006}, "SQ", "HL7DocumentTypeCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0xE008}] = TagInfo{Tag{0x0040, 0xE008}, "SQ", "DocumentClassCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0xE010}] = TagInfo{Tag{0x0040, 0xE010}, "UT", "RetrieveURI", "1"}
-	tagDict[Tag{0x0040, 0xE011}] = TagInfo{Tag{0x0040, 0xE011}, "UI", "RetrieveLocationUID", "1"}
-	tagDict[Tag{0x0040, 0xE020}] = TagInfo{Tag{0x0040, 0xE020}, "CS", "TypeOfInstances", "1"}
-	tagDict[Tag{0x0040, 0xE021}] = TagInfo{Tag{0x0040, 0xE021}, "SQ", "DICOMRetrievalSequence", "1"}
-	tagDict[Tag{0x0040, 0xE022}] = TagInfo{Tag{0x0040, 0xE022}, "SQ", "DICOMMediaRetrievalSequence", "1"}
-	tagDict[Tag{0x0040, 0xE023}] = TagInfo{Tag{0x0040, 0xE023}, "SQ", "WADORetrievalSequence", "1"}
-	tagDict[Tag{0x0040, 0xE024}] = TagInfo{Tag{0x0040, 0xE024}, "SQ", "XDSRetrievalSequence", "1"}
-	tagDict[Tag{0x0040, 0xE030}] = TagInfo{Tag{0x0040, 0xE030}, "UI", "RepositoryUniqueID", "1"}
-	tagDict[Tag{0x0040, 0xE031}] = TagInfo{Tag{0x0040, 0xE031}, "UI", "HomeCommunityID", "1"}
-	tagDict[Tag{0x0042, 0x0010}] = TagInfo{Tag{0x0042, 0x0010}, "ST", "DocumentTitle", "1"}
-	tagDict[Tag{0x0042, 0x0011}] = TagInfo{Tag{0x0042, 0x0011}, "OB", "EncapsulatedDocument", "1"}
-	tagDict[Tag{0x0042, 0x0012}] = TagInfo{Tag{0x0042, 0x0012}, "LO", "MIMETypeOfEncapsulatedDocument", "1"}
-	tagDict[Tag{0x0042, 0x0013}] = TagInfo{Tag{0x0042, 0x0013}, "SQ", "SourceInstanceSequence", "1"}
-	tagDict[Tag{0x0042, 0x0014}] = TagInfo{Tag{0x0042, 0x0014}, "LO", "ListOfMIMETypes", "1-n"}
-	tagDict[Tag{0x0044, 0x0001}] = TagInfo{Tag{0x0044, 0x0001}, "ST", "ProductPackageIdentifier", "1"}
-	tagDict[Tag{0x0044, 0x0002}] = TagInfo{Tag{0x0044, 0x0002}, "CS", "SubstanceAdministrationApproval", "1"}
-	tagDict[Tag{0x0044, 0x0003}] = TagInfo{Tag{0x0044, 0x0003}, "LT", "ApprovalStatusFurtherDescription", "1"}
-	tagDict[Tag{0x0044, 0x0004}] = TagInfo{Tag{0x0044, 0x0004}, "DT", "ApprovalStatusDateTime", "1"}
-	tagDict[Tag{0x0044, 0x0007}] = TagInfo{Tag{0x0044, 0x0007}, "SQ", "ProductTypeCodeSequence", "1"}
-	tagDict[Tag{0x0044, 0x0008}] = TagInfo{Tag{0x0044, 0x0008}, "LO", "ProductName", "1-n"}
-	tagDict[Tag{0x0044, 0x0009}] = TagInfo{Tag{0x0044, 0x0009}, "LT", "ProductDescription", "1"}
-	tagDict[Tag{0x0044, 0x000A}] = TagInfo{Tag{0x0044, 0x000A}, "LO", "ProductLotIdentifier", "1"}
-	tagDict[Tag{0x0044, 0x000B}] = TagInfo{Tag{0x0044, 0x000B}, "DT", "ProductExpirationDateTime", "1"}
-	tagDict[Tag{0x0044, 0x0010}] = TagInfo{Tag{0x0044, 0x0010}, "DT", "SubstanceAdministrationDateTime", "1"}
-	tagDict[Tag{0x0044, 0x0011}] = TagInfo{Tag{0x0044, 0x0011}, "LO", "SubstanceAdministrationNotes", "1"}
-	tagDict[Tag{0x0044, 0x0012}] = TagInfo{Tag{0x0044, 0x0012}, "LO", "SubstanceAdministrationDeviceID", "1"}
-	tagDict[Tag{0x0044, 0x0013}] = TagInfo{Tag{0x0044, 0x0013}, "SQ", "ProductParameterSequence", "1"}
-	tagDict[Tag{0x0044, 0x0019}] = TagInfo{Tag{0x0044, 0x0019}, "SQ", "SubstanceAdministrationParameterSequence", "1"}
-	tagDict[Tag{0x0046, 0x0012}] = TagInfo{Tag{0x0046, 0x0012}, "LO", "LensDescription", "1"}
-	tagDict[Tag{0x0046, 0x0014}] = TagInfo{Tag{0x0046, 0x0014}, "SQ", "RightLensSequence", "1"}
-	tagDict[Tag{0x0046, 0x0015}] = TagInfo{Tag{0x0046, 0x0015}, "SQ", "LeftLensSequence", "1"}
-	tagDict[Tag{0x0046, 0x0016}] = TagInfo{Tag{0x0046, 0x0016}, "SQ", "UnspecifiedLateralityLensSequence", "1"}
-	tagDict[Tag{0x0046, 0x0018}] = TagInfo{Tag{0x0046, 0x0018}, "SQ", "CylinderSequence", "1"}
-	tagDict[Tag{0x0046, 0x0028}] = TagInfo{Tag{0x0046, 0x0028}, "SQ", "PrismSequence", "1"}
-	tagDict[Tag{0x0046, 0x0030}] = TagInfo{Tag{0x0046, 0x0030}, "FD", "HorizontalPrismPower", "1"}
-	tagDict[Tag{0x0046, 0x0032}] = TagInfo{Tag{0x0046, 0x0032}, "CS", "HorizontalPrismBase", "1"}
-	tagDict[Tag{0x0046, 0x0034}] = TagInfo{Tag{0x0046, 0x0034}, "FD", "VerticalPrismPower", "1"}
-	tagDict[Tag{0x0046, 0x0036}] = TagInfo{Tag{0x0046, 0x0036}, "CS", "VerticalPrismBase", "1"}
-	tagDict[Tag{0x0046, 0x0038}] = TagInfo{Tag{0x0046, 0x0038}, "CS", "LensSegmentType", "1"}
-	tagDict[Tag{0x0046, 0x0040}] = TagInfo{Tag{0x0046, 0x0040}, "FD", "OpticalTransmittance", "1"}
-	tagDict[Tag{0x0046, 0x0042}] = TagInfo{Tag{0x0046, 0x0042}, "FD", "ChannelWidth", "1"}
-	tagDict[Tag{0x0046, 0x0044}] = TagInfo{Tag{0x0046, 0x0044}, "FD", "PupilSize", "1"}
-	tagDict[Tag{0x0046, 0x0046}] = TagInfo{Tag{0x0046, 0x0046}, "FD", "CornealSize", "1"}
-	tagDict[Tag{0x0046, 0x0050}] = TagInfo{Tag{0x0046, 0x0050}, "SQ", "AutorefractionRightEyeSequence", "1"}
-	tagDict[Tag{0x0046, 0x0052}] = TagInfo{Tag{0x0046, 0x0052}, "SQ", "AutorefractionLeftEyeSequence", "1"}
-	tagDict[Tag{0x0046, 0x0060}] = TagInfo{Tag{0x0046, 0x0060}, "FD", "DistancePupillaryDistance", "1"}
-	tagDict[Tag{0x0046, 0x0062}] = TagInfo{Tag{0x0046, 0x0062}, "FD", "NearPupillaryDistance", "1"}
-	tagDict[Tag{0x0046, 0x0063}] = TagInfo{Tag{0x0046, 0x0063}, "FD", "IntermediatePupillaryDistance", "1"}
-	tagDict[Tag{0x0046, 0x0064}] = TagInfo{Tag{0x0046, 0x0064}, "FD", "OtherPupillaryDistance", "1"}
-	tagDict[Tag{0x0046, 0x0070}] = TagInfo{Tag{0x0046, 0x0070}, "SQ", "KeratometryRightEyeSequence", "1"}
-	tagDict[Tag{0x0046, 0x0071}] = TagInfo{Tag{0x0046, 0x0071}, "SQ", "KeratometryLeftEyeSequence", "1"}
-	tagDict[Tag{0x0046, 0x0074}] = TagInfo{Tag{0x0046, 0x0074}, "SQ", "SteepKeratometricAxisSequence", "1"}
-	tagDict[Tag{0x0046, 0x0075}] = TagInfo{Tag{0x0046, 0x0075}, "FD", "RadiusOfCurvature", "1"}
-	tagDict[Tag{0x0046, 0x0076}] = TagInfo{Tag{0x0046, 0x0076}, "FD", "KeratometricPower", "1"}
-	tagDict[Tag{0x0046, 0x0077}] = TagInfo{Tag{0x0046, 0x0077}, "FD", "KeratometricAxis", "1"}
-	tagDict[Tag{0x0046, 0x0080}] = TagInfo{Tag{0x0046, 0x0080}, "SQ", "FlatKeratometricAxisSequence", "1"}
-	tagDict[Tag{0x0046, 0x0092}] = TagInfo{Tag{0x0046, 0x0092}, "CS", "BackgroundColor", "1"}
-	tagDict[Tag{0x0046, 0x0094}] = TagInfo{Tag{0x0046, 0x0094}, "CS", "Optotype", "1"}
-	tagDict[Tag{0x0046, 0x0095}] = TagInfo{Tag{0x0046, 0x0095}, "CS", "OptotypePresentation", "1"}
-	tagDict[Tag{0x0046, 0x0097}] = TagInfo{Tag{0x0046, 0x0097}, "SQ", "SubjectiveRefractionRightEyeSequence", "1"}
-	tagDict[Tag{0x0046, 0x0098}] = TagInfo{Tag{0x0046, 0x0098}, "SQ", "SubjectiveRefractionLeftEyeSequence", "1"}
-	tagDict[Tag{0x0046, 0x0100}] = TagInfo{Tag{0x0046, 0x0100}, "SQ", "AddNearSequence", "1"}
-	tagDict[Tag{0x0046, 0x0101}] = TagInfo{Tag{0x0046, 0x0101}, "SQ", "AddIntermediateSequence", "1"}
-	tagDict[Tag{0x0046, 0x0102}] = TagInfo{Tag{0x0046, 0x0102}, "SQ", "AddOtherSequence", "1"}
-	tagDict[Tag{0x0046, 0x0104}] = TagInfo{Tag{0x0046, 0x0104}, "FD", "AddPower", "1"}
-	tagDict[Tag{0x0046, 0x0106}] = TagInfo{Tag{0x0046, 0x0106}, "FD", "ViewingDistance", "1"}
-	tagDict[Tag{0x0046, 0x0121}] = TagInfo{Tag{0x0046, 0x0121}, "SQ", "VisualAcuityTypeCodeSequence", "1"}
-	tagDict[Tag{0x0046, 0x0122}] = TagInfo{Tag{0x0046, 0x0122}, "SQ", "VisualAcuityRightEyeSequence", "1"}
-	tagDict[Tag{0x0046, 0x0123}] = TagInfo{Tag{0x0046, 0x0123}, "SQ", "VisualAcuityLeftEyeSequence", "1"}
-	tagDict[Tag{0x0046, 0x0124}] = TagInfo{Tag{0x0046, 0x0124}, "SQ", "VisualAcuityBothEyesOpenSequence", "1"}
-	tagDict[Tag{0x0046, 0x0125}] = TagInfo{Tag{0x0046, 0x0125}, "CS", "ViewingDistanceType", "1"}
-	tagDict[Tag{0x0046, 0x0135}] = TagInfo{Tag{0x0046, 0x0135}, "SS", "VisualAcuityModifiers", "2"}
-	tagDict[Tag{0x0046, 0x0137}] = TagInfo{Tag{0x0046, 0x0137}, "FD", "DecimalVisualAcuity", "1"}
-	tagDict[Tag{0x0046, 0x0139}] = TagInfo{Tag{0x0046, 0x0139}, "LO", "OptotypeDetailedDefinition", "1"}
-	tagDict[Tag{0x0046, 0x0145}] = TagInfo{Tag{0x0046, 0x0145}, "SQ", "ReferencedRefractiveMeasurementsSequence", "1"}
-	tagDict[Tag{0x0046, 0x0146}] = TagInfo{Tag{0x0046, 0x0146}, "FD", "SpherePower", "1"}
-	tagDict[Tag{0x0046, 0x0147}] = TagInfo{Tag{0x0046, 0x0147}, "FD", "CylinderPower", "1"}
-	tagDict[Tag{0x0048, 0x0001}] = TagInfo{Tag{0x0048, 0x0001}, "FL", "ImagedVolumeWidth", "1"}
-	tagDict[Tag{0x0048, 0x0002}] = TagInfo{Tag{0x0048, 0x0002}, "FL", "ImagedVolumeHeight", "1"}
-	tagDict[Tag{0x0048, 0x0003}] = TagInfo{Tag{0x0048, 0x0003}, "FL", "ImagedVolumeDepth", "1"}
-	tagDict[Tag{0x0048, 0x0006}] = TagInfo{Tag{0x0048, 0x0006}, "UL", "TotalPixelMatrixColumns", "1"}
-	tagDict[Tag{0x0048, 0x0007}] = TagInfo{Tag{0x0048, 0x0007}, "UL", "TotalPixelMatrixRows", "1"}
-	tagDict[Tag{0x0048, 0x0008}] = TagInfo{Tag{0x0048, 0x0008}, "SQ", "TotalPixelMatrixOriginSequence", "1"}
-	tagDict[Tag{0x0048, 0x0010}] = TagInfo{Tag{0x0048, 0x0010}, "CS", "SpecimenLabelInImage", "1"}
-	tagDict[Tag{0x0048, 0x0011}] = TagInfo{Tag{0x0048, 0x0011}, "CS", "FocusMethod", "1"}
-	tagDict[Tag{0x0048, 0x0012}] = TagInfo{Tag{0x0048, 0x0012}, "CS", "ExtendedDepthOfField", "1"}
-	tagDict[Tag{0x0048, 0x0013}] = TagInfo{Tag{0x0048, 0x0013}, "US", "NumberOfFocalPlanes", "1"}
-	tagDict[Tag{0x0048, 0x0014}] = TagInfo{Tag{0x0048, 0x0014}, "FL", "DistanceBetweenFocalPlanes", "1"}
-	tagDict[Tag{0x0048, 0x0015}] = TagInfo{Tag{0x0048, 0x0015}, "US", "RecommendedAbsentPixelCIELabValue", "3"}
-	tagDict[Tag{0x0048, 0x0100}] = TagInfo{Tag{0x0048, 0x0100}, "SQ", "IlluminatorTypeCodeSequence", "1"}
-	tagDict[Tag{0x0048, 0x0102}] = TagInfo{Tag{0x0048, 0x0102}, "DS", "ImageOrientationSlide", "6"}
-	tagDict[Tag{0x0048, 0x0105}] = TagInfo{Tag{0x0048, 0x0105}, "SQ", "OpticalPathSequence", "1"}
-	tagDict[Tag{0x0048, 0x0106}] = TagInfo{Tag{0x0048, 0x0106}, "SH", "OpticalPathIdentifier", "1"}
-	tagDict[Tag{0x0048, 0x0107}] = TagInfo{Tag{0x0048, 0x0107}, "ST", "OpticalPathDescription", "1"}
-	tagDict[Tag{0x0048, 0x0108}] = TagInfo{Tag{0x0048, 0x0108}, "SQ", "IlluminationColorCodeSequence", "1"}
-	tagDict[Tag{0x0048, 0x0110}] = TagInfo{Tag{0x0048, 0x0110}, "SQ", "SpecimenReferenceSequence", "1"}
-	tagDict[Tag{0x0048, 0x0111}] = TagInfo{Tag{0x0048, 0x0111}, "DS", "CondenserLensPower", "1"}
-	tagDict[Tag{0x0048, 0x0112}] = TagInfo{Tag{0x0048, 0x0112}, "DS", "ObjectiveLensPower", "1"}
-	tagDict[Tag{0x0048, 0x0113}] = TagInfo{Tag{0x0048, 0x0113}, "DS", "ObjectiveLensNumericalAperture", "1"}
-	tagDict[Tag{0x0048, 0x0120}] = TagInfo{Tag{0x0048, 0x0120}, "SQ", "PaletteColorLookupTableSequence", "1"}
-	tagDict[Tag{0x0048, 0x0200}] = TagInfo{Tag{0x0048, 0x0200}, "SQ", "ReferencedImageNavigationSequence", "1"}
-	tagDict[Tag{0x0048, 0x0201}] = TagInfo{Tag{0x0048, 0x0201}, "US", "TopLeftHandCornerOfLocalizerArea", "2"}
-	tagDict[Tag{0x0048, 0x0202}] = TagInfo{Tag{0x0048, 0x0202}, "US", "BottomRightHandCornerOfLocalizerArea", "2"}
-	tagDict[Tag{0x0048, 0x0207}] = TagInfo{Tag{0x0048, 0x0207}, "SQ", "OpticalPathIdentificationSequence", "1"}
-	tagDict[Tag{0x0048, 0x021A}] = TagInfo{Tag{0x0048, 0x021A}, "SQ", "PlanePositionSlideSequence", "1"}
-	tagDict[Tag{0x0048, 0x021E}] = TagInfo{Tag{0x0048, 0x021E}, "SL", "ColumnPositionInTotalImagePixelMatrix", "1"}
-	tagDict[Tag{0x0048, 0x021F}] = TagInfo{Tag{0x0048, 0x021F}, "SL", "RowPositionInTotalImagePixelMatrix", "1"}
-	tagDict[Tag{0x0048, 0x0301}] = TagInfo{Tag{0x0048, 0x0301}, "CS", "PixelOriginInterpretation", "1"}
-	tagDict[Tag{0x0050, 0x0004}] = TagInfo{Tag{0x0050, 0x0004}, "CS", "CalibrationImage", "1"}
-	tagDict[Tag{0x0050, 0x0010}] = TagInfo{Tag{0x0050, 0x0010}, "SQ", "DeviceSequence", "1"}
-	tagDict[Tag{0x0050, 0x0012}] = TagInfo{Tag{0x0050, 0x0012}, "SQ", "ContainerComponentTypeCodeSequence", "1"}
-	tagDict[Tag{0x0050, 0x0013}] = TagInfo{Tag{0x0050, 0x0013}, "FD", "ContainerComponentThickness", "1"}
-	tagDict[Tag{0x0050, 0x0014}] = TagInfo{Tag{0x0050, 0x0014}, "DS", "DeviceLength", "1"}
-	tagDict[Tag{0x0050, 0x0015}] = TagInfo{Tag{0x0050, 0x0015}, "FD", "ContainerComponentWidth", "1"}
-	tagDict[Tag{0x0050, 0x0016}] = TagInfo{Tag{0x0050, 0x0016}, "DS", "DeviceDiameter", "1"}
-	tagDict[Tag{0x0050, 0x0017}] = TagInfo{Tag{0x0050, 0x0017}, "CS", "DeviceDiameterUnits", "1"}
-	tagDict[Tag{0x0050, 0x0018}] = TagInfo{Tag{0x0050, 0x0018}, "DS", "DeviceVolume", "1"}
-	tagDict[Tag{0x0050, 0x0019}] = TagInfo{Tag{0x0050, 0x0019}, "DS", "InterMarkerDistance", "1"}
-	tagDict[Tag{0x0050, 0x001A}] = TagInfo{Tag{0x0050, 0x001A}, "CS", "ContainerComponentMaterial", "1"}
-	tagDict[Tag{0x0050, 0x001B}] = TagInfo{Tag{0x0050, 0x001B}, "LO", "ContainerComponentID", "1"}
-	tagDict[Tag{0x0050, 0x001C}] = TagInfo{Tag{0x0050, 0x001C}, "FD", "ContainerComponentLength", "1"}
-	tagDict[Tag{0x0050, 0x001D}] = TagInfo{Tag{0x0050, 0x001D}, "FD", "ContainerComponentDiameter", "1"}
-	tagDict[Tag{0x0050, 0x001E}] = TagInfo{Tag{0x0050, 0x001E}, "LO", "ContainerComponentDescription", "1"}
-	tagDict[Tag{0x0050, 0x0020}] = TagInfo{Tag{0x0050, 0x0020}, "LO", "DeviceDescription", "1"}
-	tagDict[Tag{0x0052, 0x0001}] = TagInfo{Tag{0x0052, 0x0001}, "FL", "ContrastBolusIngredientPercentByVolume", "1"}
-	tagDict[Tag{0x0052, 0x0002}] = TagInfo{Tag{0x0052, 0x0002}, "FD", "OCTFocalDistance", "1"}
-	tagDict[Tag{0x0052, 0x0003}] = TagInfo{Tag{0x0052, 0x0003}, "FD", "BeamSpotSize", "1"}
-	tagDict[Tag{0x0052, 0x0004}] = TagInfo{Tag{0x0052, 0x0004}, "FD", "EffectiveRefractiveIndex", "1"}
-	tagDict[Tag{0x0052, 0x0006}] = TagInfo{Tag{0x0052, 0x0006}, "CS", "OCTAcquisitionDomain", "1"}
-	tagDict[Tag{0x0052, 0x0007}] = TagInfo{Tag{0x0052, 0x0007}, "FD", "OCTOpticalCenterWavelength", "1"}
-	tagDict[Tag{0x0052, 0x0008}] = TagInfo{Tag{0x0052, 0x0008}, "FD", "AxialResolution", "1"}
-	tagDict[Tag{0x0052, 0x0009}] = TagInfo{Tag{0x0052, 0x0009}, "FD", "RangingDepth", "1"}
-	tagDict[Tag{0x0052, 0x0011}] = TagInfo{Tag{0x0052, 0x0011}, "FD", "ALineRate", "1"}
-	tagDict[Tag{0x0052, 0x0012}] = TagInfo{Tag{0x0052, 0x0012}, "US", "ALinesPerFrame", "1"}
-	tagDict[Tag{0x0052, 0x0013}] = TagInfo{Tag{0x0052, 0x0013}, "FD", "CatheterRotationalRate", "1"}
-	tagDict[Tag{0x0052, 0x0014}] = TagInfo{Tag{0x0052, 0x0014}, "FD", "ALinePixelSpacing", "1"}
-	tagDict[Tag{0x0052, 0x0016}] = TagInfo{Tag{0x0052, 0x0016}, "SQ", "ModeOfPercutaneousAccessSequence", "1"}
-	tagDict[Tag{0x0052, 0x0025}] = TagInfo{Tag{0x0052, 0x0025}, "SQ", "IntravascularOCTFrameTypeSequence", "1"}
-	tagDict[Tag{0x0052, 0x0026}] = TagInfo{Tag{0x0052, 0x0026}, "CS", "OCTZOffsetApplied", "1"}
-	tagDict[Tag{0x0052, 0x0027}] = TagInfo{Tag{0x0052, 0x0027}, "SQ", "IntravascularFrameContentSequence", "1"}
-	tagDict[Tag{0x0052, 0x0028}] = TagInfo{Tag{0x0052, 0x0028}, "FD", "IntravascularLongitudinalDistance", "1"}
-	tagDict[Tag{0x0052, 0x0029}] = TagInfo{Tag{0x0052, 0x0029}, "SQ", "IntravascularOCTFrameContentSequence", "1"}
-	tagDict[Tag{0x0052, 0x0030}] = TagInfo{Tag{0x0052, 0x0030}, "SS", "OCTZOffsetCorrection", "1"}
-	tagDict[Tag{0x0052, 0x0031}] = TagInfo{Tag{0x0052, 0x0031}, "CS", "CatheterDirectionOfRotation", "1"}
-	tagDict[Tag{0x0052, 0x0033}] = TagInfo{Tag{0x0052, 0x0033}, "FD", "SeamLineLocation", "1"}
-	tagDict[Tag{0x0052, 0x0034}] = TagInfo{Tag{0x0052, 0x0034}, "FD", "FirstALineLocation", "1"}
-	tagDict[Tag{0x0052, 0x0036}] = TagInfo{Tag{0x0052, 0x0036}, "US", "SeamLineIndex", "1"}
-	tagDict[Tag{0x0052, 0x0038}] = TagInfo{Tag{0x0052, 0x0038}, "US", "NumberOfPaddedAlines", "1"}
-	tagDict[Tag{0x0052, 0x0039}] = TagInfo{Tag{0x0052, 0x0039}, "CS", "InterpolationType", "1"}
-	tagDict[Tag{0x0052, 0x003A}] = TagInfo{Tag{0x0052, 0x003A}, "CS", "RefractiveIndexApplied", "1"}
-	tagDict[Tag{0x0054, 0x0010}] = TagInfo{Tag{0x0054, 0x0010}, "US", "EnergyWindowVector", "1-n"}
-	tagDict[Tag{0x0054, 0x0011}] = TagInfo{Tag{0x0054, 0x0011}, "US", "NumberOfEnergyWindows", "1"}
-	tagDict[Tag{0x0054, 0x0012}] = TagInfo{Tag{0x0054, 0x0012}, "SQ", "EnergyWindowInformationSequence", "1"}
-	tagDict[Tag{0x0054, 0x0013}] = TagInfo{Tag{0x0054, 0x0013}, "SQ", "EnergyWindowRangeSequence", "1"}
-	tagDict[Tag{0x0054, 0x0014}] = TagInfo{Tag{0x0054, 0x0014}, "DS", "EnergyWindowLowerLimit", "1"}
-	tagDict[Tag{0x0054, 0x0015}] = TagInfo{Tag{0x0054, 0x0015}, "DS", "EnergyWindowUpperLimit", "1"}
-	tagDict[Tag{0x0054, 0x0016}] = TagInfo{Tag{0x0054, 0x0016}, "SQ", "RadiopharmaceuticalInformationSequence", "1"}
-	tagDict[Tag{0x0054, 0x0017}] = TagInfo{Tag{0x0054, 0x0017}, "IS", "ResidualSyringeCounts", "1"}
-	tagDict[Tag{0x0054, 0x0018}] = TagInfo{Tag{0x0054, 0x0018}, "SH", "EnergyWindowName", "1"}
-	tagDict[Tag{0x0054, 0x0020}] = TagInfo{Tag{0x0054, 0x0020}, "US", "DetectorVector", "1-n"}
-	tagDict[Tag{0x0054, 0x0021}] = TagInfo{Tag{0x0054, 0x0021}, "US", "NumberOfDetectors", "1"}
-	tagDict[Tag{0x0054, 0x0022}] = TagInfo{Tag{0x0054, 0x0022}, "SQ", "DetectorInformationSequence", "1"}
-	tagDict[Tag{0x0054, 0x0030}] = TagInfo{Tag{0x0054, 0x0030}, "US", "PhaseVector", "1-n"}
-	tagDict[Tag{0x0054, 0x0031}] = TagInfo{Tag{0x0054, 0x0031}, "US", "NumberOfPhases", "1"}
-	tagDict[Tag{0x0054, 0x0032}] = TagInfo{Tag{0x0054, 0x0032}, "SQ", "PhaseInformationSequence", "1"}
-	tagDict[Tag{0x0054, 0x0033}] = TagInfo{Tag{0x0054, 0x0033}, "US", "NumberOfFramesInPhase", "1"}
-	tagDict[Tag{0x0054, 0x0036}] = TagInfo{Tag{0x0054, 0x0036}, "IS", "PhaseDelay", "1"}
-	tagDict[Tag{0x0054, 0x0038}] = TagInfo{Tag{0x0054, 0x0038}, "IS", "PauseBetweenFrames", "1"}
-	tagDict[Tag{0x0054, 0x0039}] = TagInfo{Tag{0x0054, 0x0039}, "CS", "PhaseDescription", "1"}
-	tagDict[Tag{0x0054, 0x0050}] = TagInfo{Tag{0x0054, 0x0050}, "US", "RotationVector", "1-n"}
-	tagDict[Tag{0x0054, 0x0051}] = TagInfo{Tag{0x0054, 0x0051}, "US", "NumberOfRotations", "1"}
-	tagDict[Tag{0x0054, 0x0052}] = TagInfo{Tag{0x0054, 0x0052}, "SQ", "RotationInformationSequence", "1"}
-	tagDict[Tag{0x0054, 0x0053}] = TagInfo{Tag{0x0054, 0x0053}, "US", "NumberOfFramesInRotation", "1"}
-	tagDict[Tag{0x0054, 0x0060}] = TagInfo{Tag{0x0054, 0x0060}, "US", "RRIntervalVector", "1-n"}
-	tagDict[Tag{0x0054, 0x0061}] = TagInfo{Tag{0x0054, 0x0061}, "US", "NumberOfRRIntervals", "1"}
-	tagDict[Tag{0x0054, 0x0062}] = TagInfo{Tag{0x0054, 0x0062}, "SQ", "GatedInformationSequence", "1"}
-	tagDict[Tag{0x0054, 0x0063}] = TagInfo{Tag{0x0054, 0x0063}, "SQ", "DataInformationSequence", "1"}
-	tagDict[Tag{0x0054, 0x0070}] = TagInfo{Tag{0x0054, 0x0070}, "US", "TimeSlotVector", "1-n"}
-	tagDict[Tag{0x0054, 0x0071}] = TagInfo{Tag{0x0054, 0x0071}, "US", "NumberOfTimeSlots", "1"}
-	tagDict[Tag{0x0054, 0x0072}] = TagInfo{Tag{0x0054, 0x0072}, "SQ", "TimeSlotInformationSequence", "1"}
-	tagDict[Tag{0x0054, 0x0073}] = TagInfo{Tag{0x0054, 0x0073}, "DS", "TimeSlotTime", "1"}
-	tagDict[Tag{0x0054, 0x0080}] = TagInfo{Tag{0x0054, 0x0080}, "US", "SliceVector", "1-n"}
-	tagDict[Tag{0x0054, 0x0081}] = TagInfo{Tag{0x0054, 0x0081}, "US", "NumberOfSlices", "1"}
-	tagDict[Tag{0x0054, 0x0090}] = TagInfo{Tag{0x0054, 0x0090}, "US", "AngularViewVector", "1-n"}
-	tagDict[Tag{0x0054, 0x0100}] = TagInfo{Tag{0x0054, 0x0100}, "US", "TimeSliceVector", "1-n"}
-	tagDict[Tag{0x0054, 0x0101}] = TagInfo{Tag{0x0054, 0x0101}, "US", "NumberOfTimeSlices", "1"}
-	tagDict[Tag{0x0054, 0x0200}] = TagInfo{Tag{0x0054, 0x0200}, "DS", "StartAngle", "1"}
-	tagDict[Tag{0x0054, 0x0202}] = TagInfo{Tag{0x0054, 0x0202}, "CS", "TypeOfDetectorMotion", "1"}
-	tagDict[Tag{0x0054, 0x0210}] = TagInfo{Tag{0x0054, 0x0210}, "IS", "TriggerVector", "1-n"}
-	tagDict[Tag{0x0054, 0x0211}] = TagInfo{Tag{0x0054, 0x0211}, "US", "NumberOfTriggersInPhase", "1"}
-	tagDict[Tag{0x0054, 0x0220}] = TagInfo{Tag{0x0054, 0x0220}, "SQ", "ViewCodeSequence", "1"}
-	tagDict[Tag{0x0054, 0x0222}] = TagInfo{Tag{0x0054, 0x0222}, "SQ", "ViewModifierCodeSequence", "1"}
-	tagDict[Tag{0x0054, 0x0300}] = TagInfo{Tag{0x0054, 0x0300}, "SQ", "RadionuclideCodeSequence", "1"}
-	tagDict[Tag{0x0054, 0x0302}] = TagInfo{Tag{0x0054, 0x0302}, "SQ", "AdministrationRouteCodeSequence", "1"}
-	tagDict[Tag{0x0054, 0x0304}] = TagInfo{Tag{0x0054, 0x0304}, "SQ", "RadiopharmaceuticalCodeSequence", "1"}
-	tagDict[Tag{0x0054, 0x0306}] = TagInfo{Tag{0x0054, 0x0306}, "SQ", "CalibrationDataSequence", "1"}
-	tagDict[Tag{0x0054, 0x0308}] = TagInfo{Tag{0x0054, 0x0308}, "US", "EnergyWindowNumber", "1"}
-	tagDict[Tag{0x0054, 0x0400}] = TagInfo{Tag{0x0054, 0x0400}, "SH", "ImageID", "1"}
-	tagDict[Tag{0x0054, 0x0410}] = TagInfo{Tag{0x0054, 0x0410}, "SQ", "PatientOrientationCodeSequence", "1"}
-	tagDict[Tag{0x0054, 0x0412}] = TagInfo{Tag{0x0054, 0x0412}, "SQ", "PatientOrientationModifierCodeSequence", "1"}
-	tagDict[Tag{0x0054, 0x0414}] = TagInfo{Tag{0x0054, 0x0414}, "SQ", "PatientGantryRelationshipCodeSequence", "1"}
-	tagDict[Tag{0x0054, 0x0500}] = TagInfo{Tag{0x0054, 0x0500}, "CS", "SliceProgressionDirection", "1"}
-	tagDict[Tag{0x0054, 0x1000}] = TagInfo{Tag{0x0054, 0x1000}, "CS", "SeriesType", "2"}
-	tagDict[Tag{0x0054, 0x1001}] = TagInfo{Tag{0x0054, 0x1001}, "CS", "Units", "1"}
-	tagDict[Tag{0x0054, 0x1002}] = TagInfo{Tag{0x0054, 0x1002}, "CS", "CountsSource", "1"}
-	tagDict[Tag{0x0054, 0x1004}] = TagInfo{Tag{0x0054, 0x1004}, "CS", "ReprojectionMethod", "1"}
-	tagDict[Tag{0x0054, 0x1006}] = TagInfo{Tag{0x0054, 0x1006}, "CS", "SUVType", "1"}
-	tagDict[Tag{0x0054, 0x1100}] = TagInfo{Tag{0x0054, 0x1100}, "CS", "RandomsCorrectionMethod", "1"}
-	tagDict[Tag{0x0054, 0x1101}] = TagInfo{Tag{0x0054, 0x1101}, "LO", "AttenuationCorrectionMethod", "1"}
-	tagDict[Tag{0x0054, 0x1102}] = TagInfo{Tag{0x0054, 0x1102}, "CS", "DecayCorrection", "1"}
-	tagDict[Tag{0x0054, 0x1103}] = TagInfo{Tag{0x0054, 0x1103}, "LO", "ReconstructionMethod", "1"}
-	tagDict[Tag{0x0054, 0x1104}] = TagInfo{Tag{0x0054, 0x1104}, "LO", "DetectorLinesOfResponseUsed", "1"}
-	tagDict[Tag{0x0054, 0x1105}] = TagInfo{Tag{0x0054, 0x1105}, "LO", "ScatterCorrectionMethod", "1"}
-	tagDict[Tag{0x0054, 0x1200}] = TagInfo{Tag{0x0054, 0x1200}, "DS", "AxialAcceptance", "1"}
-	tagDict[Tag{0x0054, 0x1201}] = TagInfo{Tag{0x0054, 0x1201}, "IS", "AxialMash", "2"}
-	tagDict[Tag{0x0054, 0x1202}] = TagInfo{Tag{0x0054, 0x1202}, "IS", "TransverseMash", "1"}
-	tagDict[Tag{0x0054, 0x1203}] = TagInfo{Tag{0x0054, 0x1203}, "DS", "DetectorElementSize", "2"}
-	tagDict[Tag{0x0054, 0x1210}] = TagInfo{Tag{0x0054, 0x1210}, "DS", "CoincidenceWindowWidth", "1"}
-	tagDict[Tag{0x0054, 0x1220}] = TagInfo{Tag{0x0054, 0x1220}, "CS", "SecondaryCountsType", "1-n"}
-	tagDict[Tag{0x0054, 0x1300}] = TagInfo{Tag{0x0054, 0x1300}, "DS", "FrameReferenceTime", "1"}
-	tagDict[Tag{0x0054, 0x1310}] = TagInfo{Tag{0x0054, 0x1310}, "IS", "PrimaryPromptsCountsAccumulated", "1"}
-	tagDict[Tag{0x0054, 0x1311}] = TagInfo{Tag{0x0054, 0x1311}, "IS", "SecondaryCountsAccumulated", "1-n"}
-	tagDict[Tag{0x0054, 0x1320}] = TagInfo{Tag{0x0054, 0x1320}, "DS", "SliceSensitivityFactor", "1"}
-	tagDict[Tag{0x0054, 0x1321}] = TagInfo{Tag{0x0054, 0x1321}, "DS", "DecayFactor", "1"}
-	tagDict[Tag{0x0054, 0x1322}] = TagInfo{Tag{0x0054, 0x1322}, "DS", "DoseCalibrationFactor", "1"}
-	tagDict[Tag{0x0054, 0x1323}] = TagInfo{Tag{0x0054, 0x1323}, "DS", "ScatterFractionFactor", "1"}
-	tagDict[Tag{0x0054, 0x1324}] = TagInfo{Tag{0x0054, 0x1324}, "DS", "DeadTimeFactor", "1"}
-	tagDict[Tag{0x0054, 0x1330}] = TagInfo{Tag{0x0054, 0x1330}, "US", "ImageIndex", "1"}
-	tagDict[Tag{0x0060, 0x3000}] = TagInfo{Tag{0x0060, 0x3000}, "SQ", "HistogramSequence", "1"}
-	tagDict[Tag{0x0060, 0x3002}] = TagInfo{Tag{0x0060, 0x3002}, "US", "HistogramNumberOfBins", "1"}
-	tagDict[Tag{0x0060, 0x3004}] = TagInfo{Tag{0x0060, 0x3004}, "US", "HistogramFirstBinValue", "1"}
-	tagDict[Tag{0x0060, 0x3006}] = TagInfo{Tag{0x0060, 0x3006}, "US", "HistogramLastBinValue", "1"}
-	tagDict[Tag{0x0060, 0x3008}] = TagInfo{Tag{0x0060, 0x3008}, "US", "HistogramBinWidth", "1"}
-	tagDict[Tag{0x0060, 0x3010}] = TagInfo{Tag{0x0060, 0x3010}, "LO", "HistogramExplanation", "1"}
-	tagDict[Tag{0x0060, 0x3020}] = TagInfo{Tag{0x0060, 0x3020}, "UL", "HistogramData", "1-n"}
-	tagDict[Tag{0x0062, 0x0001}] = TagInfo{Tag{0x0062, 0x0001}, "CS", "SegmentationType", "1"}
-	tagDict[Tag{0x0062, 0x0002}] = TagInfo{Tag{0x0062, 0x0002}, "SQ", "SegmentSequence", "1"}
-	tagDict[Tag{0x0062, 0x0003}] = TagInfo{Tag{0x0062, 0x0003}, "SQ", "SegmentedPropertyCategoryCodeSequence", "1"}
-	tagDict[Tag{0x0062, 0x0004}] = TagInfo{Tag{0x0062, 0x0004}, "US", "SegmentNumber", "1"}
-	tagDict[Tag{0x0062, 0x0005}] = TagInfo{Tag{0x0062, 0x0005}, "LO", "SegmentLabel", "1"}
-	tagDict[Tag{0x0062, 0x0006}] = TagInfo{Tag{0x0062, 0x0006}, "ST", "SegmentDescription", "1"}
-	tagDict[Tag{0x0062, 0x0008}] = TagInfo{Tag{0x0062, 0x0008}, "CS", "SegmentAlgorithmType", "1"}
-	tagDict[Tag{0x0062, 0x0009}] = TagInfo{Tag{0x0062, 0x0009}, "LO", "SegmentAlgorithmName", "1"}
-	tagDict[Tag{0x0062, 0x000A}] = TagInfo{Tag{0x0062, 0x000A}, "SQ", "SegmentIdentificationSequence", "1"}
-	tagDict[Tag{0x0062, 0x000B}] = TagInfo{Tag{0x0062, 0x000B}, "US", "ReferencedSegmentNumber", "1-n"}
-	tagDict[Tag{0x0062, 0x000C}] = TagInfo{Tag{0x0062, 0x000C}, "US", "RecommendedDisplayGrayscaleValue", "1"}
-	tagDict[Tag{0x0062, 0x000D}] = TagInfo{Tag{0x0062, 0x000D}, "US", "RecommendedDisplayCIELabValue", "3"}
-	tagDict[Tag{0x0062, 0x000E}] = TagInfo{Tag{0x0062, 0x000E}, "US", "MaximumFractionalValue", "1"}
-	tagDict[Tag{0x0062, 0x000F}] = TagInfo{Tag{0x0062, 0x000F}, "SQ", "SegmentedPropertyTypeCodeSequence", "1"}
-	tagDict[Tag{0x0062, 0x0010}] = TagInfo{Tag{0x0062, 0x0010}, "CS", "SegmentationFractionalType", "1"}
-	tagDict[Tag{0x0064, 0x0002}] = TagInfo{Tag{0x0064, 0x0002}, "SQ", "DeformableRegistrationSequence", "1"}
-	tagDict[Tag{0x0064, 0x0003}] = TagInfo{Tag{0x0064, 0x0003}, "UI", "SourceFrameOfReferenceUID", "1"}
-	tagDict[Tag{0x0064, 0x0005}] = TagInfo{Tag{0x0064, 0x0005}, "SQ", "DeformableRegistrationGridSequence", "1"}
-	tagDict[Tag{0x0064, 0x0007}] = TagInfo{Tag{0x0064, 0x0007}, "UL", "GridDimensions", "3"}
-	tagDict[Tag{0x0064, 0x0008}] = TagInfo{Tag{0x0064, 0x0008}, "FD", "GridResolution", "3"}
-	tagDict[Tag{0x0064, 0x0009}] = TagInfo{Tag{0x0064, 0x0009}, "OF", "VectorGridData", "1"}
-	tagDict[Tag{0x0064, 0x000F}] = TagInfo{Tag{0x0064, 0x000F}, "SQ", "PreDeformationMatrixRegistrationSequence", "1"}
-	tagDict[Tag{0x0064, 0x0010}] = TagInfo{Tag{0x0064, 0x0010}, "SQ", "PostDeformationMatrixRegistrationSequence", "1"}
-	tagDict[Tag{0x0066, 0x0001}] = TagInfo{Tag{0x0066, 0x0001}, "UL", "NumberOfSurfaces", "1"}
-	tagDict[Tag{0x0066, 0x0002}] = TagInfo{Tag{0x0066, 0x0002}, "SQ", "SurfaceSequence", "1"}
-	tagDict[Tag{0x0066, 0x0003}] = TagInfo{Tag{0x0066, 0x0003}, "UL", "SurfaceNumber", "1"}
-	tagDict[Tag{0x0066, 0x0004}] = TagInfo{Tag{0x0066, 0x0004}, "LT", "SurfaceComments", "1"}
-	tagDict[Tag{0x0066, 0x0009}] = TagInfo{Tag{0x0066, 0x0009}, "CS", "SurfaceProcessing", "1"}
-	tagDict[Tag{0x0066, 0x000A}] = TagInfo{Tag{0x0066, 0x000A}, "FL", "SurfaceProcessingRatio", "1"}
-	tagDict[Tag{0x0066, 0x000B}] = TagInfo{Tag{0x0066, 0x000B}, "LO", "SurfaceProcessingDescription", "1"}
-	tagDict[Tag{0x0066, 0x000C}] = TagInfo{Tag{0x0066, 0x000C}, "FL", "RecommendedPresentationOpacity", "1"}
-	tagDict[Tag{0x0066, 0x000D}] = TagInfo{Tag{0x0066, 0x000D}, "CS", "RecommendedPresentationType", "1"}
-	tagDict[Tag{0x0066, 0x000E}] = TagInfo{Tag{0x0066, 0x000E}, "CS", "FiniteVolume", "1"}
-	tagDict[Tag{0x0066, 0x0010}] = TagInfo{Tag{0x0066, 0x0010}, "CS", "Manifold", "1"}
-	tagDict[Tag{0x0066, 0x0011}] = TagInfo{Tag{0x0066, 0x0011}, "SQ", "SurfacePointsSequence", "1"}
-	tagDict[Tag{0x0066, 0x0012}] = TagInfo{Tag{0x0066, 0x0012}, "SQ", "SurfacePointsNormalsSequence", "1"}
-	tagDict[Tag{0x0066, 0x0013}] = TagInfo{Tag{0x0066, 0x0013}, "SQ", "SurfaceMeshPrimitivesSequence", "1"}
-	tagDict[Tag{0x0066, 0x0015}] = TagInfo{Tag{0x0066, 0x0015}, "UL", "NumberOfSurfacePoints", "1"}
-	tagDict[Tag{0x0066, 0x0016}] = TagInfo{Tag{0x0066, 0x0016}, "OF", "PointCoordinatesData", "1"}
-	tagDict[Tag{0x0066, 0x0017}] = TagInfo{Tag{0x0066, 0x0017}, "FL", "PointPositionAccuracy", "3"}
-	tagDict[Tag{0x0066, 0x0018}] = TagInfo{Tag{0x0066, 0x0018}, "FL", "MeanPointDistance", "1"}
-	tagDict[Tag{0x0066, 0x0019}] = TagInfo{Tag{0x0066, 0x0019}, "FL", "MaximumPointDistance", "1"}
-	tagDict[Tag{0x0066, 0x001A}] = TagInfo{Tag{0x0066, 0x001A}, "FL", "PointsBoundingBoxCoordinates", "6"}
-	tagDict[Tag{0x0066, 0x001B}] = TagInfo{Tag{0x0066, 0x001B}, "FL", "AxisOfRotation", "3"}
-	tagDict[Tag{0x0066, 0x001C}] = TagInfo{Tag{0x0066, 0x001C}, "FL", "CenterOfRotation", "3"}
-	tagDict[Tag{0x0066, 0x001E}] = TagInfo{Tag{0x0066, 0x001E}, "UL", "NumberOfVectors", "1"}
-	tagDict[Tag{0x0066, 0x001F}] = TagInfo{Tag{0x0066, 0x001F}, "US", "VectorDimensionality", "1"}
-	tagDict[Tag{0x0066, 0x0020}] = TagInfo{Tag{0x0066, 0x0020}, "FL", "VectorAccuracy", "1-n"}
-	tagDict[Tag{0x0066, 0x0021}] = TagInfo{Tag{0x0066, 0x0021}, "OF", "VectorCoordinateData", "1"}
-	tagDict[Tag{0x0066, 0x0023}] = TagInfo{Tag{0x0066, 0x0023}, "OW", "TrianglePointIndexList", "1"}
-	tagDict[Tag{0x0066, 0x0024}] = TagInfo{Tag{0x0066, 0x0024}, "OW", "EdgePointIndexList", "1"}
-	tagDict[Tag{0x0066, 0x0025}] = TagInfo{Tag{0x0066, 0x0025}, "OW", "VertexPointIndexList", "1"}
-	tagDict[Tag{0x0066, 0x0026}] = TagInfo{Tag{0x0066, 0x0026}, "SQ", "TriangleStripSequence", "1"}
-	tagDict[Tag{0x0066, 0x0027}] = TagInfo{Tag{0x0066, 0x0027}, "SQ", "TriangleFanSequence", "1"}
-	tagDict[Tag{0x0066, 0x0028}] = TagInfo{Tag{0x0066, 0x0028}, "SQ", "LineSequence", "1"}
-	tagDict[Tag{0x0066, 0x0029}] = TagInfo{Tag{0x0066, 0x0029}, "OW", "PrimitivePointIndexList", "1"}
-	tagDict[Tag{0x0066, 0x002A}] = TagInfo{Tag{0x0066, 0x002A}, "UL", "SurfaceCount", "1"}
-	tagDict[Tag{0x0066, 0x002B}] = TagInfo{Tag{0x0066, 0x002B}, "SQ", "ReferencedSurfaceSequence", "1"}
-	tagDict[Tag{0x0066, 0x002C}] = TagInfo{Tag{0x0066, 0x002C}, "UL", "ReferencedSurfaceNumber", "1"}
-	tagDict[Tag{0x0066, 0x002D}] = TagInfo{Tag{0x0066, 0x002D}, "SQ", "SegmentSurfaceGenerationAlgorithmIdentificationSequence", "1"}
-	tagDict[Tag{0x0066, 0x002E}] = TagInfo{Tag{0x0066, 0x002E}, "SQ", "SegmentSurfaceSourceInstanceSequence", "1"}
-	tagDict[Tag{0x0066, 0x002F}] = TagInfo{Tag{0x0066, 0x002F}, "SQ", "AlgorithmFamilyCodeSequence", "1"}
-	tagDict[Tag{0x0066, 0x0030}] = TagInfo{Tag{0x0066, 0x0030}, "SQ", "AlgorithmNameCodeSequence", "1"}
-	tagDict[Tag{0x0066, 0x0031}] = TagInfo{Tag{0x0066, 0x0031}, "LO", "AlgorithmVersion", "1"}
-	tagDict[Tag{0x0066, 0x0032}] = TagInfo{Tag{0x0066, 0x0032}, "LT", "AlgorithmParameters", "1"}
-	tagDict[Tag{0x0066, 0x0034}] = TagInfo{Tag{0x0066, 0x0034}, "SQ", "FacetSequence", "1"}
-	tagDict[Tag{0x0066, 0x0035}] = TagInfo{Tag{0x0066, 0x0035}, "SQ", "SurfaceProcessingAlgorithmIdentificationSequence", "1"}
-	tagDict[Tag{0x0066, 0x0036}] = TagInfo{Tag{0x0066, 0x0036}, "LO", "AlgorithmName", "1"}
-	tagDict[Tag{0x0068, 0x6210}] = TagInfo{Tag{0x0068, 0x6210}, "LO", "ImplantSize", "1"}
-	tagDict[Tag{0x0068, 0x6221}] = TagInfo{Tag{0x0068, 0x6221}, "LO", "ImplantTemplateVersion", "1"}
-	tagDict[Tag{0x0068, 0x6222}] = TagInfo{Tag{0x0068, 0x6222}, "SQ", "ReplacedImplantTemplateSequence", "1"}
-	tagDict[Tag{0x0068, 0x6223}] = TagInfo{Tag{0x0068, 0x6223}, "CS", "ImplantType", "1"}
-	tagDict[Tag{0x0068, 0x6224}] = TagInfo{Tag{0x0068, 0x6224}, "SQ", "DerivationImplantTemplateSequence", "1"}
-	tagDict[Tag{0x0068, 0x6225}] = TagInfo{Tag{0x0068, 0x6225}, "SQ", "OriginalImplantTemplateSequence", "1"}
-	tagDict[Tag{0x0068, 0x6226}] = TagInfo{Tag{0x0068, 0x6226}, "DT", "EffectiveDateTime", "1"}
-	tagDict[Tag{0x0068, 0x6230}] = TagInfo{Tag{0x0068, 0x6230}, "SQ", "ImplantTargetAnatomySequence", "1"}
-	tagDict[Tag{0x0068, 0x6260}] = TagInfo{Tag{0x0068, 0x6260}, "SQ", "InformationFromManufacturerSequence", "1"}
-	tagDict[Tag{0x0068, 0x6265}] = TagInfo{Tag{0x0068, 0x6265}, "SQ", "NotificationFromManufacturerSequence", "1"}
-	tagDict[Tag{0x0068, 0x6270}] = TagInfo{Tag{0x0068, 0x6270}, "DT", "InformationIssueDateTime", "1"}
-	tagDict[Tag{0x0068, 0x6280}] = TagInfo{Tag{0x0068, 0x6280}, "ST", "InformationSummary", "1"}
-	tagDict[Tag{0x0068, 0x62A0}] = TagInfo{Tag{0x0068, 0x62A0}, "SQ", "ImplantRegulatoryDisapprovalCodeSequence", "1"}
-	tagDict[Tag{0x0068, 0x62A5}] = TagInfo{Tag{0x0068, 0x62A5}, "FD", "OverallTemplateSpatialTolerance", "1"}
-	tagDict[Tag{0x0068, 0x62C0}] = TagInfo{Tag{0x0068, 0x62C0}, "SQ", "HPGLDocumentSequence", "1"}
-	tagDict[Tag{0x0068, 0x62D0}] = TagInfo{Tag{0x0068, 0x62D0}, "US", "HPGLDocumentID", "1"}
-	tagDict[Tag{0x0068, 0x62D5}] = TagInfo{Tag{0x0068, 0x62D5}, "LO", "HPGLDocumentLabel", "1"}
-	tagDict[Tag{0x0068, 0x62E0}] = TagInfo{Tag{0x0068, 0x62E0}, "SQ", "ViewOrientationCodeSequence", "1"}
-	tagDict[Tag{0x0068, 0x62F0}] = TagInfo{Tag{0x0068, 0x62F0}, "FD", "ViewOrientationModifier", "9"}
-	tagDict[Tag{0x0068, 0x62F2}] = TagInfo{Tag{0x0068, 0x62F2}, "FD", "HPGLDocumentScaling", "1"}
-	tagDict[Tag{0x0068, 0x6300}] = TagInfo{Tag{0x0068, 0x6300}, "OB", "HPGLDocument", "1"}
-	tagDict[Tag{0x0068, 0x6310}] = TagInfo{Tag{0x0068, 0x6310}, "US", "HPGLContourPenNumber", "1"}
-	tagDict[Tag{0x0068, 0x6320}] = TagInfo{Tag{0x0068, 0x6320}, "SQ", "HPGLPenSequence", "1"}
-	tagDict[Tag{0x0068, 0x6330}] = TagInfo{Tag{0x0068, 0x6330}, "US", "HPGLPenNumber", "1"}
-	tagDict[Tag{0x0068, 0x6340}] = TagInfo{Tag{0x0068, 0x6340}, "LO", "HPGLPenLabel", "1"}
-	tagDict[Tag{0x0068, 0x6345}] = TagInfo{Tag{0x0068, 0x6345}, "ST", "HPGLPenDescription", "1"}
-	tagDict[Tag{0x0068, 0x6346}] = TagInfo{Tag{0x0068, 0x6346}, "FD", "RecommendedRotationPoint", "2"}
-	tagDict[Tag{0x0068, 0x6347}] = TagInfo{Tag{0x0068, 0x6347}, "FD", "BoundingRectangle", "4"}
-	tagDict[Tag{0x0068, 0x6350}] = TagInfo{Tag{0x0068, 0x6350}, "US", "ImplantTemplate3DModelSurfaceNumber", "1-n"}
-	tagDict[Tag{0x0068, 0x6360}] = TagInfo{Tag{0x0068, 0x6360}, "SQ", "SurfaceModelDescriptionSequence", "1"}
-	tagDict[Tag{0x0068, 0x6380}] = TagInfo{Tag{0x0068, 0x6380}, "LO", "SurfaceModelLabel", "1"}
-	tagDict[Tag{0x0068, 0x6390}] = TagInfo{Tag{0x0068, 0x6390}, "FD", "SurfaceModelScalingFactor", "1"}
-	tagDict[Tag{0x0068, 0x63A0}] = TagInfo{Tag{0x0068, 0x63A0}, "SQ", "MaterialsCodeSequence", "1"}
-	tagDict[Tag{0x0068, 0x63A4}] = TagInfo{Tag{0x0068, 0x63A4}, "SQ", "CoatingMaterialsCodeSequence", "1"}
-	tagDict[Tag{0x0068, 0x63A8}] = TagInfo{Tag{0x0068, 0x63A8}, "SQ", "ImplantTypeCodeSequence", "1"}
-	tagDict[Tag{0x0068, 0x63AC}] = TagInfo{Tag{0x0068, 0x63AC}, "SQ", "FixationMethodCodeSequence", "1"}
-	tagDict[Tag{0x0068, 0x63B0}] = TagInfo{Tag{0x0068, 0x63B0}, "SQ", "MatingFeatureSetsSequence", "1"}
-	tagDict[Tag{0x0068, 0x63C0}] = TagInfo{Tag{0x0068, 0x63C0}, "US", "MatingFeatureSetID", "1"}
-	tagDict[Tag{0x0068, 0x63D0}] = TagInfo{Tag{0x0068, 0x63D0}, "LO", "MatingFeatureSetLabel", "1"}
-	tagDict[Tag{0x0068, 0x63E0}] = TagInfo{Tag{0x0068, 0x63E0}, "SQ", "MatingFeatureSequence", "1"}
-	tagDict[Tag{0x0068, 0x63F0}] = TagInfo{Tag{0x0068, 0x63F0}, "US", "MatingFeatureID", "1"}
-	tagDict[Tag{0x0068, 0x6400}] = TagInfo{Tag{0x0068, 0x6400}, "SQ", "MatingFeatureDegreeOfFreedomSequence", "1"}
-	tagDict[Tag{0x0068, 0x6410}] = TagInfo{Tag{0x0068, 0x6410}, "US", "DegreeOfFreedomID", "1"}
-	tagDict[Tag{0x0068, 0x6420}] = TagInfo{Tag{0x0068, 0x6420}, "CS", "DegreeOfFreedomType", "1"}
-	tagDict[Tag{0x0068, 0x6430}] = TagInfo{Tag{0x0068, 0x6430}, "SQ", "TwoDMatingFeatureCoordinatesSequence", "1"}
-	tagDict[Tag{0x0068, 0x6440}] = TagInfo{Tag{0x0068, 0x6440}, "US", "ReferencedHPGLDocumentID", "1"}
-	tagDict[Tag{0x0068, 0x6450}] = TagInfo{Tag{0x0068, 0x6450}, "FD", "TwoDMatingPoint", "2"}
-	tagDict[Tag{0x0068, 0x6460}] = TagInfo{Tag{0x0068, 0x6460}, "FD", "TwoDMatingAxes", "4"}
-	tagDict[Tag{0x0068, 0x6470}] = TagInfo{Tag{0x0068, 0x6470}, "SQ", "TwoDDegreeOfFreedomSequence", "1"}
-	tagDict[Tag{0x0068, 0x6490}] = TagInfo{Tag{0x0068, 0x6490}, "FD", "ThreeDDegreeOfFreedomAxis", "3"}
-	tagDict[Tag{0x0068, 0x64A0}] = TagInfo{Tag{0x0068, 0x64A0}, "FD", "RangeOfFreedom", "2"}
-	tagDict[Tag{0x0068, 0x64C0}] = TagInfo{Tag{0x0068, 0x64C0}, "FD", "ThreeDMatingPoint", "3"}
-	tagDict[Tag{0x0068, 0x64D0}] = TagInfo{Tag{0x0068, 0x64D0}, "FD", "ThreeDMatingAxes", "9"}
-	tagDict[Tag{0x0068, 0x64F0}] = TagInfo{Tag{0x0068, 0x64F0}, "FD", "TwoDDegreeOfFreedomAxis", "3"}
-	tagDict[Tag{0x0068, 0x6500}] = TagInfo{Tag{0x0068, 0x6500}, "SQ", "PlanningLandmarkPointSequence", "1"}
-	tagDict[Tag{0x0068, 0x6510}] = TagInfo{Tag{0x0068, 0x6510}, "SQ", "PlanningLandmarkLineSequence", "1"}
-	tagDict[Tag{0x0068, 0x6520}] = TagInfo{Tag{0x0068, 0x6520}, "SQ", "PlanningLandmarkPlaneSequence", "1"}
-	tagDict[Tag{0x0068, 0x6530}] = TagInfo{Tag{0x0068, 0x6530}, "US", "PlanningLandmarkID", "1"}
-	tagDict[Tag{0x0068, 0x6540}] = TagInfo{Tag{0x0068, 0x6540}, "LO", "PlanningLandmarkDescription", "1"}
-	tagDict[Tag{0x0068, 0x6545}] = TagInfo{Tag{0x0068, 0x6545}, "SQ", "PlanningLandmarkIdentificationCodeSequence", "1"}
-	tagDict[Tag{0x0068, 0x6550}] = TagInfo{Tag{0x0068, 0x6550}, "SQ", "TwoDPointCoordinatesSequence", "1"}
-	tagDict[Tag{0x0068, 0x6560}] = TagInfo{Tag{0x0068, 0x6560}, "FD", "TwoDPointCoordinates", "2"}
-	tagDict[Tag{0x0068, 0x6590}] = TagInfo{Tag{0x0068, 0x6590}, "FD", "ThreeDPointCoordinates", "3"}
-	tagDict[Tag{0x0068, 0x65A0}] = TagInfo{Tag{0x0068, 0x65A0}, "SQ", "TwoDLineCoordinatesSequence", "1"}
-	tagDict[Tag{0x0068, 0x65B0}] = TagInfo{Tag{0x0068, 0x65B0}, "FD", "TwoDLineCoordinates", "4"}
-	tagDict[Tag{0x0068, 0x65D0}] = TagInfo{Tag{0x0068, 0x65D0}, "FD", "ThreeDLineCoordinates", "6"}
-	tagDict[Tag{0x0068, 0x65E0}] = TagInfo{Tag{0x0068, 0x65E0}, "SQ", "TwoDPlaneCoordinatesSequence", "1"}
-	tagDict[Tag{0x0068, 0x65F0}] = TagInfo{Tag{0x0068, 0x65F0}, "FD", "TwoDPlaneIntersection", "4"}
-	tagDict[Tag{0x0068, 0x6610}] = TagInfo{Tag{0x0068, 0x6610}, "FD", "ThreeDPlaneOrigin", "3"}
-	tagDict[Tag{0x0068, 0x6620}] = TagInfo{Tag{0x0068, 0x6620}, "FD", "ThreeDPlaneNormal", "3"}
-	tagDict[Tag{0x0070, 0x0001}] = TagInfo{Tag{0x0070, 0x0001}, "SQ", "GraphicAnnotationSequence", "1"}
-	tagDict[Tag{0x0070, 0x0002}] = TagInfo{Tag{0x0070, 0x0002}, "CS", "GraphicLayer", "1"}
-	tagDict[Tag{0x0070, 0x0003}] = TagInfo{Tag{0x0070, 0x0003}, "CS", "BoundingBoxAnnotationUnits", "1"}
-	tagDict[Tag{0x0070, 0x0004}] = TagInfo{Tag{0x0070, 0x0004}, "CS", "AnchorPointAnnotationUnits", "1"}
-	tagDict[Tag{0x0070, 0x0005}] = TagInfo{Tag{0x0070, 0x0005}, "CS", "GraphicAnnotationUnits", "1"}
-	tagDict[Tag{0x0070, 0x0006}] = TagInfo{Tag{0x0070, 0x0006}, "ST", "UnformattedTextValue", "1"}
-	tagDict[Tag{0x0070, 0x0008}] = TagInfo{Tag{0x0070, 0x0008}, "SQ", "TextObjectSequence", "1"}
-	tagDict[Tag{0x0070, 0x0009}] = TagInfo{Tag{0x0070, 0x0009}, "SQ", "GraphicObjectSequence", "1"}
-	tagDict[Tag{0x0070, 0x0010}] = TagInfo{Tag{0x0070, 0x0010}, "FL", "BoundingBoxTopLeftHandCorner", "2"}
-	tagDict[Tag{0x0070, 0x0011}] = TagInfo{Tag{0x0070, 0x0011}, "FL", "BoundingBoxBottomRightHandCorner", "2"}
-	tagDict[Tag{0x0070, 0x0012}] = TagInfo{Tag{0x0070, 0x0012}, "CS", "BoundingBoxTextHorizontalJustification", "1"}
-	tagDict[Tag{0x0070, 0x0014}] = TagInfo{Tag{0x0070, 0x0014}, "FL", "AnchorPoint", "2"}
-	tagDict[Tag{0x0070, 0x0015}] = TagInfo{Tag{0x0070, 0x0015}, "CS", "AnchorPointVisibility", "1"}
-	tagDict[Tag{0x0070, 0x0020}] = TagInfo{Tag{0x0070, 0x0020}, "US", "GraphicDimensions", "1"}
-	tagDict[Tag{0x0070, 0x0021}] = TagInfo{Tag{0x0070, 0x0021}, "US", "NumberOfROIContourPoints", "1"}
-	tagDict[Tag{0x0070, 0x0022}] = TagInfo{Tag{0x0070, 0x0022}, "FL", "IndicationROIContourData", "2-n"}
-	tagDict[Tag{0x0070, 0x0023}] = TagInfo{Tag{0x0070, 0x0023}, "CS", "GeometricType", "1"}
-	tagDict[Tag{0x0070, 0x0024}] = TagInfo{Tag{0x0070, 0x0024}, "CS", "GraphicFilled", "1"}
-	tagDict[Tag{0x0070, 0x0041}] = TagInfo{Tag{0x0070, 0x0041}, "CS", "ImageHorizontalFlip", "1"}
-	tagDict[Tag{0x0070, 0x0042}] = TagInfo{Tag{0x0070, 0x0042}, "US", "ImageRotation", "1"}
-	tagDict[Tag{0x0070, 0x0052}] = TagInfo{Tag{0x0070, 0x0052}, "SL", "DisplayedAreaTopLeftHandCorner", "2"}
-	tagDict[Tag{0x0070, 0x0053}] = TagInfo{Tag{0x0070, 0x0053}, "SL", "DisplayedAreaBottomRightHandCorner", "2"}
-	tagDict[Tag{0x0070, 0x005A}] = TagInfo{Tag{0x0070, 0x005A}, "SQ", "DisplayedAreaSelectionSequence", "1"}
-	tagDict[Tag{0x0070, 0x0060}] = TagInfo{Tag{0x0070, 0x0060}, "SQ", "GraphicLayerSequence", "1"}
-	tagDict[Tag{0x0070, 0x0062}] = TagInfo{Tag{0x0070, 0x0062}, "IS", "GraphicLayerOrder", "1"}
-	tagDict[Tag{0x0070, 0x0066}] = TagInfo{Tag{0x0070, 0x0066}, "US", "GraphicLayerRecommendedDisplayGrayscaleValue", "1"}
-	tagDict[Tag{0x0070, 0x0068}] = TagInfo{Tag{0x0070, 0x0068}, "LO", "GraphicLayerDescription", "1"}
-	tagDict[Tag{0x0070, 0x0080}] = TagInfo{Tag{0x0070, 0x0080}, "CS", "ContentLabel", "1"}
-	tagDict[Tag{0x0070, 0x0081}] = TagInfo{Tag{0x0070, 0x0081}, "LO", "ContentDescription", "1"}
-	tagDict[Tag{0x0070, 0x0082}] = TagInfo{Tag{0x0070, 0x0082}, "DA", "PresentationCreationDate", "1"}
-	tagDict[Tag{0x0070, 0x0083}] = TagInfo{Tag{0x0070, 0x0083}, "TM", "PresentationCreationTime", "1"}
-	tagDict[Tag{0x0070, 0x0084}] = TagInfo{Tag{0x0070, 0x0084}, "PN", "ContentCreatorName", "1"}
-	tagDict[Tag{0x0070, 0x0086}] = TagInfo{Tag{0x0070, 0x0086}, "SQ", "ContentCreatorIdentificationCodeSequence", "1"}
-	tagDict[Tag{0x0070, 0x0087}] = TagInfo{Tag{0x0070, 0x0087}, "SQ", "AlternateContentDescriptionSequence", "1"}
-	tagDict[Tag{0x0070, 0x0100}] = TagInfo{Tag{0x0070, 0x0100}, "CS", "PresentationSizeMode", "1"}
-	tagDict[Tag{0x0070, 0x0101}] = TagInfo{Tag{0x0070, 0x0101}, "DS", "PresentationPixelSpacing", "2"}
-	tagDict[Tag{0x0070, 0x0102}] = TagInfo{Tag{0x0070, 0x0102}, "IS", "PresentationPixelAspectRatio", "2"}
-	tagDict[Tag{0x0070, 0x0103}] = TagInfo{Tag{0x0070, 0x0103}, "FL", "PresentationPixelMagnificationRatio", "1"}
-	tagDict[Tag{0x0070, 0x0207}] = TagInfo{Tag{0x0070, 0x0207}, "LO", "GraphicGroupLabel", "1"}
-	tagDict[Tag{0x0070, 0x0208}] = TagInfo{Tag{0x0070, 0x0208}, "ST", "GraphicGroupDescription", "1"}
-	tagDict[Tag{0x0070, 0x0209}] = TagInfo{Tag{0x0070, 0x0209}, "SQ", "CompoundGraphicSequence", "1"}
-	tagDict[Tag{0x0070, 0x0226}] = TagInfo{Tag{0x0070, 0x0226}, "UL", "CompoundGraphicInstanceID", "1"}
-	tagDict[Tag{0x0070, 0x0227}] = TagInfo{Tag{0x0070, 0x0227}, "LO", "FontName", "1"}
-	tagDict[Tag{0x0070, 0x0228}] = TagInfo{Tag{0x0070, 0x0228}, "CS", "FontNameType", "1"}
-	tagDict[Tag{0x0070, 0x0229}] = TagInfo{Tag{0x0070, 0x0229}, "LO", "CSSFontName", "1"}
-	tagDict[Tag{0x0070, 0x0230}] = TagInfo{Tag{0x0070, 0x0230}, "FD", "RotationAngle", "1"}
-	tagDict[Tag{0x0070, 0x0231}] = TagInfo{Tag{0x0070, 0x0231}, "SQ", "TextStyleSequence", "1"}
-	tagDict[Tag{0x0070, 0x0232}] = TagInfo{Tag{0x0070, 0x0232}, "SQ", "LineStyleSequence", "1"}
-	tagDict[Tag{0x0070, 0x0233}] = TagInfo{Tag{0x0070, 0x0233}, "SQ", "FillStyleSequence", "1"}
-	tagDict[Tag{0x0070, 0x0234}] = TagInfo{Tag{0x0070, 0x0234}, "SQ", "GraphicGroupSequence", "1"}
-	tagDict[Tag{0x0070, 0x0241}] = TagInfo{Tag{0x0070, 0x0241}, "US", "TextColorCIELabValue", "3"}
-	tagDict[Tag{0x0070, 0x0242}] = TagInfo{Tag{0x0070, 0x0242}, "CS", "HorizontalAlignment", "1"}
-	tagDict[Tag{0x0070, 0x0243}] = TagInfo{Tag{0x0070, 0x0243}, "CS", "VerticalAlignment", "1"}
-	tagDict[Tag{0x0070, 0x0244}] = TagInfo{Tag{0x0070, 0x0244}, "CS", "ShadowStyle", "1"}
-	tagDict[Tag{0x0070, 0x0245}] = TagInfo{Tag{0x0070, 0x0245}, "FL", "ShadowOffsetX", "1"}
-	tagDict[Tag{0x0070, 0x0246}] = TagInfo{Tag{0x0070, 0x0246}, "FL", "ShadowOffsetY", "1"}
-	tagDict[Tag{0x0070, 0x0247}] = TagInfo{Tag{0x0070, 0x0247}, "US", "ShadowColorCIELabValue", "3"}
-	tagDict[Tag{0x0070, 0x0248}] = TagInfo{Tag{0x0070, 0x0248}, "CS", "Underlined", "1"}
-	tagDict[Tag{0x0070, 0x0249}] = TagInfo{Tag{0x0070, 0x0249}, "CS", "Bold", "1"}
-	tagDict[Tag{0x0070, 0x0250}] = TagInfo{Tag{0x0070, 0x0250}, "CS", "Italic", "1"}
-	tagDict[Tag{0x0070, 0x0251}] = TagInfo{Tag{0x0070, 0x0251}, "US", "PatternOnColorCIELabValue", "3"}
-	tagDict[Tag{0x0070, 0x0252}] = TagInfo{Tag{0x0070, 0x0252}, "US", "PatternOffColorCIELabValue", "3"}
-	tagDict[Tag{0x0070, 0x0253}] = TagInfo{Tag{0x0070, 0x0253}, "FL", "LineThickness", "1"}
-	tagDict[Tag{0x0070, 0x0254}] = TagInfo{Tag{0x0070, 0x0254}, "CS", "LineDashingStyle", "1"}
-	tagDict[Tag{0x0070, 0x0255}] = TagInfo{Tag{0x0070, 0x0255}, "UL", "LinePattern", "1"}
-	tagDict[Tag{0x0070, 0x0256}] = TagInfo{Tag{0x0070, 0x0256}, "OB", "FillPattern", "1"}
-	tagDict[Tag{0x0070, 0x0257}] = TagInfo{Tag{0x0070, 0x0257}, "CS", "FillMode", "1"}
-	tagDict[Tag{0x0070, 0x0258}] = TagInfo{Tag{0x0070, 0x0258}, "FL", "ShadowOpacity", "1"}
-	tagDict[Tag{0x0070, 0x0261}] = TagInfo{Tag{0x0070, 0x0261}, "FL", "GapLength", "1"}
-	tagDict[Tag{0x0070, 0x0262}] = TagInfo{Tag{0x0070, 0x0262}, "FL", "DiameterOfVisibility", "1"}
-	tagDict[Tag{0x0070, 0x0273}] = TagInfo{Tag{0x0070, 0x0273}, "FL", "RotationPoint", "2"}
-	tagDict[Tag{0x0070, 0x0274}] = TagInfo{Tag{0x0070, 0x0274}, "CS", "TickAlignment", "1"}
-	tagDict[Tag{0x0070, 0x0278}] = TagInfo{Tag{0x0070, 0x0278}, "CS", "ShowTickLabel", "1"}
-	tagDict[Tag{0x0070, 0x0279}] = TagInfo{Tag{0x0070, 0x0279}, "CS", "TickLabelAlignment", "1"}
-	tagDict[Tag{0x0070, 0x0282}] = TagInfo{Tag{0x0070, 0x0282}, "CS", "CompoundGraphicUnits", "1"}
-	tagDict[Tag{0x0070, 0x0284}] = TagInfo{Tag{0x0070, 0x0284}, "FL", "PatternOnOpacity", "1"}
-	tagDict[Tag{0x0070, 0x0285}] = TagInfo{Tag{0x0070, 0x0285}, "FL", "PatternOffOpacity", "1"}
-	tagDict[Tag{0x0070, 0x0287}] = TagInfo{Tag{0x0070, 0x0287}, "SQ", "MajorTicksSequence", "1"}
-	tagDict[Tag{0x0070, 0x0288}] = TagInfo{Tag{0x0070, 0x0288}, "FL", "TickPosition", "1"}
-	tagDict[Tag{0x0070, 0x0289}] = TagInfo{Tag{0x0070, 0x0289}, "SH", "TickLabel", "1"}
-	tagDict[Tag{0x0070, 0x0294}] = TagInfo{Tag{0x0070, 0x0294}, "CS", "CompoundGraphicType", "1"}
-	tagDict[Tag{0x0070, 0x0295}] = TagInfo{Tag{0x0070, 0x0295}, "UL", "GraphicGroupID", "1"}
-	tagDict[Tag{0x0070, 0x0306}] = TagInfo{Tag{0x0070, 0x0306}, "CS", "ShapeType", "1"}
-	tagDict[Tag{0x0070, 0x0308}] = TagInfo{Tag{0x0070, 0x0308}, "SQ", "RegistrationSequence", "1"}
-	tagDict[Tag{0x0070, 0x0309}] = TagInfo{Tag{0x0070, 0x0309}, "SQ", "MatrixRegistrationSequence", "1"}
-	tagDict[Tag{0x0070, 0x030A}] = TagInfo{Tag{0x0070, 0x030A}, "SQ", "MatrixSequence", "1"}
-	tagDict[Tag{0x0070, 0x030C}] = TagInfo{Tag{0x0070, 0x030C}, "CS", "FrameOfReferenceTransformationMatrixType", "1"}
-	tagDict[Tag{0x0070, 0x030D}] = TagInfo{Tag{0x0070, 0x030D}, "SQ", "RegistrationTypeCodeSequence", "1"}
-	tagDict[Tag{0x0070, 0x030F}] = TagInfo{Tag{0x0070, 0x030F}, "ST", "FiducialDescription", "1"}
-	tagDict[Tag{0x0070, 0x0310}] = TagInfo{Tag{0x0070, 0x0310}, "SH", "FiducialIdentifier", "1"}
-	tagDict[Tag{0x0070, 0x0311}] = TagInfo{Tag{0x0070, 0x0311}, "SQ", "FiducialIdentifierCodeSequence", "1"}
-	tagDict[Tag{0x0070, 0x0312}] = TagInfo{Tag{0x0070, 0x0312}, "FD", "ContourUncertaintyRadius", "1"}
-	tagDict[Tag{0x0070, 0x0314}] = TagInfo{Tag{0x0070, 0x0314}, "SQ", "UsedFiducialsSequence", "1"}
-	tagDict[Tag{0x0070, 0x0318}] = TagInfo{Tag{0x0070, 0x0318}, "SQ", "GraphicCoordinatesDataSequence", "1"}
-	tagDict[Tag{0x0070, 0x031A}] = TagInfo{Tag{0x0070, 0x031A}, "UI", "FiducialUID", "1"}
-	tagDict[Tag{0x0070, 0x031C}] = TagInfo{Tag{0x0070, 0x031C}, "SQ", "FiducialSetSequence", "1"}
-	tagDict[Tag{0x0070, 0x031E}] = TagInfo{Tag{0x0070, 0x031E}, "SQ", "FiducialSequence", "1"}
-	tagDict[Tag{0x0070, 0x0401}] = TagInfo{Tag{0x0070, 0x0401}, "US", "GraphicLayerRecommendedDisplayCIELabValue", "3"}
-	tagDict[Tag{0x0070, 0x0402}] = TagInfo{Tag{0x0070, 0x0402}, "SQ", "BlendingSequence", "1"}
-	tagDict[Tag{0x0070, 0x0403}] = TagInfo{Tag{0x0070, 0x0403}, "FL", "RelativeOpacity", "1"}
-	tagDict[Tag{0x0070, 0x0404}] = TagInfo{Tag{0x0070, 0x0404}, "SQ", "ReferencedSpatialRegistrationSequence", "1"}
-	tagDict[Tag{0x0070, 0x0405}] = TagInfo{Tag{0x0070, 0x0405}, "CS", "BlendingPosition", "1"}
-	tagDict[Tag{0x0072, 0x0002}] = TagInfo{Tag{0x0072, 0x0002}, "SH", "HangingProtocolName", "1"}
-	tagDict[Tag{0x0072, 0x0004}] = TagInfo{Tag{0x0072, 0x0004}, "LO", "HangingProtocolDescription", "1"}
-	tagDict[Tag{0x0072, 0x0006}] = TagInfo{Tag{0x0072, 0x0006}, "CS", "HangingProtocolLevel", "1"}
-	tagDict[Tag{0x0072, 0x0008}] = TagInfo{Tag{0x0072, 0x0008}, "LO", "HangingProtocolCreator", "1"}
-	tagDict[Tag{0x0072, 0x000A}] = TagInfo{Tag{0x0072, 0x000A}, "DT", "HangingProtocolCreationDateTime", "1"}
-	tagDict[Tag{0x0072, 0x000C}] = TagInfo{Tag{0x0072, 0x000C}, "SQ", "HangingProtocolDefinitionSequence", "1"}
-	tagDict[Tag{0x0072, 0x000E}] = TagInfo{Tag{0x0072, 0x000E}, "SQ", "HangingProtocolUserIdentificationCodeSequence", "1"}
-	tagDict[Tag{0x0072, 0x0010}] = TagInfo{Tag{0x0072, 0x0010}, "LO", "HangingProtocolUserGroupName", "1"}
-	tagDict[Tag{0x0072, 0x0012}] = TagInfo{Tag{0x0072, 0x0012}, "SQ", "SourceHangingProtocolSequence", "1"}
-	tagDict[Tag{0x0072, 0x0014}] = TagInfo{Tag{0x0072, 0x0014}, "US", "NumberOfPriorsReferenced", "1"}
-	tagDict[Tag{0x0072, 0x0020}] = TagInfo{Tag{0x0072, 0x0020}, "SQ", "ImageSetsSequence", "1"}
-	tagDict[Tag{0x0072, 0x0022}] = TagInfo{Tag{0x0072, 0x0022}, "SQ", "ImageSetSelectorSequence", "1"}
-	tagDict[Tag{0x0072, 0x0024}] = TagInfo{Tag{0x0072, 0x0024}, "CS", "ImageSetSelectorUsageFlag", "1"}
-	tagDict[Tag{0x0072, 0x0026}] = TagInfo{Tag{0x0072, 0x0026}, "AT", "SelectorAttribute", "1"}
-	tagDict[Tag{0x0072, 0x0028}] = TagInfo{Tag{0x0072, 0x0028}, "US", "SelectorValueNumber", "1"}
-	tagDict[Tag{0x0072, 0x0030}] = TagInfo{Tag{0x0072, 0x0030}, "SQ", "TimeBasedImageSetsSequence", "1"}
-	tagDict[Tag{0x0072, 0x0032}] = TagInfo{Tag{0x0072, 0x0032}, "US", "ImageSetNumber", "1"}
-	tagDict[Tag{0x0072, 0x0034}] = TagInfo{Tag{0x0072, 0x0034}, "CS", "ImageSetSelectorCategory", "1"}
-	tagDict[Tag{0x0072, 0x0038}] = TagInfo{Tag{0x0072, 0x0038}, "US", "RelativeTime", "2"}
-	tagDict[Tag{0x0072, 0x003A}] = TagInfo{Tag{0x0072, 0x003A}, "CS", "RelativeTimeUnits", "1"}
-	tagDict[Tag{0x0072, 0x003C}] = TagInfo{Tag{0x0072, 0x003C}, "SS", "AbstractPriorValue", "2"}
-	tagDict[Tag{0x0072, 0x003E}] = TagInfo{Tag{0x0072, 0x003E}, "SQ", "AbstractPriorCodeSequence", "1"}
-	tagDict[Tag{0x0072, 0x0040}] = TagInfo{Tag{0x0072, 0x0040}, "LO", "ImageSetLabel", "1"}
-	tagDict[Tag{0x0072, 0x0050}] = TagInfo{Tag{0x0072, 0x0050}, "CS", "SelectorAttributeVR", "1"}
-	tagDict[Tag{0x0072, 0x0052}] = TagInfo{Tag{0x0072, 0x0052}, "AT", "SelectorSequencePointer", "1-n"}
-	tagDict[Tag{0x0072, 0x0054}] = TagInfo{Tag{0x0072, 0x0054}, "LO", "SelectorSequencePointerPrivateCreator", "1-n"}
-	tagDict[Tag{0x0072, 0x0056}] = TagInfo{Tag{0x0072, 0x0056}, "LO", "SelectorAttributePrivateCreator", "1"}
-	tagDict[Tag{0x0072, 0x0060}] = TagInfo{Tag{0x0072, 0x0060}, "AT", "SelectorATValue", "1-n"}
-	tagDict[Tag{0x0072, 0x0062}] = TagInfo{Tag{0x0072, 0x0062}, "CS", "SelectorCSValue", "1-n"}
-	tagDict[Tag{0x0072, 0x0064}] = TagInfo{Tag{0x0072, 0x0064}, "IS", "SelectorISValue", "1-n"}
-	tagDict[Tag{0x0072, 0x0066}] = TagInfo{Tag{0x0072, 0x0066}, "LO", "SelectorLOValue", "1-n"}
-	tagDict[Tag{0x0072, 0x0068}] = TagInfo{Tag{0x0072, 0x0068}, "LT", "SelectorLTValue", "1"}
-	tagDict[Tag{0x0072, 0x006A}] = TagInfo{Tag{0x0072, 0x006A}, "PN", "SelectorPNValue", "1-n"}
-	tagDict[Tag{0x0072, 0x006C}] = TagInfo{Tag{0x0072, 0x006C}, "SH", "SelectorSHValue", "1-n"}
-	tagDict[Tag{0x0072, 0x006E}] = TagInfo{Tag{0x0072, 0x006E}, "ST", "SelectorSTValue", "1"}
-	tagDict[Tag{0x0072, 0x0070}] = TagInfo{Tag{0x0072, 0x0070}, "UT", "SelectorUTValue", "1"}
-	tagDict[Tag{0x0072, 0x0072}] = TagInfo{Tag{0x0072, 0x0072}, "DS", "SelectorDSValue", "1-n"}
-	tagDict[Tag{0x0072, 0x0074}] = TagInfo{Tag{0x0072, 0x0074}, "FD", "SelectorFDValue", "1-n"}
-	tagDict[Tag{0x0072, 0x0076}] = TagInfo{Tag{0x0072, 0x0076}, "FL", "SelectorFLValue", "1-n"}
-	tagDict[Tag{0x0072, 0x0078}] = TagInfo{Tag{0x0072, 0x0078}, "UL", "SelectorULValue", "1-n"}
-	tagDict[Tag{0x0072, 0x007A}] = TagInfo{Tag{0x0072, 0x007A}, "US", "SelectorUSValue", "1-n"}
-	tagDict[Tag{0x0072, 0x007C}] = TagInfo{Tag{0x0072, 0x007C}, "SL", "SelectorSLValue", "1-n"}
-	tagDict[Tag{0x0072, 0x007E}] = TagInfo{Tag{0x0072, 0x007E}, "SS", "SelectorSSValue", "1-n"}
-	tagDict[Tag{0x0072, 0x0080}] = TagInfo{Tag{0x0072, 0x0080}, "SQ", "SelectorCodeSequenceValue", "1"}
-	tagDict[Tag{0x0072, 0x0100}] = TagInfo{Tag{0x0072, 0x0100}, "US", "NumberOfScreens", "1"}
-	tagDict[Tag{0x0072, 0x0102}] = TagInfo{Tag{0x0072, 0x0102}, "SQ", "NominalScreenDefinitionSequence", "1"}
-	tagDict[Tag{0x0072, 0x0104}] = TagInfo{Tag{0x0072, 0x0104}, "US", "NumberOfVerticalPixels", "1"}
-	tagDict[Tag{0x0072, 0x0106}] = TagInfo{Tag{0x0072, 0x0106}, "US", "NumberOfHorizontalPixels", "1"}
-	tagDict[Tag{0x0072, 0x0108}] = TagInfo{Tag{0x0072, 0x0108}, "FD", "DisplayEnvironmentSpatialPosition", "4"}
-	tagDict[Tag{0x0072, 0x010A}] = TagInfo{Tag{0x0072, 0x010A}, "US", "ScreenMinimumGrayscaleBitDepth", "1"}
-	tagDict[Tag{0x0072, 0x010C}] = TagInfo{Tag{0x0072, 0x010C}, "US", "ScreenMinimumColorBitDepth", "1"}
-	tagDict[Tag{0x0072, 0x010E}] = TagInfo{Tag{0x0072, 0x010E}, "US", "ApplicationMaximumRepaintTime", "1"}
-	tagDict[Tag{0x0072, 0x0200}] = TagInfo{Tag{0x0072, 0x0200}, "SQ", "DisplaySetsSequence", "1"}
-	tagDict[Tag{0x0072, 0x0202}] = TagInfo{Tag{0x0072, 0x0202}, "US", "DisplaySetNumber", "1"}
-	tagDict[Tag{0x0072, 0x0203}] = TagInfo{Tag{0x0072, 0x0203}, "LO", "DisplaySetLabel", "1"}
-	tagDict[Tag{0x0072, 0x0204}] = TagInfo{Tag{0x0072, 0x0204}, "US", "DisplaySetPresentationGroup", "1"}
-	tagDict[Tag{0x0072, 0x0206}] = TagInfo{Tag{0x0072, 0x0206}, "LO", "DisplaySetPresentationGroupDescription", "1"}
-	tagDict[Tag{0x0072, 0x0208}] = TagInfo{Tag{0x0072, 0x0208}, "CS", "PartialDataDisplayHandling", "1"}
-	tagDict[Tag{0x0072, 0x0210}] = TagInfo{Tag{0x0072, 0x0210}, "SQ", "SynchronizedScrollingSequence", "1"}
-	tagDict[Tag{0x0072, 0x0212}] = TagInfo{Tag{0x0072, 0x0212}, "US", "DisplaySetScrollingGroup", "2-n"}
-	tagDict[Tag{0x0072, 0x0214}] = TagInfo{Tag{0x0072, 0x0214}, "SQ", "NavigationIndicatorSequence", "1"}
-	tagDict[Tag{0x0072, 0x0216}] = TagInfo{Tag{0x0072, 0x0216}, "US", "NavigationDisplaySet", "1"}
-	tagDict[Tag{0x0072, 0x0218}] = TagInfo{Tag{0x0072, 0x0218}, "US", "ReferenceDisplaySets", "1-n"}
-	tagDict[Tag{0x0072, 0x0300}] = TagInfo{Tag{0x0072, 0x0300}, "SQ", "ImageBoxesSequence", "1"}
-	tagDict[Tag{0x0072, 0x0302}] = TagInfo{Tag{0x0072, 0x0302}, "US", "ImageBoxNumber", "1"}
-	tagDict[Tag{0x0072, 0x0304}] = TagInfo{Tag{0x0072, 0x0304}, "CS", "ImageBoxLayoutType", "1"}
-	tagDict[Tag{0x0072, 0x0306}] = TagInfo{Tag{0x0072, 0x0306}, "US", "ImageBoxTileHorizontalDimension", "1"}
-	tagDict[Tag{0x0072, 0x0308}] = TagInfo{Tag{0x0072, 0x0308}, "US", "ImageBoxTileVerticalDimension", "1"}
-	tagDict[Tag{0x0072, 0x0310}] = TagInfo{Tag{0x0072, 0x0310}, "CS", "ImageBoxScrollDirection", "1"}
-	tagDict[Tag{0x0072, 0x0312}] = TagInfo{Tag{0x0072, 0x0312}, "CS", "ImageBoxSmallScrollType", "1"}
-	tagDict[Tag{0x0072, 0x0314}] = TagInfo{Tag{0x0072, 0x0314}, "US", "ImageBoxSmallScrollAmount", "1"}
-	tagDict[Tag{0x0072, 0x0316}] = TagInfo{Tag{0x0072, 0x0316}, "CS", "ImageBoxLargeScrollType", "1"}
-	tagDict[Tag{0x0072, 0x0318}] = TagInfo{Tag{0x0072, 0x0318}, "US", "ImageBoxLargeScrollAmount", "1"}
-	tagDict[Tag{0x0072, 0x0320}] = TagInfo{Tag{0x0072, 0x0320}, "US", "ImageBoxOverlapPriority", "1"}
-	tagDict[Tag{0x0072, 0x0330}] = TagInfo{Tag{0x0072, 0x0330}, "FD", "CineRelativeToRealTime", "1"}
-	tagDict[Tag{0x0072, 0x0400}] = TagInfo{Tag{0x0072, 0x0400}, "SQ", "FilterOperationsSequence", "1"}
-	tagDict[Tag{0x0072, 0x0402}] = TagInfo{Tag{0x0072, 0x0402}, "CS", "FilterByCategory", "1"}
-	tagDict[Tag{0x0072, 0x0404}] = TagInfo{Tag{0x0072, 0x0404}, "CS", "FilterByAttributePresence", "1"}
-	tagDict[Tag{0x0072, 0x0406}] = TagInfo{Tag{0x0072, 0x0406}, "CS", "FilterByOperator", "1"}
-	tagDict[Tag{0x0072, 0x0420}] = TagInfo{Tag{0x0072, 0x0420}, "US", "StructuredDisplayBackgroundCIELabValue", "3"}
-	tagDict[Tag{0x0072, 0x0421}] = TagInfo{Tag{0x0072, 0x0421}, "US", "EmptyImageBoxCIELabValue", "3"}
-	tagDict[Tag{0x0072, 0x0422}] = TagInfo{Tag{0x0072, 0x0422}, "SQ", "StructuredDisplayImageBoxSequence", "1"}
-	tagDict[Tag{0x0072, 0x0424}] = TagInfo{Tag{0x0072, 0x0424}, "SQ", "StructuredDisplayTextBoxSequence", "1"}
-	tagDict[Tag{0x0072, 0x0427}] = TagInfo{Tag{0x0072, 0x0427}, "SQ", "ReferencedFirstFrameSequence", "1"}
-	tagDict[Tag{0x0072, 0x0430}] = TagInfo{Tag{0x0072, 0x0430}, "SQ", "ImageBoxSynchronizationSequence", "1"}
-	tagDict[Tag{0x0072, 0x0432}] = TagInfo{Tag{0x0072, 0x0432}, "US", "SynchronizedImageBoxList", "2-n"}
-	tagDict[Tag{0x0072, 0x0434}] = TagInfo{Tag{0x0072, 0x0434}, "CS", "TypeOfSynchronization", "1"}
-	tagDict[Tag{0x0072, 0x0500}] = TagInfo{Tag{0x0072, 0x0500}, "CS", "BlendingOperationType", "1"}
-	tagDict[Tag{0x0072, 0x0510}] = TagInfo{Tag{0x0072, 0x0510}, "CS", "ReformattingOperationType", "1"}
-	tagDict[Tag{0x0072, 0x0512}] = TagInfo{Tag{0x0072, 0x0512}, "FD", "ReformattingThickness", "1"}
-	tagDict[Tag{0x0072, 0x0514}] = TagInfo{Tag{0x0072, 0x0514}, "FD", "ReformattingInterval", "1"}
-	tagDict[Tag{0x0072, 0x0516}] = TagInfo{Tag{0x0072, 0x0516}, "CS", "ReformattingOperationInitialViewDirection", "1"}
-	tagDict[Tag{0x0072, 0x0520}] = TagInfo{Tag{0x0072, 0x0520}, "CS", "ThreeDRenderingType", "1-n"}
-	tagDict[Tag{0x0072, 0x0600}] = TagInfo{Tag{0x0072, 0x0600}, "SQ", "SortingOperationsSequence", "1"}
-	tagDict[Tag{0x0072, 0x0602}] = TagInfo{Tag{0x0072, 0x0602}, "CS", "SortByCategory", "1"}
-	tagDict[Tag{0x0072, 0x0604}] = TagInfo{Tag{0x0072, 0x0604}, "CS", "SortingDirection", "1"}
-	tagDict[Tag{0x0072, 0x0700}] = TagInfo{Tag{0x0072, 0x0700}, "CS", "DisplaySetPatientOrientation", "2"}
-	tagDict[Tag{0x0072, 0x0702}] = TagInfo{Tag{0x0072, 0x0702}, "CS", "VOIType", "1"}
-	tagDict[Tag{0x0072, 0x0704}] = TagInfo{Tag{0x0072, 0x0704}, "CS", "PseudoColorType", "1"}
-	tagDict[Tag{0x0072, 0x0705}] = TagInfo{Tag{0x0072, 0x0705}, "SQ", "PseudoColorPaletteInstanceReferenceSequence", "1"}
-	tagDict[Tag{0x0072, 0x0706}] = TagInfo{Tag{0x0072, 0x0706}, "CS", "ShowGrayscaleInverted", "1"}
-	tagDict[Tag{0x0072, 0x0710}] = TagInfo{Tag{0x0072, 0x0710}, "CS", "ShowImageTrueSizeFlag", "1"}
-	tagDict[Tag{0x0072, 0x0712}] = TagInfo{Tag{0x0072, 0x0712}, "CS", "ShowGraphicAnnotationFlag", "1"}
-	tagDict[Tag{0x0072, 0x0714}] = TagInfo{Tag{0x0072, 0x0714}, "CS", "ShowPatientDemographicsFlag", "1"}
-	tagDict[Tag{0x0072, 0x0716}] = TagInfo{Tag{0x0072, 0x0716}, "CS", "ShowAcquisitionTechniquesFlag", "1"}
-	tagDict[Tag{0x0072, 0x0717}] = TagInfo{Tag{0x0072, 0x0717}, "CS", "DisplaySetHorizontalJustification", "1"}
-	tagDict[Tag{0x0072, 0x0718}] = TagInfo{Tag{0x0072, 0x0718}, "CS", "DisplaySetVerticalJustification", "1"}
-	tagDict[Tag{0x0074, 0x0120}] = TagInfo{Tag{0x0074, 0x0120}, "FD", "ContinuationStartMeterset", "1"}
-	tagDict[Tag{0x0074, 0x0121}] = TagInfo{Tag{0x0074, 0x0121}, "FD", "ContinuationEndMeterset", "1"}
-	tagDict[Tag{0x0074, 0x1000}] = TagInfo{Tag{0x0074, 0x1000}, "CS", "ProcedureStepState", "1"}
-	tagDict[Tag{0x0074, 0x1002}] = TagInfo{Tag{0x0074, 0x1002}, "SQ", "ProcedureStepProgressInformationSequence", "1"}
-	tagDict[Tag{0x0074, 0x1004}] = TagInfo{Tag{0x0074, 0x1004}, "DS", "ProcedureStepProgress", "1"}
-	tagDict[Tag{0x0074, 0x1006}] = TagInfo{Tag{0x0074, 0x1006}, "ST", "ProcedureStepProgressDescription", "1"}
-	tagDict[Tag{0x0074, 0x1008}] = TagInfo{Tag{0x0074, 0x1008}, "SQ", "ProcedureStepCommunicationsURISequence", "1"}
-	tagDict[Tag{0x0074, 0x100A}] = TagInfo{Tag{0x0074, 0x100A}, "ST", "ContactURI", "1"}
-	tagDict[Tag{0x0074, 0x100C}] = TagInfo{Tag{0x0074, 0x100C}, "LO", "ContactDisplayName", "1"}
-	tagDict[Tag{0x0074, 0x100E}] = TagInfo{Tag{0x0074, 0x100E}, "SQ", "ProcedureStepDiscontinuationReasonCodeSequence", "1"}
-	tagDict[Tag{0x0074, 0x1020}] = TagInfo{Tag{0x0074, 0x1020}, "SQ", "BeamTaskSequence", "1"}
-	tagDict[Tag{0x0074, 0x1022}] = TagInfo{Tag{0x0074, 0x1022}, "CS", "BeamTaskType", "1"}
-	tagDict[Tag{0x0074, 0x1026}] = TagInfo{Tag{0x0074, 0x1026}, "FD", "TableTopVerticalAdjustedPosition", "1"}
-	tagDict[Tag{0x0074, 0x1027}] = TagInfo{Tag{0x0074, 0x1027}, "FD", "TableTopLongitudinalAdjustedPosition", "1"}
-	tagDict[Tag{0x0074, 0x1028}] = TagInfo{Tag{0x0074, 0x1028}, "FD", "TableTopLateralAdjustedPosition", "1"}
-	tagDict[Tag{0x0074, 0x102A}] = TagInfo{Tag{0x0074, 0x102A}, "FD", "PatientSupportAdjustedAngle", "1"}
-	tagDict[Tag{0x0074, 0x102B}] = TagInfo{Tag{0x0074, 0x102B}, "FD", "TableTopEccentricAdjustedAngle", "1"}
-	tagDict[Tag{0x0074, 0x102C}] = TagInfo{Tag{0x0074, 0x102C}, "FD", "TableTopPitchAdjustedAngle", "1"}
-	tagDict[Tag{0x0074, 0x102D}] = TagInfo{Tag{0x0074, 0x102D}, "FD", "TableTopRollAdjustedAngle", "1"}
-	tagDict[Tag{0x0074, 0x1030}] = TagInfo{Tag{0x0074, 0x1030}, "SQ", "DeliveryVerificationImageSequence", "1"}
-	tagDict[Tag{0x0074, 0x1032}] = TagInfo{Tag{0x0074, 0x1032}, "CS", "VerificationImageTiming", "1"}
-	tagDict[Tag{0x0074, 0x1034}] = TagInfo{Tag{0x0074, 0x1034}, "CS", "DoubleExposureFlag", "1"}
-	tagDict[Tag{0x0074, 0x1036}] = TagInfo{Tag{0x0074, 0x1036}, "CS", "DoubleExposureOrdering", "1"}
-	tagDict[Tag{0x0074, 0x1040}] = TagInfo{Tag{0x0074, 0x1040}, "SQ", "RelatedReferenceRTImageSequence", "1"}
-	tagDict[Tag{0x0074, 0x1042}] = TagInfo{Tag{0x0074, 0x1042}, "SQ", "GeneralMachineVerificationSequence", "1"}
-	tagDict[Tag{0x0074, 0x1044}] = TagInfo{Tag{0x0074, 0x1044}, "SQ", "ConventionalMachineVerificationSequence", "1"}
-	tagDict[Tag{0x0074, 0x1046}] = TagInfo{Tag{0x0074, 0x1046}, "SQ", "IonMachineVerificationSequence", "1"}
-	tagDict[Tag{0x0074, 0x1048}] = TagInfo{Tag{0x0074, 0x1048}, "SQ", "FailedAttributesSequence", "1"}
-	tagDict[Tag{0x0074, 0x104A}] = TagInfo{Tag{0x0074, 0x104A}, "SQ", "OverriddenAttributesSequence", "1"}
-	tagDict[Tag{0x0074, 0x104C}] = TagInfo{Tag{0x0074, 0x104C}, "SQ", "ConventionalControlPointVerificationSequence", "1"}
-	tagDict[Tag{0x0074, 0x104E}] = TagInfo{Tag{0x0074, 0x104E}, "SQ", "IonControlPointVerificationSequence", "1"}
-	tagDict[Tag{0x0074, 0x1050}] = TagInfo{Tag{0x0074, 0x1050}, "SQ", "AttributeOccurrenceSequence", "1"}
-	tagDict[Tag{0x0074, 0x1052}] = TagInfo{Tag{0x0074, 0x1052}, "AT", "AttributeOccurrencePointer", "1"}
-	tagDict[Tag{0x0074, 0x1054}] = TagInfo{Tag{0x0074, 0x1054}, "UL", "AttributeItemSelector", "1"}
-	tagDict[Tag{0x0074, 0x1056}] = TagInfo{Tag{0x0074, 0x1056}, "LO", "AttributeOccurrencePrivateCreator", "1"}
-	tagDict[Tag{0x0074, 0x1057}] = TagInfo{Tag{0x0074, 0x1057}, "IS", "SelectorSequencePointerItems", "1-n"}
-	tagDict[Tag{0x0074, 0x1200}] = TagInfo{Tag{0x0074, 0x1200}, "CS", "ScheduledProcedureStepPriority", "1"}
-	tagDict[Tag{0x0074, 0x1202}] = TagInfo{Tag{0x0074, 0x1202}, "LO", "WorklistLabel", "1"}
-	tagDict[Tag{0x0074, 0x1204}] = TagInfo{Tag{0x0074, 0x1204}, "LO", "ProcedureStepLabel", "1"}
-	tagDict[Tag{0x0074, 0x1210}] = TagInfo{Tag{0x0074, 0x1210}, "SQ", "ScheduledProcessingParametersSequence", "1"}
-	tagDict[Tag{0x0074, 0x1212}] = TagInfo{Tag{0x0074, 0x1212}, "SQ", "PerformedProcessingParametersSequence", "1"}
-	tagDict[Tag{0x0074, 0x1216}] = TagInfo{Tag{0x0074, 0x1216}, "SQ", "UnifiedProcedureStepPerformedProcedureSequence", "1"}
-	tagDict[Tag{0x0074, 0x1224}] = TagInfo{Tag{0x0074, 0x1224}, "SQ", "ReplacedProcedureStepSequence", "1"}
-	tagDict[Tag{0x0074, 0x1230}] = TagInfo{Tag{0x0074, 0x1230}, "LO", "DeletionLock", "1"}
-	tagDict[Tag{0x0074, 0x1234}] = TagInfo{Tag{0x0074, 0x1234}, "AE", "ReceivingAE", "1"}
-	tagDict[Tag{0x0074, 0x1236}] = TagInfo{Tag{0x0074, 0x1236}, "AE", "RequestingAE", "1"}
-	tagDict[Tag{0x0074, 0x1238}] = TagInfo{Tag{0x0074, 0x1238}, "LT", "ReasonForCancellation", "1"}
-	tagDict[Tag{0x0074, 0x1242}] = TagInfo{Tag{0x0074, 0x1242}, "CS", "SCPStatus", "1"}
-	tagDict[Tag{0x0074, 0x1244}] = TagInfo{Tag{0x0074, 0x1244}, "CS", "SubscriptionListStatus", "1"}
-	tagDict[Tag{0x0074, 0x1246}] = TagInfo{Tag{0x0074, 0x1246}, "CS", "UnifiedProcedureStepListStatus", "1"}
-	tagDict[Tag{0x0074, 0x1324}] = TagInfo{Tag{0x0074, 0x1324}, "UL", "BeamOrderIndex", "1"}
-	tagDict[Tag{0x0074, 0x1338}] = TagInfo{Tag{0x0074, 0x1338}, "FD", "DoubleExposureMeterset", "1"}
-	tagDict[Tag{0x0074, 0x133A}] = TagInfo{Tag{0x0074, 0x133A}, "FD", "DoubleExposureFieldDelta", "4"}
-	tagDict[Tag{0x0076, 0x0001}] = TagInfo{Tag{0x0076, 0x0001}, "LO", "ImplantAssemblyTemplateName", "1"}
-	tagDict[Tag{0x0076, 0x0003}] = TagInfo{Tag{0x0076, 0x0003}, "LO", "ImplantAssemblyTemplateIssuer", "1"}
-	tagDict[Tag{0x0076, 0x0006}] = TagInfo{Tag{0x0076, 0x0006}, "LO", "ImplantAssemblyTemplateVersion", "1"}
-	tagDict[Tag{0x0076, 0x0008}] = TagInfo{Tag{0x0076, 0x0008}, "SQ", "ReplacedImplantAssemblyTemplateSequence", "1"}
-	tagDict[Tag{0x0076, 0x000A}] = TagInfo{Tag{0x0076, 0x000A}, "CS", "ImplantAssemblyTemplateType", "1"}
-	tagDict[Tag{0x0076, 0x000C}] = TagInfo{Tag{0x0076, 0x000C}, "SQ", "OriginalImplantAssemblyTemplateSequence", "1"}
-	tagDict[Tag{0x0076, 0x000E}] = TagInfo{Tag{0x0076, 0x000E}, "SQ", "DerivationImplantAssemblyTemplateSequence", "1"}
-	tagDict[Tag{0x0076, 0x0010}] = TagInfo{Tag{0x0076, 0x0010}, "SQ", "ImplantAssemblyTemplateTargetAnatomySequence", "1"}
-	tagDict[Tag{0x0076, 0x0020}] = TagInfo{Tag{0x0076, 0x0020}, "SQ", "ProcedureTypeCodeSequence", "1"}
-	tagDict[Tag{0x0076, 0x0030}] = TagInfo{Tag{0x0076, 0x0030}, "LO", "SurgicalTechnique", "1"}
-	tagDict[Tag{0x0076, 0x0032}] = TagInfo{Tag{0x0076, 0x0032}, "SQ", "ComponentTypesSequence", "1"}
-	tagDict[Tag{0x0076, 0x0034}] = TagInfo{Tag{0x0076, 0x0034}, "CS", "ComponentTypeCodeSequence", "1"}
-	tagDict[Tag{0x0076, 0x0036}] = TagInfo{Tag{0x0076, 0x0036}, "CS", "ExclusiveComponentType", "1"}
-	tagDict[Tag{0x0076, 0x0038}] = TagInfo{Tag{0x0076, 0x0038}, "CS", "MandatoryComponentType", "1"}
-	tagDict[Tag{0x0076, 0x0040}] = TagInfo{Tag{0x0076, 0x0040}, "SQ", "ComponentSequence", "1"}
-	tagDict[Tag{0x0076, 0x0055}] = TagInfo{Tag{0x0076, 0x0055}, "US", "ComponentID", "1"}
-	tagDict[Tag{0x0076, 0x0060}] = TagInfo{Tag{0x0076, 0x0060}, "SQ", "ComponentAssemblySequence", "1"}
-	tagDict[Tag{0x0076, 0x0070}] = TagInfo{Tag{0x0076, 0x0070}, "US", "Component1ReferencedID", "1"}
-	tagDict[Tag{0x0076, 0x0080}] = TagInfo{Tag{0x0076, 0x0080}, "US", "Component1ReferencedMatingFeatureSetID", "1"}
-	tagDict[Tag{0x0076, 0x0090}] = TagInfo{Tag{0x0076, 0x0090}, "US", "Component1ReferencedMatingFeatureID", "1"}
-	tagDict[Tag{0x0076, 0x00A0}] = TagInfo{Tag{0x0076, 0x00A0}, "US", "Component2ReferencedID", "1"}
-	tagDict[Tag{0x0076, 0x00B0}] = TagInfo{Tag{0x0076, 0x00B0}, "US", "Component2ReferencedMatingFeatureSetID", "1"}
-	tagDict[Tag{0x0076, 0x00C0}] = TagInfo{Tag{0x0076, 0x00C0}, "US", "Component2ReferencedMatingFeatureID", "1"}
-	tagDict[Tag{0x0078, 0x0001}] = TagInfo{Tag{0x0078, 0x0001}, "LO", "ImplantTemplateGroupName", "1"}
-	tagDict[Tag{0x0078, 0x0010}] = TagInfo{Tag{0x0078, 0x0010}, "ST", "ImplantTemplateGroupDescription", "1"}
-	tagDict[Tag{0x0078, 0x0020}] = TagInfo{Tag{0x0078, 0x0020}, "LO", "ImplantTemplateGroupIssuer", "1"}
-	tagDict[Tag{0x0078, 0x0024}] = TagInfo{Tag{0x0078, 0x0024}, "LO", "ImplantTemplateGroupVersion", "1"}
-	tagDict[Tag{0x0078, 0x0026}] = TagInfo{Tag{0x0078, 0x0026}, "SQ", "ReplacedImplantTemplateGroupSequence", "1"}
-	tagDict[Tag{0x0078, 0x0028}] = TagInfo{Tag{0x0078, 0x0028}, "SQ", "ImplantTemplateGroupTargetAnatomySequence", "1"}
-	tagDict[Tag{0x0078, 0x002A}] = TagInfo{Tag{0x0078, 0x002A}, "SQ", "ImplantTemplateGroupMembersSequence", "1"}
-	tagDict[Tag{0x0078, 0x002E}] = TagInfo{Tag{0x0078, 0x002E}, "US", "ImplantTemplateGroupMemberID", "1"}
-	tagDict[Tag{0x0078, 0x0050}] = TagInfo{Tag{0x0078, 0x0050}, "FD", "ThreeDImplantTemplateGroupMemberMatchingPoint", "3"}
-	tagDict[Tag{0x0078, 0x0060}] = TagInfo{Tag{0x0078, 0x0060}, "FD", "ThreeDImplantTemplateGroupMemberMatchingAxes", "9"}
-	tagDict[Tag{0x0078, 0x0070}] = TagInfo{Tag{0x0078, 0x0070}, "SQ", "ImplantTemplateGroupMemberMatching2DCoordinatesSequence", "1"}
-	tagDict[Tag{0x0078, 0x0090}] = TagInfo{Tag{0x0078, 0x0090}, "FD", "TwoDImplantTemplateGroupMemberMatchingPoint", "2"}
-	tagDict[Tag{0x0078, 0x00A0}] = TagInfo{Tag{0x0078, 0x00A0}, "FD", "TwoDImplantTemplateGroupMemberMatchingAxes", "4"}
-	tagDict[Tag{0x0078, 0x00B0}] = TagInfo{Tag{0x0078, 0x00B0}, "SQ", "ImplantTemplateGroupVariationDimensionSequence", "1"}
-	tagDict[Tag{0x0078, 0x00B2}] = TagInfo{Tag{0x0078, 0x00B2}, "LO", "ImplantTemplateGroupVariationDimensionName", "1"}
-	tagDict[Tag{0x0078, 0x00B4}] = TagInfo{Tag{0x0078, 0x00B4}, "SQ", "ImplantTemplateGroupVariationDimensionRankSequence", "1"}
-	tagDict[Tag{0x0078, 0x00B6}] = TagInfo{Tag{0x0078, 0x00B6}, "US", "ReferencedImplantTemplateGroupMemberID", "1"}
-	tagDict[Tag{0x0078, 0x00B8}] = TagInfo{Tag{0x0078, 0x00B8}, "US", "ImplantTemplateGroupVariationDimensionRank", "1"}
-	tagDict[Tag{0x0088, 0x0130}] = TagInfo{Tag{0x0088, 0x0130}, "SH", "StorageMediaFileSetID", "1"}
-	tagDict[Tag{0x0088, 0x0140}] = TagInfo{Tag{0x0088, 0x0140}, "UI", "StorageMediaFileSetUID", "1"}
-	tagDict[Tag{0x0088, 0x0200}] = TagInfo{Tag{0x0088, 0x0200}, "SQ", "IconImageSequence", "1"}
-	tagDict[Tag{0x0100, 0x0410}] = TagInfo{Tag{0x0100, 0x0410}, "CS", "SOPInstanceStatus", "1"}
-	tagDict[Tag{0x0100, 0x0420}] = TagInfo{Tag{0x0100, 0x0420}, "DT", "SOPAuthorizationDateTime", "1"}
-	tagDict[Tag{0x0100, 0x0424}] = TagInfo{Tag{0x0100, 0x0424}, "LT", "SOPAuthorizationComment", "1"}
-	tagDict[Tag{0x0100, 0x0426}] = TagInfo{Tag{0x0100, 0x0426}, "LO", "AuthorizationEquipmentCertificationNumber", "1"}
-	tagDict[Tag{0x0400, 0x0005}] = TagInfo{Tag{0x0400, 0x0005}, "US", "MACIDNumber", "1"}
-	tagDict[Tag{0x0400, 0x0010}] = TagInfo{Tag{0x0400, 0x0010}, "UI", "MACCalculationTransferSyntaxUID", "1"}
-	tagDict[Tag{0x0400, 0x0015}] = TagInfo{Tag{0x0400, 0x0015}, "CS", "MACAlgorithm", "1"}
-	tagDict[Tag{0x0400, 0x0020}] = TagInfo{Tag{0x0400, 0x0020}, "AT", "DataElementsSigned", "1-n"}
-	tagDict[Tag{0x0400, 0x0100}] = TagInfo{Tag{0x0400, 0x0100}, "UI", "DigitalSignatureUID", "1"}
-	tagDict[Tag{0x0400, 0x0105}] = TagInfo{Tag{0x0400, 0x0105}, "DT", "DigitalSignatureDateTime", "1"}
-	tagDict[Tag{0x0400, 0x0110}] = TagInfo{Tag{0x0400, 0x0110}, "CS", "CertificateType", "1"}
-	tagDict[Tag{0x0400, 0x0115}] = TagInfo{Tag{0x0400, 0x0115}, "OB", "CertificateOfSigner", "1"}
-	tagDict[Tag{0x0400, 0x0120}] = TagInfo{Tag{0x0400, 0x0120}, "OB", "Signature", "1"}
-	tagDict[Tag{0x0400, 0x0305}] = TagInfo{Tag{0x0400, 0x0305}, "CS", "CertifiedTimestampType", "1"}
-	tagDict[Tag{0x0400, 0x0310}] = TagInfo{Tag{0x0400, 0x0310}, "OB", "CertifiedTimestamp", "1"}
-	tagDict[Tag{0x0400, 0x0401}] = TagInfo{Tag{0x0400, 0x0401}, "SQ", "DigitalSignaturePurposeCodeSequence", "1"}
-	tagDict[Tag{0x0400, 0x0402}] = TagInfo{Tag{0x0400, 0x0402}, "SQ", "ReferencedDigitalSignatureSequence", "1"}
-	tagDict[Tag{0x0400, 0x0403}] = TagInfo{Tag{0x0400, 0x0403}, "SQ", "ReferencedSOPInstanceMACSequence", "1"}
-	tagDict[Tag{0x0400, 0x0404}] = TagInfo{Tag{0x0400, 0x0404}, "OB", "MAC", "1"}
-	tagDict[Tag{0x0400, 0x0500}] = TagInfo{Tag{0x0400, 0x0500}, "SQ", "EncryptedAttributesSequence", "1"}
-	tagDict[Tag{0x0400, 0x0510}] = TagInfo{Tag{0x0400, 0x0510}, "UI", "EncryptedContentTransferSyntaxUID", "1"}
-	tagDict[Tag{0x0400, 0x0520}] = TagInfo{Tag{0x0400, 0x0520}, "OB", "EncryptedContent", "1"}
-	tagDict[Tag{0x0400, 0x0550}] = TagInfo{Tag{0x0400, 0x0550}, "SQ", "ModifiedAttributesSequence", "1"}
-	tagDict[Tag{0x0400, 0x0561}] = TagInfo{Tag{0x0400, 0x0561}, "SQ", "OriginalAttributesSequence", "1"}
-	tagDict[Tag{0x0400, 0x0562}] = TagInfo{Tag{0x0400, 0x0562}, "DT", "AttributeModificationDateTime", "1"}
-	tagDict[Tag{0x0400, 0x0563}] = TagInfo{Tag{0x0400, 0x0563}, "LO", "ModifyingSystem", "1"}
-	tagDict[Tag{0x0400, 0x0564}] = TagInfo{Tag{0x0400, 0x0564}, "LO", "SourceOfPreviousValues", "1"}
-	tagDict[Tag{0x0400, 0x0565}] = TagInfo{Tag{0x0400, 0x0565}, "CS", "ReasonForTheAttributeModification", "1"}
-	tagDict[Tag{0x2000, 0x0010}] = TagInfo{Tag{0x2000, 0x0010}, "IS", "NumberOfCopies", "1"}
-	tagDict[Tag{0x2000, 0x001E}] = TagInfo{Tag{0x2000, 0x001E}, "SQ", "PrinterConfigurationSequence", "1"}
-	tagDict[Tag{0x2000, 0x0020}] = TagInfo{Tag{0x2000, 0x0020}, "CS", "PrintPriority", "1"}
-	tagDict[Tag{0x2000, 0x0030}] = TagInfo{Tag{0x2000, 0x0030}, "CS", "MediumType", "1"}
-	tagDict[Tag{0x2000, 0x0040}] = TagInfo{Tag{0x2000, 0x0040}, "CS", "FilmDestination", "1"}
-	tagDict[Tag{0x2000, 0x0050}] = TagInfo{Tag{0x2000, 0x0050}, "LO", "FilmSessionLabel", "1"}
-	tagDict[Tag{0x2000, 0x0060}] = TagInfo{Tag{0x2000, 0x0060}, "IS", "MemoryAllocation", "1"}
-	tagDict[Tag{0x2000, 0x0061}] = TagInfo{Tag{0x2000, 0x0061}, "IS", "MaximumMemoryAllocation", "1"}
-	tagDict[Tag{0x2000, 0x00A0}] = TagInfo{Tag{0x2000, 0x00A0}, "US", "MemoryBitDepth", "1"}
-	tagDict[Tag{0x2000, 0x00A1}] = TagInfo{Tag{0x2000, 0x00A1}, "US", "PrintingBitDepth", "1"}
-	tagDict[Tag{0x2000, 0x00A2}] = TagInfo{Tag{0x2000, 0x00A2}, "SQ", "MediaInstalledSequence", "1"}
-	tagDict[Tag{0x2000, 0x00A4}] = TagInfo{Tag{0x2000, 0x00A4}, "SQ", "OtherMediaAvailableSequence", "1"}
-	tagDict[Tag{0x2000, 0x00A8}] = TagInfo{Tag{0x2000, 0x00A8}, "SQ", "SupportedImageDisplayFormatsSequence", "1"}
-	tagDict[Tag{0x2000, 0x0500}] = TagInfo{Tag{0x2000, 0x0500}, "SQ", "ReferencedFilmBoxSequence", "1"}
-	tagDict[Tag{0x2010, 0x0010}] = TagInfo{Tag{0x2010, 0x0010}, "ST", "ImageDisplayFormat", "1"}
-	tagDict[Tag{0x2010, 0x0030}] = TagInfo{Tag{0x2010, 0x0030}, "CS", "AnnotationDisplayFormatID", "1"}
-	tagDict[Tag{0x2010, 0x0040}] = TagInfo{Tag{0x2010, 0x0040}, "CS", "FilmOrientation", "1"}
-	tagDict[Tag{0x2010, 0x0050}] = TagInfo{Tag{0x2010, 0x0050}, "CS", "FilmSizeID", "1"}
-	tagDict[Tag{0x2010, 0x0052}] = TagInfo{Tag{0x2010, 0x0052}, "CS", "PrinterResolutionID", "1"}
-	tagDict[Tag{0x2010, 0x0054}] = TagInfo{Tag{0x2010, 0x0054}, "CS", "DefaultPrinterResolutionID", "1"}
-	tagDict[Tag{0x2010, 0x0060}] = TagInfo{Tag{0x2010, 0x0060}, "CS", "MagnificationType", "1"}
-	tagDict[Tag{0x2010, 0x0080}] = TagInfo{Tag{0x2010, 0x0080}, "CS", "SmoothingType", "1"}
-	tagDict[Tag{0x2010, 0x00A6}] = TagInfo{Tag{0x2010, 0x00A6}, "CS", "DefaultMagnificationType", "1"}
-	tagDict[Tag{0x2010, 0x00A7}] = TagInfo{Tag{0x2010, 0x00A7}, "CS", "OtherMagnificationTypesAvailable", "1-n"}
-	tagDict[Tag{0x2010, 0x00A8}] = TagInfo{Tag{0x2010, 0x00A8}, "CS", "DefaultSmoothingType", "1"}
-	tagDict[Tag{0x2010, 0x00A9}] = TagInfo{Tag{0x2010, 0x00A9}, "CS", "OtherSmoothingTypesAvailable", "1-n"}
-	tagDict[Tag{0x2010, 0x0100}] = TagInfo{Tag{0x2010, 0x0100}, "CS", "BorderDensity", "1"}
-	tagDict[Tag{0x2010, 0x0110}] = TagInfo{Tag{0x2010, 0x0110}, "CS", "EmptyImageDensity", "1"}
-	tagDict[Tag{0x2010, 0x0120}] = TagInfo{Tag{0x2010, 0x0120}, "US", "MinDensity", "1"}
-	tagDict[Tag{0x2010, 0x0130}] = TagInfo{Tag{0x2010, 0x0130}, "US", "MaxDensity", "1"}
-	tagDict[Tag{0x2010, 0x0140}] = TagInfo{Tag{0x2010, 0x0140}, "CS", "Trim", "1"}
-	tagDict[Tag{0x2010, 0x0150}] = TagInfo{Tag{0x2010, 0x0150}, "ST", "ConfigurationInformation", "1"}
-	tagDict[Tag{0x2010, 0x0152}] = TagInfo{Tag{0x2010, 0x0152}, "LT", "ConfigurationInformationDescription", "1"}
-	tagDict[Tag{0x2010, 0x0154}] = TagInfo{Tag{0x2010, 0x0154}, "IS", "MaximumCollatedFilms", "1"}
-	tagDict[Tag{0x2010, 0x015E}] = TagInfo{Tag{0x2010, 0x015E}, "US", "Illumination", "1"}
-	tagDict[Tag{0x2010, 0x0160}] = TagInfo{Tag{0x2010, 0x0160}, "US", "ReflectedAmbientLight", "1"}
-	tagDict[Tag{0x2010, 0x0376}] = TagInfo{Tag{0x2010, 0x0376}, "DS", "PrinterPixelSpacing", "2"}
-	tagDict[Tag{0x2010, 0x0500}] = TagInfo{Tag{0x2010, 0x0500}, "SQ", "ReferencedFilmSessionSequence", "1"}
-	tagDict[Tag{0x2010, 0x0510}] = TagInfo{Tag{0x2010, 0x0510}, "SQ", "ReferencedImageBoxSequence", "1"}
-	tagDict[Tag{0x2010, 0x0520}] = TagInfo{Tag{0x2010, 0x0520}, "SQ", "ReferencedBasicAnnotationBoxSequence", "1"}
-	tagDict[Tag{0x2020, 0x0010}] = TagInfo{Tag{0x2020, 0x0010}, "US", "ImageBoxPosition", "1"}
-	tagDict[Tag{0x2020, 0x0020}] = TagInfo{Tag{0x2020, 0x0020}, "CS", "Polarity", "1"}
-	tagDict[Tag{0x2020, 0x0030}] = TagInfo{Tag{0x2020, 0x0030}, "DS", "RequestedImageSize", "1"}
-	tagDict[Tag{0x2020, 0x0040}] = TagInfo{Tag{0x2020, 0x0040}, "CS", "RequestedDecimateCropBehavior", "1"}
-	tagDict[Tag{0x2020, 0x0050}] = TagInfo{Tag{0x2020, 0x0050}, "CS", "RequestedResolutionID", "1"}
-	tagDict[Tag{0x2020, 0x00A0}] = TagInfo{Tag{0x2020, 0x00A0}, "CS", "RequestedImageSizeFlag", "1"}
-	tagDict[Tag{0x2020, 0x00A2}] = TagInfo{Tag{0x2020, 0x00A2}, "CS", "DecimateCropResult", "1"}
-	tagDict[Tag{0x2020, 0x0110}] = TagInfo{Tag{0x2020, 0x0110}, "SQ", "BasicGrayscaleImageSequence", "1"}
-	tagDict[Tag{0x2020, 0x0111}] = TagInfo{Tag{0x2020, 0x0111}, "SQ", "BasicColorImageSequence", "1"}
-	tagDict[Tag{0x2030, 0x0010}] = TagInfo{Tag{0x2030, 0x0010}, "US", "AnnotationPosition", "1"}
-	tagDict[Tag{0x2030, 0x0020}] = TagInfo{Tag{0x2030, 0x0020}, "LO", "TextString", "1"}
-	tagDict[Tag{0x2050, 0x0010}] = TagInfo{Tag{0x2050, 0x0010}, "SQ", "PresentationLUTSequence", "1"}
-	tagDict[Tag{0x2050, 0x0020}] = TagInfo{Tag{0x2050, 0x0020}, "CS", "PresentationLUTShape", "1"}
-	tagDict[Tag{0x2050, 0x0500}] = TagInfo{Tag{0x2050, 0x0500}, "SQ", "ReferencedPresentationLUTSequence", "1"}
-	tagDict[Tag{0x2100, 0x0020}] = TagInfo{Tag{0x2100, 0x0020}, "CS", "ExecutionStatus", "1"}
-	tagDict[Tag{0x2100, 0x0030}] = TagInfo{Tag{0x2100, 0x0030}, "CS", "ExecutionStatusInfo", "1"}
-	tagDict[Tag{0x2100, 0x0040}] = TagInfo{Tag{0x2100, 0x0040}, "DA", "CreationDate", "1"}
-	tagDict[Tag{0x2100, 0x0050}] = TagInfo{Tag{0x2100, 0x0050}, "TM", "CreationTime", "1"}
-	tagDict[Tag{0x2100, 0x0070}] = TagInfo{Tag{0x2100, 0x0070}, "AE", "Originator", "1"}
-	tagDict[Tag{0x2100, 0x0160}] = TagInfo{Tag{0x2100, 0x0160}, "SH", "OwnerID", "1"}
-	tagDict[Tag{0x2100, 0x0170}] = TagInfo{Tag{0x2100, 0x0170}, "IS", "NumberOfFilms", "1"}
-	tagDict[Tag{0x2110, 0x0010}] = TagInfo{Tag{0x2110, 0x0010}, "CS", "PrinterStatus", "1"}
-	tagDict[Tag{0x2110, 0x0020}] = TagInfo{Tag{0x2110, 0x0020}, "CS", "PrinterStatusInfo", "1"}
-	tagDict[Tag{0x2110, 0x0030}] = TagInfo{Tag{0x2110, 0x0030}, "LO", "PrinterName", "1"}
-	tagDict[Tag{0x2200, 0x0001}] = TagInfo{Tag{0x2200, 0x0001}, "CS", "LabelUsingInformationExtractedFromInstances", "1"}
-	tagDict[Tag{0x2200, 0x0002}] = TagInfo{Tag{0x2200, 0x0002}, "UT", "LabelText", "1"}
-	tagDict[Tag{0x2200, 0x0003}] = TagInfo{Tag{0x2200, 0x0003}, "CS", "LabelStyleSelection", "1"}
-	tagDict[Tag{0x2200, 0x0004}] = TagInfo{Tag{0x2200, 0x0004}, "LT", "MediaDisposition", "1"}
-	tagDict[Tag{0x2200, 0x0005}] = TagInfo{Tag{0x2200, 0x0005}, "LT", "BarcodeValue", "1"}
-	tagDict[Tag{0x2200, 0x0006}] = TagInfo{Tag{0x2200, 0x0006}, "CS", "BarcodeSymbology", "1"}
-	tagDict[Tag{0x2200, 0x0007}] = TagInfo{Tag{0x2200, 0x0007}, "CS", "AllowMediaSplitting", "1"}
-	tagDict[Tag{0x2200, 0x0008}] = TagInfo{Tag{0x2200, 0x0008}, "CS", "IncludeNonDICOMObjects", "1"}
-	tagDict[Tag{0x2200, 0x0009}] = TagInfo{Tag{0x2200, 0x0009}, "CS", "IncludeDisplayApplication", "1"}
-	tagDict[Tag{0x2200, 0x000A}] = TagInfo{Tag{0x2200, 0x000A}, "CS", "PreserveCompositeInstancesAfterMediaCreation", "1"}
-	tagDict[Tag{0x2200, 0x000B}] = TagInfo{Tag{0x2200, 0x000B}, "US", "TotalNumberOfPiecesOfMediaCreated", "1"}
-	tagDict[Tag{0x2200, 0x000C}] = TagInfo{Tag{0x2200, 0x000C}, "LO", "RequestedMediaApplicationProfile", "1"}
-	tagDict[Tag{0x2200, 0x000D}] = TagInfo{Tag{0x2200, 0x000D}, "SQ", "ReferencedStorageMediaSequence", "1"}
-	tagDict[Tag{0x2200, 0x000E}] = TagInfo{Tag{0x2200, 0x000E}, "AT", "FailureAttributes", "1-n"}
-	tagDict[Tag{0x2200, 0x000F}] = TagInfo{Tag{0x2200, 0x000F}, "CS", "AllowLossyCompression", "1"}
-	tagDict[Tag{0x2200, 0x0020}] = TagInfo{Tag{0x2200, 0x0020}, "CS", "RequestPriority", "1"}
-	tagDict[Tag{0x3002, 0x0002}] = TagInfo{Tag{0x3002, 0x0002}, "SH", "RTImageLabel", "1"}
-	tagDict[Tag{0x3002, 0x0003}] = TagInfo{Tag{0x3002, 0x0003}, "LO", "RTImageName", "1"}
-	tagDict[Tag{0x3002, 0x0004}] = TagInfo{Tag{0x3002, 0x0004}, "ST", "RTImageDescription", "1"}
-	tagDict[Tag{0x3002, 0x000A}] = TagInfo{Tag{0x3002, 0x000A}, "CS", "ReportedValuesOrigin", "1"}
-	tagDict[Tag{0x3002, 0x000C}] = TagInfo{Tag{0x3002, 0x000C}, "CS", "RTImagePlane", "1"}
-	tagDict[Tag{0x3002, 0x000D}] = TagInfo{Tag{0x3002, 0x000D}, "DS", "XRayImageReceptorTranslation", "3"}
-	tagDict[Tag{0x3002, 0x000E}] = TagInfo{Tag{0x3002, 0x000E}, "DS", "XRayImageReceptorAngle", "1"}
-	tagDict[Tag{0x3002, 0x0010}] = TagInfo{Tag{0x3002, 0x0010}, "DS", "RTImageOrientation", "6"}
-	tagDict[Tag{0x3002, 0x0011}] = TagInfo{Tag{0x3002, 0x0011}, "DS", "ImagePlanePixelSpacing", "2"}
-	tagDict[Tag{0x3002, 0x0012}] = TagInfo{Tag{0x3002, 0x0012}, "DS", "RTImagePosition", "2"}
-	tagDict[Tag{0x3002, 0x0020}] = TagInfo{Tag{0x3002, 0x0020}, "SH", "RadiationMachineName", "1"}
-	tagDict[Tag{0x3002, 0x0022}] = TagInfo{Tag{0x3002, 0x0022}, "DS", "RadiationMachineSAD", "1"}
-	tagDict[Tag{0x3002, 0x0024}] = TagInfo{Tag{0x3002, 0x0024}, "DS", "RadiationMachineSSD", "1"}
-	tagDict[Tag{0x3002, 0x0026}] = TagInfo{Tag{0x3002, 0x0026}, "DS", "RTImageSID", "1"}
-	tagDict[Tag{0x3002, 0x0028}] = TagInfo{Tag{0x3002, 0x0028}, "DS", "SourceToReferenceObjectDistance", "1"}
-	tagDict[Tag{0x3002, 0x0029}] = TagInfo{Tag{0x3002, 0x0029}, "IS", "FractionNumber", "1"}
-	tagDict[Tag{0x3002, 0x0030}] = TagInfo{Tag{0x3002, 0x0030}, "SQ", "ExposureSequence", "1"}
-	tagDict[Tag{0x3002, 0x0032}] = TagInfo{Tag{0x3002, 0x0032}, "DS", "MetersetExposure", "1"}
-	tagDict[Tag{0x3002, 0x0034}] = TagInfo{Tag{0x3002, 0x0034}, "DS", "DiaphragmPosition", "4"}
-	tagDict[Tag{0x3002, 0x0040}] = TagInfo{Tag{0x3002, 0x0040}, "SQ", "FluenceMapSequence", "1"}
-	tagDict[Tag{0x3002, 0x0041}] = TagInfo{Tag{0x3002, 0x0041}, "CS", "FluenceDataSource", "1"}
-	tagDict[Tag{0x3002, 0x0042}] = TagInfo{Tag{0x3002, 0x0042}, "DS", "FluenceDataScale", "1"}
-	tagDict[Tag{0x3002, 0x0050}] = TagInfo{Tag{0x3002, 0x0050}, "SQ", "PrimaryFluenceModeSequence", "1"}
-	tagDict[Tag{0x3002, 0x0051}] = TagInfo{Tag{0x3002, 0x0051}, "CS", "FluenceMode", "1"}
-	tagDict[Tag{0x3002, 0x0052}] = TagInfo{Tag{0x3002, 0x0052}, "SH", "FluenceModeID", "1"}
-	tagDict[Tag{0x3004, 0x0001}] = TagInfo{Tag{0x3004, 0x0001}, "CS", "DVHType", "1"}
-	tagDict[Tag{0x3004, 0x0002}] = TagInfo{Tag{0x3004, 0x0002}, "CS", "DoseUnits", "1"}
-	tagDict[Tag{0x3004, 0x0004}] = TagInfo{Tag{0x3004, 0x0004}, "CS", "DoseType", "1"}
-	tagDict[Tag{0x3004, 0x0006}] = TagInfo{Tag{0x3004, 0x0006}, "LO", "DoseComment", "1"}
-	tagDict[Tag{0x3004, 0x0008}] = TagInfo{Tag{0x3004, 0x0008}, "DS", "NormalizationPoint", "3"}
-	tagDict[Tag{0x3004, 0x000A}] = TagInfo{Tag{0x3004, 0x000A}, "CS", "DoseSummationType", "1"}
-	tagDict[Tag{0x3004, 0x000C}] = TagInfo{Tag{0x3004, 0x000C}, "DS", "GridFrameOffsetVector", "2-n"}
-	tagDict[Tag{0x3004, 0x000E}] = TagInfo{Tag{0x3004, 0x000E}, "DS", "DoseGridScaling", "1"}
-	tagDict[Tag{0x3004, 0x0010}] = TagInfo{Tag{0x3004, 0x0010}, "SQ", "RTDoseROISequence", "1"}
-	tagDict[Tag{0x3004, 0x0012}] = TagInfo{Tag{0x3004, 0x0012}, "DS", "DoseValue", "1"}
-	tagDict[Tag{0x3004, 0x0014}] = TagInfo{Tag{0x3004, 0x0014}, "CS", "TissueHeterogeneityCorrection", "1-3"}
-	tagDict[Tag{0x3004, 0x0040}] = TagInfo{Tag{0x3004, 0x0040}, "DS", "DVHNormalizationPoint", "3"}
-	tagDict[Tag{0x3004, 0x0042}] = TagInfo{Tag{0x3004, 0x0042}, "DS", "DVHNormalizationDoseValue", "1"}
-	tagDict[Tag{0x3004, 0x0050}] = TagInfo{Tag{0x3004, 0x0050}, "SQ", "DVHSequence", "1"}
-	tagDict[Tag{0x3004, 0x0052}] = TagInfo{Tag{0x3004, 0x0052}, "DS", "DVHDoseScaling", "1"}
-	tagDict[Tag{0x3004, 0x0054}] = TagInfo{Tag{0x3004, 0x0054}, "CS", "DVHVolumeUnits", "1"}
-	tagDict[Tag{0x3004, 0x0056}] = TagInfo{Tag{0x3004, 0x0056}, "IS", "DVHNumberOfBins", "1"}
-	tagDict[Tag{0x3004, 0x0058}] = TagInfo{Tag{0x3004, 0x0058}, "DS", "DVHData", "2-2n"}
-	tagDict[Tag{0x3004, 0x0060}] = TagInfo{Tag{0x3004, 0x0060}, "SQ", "DVHReferencedROISequence", "1"}
-	tagDict[Tag{0x3004, 0x0062}] = TagInfo{Tag{0x3004, 0x0062}, "CS", "DVHROIContributionType", "1"}
-	tagDict[Tag{0x3004, 0x0070}] = TagInfo{Tag{0x3004, 0x0070}, "DS", "DVHMinimumDose", "1"}
-	tagDict[Tag{0x3004, 0x0072}] = TagInfo{Tag{0x3004, 0x0072}, "DS", "DVHMaximumDose", "1"}
-	tagDict[Tag{0x3004, 0x0074}] = TagInfo{Tag{0x3004, 0x0074}, "DS", "DVHMeanDose", "1"}
-	tagDict[Tag{0x3006, 0x0002}] = TagInfo{Tag{0x3006, 0x0002}, "SH", "StructureSetLabel", "1"}
-	tagDict[Tag{0x3006, 0x0004}] = TagInfo{Tag{0x3006, 0x0004}, "LO", "StructureSetName", "1"}
-	tagDict[Tag{0x3006, 0x0006}] = TagInfo{Tag{0x3006, 0x0006}, "ST", "StructureSetDescription", "1"}
-	tagDict[Tag{0x3006, 0x0008}] = TagInfo{Tag{0x3006, 0x0008}, "DA", "StructureSetDate", "1"}
-	tagDict[Tag{0x3006, 0x0009}] = TagInfo{Tag{0x3006, 0x0009}, "TM", "StructureSetTime", "1"}
-	tagDict[Tag{0x3006, 0x0010}] = TagInfo{Tag{0x3006, 0x0010}, "SQ", "ReferencedFrameOfReferenceSequence", "1"}
-	tagDict[Tag{0x3006, 0x0012}] = TagInfo{Tag{0x3006, 0x0012}, "SQ", "RTReferencedStudySequence", "1"}
-	tagDict[Tag{0x3006, 0x0014}] = TagInfo{Tag{0x3006, 0x0014}, "SQ", "RTReferencedSeriesSequence", "1"}
-	tagDict[Tag{0x3006, 0x0016}] = TagInfo{Tag{0x3006, 0x0016}, "SQ", "ContourImageSequence", "1"}
-	tagDict[Tag{0x3006, 0x0020}] = TagInfo{Tag{0x3006, 0x0020}, "SQ", "StructureSetROISequence", "1"}
-	tagDict[Tag{0x3006, 0x0022}] = TagInfo{Tag{0x3006, 0x0022}, "IS", "ROINumber", "1"}
-	tagDict[Tag{0x3006, 0x0024}] = TagInfo{Tag{0x3006, 0x0024}, "UI", "ReferencedFrameOfReferenceUID", "1"}
-	tagDict[Tag{0x3006, 0x0026}] = TagInfo{Tag{0x3006, 0x0026}, "LO", "ROIName", "1"}
-	tagDict[Tag{0x3006, 0x0028}] = TagInfo{Tag{0x3006, 0x0028}, "ST", "ROIDescription", "1"}
-	tagDict[Tag{0x3006, 0x002A}] = TagInfo{Tag{0x3006, 0x002A}, "IS", "ROIDisplayColor", "3"}
-	tagDict[Tag{0x3006, 0x002C}] = TagInfo{Tag{0x3006, 0x002C}, "DS", "ROIVolume", "1"}
-	tagDict[Tag{0x3006, 0x0030}] = TagInfo{Tag{0x3006, 0x0030}, "SQ", "RTRelatedROISequence", "1"}
-	tagDict[Tag{0x3006, 0x0033}] = TagInfo{Tag{0x3006, 0x0033}, "CS", "RTROIRelationship", "1"}
-	tagDict[Tag{0x3006, 0x0036}] = TagInfo{Tag{0x3006, 0x0036}, "CS", "ROIGenerationAlgorithm", "1"}
-	tagDict[Tag{0x3006, 0x0038}] = TagInfo{Tag{0x3006, 0x0038}, "LO", "ROIGenerationDescription", "1"}
-	tagDict[Tag{0x3006, 0x0039}] = TagInfo{Tag{0x3006, 0x0039}, "SQ", "ROIContourSequence", "1"}
-	tagDict[Tag{0x3006, 0x0040}] = TagInfo{Tag{0x3006, 0x0040}, "SQ", "ContourSequence", "1"}
-	tagDict[Tag{0x3006, 0x0042}] = TagInfo{Tag{0x3006, 0x0042}, "CS", "ContourGeometricType", "1"}
-	tagDict[Tag{0x3006, 0x0044}] = TagInfo{Tag{0x3006, 0x0044}, "DS", "ContourSlabThickness", "1"}
-	tagDict[Tag{0x3006, 0x0045}] = TagInfo{Tag{0x3006, 0x0045}, "DS", "ContourOffsetVector", "3"}
-	tagDict[Tag{0x3006, 0x0046}] = TagInfo{Tag{0x3006, 0x0046}, "IS", "NumberOfContourPoints", "1"}
-	tagDict[Tag{0x3006, 0x0048}] = TagInfo{Tag{0x3006, 0x0048}, "IS", "ContourNumber", "1"}
-	tagDict[Tag{0x3006, 0x0049}] = TagInfo{Tag{0x3006, 0x0049}, "IS", "AttachedContours", "1-n"}
-	tagDict[Tag{0x3006, 0x0050}] = TagInfo{Tag{0x3006, 0x0050}, "DS", "ContourData", "3-3n"}
-	tagDict[Tag{0x3006, 0x0080}] = TagInfo{Tag{0x3006, 0x0080}, "SQ", "RTROIObservationsSequence", "1"}
-	tagDict[Tag{0x3006, 0x0082}] = TagInfo{Tag{0x3006, 0x0082}, "IS", "ObservationNumber", "1"}
-	tagDict[Tag{0x3006, 0x0084}] = TagInfo{Tag{0x3006, 0x0084}, "IS", "ReferencedROINumber", "1"}
-	tagDict[Tag{0x3006, 0x0085}] = TagInfo{Tag{0x3006, 0x0085}, "SH", "ROIObservationLabel", "1"}
-	tagDict[Tag{0x3006, 0x0086}] = TagInfo{Tag{0x3006, 0x0086}, "SQ", "RTROIIdentificationCodeSequence", "1"}
-	tagDict[Tag{0x3006, 0x0088}] = TagInfo{Tag{0x3006, 0x0088}, "ST", "ROIObservationDescription", "1"}
-	tagDict[Tag{0x3006, 0x00A0}] = TagInfo{Tag{0x3006, 0x00A0}, "SQ", "RelatedRTROIObservationsSequence", "1"}
-	tagDict[Tag{0x3006, 0x00A4}] = TagInfo{Tag{0x3006, 0x00A4}, "CS", "RTROIInterpretedType", "1"}
-	tagDict[Tag{0x3006, 0x00A6}] = TagInfo{Tag{0x3006, 0x00A6}, "PN", "ROIInterpreter", "1"}
-	tagDict[Tag{0x3006, 0x00B0}] = TagInfo{Tag{0x3006, 0x00B0}, "SQ", "ROIPhysicalPropertiesSequence", "1"}
-	tagDict[Tag{0x3006, 0x00B2}] = TagInfo{Tag{0x3006, 0x00B2}, "CS", "ROIPhysicalProperty", "1"}
-	tagDict[Tag{0x3006, 0x00B4}] = TagInfo{Tag{0x3006, 0x00B4}, "DS", "ROIPhysicalPropertyValue", "1"}
-	tagDict[Tag{0x3006, 0x00B6}] = TagInfo{Tag{0x3006, 0x00B6}, "SQ", "ROIElementalCompositionSequence", "1"}
-	tagDict[Tag{0x3006, 0x00B7}] = TagInfo{Tag{0x3006, 0x00B7}, "US", "ROIElementalCompositionAtomicNumber", "1"}
-	tagDict[Tag{0x3006, 0x00B8}] = TagInfo{Tag{0x3006, 0x00B8}, "FL", "ROIElementalCompositionAtomicMassFraction", "1"}
-	tagDict[Tag{0x3006, 0x00C0}] = TagInfo{Tag{0x3006, 0x00C0}, "SQ", "FrameOfReferenceRelationshipSequence", "1"}
-	tagDict[Tag{0x3006, 0x00C2}] = TagInfo{Tag{0x3006, 0x00C2}, "UI", "RelatedFrameOfReferenceUID", "1"}
-	tagDict[Tag{0x3006, 0x00C4}] = TagInfo{Tag{0x3006, 0x00C4}, "CS", "FrameOfReferenceTransformationType", "1"}
-	tagDict[Tag{0x3006, 0x00C6}] = TagInfo{Tag{0x3006, 0x00C6}, "DS", "FrameOfReferenceTransformationMatrix", "16"}
-	tagDict[Tag{0x3006, 0x00C8}] = TagInfo{Tag{0x3006, 0x00C8}, "LO", "FrameOfReferenceTransformationComment", "1"}
-	tagDict[Tag{0x3008, 0x0010}] = TagInfo{Tag{0x3008, 0x0010}, "SQ", "MeasuredDoseReferenceSequence", "1"}
-	tagDict[Tag{0x3008, 0x0012}] = TagInfo{Tag{0x3008, 0x0012}, "ST", "MeasuredDoseDescription", "1"}
-	tagDict[Tag{0x3008, 0x0014}] = TagInfo{Tag{0x3008, 0x0014}, "CS", "MeasuredDoseType", "1"}
-	tagDict[Tag{0x3008, 0x0016}] = TagInfo{Tag{0x3008, 0x0016}, "DS", "MeasuredDoseValue", "1"}
-	tagDict[Tag{0x3008, 0x0020}] = TagInfo{Tag{0x3008, 0x0020}, "SQ", "TreatmentSessionBeamSequence", "1"}
-	tagDict[Tag{0x3008, 0x0021}] = TagInfo{Tag{0x3008, 0x0021}, "SQ", "TreatmentSessionIonBeamSequence", "1"}
-	tagDict[Tag{0x3008, 0x0022}] = TagInfo{Tag{0x3008, 0x0022}, "IS", "CurrentFractionNumber", "1"}
-	tagDict[Tag{0x3008, 0x0024}] = TagInfo{Tag{0x3008, 0x0024}, "DA", "TreatmentControlPointDate", "1"}
-	tagDict[Tag{0x3008, 0x0025}] = TagInfo{Tag{0x3008, 0x0025}, "TM", "TreatmentControlPointTime", "1"}
-	tagDict[Tag{0x3008, 0x002A}] = TagInfo{Tag{0x3008, 0x002A}, "CS", "TreatmentTerminationStatus", "1"}
-	tagDict[Tag{0x3008, 0x002B}] = TagInfo{Tag{0x3008, 0x002B}, "SH", "TreatmentTerminationCode", "1"}
-	tagDict[Tag{0x3008, 0x002C}] = TagInfo{Tag{0x3008, 0x002C}, "CS", "TreatmentVerificationStatus", "1"}
-	tagDict[Tag{0x3008, 0x0030}] = TagInfo{Tag{0x3008, 0x0030}, "SQ", "ReferencedTreatmentRecordSequence", "1"}
-	tagDict[Tag{0x3008, 0x0032}] = TagInfo{Tag{0x3008, 0x0032}, "DS", "SpecifiedPrimaryMeterset", "1"}
-	tagDict[Tag{0x3008, 0x0033}] = TagInfo{Tag{0x3008, 0x0033}, "DS", "SpecifiedSecondaryMeterset", "1"}
-	tagDict[Tag{0x3008, 0x0036}] = TagInfo{Tag{0x3008, 0x0036}, "DS", "DeliveredPrimaryMeterset", "1"}
-	tagDict[Tag{0x3008, 0x0037}] = TagInfo{Tag{0x3008, 0x0037}, "DS", "DeliveredSecondaryMeterset", "1"}
-	tagDict[Tag{0x3008, 0x003A}] = TagInfo{Tag{0x3008, 0x003A}, "DS", "SpecifiedTreatmentTime", "1"}
-	tagDict[Tag{0x3008, 0x003B}] = TagInfo{Tag{0x3008, 0x003B}, "DS", "DeliveredTreatmentTime", "1"}
-	tagDict[Tag{0x3008, 0x0040}] = TagInfo{Tag{0x3008, 0x0040}, "SQ", "ControlPointDeliverySequence", "1"}
-	tagDict[Tag{0x3008, 0x0041}] = TagInfo{Tag{0x3008, 0x0041}, "SQ", "IonControlPointDeliverySequence", "1"}
-	tagDict[Tag{0x3008, 0x0042}] = TagInfo{Tag{0x3008, 0x0042}, "DS", "SpecifiedMeterset", "1"}
-	tagDict[Tag{0x3008, 0x0044}] = TagInfo{Tag{0x3008, 0x0044}, "DS", "DeliveredMeterset", "1"}
-	tagDict[Tag{0x3008, 0x0045}] = TagInfo{Tag{0x3008, 0x0045}, "FL", "MetersetRateSet", "1"}
-	tagDict[Tag{0x3008, 0x0046}] = TagInfo{Tag{0x3008, 0x0046}, "FL", "MetersetRateDelivered", "1"}
-	tagDict[Tag{0x3008, 0x0047}] = TagInfo{Tag{0x3008, 0x0047}, "FL", "ScanSpotMetersetsDelivered", "1-n"}
-	tagDict[Tag{0x3008, 0x0048}] = TagInfo{Tag{0x3008, 0x0048}, "DS", "DoseRateDelivered", "1"}
-	tagDict[Tag{0x3008, 0x0050}] = TagInfo{Tag{0x3008, 0x0050}, "SQ", "TreatmentSummaryCalculatedDoseReferenceSequence", "1"}
-	tagDict[Tag{0x3008, 0x0052}] = TagInfo{Tag{0x3008, 0x0052}, "DS", "CumulativeDoseToDoseReference", "1"}
-	tagDict[Tag{0x3008, 0x0054}] = TagInfo{Tag{0x3008, 0x0054}, "DA", "FirstTreatmentDate", "1"}
-	tagDict[Tag{0x3008, 0x0056}] = TagInfo{Tag{0x3008, 0x0056}, "DA", "MostRecentTreatmentDate", "1"}
-	tagDict[Tag{0x3008, 0x005A}] = TagInfo{Tag{0x3008, 0x005A}, "IS", "NumberOfFractionsDelivered", "1"}
-	tagDict[Tag{0x3008, 0x0060}] = TagInfo{Tag{0x3008, 0x0060}, "SQ", "OverrideSequence", "1"}
-	tagDict[Tag{0x3008, 0x0061}] = TagInfo{Tag{0x3008, 0x0061}, "AT", "ParameterSequencePointer", "1"}
-	tagDict[Tag{0x3008, 0x0062}] = TagInfo{Tag{0x3008, 0x0062}, "AT", "OverrideParameterPointer", "1"}
-	tagDict[Tag{0x3008, 0x0063}] = TagInfo{Tag{0x3008, 0x0063}, "IS", "ParameterItemIndex", "1"}
-	tagDict[Tag{0x3008, 0x0064}] = TagInfo{Tag{0x3008, 0x0064}, "IS", "MeasuredDoseReferenceNumber", "1"}
-	tagDict[Tag{0x3008, 0x0065}] = TagInfo{Tag{0x3008, 0x0065}, "AT", "ParameterPointer", "1"}
-	tagDict[Tag{0x3008, 0x0066}] = TagInfo{Tag{0x3008, 0x0066}, "ST", "OverrideReason", "1"}
-	tagDict[Tag{0x3008, 0x0068}] = TagInfo{Tag{0x3008, 0x0068}, "SQ", "CorrectedParameterSequence", "1"}
-	tagDict[Tag{0x3008, 0x006A}] = TagInfo{Tag{0x3008, 0x006A}, "FL", "CorrectionValue", "1"}
-	tagDict[Tag{0x3008, 0x0070}] = TagInfo{Tag{0x3008, 0x0070}, "SQ", "CalculatedDoseReferenceSequence", "1"}
-	tagDict[Tag{0x3008, 0x0072}] = TagInfo{Tag{0x3008, 0x0072}, "IS", "CalculatedDoseReferenceNumber", "1"}
-	tagDict[Tag{0x3008, 0x0074}] = TagInfo{Tag{0x3008, 0x0074}, "ST", "CalculatedDoseReferenceDescription", "1"}
-	tagDict[Tag{0x3008, 0x0076}] = TagInfo{Tag{0x3008, 0x0076}, "DS", "CalculatedDoseReferenceDoseValue", "1"}
-	tagDict[Tag{0x3008, 0x0078}] = TagInfo{Tag{0x3008, 0x0078}, "DS", "StartMeterset", "1"}
-	tagDict[Tag{0x3008, 0x007A}] = TagInfo{Tag{0x3008, 0x007A}, "DS", "EndMeterset", "1"}
-	tagDict[Tag{0x3008, 0x0080}] = TagInfo{Tag{0x3008, 0x0080}, "SQ", "ReferencedMeasuredDoseReferenceSequence", "1"}
-	tagDict[Tag{0x3008, 0x0082}] = TagInfo{Tag{0x3008, 0x0082}, "IS", "ReferencedMeasuredDoseReferenceNumber", "1"}
-	tagDict[Tag{0x3008, 0x0090}] = TagInfo{Tag{0x3008, 0x0090}, "SQ", "ReferencedCalculatedDoseReferenceSequence", "1"}
-	tagDict[Tag{0x3008, 0x0092}] = TagInfo{Tag{0x3008, 0x0092}, "IS", "ReferencedCalculatedDoseReferenceNumber", "1"}
-	tagDict[Tag{0x3008, 0x00A0}] = TagInfo{Tag{0x3008, 0x00A0}, "SQ", "BeamLimitingDeviceLeafPairsSequence", "1"}
-	tagDict[Tag{0x3008, 0x00B0}] = TagInfo{Tag{0x3008, 0x00B0}, "SQ", "RecordedWedgeSequence", "1"}
-	tagDict[Tag{0x3008, 0x00C0}] = TagInfo{Tag{0x3008, 0x00C0}, "SQ", "RecordedCompensatorSequence", "1"}
-	tagDict[Tag{0x3008, 0x00D0}] = TagInfo{Tag{0x3008, 0x00D0}, "SQ", "RecordedBlockSequence", "1"}
-	tagDict[Tag{0x3008, 0x00E0}] = TagInfo{Tag{0x3008, 0x00E0}, "SQ", "TreatmentSummaryMeasuredDoseReferenceSequence", "1"}
-	tagDict[Tag{0x3008, 0x00F0}] = TagInfo{Tag{0x3008, 0x00F0}, "SQ", "RecordedSnoutSequence", "1"}
-	tagDict[Tag{0x3008, 0x00F2}] = TagInfo{Tag{0x3008, 0x00F2}, "SQ", "RecordedRangeShifterSequence", "1"}
-	tagDict[Tag{0x3008, 0x00F4}] = TagInfo{Tag{0x3008, 0x00F4}, "SQ", "RecordedLateralSpreadingDeviceSequence", "1"}
-	tagDict[Tag{0x3008, 0x00F6}] = TagInfo{Tag{0x3008, 0x00F6}, "SQ", "RecordedRangeModulatorSequence", "1"}
-	tagDict[Tag{0x3008, 0x0100}] = TagInfo{Tag{0x3008, 0x0100}, "SQ", "RecordedSourceSequence", "1"}
-	tagDict[Tag{0x3008, 0x0105}] = TagInfo{Tag{0x3008, 0x0105}, "LO", "SourceSerialNumber", "1"}
-	tagDict[Tag{0x3008, 0x0110}] = TagInfo{Tag{0x3008, 0x0110}, "SQ", "TreatmentSessionApplicationSetupSequence", "1"}
-	tagDict[Tag{0x3008, 0x0116}] = TagInfo{Tag{0x3008, 0x0116}, "CS", "ApplicationSetupCheck", "1"}
-	tagDict[Tag{0x3008, 0x0120}] = TagInfo{Tag{0x3008, 0x0120}, "SQ", "RecordedBrachyAccessoryDeviceSequence", "1"}
-	tagDict[Tag{0x3008, 0x0122}] = TagInfo{Tag{0x3008, 0x0122}, "IS", "ReferencedBrachyAccessoryDeviceNumber", "1"}
-	tagDict[Tag{0x3008, 0x0130}] = TagInfo{Tag{0x3008, 0x0130}, "SQ", "RecordedChannelSequence", "1"}
-	tagDict[Tag{0x3008, 0x0132}] = TagInfo{Tag{0x3008, 0x0132}, "DS", "SpecifiedChannelTotalTime", "1"}
-	tagDict[Tag{0x3008, 0x0134}] = TagInfo{Tag{0x3008, 0x0134}, "DS", "DeliveredChannelTotalTime", "1"}
-	tagDict[Tag{0x3008, 0x0136}] = TagInfo{Tag{0x3008, 0x0136}, "IS", "SpecifiedNumberOfPulses", "1"}
-	tagDict[Tag{0x3008, 0x0138}] = TagInfo{Tag{0x3008, 0x0138}, "IS", "DeliveredNumberOfPulses", "1"}
-	tagDict[Tag{0x3008, 0x013A}] = TagInfo{Tag{0x3008, 0x013A}, "DS", "SpecifiedPulseRepetitionInterval", "1"}
-	tagDict[Tag{0x3008, 0x013C}] = TagInfo{Tag{0x3008, 0x013C}, "DS", "DeliveredPulseRepetitionInterval", "1"}
-	tagDict[Tag{0x3008, 0x0140}] = TagInfo{Tag{0x3008, 0x0140}, "SQ", "RecordedSourceApplicatorSequence", "1"}
-	tagDict[Tag{0x3008, 0x0142}] = TagInfo{Tag{0x3008, 0x0142}, "IS", "ReferencedSourceApplicatorNumber", "1"}
-	tagDict[Tag{0x3008, 0x0150}] = TagInfo{Tag{0x3008, 0x0150}, "SQ", "RecordedChannelShieldSequence", "1"}
-	tagDict[Tag{0x3008, 0x0152}] = TagInfo{Tag{0x3008, 0x0152}, "IS", "ReferencedChannelShieldNumber", "1"}
-	tagDict[Tag{0x3008, 0x0160}] = TagInfo{Tag{0x3008, 0x0160}, "SQ", "BrachyControlPointDeliveredSequence", "1"}
-	tagDict[Tag{0x3008, 0x0162}] = TagInfo{Tag{0x3008, 0x0162}, "DA", "SafePositionExitDate", "1"}
-	tagDict[Tag{0x3008, 0x0164}] = TagInfo{Tag{0x3008, 0x0164}, "TM", "SafePositionExitTime", "1"}
-	tagDict[Tag{0x3008, 0x0166}] = TagInfo{Tag{0x3008, 0x0166}, "DA", "SafePositionReturnDate", "1"}
-	tagDict[Tag{0x3008, 0x0168}] = TagInfo{Tag{0x3008, 0x0168}, "TM", "SafePositionReturnTime", "1"}
-	tagDict[Tag{0x3008, 0x0200}] = TagInfo{Tag{0x3008, 0x0200}, "CS", "CurrentTreatmentStatus", "1"}
-	tagDict[Tag{0x3008, 0x0202}] = TagInfo{Tag{0x3008, 0x0202}, "ST", "TreatmentStatusComment", "1"}
-	tagDict[Tag{0x3008, 0x0220}] = TagInfo{Tag{0x3008, 0x0220}, "SQ", "FractionGroupSummarySequence", "1"}
-	tagDict[Tag{0x3008, 0x0223}] = TagInfo{Tag{0x3008, 0x0223}, "IS", "ReferencedFractionNumber", "1"}
-	tagDict[Tag{0x3008, 0x0224}] = TagInfo{Tag{0x3008, 0x0224}, "CS", "FractionGroupType", "1"}
-	tagDict[Tag{0x3008, 0x0230}] = TagInfo{Tag{0x3008, 0x0230}, "CS", "BeamStopperPosition", "1"}
-	tagDict[Tag{0x3008, 0x0240}] = TagInfo{Tag{0x3008, 0x0240}, "SQ", "FractionStatusSummarySequence", "1"}
-	tagDict[Tag{0x3008, 0x0250}] = TagInfo{Tag{0x3008, 0x0250}, "DA", "TreatmentDate", "1"}
-	tagDict[Tag{0x3008, 0x0251}] = TagInfo{Tag{0x3008, 0x0251}, "TM", "TreatmentTime", "1"}
-	tagDict[Tag{0x300A, 0x0002}] = TagInfo{Tag{0x300A, 0x0002}, "SH", "RTPlanLabel", "1"}
-	tagDict[Tag{0x300A, 0x0003}] = TagInfo{Tag{0x300A, 0x0003}, "LO", "RTPlanName", "1"}
-	tagDict[Tag{0x300A, 0x0004}] = TagInfo{Tag{0x300A, 0x0004}, "ST", "RTPlanDescription", "1"}
-	tagDict[Tag{0x300A, 0x0006}] = TagInfo{Tag{0x300A, 0x0006}, "DA", "RTPlanDate", "1"}
-	tagDict[Tag{0x300A, 0x0007}] = TagInfo{Tag{0x300A, 0x0007}, "TM", "RTPlanTime", "1"}
-	tagDict[Tag{0x300A, 0x0009}] = TagInfo{Tag{0x300A, 0x0009}, "LO", "TreatmentProtocols", "1-n"}
-	tagDict[Tag{0x300A, 0x000A}] = TagInfo{Tag{0x300A, 0x000A}, "CS", "PlanIntent", "1"}
-	tagDict[Tag{0x300A, 0x000B}] = TagInfo{Tag{0x300A, 0x000B}, "LO", "TreatmentSites", "1-n"}
-	tagDict[Tag{0x300A, 0x000C}] = TagInfo{Tag{0x300A, 0x000C}, "CS", "RTPlanGeometry", "1"}
-	tagDict[Tag{0x300A, 0x000E}] = TagInfo{Tag{0x300A, 0x000E}, "ST", "PrescriptionDescription", "1"}
-	tagDict[Tag{0x300A, 0x0010}] = TagInfo{Tag{0x300A, 0x0010}, "SQ", "DoseReferenceSequence", "1"}
-	tagDict[Tag{0x300A, 0x0012}] = TagInfo{Tag{0x300A, 0x0012}, "IS", "DoseReferenceNumber", "1"}
-	tagDict[Tag{0x300A, 0x0013}] = TagInfo{Tag{0x300A, 0x0013}, "UI", "DoseReferenceUID", "1"}
-	tagDict[Tag{0x300A, 0x0014}] = TagInfo{Tag{0x300A, 0x0014}, "CS", "DoseReferenceStructureType", "1"}
-	tagDict[Tag{0x300A, 0x0015}] = TagInfo{Tag{0x300A, 0x0015}, "CS", "NominalBeamEnergyUnit", "1"}
-	tagDict[Tag{0x300A, 0x0016}] = TagInfo{Tag{0x300A, 0x0016}, "LO", "DoseReferenceDescription", "1"}
-	tagDict[Tag{0x300A, 0x0018}] = TagInfo{Tag{0x300A, 0x0018}, "DS", "DoseReferencePointCoordinates", "3"}
-	tagDict[Tag{0x300A, 0x001A}] = TagInfo{Tag{0x300A, 0x001A}, "DS", "NominalPriorDose", "1"}
-	tagDict[Tag{0x300A, 0x0020}] = TagInfo{Tag{0x300A, 0x0020}, "CS", "DoseReferenceType", "1"}
-	tagDict[Tag{0x300A, 0x0021}] = TagInfo{Tag{0x300A, 0x0021}, "DS", "ConstraintWeight", "1"}
-	tagDict[Tag{0x300A, 0x0022}] = TagInfo{Tag{0x300A, 0x0022}, "DS", "DeliveryWarningDose", "1"}
-	tagDict[Tag{0x300A, 0x0023}] = TagInfo{Tag{0x300A, 0x0023}, "DS", "DeliveryMaximumDose", "1"}
-	tagDict[Tag{0x300A, 0x0025}] = TagInfo{Tag{0x300A, 0x0025}, "DS", "TargetMinimumDose", "1"}
-	tagDict[Tag{0x300A, 0x0026}] = TagInfo{Tag{0x300A, 0x0026}, "DS", "TargetPrescriptionDose", "1"}
-	tagDict[Tag{0x300A, 0x0027}] = TagInfo{Tag{0x300A, 0x0027}, "DS", "TargetMaximumDose", "1"}
-	tagDict[Tag{0x300A, 0x0028}] = TagInfo{Tag{0x300A, 0x0028}, "DS", "TargetUnderdoseVolumeFraction", "1"}
-	tagDict[Tag{0x300A, 0x002A}] = TagInfo{Tag{0x300A, 0x002A}, "DS", "OrganAtRiskFullVolumeDose", "1"}
-	tagDict[Tag{0x300A, 0x002B}] = TagInfo{Tag{0x300A, 0x002B}, "DS", "OrganAtRiskLimitDose", "1"}
-	tagDict[Tag{0x300A, 0x002C}] = TagInfo{Tag{0x300A, 0x002C}, "DS", "OrganAtRiskMaximumDose", "1"}
-	tagDict[Tag{0x300A, 0x002D}] = TagInfo{Tag{0x300A, 0x002D}, "DS", "OrganAtRiskOverdoseVolumeFraction", "1"}
-	tagDict[Tag{0x300A, 0x0040}] = TagInfo{Tag{0x300A, 0x0040}, "SQ", "ToleranceTableSequence", "1"}
-	tagDict[Tag{0x300A, 0x0042}] = TagInfo{Tag{0x300A, 0x0042}, "IS", "ToleranceTableNumber", "1"}
-	tagDict[Tag{0x300A, 0x0043}] = TagInfo{Tag{0x300A, 0x0043}, "SH", "ToleranceTableLabel", "1"}
-	tagDict[Tag{0x300A, 0x0044}] = TagInfo{Tag{0x300A, 0x0044}, "DS", "GantryAngleTolerance", "1"}
-	tagDict[Tag{0x300A, 0x0046}] = TagInfo{Tag{0x300A, 0x0046}, "DS", "BeamLimitingDeviceAngleTolerance", "1"}
-	tagDict[Tag{0x300A, 0x0048}] = TagInfo{Tag{0x300A, 0x0048}, "SQ", "BeamLimitingDeviceToleranceSequence", "1"}
-	tagDict[Tag{0x300A, 0x004A}] = TagInfo{Tag{0x300A, 0x004A}, "DS", "BeamLimitingDevicePositionTolerance", "1"}
-	tagDict[Tag{0x300A, 0x004B}] = TagInfo{Tag{0x300A, 0x004B}, "FL", "SnoutPositionTolerance", "1"}
-	tagDict[Tag{0x300A, 0x004C}] = TagInfo{Tag{0x300A, 0x004C}, "DS", "PatientSupportAngleTolerance", "1"}
-	tagDict[Tag{0x300A, 0x004E}] = TagInfo{Tag{0x300A, 0x004E}, "DS", "TableTopEccentricAngleTolerance", "1"}
-	tagDict[Tag{0x300A, 0x004F}] = TagInfo{Tag{0x300A, 0x004F}, "FL", "TableTopPitchAngleTolerance", "1"}
-	tagDict[Tag{0x300A, 0x0050}] = TagInfo{Tag{0x300A, 0x0050}, "FL", "TableTopRollAngleTolerance", "1"}
-	tagDict[Tag{0x300A, 0x0051}] = TagInfo{Tag{0x300A, 0x0051}, "DS", "TableTopVerticalPositionTolerance", "1"}
-	tagDict[Tag{0x300A, 0x0052}] = TagInfo{Tag{0x300A, 0x0052}, "DS", "TableTopLongitudinalPositionTolerance", "1"}
-	tagDict[Tag{0x300A, 0x0053}] = TagInfo{Tag{0x300A, 0x0053}, "DS", "TableTopLateralPositionTolerance", "1"}
-	tagDict[Tag{0x300A, 0x0055}] = TagInfo{Tag{0x300A, 0x0055}, "CS", "RTPlanRelationship", "1"}
-	tagDict[Tag{0x300A, 0x0070}] = TagInfo{Tag{0x300A, 0x0070}, "SQ", "FractionGroupSequence", "1"}
-	tagDict[Tag{0x300A, 0x0071}] = TagInfo{Tag{0x300A, 0x0071}, "IS", "FractionGroupNumber", "1"}
-	tagDict[Tag{0x300A, 0x0072}] = TagInfo{Tag{0x300A, 0x0072}, "LO", "FractionGroupDescription", "1"}
-	tagDict[Tag{0x300A, 0x0078}] = TagInfo{Tag{0x300A, 0x0078}, "IS", "NumberOfFractionsPlanned", "1"}
-	tagDict[Tag{0x300A, 0x0079}] = TagInfo{Tag{0x300A, 0x0079}, "IS", "NumberOfFractionPatternDigitsPerDay", "1"}
-	tagDict[Tag{0x300A, 0x007A}] = TagInfo{Tag{0x300A, 0x007A}, "IS", "RepeatFractionCycleLength", "1"}
-	tagDict[Tag{0x300A, 0x007B}] = TagInfo{Tag{0x300A, 0x007B}, "LT", "FractionPattern", "1"}
-	tagDict[Tag{0x300A, 0x0080}] = TagInfo{Tag{0x300A, 0x0080}, "IS", "NumberOfBeams", "1"}
-	tagDict[Tag{0x300A, 0x0082}] = TagInfo{Tag{0x300A, 0x0082}, "DS", "BeamDoseSpecificationPoint", "3"}
-	tagDict[Tag{0x300A, 0x0084}] = TagInfo{Tag{0x300A, 0x0084}, "DS", "BeamDose", "1"}
-	tagDict[Tag{0x300A, 0x0086}] = TagInfo{Tag{0x300A, 0x0086}, "DS", "BeamMeterset", "1"}
-	tagDict[Tag{0x300A, 0x0088}] = TagInfo{Tag{0x300A, 0x0088}, "FL", "BeamDosePointDepth", "1"}
-	tagDict[Tag{0x300A, 0x0089}] = TagInfo{Tag{0x300A, 0x0089}, "FL", "BeamDosePointEquivalentDepth", "1"}
-	tagDict[Tag{0x300A, 0x008A}] = TagInfo{Tag{0x300A, 0x008A}, "FL", "BeamDosePointSSD", "1"}
-	tagDict[Tag{0x300A, 0x00A0}] = TagInfo{Tag{0x300A, 0x00A0}, "IS", "NumberOfBrachyApplicationSetups", "1"}
-	tagDict[Tag{0x300A, 0x00A2}] = TagInfo{Tag{0x300A, 0x00A2}, "DS", "BrachyApplicationSetupDoseSpecificationPoint", "3"}
-	tagDict[Tag{0x300A, 0x00A4}] = TagInfo{Tag{0x300A, 0x00A4}, "DS", "BrachyApplicationSetupDose", "1"}
-	tagDict[Tag{0x300A, 0x00B0}] = TagInfo{Tag{0x300A, 0x00B0}, "SQ", "BeamSequence", "1"}
-	tagDict[Tag{0x300A, 0x00B2}] = TagInfo{Tag{0x300A, 0x00B2}, "SH", "TreatmentMachineName", "1"}
-	tagDict[Tag{0x300A, 0x00B3}] = TagInfo{Tag{0x300A, 0x00B3}, "CS", "PrimaryDosimeterUnit", "1"}
-	tagDict[Tag{0x300A, 0x00B4}] = TagInfo{Tag{0x300A, 0x00B4}, "DS", "SourceAxisDistance", "1"}
-	tagDict[Tag{0x300A, 0x00B6}] = TagInfo{Tag{0x300A, 0x00B6}, "SQ", "BeamLimitingDeviceSequence", "1"}
-	tagDict[Tag{0x300A, 0x00B8}] = TagInfo{Tag{0x300A, 0x00B8}, "CS", "RTBeamLimitingDeviceType", "1"}
-	tagDict[Tag{0x300A, 0x00BA}] = TagInfo{Tag{0x300A, 0x00BA}, "DS", "SourceToBeamLimitingDeviceDistance", "1"}
-	tagDict[Tag{0x300A, 0x00BB}] = TagInfo{Tag{0x300A, 0x00BB}, "FL", "IsocenterToBeamLimitingDeviceDistance", "1"}
-	tagDict[Tag{0x300A, 0x00BC}] = TagInfo{Tag{0x300A, 0x00BC}, "IS", "NumberOfLeafJawPairs", "1"}
-	tagDict[Tag{0x300A, 0x00BE}] = TagInfo{Tag{0x300A, 0x00BE}, "DS", "LeafPositionBoundaries", "3-n"}
-	tagDict[Tag{0x300A, 0x00C0}] = TagInfo{Tag{0x300A, 0x00C0}, "IS", "BeamNumber", "1"}
-	tagDict[Tag{0x300A, 0x00C2}] = TagInfo{Tag{0x300A, 0x00C2}, "LO", "BeamName", "1"}
-	tagDict[Tag{0x300A, 0x00C3}] = TagInfo{Tag{0x300A, 0x00C3}, "ST", "BeamDescription", "1"}
-	tagDict[Tag{0x300A, 0x00C4}] = TagInfo{Tag{0x300A, 0x00C4}, "CS", "BeamType", "1"}
-	tagDict[Tag{0x300A, 0x00C6}] = TagInfo{Tag{0x300A, 0x00C6}, "CS", "RadiationType", "1"}
-	tagDict[Tag{0x300A, 0x00C7}] = TagInfo{Tag{0x300A, 0x00C7}, "CS", "HighDoseTechniqueType", "1"}
-	tagDict[Tag{0x300A, 0x00C8}] = TagInfo{Tag{0x300A, 0x00C8}, "IS", "ReferenceImageNumber", "1"}
-	tagDict[Tag{0x300A, 0x00CA}] = TagInfo{Tag{0x300A, 0x00CA}, "SQ", "PlannedVerificationImageSequence", "1"}
-	tagDict[Tag{0x300A, 0x00CC}] = TagInfo{Tag{0x300A, 0x00CC}, "LO", "ImagingDeviceSpecificAcquisitionParameters", "1-n"}
-	tagDict[Tag{0x300A, 0x00CE}] = TagInfo{Tag{0x300A, 0x00CE}, "CS", "TreatmentDeliveryType", "1"}
-	tagDict[Tag{0x300A, 0x00D0}] = TagInfo{Tag{0x300A, 0x00D0}, "IS", "NumberOfWedges", "1"}
-	tagDict[Tag{0x300A, 0x00D1}] = TagInfo{Tag{0x300A, 0x00D1}, "SQ", "WedgeSequence", "1"}
-	tagDict[Tag{0x300A, 0x00D2}] = TagInfo{Tag{0x300A, 0x00D2}, "IS", "WedgeNumber", "1"}
-	tagDict[Tag{0x300A, 0x00D3}] = TagInfo{Tag{0x300A, 0x00D3}, "CS", "WedgeType", "1"}
-	tagDict[Tag{0x300A, 0x00D4}] = TagInfo{Tag{0x300A, 0x00D4}, "SH", "WedgeID", "1"}
-	tagDict[Tag{0x300A, 0x00D5}] = TagInfo{Tag{0x300A, 0x00D5}, "IS", "WedgeAngle", "1"}
-	tagDict[Tag{0x300A, 0x00D6}] = TagInfo{Tag{0x300A, 0x00D6}, "DS", "WedgeFactor", "1"}
-	tagDict[Tag{0x300A, 0x00D7}] = TagInfo{Tag{0x300A, 0x00D7}, "FL", "TotalWedgeTrayWaterEquivalentThickness", "1"}
-	tagDict[Tag{0x300A, 0x00D8}] = TagInfo{Tag{0x300A, 0x00D8}, "DS", "WedgeOrientation", "1"}
-	tagDict[Tag{0x300A, 0x00D9}] = TagInfo{Tag{0x300A, 0x00D9}, "FL", "IsocenterToWedgeTrayDistance", "1"}
-	tagDict[Tag{0x300A, 0x00DA}] = TagInfo{Tag{0x300A, 0x00DA}, "DS", "SourceToWedgeTrayDistance", "1"}
-	tagDict[Tag{0x300A, 0x00DB}] = TagInfo{Tag{0x300A, 0x00DB}, "FL", "WedgeThinEdgePosition", "1"}
-	tagDict[Tag{0x300A, 0x00DC}] = TagInfo{Tag{0x300A, 0x00DC}, "SH", "BolusID", "1"}
-	tagDict[Tag{0x300A, 0x00DD}] = TagInfo{Tag{0x300A, 0x00DD}, "ST", "BolusDescription", "1"}
-	tagDict[Tag{0x300A, 0x00E0}] = TagInfo{Tag{0x300A, 0x00E0}, "IS", "NumberOfCompensators", "1"}
-	tagDict[Tag{0x300A, 0x00E1}] = TagInfo{Tag{0x300A, 0x00E1}, "SH", "MaterialID", "1"}
-	tagDict[Tag{0x300A, 0x00E2}] = TagInfo{Tag{0x300A, 0x00E2}, "DS", "TotalCompensatorTrayFactor", "1"}
-	tagDict[Tag{0x300A, 0x00E3}] = TagInfo{Tag{0x300A, 0x00E3}, "SQ", "CompensatorSequence", "1"}
-	tagDict[Tag{0x300A, 0x00E4}] = TagInfo{Tag{0x300A, 0x00E4}, "IS", "CompensatorNumber", "1"}
-	tagDict[Tag{0x300A, 0x00E5}] = TagInfo{Tag{0x300A, 0x00E5}, "SH", "CompensatorID", "1"}
-	tagDict[Tag{0x300A, 0x00E6}] = TagInfo{Tag{0x300A, 0x00E6}, "DS", "SourceToCompensatorTrayDistance", "1"}
-	tagDict[Tag{0x300A, 0x00E7}] = TagInfo{Tag{0x300A, 0x00E7}, "IS", "CompensatorRows", "1"}
-	tagDict[Tag{0x300A, 0x00E8}] = TagInfo{Tag{0x300A, 0x00E8}, "IS", "CompensatorColumns", "1"}
-	tagDict[Tag{0x300A, 0x00E9}] = TagInfo{Tag{0x300A, 0x00E9}, "DS", "CompensatorPixelSpacing", "2"}
-	tagDict[Tag{0x300A, 0x00EA}] = TagInfo{Tag{0x300A, 0x00EA}, "DS", "CompensatorPosition", "2"}
-	tagDict[Tag{0x300A, 0x00EB}] = TagInfo{Tag{0x300A, 0x00EB}, "DS", "CompensatorTransmissionData", "1-n"}
-	tagDict[Tag{0x300A, 0x00EC}] = TagInfo{Tag{0x300A, 0x00EC}, "DS", "CompensatorThicknessData", "1-n"}
-	tagDict[Tag{0x300A, 0x00ED}] = TagInfo{Tag{0x300A, 0x00ED}, "IS", "NumberOfBoli", "1"}
-	tagDict[Tag{0x300A, 0x00EE}] = TagInfo{Tag{0x300A, 0x00EE}, "CS", "CompensatorType", "1"}
-	tagDict[Tag{0x300A, 0x00F0}] = TagInfo{Tag{0x300A, 0x00F0}, "IS", "NumberOfBlocks", "1"}
-	tagDict[Tag{0x300A, 0x00F2}] = TagInfo{Tag{0x300A, 0x00F2}, "DS", "TotalBlockTrayFactor", "1"}
-	tagDict[Tag{0x300A, 0x00F3}] = TagInfo{Tag{0x300A, 0x00F3}, "FL", "TotalBlockTrayWaterEquivalentThickness", "1"}
-	tagDict[Tag{0x300A, 0x00F4}] = TagInfo{Tag{0x300A, 0x00F4}, "SQ", "BlockSequence", "1"}
-	tagDict[Tag{0x300A, 0x00F5}] = TagInfo{Tag{0x300A, 0x00F5}, "SH", "BlockTrayID", "1"}
-	tagDict[Tag{0x300A, 0x00F6}] = TagInfo{Tag{0x300A, 0x00F6}, "DS", "SourceToBlockTrayDistance", "1"}
-	tagDict[Tag{0x300A, 0x00F7}] = TagInfo{Tag{0x300A, 0x00F7}, "FL", "IsocenterToBlockTrayDistance", "1"}
-	tagDict[Tag{0x300A, 0x00F8}] = TagInfo{Tag{0x300A, 0x00F8}, "CS", "BlockType", "1"}
-	tagDict[Tag{0x300A, 0x00F9}] = TagInfo{Tag{0x300A, 0x00F9}, "LO", "AccessoryCode", "1"}
-	tagDict[Tag{0x300A, 0x00FA}] = TagInfo{Tag{0x300A, 0x00FA}, "CS", "BlockDivergence", "1"}
-	tagDict[Tag{0x300A, 0x00FB}] = TagInfo{Tag{0x300A, 0x00FB}, "CS", "BlockMountingPosition", "1"}
-	tagDict[Tag{0x300A, 0x00FC}] = TagInfo{Tag{0x300A, 0x00FC}, "IS", "BlockNumber", "1"}
-	tagDict[Tag{0x300A, 0x00FE}] = TagInfo{Tag{0x300A, 0x00FE}, "LO", "BlockName", "1"}
-	tagDict[Tag{0x300A, 0x0100}] = TagInfo{Tag{0x300A, 0x0100}, "DS", "BlockThickness", "1"}
-	tagDict[Tag{0x300A, 0x0102}] = TagInfo{Tag{0x300A, 0x0102}, "DS", "BlockTransmission", "1"}
-	tagDict[Tag{0x300A, 0x0104}] = TagInfo{Tag{0x300A, 0x0104}, "IS", "BlockNumberOfPoints", "1"}
-	tagDict[Tag{0x300A, 0x0106}] = TagInfo{Tag{0x300A, 0x0106}, "DS", "BlockData", "2-2n"}
-	tagDict[Tag{0x300A, 0x0107}] = TagInfo{Tag{0x300A, 0x0107}, "SQ", "ApplicatorSequence", "1"}
-	tagDict[Tag{0x300A, 0x0108}] = TagInfo{Tag{0x300A, 0x0108}, "SH", "ApplicatorID", "1"}
-	tagDict[Tag{0x300A, 0x0109}] = TagInfo{Tag{0x300A, 0x0109}, "CS", "ApplicatorType", "1"}
-	tagDict[Tag{0x300A, 0x010A}] = TagInfo{Tag{0x300A, 0x010A}, "LO", "ApplicatorDescription", "1"}
-	tagDict[Tag{0x300A, 0x010C}] = TagInfo{Tag{0x300A, 0x010C}, "DS", "CumulativeDoseReferenceCoefficient", "1"}
-	tagDict[Tag{0x300A, 0x010E}] = TagInfo{Tag{0x300A, 0x010E}, "DS", "FinalCumulativeMetersetWeight", "1"}
-	tagDict[Tag{0x300A, 0x0110}] = TagInfo{Tag{0x300A, 0x0110}, "IS", "NumberOfControlPoints", "1"}
-	tagDict[Tag{0x300A, 0x0111}] = TagInfo{Tag{0x300A, 0x0111}, "SQ", "ControlPointSequence", "1"}
-	tagDict[Tag{0x300A, 0x0112}] = TagInfo{Tag{0x300A, 0x0112}, "IS", "ControlPointIndex", "1"}
-	tagDict[Tag{0x300A, 0x0114}] = TagInfo{Tag{0x300A, 0x0114}, "DS", "NominalBeamEnergy", "1"}
-	tagDict[Tag{0x300A, 0x0115}] = TagInfo{Tag{0x300A, 0x0115}, "DS", "DoseRateSet", "1"}
-	tagDict[Tag{0x300A, 0x0116}] = TagInfo{Tag{0x300A, 0x0116}, "SQ", "WedgePositionSequence", "1"}
-	tagDict[Tag{0x300A, 0x0118}] = TagInfo{Tag{0x300A, 0x0118}, "CS", "WedgePosition", "1"}
-	tagDict[Tag{0x300A, 0x011A}] = TagInfo{Tag{0x300A, 0x011A}, "SQ", "BeamLimitingDevicePositionSequence", "1"}
-	tagDict[Tag{0x300A, 0x011C}] = TagInfo{Tag{0x300A, 0x011C}, "DS", "LeafJawPositions", "2-2n"}
-	tagDict[Tag{0x300A, 0x011E}] = TagInfo{Tag{0x300A, 0x011E}, "DS", "GantryAngle", "1"}
-	tagDict[Tag{0x300A, 0x011F}] = TagInfo{Tag{0x300A, 0x011F}, "CS", "GantryRotationDirection", "1"}
-	tagDict[Tag{0x300A, 0x0120}] = TagInfo{Tag{0x300A, 0x0120}, "DS", "BeamLimitingDeviceAngle", "1"}
-	tagDict[Tag{0x300A, 0x0121}] = TagInfo{Tag{0x300A, 0x0121}, "CS", "BeamLimitingDeviceRotationDirection", "1"}
-	tagDict[Tag{0x300A, 0x0122}] = TagInfo{Tag{0x300A, 0x0122}, "DS", "PatientSupportAngle", "1"}
-	tagDict[Tag{0x300A, 0x0123}] = TagInfo{Tag{0x300A, 0x0123}, "CS", "PatientSupportRotationDirection", "1"}
-	tagDict[Tag{0x300A, 0x0124}] = TagInfo{Tag{0x300A, 0x0124}, "DS", "TableTopEccentricAxisDistance", "1"}
-	tagDict[Tag{0x300A, 0x0125}] = TagInfo{Tag{0x300A, 0x0125}, "DS", "TableTopEccentricAngle", "1"}
-	tagDict[Tag{0x300A, 0x0126}] = TagInfo{Tag{0x300A, 0x0126}, "CS", "TableTopEccentricRotationDirection", "1"}
-	tagDict[Tag{0x300A, 0x0128}] = TagInfo{Tag{0x300A, 0x0128}, "DS", "TableTopVerticalPosition", "1"}
-	tagDict[Tag{0x300A, 0x0129}] = TagInfo{Tag{0x300A, 0x0129}, "DS", "TableTopLongitudinalPosition", "1"}
-	tagDict[Tag{0x300A, 0x012A}] = TagInfo{Tag{0x300A, 0x012A}, "DS", "TableTopLateralPosition", "1"}
-	tagDict[Tag{0x300A, 0x012C}] = TagInfo{Tag{0x300A, 0x012C}, "DS", "IsocenterPosition", "3"}
-	tagDict[Tag{0x300A, 0x012E}] = TagInfo{Tag{0x300A, 0x012E}, "DS", "SurfaceEntryPoint", "3"}
-	tagDict[Tag{0x300A, 0x0130}] = TagInfo{Tag{0x300A, 0x0130}, "DS", "SourceToSurfaceDistance", "1"}
-	tagDict[Tag{0x300A, 0x0134}] = TagInfo{Tag{0x300A, 0x0134}, "DS", "CumulativeMetersetWeight", "1"}
-	tagDict[Tag{0x300A, 0x0140}] = TagInfo{Tag{0x300A, 0x0140}, "FL", "TableTopPitchAngle", "1"}
-	tagDict[Tag{0x300A, 0x0142}] = TagInfo{Tag{0x300A, 0x0142}, "CS", "TableTopPitchRotationDirection", "1"}
-	tagDict[Tag{0x300A, 0x0144}] = TagInfo{Tag{0x300A, 0x0144}, "FL", "TableTopRollAngle", "1"}
-	tagDict[Tag{0x300A, 0x0146}] = TagInfo{Tag{0x300A, 0x0146}, "CS", "TableTopRollRotationDirection", "1"}
-	tagDict[Tag{0x300A, 0x0148}] = TagInfo{Tag{0x300A, 0x0148}, "FL", "HeadFixationAngle", "1"}
-	tagDict[Tag{0x300A, 0x014A}] = TagInfo{Tag{0x300A, 0x014A}, "FL", "GantryPitchAngle", "1"}
-	tagDict[Tag{0x300A, 0x014C}] = TagInfo{Tag{0x300A, 0x014C}, "CS", "GantryPitchRotationDirection", "1"}
-	tagDict[Tag{0x300A, 0x014E}] = TagInfo{Tag{0x300A, 0x014E}, "FL", "GantryPitchAngleTolerance", "1"}
-	tagDict[Tag{0x300A, 0x0180}] = TagInfo{Tag{0x300A, 0x0180}, "SQ", "PatientSetupSequence", "1"}
-	tagDict[Tag{0x300A, 0x0182}] = TagInfo{Tag{0x300A, 0x0182}, "IS", "PatientSetupNumber", "1"}
-	tagDict[Tag{0x300A, 0x0183}] = TagInfo{Tag{0x300A, 0x0183}, "LO", "PatientSetupLabel", "1"}
-	tagDict[Tag{0x300A, 0x0184}] = TagInfo{Tag{0x300A, 0x0184}, "LO", "PatientAdditionalPosition", "1"}
-	tagDict[Tag{0x300A, 0x0190}] = TagInfo{Tag{0x300A, 0x0190}, "SQ", "FixationDeviceSequence", "1"}
-	tagDict[Tag{0x300A, 0x0192}] = TagInfo{Tag{0x300A, 0x0192}, "CS", "FixationDeviceType", "1"}
-	tagDict[Tag{0x300A, 0x0194}] = TagInfo{Tag{0x300A, 0x0194}, "SH", "FixationDeviceLabel", "1"}
-	tagDict[Tag{0x300A, 0x0196}] = TagInfo{Tag{0x300A, 0x0196}, "ST", "FixationDeviceDescription", "1"}
-	tagDict[Tag{0x300A, 0x0198}] = TagInfo{Tag{0x300A, 0x0198}, "SH", "FixationDevicePosition", "1"}
-	tagDict[Tag{0x300A, 0x0199}] = TagInfo{Tag{0x300A, 0x0199}, "FL", "FixationDevicePitchAngle", "1"}
-	tagDict[Tag{0x300A, 0x019A}] = TagInfo{Tag{0x300A, 0x019A}, "FL", "FixationDeviceRollAngle", "1"}
-	tagDict[Tag{0x300A, 0x01A0}] = TagInfo{Tag{0x300A, 0x01A0}, "SQ", "ShieldingDeviceSequence", "1"}
-	tagDict[Tag{0x300A, 0x01A2}] = TagInfo{Tag{0x300A, 0x01A2}, "CS", "ShieldingDeviceType", "1"}
-	tagDict[Tag{0x300A, 0x01A4}] = TagInfo{Tag{0x300A, 0x01A4}, "SH", "ShieldingDeviceLabel", "1"}
-	tagDict[Tag{0x300A, 0x01A6}] = TagInfo{Tag{0x300A, 0x01A6}, "ST", "ShieldingDeviceDescription", "1"}
-	tagDict[Tag{0x300A, 0x01A8}] = TagInfo{Tag{0x300A, 0x01A8}, "SH", "ShieldingDevicePosition", "1"}
-	tagDict[Tag{0x300A, 0x01B0}] = TagInfo{Tag{0x300A, 0x01B0}, "CS", "SetupTechnique", "1"}
-	tagDict[Tag{0x300A, 0x01B2}] = TagInfo{Tag{0x300A, 0x01B2}, "ST", "SetupTechniqueDescription", "1"}
-	tagDict[Tag{0x300A, 0x01B4}] = TagInfo{Tag{0x300A, 0x01B4}, "SQ", "SetupDeviceSequence", "1"}
-	tagDict[Tag{0x300A, 0x01B6}] = TagInfo{Tag{0x300A, 0x01B6}, "CS", "SetupDeviceType", "1"}
-	tagDict[Tag{0x300A, 0x01B8}] = TagInfo{Tag{0x300A, 0x01B8}, "SH", "SetupDeviceLabel", "1"}
-	tagDict[Tag{0x300A, 0x01BA}] = TagInfo{Tag{0x300A, 0x01BA}, "ST", "SetupDeviceDescription", "1"}
-	tagDict[Tag{0x300A, 0x01BC}] = TagInfo{Tag{0x300A, 0x01BC}, "DS", "SetupDeviceParameter", "1"}
-	tagDict[Tag{0x300A, 0x01D0}] = TagInfo{Tag{0x300A, 0x01D0}, "ST", "SetupReferenceDescription", "1"}
-	tagDict[Tag{0x300A, 0x01D2}] = TagInfo{Tag{0x300A, 0x01D2}, "DS", "TableTopVerticalSetupDisplacement", "1"}
-	tagDict[Tag{0x300A, 0x01D4}] = TagInfo{Tag{0x300A, 0x01D4}, "DS", "TableTopLongitudinalSetupDisplacement", "1"}
-	tagDict[Tag{0x300A, 0x01D6}] = TagInfo{Tag{0x300A, 0x01D6}, "DS", "TableTopLateralSetupDisplacement", "1"}
-	tagDict[Tag{0x300A, 0x0200}] = TagInfo{Tag{0x300A, 0x0200}, "CS", "BrachyTreatmentTechnique", "1"}
-	tagDict[Tag{0x300A, 0x0202}] = TagInfo{Tag{0x300A, 0x0202}, "CS", "BrachyTreatmentType", "1"}
-	tagDict[Tag{0x300A, 0x0206}] = TagInfo{Tag{0x300A, 0x0206}, "SQ", "TreatmentMachineSequence", "1"}
-	tagDict[Tag{0x300A, 0x0210}] = TagInfo{Tag{0x300A, 0x0210}, "SQ", "SourceSequence", "1"}
-	tagDict[Tag{0x300A, 0x0212}] = TagInfo{Tag{0x300A, 0x0212}, "IS", "SourceNumber", "1"}
-	tagDict[Tag{0x300A, 0x0214}] = TagInfo{Tag{0x300A, 0x0214}, "CS", "SourceType", "1"}
-	tagDict[Tag{0x300A, 0x0216}] = TagInfo{Tag{0x300A, 0x0216}, "LO", "SourceManufacturer", "1"}
-	tagDict[Tag{0x300A, 0x0218}] = TagInfo{Tag{0x300A, 0x0218}, "DS", "ActiveSourceDiameter", "1"}
-	tagDict[Tag{0x300A, 0x021A}] = TagInfo{Tag{0x300A, 0x021A}, "DS", "ActiveSourceLength", "1"}
-	tagDict[Tag{0x300A, 0x0220}] = TagInfo{Tag{0x300A, 0x0220}, "DS", "ActiveSourceLength2", "1"}
-	tagDict[Tag{0x300A, 0x0222}] = TagInfo{Tag{0x300A, 0x0222}, "DS", "SourceEncapsulationNominalThickness", "1"}
-	tagDict[Tag{0x300A, 0x0224}] = TagInfo{Tag{0x300A, 0x0224}, "DS", "SourceEncapsulationNominalTransmission", "1"}
-	tagDict[Tag{0x300A, 0x0226}] = TagInfo{Tag{0x300A, 0x0226}, "LO", "SourceIsotopeName", "1"}
-	tagDict[Tag{0x300A, 0x0228}] = TagInfo{Tag{0x300A, 0x0228}, "DS", "SourceIsotopeHalfLife", "1"}
-	tagDict[Tag{0x300A, 0x0229}] = TagInfo{Tag{0x300A, 0x0229}, "CS", "SourceStrengthUnits", "1"}
-	tagDict[Tag{0x300A, 0x022A}] = TagInfo{Tag{0x300A, 0x022A}, "DS", "ReferenceAirKermaRate", "1"}
-	tagDict[Tag{0x300A, 0x022B}] = TagInfo{Tag{0x300A, 0x022B}, "DS", "SourceStrength", "1"}
-	tagDict[Tag{0x300A, 0x022C}] = TagInfo{Tag{0x300A, 0x022C}, "DA", "SourceStrengthReferenceDate", "1"}
-	tagDict[Tag{0x300A, 0x022E}] = TagInfo{Tag{0x300A, 0x022E}, "TM", "SourceStrengthReferenceTime", "1"}
-	tagDict[Tag{0x300A, 0x0230}] = TagInfo{Tag{0x300A, 0x0230}, "SQ", "ApplicationSetupSequence", "1"}
-	tagDict[Tag{0x300A, 0x0232}] = TagInfo{Tag{0x300A, 0x0232}, "CS", "ApplicationSetupType", "1"}
-	tagDict[Tag{0x300A, 0x0234}] = TagInfo{Tag{0x300A, 0x0234}, "IS", "ApplicationSetupNumber", "1"}
-	tagDict[Tag{0x300A, 0x0236}] = TagInfo{Tag{0x300A, 0x0236}, "LO", "ApplicationSetupName", "1"}
-	tagDict[Tag{0x300A, 0x0238}] = TagInfo{Tag{0x300A, 0x0238}, "LO", "ApplicationSetupManufacturer", "1"}
-	tagDict[Tag{0x300A, 0x0240}] = TagInfo{Tag{0x300A, 0x0240}, "IS", "TemplateNumber", "1"}
-	tagDict[Tag{0x300A, 0x0242}] = TagInfo{Tag{0x300A, 0x0242}, "SH", "TemplateType", "1"}
-	tagDict[Tag{0x300A, 0x0244}] = TagInfo{Tag{0x300A, 0x0244}, "LO", "TemplateName", "1"}
-	tagDict[Tag{0x300A, 0x0250}] = TagInfo{Tag{0x300A, 0x0250}, "DS", "TotalReferenceAirKerma", "1"}
-	tagDict[Tag{0x300A, 0x0260}] = TagInfo{Tag{0x300A, 0x0260}, "SQ", "BrachyAccessoryDeviceSequence", "1"}
-	tagDict[Tag{0x300A, 0x0262}] = TagInfo{Tag{0x300A, 0x0262}, "IS", "BrachyAccessoryDeviceNumber", "1"}
-	tagDict[Tag{0x300A, 0x0263}] = TagInfo{Tag{0x300A, 0x0263}, "SH", "BrachyAccessoryDeviceID", "1"}
-	tagDict[Tag{0x300A, 0x0264}] = TagInfo{Tag{0x300A, 0x0264}, "CS", "BrachyAccessoryDeviceType", "1"}
-	tagDict[Tag{0x300A, 0x0266}] = TagInfo{Tag{0x300A, 0x0266}, "LO", "BrachyAccessoryDeviceName", "1"}
-	tagDict[Tag{0x300A, 0x026A}] = TagInfo{Tag{0x300A, 0x026A}, "DS", "BrachyAccessoryDeviceNominalThickness", "1"}
-	tagDict[Tag{0x300A, 0x026C}] = TagInfo{Tag{0x300A, 0x026C}, "DS", "BrachyAccessoryDeviceNominalTransmission", "1"}
-	tagDict[Tag{0x300A, 0x0280}] = TagInfo{Tag{0x300A, 0x0280}, "SQ", "ChannelSequence", "1"}
-	tagDict[Tag{0x300A, 0x0282}] = TagInfo{Tag{0x300A, 0x0282}, "IS", "ChannelNumber", "1"}
-	tagDict[Tag{0x300A, 0x0284}] = TagInfo{Tag{0x300A, 0x0284}, "DS", "ChannelLength", "1"}
-	tagDict[Tag{0x300A, 0x0286}] = TagInfo{Tag{0x300A, 0x0286}, "DS", "ChannelTotalTime", "1"}
-	tagDict[Tag{0x300A, 0x0288}] = TagInfo{Tag{0x300A, 0x0288}, "CS", "SourceMovementType", "1"}
-	tagDict[Tag{0x300A, 0x028A}] = TagInfo{Tag{0x300A, 0x028A}, "IS", "NumberOfPulses", "1"}
-	tagDict[Tag{0x300A, 0x028C}] = TagInfo{Tag{0x300A, 0x028C}, "DS", "PulseRepetitionInterval", "1"}
-	tagDict[Tag{0x300A, 0x0290}] = TagInfo{Tag{0x300A, 0x0290}, "IS", "SourceApplicatorNumber", "1"}
-	tagDict[Tag{0x300A, 0x0291}] = TagInfo{Tag{0x300A, 0x0291}, "SH", "SourceApplicatorID", "1"}
-	tagDict[Tag{0x300A, 0x0292}] = TagInfo{Tag{0x300A, 0x0292}, "CS", "SourceApplicatorType", "1"}
-	tagDict[Tag{0x300A, 0x0294}] = TagInfo{Tag{0x300A, 0x0294}, "LO", "SourceApplicatorName", "1"}
-	tagDict[Tag{0x300A, 0x0296}] = TagInfo{Tag{0x300A, 0x0296}, "DS", "SourceApplicatorLength", "1"}
-	tagDict[Tag{0x300A, 0x0298}] = TagInfo{Tag{0x300A, 0x0298}, "LO", "SourceApplicatorManufacturer", "1"}
-	tagDict[Tag{0x300A, 0x029C}] = TagInfo{Tag{0x300A, 0x029C}, "DS", "SourceApplicatorWallNominalThickness", "1"}
-	tagDict[Tag{0x300A, 0x029E}] = TagInfo{Tag{0x300A, 0x029E}, "DS", "SourceApplicatorWallNominalTransmission", "1"}
-	tagDict[Tag{0x300A, 0x02A0}] = TagInfo{Tag{0x300A, 0x02A0}, "DS", "SourceApplicatorStepSize", "1"}
-	tagDict[Tag{0x300A, 0x02A2}] = TagInfo{Tag{0x300A, 0x02A2}, "IS", "TransferTubeNumber", "1"}
-	tagDict[Tag{0x300A, 0x02A4}] = TagInfo{Tag{0x300A, 0x02A4}, "DS", "TransferTubeLength", "1"}
-	tagDict[Tag{0x300A, 0x02B0}] = TagInfo{Tag{0x300A, 0x02B0}, "SQ", "ChannelShieldSequence", "1"}
-	tagDict[Tag{0x300A, 0x02B2}] = TagInfo{Tag{0x300A, 0x02B2}, "IS", "ChannelShieldNumber", "1"}
-	tagDict[Tag{0x300A, 0x02B3}] = TagInfo{Tag{0x300A, 0x02B3}, "SH", "ChannelShieldID", "1"}
-	tagDict[Tag{0x300A, 0x02B4}] = TagInfo{Tag{0x300A, 0x02B4}, "LO", "ChannelShieldName", "1"}
-	tagDict[Tag{0x300A, 0x02B8}] = TagInfo{Tag{0x300A, 0x02B8}, "DS", "ChannelShieldNominalThickness", "1"}
-	tagDict[Tag{0x300A, 0x02BA}] = TagInfo{Tag{0x300A, 0x02BA}, "DS", "ChannelShieldNominalTransmission", "1"}
-	tagDict[Tag{0x300A, 0x02C8}] = TagInfo{Tag{0x300A, 0x02C8}, "DS", "FinalCumulativeTimeWeight", "1"}
-	tagDict[Tag{0x300A, 0x02D0}] = TagInfo{Tag{0x300A, 0x02D0}, "SQ", "BrachyControlPointSequence", "1"}
-	tagDict[Tag{0x300A, 0x02D2}] = TagInfo{Tag{0x300A, 0x02D2}, "DS", "ControlPointRelativePosition", "1"}
-	tagDict[Tag{0x300A, 0x02D4}] = TagInfo{Tag{0x300A, 0x02D4}, "DS", "ControlPoint3DPosition", "3"}
-	tagDict[Tag{0x300A, 0x02D6}] = TagInfo{Tag{0x300A, 0x02D6}, "DS", "CumulativeTimeWeight", "1"}
-	tagDict[Tag{0x300A, 0x02E0}] = TagInfo{Tag{0x300A, 0x02E0}, "CS", "CompensatorDivergence", "1"}
-	tagDict[Tag{0x300A, 0x02E1}] = TagInfo{Tag{0x300A, 0x02E1}, "CS", "CompensatorMountingPosition", "1"}
-	tagDict[Tag{0x300A, 0x02E2}] = TagInfo{Tag{0x300A, 0x02E2}, "DS", "SourceToCompensatorDistance", "1-n"}
-	tagDict[Tag{0x300A, 0x02E3}] = TagInfo{Tag{0x300A, 0x02E3}, "FL", "TotalCompensatorTrayWaterEquivalentThickness", "1"}
-	tagDict[Tag{0x300A, 0x02E4}] = TagInfo{Tag{0x300A, 0x02E4}, "FL", "IsocenterToCompensatorTrayDistance", "1"}
-	tagDict[Tag{0x300A, 0x02E5}] = TagInfo{Tag{0x300A, 0x02E5}, "FL", "CompensatorColumnOffset", "1"}
-	tagDict[Tag{0x300A, 0x02E6}] = TagInfo{Tag{0x300A, 0x02E6}, "FL", "IsocenterToCompensatorDistances", "1-n"}
-	tagDict[Tag{0x300A, 0x02E7}] = TagInfo{Tag{0x300A, 0x02E7}, "FL", "CompensatorRelativeStoppingPowerRatio", "1"}
-	tagDict[Tag{0x300A, 0x02E8}] = TagInfo{Tag{0x300A, 0x02E8}, "FL", "CompensatorMillingToolDiameter", "1"}
-	tagDict[Tag{0x300A, 0x02EA}] = TagInfo{Tag{0x300A, 0x02EA}, "SQ", "IonRangeCompensatorSequence", "1"}
-	tagDict[Tag{0x300A, 0x02EB}] = TagInfo{Tag{0x300A, 0x02EB}, "LT", "CompensatorDescription", "1"}
-	tagDict[Tag{0x300A, 0x0302}] = TagInfo{Tag{0x300A, 0x0302}, "IS", "RadiationMassNumber", "1"}
-	tagDict[Tag{0x300A, 0x0304}] = TagInfo{Tag{0x300A, 0x0304}, "IS", "RadiationAtomicNumber", "1"}
-	tagDict[Tag{0x300A, 0x0306}] = TagInfo{Tag{0x300A, 0x0306}, "SS", "RadiationChargeState", "1"}
-	tagDict[Tag{0x300A, 0x0308}] = TagInfo{Tag{0x300A, 0x0308}, "CS", "ScanMode", "1"}
-	tagDict[Tag{0x300A, 0x030A}] = TagInfo{Tag{0x300A, 0x030A}, "FL", "VirtualSourceAxisDistances", "2"}
-	tagDict[Tag{0x300A, 0x030C}] = TagInfo{Tag{0x300A, 0x030C}, "SQ", "SnoutSequence", "1"}
-	tagDict[Tag{0x300A, 0x030D}] = TagInfo{Tag{0x300A, 0x030D}, "FL", "SnoutPosition", "1"}
-	tagDict[Tag{0x300A, 0x030F}] = TagInfo{Tag{0x300A, 0x030F}, "SH", "SnoutID", "1"}
-	tagDict[Tag{0x300A, 0x0312}] = TagInfo{Tag{0x300A, 0x0312}, "IS", "NumberOfRangeShifters", "1"}
-	tagDict[Tag{0x300A, 0x0314}] = TagInfo{Tag{0x300A, 0x0314}, "SQ", "RangeShifterSequence", "1"}
-	tagDict[Tag{0x300A, 0x0316}] = TagInfo{Tag{0x300A, 0x0316}, "IS", "RangeShifterNumber", "1"}
-	tagDict[Tag{0x300A, 0x0318}] = TagInfo{Tag{0x300A, 0x0318}, "SH", "RangeShifterID", "1"}
-	tagDict[Tag{0x300A, 0x0320}] = TagInfo{Tag{0x300A, 0x0320}, "CS", "RangeShifterType", "1"}
-	tagDict[Tag{0x300A, 0x0322}] = TagInfo{Tag{0x300A, 0x0322}, "LO", "RangeShifterDescription", "1"}
-	tagDict[Tag{0x300A, 0x0330}] = TagInfo{Tag{0x300A, 0x0330}, "IS", "NumberOfLateralSpreadingDevices", "1"}
-	tagDict[Tag{0x300A, 0x0332}] = TagInfo{Tag{0x300A, 0x0332}, "SQ", "LateralSpreadingDeviceSequence", "1"}
-	tagDict[Tag{0x300A, 0x0334}] = TagInfo{Tag{0x300A, 0x0334}, "IS", "LateralSpreadingDeviceNumber", "1"}
-	tagDict[Tag{0x300A, 0x0336}] = TagInfo{Tag{0x300A, 0x0336}, "SH", "LateralSpreadingDeviceID", "1"}
-	tagDict[Tag{0x300A, 0x0338}] = TagInfo{Tag{0x300A, 0x0338}, "CS", "LateralSpreadingDeviceType", "1"}
-	tagDict[Tag{0x300A, 0x033A}] = TagInfo{Tag{0x300A, 0x033A}, "LO", "LateralSpreadingDeviceDescription", "1"}
-	tagDict[Tag{0x300A, 0x033C}] = TagInfo{Tag{0x300A, 0x033C}, "FL", "LateralSpreadingDeviceWaterEquivalentThickness", "1"}
-	tagDict[Tag{0x300A, 0x0340}] = TagInfo{Tag{0x300A, 0x0340}, "IS", "NumberOfRangeModulators", "1"}
-	tagDict[Tag{0x300A, 0x0342}] = TagInfo{Tag{0x300A, 0x0342}, "SQ", "RangeModulatorSequence", "1"}
-	tagDict[Tag{0x300A, 0x0344}] = TagInfo{Tag{0x300A, 0x0344}, "IS", "RangeModulatorNumber", "1"}
-	tagDict[Tag{0x300A, 0x0346}] = TagInfo{Tag{0x300A, 0x0346}, "SH", "RangeModulatorID", "1"}
-	tagDict[Tag{0x300A, 0x0348}] = TagInfo{Tag{0x300A, 0x0348}, "CS", "RangeModulatorType", "1"}
-	tagDict[Tag{0x300A, 0x034A}] = TagInfo{Tag{0x300A, 0x034A}, "LO", "RangeModulatorDescription", "1"}
-	tagDict[Tag{0x300A, 0x034C}] = TagInfo{Tag{0x300A, 0x034C}, "SH", "BeamCurrentModulationID", "1"}
-	tagDict[Tag{0x300A, 0x0350}] = TagInfo{Tag{0x300A, 0x0350}, "CS", "PatientSupportType", "1"}
-	tagDict[Tag{0x300A, 0x0352}] = TagInfo{Tag{0x300A, 0x0352}, "SH", "PatientSupportID", "1"}
-	tagDict[Tag{0x300A, 0x0354}] = TagInfo{Tag{0x300A, 0x0354}, "LO", "PatientSupportAccessoryCode", "1"}
-	tagDict[Tag{0x300A, 0x0356}] = TagInfo{Tag{0x300A, 0x0356}, "FL", "FixationLightAzimuthalAngle", "1"}
-	tagDict[Tag{0x300A, 0x0358}] = TagInfo{Tag{0x300A, 0x0358}, "FL", "FixationLightPolarAngle", "1"}
-	tagDict[Tag{0x300A, 0x035A}] = TagInfo{Tag{0x300A, 0x035A}, "FL", "MetersetRate", "1"}
-	tagDict[Tag{0x300A, 0x0360}] = TagInfo{Tag{0x300A, 0x0360}, "SQ", "RangeShifterSettingsSequence", "1"}
-	tagDict[Tag{0x300A, 0x0362}] = TagInfo{Tag{0x300A, 0x0362}, "LO", "RangeShifterSetting", "1"}
-	tagDict[Tag{0x300A, 0x0364}] = TagInfo{Tag{0x300A, 0x0364}, "FL", "IsocenterToRangeShifterDistance", "1"}
-	tagDict[Tag{0x300A, 0x0366}] = TagInfo{Tag{0x300A, 0x0366}, "FL", "RangeShifterWaterEquivalentThickness", "1"}
-	tagDict[Tag{0x300A, 0x0370}] = TagInfo{Tag{0x300A, 0x0370}, "SQ", "LateralSpreadingDeviceSettingsSequence", "1"}
-	tagDict[Tag{0x300A, 0x0372}] = TagInfo{Tag{0x300A, 0x0372}, "LO", "LateralSpreadingDeviceSetting", "1"}
-	tagDict[Tag{0x300A, 0x0374}] = TagInfo{Tag{0x300A, 0x0374}, "FL", "IsocenterToLateralSpreadingDeviceDistance", "1"}
-	tagDict[Tag{0x300A, 0x0380}] = TagInfo{Tag{0x300A, 0x0380}, "SQ", "RangeModulatorSettingsSequence", "1"}
-	tagDict[Tag{0x300A, 0x0382}] = TagInfo{Tag{0x300A, 0x0382}, "FL", "RangeModulatorGatingStartValue", "1"}
-	tagDict[Tag{0x300A, 0x0384}] = TagInfo{Tag{0x300A, 0x0384}, "FL", "RangeModulatorGatingStopValue", "1"}
-	tagDict[Tag{0x300A, 0x0386}] = TagInfo{Tag{0x300A, 0x0386}, "FL", "RangeModulatorGatingStartWaterEquivalentThickness", "1"}
-	tagDict[Tag{0x300A, 0x0388}] = TagInfo{Tag{0x300A, 0x0388}, "FL", "RangeModulatorGatingStopWaterEquivalentThickness", "1"}
-	tagDict[Tag{0x300A, 0x038A}] = TagInfo{Tag{0x300A, 0x038A}, "FL", "IsocenterToRangeModulatorDistance", "1"}
-	tagDict[Tag{0x300A, 0x0390}] = TagInfo{Tag{0x300A, 0x0390}, "SH", "ScanSpotTuneID", "1"}
-	tagDict[Tag{0x300A, 0x0392}] = TagInfo{Tag{0x300A, 0x0392}, "IS", "NumberOfScanSpotPositions", "1"}
-	tagDict[Tag{0x300A, 0x0394}] = TagInfo{Tag{0x300A, 0x0394}, "FL", "ScanSpotPositionMap", "1-n"}
-	tagDict[Tag{0x300A, 0x0396}] = TagInfo{Tag{0x300A, 0x0396}, "FL", "ScanSpotMetersetWeights", "1-n"}
-	tagDict[Tag{0x300A, 0x0398}] = TagInfo{Tag{0x300A, 0x0398}, "FL", "ScanningSpotSize", "2"}
-	tagDict[Tag{0x300A, 0x039A}] = TagInfo{Tag{0x300A, 0x039A}, "IS", "NumberOfPaintings", "1"}
-	tagDict[Tag{0x300A, 0x03A0}] = TagInfo{Tag{0x300A, 0x03A0}, "SQ", "IonToleranceTableSequence", "1"}
-	tagDict[Tag{0x300A, 0x03A2}] = TagInfo{Tag{0x300A, 0x03A2}, "SQ", "IonBeamSequence", "1"}
-	tagDict[Tag{0x300A, 0x03A4}] = TagInfo{Tag{0x300A, 0x03A4}, "SQ", "IonBeamLimitingDeviceSequence", "1"}
-	tagDict[Tag{0x300A, 0x03A6}] = TagInfo{Tag{0x300A, 0x03A6}, "SQ", "IonBlockSequence", "1"}
-	tagDict[Tag{0x300A, 0x03A8}] = TagInfo{Tag{0x300A, 0x03A8}, "SQ", "IonControlPointSequence", "1"}
-	tagDict[Tag{0x300A, 0x03AA}] = TagInfo{Tag{0x300A, 0x03AA}, "SQ", "IonWedgeSequence", "1"}
-	tagDict[Tag{0x300A, 0x03AC}] = TagInfo{Tag{0x300A, 0x03AC}, "SQ", "IonWedgePositionSequence", "1"}
-	tagDict[Tag{0x300A, 0x0401}] = TagInfo{Tag{0x300A, 0x0401}, "SQ", "ReferencedSetupImageSequence", "1"}
-	tagDict[Tag{0x300A, 0x0402}] = TagInfo{Tag{0x300A, 0x0402}, "ST", "SetupImageComment", "1"}
-	tagDict[Tag{0x300A, 0x0410}] = TagInfo{Tag{0x300A, 0x0410}, "SQ", "MotionSynchronizationSequence", "1"}
-	tagDict[Tag{0x300A, 0x0412}] = TagInfo{Tag{0x300A, 0x0412}, "FL", "ControlPointOrientation", "3"}
-	tagDict[Tag{0x300A, 0x0420}] = TagInfo{Tag{0x300A, 0x0420}, "SQ", "GeneralAccessorySequence", "1"}
-	tagDict[Tag{0x300A, 0x0421}] = TagInfo{Tag{0x300A, 0x0421}, "SH", "GeneralAccessoryID", "1"}
-	tagDict[Tag{0x300A, 0x0422}] = TagInfo{Tag{0x300A, 0x0422}, "ST", "GeneralAccessoryDescription", "1"}
-	tagDict[Tag{0x300A, 0x0423}] = TagInfo{Tag{0x300A, 0x0423}, "CS", "GeneralAccessoryType", "1"}
-	tagDict[Tag{0x300A, 0x0424}] = TagInfo{Tag{0x300A, 0x0424}, "IS", "GeneralAccessoryNumber", "1"}
-	tagDict[Tag{0x300A, 0x0431}] = TagInfo{Tag{0x300A, 0x0431}, "SQ", "ApplicatorGeometrySequence", "1"}
-	tagDict[Tag{0x300A, 0x0432}] = TagInfo{Tag{0x300A, 0x0432}, "CS", "ApplicatorApertureShape", "1"}
-	tagDict[Tag{0x300A, 0x0433}] = TagInfo{Tag{0x300A, 0x0433}, "FL", "ApplicatorOpening", "1"}
-	tagDict[Tag{0x300A, 0x0434}] = TagInfo{Tag{0x300A, 0x0434}, "FL", "ApplicatorOpeningX", "1"}
-	tagDict[Tag{0x300A, 0x0435}] = TagInfo{Tag{0x300A, 0x0435}, "FL", "ApplicatorOpeningY", "1"}
-	tagDict[Tag{0x300A, 0x0436}] = TagInfo{Tag{0x300A, 0x0436}, "FL", "SourceToApplicatorMountingPositionDistance", "1"}
-	tagDict[Tag{0x300C, 0x0002}] = TagInfo{Tag{0x300C, 0x0002}, "SQ", "ReferencedRTPlanSequence", "1"}
-	tagDict[Tag{0x300C, 0x0004}] = TagInfo{Tag{0x300C, 0x0004}, "SQ", "ReferencedBeamSequence", "1"}
-	tagDict[Tag{0x300C, 0x0006}] = TagInfo{Tag{0x300C, 0x0006}, "IS", "ReferencedBeamNumber", "1"}
-	tagDict[Tag{0x300C, 0x0007}] = TagInfo{Tag{0x300C, 0x0007}, "IS", "ReferencedReferenceImageNumber", "1"}
-	tagDict[Tag{0x300C, 0x0008}] = TagInfo{Tag{0x300C, 0x0008}, "DS", "StartCumulativeMetersetWeight", "1"}
-	tagDict[Tag{0x300C, 0x0009}] = TagInfo{Tag{0x300C, 0x0009}, "DS", "EndCumulativeMetersetWeight", "1"}
-	tagDict[Tag{0x300C, 0x000A}] = TagInfo{Tag{0x300C, 0x000A}, "SQ", "ReferencedBrachyApplicationSetupSequence", "1"}
-	tagDict[Tag{0x300C, 0x000C}] = TagInfo{Tag{0x300C, 0x000C}, "IS", "ReferencedBrachyApplicationSetupNumber", "1"}
-	tagDict[Tag{0x300C, 0x000E}] = TagInfo{Tag{0x300C, 0x000E}, "IS", "ReferencedSourceNumber", "1"}
-	tagDict[Tag{0x300C, 0x0020}] = TagInfo{Tag{0x300C, 0x0020}, "SQ", "ReferencedFractionGroupSequence", "1"}
-	tagDict[Tag{0x300C, 0x0022}] = TagInfo{Tag{0x300C, 0x0022}, "IS", "ReferencedFractionGroupNumber", "1"}
-	tagDict[Tag{0x300C, 0x0040}] = TagInfo{Tag{0x300C, 0x0040}, "SQ", "ReferencedVerificationImageSequence", "1"}
-	tagDict[Tag{0x300C, 0x0042}] = TagInfo{Tag{0x300C, 0x0042}, "SQ", "ReferencedReferenceImageSequence", "1"}
-	tagDict[Tag{0x300C, 0x0050}] = TagInfo{Tag{0x300C, 0x0050}, "SQ", "ReferencedDoseReferenceSequence", "1"}
-	tagDict[Tag{0x300C, 0x0051}] = TagInfo{Tag{0x300C, 0x0051}, "IS", "ReferencedDoseReferenceNumber", "1"}
-	tagDict[Tag{0x300C, 0x0055}] = TagInfo{Tag{0x300C, 0x0055}, "SQ", "BrachyReferencedDoseReferenceSequence", "1"}
-	tagDict[Tag{0x300C, 0x0060}] = TagInfo{Tag{0x300C, 0x0060}, "SQ", "ReferencedStructureSetSequence", "1"}
-	tagDict[Tag{0x300C, 0x006A}] = TagInfo{Tag{0x300C, 0x006A}, "IS", "ReferencedPatientSetupNumber", "1"}
-	tagDict[Tag{0x300C, 0x0080}] = TagInfo{Tag{0x300C, 0x0080}, "SQ", "ReferencedDoseSequence", "1"}
-	tagDict[Tag{0x300C, 0x00A0}] = TagInfo{Tag{0x300C, 0x00A0}, "IS", "ReferencedToleranceTableNumber", "1"}
-	tagDict[Tag{0x300C, 0x00B0}] = TagInfo{Tag{0x300C, 0x00B0}, "SQ", "ReferencedBolusSequence", "1"}
-	tagDict[Tag{0x300C, 0x00C0}] = TagInfo{Tag{0x300C, 0x00C0}, "IS", "ReferencedWedgeNumber", "1"}
-	tagDict[Tag{0x300C, 0x00D0}] = TagInfo{Tag{0x300C, 0x00D0}, "IS", "ReferencedCompensatorNumber", "1"}
-	tagDict[Tag{0x300C, 0x00E0}] = TagInfo{Tag{0x300C, 0x00E0}, "IS", "ReferencedBlockNumber", "1"}
-	tagDict[Tag{0x300C, 0x00F0}] = TagInfo{Tag{0x300C, 0x00F0}, "IS", "ReferencedControlPointIndex", "1"}
-	tagDict[Tag{0x300C, 0x00F2}] = TagInfo{Tag{0x300C, 0x00F2}, "SQ", "ReferencedControlPointSequence", "1"}
-	tagDict[Tag{0x300C, 0x00F4}] = TagInfo{Tag{0x300C, 0x00F4}, "IS", "ReferencedStartControlPointIndex", "1"}
-	tagDict[Tag{0x300C, 0x00F6}] = TagInfo{Tag{0x300C, 0x00F6}, "IS", "ReferencedStopControlPointIndex", "1"}
-	tagDict[Tag{0x300C, 0x0100}] = TagInfo{Tag{0x300C, 0x0100}, "IS", "ReferencedRangeShifterNumber", "1"}
-	tagDict[Tag{0x300C, 0x0102}] = TagInfo{Tag{0x300C, 0x0102}, "IS", "ReferencedLateralSpreadingDeviceNumber", "1"}
-	tagDict[Tag{0x300C, 0x0104}] = TagInfo{Tag{0x300C, 0x0104}, "IS", "ReferencedRangeModulatorNumber", "1"}
-	tagDict[Tag{0x300E, 0x0002}] = TagInfo{Tag{0x300E, 0x0002}, "CS", "ApprovalStatus", "1"}
-	tagDict[Tag{0x300E, 0x0004}] = TagInfo{Tag{0x300E, 0x0004}, "DA", "ReviewDate", "1"}
-	tagDict[Tag{0x300E, 0x0005}] = TagInfo{Tag{0x300E, 0x0005}, "TM", "ReviewTime", "1"}
-	tagDict[Tag{0x300E, 0x0008}] = TagInfo{Tag{0x300E, 0x0008}, "PN", "ReviewerName", "1"}
-	tagDict[Tag{0x4010, 0x0001}] = TagInfo{Tag{0x4010, 0x0001}, "CS", "LowEnergyDetectors", "1"}
-	tagDict[Tag{0x4010, 0x0002}] = TagInfo{Tag{0x4010, 0x0002}, "CS", "HighEnergyDetectors", "1"}
-	tagDict[Tag{0x4010, 0x0004}] = TagInfo{Tag{0x4010, 0x0004}, "SQ", "DetectorGeometrySequence", "1"}
-	tagDict[Tag{0x4010, 0x1001}] = TagInfo{Tag{0x4010, 0x1001}, "SQ", "ThreatROIVoxelSequence", "1"}
-	tagDict[Tag{0x4010, 0x1004}] = TagInfo{Tag{0x4010, 0x1004}, "FL", "ThreatROIBase", "3"}
-	tagDict[Tag{0x4010, 0x1005}] = TagInfo{Tag{0x4010, 0x1005}, "FL", "ThreatROIExtents", "3"}
-	tagDict[Tag{0x4010, 0x1006}] = TagInfo{Tag{0x4010, 0x1006}, "OB", "ThreatROIBitmap", "1"}
-	tagDict[Tag{0x4010, 0x1007}] = TagInfo{Tag{0x4010, 0x1007}, "SH", "RouteSegmentID", "1"}
-	tagDict[Tag{0x4010, 0x1008}] = TagInfo{Tag{0x4010, 0x1008}, "CS", "GantryType", "1"}
-	tagDict[Tag{0x4010, 0x1009}] = TagInfo{Tag{0x4010, 0x1009}, "CS", "OOIOwnerType", "1"}
-	tagDict[Tag{0x4010, 0x100A}] = TagInfo{Tag{0x4010, 0x100A}, "SQ", "RouteSegmentSequence", "1"}
-	tagDict[Tag{0x4010, 0x1010}] = TagInfo{Tag{0x4010, 0x1010}, "US", "PotentialThreatObjectID", "1"}
-	tagDict[Tag{0x4010, 0x1011}] = TagInfo{Tag{0x4010, 0x1011}, "SQ", "ThreatSequence", "1"}
-	tagDict[Tag{0x4010, 0x1012}] = TagInfo{Tag{0x4010, 0x1012}, "CS", "ThreatCategory", "1"}
-	tagDict[Tag{0x4010, 0x1013}] = TagInfo{Tag{0x4010, 0x1013}, "LT", "ThreatCategoryDescription", "1"}
-	tagDict[Tag{0x4010, 0x1014}] = TagInfo{Tag{0x4010, 0x1014}, "CS", "ATDAbilityAssessment", "1"}
-	tagDict[Tag{0x4010, 0x1015}] = TagInfo{Tag{0x4010, 0x1015}, "CS", "ATDAssessmentFlag", "1"}
-	tagDict[Tag{0x4010, 0x1016}] = TagInfo{Tag{0x4010, 0x1016}, "FL", "ATDAssessmentProbability", "1"}
-	tagDict[Tag{0x4010, 0x1017}] = TagInfo{Tag{0x4010, 0x1017}, "FL", "Mass", "1"}
-	tagDict[Tag{0x4010, 0x1018}] = TagInfo{Tag{0x4010, 0x1018}, "FL", "Density", "1"}
-	tagDict[Tag{0x4010, 0x1019}] = TagInfo{Tag{0x4010, 0x1019}, "FL", "ZEffective", "1"}
-	tagDict[Tag{0x4010, 0x101A}] = TagInfo{Tag{0x4010, 0x101A}, "SH", "BoardingPassID", "1"}
-	tagDict[Tag{0x4010, 0x101B}] = TagInfo{Tag{0x4010, 0x101B}, "FL", "CenterOfMass", "3"}
-	tagDict[Tag{0x4010, 0x101C}] = TagInfo{Tag{0x4010, 0x101C}, "FL", "CenterOfPTO", "3"}
-	tagDict[Tag{0x4010, 0x101D}] = TagInfo{Tag{0x4010, 0x101D}, "FL", "BoundingPolygon", "6-n"}
-	tagDict[Tag{0x4010, 0x101E}] = TagInfo{Tag{0x4010, 0x101E}, "SH", "RouteSegmentStartLocationID", "1"}
-	tagDict[Tag{0x4010, 0x101F}] = TagInfo{Tag{0x4010, 0x101F}, "SH", "RouteSegmentEndLocationID", "1"}
-	tagDict[Tag{0x4010, 0x1020}] = TagInfo{Tag{0x4010, 0x1020}, "CS", "RouteSegmentLocationIDType", "1"}
-	tagDict[Tag{0x4010, 0x1021}] = TagInfo{Tag{0x4010, 0x1021}, "CS", "AbortReason", "1-n"}
-	tagDict[Tag{0x4010, 0x1023}] = TagInfo{Tag{0x4010, 0x1023}, "FL", "VolumeOfPTO", "1"}
-	tagDict[Tag{0x4010, 0x1024}] = TagInfo{Tag{0x4010, 0x1024}, "CS", "AbortFlag", "1"}
-	tagDict[Tag{0x4010, 0x1025}] = TagInfo{Tag{0x4010, 0x1025}, "DT", "RouteSegmentStartTime", "1"}
-	tagDict[Tag{0x4010, 0x1026}] = TagInfo{Tag{0x4010, 0x1026}, "DT", "RouteSegmentEndTime", "1"}
-	tagDict[Tag{0x4010, 0x1027}] = TagInfo{Tag{0x4010, 0x1027}, "CS", "TDRType", "1"}
-	tagDict[Tag{0x4010, 0x1028}] = TagInfo{Tag{0x4010, 0x1028}, "CS", "InternationalRouteSegment", "1"}
-	tagDict[Tag{0x4010, 0x1029}] = TagInfo{Tag{0x4010, 0x1029}, "LO", "ThreatDetectionAlgorithmandVersion", "1-n"}
-	tagDict[Tag{0x4010, 0x102A}] = TagInfo{Tag{0x4010, 0x102A}, "SH", "AssignedLocation", "1"}
-	tagDict[Tag{0x4010, 0x102B}] = TagInfo{Tag{0x4010, 0x102B}, "DT", "AlarmDecisionTime", "1"}
-	tagDict[Tag{0x4010, 0x1031}] = TagInfo{Tag{0x4010, 0x1031}, "CS", "AlarmDecision", "1"}
-	tagDict[Tag{0x4010, 0x1033}] = TagInfo{Tag{0x4010, 0x1033}, "US", "NumberOfTotalObjects", "1"}
-	tagDict[Tag{0x4010, 0x1034}] = TagInfo{Tag{0x4010, 0x1034}, "US", "NumberOfAlarmObjects", "1"}
-	tagDict[Tag{0x4010, 0x1037}] = TagInfo{Tag{0x4010, 0x1037}, "SQ", "PTORepresentationSequence", "1"}
-	tagDict[Tag{0x4010, 0x1038}] = TagInfo{Tag{0x4010, 0x1038}, "SQ", "ATDAssessmentSequence", "1"}
-	tagDict[Tag{0x4010, 0x1039}] = TagInfo{Tag{0x4010, 0x1039}, "CS", "TIPType", "1"}
-	tagDict[Tag{0x4010, 0x103A}] = TagInfo{Tag{0x4010, 0x103A}, "CS", "DICOSVersion", "1"}
-	tagDict[Tag{0x4010, 0x1041}] = TagInfo{Tag{0x4010, 0x1041}, "DT", "OOIOwnerCreationTime", "1"}
-	tagDict[Tag{0x4010, 0x1042}] = TagInfo{Tag{0x4010, 0x1042}, "CS", "OOIType", "1"}
-	tagDict[Tag{0x4010, 0x1043}] = TagInfo{Tag{0x4010, 0x1043}, "FL", "OOISize", "3"}
-	tagDict[Tag{0x4010, 0x1044}] = TagInfo{Tag{0x4010, 0x1044}, "CS", "AcquisitionStatus", "1"}
-	tagDict[Tag{0x4010, 0x1045}] = TagInfo{Tag{0x4010, 0x1045}, "SQ", "BasisMaterialsCodeSequence", "1"}
-	tagDict[Tag{0x4010, 0x1046}] = TagInfo{Tag{0x4010, 0x1046}, "CS", "PhantomType", "1"}
-	tagDict[Tag{0x4010, 0x1047}] = TagInfo{Tag{0x4010, 0x1047}, "SQ", "OOIOwnerSequence", "1"}
-	tagDict[Tag{0x4010, 0x1048}] = TagInfo{Tag{0x4010, 0x1048}, "CS", "ScanType", "1"}
-	tagDict[Tag{0x4010, 0x1051}] = TagInfo{Tag{0x4010, 0x1051}, "LO", "ItineraryID", "1"}
-	tagDict[Tag{0x4010, 0x1052}] = TagInfo{Tag{0x4010, 0x1052}, "SH", "ItineraryIDType", "1"}
-	tagDict[Tag{0x4010, 0x1053}] = TagInfo{Tag{0x4010, 0x1053}, "LO", "ItineraryIDAssigningAuthority", "1"}
-	tagDict[Tag{0x4010, 0x1054}] = TagInfo{Tag{0x4010, 0x1054}, "SH", "RouteID", "1"}
-	tagDict[Tag{0x4010, 0x1055}] = TagInfo{Tag{0x4010, 0x1055}, "SH", "RouteIDAssigningAuthority", "1"}
-	tagDict[Tag{0x4010, 0x1056}] = TagInfo{Tag{0x4010, 0x1056}, "CS", "InboundArrivalType", "1"}
-	tagDict[Tag{0x4010, 0x1058}] = TagInfo{Tag{0x4010, 0x1058}, "SH", "CarrierID", "1"}
-	tagDict[Tag{0x4010, 0x1059}] = TagInfo{Tag{0x4010, 0x1059}, "CS", "CarrierIDAssigningAuthority", "1"}
-	tagDict[Tag{0x4010, 0x1060}] = TagInfo{Tag{0x4010, 0x1060}, "FL", "SourceOrientation", "3"}
-	tagDict[Tag{0x4010, 0x1061}] = TagInfo{Tag{0x4010, 0x1061}, "FL", "SourcePosition", "3"}
-	tagDict[Tag{0x4010, 0x1062}] = TagInfo{Tag{0x4010, 0x1062}, "FL", "BeltHeight", "1"}
-	tagDict[Tag{0x4010, 0x1064}] = TagInfo{Tag{0x4010, 0x1064}, "SQ", "AlgorithmRoutingCodeSequence", "1"}
-	tagDict[Tag{0x4010, 0x1067}] = TagInfo{Tag{0x4010, 0x1067}, "CS", "TransportClassification", "1"}
-	tagDict[Tag{0x4010, 0x1068}] = TagInfo{Tag{0x4010, 0x1068}, "LT", "OOITypeDescriptor", "1"}
-	tagDict[Tag{0x4010, 0x1069}] = TagInfo{Tag{0x4010, 0x1069}, "FL", "TotalProcessingTime", "1"}
-	tagDict[Tag{0x4010, 0x106C}] = TagInfo{Tag{0x4010, 0x106C}, "OB", "DetectorCalibrationData", "1"}
-	tagDict[Tag{0x4FFE, 0x0001}] = TagInfo{Tag{0x4FFE, 0x0001}, "SQ", "MACParametersSequence", "1"}
-	tagDict[Tag{0x5200, 0x9229}] = TagInfo{Tag{0x5200, 0x9229}, "SQ", "SharedFunctionalGroupsSequence", "1"}
-	tagDict[Tag{0x5200, 0x9230}] = TagInfo{Tag{0x5200, 0x9230}, "SQ", "PerFrameFunctionalGroupsSequence", "1"}
-	tagDict[Tag{0x5400, 0x0100}] = TagInfo{Tag{0x5400, 0x0100}, "SQ", "WaveformSequence", "1"}
-	tagDict[Tag{0x5400, 0x0110}] = TagInfo{Tag{0x5400, 0x0110}, "OW", "ChannelMinimumValue", "1"}
-	tagDict[Tag{0x5400, 0x0112}] = TagInfo{Tag{0x5400, 0x0112}, "OW", "ChannelMaximumValue", "1"}
-	tagDict[Tag{0x5400, 0x1004}] = TagInfo{Tag{0x5400, 0x1004}, "US", "WaveformBitsAllocated", "1"}
-	tagDict[Tag{0x5400, 0x1006}] = TagInfo{Tag{0x5400, 0x1006}, "CS", "WaveformSampleInterpretation", "1"}
-	tagDict[Tag{0x5400, 0x100A}] = TagInfo{Tag{0x5400, 0x100A}, "OW", "WaveformPaddingValue", "1"}
-	tagDict[Tag{0x5400, 0x1010}] = TagInfo{Tag{0x5400, 0x1010}, "OW", "WaveformData", "1"}
-	tagDict[Tag{0x5600, 0x0010}] = TagInfo{Tag{0x5600, 0x0010}, "OF", "FirstOrderPhaseCorrectionAngle", "1"}
-	tagDict[Tag{0x5600, 0x0020}] = TagInfo{Tag{0x5600, 0x0020}, "OF", "SpectroscopyData", "1"}
-	tagDict[Tag{0x7FE0, 0x0010}] = TagInfo{Tag{0x7FE0, 0x0010}, "OW", "PixelData", "1"}
-	tagDict[Tag{0xFFFA, 0xFFFA}] = TagInfo{Tag{0xFFFA, 0xFFFA}, "SQ", "DigitalSignaturesSequence", "1"}
-	tagDict[Tag{0xFFFC, 0xFFFC}] = TagInfo{Tag{0xFFFC, 0xFFFC}, "OB", "DataSetTrailingPadding", "1"}
-	tagDict[Tag{0xFFFE, 0xE000}] = TagInfo{Tag{0xFFFE, 0xE000}, "NA", "Item", "1"}
-	tagDict[Tag{0xFFFE, 0xE00D}] = TagInfo{Tag{0xFFFE, 0xE00D}, "NA", "ItemDelimitationItem", "1"}
-	tagDict[Tag{0xFFFE, 0xE0DD}] = TagInfo{Tag{0xFFFE, 0xE0DD}, "NA", "SequenceDelimitationItem", "1"}
-	tagDict[Tag{0x0022, 0x1415}] = TagInfo{Tag{0x0022, 0x1415}, "CS", "OphthalmicMappingDeviceType", "1"}
-	tagDict[Tag{0x0022, 0x1420}] = TagInfo{Tag{0x0022, 0x1420}, "SQ", "AcquisitionTypeMethodSequence", "1"}
-	tagDict[Tag{0x0022, 0x1423}] = TagInfo{Tag{0x0022, 0x1423}, "SQ", "AcquisitonMethodAlgorithmSequence", "1"}
-	tagDict[Tag{0x0022, 0x1436}] = TagInfo{Tag{0x0022, 0x1436}, "SQ", "OphthalmicThicknessMapTypeCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1443}] = TagInfo{Tag{0x0022, 0x1443}, "SQ", "OphthalmicThicknessMappingNormalsSequence", "1"}
-	tagDict[Tag{0x0022, 0x1445}] = TagInfo{Tag{0x0022, 0x1445}, "SQ", "RetinalThicknessDefinitionCodeSequence", "1"}
-	tagDict[Tag{0x0022, 0x1450}] = TagInfo{Tag{0x0022, 0x1450}, "SQ", "PixelValueMappingtoCodedConceptSequence", "1"}
-	tagDict[Tag{0x0022, 0x1452}] = TagInfo{Tag{0x0022, 0x1452}, "US", "MappedPixelValue", "1"}
-	tagDict[Tag{0x0022, 0x1454}] = TagInfo{Tag{0x0022, 0x1454}, "LO", "PixelValueMappingExplanation", "1"}
-	tagDict[Tag{0x0022, 0x1458}] = TagInfo{Tag{0x0022, 0x1458}, "SQ", "OphthalmicThicknessMapQualityThresholdSequence", "1"}
-	tagDict[Tag{0x0022, 0x1460}] = TagInfo{Tag{0x0022, 0x1460}, "FL", "OphthalmicThicknessMapThresholdQualityRating", "1"}
-	tagDict[Tag{0x0022, 0x1463}] = TagInfo{Tag{0x0022, 0x1463}, "FL", "AnatomicStructureReferencePoint", "2"}
-	tagDict[Tag{0x0022, 0x1465}] = TagInfo{Tag{0x0022, 0x1465}, "SQ", "RegistrationtoLocalizerSequence", "1"}
-	tagDict[Tag{0x0022, 0x1466}] = TagInfo{Tag{0x0022, 0x1466}, "CS", "RegisteredLocalizerUnits", "1"}
-	tagDict[Tag{0x0022, 0x1467}] = TagInfo{Tag{0x0022, 0x1467}, "FL", "RegisteredTopLeftHandCorner", "2"}
-	tagDict[Tag{0x0022, 0x1468}] = TagInfo{Tag{0x0022, 0x1468}, "FL", "RegisteredBottomRightHandCorner", "2"}
-	tagDict[Tag{0x0022, 0x1470}] = TagInfo{Tag{0x0022, 0x1470}, "SQ", "OphthalmicThicknessMapQualityRatingSequence", "1"}
-	tagDict[Tag{0x0022, 0x1472}] = TagInfo{Tag{0x0022, 0x1472}, "SQ", "RelevantOPTAttributesSequence", "1"}
-	tagDict[Tag{0x0040, 0x4001}] = TagInfo{Tag{0x0040, 0x4001}, "CS", "RETIRED_GeneralPurposeScheduledProcedureStepStatus", "1"}
-	tagDict[Tag{0x0040, 0x4002}] = TagInfo{Tag{0x0040, 0x4002}, "CS", "RETIRED_GeneralPurposePerformedProcedureStepStatus", "1"}
-	tagDict[Tag{0x0040, 0x4003}] = TagInfo{Tag{0x0040, 0x4003}, "CS", "RETIRED_GeneralPurposeScheduledProcedureStepPriority", "1"}
-	tagDict[Tag{0x0040, 0x4004}] = TagInfo{Tag{0x0040, 0x4004}, "SQ", "RETIRED_ScheduledProcessingApplicationsCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x4006}] = TagInfo{Tag{0x0040, 0x4006}, "CS", "RETIRED_MultipleCopiesFlag", "1"}
-	tagDict[Tag{0x0040, 0x4015}] = TagInfo{Tag{0x0040, 0x4015}, "SQ", "RETIRED_ResultingGeneralPurposePerformedProcedureStepsSequence", "1"}
-	tagDict[Tag{0x0040, 0x4016}] = TagInfo{Tag{0x0040, 0x4016}, "SQ", "RETIRED_ReferencedGeneralPurposeScheduledProcedureStepSequence", "1"}
-	tagDict[Tag{0x0040, 0x4022}] = TagInfo{Tag{0x0040, 0x4022}, "SQ", "RETIRED_RelevantInformationSequence", "1"}
-	tagDict[Tag{0x0040, 0x4023}] = TagInfo{Tag{0x0040, 0x4023}, "UI", "RETIRED_ReferencedGeneralPurposeScheduledProcedureStepTransactionUID", "1"}
-	tagDict[Tag{0x0040, 0x4031}] = TagInfo{Tag{0x0040, 0x4031}, "SQ", "RETIRED_RequestedSubsequentWorkitemCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x4032}] = TagInfo{Tag{0x0040, 0x4032}, "SQ", "RETIRED_NonDICOMOutputCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0xA161}] = TagInfo{Tag{0x0040, 0xA161}, "FD", "FloatingPointValue", "1-n"}
-	tagDict[Tag{0x0040, 0xA162}] = TagInfo{Tag{0x0040, 0xA162}, "SL", "RationalNumeratorValue", "1-n"}
-	tagDict[Tag{0x0040, 0xA163}] = TagInfo{Tag{0x0040, 0xA163}, "UL", "RationalDenominatorValue", "1-n"}
-	tagDict[Tag{0x0010, 0x0200}] = TagInfo{Tag{0x0010, 0x0200}, "CS", "QualityControlSubject", "1"}
-	tagDict[Tag{0x3006, 0x0018}] = TagInfo{Tag{0x3006, 0x0018}, "SQ", "PredecessorStructureSetSequence", "1"}
-	tagDict[Tag{0x300A, 0x0088}] = TagInfo{Tag{0x300A, 0x0088}, "FL", "RETIRED_BeamDosePointDepth", "1"}
-	tagDict[Tag{0x300A, 0x0089}] = TagInfo{Tag{0x300A, 0x0089}, "FL", "RETIRED_BeamDosePointEquivalentDepth", "1"}
-	tagDict[Tag{0x300A, 0x008A}] = TagInfo{Tag{0x300A, 0x008A}, "FL", "RETIRED_BeamDosePointSSD", "1"}
-	tagDict[Tag{0x300A, 0x008C}] = TagInfo{Tag{0x300A, 0x008C}, "SQ", "BeamDoseVerificationControlPointSequence", "1"}
-	tagDict[Tag{0x300A, 0x008D}] = TagInfo{Tag{0x300A, 0x008D}, "FL", "AverageBeamDosePointDepth", "1"}
-	tagDict[Tag{0x300A, 0x008E}] = TagInfo{Tag{0x300A, 0x008E}, "FL", "AverageBeamDosePointEquivalentDepth", "1"}
-	tagDict[Tag{0x300A, 0x008F}] = TagInfo{Tag{0x300A, 0x008F}, "FL", "AverageBeamDosePointSSD", "1"}
-	tagDict[Tag{0x0040, 0xA171}] = TagInfo{Tag{0x0040, 0xA171}, "UI", "ObservationUID", "1"}
-	tagDict[Tag{0x300A, 0x00EF}] = TagInfo{Tag{0x300A, 0x00EF}, "SH", "CompensatorTrayID", "1"}
-	tagDict[Tag{0x0066, 0x0037}] = TagInfo{Tag{0x0066, 0x0037}, "FL", "RecommendedPointRadius", "1"}
-	tagDict[Tag{0x0066, 0x0038}] = TagInfo{Tag{0x0066, 0x0038}, "FL", "RecommendedLineThickness", "1"}
-	tagDict[Tag{0x300A, 0x021B}] = TagInfo{Tag{0x300A, 0x021B}, "SH", "SourceModel", "1"}
-	tagDict[Tag{0x300A, 0x021C}] = TagInfo{Tag{0x300A, 0x021C}, "LO", "SourceDescription", "1"}
-	tagDict[Tag{0x0008, 0x0015}] = TagInfo{Tag{0x0008, 0x0015}, "DT", "InstanceCoercionDateTime", "1"}
-	tagDict[Tag{0x0000, 0x0001}] = TagInfo{Tag{0x0000, 0x0001}, "UL", "ACR_NEMA_CommandGroupLengthToEnd", "1"}
-	tagDict[Tag{0x0000, 0x0010}] = TagInfo{Tag{0x0000, 0x0010}, "CS", "ACR_NEMA_CommandRecognitionCode", "1"}
-	tagDict[Tag{0x0000, 0x0200}] = TagInfo{Tag{0x0000, 0x0200}, "LO", "ACR_NEMA_Initiator", "1"}
-	tagDict[Tag{0x0000, 0x0300}] = TagInfo{Tag{0x0000, 0x0300}, "LO", "ACR_NEMA_Receiver", "1"}
-	tagDict[Tag{0x0000, 0x0400}] = TagInfo{Tag{0x0000, 0x0400}, "LO", "ACR_NEMA_FindLocation", "1"}
-	tagDict[Tag{0x0000, 0x0850}] = TagInfo{Tag{0x0000, 0x0850}, "US", "ACR_NEMA_NumberOfMatches", "1"}
-	tagDict[Tag{0x0000, 0x0860}] = TagInfo{Tag{0x0000, 0x0860}, "US", "ACR_NEMA_ResponseSequenceNumber", "1"}
-	tagDict[Tag{0x0000, 0x4000}] = TagInfo{Tag{0x0000, 0x4000}, "LO", "ACR_NEMA_DialogReceiver", "1"}
-	tagDict[Tag{0x0000, 0x4010}] = TagInfo{Tag{0x0000, 0x4010}, "LO", "ACR_NEMA_TerminalType", "1"}
-	tagDict[Tag{0x0000, 0x5010}] = TagInfo{Tag{0x0000, 0x5010}, "LO", "ACR_NEMA_MessageSetID", "1"}
-	tagDict[Tag{0x0000, 0x5020}] = TagInfo{Tag{0x0000, 0x5020}, "LO", "ACR_NEMA_EndMessageSet", "1"}
-	tagDict[Tag{0x0000, 0x5110}] = TagInfo{Tag{0x0000, 0x5110}, "LO", "ACR_NEMA_DisplayFormat", "1"}
-	tagDict[Tag{0x0000, 0x5120}] = TagInfo{Tag{0x0000, 0x5120}, "LO", "ACR_NEMA_PagePositionID", "1"}
-	tagDict[Tag{0x0000, 0x5130}] = TagInfo{Tag{0x0000, 0x5130}, "LO", "ACR_NEMA_TextFormatID", "1"}
-	tagDict[Tag{0x0000, 0x5140}] = TagInfo{Tag{0x0000, 0x5140}, "CS", "ACR_NEMA_NormalReverse", "1"}
-	tagDict[Tag{0x0000, 0x5150}] = TagInfo{Tag{0x0000, 0x5150}, "CS", "ACR_NEMA_AddGrayScale", "1"}
-	tagDict[Tag{0x0000, 0x5160}] = TagInfo{Tag{0x0000, 0x5160}, "CS", "ACR_NEMA_Borders", "1"}
-	tagDict[Tag{0x0000, 0x5170}] = TagInfo{Tag{0x0000, 0x5170}, "IS", "ACR_NEMA_Copies", "1"}
-	tagDict[Tag{0x0000, 0x5180}] = TagInfo{Tag{0x0000, 0x5180}, "LO", "ACR_NEMA_MagnificationType", "1"}
-	tagDict[Tag{0x0000, 0x5190}] = TagInfo{Tag{0x0000, 0x5190}, "LO", "ACR_NEMA_Erase", "1-n"}
-	tagDict[Tag{0x0000, 0x51A0}] = TagInfo{Tag{0x0000, 0x51A0}, "CS", "ACR_NEMA_Print", "1"}
-	tagDict[Tag{0x0000, 0x51B0}] = TagInfo{Tag{0x0000, 0x51B0}, "US", "ACR_NEMA_Overlays", "1-n"}
-	tagDict[Tag{0x0008, 0x0001}] = TagInfo{Tag{0x0008, 0x0001}, "UL", "ACR_NEMA_IdentifyingGroupLengthToEnd", "1"}
-	tagDict[Tag{0x0008, 0x0010}] = TagInfo{Tag{0x0008, 0x0010}, "LO", "ACR_NEMA_RecognitionCode", "1"}
-	tagDict[Tag{0x0008, 0x0040}] = TagInfo{Tag{0x0008, 0x0040}, "US", "ACR_NEMA_OldDataSetType", "1"}
-	tagDict[Tag{0x0008, 0x0041}] = TagInfo{Tag{0x0008, 0x0041}, "LO", "ACR_NEMA_DataSetSubtype", "1"}
-	tagDict[Tag{0x0008, 0x1000}] = TagInfo{Tag{0x0008, 0x1000}, "LO", "ACR_NEMA_NetworkID", "1"}
-	tagDict[Tag{0x0008, 0x4000}] = TagInfo{Tag{0x0008, 0x4000}, "LT", "ACR_NEMA_IdentifyingComments", "1-n"}
-	tagDict[Tag{0x0010, 0x1050}] = TagInfo{Tag{0x0010, 0x1050}, "LT", "ACR_NEMA_InsurancePlanIdentification", "1-n"}
-	tagDict[Tag{0x0018, 0x1240}] = TagInfo{Tag{0x0018, 0x1240}, "IS", "ACR_NEMA_UpperLowerPixelValues", "1-n"}
-	tagDict[Tag{0x0018, 0x4000}] = TagInfo{Tag{0x0018, 0x4000}, "LT", "ACR_NEMA_AcquisitionComments", "1-n"}
-	tagDict[Tag{0x0018, 0x5030}] = TagInfo{Tag{0x0018, 0x5030}, "DS", "ACR_NEMA_DynamicRange", "1"}
-	tagDict[Tag{0x0018, 0x5040}] = TagInfo{Tag{0x0018, 0x5040}, "DS", "ACR_NEMA_TotalGain", "1"}
-	tagDict[Tag{0x0020, 0x0030}] = TagInfo{Tag{0x0020, 0x0030}, "DS", "ACR_NEMA_ImagePosition", "3"}
-	tagDict[Tag{0x0020, 0x0035}] = TagInfo{Tag{0x0020, 0x0035}, "DS", "ACR_NEMA_ImageOrientation", "6"}
-	tagDict[Tag{0x0020, 0x0050}] = TagInfo{Tag{0x0020, 0x0050}, "DS", "ACR_NEMA_Location", "1"}
-	tagDict[Tag{0x0020, 0x0070}] = TagInfo{Tag{0x0020, 0x0070}, "LO", "ACR_NEMA_ImageGeometryType", "1"}
-	tagDict[Tag{0x0020, 0x0080}] = TagInfo{Tag{0x0020, 0x0080}, "LO", "ACR_NEMA_MaskingImage", "1-n"}
-	tagDict[Tag{0x0020, 0x1001}] = TagInfo{Tag{0x0020, 0x1001}, "IS", "ACR_NEMA_AcquisitionsInSeries", "1"}
-	tagDict[Tag{0x0020, 0x1003}] = TagInfo{Tag{0x0020, 0x1003}, "IS", "ACR_NEMA_ImagesInSeries", "1"}
-	tagDict[Tag{0x0020, 0x1005}] = TagInfo{Tag{0x0020, 0x1005}, "IS", "ACR_NEMA_ImagesInStudy", "1"}
-	tagDict[Tag{0x0020, 0x1020}] = TagInfo{Tag{0x0020, 0x1020}, "LO", "ACR_NEMA_Reference", "1-n"}
-	tagDict[Tag{0x0020, 0x3401}] = TagInfo{Tag{0x0020, 0x3401}, "LO", "ACR_NEMA_ModifyingDeviceID", "1"}
-	tagDict[Tag{0x0020, 0x3402}] = TagInfo{Tag{0x0020, 0x3402}, "LO", "ACR_NEMA_ModifiedImageID", "1"}
-	tagDict[Tag{0x0020, 0x3403}] = TagInfo{Tag{0x0020, 0x3403}, "DA", "ACR_NEMA_ModifiedImageDate", "1"}
-	tagDict[Tag{0x0020, 0x3404}] = TagInfo{Tag{0x0020, 0x3404}, "LO", "ACR_NEMA_ModifyingDeviceManufacturer", "1"}
-	tagDict[Tag{0x0020, 0x3405}] = TagInfo{Tag{0x0020, 0x3405}, "TM", "ACR_NEMA_ModifiedImageTime", "1"}
-	tagDict[Tag{0x0020, 0x3406}] = TagInfo{Tag{0x0020, 0x3406}, "LO", "ACR_NEMA_ModifiedImageDescription", "1"}
-	tagDict[Tag{0x0020, 0x5000}] = TagInfo{Tag{0x0020, 0x5000}, "AT", "ACR_NEMA_OriginalImageIdentification", "1-n"}
-	tagDict[Tag{0x0020, 0x5002}] = TagInfo{Tag{0x0020, 0x5002}, "LO", "ACR_NEMA_OriginalImageIdentificationNomenclature", "1-n"}
-	tagDict[Tag{0x0028, 0x0005}] = TagInfo{Tag{0x0028, 0x0005}, "US", "ACR_NEMA_ImageDimensions", "1"}
-	tagDict[Tag{0x0028, 0x0040}] = TagInfo{Tag{0x0028, 0x0040}, "CS", "ACR_NEMA_ImageFormat", "1"}
-	tagDict[Tag{0x0028, 0x0050}] = TagInfo{Tag{0x0028, 0x0050}, "LO", "ACR_NEMA_ManipulatedImage", "1-n"}
-	tagDict[Tag{0x0028, 0x0060}] = TagInfo{Tag{0x0028, 0x0060}, "CS", "ACR_NEMA_CompressionCode", "1"}
-	tagDict[Tag{0x0028, 0x0104}] = TagInfo{Tag{0x0028, 0x0104}, "US", "ACR_NEMA_SmallestValidPixelValue", "1"}
-	tagDict[Tag{0x0028, 0x0105}] = TagInfo{Tag{0x0028, 0x0105}, "US", "ACR_NEMA_LargestValidPixelValue", "1"}
-	tagDict[Tag{0x0028, 0x0200}] = TagInfo{Tag{0x0028, 0x0200}, "US", "ACR_NEMA_ImageLocation", "1"}
-	tagDict[Tag{0x0028, 0x1080}] = TagInfo{Tag{0x0028, 0x1080}, "CS", "ACR_NEMA_GrayScale", "1"}
-	tagDict[Tag{0x0028, 0x1100}] = TagInfo{Tag{0x0028, 0x1100}, "US", "ACR_NEMA_GrayLookupTableDescriptor", "3"}
-	tagDict[Tag{0x0028, 0x1200}] = TagInfo{Tag{0x0028, 0x1200}, "US", "ACR_NEMA_GrayLookupTableData", "1-n"}
-	tagDict[Tag{0x0028, 0x4000}] = TagInfo{Tag{0x0028, 0x4000}, "LT", "ACR_NEMA_ImagePresentationComments", "1-n"}
-	tagDict[Tag{0x4000, 0x0000}] = TagInfo{Tag{0x4000, 0x0000}, "UL", "ACR_NEMA_TextGroupLength", "1"}
-	tagDict[Tag{0x4000, 0x0010}] = TagInfo{Tag{0x4000, 0x0010}, "LT", "ACR_NEMA_TextArbitrary", "1-n"}
-	tagDict[Tag{0x4000, 0x4000}] = TagInfo{Tag{0x4000, 0x4000}, "LT", "ACR_NEMA_TextComments", "1-n"}
-	tagDict[Tag{0x0028, 0x005F}] = TagInfo{Tag{0x0028, 0x005F}, "CS", "ACR_NEMA_2C_CompressionRecognitionCode", "1"}
-	tagDict[Tag{0x0028, 0x0061}] = TagInfo{Tag{0x0028, 0x0061}, "SH", "ACR_NEMA_2C_CompressionOriginator", "1"}
-	tagDict[Tag{0x0028, 0x0062}] = TagInfo{Tag{0x0028, 0x0062}, "SH", "ACR_NEMA_2C_CompressionLabel", "1"}
-	tagDict[Tag{0x0028, 0x0063}] = TagInfo{Tag{0x0028, 0x0063}, "SH", "ACR_NEMA_2C_CompressionDescription", "1"}
-	tagDict[Tag{0x0028, 0x0065}] = TagInfo{Tag{0x0028, 0x0065}, "CS", "ACR_NEMA_2C_CompressionSequence", "1-n"}
-	tagDict[Tag{0x0028, 0x0066}] = TagInfo{Tag{0x0028, 0x0066}, "AT", "ACR_NEMA_2C_CompressionStepPointers", "1-n"}
-	tagDict[Tag{0x0028, 0x0068}] = TagInfo{Tag{0x0028, 0x0068}, "US", "ACR_NEMA_2C_RepeatInterval", "1"}
-	tagDict[Tag{0x0028, 0x0069}] = TagInfo{Tag{0x0028, 0x0069}, "US", "ACR_NEMA_2C_BitsGrouped", "1"}
-	tagDict[Tag{0x0028, 0x0070}] = TagInfo{Tag{0x0028, 0x0070}, "US", "ACR_NEMA_2C_PerimeterTable", "1-n"}
-	tagDict[Tag{0x0028, 0x0071}] = TagInfo{Tag{0x0028, 0x0071}, "US", "ACR_NEMA_2C_PerimeterValue", "1"}
-	tagDict[Tag{0x0028, 0x0080}] = TagInfo{Tag{0x0028, 0x0080}, "US", "ACR_NEMA_2C_PredictorRows", "1"}
-	tagDict[Tag{0x0028, 0x0081}] = TagInfo{Tag{0x0028, 0x0081}, "US", "ACR_NEMA_2C_PredictorColumns", "1"}
-	tagDict[Tag{0x0028, 0x0082}] = TagInfo{Tag{0x0028, 0x0082}, "US", "ACR_NEMA_2C_PredictorConstants", "1-n"}
-	tagDict[Tag{0x0028, 0x0090}] = TagInfo{Tag{0x0028, 0x0090}, "CS", "ACR_NEMA_2C_BlockedPixels", "1"}
-	tagDict[Tag{0x0028, 0x0091}] = TagInfo{Tag{0x0028, 0x0091}, "US", "ACR_NEMA_2C_BlockRows", "1"}
-	tagDict[Tag{0x0028, 0x0092}] = TagInfo{Tag{0x0028, 0x0092}, "US", "ACR_NEMA_2C_BlockColumns", "1"}
-	tagDict[Tag{0x0028, 0x0093}] = TagInfo{Tag{0x0028, 0x0093}, "US", "ACR_NEMA_2C_RowOverlap", "1"}
-	tagDict[Tag{0x0028, 0x0094}] = TagInfo{Tag{0x0028, 0x0094}, "US", "ACR_NEMA_2C_ColumnOverlap", "1"}
-	tagDict[Tag{0x0028, 0x0400}] = TagInfo{Tag{0x0028, 0x0400}, "CS", "ACR_NEMA_2C_TransformLabel", "1"}
-	tagDict[Tag{0x0028, 0x0401}] = TagInfo{Tag{0x0028, 0x0401}, "CS", "ACR_NEMA_2C_TransformVersionNumber", "1"}
-	tagDict[Tag{0x0028, 0x0402}] = TagInfo{Tag{0x0028, 0x0402}, "US", "ACR_NEMA_2C_NumberOfTransformSteps", "1"}
-	tagDict[Tag{0x0028, 0x0403}] = TagInfo{Tag{0x0028, 0x0403}, "CS", "ACR_NEMA_2C_SequenceOfCompressedData", "1-n"}
-	tagDict[Tag{0x0028, 0x0404}] = TagInfo{Tag{0x0028, 0x0404}, "AT", "ACR_NEMA_2C_DetailsOfCoefficients", "1-n"}
-	tagDict[Tag{0x0028, 0x0410}] = TagInfo{Tag{0x0028, 0x0410}, "US", "ACR_NEMA_2C_RowsForNthOrderCoefficients", "1"}
-	tagDict[Tag{0x0028, 0x0411}] = TagInfo{Tag{0x0028, 0x0411}, "US", "ACR_NEMA_2C_ColumnsForNthOrderCoefficients", "1"}
-	tagDict[Tag{0x0028, 0x0412}] = TagInfo{Tag{0x0028, 0x0412}, "CS", "ACR_NEMA_2C_CoefficientCoding", "1-n"}
-	tagDict[Tag{0x0028, 0x0413}] = TagInfo{Tag{0x0028, 0x0413}, "AT", "ACR_NEMA_2C_CoefficientCodingPointers", "1-n"}
-	tagDict[Tag{0x0028, 0x0700}] = TagInfo{Tag{0x0028, 0x0700}, "CS", "ACR_NEMA_2C_DCTLabel", "1"}
-	tagDict[Tag{0x0028, 0x0701}] = TagInfo{Tag{0x0028, 0x0701}, "CS", "ACR_NEMA_2C_DataBlockDescription", "1-n"}
-	tagDict[Tag{0x0028, 0x0702}] = TagInfo{Tag{0x0028, 0x0702}, "AT", "ACR_NEMA_2C_DataBlock", "1-n"}
-	tagDict[Tag{0x0028, 0x0710}] = TagInfo{Tag{0x0028, 0x0710}, "US", "ACR_NEMA_2C_NormalizationFactorFormat", "1"}
-	tagDict[Tag{0x0028, 0x0720}] = TagInfo{Tag{0x0028, 0x0720}, "US", "ACR_NEMA_2C_ZonalMapNumberFormat", "1"}
-	tagDict[Tag{0x0028, 0x0721}] = TagInfo{Tag{0x0028, 0x0721}, "AT", "ACR_NEMA_2C_ZonalMapLocation", "1-n"}
-	tagDict[Tag{0x0028, 0x0722}] = TagInfo{Tag{0x0028, 0x0722}, "US", "ACR_NEMA_2C_ZonalMapFormat", "1"}
-	tagDict[Tag{0x0028, 0x0730}] = TagInfo{Tag{0x0028, 0x0730}, "US", "ACR_NEMA_2C_AdaptiveMapFormat", "1"}
-	tagDict[Tag{0x0028, 0x0740}] = TagInfo{Tag{0x0028, 0x0740}, "US", "ACR_NEMA_2C_CodeNumberFormat", "1"}
-	tagDict[Tag{0x0028, 0x0800}] = TagInfo{Tag{0x0028, 0x0800}, "CS", "ACR_NEMA_2C_CodeLabel", "1-n"}
-	tagDict[Tag{0x0028, 0x0802}] = TagInfo{Tag{0x0028, 0x0802}, "US", "ACR_NEMA_2C_NumberOfTables", "1"}
-	tagDict[Tag{0x0028, 0x0803}] = TagInfo{Tag{0x0028, 0x0803}, "AT", "ACR_NEMA_2C_CodeTableLocation", "1-n"}
-	tagDict[Tag{0x0028, 0x0804}] = TagInfo{Tag{0x0028, 0x0804}, "US", "ACR_NEMA_2C_BitsForCodeWord", "1"}
-	tagDict[Tag{0x0028, 0x0808}] = TagInfo{Tag{0x0028, 0x0808}, "AT", "ACR_NEMA_2C_ImageDataLocation", "1-n"}
-	tagDict[Tag{0x1000, 0x0000}] = TagInfo{Tag{0x1000, 0x0000}, "UL", "ACR_NEMA_2C_CodeTableGroupLength", "1"}
-	tagDict[Tag{0x1000, 0x0010}] = TagInfo{Tag{0x1000, 0x0010}, "US", "ACR_NEMA_2C_EscapeTriplet", "3"}
-	tagDict[Tag{0x1000, 0x0011}] = TagInfo{Tag{0x1000, 0x0011}, "US", "ACR_NEMA_2C_RunLengthTriplet", "3"}
-	tagDict[Tag{0x1000, 0x0012}] = TagInfo{Tag{0x1000, 0x0012}, "US", "ACR_NEMA_2C_HuffmanTableSize", "1"}
-	tagDict[Tag{0x1000, 0x0013}] = TagInfo{Tag{0x1000, 0x0013}, "US", "ACR_NEMA_2C_HuffmanTableTriplet", "3"}
-	tagDict[Tag{0x1000, 0x0014}] = TagInfo{Tag{0x1000, 0x0014}, "US", "ACR_NEMA_2C_ShiftTableSize", "1"}
-	tagDict[Tag{0x1000, 0x0015}] = TagInfo{Tag{0x1000, 0x0015}, "US", "ACR_NEMA_2C_ShiftTableTriplet", "3"}
-	tagDict[Tag{0x1010, 0x0000}] = TagInfo{Tag{0x1010, 0x0000}, "UL", "ACR_NEMA_2C_ZonalMapGroupLength", "1"}
-	tagDict[Tag{0x1010, 0x0004}] = TagInfo{Tag{0x1010, 0x0004}, "US", "ACR_NEMA_2C_ZonalMap", "1-n"}
-	tagDict[Tag{0x7FE0, 0x0020}] = TagInfo{Tag{0x7FE0, 0x0020}, "OW", "ACR_NEMA_2C_CoefficientsSDVN", "1-n"}
-	tagDict[Tag{0x7FE0, 0x0030}] = TagInfo{Tag{0x7FE0, 0x0030}, "OW", "ACR_NEMA_2C_CoefficientsSDHN", "1-n"}
-	tagDict[Tag{0x7FE0, 0x0040}] = TagInfo{Tag{0x7FE0, 0x0040}, "OW", "ACR_NEMA_2C_CoefficientsSDDN", "1-n"}
-	tagDict[Tag{0x0000, 0x0001}] = TagInfo{Tag{0x0000, 0x0001}, "UL", "RETIRED_CommandLengthToEnd", "1"}
-	tagDict[Tag{0x0000, 0x0010}] = TagInfo{Tag{0x0000, 0x0010}, "SH", "RETIRED_CommandRecognitionCode", "1"}
-	tagDict[Tag{0x0000, 0x0200}] = TagInfo{Tag{0x0000, 0x0200}, "AE", "RETIRED_Initiator", "1"}
-	tagDict[Tag{0x0000, 0x0300}] = TagInfo{Tag{0x0000, 0x0300}, "AE", "RETIRED_Receiver", "1"}
-	tagDict[Tag{0x0000, 0x0400}] = TagInfo{Tag{0x0000, 0x0400}, "AE", "RETIRED_FindLocation", "1"}
-	tagDict[Tag{0x0000, 0x0850}] = TagInfo{Tag{0x0000, 0x0850}, "US", "RETIRED_NumberOfMatches", "1"}
-	tagDict[Tag{0x0000, 0x0860}] = TagInfo{Tag{0x0000, 0x0860}, "US", "RETIRED_ResponseSequenceNumber", "1"}
-	tagDict[Tag{0x0000, 0x4000}] = TagInfo{Tag{0x0000, 0x4000}, "AT", "RETIRED_DialogReceiver", "1"}
-	tagDict[Tag{0x0000, 0x4010}] = TagInfo{Tag{0x0000, 0x4010}, "AT", "RETIRED_TerminalType", "1"}
-	tagDict[Tag{0x0000, 0x5010}] = TagInfo{Tag{0x0000, 0x5010}, "SH", "RETIRED_MessageSetID", "1"}
-	tagDict[Tag{0x0000, 0x5020}] = TagInfo{Tag{0x0000, 0x5020}, "SH", "RETIRED_EndMessageID", "1"}
-	tagDict[Tag{0x0000, 0x5110}] = TagInfo{Tag{0x0000, 0x5110}, "AT", "RETIRED_DisplayFormat", "1"}
-	tagDict[Tag{0x0000, 0x5120}] = TagInfo{Tag{0x0000, 0x5120}, "AT", "RETIRED_PagePositionID", "1"}
-	tagDict[Tag{0x0000, 0x5130}] = TagInfo{Tag{0x0000, 0x5130}, "CS", "RETIRED_TextFormatID", "1"}
-	tagDict[Tag{0x0000, 0x5140}] = TagInfo{Tag{0x0000, 0x5140}, "CS", "RETIRED_NormalReverse", "1"}
-	tagDict[Tag{0x0000, 0x5150}] = TagInfo{Tag{0x0000, 0x5150}, "CS", "RETIRED_AddGrayScale", "1"}
-	tagDict[Tag{0x0000, 0x5160}] = TagInfo{Tag{0x0000, 0x5160}, "CS", "RETIRED_Borders", "1"}
-	tagDict[Tag{0x0000, 0x5170}] = TagInfo{Tag{0x0000, 0x5170}, "IS", "RETIRED_Copies", "1"}
-	tagDict[Tag{0x0000, 0x5180}] = TagInfo{Tag{0x0000, 0x5180}, "CS", "RETIRED_CommandMagnificationType", "1"}
-	tagDict[Tag{0x0000, 0x5190}] = TagInfo{Tag{0x0000, 0x5190}, "CS", "RETIRED_Erase", "1"}
-	tagDict[Tag{0x0000, 0x51A0}] = TagInfo{Tag{0x0000, 0x51A0}, "CS", "RETIRED_Print", "1"}
-	tagDict[Tag{0x0000, 0x51B0}] = TagInfo{Tag{0x0000, 0x51B0}, "US", "RETIRED_Overlays", "1-n"}
-	tagDict[Tag{0x0004, 0x1504}] = TagInfo{Tag{0x0004, 0x1504}, "UP", "RETIRED_MRDRDirectoryRecordOffset", "1"}
-	tagDict[Tag{0x0004, 0x1600}] = TagInfo{Tag{0x0004, 0x1600}, "UL", "RETIRED_NumberOfReferences", "1"}
-	tagDict[Tag{0x0008, 0x0001}] = TagInfo{Tag{0x0008, 0x0001}, "UL", "RETIRED_LengthToEnd", "1"}
-	tagDict[Tag{0x0008, 0x0010}] = TagInfo{Tag{0x0008, 0x0010}, "SH", "RETIRED_RecognitionCode", "1"}
-	tagDict[Tag{0x0008, 0x0024}] = TagInfo{Tag{0x0008, 0x0024}, "DA", "RETIRED_OverlayDate", "1"}
-	tagDict[Tag{0x0008, 0x0025}] = TagInfo{Tag{0x0008, 0x0025}, "DA", "RETIRED_CurveDate", "1"}
-	tagDict[Tag{0x0008, 0x0034}] = TagInfo{Tag{0x0008, 0x0034}, "TM", "RETIRED_OverlayTime", "1"}
-	tagDict[Tag{0x0008, 0x0035}] = TagInfo{Tag{0x0008, 0x0035}, "TM", "RETIRED_CurveTime", "1"}
-	tagDict[Tag{0x0008, 0x0040}] = TagInfo{Tag{0x0008, 0x0040}, "US", "RETIRED_DataSetType", "1"}
-	tagDict[Tag{0x0008, 0x0041}] = TagInfo{Tag{0x0008, 0x0041}, "LO", "RETIRED_DataSetSubtype", "1"}
-	tagDict[Tag{0x0008, 0x0042}] = TagInfo{Tag{0x0008, 0x0042}, "CS", "RETIRED_NuclearMedicineSeriesType", "1"}
-	tagDict[Tag{0x0008, 0x1000}] = TagInfo{Tag{0x0008, 0x1000}, "AE", "RETIRED_NetworkID", "1"}
-	tagDict[Tag{0x0008, 0x1100}] = TagInfo{Tag{0x0008, 0x1100}, "SQ", "RETIRED_ReferencedResultsSequence", "1"}
-	tagDict[Tag{0x0008, 0x1130}] = TagInfo{Tag{0x0008, 0x1130}, "SQ", "RETIRED_ReferencedOverlaySequence", "1"}
-	tagDict[Tag{0x0008, 0x1145}] = TagInfo{Tag{0x0008, 0x1145}, "SQ", "RETIRED_ReferencedCurveSequence", "1"}
-	tagDict[Tag{0x0008, 0x2110}] = TagInfo{Tag{0x0008, 0x2110}, "CS", "RETIRED_LossyImageCompressionRetired", "1"}
-	tagDict[Tag{0x0008, 0x2200}] = TagInfo{Tag{0x0008, 0x2200}, "CS", "RETIRED_TransducerPosition", "1"}
-	tagDict[Tag{0x0008, 0x2204}] = TagInfo{Tag{0x0008, 0x2204}, "CS", "RETIRED_TransducerOrientation", "1"}
-	tagDict[Tag{0x0008, 0x2208}] = TagInfo{Tag{0x0008, 0x2208}, "CS", "RETIRED_AnatomicStructure", "1"}
-	tagDict[Tag{0x0008, 0x2240}] = TagInfo{Tag{0x0008, 0x2240}, "SQ", "RETIRED_TransducerPositionSequence", "1"}
-	tagDict[Tag{0x0008, 0x2242}] = TagInfo{Tag{0x0008, 0x2242}, "SQ", "RETIRED_TransducerPositionModifierSequence", "1"}
-	tagDict[Tag{0x0008, 0x2244}] = TagInfo{Tag{0x0008, 0x2244}, "SQ", "RETIRED_TransducerOrientationSequence", "1"}
-	tagDict[Tag{0x0008, 0x2246}] = TagInfo{Tag{0x0008, 0x2246}, "SQ", "RETIRED_TransducerOrientationModifierSequence", "1"}
-	tagDict[Tag{0x0008, 0x2251}] = TagInfo{Tag{0x0008, 0x2251}, "SQ", "RETIRED_AnatomicStructureSpaceOrRegionCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0008, 0x2253}] = TagInfo{Tag{0x0008, 0x2253}, "SQ", "RETIRED_AnatomicPortalOfEntranceCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0008, 0x2255}] = TagInfo{Tag{0x0008, 0x2255}, "SQ", "RETIRED_AnatomicApproachDirectionCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0008, 0x2256}] = TagInfo{Tag{0x0008, 0x2256}, "ST", "RETIRED_AnatomicPerspectiveDescriptionTrial", "1"}
-	tagDict[Tag{0x0008, 0x2257}] = TagInfo{Tag{0x0008, 0x2257}, "SQ", "RETIRED_AnatomicPerspectiveCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0008, 0x2258}] = TagInfo{Tag{0x0008, 0x2258}, "ST", "RETIRED_AnatomicLocationOfExaminingInstrumentDescriptionTrial", "1"}
-	tagDict[Tag{0x0008, 0x2259}] = TagInfo{Tag{0x0008, 0x2259}, "SQ", "RETIRED_AnatomicLocationOfExaminingInstrumentCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0008, 0x225A}] = TagInfo{Tag{0x0008, 0x225A}, "SQ", "RETIRED_AnatomicStructureSpaceOrRegionModifierCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0008, 0x225C}] = TagInfo{Tag{0x0008, 0x225C}, "SQ", "RETIRED_OnAxisBackgroundAnatomicStructureCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0008, 0x4000}] = TagInfo{Tag{0x0008, 0x4000}, "LT", "RETIRED_IdentifyingComments", "1"}
-	tagDict[Tag{0x0010, 0x1050}] = TagInfo{Tag{0x0010, 0x1050}, "LO", "RETIRED_InsurancePlanIdentification", "1-n"}
-	tagDict[Tag{0x0018, 0x0030}] = TagInfo{Tag{0x0018, 0x0030}, "LO", "RETIRED_Radionuclide", "1-n"}
-	tagDict[Tag{0x0018, 0x0032}] = TagInfo{Tag{0x0018, 0x0032}, "DS", "RETIRED_EnergyWindowCenterline", "1"}
-	tagDict[Tag{0x0018, 0x0033}] = TagInfo{Tag{0x0018, 0x0033}, "DS", "RETIRED_EnergyWindowTotalWidth", "1-n"}
-	tagDict[Tag{0x0018, 0x0037}] = TagInfo{Tag{0x0018, 0x0037}, "CS", "RETIRED_TherapyType", "1"}
-	tagDict[Tag{0x0018, 0x0039}] = TagInfo{Tag{0x0018, 0x0039}, "CS", "RETIRED_TherapyDescription", "1"}
-	tagDict[Tag{0x0018, 0x1011}] = TagInfo{Tag{0x0018, 0x1011}, "LO", "RETIRED_HardcopyCreationDeviceID", "1"}
-	tagDict[Tag{0x0018, 0x1017}] = TagInfo{Tag{0x0018, 0x1017}, "LO", "RETIRED_HardcopyDeviceManufacturer", "1"}
-	tagDict[Tag{0x0018, 0x101A}] = TagInfo{Tag{0x0018, 0x101A}, "LO", "RETIRED_HardcopyDeviceSoftwareVersion", "1-n"}
-	tagDict[Tag{0x0018, 0x101B}] = TagInfo{Tag{0x0018, 0x101B}, "LO", "RETIRED_HardcopyDeviceManufacturerModelName", "1"}
-	tagDict[Tag{0x0018, 0x1141}] = TagInfo{Tag{0x0018, 0x1141}, "DS", "RETIRED_AngularPosition", "1"}
-	tagDict[Tag{0x0018, 0x1146}] = TagInfo{Tag{0x0018, 0x1146}, "DS", "RETIRED_RotationOffset", "1-n"}
-	tagDict[Tag{0x0018, 0x1240}] = TagInfo{Tag{0x0018, 0x1240}, "IS", "RETIRED_UpperLowerPixelValues", "1-n"}
-	tagDict[Tag{0x0018, 0x4000}] = TagInfo{Tag{0x0018, 0x4000}, "LT", "RETIRED_AcquisitionComments", "1"}
-	tagDict[Tag{0x0018, 0x5021}] = TagInfo{Tag{0x0018, 0x5021}, "LO", "RETIRED_PostprocessingFunction", "1"}
-	tagDict[Tag{0x0018, 0x5030}] = TagInfo{Tag{0x0018, 0x5030}, "DS", "RETIRED_DynamicRange", "1"}
-	tagDict[Tag{0x0018, 0x5040}] = TagInfo{Tag{0x0018, 0x5040}, "DS", "RETIRED_TotalGain", "1"}
-	tagDict[Tag{0x0018, 0x5210}] = TagInfo{Tag{0x0018, 0x5210}, "DS", "RETIRED_ImageTransformationMatrix", "6"}
-	tagDict[Tag{0x0018, 0x5212}] = TagInfo{Tag{0x0018, 0x5212}, "DS", "RETIRED_ImageTranslationVector", "3"}
-	tagDict[Tag{0x0018, 0x6038}] = TagInfo{Tag{0x0018, 0x6038}, "UL", "RETIRED_DopplerSampleVolumeXPositionRetired", "1"}
-	tagDict[Tag{0x0018, 0x603A}] = TagInfo{Tag{0x0018, 0x603A}, "UL", "RETIRED_DopplerSampleVolumeYPositionRetired", "1"}
-	tagDict[Tag{0x0018, 0x603C}] = TagInfo{Tag{0x0018, 0x603C}, "UL", "RETIRED_TMLinePositionX0Retired", "1"}
-	tagDict[Tag{0x0018, 0x603E}] = TagInfo{Tag{0x0018, 0x603E}, "UL", "RETIRED_TMLinePositionY0Retired", "1"}
-	tagDict[Tag{0x0018, 0x6040}] = TagInfo{Tag{0x0018, 0x6040}, "UL", "RETIRED_TMLinePositionX1Retired", "1"}
-	tagDict[Tag{0x0018, 0x6042}] = TagInfo{Tag{0x0018, 0x6042}, "UL", "RETIRED_TMLinePositionY1Retired", "1"}
-	tagDict[Tag{0x0018, 0x9096}] = TagInfo{Tag{0x0018, 0x9096}, "FD", "RETIRED_ParallelReductionFactorInPlaneRetired", "1"}
-	tagDict[Tag{0x0018, 0x9166}] = TagInfo{Tag{0x0018, 0x9166}, "CS", "RETIRED_BulkMotionStatus", "1"}
-	tagDict[Tag{0x0018, 0x9195}] = TagInfo{Tag{0x0018, 0x9195}, "FD", "RETIRED_ChemicalShiftMinimumIntegrationLimitInHz", "1"}
-	tagDict[Tag{0x0018, 0x9196}] = TagInfo{Tag{0x0018, 0x9196}, "FD", "RETIRED_ChemicalShiftMaximumIntegrationLimitInHz", "1"}
-	tagDict[Tag{0x0020, 0x0014}] = TagInfo{Tag{0x0020, 0x0014}, "IS", "RETIRED_IsotopeNumber", "1"}
-	tagDict[Tag{0x0020, 0x0015}] = TagInfo{Tag{0x0020, 0x0015}, "IS", "RETIRED_PhaseNumber", "1"}
-	tagDict[Tag{0x0020, 0x0016}] = TagInfo{Tag{0x0020, 0x0016}, "IS", "RETIRED_IntervalNumber", "1"}
-	tagDict[Tag{0x0020, 0x0017}] = TagInfo{Tag{0x0020, 0x0017}, "IS", "RETIRED_TimeSlotNumber", "1"}
-	tagDict[Tag{0x0020, 0x0018}] = TagInfo{Tag{0x0020, 0x0018}, "IS", "RETIRED_AngleNumber", "1"}
-	tagDict[Tag{0x0020, 0x0022}] = TagInfo{Tag{0x0020, 0x0022}, "IS", "RETIRED_OverlayNumber", "1"}
-	tagDict[Tag{0x0020, 0x0024}] = TagInfo{Tag{0x0020, 0x0024}, "IS", "RETIRED_CurveNumber", "1"}
-	tagDict[Tag{0x0020, 0x0026}] = TagInfo{Tag{0x0020, 0x0026}, "IS", "RETIRED_LUTNumber", "1"}
-	tagDict[Tag{0x0020, 0x0030}] = TagInfo{Tag{0x0020, 0x0030}, "DS", "RETIRED_ImagePosition", "3"}
-	tagDict[Tag{0x0020, 0x0035}] = TagInfo{Tag{0x0020, 0x0035}, "DS", "RETIRED_ImageOrientation", "6"}
-	tagDict[Tag{0x0020, 0x0050}] = TagInfo{Tag{0x0020, 0x0050}, "DS", "RETIRED_Location", "1"}
-	tagDict[Tag{0x0020, 0x0070}] = TagInfo{Tag{0x0020, 0x0070}, "LO", "RETIRED_ImageGeometryType", "1"}
-	tagDict[Tag{0x0020, 0x0080}] = TagInfo{Tag{0x0020, 0x0080}, "CS", "RETIRED_MaskingImage", "1-n"}
-	tagDict[Tag{0x0020, 0x00AA}] = TagInfo{Tag{0x0020, 0x00AA}, "IS", "RETIRED_ReportNumber", "1"}
-	tagDict[Tag{0x0020, 0x1000}] = TagInfo{Tag{0x0020, 0x1000}, "IS", "RETIRED_SeriesInStudy", "1"}
-	tagDict[Tag{0x0020, 0x1001}] = TagInfo{Tag{0x0020, 0x1001}, "IS", "RETIRED_AcquisitionsInSeries", "1"}
-	tagDict[Tag{0x0020, 0x1003}] = TagInfo{Tag{0x0020, 0x1003}, "IS", "RETIRED_ImagesInSeries", "1"}
-	tagDict[Tag{0x0020, 0x1004}] = TagInfo{Tag{0x0020, 0x1004}, "IS", "RETIRED_AcquisitionsInStudy", "1"}
-	tagDict[Tag{0x0020, 0x1005}] = TagInfo{Tag{0x0020, 0x1005}, "IS", "RETIRED_ImagesInStudy", "1"}
-	tagDict[Tag{0x0020, 0x1020}] = TagInfo{Tag{0x0020, 0x1020}, "LO", "RETIRED_Reference", "1-n"}
-	tagDict[Tag{0x0020, 0x1070}] = TagInfo{Tag{0x0020, 0x1070}, "IS", "RETIRED_OtherStudyNumbers", "1-n"}
-	tagDict[Tag{0x0020, 0x3401}] = TagInfo{Tag{0x0020, 0x3401}, "CS", "RETIRED_ModifyingDeviceID", "1"}
-	tagDict[Tag{0x0020, 0x3402}] = TagInfo{Tag{0x0020, 0x3402}, "CS", "RETIRED_ModifiedImageID", "1"}
-	tagDict[Tag{0x0020, 0x3403}] = TagInfo{Tag{0x0020, 0x3403}, "DA", "RETIRED_ModifiedImageDate", "1"}
-	tagDict[Tag{0x0020, 0x3404}] = TagInfo{Tag{0x0020, 0x3404}, "LO", "RETIRED_ModifyingDeviceManufacturer", "1"}
-	tagDict[Tag{0x0020, 0x3405}] = TagInfo{Tag{0x0020, 0x3405}, "TM", "RETIRED_ModifiedImageTime", "1"}
-	tagDict[Tag{0x0020, 0x3406}] = TagInfo{Tag{0x0020, 0x3406}, "LO", "RETIRED_ModifiedImageDescription", "1"}
-	tagDict[Tag{0x0020, 0x5000}] = TagInfo{Tag{0x0020, 0x5000}, "AT", "RETIRED_OriginalImageIdentification", "1-n"}
-	tagDict[Tag{0x0020, 0x5002}] = TagInfo{Tag{0x0020, 0x5002}, "LO", "RETIRED_OriginalImageIdentificationNomenclature", "1-n"}
-	tagDict[Tag{0x0028, 0x0005}] = TagInfo{Tag{0x0028, 0x0005}, "US", "RETIRED_ImageDimensions", "1"}
-	tagDict[Tag{0x0028, 0x0012}] = TagInfo{Tag{0x0028, 0x0012}, "US", "RETIRED_Planes", "1"}
-	tagDict[Tag{0x0028, 0x0040}] = TagInfo{Tag{0x0028, 0x0040}, "CS", "RETIRED_ImageFormat", "1"}
-	tagDict[Tag{0x0028, 0x0050}] = TagInfo{Tag{0x0028, 0x0050}, "LO", "RETIRED_ManipulatedImage", "1-n"}
-	tagDict[Tag{0x0028, 0x005F}] = TagInfo{Tag{0x0028, 0x005F}, "LO", "RETIRED_CompressionRecognitionCode", "1"}
-	tagDict[Tag{0x0028, 0x0060}] = TagInfo{Tag{0x0028, 0x0060}, "CS", "RETIRED_CompressionCode", "1"}
-	tagDict[Tag{0x0028, 0x0061}] = TagInfo{Tag{0x0028, 0x0061}, "SH", "RETIRED_CompressionOriginator", "1"}
-	tagDict[Tag{0x0028, 0x0062}] = TagInfo{Tag{0x0028, 0x0062}, "LO", "RETIRED_CompressionLabel", "1"}
-	tagDict[Tag{0x0028, 0x0063}] = TagInfo{Tag{0x0028, 0x0063}, "SH", "RETIRED_CompressionDescription", "1"}
-	tagDict[Tag{0x0028, 0x0065}] = TagInfo{Tag{0x0028, 0x0065}, "CS", "RETIRED_CompressionSequence", "1-n"}
-	tagDict[Tag{0x0028, 0x0066}] = TagInfo{Tag{0x0028, 0x0066}, "AT", "RETIRED_CompressionStepPointers", "1-n"}
-	tagDict[Tag{0x0028, 0x0068}] = TagInfo{Tag{0x0028, 0x0068}, "US", "RETIRED_RepeatInterval", "1"}
-	tagDict[Tag{0x0028, 0x0069}] = TagInfo{Tag{0x0028, 0x0069}, "US", "RETIRED_BitsGrouped", "1"}
-	tagDict[Tag{0x0028, 0x0070}] = TagInfo{Tag{0x0028, 0x0070}, "US", "RETIRED_PerimeterTable", "1-n"}
-	tagDict[Tag{0x0028, 0x0071}] = TagInfo{Tag{0x0028, 0x0071}, "US", "RETIRED_PerimeterValue", "1"}
-	tagDict[Tag{0x0028, 0x0080}] = TagInfo{Tag{0x0028, 0x0080}, "US", "RETIRED_PredictorRows", "1"}
-	tagDict[Tag{0x0028, 0x0081}] = TagInfo{Tag{0x0028, 0x0081}, "US", "RETIRED_PredictorColumns", "1"}
-	tagDict[Tag{0x0028, 0x0082}] = TagInfo{Tag{0x0028, 0x0082}, "US", "RETIRED_PredictorConstants", "1-n"}
-	tagDict[Tag{0x0028, 0x0090}] = TagInfo{Tag{0x0028, 0x0090}, "CS", "RETIRED_BlockedPixels", "1"}
-	tagDict[Tag{0x0028, 0x0091}] = TagInfo{Tag{0x0028, 0x0091}, "US", "RETIRED_BlockRows", "1"}
-	tagDict[Tag{0x0028, 0x0092}] = TagInfo{Tag{0x0028, 0x0092}, "US", "RETIRED_BlockColumns", "1"}
-	tagDict[Tag{0x0028, 0x0093}] = TagInfo{Tag{0x0028, 0x0093}, "US", "RETIRED_RowOverlap", "1"}
-	tagDict[Tag{0x0028, 0x0094}] = TagInfo{Tag{0x0028, 0x0094}, "US", "RETIRED_ColumnOverlap", "1"}
-	tagDict[Tag{0x0028, 0x0104}] = TagInfo{Tag{0x0028, 0x0104}, "US", "RETIRED_SmallestValidPixelValue", "1"}
-	tagDict[Tag{0x0028, 0x0105}] = TagInfo{Tag{0x0028, 0x0105}, "US", "RETIRED_LargestValidPixelValue", "1"}
-	tagDict[Tag{0x0028, 0x0110}] = TagInfo{Tag{0x0028, 0x0110}, "US", "RETIRED_SmallestImagePixelValueInPlane", "1"}
-	tagDict[Tag{0x0028, 0x0111}] = TagInfo{Tag{0x0028, 0x0111}, "US", "RETIRED_LargestImagePixelValueInPlane", "1"}
-	tagDict[Tag{0x0028, 0x0200}] = TagInfo{Tag{0x0028, 0x0200}, "US", "RETIRED_ImageLocation", "1"}
-	tagDict[Tag{0x0028, 0x0400}] = TagInfo{Tag{0x0028, 0x0400}, "LO", "RETIRED_TransformLabel", "1"}
-	tagDict[Tag{0x0028, 0x0401}] = TagInfo{Tag{0x0028, 0x0401}, "LO", "RETIRED_TransformVersionNumber", "1"}
-	tagDict[Tag{0x0028, 0x0402}] = TagInfo{Tag{0x0028, 0x0402}, "US", "RETIRED_NumberOfTransformSteps", "1"}
-	tagDict[Tag{0x0028, 0x0403}] = TagInfo{Tag{0x0028, 0x0403}, "LO", "RETIRED_SequenceOfCompressedData", "1-n"}
-	tagDict[Tag{0x0028, 0x0404}] = TagInfo{Tag{0x0028, 0x0404}, "AT", "RETIRED_DetailsOfCoefficients", "1-n"}
-	tagDict[Tag{0x0028, 0x0700}] = TagInfo{Tag{0x0028, 0x0700}, "LO", "RETIRED_DCTLabel", "1"}
-	tagDict[Tag{0x0028, 0x0701}] = TagInfo{Tag{0x0028, 0x0701}, "CS", "RETIRED_DataBlockDescription", "1-n"}
-	tagDict[Tag{0x0028, 0x0702}] = TagInfo{Tag{0x0028, 0x0702}, "AT", "RETIRED_DataBlock", "1-n"}
-	tagDict[Tag{0x0028, 0x0710}] = TagInfo{Tag{0x0028, 0x0710}, "US", "RETIRED_NormalizationFactorFormat", "1"}
-	tagDict[Tag{0x0028, 0x0720}] = TagInfo{Tag{0x0028, 0x0720}, "US", "RETIRED_ZonalMapNumberFormat", "1"}
-	tagDict[Tag{0x0028, 0x0721}] = TagInfo{Tag{0x0028, 0x0721}, "AT", "RETIRED_ZonalMapLocation", "1-n"}
-	tagDict[Tag{0x0028, 0x0722}] = TagInfo{Tag{0x0028, 0x0722}, "US", "RETIRED_ZonalMapFormat", "1"}
-	tagDict[Tag{0x0028, 0x0730}] = TagInfo{Tag{0x0028, 0x0730}, "US", "RETIRED_AdaptiveMapFormat", "1"}
-	tagDict[Tag{0x0028, 0x0740}] = TagInfo{Tag{0x0028, 0x0740}, "US", "RETIRED_CodeNumberFormat", "1"}
-	tagDict[Tag{0x0028, 0x1080}] = TagInfo{Tag{0x0028, 0x1080}, "CS", "RETIRED_GrayScale", "1"}
-	tagDict[Tag{0x0028, 0x1100}] = TagInfo{Tag{0x0028, 0x1100}, "US", "RETIRED_GrayLookupTableDescriptor", "3"}
-	tagDict[Tag{0x0028, 0x1111}] = TagInfo{Tag{0x0028, 0x1111}, "US", "RETIRED_LargeRedPaletteColorLookupTableDescriptor", "4"}
-	tagDict[Tag{0x0028, 0x1112}] = TagInfo{Tag{0x0028, 0x1112}, "US", "RETIRED_LargeGreenPaletteColorLookupTableDescriptor", "4"}
-	tagDict[Tag{0x0028, 0x1113}] = TagInfo{Tag{0x0028, 0x1113}, "US", "RETIRED_LargeBluePaletteColorLookupTableDescriptor", "4"}
-	tagDict[Tag{0x0028, 0x1200}] = TagInfo{Tag{0x0028, 0x1200}, "LT", "RETIRED_GrayLookupTableData", "1-n"}
-	tagDict[Tag{0x0028, 0x1211}] = TagInfo{Tag{0x0028, 0x1211}, "OW", "RETIRED_LargeRedPaletteColorLookupTableData", "1"}
-	tagDict[Tag{0x0028, 0x1212}] = TagInfo{Tag{0x0028, 0x1212}, "OW", "RETIRED_LargeGreenPaletteColorLookupTableData", "1"}
-	tagDict[Tag{0x0028, 0x1213}] = TagInfo{Tag{0x0028, 0x1213}, "OW", "RETIRED_LargeBluePaletteColorLookupTableData", "1"}
-	tagDict[Tag{0x0028, 0x1214}] = TagInfo{Tag{0x0028, 0x1214}, "UI", "RETIRED_LargePaletteColorLookupTableUID", "1"}
-	tagDict[Tag{0x0028, 0x4000}] = TagInfo{Tag{0x0028, 0x4000}, "LT", "RETIRED_ImagePresentationComments", "1"}
-	tagDict[Tag{0x0028, 0x5000}] = TagInfo{Tag{0x0028, 0x5000}, "SQ", "RETIRED_BiPlaneAcquisitionSequence", "1"}
-	tagDict[Tag{0x0028, 0x6030}] = TagInfo{Tag{0x0028, 0x6030}, "US", "RETIRED_MaskPointers", "1-n"}
-	tagDict[Tag{0x0028, 0x9099}] = TagInfo{Tag{0x0028, 0x9099}, "US", "RETIRED_LargestMonochromePixelValue", "1"}
-	tagDict[Tag{0x0032, 0x000A}] = TagInfo{Tag{0x0032, 0x000A}, "CS", "RETIRED_StudyStatusID", "1"}
-	tagDict[Tag{0x0032, 0x000C}] = TagInfo{Tag{0x0032, 0x000C}, "CS", "RETIRED_StudyPriorityID", "1"}
-	tagDict[Tag{0x0032, 0x0012}] = TagInfo{Tag{0x0032, 0x0012}, "LO", "RETIRED_StudyIDIssuer", "1"}
-	tagDict[Tag{0x0032, 0x0032}] = TagInfo{Tag{0x0032, 0x0032}, "DA", "RETIRED_StudyVerifiedDate", "1"}
-	tagDict[Tag{0x0032, 0x0033}] = TagInfo{Tag{0x0032, 0x0033}, "TM", "RETIRED_StudyVerifiedTime", "1"}
-	tagDict[Tag{0x0032, 0x0034}] = TagInfo{Tag{0x0032, 0x0034}, "DA", "RETIRED_StudyReadDate", "1"}
-	tagDict[Tag{0x0032, 0x0035}] = TagInfo{Tag{0x0032, 0x0035}, "TM", "RETIRED_StudyReadTime", "1"}
-	tagDict[Tag{0x0032, 0x1000}] = TagInfo{Tag{0x0032, 0x1000}, "DA", "RETIRED_ScheduledStudyStartDate", "1"}
-	tagDict[Tag{0x0032, 0x1001}] = TagInfo{Tag{0x0032, 0x1001}, "TM", "RETIRED_ScheduledStudyStartTime", "1"}
-	tagDict[Tag{0x0032, 0x1010}] = TagInfo{Tag{0x0032, 0x1010}, "DA", "RETIRED_ScheduledStudyStopDate", "1"}
-	tagDict[Tag{0x0032, 0x1011}] = TagInfo{Tag{0x0032, 0x1011}, "TM", "RETIRED_ScheduledStudyStopTime", "1"}
-	tagDict[Tag{0x0032, 0x1020}] = TagInfo{Tag{0x0032, 0x1020}, "LO", "RETIRED_ScheduledStudyLocation", "1"}
-	tagDict[Tag{0x0032, 0x1021}] = TagInfo{Tag{0x0032, 0x1021}, "AE", "RETIRED_ScheduledStudyLocationAETitle", "1-n"}
-	tagDict[Tag{0x0032, 0x1030}] = TagInfo{Tag{0x0032, 0x1030}, "LO", "RETIRED_ReasonForStudy", "1"}
-	tagDict[Tag{0x0032, 0x1040}] = TagInfo{Tag{0x0032, 0x1040}, "DA", "RETIRED_StudyArrivalDate", "1"}
-	tagDict[Tag{0x0032, 0x1041}] = TagInfo{Tag{0x0032, 0x1041}, "TM", "RETIRED_StudyArrivalTime", "1"}
-	tagDict[Tag{0x0032, 0x1050}] = TagInfo{Tag{0x0032, 0x1050}, "DA", "RETIRED_StudyCompletionDate", "1"}
-	tagDict[Tag{0x0032, 0x1051}] = TagInfo{Tag{0x0032, 0x1051}, "TM", "RETIRED_StudyCompletionTime", "1"}
-	tagDict[Tag{0x0032, 0x1055}] = TagInfo{Tag{0x0032, 0x1055}, "CS", "RETIRED_StudyComponentStatusID", "1"}
-	tagDict[Tag{0x0032, 0x4000}] = TagInfo{Tag{0x0032, 0x4000}, "LT", "RETIRED_StudyComments", "1"}
-	tagDict[Tag{0x0038, 0x0011}] = TagInfo{Tag{0x0038, 0x0011}, "LO", "RETIRED_IssuerOfAdmissionID", "1"}
-	tagDict[Tag{0x0038, 0x001A}] = TagInfo{Tag{0x0038, 0x001A}, "DA", "RETIRED_ScheduledAdmissionDate", "1"}
-	tagDict[Tag{0x0038, 0x001B}] = TagInfo{Tag{0x0038, 0x001B}, "TM", "RETIRED_ScheduledAdmissionTime", "1"}
-	tagDict[Tag{0x0038, 0x001C}] = TagInfo{Tag{0x0038, 0x001C}, "DA", "RETIRED_ScheduledDischargeDate", "1"}
-	tagDict[Tag{0x0038, 0x001D}] = TagInfo{Tag{0x0038, 0x001D}, "TM", "RETIRED_ScheduledDischargeTime", "1"}
-	tagDict[Tag{0x0038, 0x001E}] = TagInfo{Tag{0x0038, 0x001E}, "LO", "RETIRED_ScheduledPatientInstitutionResidence", "1"}
-	tagDict[Tag{0x0038, 0x0030}] = TagInfo{Tag{0x0038, 0x0030}, "DA", "RETIRED_DischargeDate", "1"}
-	tagDict[Tag{0x0038, 0x0032}] = TagInfo{Tag{0x0038, 0x0032}, "TM", "RETIRED_DischargeTime", "1"}
-	tagDict[Tag{0x0038, 0x0040}] = TagInfo{Tag{0x0038, 0x0040}, "LO", "RETIRED_DischargeDiagnosisDescription", "1"}
-	tagDict[Tag{0x0038, 0x0044}] = TagInfo{Tag{0x0038, 0x0044}, "SQ", "RETIRED_DischargeDiagnosisCodeSequence", "1"}
-	tagDict[Tag{0x0038, 0x0061}] = TagInfo{Tag{0x0038, 0x0061}, "LO", "RETIRED_IssuerOfServiceEpisodeID", "1"}
-	tagDict[Tag{0x0040, 0x0307}] = TagInfo{Tag{0x0040, 0x0307}, "DS", "RETIRED_DistanceSourceToSupport", "1"}
-	tagDict[Tag{0x0040, 0x0330}] = TagInfo{Tag{0x0040, 0x0330}, "SQ", "RETIRED_ReferencedProcedureStepSequence", "1"}
-	tagDict[Tag{0x0040, 0x050A}] = TagInfo{Tag{0x0040, 0x050A}, "LO", "RETIRED_SpecimenAccessionNumber", "1"}
-	tagDict[Tag{0x0040, 0x0550}] = TagInfo{Tag{0x0040, 0x0550}, "SQ", "RETIRED_SpecimenSequence", "1"}
-	tagDict[Tag{0x0040, 0x0552}] = TagInfo{Tag{0x0040, 0x0552}, "SQ", "RETIRED_SpecimenDescriptionSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0x0553}] = TagInfo{Tag{0x0040, 0x0553}, "ST", "RETIRED_SpecimenDescriptionTrial", "1"}
-	tagDict[Tag{0x0040, 0x06FA}] = TagInfo{Tag{0x0040, 0x06FA}, "LO", "RETIRED_SlideIdentifier", "1"}
-	tagDict[Tag{0x0040, 0x08D8}] = TagInfo{Tag{0x0040, 0x08D8}, "SQ", "RETIRED_PixelSpacingSequence", "1"}
-	tagDict[Tag{0x0040, 0x08DA}] = TagInfo{Tag{0x0040, 0x08DA}, "SQ", "RETIRED_CoordinateSystemAxisCodeSequence", "1"}
-	tagDict[Tag{0x0040, 0x09F8}] = TagInfo{Tag{0x0040, 0x09F8}, "SQ", "RETIRED_VitalStainCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0x1006}] = TagInfo{Tag{0x0040, 0x1006}, "SH", "RETIRED_PlacerOrderNumberProcedure", "1"}
-	tagDict[Tag{0x0040, 0x1007}] = TagInfo{Tag{0x0040, 0x1007}, "SH", "RETIRED_FillerOrderNumberProcedure", "1"}
-	tagDict[Tag{0x0040, 0x1060}] = TagInfo{Tag{0x0040, 0x1060}, "LO", "RETIRED_RequestedProcedureDescriptionTrial", "1"}
-	tagDict[Tag{0x0040, 0x2001}] = TagInfo{Tag{0x0040, 0x2001}, "LO", "RETIRED_ReasonForTheImagingServiceRequest", "1"}
-	tagDict[Tag{0x0040, 0x2006}] = TagInfo{Tag{0x0040, 0x2006}, "SH", "RETIRED_PlacerOrderNumberImagingServiceRequestRetired", "1"}
-	tagDict[Tag{0x0040, 0x2007}] = TagInfo{Tag{0x0040, 0x2007}, "SH", "RETIRED_FillerOrderNumberImagingServiceRequestRetired", "1"}
-	tagDict[Tag{0x0040, 0xA007}] = TagInfo{Tag{0x0040, 0xA007}, "CS", "RETIRED_FindingsFlagTrial", "1"}
-	tagDict[Tag{0x0040, 0xA020}] = TagInfo{Tag{0x0040, 0xA020}, "SQ", "RETIRED_FindingsSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA021}] = TagInfo{Tag{0x0040, 0xA021}, "UI", "RETIRED_FindingsGroupUIDTrial", "1"}
-	tagDict[Tag{0x0040, 0xA022}] = TagInfo{Tag{0x0040, 0xA022}, "UI", "RETIRED_ReferencedFindingsGroupUIDTrial", "1"}
-	tagDict[Tag{0x0040, 0xA023}] = TagInfo{Tag{0x0040, 0xA023}, "DA", "RETIRED_FindingsGroupRecordingDateTrial", "1"}
-	tagDict[Tag{0x0040, 0xA024}] = TagInfo{Tag{0x0040, 0xA024}, "TM", "RETIRED_FindingsGroupRecordingTimeTrial", "1"}
-	tagDict[Tag{0x0040, 0xA026}] = TagInfo{Tag{0x0040, 0xA026}, "SQ", "RETIRED_FindingsSourceCategoryCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA028}] = TagInfo{Tag{0x0040, 0xA028}, "SQ", "RETIRED_DocumentingOrganizationIdentifierCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA047}] = TagInfo{Tag{0x0040, 0xA047}, "LO", "RETIRED_MeasurementPrecisionDescriptionTrial", "1"}
-	tagDict[Tag{0x0040, 0xA057}] = TagInfo{Tag{0x0040, 0xA057}, "CS", "RETIRED_UrgencyOrPriorityAlertsTrial", "1-n"}
-	tagDict[Tag{0x0040, 0xA060}] = TagInfo{Tag{0x0040, 0xA060}, "LO", "RETIRED_SequencingIndicatorTrial", "1"}
-	tagDict[Tag{0x0040, 0xA066}] = TagInfo{Tag{0x0040, 0xA066}, "SQ", "RETIRED_DocumentIdentifierCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA067}] = TagInfo{Tag{0x0040, 0xA067}, "PN", "RETIRED_DocumentAuthorTrial", "1"}
-	tagDict[Tag{0x0040, 0xA068}] = TagInfo{Tag{0x0040, 0xA068}, "SQ", "RETIRED_DocumentAuthorIdentifierCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA070}] = TagInfo{Tag{0x0040, 0xA070}, "SQ", "RETIRED_IdentifierCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA074}] = TagInfo{Tag{0x0040, 0xA074}, "OB", "RETIRED_ObjectBinaryIdentifierTrial", "1"}
-	tagDict[Tag{0x0040, 0xA076}] = TagInfo{Tag{0x0040, 0xA076}, "SQ", "RETIRED_DocumentingObserverIdentifierCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA085}] = TagInfo{Tag{0x0040, 0xA085}, "SQ", "RETIRED_ProcedureIdentifierCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA089}] = TagInfo{Tag{0x0040, 0xA089}, "OB", "RETIRED_ObjectDirectoryBinaryIdentifierTrial", "1"}
-	tagDict[Tag{0x0040, 0xA090}] = TagInfo{Tag{0x0040, 0xA090}, "SQ", "RETIRED_EquivalentCDADocumentSequence", "1"}
-	tagDict[Tag{0x0040, 0xA110}] = TagInfo{Tag{0x0040, 0xA110}, "DA", "RETIRED_DateOfDocumentOrVerbalTransactionTrial", "1"}
-	tagDict[Tag{0x0040, 0xA112}] = TagInfo{Tag{0x0040, 0xA112}, "TM", "RETIRED_TimeOfDocumentCreationOrVerbalTransactionTrial", "1"}
-	tagDict[Tag{0x0040, 0xA125}] = TagInfo{Tag{0x0040, 0xA125}, "CS", "RETIRED_ReportStatusIDTrial", "2"}
-	tagDict[Tag{0x0040, 0xA167}] = TagInfo{Tag{0x0040, 0xA167}, "SQ", "RETIRED_ObservationCategoryCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA16A}] = TagInfo{Tag{0x0040, 0xA16A}, "ST", "RETIRED_BibliographicCitationTrial", "1"}
-	tagDict[Tag{0x0040, 0xA172}] = TagInfo{Tag{0x0040, 0xA172}, "UI", "RETIRED_ReferencedObservationUIDTrial", "1"}
-	tagDict[Tag{0x0040, 0xA173}] = TagInfo{Tag{0x0040, 0xA173}, "CS", "RETIRED_ReferencedObservationClassTrial", "1"}
-	tagDict[Tag{0x0040, 0xA174}] = TagInfo{Tag{0x0040, 0xA174}, "CS", "RETIRED_ReferencedObjectObservationClassTrial", "1"}
-	tagDict[Tag{0x0040, 0xA192}] = TagInfo{Tag{0x0040, 0xA192}, "DA", "RETIRED_ObservationDateTrial", "1"}
-	tagDict[Tag{0x0040, 0xA193}] = TagInfo{Tag{0x0040, 0xA193}, "TM", "RETIRED_ObservationTimeTrial", "1"}
-	tagDict[Tag{0x0040, 0xA194}] = TagInfo{Tag{0x0040, 0xA194}, "CS", "RETIRED_MeasurementAutomationTrial", "1"}
-	tagDict[Tag{0x0040, 0xA224}] = TagInfo{Tag{0x0040, 0xA224}, "ST", "RETIRED_IdentificationDescriptionTrial", "1"}
-	tagDict[Tag{0x0040, 0xA290}] = TagInfo{Tag{0x0040, 0xA290}, "CS", "RETIRED_CoordinatesSetGeometricTypeTrial", "1"}
-	tagDict[Tag{0x0040, 0xA296}] = TagInfo{Tag{0x0040, 0xA296}, "SQ", "RETIRED_AlgorithmCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA297}] = TagInfo{Tag{0x0040, 0xA297}, "ST", "RETIRED_AlgorithmDescriptionTrial", "1"}
-	tagDict[Tag{0x0040, 0xA29A}] = TagInfo{Tag{0x0040, 0xA29A}, "SL", "RETIRED_PixelCoordinatesSetTrial", "2-2n"}
-	tagDict[Tag{0x0040, 0xA307}] = TagInfo{Tag{0x0040, 0xA307}, "PN", "RETIRED_CurrentObserverTrial", "1"}
-	tagDict[Tag{0x0040, 0xA313}] = TagInfo{Tag{0x0040, 0xA313}, "SQ", "RETIRED_ReferencedAccessionSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA33A}] = TagInfo{Tag{0x0040, 0xA33A}, "ST", "RETIRED_ReportStatusCommentTrial", "1"}
-	tagDict[Tag{0x0040, 0xA340}] = TagInfo{Tag{0x0040, 0xA340}, "SQ", "RETIRED_ProcedureContextSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA352}] = TagInfo{Tag{0x0040, 0xA352}, "PN", "RETIRED_VerbalSourceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA353}] = TagInfo{Tag{0x0040, 0xA353}, "ST", "RETIRED_AddressTrial", "1"}
-	tagDict[Tag{0x0040, 0xA354}] = TagInfo{Tag{0x0040, 0xA354}, "LO", "RETIRED_TelephoneNumberTrial", "1"}
-	tagDict[Tag{0x0040, 0xA358}] = TagInfo{Tag{0x0040, 0xA358}, "SQ", "RETIRED_VerbalSourceIdentifierCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA380}] = TagInfo{Tag{0x0040, 0xA380}, "SQ", "RETIRED_ReportDetailSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA402}] = TagInfo{Tag{0x0040, 0xA402}, "UI", "RETIRED_ObservationSubjectUIDTrial", "1"}
-	tagDict[Tag{0x0040, 0xA403}] = TagInfo{Tag{0x0040, 0xA403}, "CS", "RETIRED_ObservationSubjectClassTrial", "1"}
-	tagDict[Tag{0x0040, 0xA404}] = TagInfo{Tag{0x0040, 0xA404}, "SQ", "RETIRED_ObservationSubjectTypeCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA600}] = TagInfo{Tag{0x0040, 0xA600}, "CS", "RETIRED_ObservationSubjectContextFlagTrial", "1"}
-	tagDict[Tag{0x0040, 0xA601}] = TagInfo{Tag{0x0040, 0xA601}, "CS", "RETIRED_ObserverContextFlagTrial", "1"}
-	tagDict[Tag{0x0040, 0xA603}] = TagInfo{Tag{0x0040, 0xA603}, "CS", "RETIRED_ProcedureContextFlagTrial", "1"}
-	tagDict[Tag{0x0040, 0xA731}] = TagInfo{Tag{0x0040, 0xA731}, "SQ", "RETIRED_RelationshipSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA732}] = TagInfo{Tag{0x0040, 0xA732}, "SQ", "RETIRED_RelationshipTypeCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA744}] = TagInfo{Tag{0x0040, 0xA744}, "SQ", "RETIRED_LanguageCodeSequenceTrial", "1"}
-	tagDict[Tag{0x0040, 0xA992}] = TagInfo{Tag{0x0040, 0xA992}, "ST", "RETIRED_UniformResourceLocatorTrial", "1"}
-	tagDict[Tag{0x0040, 0xDB06}] = TagInfo{Tag{0x0040, 0xDB06}, "DT", "RETIRED_TemplateVersion", "1"}
-	tagDict[Tag{0x0040, 0xDB07}] = TagInfo{Tag{0x0040, 0xDB07}, "DT", "RETIRED_TemplateLocalVersion", "1"}
-	tagDict[Tag{0x0040, 0xDB0B}] = TagInfo{Tag{0x0040, 0xDB0B}, "CS", "RETIRED_TemplateExtensionFlag", "1"}
-	tagDict[Tag{0x0040, 0xDB0C}] = TagInfo{Tag{0x0040, 0xDB0C}, "UI", "RETIRED_TemplateExtensionOrganizationUID", "1"}
-	tagDict[Tag{0x0040, 0xDB0D}] = TagInfo{Tag{0x0040, 0xDB0D}, "UI", "RETIRED_TemplateExtensionCreatorUID", "1"}
-	tagDict[Tag{0x0054, 0x1400}] = TagInfo{Tag{0x0054, 0x1400}, "CS", "RETIRED_CountsIncluded", "1-n"}
-	tagDict[Tag{0x0054, 0x1401}] = TagInfo{Tag{0x0054, 0x1401}, "CS", "RETIRED_DeadTimeCorrectionFlag", "1"}
-	tagDict[Tag{0x0070, 0x0040}] = TagInfo{Tag{0x0070, 0x0040}, "IS", "RETIRED_ImageRotationRetired", "1"}
-	tagDict[Tag{0x0070, 0x0050}] = TagInfo{Tag{0x0070, 0x0050}, "US", "RETIRED_DisplayedAreaTopLeftHandCornerTrial", "2"}
-	tagDict[Tag{0x0070, 0x0051}] = TagInfo{Tag{0x0070, 0x0051}, "US", "RETIRED_DisplayedAreaBottomRightHandCornerTrial", "2"}
-	tagDict[Tag{0x0070, 0x0067}] = TagInfo{Tag{0x0070, 0x0067}, "US", "RETIRED_GraphicLayerRecommendedDisplayRGBValue", "3"}
-	tagDict[Tag{0x0074, 0x1024}] = TagInfo{Tag{0x0074, 0x1024}, "IS", "RETIRED_BeamOrderIndexTrial", "1"}
-	tagDict[Tag{0x0074, 0x1038}] = TagInfo{Tag{0x0074, 0x1038}, "DS", "RETIRED_DoubleExposureMetersetTrial", "1"}
-	tagDict[Tag{0x0074, 0x103A}] = TagInfo{Tag{0x0074, 0x103A}, "DS", "RETIRED_DoubleExposureFieldDeltaTrial", "4"}
-	tagDict[Tag{0x0074, 0x1220}] = TagInfo{Tag{0x0074, 0x1220}, "SQ", "RETIRED_RelatedProcedureStepSequence", "1"}
-	tagDict[Tag{0x0074, 0x1222}] = TagInfo{Tag{0x0074, 0x1222}, "LO", "RETIRED_ProcedureStepRelationshipType", "1"}
-	tagDict[Tag{0x0088, 0x0904}] = TagInfo{Tag{0x0088, 0x0904}, "LO", "RETIRED_TopicTitle", "1"}
-	tagDict[Tag{0x0088, 0x0906}] = TagInfo{Tag{0x0088, 0x0906}, "ST", "RETIRED_TopicSubject", "1"}
-	tagDict[Tag{0x0088, 0x0910}] = TagInfo{Tag{0x0088, 0x0910}, "LO", "RETIRED_TopicAuthor", "1"}
-	tagDict[Tag{0x0088, 0x0912}] = TagInfo{Tag{0x0088, 0x0912}, "LO", "RETIRED_TopicKeywords", "1-32"}
-	tagDict[Tag{0x2000, 0x0062}] = TagInfo{Tag{0x2000, 0x0062}, "CS", "RETIRED_ColorImagePrintingFlag", "1"}
-	tagDict[Tag{0x2000, 0x0063}] = TagInfo{Tag{0x2000, 0x0063}, "CS", "RETIRED_CollationFlag", "1"}
-	tagDict[Tag{0x2000, 0x0065}] = TagInfo{Tag{0x2000, 0x0065}, "CS", "RETIRED_AnnotationFlag", "1"}
-	tagDict[Tag{0x2000, 0x0067}] = TagInfo{Tag{0x2000, 0x0067}, "CS", "RETIRED_ImageOverlayFlag", "1"}
-	tagDict[Tag{0x2000, 0x0069}] = TagInfo{Tag{0x2000, 0x0069}, "CS", "RETIRED_PresentationLUTFlag", "1"}
-	tagDict[Tag{0x2000, 0x006A}] = TagInfo{Tag{0x2000, 0x006A}, "CS", "RETIRED_ImageBoxPresentationLUTFlag", "1"}
-	tagDict[Tag{0x2000, 0x0510}] = TagInfo{Tag{0x2000, 0x0510}, "SQ", "RETIRED_ReferencedStoredPrintSequence", "1"}
-	tagDict[Tag{0x2020, 0x0130}] = TagInfo{Tag{0x2020, 0x0130}, "SQ", "RETIRED_ReferencedImageOverlayBoxSequence", "1"}
-	tagDict[Tag{0x2020, 0x0140}] = TagInfo{Tag{0x2020, 0x0140}, "SQ", "RETIRED_ReferencedVOILUTBoxSequence", "1"}
-	tagDict[Tag{0x2040, 0x0010}] = TagInfo{Tag{0x2040, 0x0010}, "SQ", "RETIRED_ReferencedOverlayPlaneSequence", "1"}
-	tagDict[Tag{0x2040, 0x0011}] = TagInfo{Tag{0x2040, 0x0011}, "US", "RETIRED_ReferencedOverlayPlaneGroups", "1-99"}
-	tagDict[Tag{0x2040, 0x0020}] = TagInfo{Tag{0x2040, 0x0020}, "SQ", "RETIRED_OverlayPixelDataSequence", "1"}
-	tagDict[Tag{0x2040, 0x0060}] = TagInfo{Tag{0x2040, 0x0060}, "CS", "RETIRED_OverlayMagnificationType", "1"}
-	tagDict[Tag{0x2040, 0x0070}] = TagInfo{Tag{0x2040, 0x0070}, "CS", "RETIRED_OverlaySmoothingType", "1"}
-	tagDict[Tag{0x2040, 0x0072}] = TagInfo{Tag{0x2040, 0x0072}, "CS", "RETIRED_OverlayOrImageMagnification", "1"}
-	tagDict[Tag{0x2040, 0x0074}] = TagInfo{Tag{0x2040, 0x0074}, "US", "RETIRED_MagnifyToNumberOfColumns", "1"}
-	tagDict[Tag{0x2040, 0x0080}] = TagInfo{Tag{0x2040, 0x0080}, "CS", "RETIRED_OverlayForegroundDensity", "1"}
-	tagDict[Tag{0x2040, 0x0082}] = TagInfo{Tag{0x2040, 0x0082}, "CS", "RETIRED_OverlayBackgroundDensity", "1"}
-	tagDict[Tag{0x2040, 0x0090}] = TagInfo{Tag{0x2040, 0x0090}, "CS", "RETIRED_OverlayMode", "1"}
-	tagDict[Tag{0x2040, 0x0100}] = TagInfo{Tag{0x2040, 0x0100}, "CS", "RETIRED_ThresholdDensity", "1"}
-	tagDict[Tag{0x2040, 0x0500}] = TagInfo{Tag{0x2040, 0x0500}, "SQ", "RETIRED_ReferencedImageBoxSequenceRetired", "1"}
-	tagDict[Tag{0x2100, 0x0010}] = TagInfo{Tag{0x2100, 0x0010}, "SH", "RETIRED_PrintJobID", "1"}
-	tagDict[Tag{0x2100, 0x0140}] = TagInfo{Tag{0x2100, 0x0140}, "AE", "RETIRED_DestinationAE", "1"}
-	tagDict[Tag{0x2100, 0x0500}] = TagInfo{Tag{0x2100, 0x0500}, "SQ", "RETIRED_ReferencedPrintJobSequencePullStoredPrint", "1"}
-	tagDict[Tag{0x2110, 0x0099}] = TagInfo{Tag{0x2110, 0x0099}, "SH", "RETIRED_PrintQueueID", "1"}
-	tagDict[Tag{0x2120, 0x0010}] = TagInfo{Tag{0x2120, 0x0010}, "CS", "RETIRED_QueueStatus", "1"}
-	tagDict[Tag{0x2120, 0x0050}] = TagInfo{Tag{0x2120, 0x0050}, "SQ", "RETIRED_PrintJobDescriptionSequence", "1"}
-	tagDict[Tag{0x2120, 0x0070}] = TagInfo{Tag{0x2120, 0x0070}, "SQ", "RETIRED_ReferencedPrintJobSequence", "1"}
-	tagDict[Tag{0x2130, 0x0010}] = TagInfo{Tag{0x2130, 0x0010}, "SQ", "RETIRED_PrintManagementCapabilitiesSequence", "1"}
-	tagDict[Tag{0x2130, 0x0015}] = TagInfo{Tag{0x2130, 0x0015}, "SQ", "RETIRED_PrinterCharacteristicsSequence", "1"}
-	tagDict[Tag{0x2130, 0x0030}] = TagInfo{Tag{0x2130, 0x0030}, "SQ", "RETIRED_FilmBoxContentSequence", "1"}
-	tagDict[Tag{0x2130, 0x0040}] = TagInfo{Tag{0x2130, 0x0040}, "SQ", "RETIRED_ImageBoxContentSequence", "1"}
-	tagDict[Tag{0x2130, 0x0050}] = TagInfo{Tag{0x2130, 0x0050}, "SQ", "RETIRED_AnnotationContentSequence", "1"}
-	tagDict[Tag{0x2130, 0x0060}] = TagInfo{Tag{0x2130, 0x0060}, "SQ", "RETIRED_ImageOverlayBoxContentSequence", "1"}
-	tagDict[Tag{0x2130, 0x0080}] = TagInfo{Tag{0x2130, 0x0080}, "SQ", "RETIRED_PresentationLUTContentSequence", "1"}
-	tagDict[Tag{0x2130, 0x00A0}] = TagInfo{Tag{0x2130, 0x00A0}, "SQ", "RETIRED_ProposedStudySequence", "1"}
-	tagDict[Tag{0x2130, 0x00C0}] = TagInfo{Tag{0x2130, 0x00C0}, "SQ", "RETIRED_OriginalImageSequence", "1"}
-	tagDict[Tag{0x4000, 0x0010}] = TagInfo{Tag{0x4000, 0x0010}, "LT", "RETIRED_Arbitrary", "1"}
-	tagDict[Tag{0x4000, 0x4000}] = TagInfo{Tag{0x4000, 0x4000}, "LT", "RETIRED_TextComments", "1"}
-	tagDict[Tag{0x4008, 0x0040}] = TagInfo{Tag{0x4008, 0x0040}, "SH", "RETIRED_ResultsID", "1"}
-	tagDict[Tag{0x4008, 0x0042}] = TagInfo{Tag{0x4008, 0x0042}, "LO", "RETIRED_ResultsIDIssuer", "1"}
-	tagDict[Tag{0x4008, 0x0050}] = TagInfo{Tag{0x4008, 0x0050}, "SQ", "RETIRED_ReferencedInterpretationSequence", "1"}
-	tagDict[Tag{0x4008, 0x00FF}] = TagInfo{Tag{0x4008, 0x00FF}, "CS", "RETIRED_ReportProductionStatusTrial", "1"}
-	tagDict[Tag{0x4008, 0x0100}] = TagInfo{Tag{0x4008, 0x0100}, "DA", "RETIRED_InterpretationRecordedDate", "1"}
-	tagDict[Tag{0x4008, 0x0101}] = TagInfo{Tag{0x4008, 0x0101}, "TM", "RETIRED_InterpretationRecordedTime", "1"}
-	tagDict[Tag{0x4008, 0x0102}] = TagInfo{Tag{0x4008, 0x0102}, "PN", "RETIRED_InterpretationRecorder", "1"}
-	tagDict[Tag{0x4008, 0x0103}] = TagInfo{Tag{0x4008, 0x0103}, "LO", "RETIRED_ReferenceToRecordedSound", "1"}
-	tagDict[Tag{0x4008, 0x0108}] = TagInfo{Tag{0x4008, 0x0108}, "DA", "RETIRED_InterpretationTranscriptionDate", "1"}
-	tagDict[Tag{0x4008, 0x0109}] = TagInfo{Tag{0x4008, 0x0109}, "TM", "RETIRED_InterpretationTranscriptionTime", "1"}
-	tagDict[Tag{0x4008, 0x010A}] = TagInfo{Tag{0x4008, 0x010A}, "PN", "RETIRED_InterpretationTranscriber", "1"}
-	tagDict[Tag{0x4008, 0x010B}] = TagInfo{Tag{0x4008, 0x010B}, "ST", "RETIRED_InterpretationText", "1"}
-	tagDict[Tag{0x4008, 0x010C}] = TagInfo{Tag{0x4008, 0x010C}, "PN", "RETIRED_InterpretationAuthor", "1"}
-	tagDict[Tag{0x4008, 0x0111}] = TagInfo{Tag{0x4008, 0x0111}, "SQ", "RETIRED_InterpretationApproverSequence", "1"}
-	tagDict[Tag{0x4008, 0x0112}] = TagInfo{Tag{0x4008, 0x0112}, "DA", "RETIRED_InterpretationApprovalDate", "1"}
-	tagDict[Tag{0x4008, 0x0113}] = TagInfo{Tag{0x4008, 0x0113}, "TM", "RETIRED_InterpretationApprovalTime", "1"}
-	tagDict[Tag{0x4008, 0x0114}] = TagInfo{Tag{0x4008, 0x0114}, "PN", "RETIRED_PhysicianApprovingInterpretation", "1"}
-	tagDict[Tag{0x4008, 0x0115}] = TagInfo{Tag{0x4008, 0x0115}, "LT", "RETIRED_InterpretationDiagnosisDescription", "1"}
-	tagDict[Tag{0x4008, 0x0117}] = TagInfo{Tag{0x4008, 0x0117}, "SQ", "RETIRED_InterpretationDiagnosisCodeSequence", "1"}
-	tagDict[Tag{0x4008, 0x0118}] = TagInfo{Tag{0x4008, 0x0118}, "SQ", "RETIRED_ResultsDistributionListSequence", "1"}
-	tagDict[Tag{0x4008, 0x0119}] = TagInfo{Tag{0x4008, 0x0119}, "PN", "RETIRED_DistributionName", "1"}
-	tagDict[Tag{0x4008, 0x011A}] = TagInfo{Tag{0x4008, 0x011A}, "LO", "RETIRED_DistributionAddress", "1"}
-	tagDict[Tag{0x4008, 0x0200}] = TagInfo{Tag{0x4008, 0x0200}, "SH", "RETIRED_InterpretationID", "1"}
-	tagDict[Tag{0x4008, 0x0202}] = TagInfo{Tag{0x4008, 0x0202}, "LO", "RETIRED_InterpretationIDIssuer", "1"}
-	tagDict[Tag{0x4008, 0x0210}] = TagInfo{Tag{0x4008, 0x0210}, "CS", "RETIRED_InterpretationTypeID", "1"}
-	tagDict[Tag{0x4008, 0x0212}] = TagInfo{Tag{0x4008, 0x0212}, "CS", "RETIRED_InterpretationStatusID", "1"}
-	tagDict[Tag{0x4008, 0x0300}] = TagInfo{Tag{0x4008, 0x0300}, "ST", "RETIRED_Impressions", "1"}
-	tagDict[Tag{0x4008, 0x4000}] = TagInfo{Tag{0x4008, 0x4000}, "ST", "RETIRED_ResultsComments", "1"}
-	tagDict[Tag{0x7FE0, 0x0020}] = TagInfo{Tag{0x7FE0, 0x0020}, "OW", "RETIRED_CoefficientsSDVN", "1"}
-	tagDict[Tag{0x7FE0, 0x0030}] = TagInfo{Tag{0x7FE0, 0x0030}, "OW", "RETIRED_CoefficientsSDHN", "1"}
-	tagDict[Tag{0x7FE0, 0x0040}] = TagInfo{Tag{0x7FE0, 0x0040}, "OW", "RETIRED_CoefficientsSDDN", "1"}
+	tagDict[Tag{0x0000, 0x0000}] = TagInfo{Tag: Tag{0x0000, 0x0000}, VR: "UL", Name: "CommandGroupLength", VM: "1"}
+	tagDict[Tag{0x0000, 0x0002}] = TagInfo{Tag: Tag{0x0000, 0x0002}, VR: "UI", Name: "AffectedSOPClassUID", VM: "1"}
+	tagDict[Tag{0x0000, 0x0003}] = TagInfo{Tag: Tag{0x0000, 0x0003}, VR: "UI", Name: "RequestedSOPClassUID", VM: "1"}
+	tagDict[Tag{0x0000, 0x0100}] = TagInfo{Tag: Tag{0x0000, 0x0100}, VR: "US", Name: "CommandField", VM: "1"}
+	tagDict[Tag{0x0000, 0x0110}] = TagInfo{Tag: Tag{0x0000, 0x0110}, VR: "US", Name: "MessageID", VM: "1"}
+	tagDict[Tag{0x0000, 0x0120}] = TagInfo{Tag: Tag{0x0000, 0x0120}, VR: "US", Name: "MessageIDBeingRespondedTo", VM: "1"}
+	tagDict[Tag{0x0000, 0x0600}] = TagInfo{Tag: Tag{0x0000, 0x0600}, VR: "AE", Name: "MoveDestination", VM: "1"}
+	tagDict[Tag{0x0000, 0x0700}] = TagInfo{Tag: Tag{0x0000, 0x0700}, VR: "US", Name: "Priority", VM: "1"}
+	tagDict[Tag{0x0000, 0x0800}] = TagInfo{Tag: Tag{0x0000, 0x0800}, VR: "US", Name: "CommandDataSetType", VM: "1"}
+	tagDict[Tag{0x0000, 0x0900}] = TagInfo{Tag: Tag{0x0000, 0x0900}, VR: "US", Name: "Status", VM: "1"}
+	tagDict[Tag{0x0000, 0x0901}] = TagInfo{Tag: Tag{0x0000, 0x0901}, VR: "AT", Name: "OffendingElement", VM: "1-n"}
+	tagDict[Tag{0x0000, 0x0902}] = TagInfo{Tag: Tag{0x0000, 0x0902}, VR: "LO", Name: "ErrorComment", VM: "1"}
+	tagDict[Tag{0x0000, 0x0903}] = TagInfo{Tag: Tag{0x0000, 0x0903}, VR: "US", Name: "ErrorID", VM: "1"}
+	tagDict[Tag{0x0000, 0x1000}] = TagInfo{Tag: Tag{0x0000, 0x1000}, VR: "UI", Name: "AffectedSOPInstanceUID", VM: "1"}
+	tagDict[Tag{0x0000, 0x1001}] = TagInfo{Tag: Tag{0x0000, 0x1001}, VR: "UI", Name: "RequestedSOPInstanceUID", VM: "1"}
+	tagDict[Tag{0x0000, 0x1002}] = TagInfo{Tag: Tag{0x0000, 0x1002}, VR: "US", Name: "EventTypeID", VM: "1"}
+	tagDict[Tag{0x0000, 0x1005}] = TagInfo{Tag: Tag{0x0000, 0x1005}, VR: "AT", Name: "AttributeIdentifierList", VM: "1-n"}
+	tagDict[Tag{0x0000, 0x1008}] = TagInfo{Tag: Tag{0x0000, 0x1008}, VR: "US", Name: "ActionTypeID", VM: "1"}
+	tagDict[Tag{0x0000, 0x1020}] = TagInfo{Tag: Tag{0x0000, 0x1020}, VR: "US", Name: "NumberOfRemainingSuboperations", VM: "1"}
+	tagDict[Tag{0x0000, 0x1021}] = TagInfo{Tag: Tag{0x0000, 0x1021}, VR: "US", Name: "NumberOfCompletedSuboperations", VM: "1"}
+	tagDict[Tag{0x0000, 0x1022}] = TagInfo{Tag: Tag{0x0000, 0x1022}, VR: "US", Name: "NumberOfFailedSuboperations", VM: "1"}
+	tagDict[Tag{0x0000, 0x1023}] = TagInfo{Tag: Tag{0x0000, 0x1023}, VR: "US", Name: "NumberOfWarningSuboperations", VM: "1"}
+	tagDict[Tag{0x0000, 0x1030}] = TagInfo{Tag: Tag{0x0000, 0x1030}, VR: "AE", Name: "MoveOriginatorApplicationEntityTitle", VM: "1"}
+	tagDict[Tag{0x0000, 0x1031}] = TagInfo{Tag: Tag{0x0000, 0x1031}, VR: "US", Name: "MoveOriginatorMessageID", VM: "1"}
+	tagDict[Tag{0x0002, 0x0000}] = TagInfo{Tag: Tag{0x0002, 0x0000}, VR: "UL", Name: "FileMetaInformationGroupLength", VM: "1"}
+	tagDict[Tag{0x0002, 0x0001}] = TagInfo{Tag: Tag{0x0002, 0x0001}, VR: "OB", Name: "FileMetaInformationVersion", VM: "1"}
+	tagDict[Tag{0x0002, 0x0002}] = TagInfo{Tag: Tag{0x0002, 0x0002}, VR: "UI", Name: "MediaStorageSOPClassUID", VM: "1"}
+	tagDict[Tag{0x0002, 0x0003}] = TagInfo{Tag: Tag{0x0002, 0x0003}, VR: "UI", Name: "MediaStorageSOPInstanceUID", VM: "1"}
+	tagDict[Tag{0x0002, 0x0010}] = TagInfo{Tag: Tag{0x0002, 0x0010}, VR: "UI", Name: "TransferSyntaxUID", VM: "1"}
+	tagDict[Tag{0x0002, 0x0012}] = TagInfo{Tag: Tag{0x0002, 0x0012}, VR: "UI", Name: "ImplementationClassUID", VM: "1"}
+	tagDict[Tag{0x0002, 0x0013}] = TagInfo{Tag: Tag{0x0002, 0x0013}, VR: "SH", Name: "ImplementationVersionName", VM: "1"}
+	tagDict[Tag{0x0002, 0x0016}] = TagInfo{Tag: Tag{0x0002, 0x0016}, VR: "AE", Name: "SourceApplicationEntityTitle", VM: "1"}
+	tagDict[Tag{0x0002, 0x0100}] = TagInfo{Tag: Tag{0x0002, 0x0100}, VR: "UI", Name: "PrivateInformationCreatorUID", VM: "1"}
+	tagDict[Tag{0x0002, 0x0102}] = TagInfo{Tag: Tag{0x0002, 0x0102}, VR: "OB", Name: "PrivateInformation", VM: "1"}
+	tagDict[Tag{0x0004, 0x1130}] = TagInfo{Tag: Tag{0x0004, 0x1130}, VR: "CS", Name: "FileSetID", VM: "1"}
+	tagDict[Tag{0x0004, 0x1141}] = TagInfo{Tag: Tag{0x0004, 0x1141}, VR: "CS", Name: "FileSetDescriptorFileID", VM: "1-8"}
+	tagDict[Tag{0x0004, 0x1142}] = TagInfo{Tag: Tag{0x0004, 0x1142}, VR: "CS", Name: "SpecificCharacterSetOfFileSetDescriptorFile", VM: "1"}
+	tagDict[Tag{0x0004, 0x1200}] = TagInfo{Tag: Tag{0x0004, 0x1200}, VR: "UL", Name: "OffsetOfTheFirstDirectoryRecordOfTheRootDirectoryEntity", VM: "1"}
+	tagDict[Tag{0x0004, 0x1202}] = TagInfo{Tag: Tag{0x0004, 0x1202}, VR: "UL", Name: "OffsetOfTheLastDirectoryRecordOfTheRootDirectoryEntity", VM: "1"}
+	tagDict[Tag{0x0004, 0x1212}] = TagInfo{Tag: Tag{0x0004, 0x1212}, VR: "US", Name: "FileSetConsistencyFlag", VM: "1"}
+	tagDict[Tag{0x0004, 0x1220}] = TagInfo{Tag: Tag{0x0004, 0x1220}, VR: "SQ", Name: "DirectoryRecordSequence", VM: "1"}
+	tagDict[Tag{0x0004, 0x1400}] = TagInfo{Tag: Tag{0x0004, 0x1400}, VR: "UL", Name: "OffsetOfTheNextDirectoryRecord", VM: "1"}
+	tagDict[Tag{0x0004, 0x1410}] = TagInfo{Tag: Tag{0x0004, 0x1410}, VR: "US", Name: "RecordInUseFlag", VM: "1"}
+	tagDict[Tag{0x0004, 0x1420}] = TagInfo{Tag: Tag{0x0004, 0x1420}, VR: "UL", Name: "OffsetOfReferencedLowerLevelDirectoryEntity", VM: "1"}
+	tagDict[Tag{0x0004, 0x1430}] = TagInfo{Tag: Tag{0x0004, 0x1430}, VR: "CS", Name: "DirectoryRecordType", VM: "1"}
+	tagDict[Tag{0x0004, 0x1432}] = TagInfo{Tag: Tag{0x0004, 0x1432}, VR: "UI", Name: "PrivateRecordUID", VM: "1"}
+	tagDict[Tag{0x0004, 0x1500}] = TagInfo{Tag: Tag{0x0004, 0x1500}, VR: "CS", Name: "ReferencedFileID", VM: "1-8"}
+	tagDict[Tag{0x0004, 0x1510}] = TagInfo{Tag: Tag{0x0004, 0x1510}, VR: "UI", Name: "ReferencedSOPClassUIDInFile", VM: "1"}
+	tagDict[Tag{0x0004, 0x1511}] = TagInfo{Tag: Tag{0x0004, 0x1511}, VR: "UI", Name: "ReferencedSOPInstanceUIDInFile", VM: "1"}
+	tagDict[Tag{0x0004, 0x1512}] = TagInfo{Tag: Tag{0x0004, 0x1512}, VR: "UI", Name: "ReferencedTransferSyntaxUIDInFile", VM: "1"}
+	tagDict[Tag{0x0004, 0x151A}] = TagInfo{Tag: Tag{0x0004, 0x151A}, VR: "UI", Name: "ReferencedRelatedGeneralSOPClassUIDInFile", VM: "1-n"}
+	tagDict[Tag{0x0008, 0x0005}] = TagInfo{Tag: Tag{0x0008, 0x0005}, VR: "CS", Name: "SpecificCharacterSet", VM: "1-n"}
+	tagDict[Tag{0x0008, 0x0006}] = TagInfo{Tag: Tag{0x0008, 0x0006}, VR: "SQ", Name: "LanguageCodeSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x0008}] = TagInfo{Tag: Tag{0x0008, 0x0008}, VR: "CS", Name: "ImageType", VM: "2-n"}
+	tagDict[Tag{0x0008, 0x0012}] = TagInfo{Tag: Tag{0x0008, 0x0012}, VR: "DA", Name: "InstanceCreationDate", VM: "1"}
+	tagDict[Tag{0x0008, 0x0013}] = TagInfo{Tag: Tag{0x0008, 0x0013}, VR: "TM", Name: "InstanceCreationTime", VM: "1"}
+	tagDict[Tag{0x0008, 0x0014}] = TagInfo{Tag: Tag{0x0008, 0x0014}, VR: "UI", Name: "InstanceCreatorUID", VM: "1"}
+	tagDict[Tag{0x0008, 0x0016}] = TagInfo{Tag: Tag{0x0008, 0x0016}, VR: "UI", Name: "SOPClassUID", VM: "1"}
+	tagDict[Tag{0x0008, 0x0018}] = TagInfo{Tag: Tag{0x0008, 0x0018}, VR: "UI", Name: "SOPInstanceUID", VM: "1"}
+	tagDict[Tag{0x0008, 0x001A}] = TagInfo{Tag: Tag{0x0008, 0x001A}, VR: "UI", Name: "RelatedGeneralSOPClassUID", VM: "1-n"}
+	tagDict[Tag{0x0008, 0x001B}] = TagInfo{Tag: Tag{0x0008, 0x001B}, VR: "UI", Name: "OriginalSpecializedSOPClassUID", VM: "1"}
+	tagDict[Tag{0x0008, 0x0020}] = TagInfo{Tag: Tag{0x0008, 0x0020}, VR: "DA", Name: "StudyDate", VM: "1"}
+	tagDict[Tag{0x0008, 0x0021}] = TagInfo{Tag: Tag{0x0008, 0x0021}, VR: "DA", Name: "SeriesDate", VM: "1"}
+	tagDict[Tag{0x0008, 0x0022}] = TagInfo{Tag: Tag{0x0008, 0x0022}, VR: "DA", Name: "AcquisitionDate", VM: "1"}
+	tagDict[Tag{0x0008, 0x0023}] = TagInfo{Tag: Tag{0x0008, 0x0023}, VR: "DA", Name: "ContentDate", VM: "1"}
+	tagDict[Tag{0x0008, 0x002A}] = TagInfo{Tag: Tag{0x0008, 0x002A}, VR: "DT", Name: "AcquisitionDateTime", VM: "1"}
+	tagDict[Tag{0x0008, 0x0030}] = TagInfo{Tag: Tag{0x0008, 0x0030}, VR: "TM", Name: "StudyTime", VM: "1"}
+	tagDict[Tag{0x0008, 0x0031}] = TagInfo{Tag: Tag{0x0008, 0x0031}, VR: "TM", Name: "SeriesTime", VM: "1"}
+	tagDict[Tag{0x0008, 0x0032}] = TagInfo{Tag: Tag{0x0008, 0x0032}, VR: "TM", Name: "AcquisitionTime", VM: "1"}
+	tagDict[Tag{0x0008, 0x0033}] = TagInfo{Tag: Tag{0x0008, 0x0033}, VR: "TM", Name: "ContentTime", VM: "1"}
+	tagDict[Tag{0x0008, 0x0050}] = TagInfo{Tag: Tag{0x0008, 0x0050}, VR: "SH", Name: "AccessionNumber", VM: "1"}
+	tagDict[Tag{0x0008, 0x0051}] = TagInfo{Tag: Tag{0x0008, 0x0051}, VR: "SQ", Name: "IssuerOfAccessionNumberSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x0052}] = TagInfo{Tag: Tag{0x0008, 0x0052}, VR: "CS", Name: "QueryRetrieveLevel", VM: "1"}
+	tagDict[Tag{0x0008, 0x0054}] = TagInfo{Tag: Tag{0x0008, 0x0054}, VR: "AE", Name: "RetrieveAETitle", VM: "1-n"}
+	tagDict[Tag{0x0008, 0x0056}] = TagInfo{Tag: Tag{0x0008, 0x0056}, VR: "CS", Name: "InstanceAvailability", VM: "1"}
+	tagDict[Tag{0x0008, 0x0058}] = TagInfo{Tag: Tag{0x0008, 0x0058}, VR: "UI", Name: "FailedSOPInstanceUIDList", VM: "1-n"}
+	tagDict[Tag{0x0008, 0x0060}] = TagInfo{Tag: Tag{0x0008, 0x0060}, VR: "CS", Name: "Modality", VM: "1"}
+	tagDict[Tag{0x0008, 0x0061}] = TagInfo{Tag: Tag{0x0008, 0x0061}, VR: "CS", Name: "ModalitiesInStudy", VM: "1-n"}
+	tagDict[Tag{0x0008, 0x0062}] = TagInfo{Tag: Tag{0x0008, 0x0062}, VR: "UI", Name: "SOPClassesInStudy", VM: "1-n"}
+	tagDict[Tag{0x0008, 0x0064}] = TagInfo{Tag: Tag{0x0008, 0x0064}, VR: "CS", Name: "ConversionType", VM: "1"}
+	tagDict[Tag{0x0008, 0x0068}] = TagInfo{Tag: Tag{0x0008, 0x0068}, VR: "CS", Name: "PresentationIntentType", VM: "1"}
+	tagDict[Tag{0x0008, 0x0070}] = TagInfo{Tag: Tag{0x0008, 0x0070}, VR: "LO", Name: "Manufacturer", VM: "1"}
+	tagDict[Tag{0x0008, 0x0080}] = TagInfo{Tag: Tag{0x0008, 0x0080}, VR: "LO", Name: "CompanyName", VM: "1"}
+	tagDict[Tag{0x0008, 0x0081}] = TagInfo{Tag: Tag{0x0008, 0x0081}, VR: "ST", Name: "CompanyAddress", VM: "1"}
+	tagDict[Tag{0x0008, 0x0082}] = TagInfo{Tag: Tag{0x0008, 0x0082}, VR: "SQ", Name: "InstitutionCodeSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x0090}] = TagInfo{Tag: Tag{0x0008, 0x0090}, VR: "PN", Name: "ComponentOwnerName", VM: "1"}
+	tagDict[Tag{0x0008, 0x0092}] = TagInfo{Tag: Tag{0x0008, 0x0092}, VR: "ST", Name: "ReferringPhysicianAddress", VM: "1"}
+	tagDict[Tag{0x0008, 0x0094}] = TagInfo{Tag: Tag{0x0008, 0x0094}, VR: "SH", Name: "ReferringPhysicianTelephoneNumbers", VM: "1-n"}
+	tagDict[Tag{0x0008, 0x0096}] = TagInfo{Tag: Tag{0x0008, 0x0096}, VR: "SQ", Name: "ReferringPhysicianIdentificationSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x0100}] = TagInfo{Tag: Tag{0x0008, 0x0100}, VR: "SH", Name: "CodeValue", VM: "1"}
+	tagDict[Tag{0x0008, 0x0102}] = TagInfo{Tag: Tag{0x0008, 0x0102}, VR: "SH", Name: "CodingSchemeDesignator", VM: "1"}
+	tagDict[Tag{0x0008, 0x0103}] = TagInfo{Tag: Tag{0x0008, 0x0103}, VR: "SH", Name: "CodingSchemeVersion", VM: "1"}
+	tagDict[Tag{0x0008, 0x0104}] = TagInfo{Tag: Tag{0x0008, 0x0104}, VR: "LO", Name: "CodeMeaning", VM: "1"}
+	tagDict[Tag{0x0008, 0x0105}] = TagInfo{Tag: Tag{0x0008, 0x0105}, VR: "CS", Name: "MappingResource", VM: "1"}
+	tagDict[Tag{0x0008, 0x0106}] = TagInfo{Tag: Tag{0x0008, 0x0106}, VR: "DT", Name: "ContextGroupVersion", VM: "1"}
+	tagDict[Tag{0x0008, 0x0107}] = TagInfo{Tag: Tag{0x0008, 0x0107}, VR: "DT", Name: "ContextGroupLocalVersion", VM: "1"}
+	tagDict[Tag{0x0008, 0x010B}] = TagInfo{Tag: Tag{0x0008, 0x010B}, VR: "CS", Name: "ContextGroupExtensionFlag", VM: "1"}
+	tagDict[Tag{0x0008, 0x010C}] = TagInfo{Tag: Tag{0x0008, 0x010C}, VR: "UI", Name: "CodingSchemeUID", VM: "1"}
+	tagDict[Tag{0x0008, 0x010D}] = TagInfo{Tag: Tag{0x0008, 0x010D}, VR: "UI", Name: "ContextGroupExtensionCreatorUID", VM: "1"}
+	tagDict[Tag{0x0008, 0x010F}] = TagInfo{Tag: Tag{0x0008, 0x010F}, VR: "CS", Name: "ContextIdentifier", VM: "1"}
+	tagDict[Tag{0x0008, 0x0110}] = TagInfo{Tag: Tag{0x0008, 0x0110}, VR: "SQ", Name: "CodingSchemeIdentificationSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x0112}] = TagInfo{Tag: Tag{0x0008, 0x0112}, VR: "LO", Name: "CodingSchemeRegistry", VM: "1"}
+	tagDict[Tag{0x0008, 0x0114}] = TagInfo{Tag: Tag{0x0008, 0x0114}, VR: "ST", Name: "CodingSchemeExternalID", VM: "1"}
+	tagDict[Tag{0x0008, 0x0115}] = TagInfo{Tag: Tag{0x0008, 0x0115}, VR: "ST", Name: "CodingSchemeName", VM: "1"}
+	tagDict[Tag{0x0008, 0x0116}] = TagInfo{Tag: Tag{0x0008, 0x0116}, VR: "ST", Name: "CodingSchemeResponsibleOrganization", VM: "1"}
+	tagDict[Tag{0x0008, 0x0117}] = TagInfo{Tag: Tag{0x0008, 0x0117}, VR: "UI", Name: "ContextUID", VM: "1"}
+	tagDict[Tag{0x0008, 0x0201}] = TagInfo{Tag: Tag{0x0008, 0x0201}, VR: "SH", Name: "TimezoneOffsetFromUTC", VM: "1"}
+	tagDict[Tag{0x0008, 0x1010}] = TagInfo{Tag: Tag{0x0008, 0x1010}, VR: "SH", Name: "StationName", VM: "1"}
+	tagDict[Tag{0x0008, 0x1030}] = TagInfo{Tag: Tag{0x0008, 0x1030}, VR: "LO", Name: "StudyDescription", VM: "1"}
+	tagDict[Tag{0x0008, 0x1032}] = TagInfo{Tag: Tag{0x0008, 0x1032}, VR: "SQ", Name: "ProcedureCodeSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x103E}] = TagInfo{Tag: Tag{0x0008, 0x103E}, VR: "LO", Name: "SeriesDescription", VM: "1"}
+	tagDict[Tag{0x0008, 0x103F}] = TagInfo{Tag: Tag{0x0008, 0x103F}, VR: "SQ", Name: "SeriesDescriptionCodeSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x1040}] = TagInfo{Tag: Tag{0x0008, 0x1040}, VR: "LO", Name: "DepartmentName", VM: "1"}
+	tagDict[Tag{0x0008, 0x1048}] = TagInfo{Tag: Tag{0x0008, 0x1048}, VR: "PN", Name: "InspectingCompanyName", VM: "1-n"}
+	tagDict[Tag{0x0008, 0x1049}] = TagInfo{Tag: Tag{0x0008, 0x1049}, VR: "SQ", Name: "PhysiciansOfRecordIdentificationSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x1050}] = TagInfo{Tag: Tag{0x0008, 0x1050}, VR: "PN", Name: "InspectorName", VM: "1-n"}
+	tagDict[Tag{0x0008, 0x1052}] = TagInfo{Tag: Tag{0x0008, 0x1052}, VR: "SQ", Name: "PerformingPhysicianIdentificationSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x1060}] = TagInfo{Tag: Tag{0x0008, 0x1060}, VR: "PN", Name: "CertifyingInspectorName", VM: "1-n"}
+	tagDict[Tag{0x0008, 0x1062}] = TagInfo{Tag: Tag{0x0008, 0x1062}, VR: "SQ", Name: "PhysiciansReadingStudyIdentificationSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x1070}] = TagInfo{Tag: Tag{0x0008, 0x1070}, VR: "PN", Name: "OperatorsName", VM: "1-n"}
+	tagDict[Tag{0x0008, 0x1072}] = TagInfo{Tag: Tag{0x0008, 0x1072}, VR: "SQ", Name: "OperatorIdentificationSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x1080}] = TagInfo{Tag: Tag{0x0008, 0x1080}, VR: "LO", Name: "AdmittingDiagnosesDescription", VM: "1-n"}
+	tagDict[Tag{0x0008, 0x1084}] = TagInfo{Tag: Tag{0x0008, 0x1084}, VR: "SQ", Name: "AdmittingDiagnosesCodeSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x1090}] = TagInfo{Tag: Tag{0x0008, 0x1090}, VR: "LO", Name: "ManufacturerModelName", VM: "1"}
+	tagDict[Tag{0x0008, 0x1110}] = TagInfo{Tag: Tag{0x0008, 0x1110}, VR: "SQ", Name: "ReferencedStudySequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x1111}] = TagInfo{Tag: Tag{0x0008, 0x1111}, VR: "SQ", Name: "ReferencedPerformedProcedureStepSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x1115}] = TagInfo{Tag: Tag{0x0008, 0x1115}, VR: "SQ", Name: "ReferencedSeriesSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x1120}] = TagInfo{Tag: Tag{0x0008, 0x1120}, VR: "SQ", Name: "ReferencedPatientSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x1125}] = TagInfo{Tag: Tag{0x0008, 0x1125}, VR: "SQ", Name: "ReferencedVisitSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x1134}] = TagInfo{Tag: Tag{0x0008, 0x1134}, VR: "SQ", Name: "ReferencedStereometricInstanceSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x113A}] = TagInfo{Tag: Tag{0x0008, 0x113A}, VR: "SQ", Name: "ReferencedWaveformSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x1140}] = TagInfo{Tag: Tag{0x0008, 0x1140}, VR: "SQ", Name: "ReferencedImageSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x114A}] = TagInfo{Tag: Tag{0x0008, 0x114A}, VR: "SQ", Name: "ReferencedInstanceSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x114B}] = TagInfo{Tag: Tag{0x0008, 0x114B}, VR: "SQ", Name: "ReferencedRealWorldValueMappingInstanceSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x1150}] = TagInfo{Tag: Tag{0x0008, 0x1150}, VR: "UI", Name: "ReferencedSOPClassUID", VM: "1"}
+	tagDict[Tag{0x0008, 0x1155}] = TagInfo{Tag: Tag{0x0008, 0x1155}, VR: "UI", Name: "ReferencedSOPInstanceUID", VM: "1"}
+	tagDict[Tag{0x0008, 0x115A}] = TagInfo{Tag: Tag{0x0008, 0x115A}, VR: "UI", Name: "SOPClassesSupported", VM: "1-n"}
+	tagDict[Tag{0x0008, 0x1160}] = TagInfo{Tag: Tag{0x0008, 0x1160}, VR: "IS", Name: "ReferencedFrameNumber", VM: "1-n"}
+	tagDict[Tag{0x0008, 0x1161}] = TagInfo{Tag: Tag{0x0008, 0x1161}, VR: "UL", Name: "SimpleFrameList", VM: "1-n"}
+	tagDict[Tag{0x0008, 0x1162}] = TagInfo{Tag: Tag{0x0008, 0x1162}, VR: "UL", Name: "CalculatedFrameList", VM: "3-3n"}
+	tagDict[Tag{0x0008, 0x1163}] = TagInfo{Tag: Tag{0x0008, 0x1163}, VR: "FD", Name: "TimeRange", VM: "2"}
+	tagDict[Tag{0x0008, 0x1164}] = TagInfo{Tag: Tag{0x0008, 0x1164}, VR: "SQ", Name: "FrameExtractionSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x1167}] = TagInfo{Tag: Tag{0x0008, 0x1167}, VR: "UI", Name: "MultiFrameSourceSOPInstanceUID", VM: "1"}
+	tagDict[Tag{0x0008, 0x1195}] = TagInfo{Tag: Tag{0x0008, 0x1195}, VR: "UI", Name: "TransactionUID", VM: "1"}
+	tagDict[Tag{0x0008, 0x1197}] = TagInfo{Tag: Tag{0x0008, 0x1197}, VR: "US", Name: "FailureReason", VM: "1"}
+	tagDict[Tag{0x0008, 0x1198}] = TagInfo{Tag: Tag{0x0008, 0x1198}, VR: "SQ", Name: "FailedSOPSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x1199}] = TagInfo{Tag: Tag{0x0008, 0x1199}, VR: "SQ", Name: "ReferencedSOPSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x1200}] = TagInfo{Tag: Tag{0x0008, 0x1200}, VR: "SQ", Name: "StudiesContainingOtherReferencedInstancesSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x1250}] = TagInfo{Tag: Tag{0x0008, 0x1250}, VR: "SQ", Name: "RelatedSeriesSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x2111}] = TagInfo{Tag: Tag{0x0008, 0x2111}, VR: "ST", Name: "DerivationDescription", VM: "1"}
+	tagDict[Tag{0x0008, 0x2112}] = TagInfo{Tag: Tag{0x0008, 0x2112}, VR: "SQ", Name: "SourceImageSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x2120}] = TagInfo{Tag: Tag{0x0008, 0x2120}, VR: "SH", Name: "StageName", VM: "1"}
+	tagDict[Tag{0x0008, 0x2122}] = TagInfo{Tag: Tag{0x0008, 0x2122}, VR: "IS", Name: "StageNumber", VM: "1"}
+	tagDict[Tag{0x0008, 0x2124}] = TagInfo{Tag: Tag{0x0008, 0x2124}, VR: "IS", Name: "NumberOfStages", VM: "1"}
+	tagDict[Tag{0x0008, 0x2127}] = TagInfo{Tag: Tag{0x0008, 0x2127}, VR: "SH", Name: "ViewName", VM: "1"}
+	tagDict[Tag{0x0008, 0x2128}] = TagInfo{Tag: Tag{0x0008, 0x2128}, VR: "IS", Name: "ViewNumber", VM: "1"}
+	tagDict[Tag{0x0008, 0x2129}] = TagInfo{Tag: Tag{0x0008, 0x2129}, VR: "IS", Name: "NumberOfEventTimers", VM: "1"}
+	tagDict[Tag{0x0008, 0x212A}] = TagInfo{Tag: Tag{0x0008, 0x212A}, VR: "IS", Name: "NumberOfViewsInStage", VM: "1"}
+	tagDict[Tag{0x0008, 0x2130}] = TagInfo{Tag: Tag{0x0008, 0x2130}, VR: "DS", Name: "EventElapsedTimes", VM: "1-n"}
+	tagDict[Tag{0x0008, 0x2132}] = TagInfo{Tag: Tag{0x0008, 0x2132}, VR: "LO", Name: "EventTimerNames", VM: "1-n"}
+	tagDict[Tag{0x0008, 0x2133}] = TagInfo{Tag: Tag{0x0008, 0x2133}, VR: "SQ", Name: "EventTimerSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x2134}] = TagInfo{Tag: Tag{0x0008, 0x2134}, VR: "FD", Name: "EventTimeOffset", VM: "1"}
+	tagDict[Tag{0x0008, 0x2135}] = TagInfo{Tag: Tag{0x0008, 0x2135}, VR: "SQ", Name: "EventCodeSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x2142}] = TagInfo{Tag: Tag{0x0008, 0x2142}, VR: "IS", Name: "StartTrim", VM: "1"}
+	tagDict[Tag{0x0008, 0x2143}] = TagInfo{Tag: Tag{0x0008, 0x2143}, VR: "IS", Name: "StopTrim", VM: "1"}
+	tagDict[Tag{0x0008, 0x2144}] = TagInfo{Tag: Tag{0x0008, 0x2144}, VR: "IS", Name: "RecommendedDisplayFrameRate", VM: "1"}
+	tagDict[Tag{0x0008, 0x2218}] = TagInfo{Tag: Tag{0x0008, 0x2218}, VR: "SQ", Name: "AnatomicRegionSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x2220}] = TagInfo{Tag: Tag{0x0008, 0x2220}, VR: "SQ", Name: "AnatomicRegionModifierSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x2228}] = TagInfo{Tag: Tag{0x0008, 0x2228}, VR: "SQ", Name: "PrimaryAnatomicStructureSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x2229}] = TagInfo{Tag: Tag{0x0008, 0x2229}, VR: "SQ", Name: "AnatomicStructureSpaceOrRegionSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x2230}] = TagInfo{Tag: Tag{0x0008, 0x2230}, VR: "SQ", Name: "PrimaryAnatomicStructureModifierSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x3001}] = TagInfo{Tag: Tag{0x0008, 0x3001}, VR: "SQ", Name: "AlternateRepresentationSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x3010}] = TagInfo{Tag: Tag{0x0008, 0x3010}, VR: "UI", Name: "IrradiationEventUID", VM: "1"}
+	tagDict[Tag{0x0008, 0x9007}] = TagInfo{Tag: Tag{0x0008, 0x9007}, VR: "CS", Name: "FrameType", VM: "4"}
+	tagDict[Tag{0x0008, 0x9092}] = TagInfo{Tag: Tag{0x0008, 0x9092}, VR: "SQ", Name: "ReferencedImageEvidenceSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x9121}] = TagInfo{Tag: Tag{0x0008, 0x9121}, VR: "SQ", Name: "ReferencedRawDataSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x9123}] = TagInfo{Tag: Tag{0x0008, 0x9123}, VR: "UI", Name: "CreatorVersionUID", VM: "1"}
+	tagDict[Tag{0x0008, 0x9124}] = TagInfo{Tag: Tag{0x0008, 0x9124}, VR: "SQ", Name: "DerivationImageSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x9154}] = TagInfo{Tag: Tag{0x0008, 0x9154}, VR: "SQ", Name: "SourceImageEvidenceSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x9205}] = TagInfo{Tag: Tag{0x0008, 0x9205}, VR: "CS", Name: "PixelPresentation", VM: "1"}
+	tagDict[Tag{0x0008, 0x9206}] = TagInfo{Tag: Tag{0x0008, 0x9206}, VR: "CS", Name: "VolumetricProperties", VM: "1"}
+	tagDict[Tag{0x0008, 0x9207}] = TagInfo{Tag: Tag{0x0008, 0x9207}, VR: "CS", Name: "VolumeBasedCalculationTechnique", VM: "1"}
+	tagDict[Tag{0x0008, 0x9208}] = TagInfo{Tag: Tag{0x0008, 0x9208}, VR: "CS", Name: "ComplexImageComponent", VM: "1"}
+	tagDict[Tag{0x0008, 0x9209}] = TagInfo{Tag: Tag{0x0008, 0x9209}, VR: "CS", Name: "AcquisitionContrast", VM: "1"}
+	tagDict[Tag{0x0008, 0x9215}] = TagInfo{Tag: Tag{0x0008, 0x9215}, VR: "SQ", Name: "DerivationCodeSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x9237}] = TagInfo{Tag: Tag{0x0008, 0x9237}, VR: "SQ", Name: "ReferencedPresentationStateSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x9410}] = TagInfo{Tag: Tag{0x0008, 0x9410}, VR: "SQ", Name: "ReferencedOtherPlaneSequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x9458}] = TagInfo{Tag: Tag{0x0008, 0x9458}, VR: "SQ", Name: "FrameDisplaySequence", VM: "1"}
+	tagDict[Tag{0x0008, 0x9459}] = TagInfo{Tag: Tag{0x0008, 0x9459}, VR: "FL", Name: "RecommendedDisplayFrameRateInFloat", VM: "1"}
+	tagDict[Tag{0x0008, 0x9460}] = TagInfo{Tag: Tag{0x0008, 0x9460}, VR: "CS", Name: "SkipFrameRangeFlag", VM: "1"}
+	tagDict[Tag{0x0010, 0x0010}] = TagInfo{Tag: Tag{0x0010, 0x0010}, VR: "PN", Name: "ComponentName", VM: "1"}
+	tagDict[Tag{0x0010, 0x0020}] = TagInfo{Tag: Tag{0x0010, 0x0020}, VR: "LO", Name: "ComponentIDNumber", VM: "1-n"}
+	tagDict[Tag{0x0010, 0x0021}] = TagInfo{Tag: Tag{0x0010, 0x0021}, VR: "LO", Name: "IssuerOfPatientID", VM: "1"}
+	tagDict[Tag{0x0010, 0x0022}] = TagInfo{Tag: Tag{0x0010, 0x0022}, VR: "CS", Name: "TypeOfPatientID", VM: "1"}
+	tagDict[Tag{0x0010, 0x0024}] = TagInfo{Tag: Tag{0x0010, 0x0024}, VR: "SQ", Name: "IssuerOfPatientIDQualifiersSequence", VM: "1"}
+	tagDict[Tag{0x0010, 0x0030}] = TagInfo{Tag: Tag{0x0010, 0x0030}, VR: "DA", Name: "ComponentManufacturingDate", VM: "1"}
+	tagDict[Tag{0x0010, 0x0032}] = TagInfo{Tag: Tag{0x0010, 0x0032}, VR: "TM", Name: "PatientBirthTime", VM: "1"}
+	tagDict[Tag{0x0010, 0x0040}] = TagInfo{Tag: Tag{0x0010, 0x0040}, VR: "CS", Name: "PatientSex", VM: "1"}
+	tagDict[Tag{0x0010, 0x0050}] = TagInfo{Tag: Tag{0x0010, 0x0050}, VR: "SQ", Name: "PatientInsurancePlanCodeSequence", VM: "1"}
+	tagDict[Tag{0x0010, 0x0101}] = TagInfo{Tag: Tag{0x0010, 0x0101}, VR: "SQ", Name: "PatientPrimaryLanguageCodeSequence", VM: "1"}
+	tagDict[Tag{0x0010, 0x0102}] = TagInfo{Tag: Tag{0x0010, 0x0102}, VR: "SQ", Name: "PatientPrimaryLanguageModifierCodeSequence", VM: "1"}
+	tagDict[Tag{0x0010, 0x1000}] = TagInfo{Tag: Tag{0x0010, 0x1000}, VR: "LO", Name: "OtherComponentIDs", VM: "1-n"}
+	tagDict[Tag{0x0010, 0x1001}] = TagInfo{Tag: Tag{0x0010, 0x1001}, VR: "PN", Name: "OtherComponentNames", VM: "1"}
+	tagDict[Tag{0x0010, 0x1002}] = TagInfo{Tag: Tag{0x0010, 0x1002}, VR: "SQ", Name: "OtherPatientIDsSequence", VM: "1"}
+	tagDict[Tag{0x0010, 0x1005}] = TagInfo{Tag: Tag{0x0010, 0x1005}, VR: "PN", Name: "PatientBirthName", VM: "1"}
+	tagDict[Tag{0x0010, 0x1010}] = TagInfo{Tag: Tag{0x0010, 0x1010}, VR: "AS", Name: "PatientAge", VM: "1"}
+	tagDict[Tag{0x0010, 0x1020}] = TagInfo{Tag: Tag{0x0010, 0x1020}, VR: "DS", Name: "PatientSize", VM: "1"}
+	tagDict[Tag{0x0010, 0x1021}] = TagInfo{Tag: Tag{0x0010, 0x1021}, VR: "SQ", Name: "PatientSizeCodeSequence", VM: "1"}
+	tagDict[Tag{0x0010, 0x1030}] = TagInfo{Tag: Tag{0x0010, 0x1030}, VR: "DS", Name: "PatientWeight", VM: "1"}
+	tagDict[Tag{0x0010, 0x1040}] = TagInfo{Tag: Tag{0x0010, 0x1040}, VR: "LO", Name: "PatientAddress", VM: "1"}
+	tagDict[Tag{0x0010, 0x1060}] = TagInfo{Tag: Tag{0x0010, 0x1060}, VR: "PN", Name: "PatientMotherBirthName", VM: "1"}
+	tagDict[Tag{0x0010, 0x1080}] = TagInfo{Tag: Tag{0x0010, 0x1080}, VR: "LO", Name: "MilitaryRank", VM: "1"}
+	tagDict[Tag{0x0010, 0x1081}] = TagInfo{Tag: Tag{0x0010, 0x1081}, VR: "LO", Name: "BranchOfService", VM: "1"}
+	tagDict[Tag{0x0010, 0x1090}] = TagInfo{Tag: Tag{0x0010, 0x1090}, VR: "LO", Name: "MedicalRecordLocator", VM: "1"}
+	tagDict[Tag{0x0010, 0x2000}] = TagInfo{Tag: Tag{0x0010, 0x2000}, VR: "LO", Name: "MedicalAlerts", VM: "1-n"}
+	tagDict[Tag{0x0010, 0x2110}] = TagInfo{Tag: Tag{0x0010, 0x2110}, VR: "LO", Name: "Allergies", VM: "1-n"}
+	tagDict[Tag{0x0010, 0x2150}] = TagInfo{Tag: Tag{0x0010, 0x2150}, VR: "LO", Name: "CountryOfResidence", VM: "1"}
+	tagDict[Tag{0x0010, 0x2152}] = TagInfo{Tag: Tag{0x0010, 0x2152}, VR: "LO", Name: "RegionOfResidence", VM: "1"}
+	tagDict[Tag{0x0010, 0x2154}] = TagInfo{Tag: Tag{0x0010, 0x2154}, VR: "SH", Name: "PatientTelephoneNumbers", VM: "1-n"}
+	tagDict[Tag{0x0010, 0x2160}] = TagInfo{Tag: Tag{0x0010, 0x2160}, VR: "SH", Name: "MaterialName", VM: "1"}
+	tagDict[Tag{0x0010, 0x2180}] = TagInfo{Tag: Tag{0x0010, 0x2180}, VR: "SH", Name: "Occupation", VM: "1"}
+	tagDict[Tag{0x0010, 0x21A0}] = TagInfo{Tag: Tag{0x0010, 0x21A0}, VR: "CS", Name: "SmokingStatus", VM: "1"}
+	tagDict[Tag{0x0010, 0x21B0}] = TagInfo{Tag: Tag{0x0010, 0x21B0}, VR: "LT", Name: "AdditionalPatientHistory", VM: "1"}
+	tagDict[Tag{0x0010, 0x21C0}] = TagInfo{Tag: Tag{0x0010, 0x21C0}, VR: "US", Name: "PregnancyStatus", VM: "1"}
+	tagDict[Tag{0x0010, 0x21D0}] = TagInfo{Tag: Tag{0x0010, 0x21D0}, VR: "DA", Name: "LastMenstrualDate", VM: "1"}
+	tagDict[Tag{0x0010, 0x21F0}] = TagInfo{Tag: Tag{0x0010, 0x21F0}, VR: "LO", Name: "PatientReligiousPreference", VM: "1"}
+	tagDict[Tag{0x0010, 0x2201}] = TagInfo{Tag: Tag{0x0010, 0x2201}, VR: "LO", Name: "PatientSpeciesDescription", VM: "1"}
+	tagDict[Tag{0x0010, 0x2202}] = TagInfo{Tag: Tag{0x0010, 0x2202}, VR: "SQ", Name: "PatientSpeciesCodeSequence", VM: "1"}
+	tagDict[Tag{0x0010, 0x2203}] = TagInfo{Tag: Tag{0x0010, 0x2203}, VR: "CS", Name: "PatientSexNeutered", VM: "1"}
+	tagDict[Tag{0x0010, 0x2210}] = TagInfo{Tag: Tag{0x0010, 0x2210}, VR: "CS", Name: "AnatomicalOrientationType", VM: "1"}
+	tagDict[Tag{0x0010, 0x2292}] = TagInfo{Tag: Tag{0x0010, 0x2292}, VR: "LO", Name: "PatientBreedDescription", VM: "1"}
+	tagDict[Tag{0x0010, 0x2293}] = TagInfo{Tag: Tag{0x0010, 0x2293}, VR: "SQ", Name: "PatientBreedCodeSequence", VM: "1"}
+	tagDict[Tag{0x0010, 0x2294}] = TagInfo{Tag: Tag{0x0010, 0x2294}, VR: "SQ", Name: "BreedRegistrationSequence", VM: "1"}
+	tagDict[Tag{0x0010, 0x2295}] = TagInfo{Tag: Tag{0x0010, 0x2295}, VR: "LO", Name: "BreedRegistrationNumber", VM: "1"}
+	tagDict[Tag{0x0010, 0x2296}] = TagInfo{Tag: Tag{0x0010, 0x2296}, VR: "SQ", Name: "BreedRegistryCodeSequence", VM: "1"}
+	tagDict[Tag{0x0010, 0x2297}] = TagInfo{Tag: Tag{0x0010, 0x2297}, VR: "PN", Name: "ResponsiblePerson", VM: "1"}
+	tagDict[Tag{0x0010, 0x2298}] = TagInfo{Tag: Tag{0x0010, 0x2298}, VR: "CS", Name: "ResponsiblePersonRole", VM: "1"}
+	tagDict[Tag{0x0010, 0x2299}] = TagInfo{Tag: Tag{0x0010, 0x2299}, VR: "LO", Name: "ResponsibleOrganization", VM: "1"}
+	tagDict[Tag{0x0010, 0x4000}] = TagInfo{Tag: Tag{0x0010, 0x4000}, VR: "LT", Name: "ComponentNotes", VM: "1"}
+	tagDict[Tag{0x0010, 0x9431}] = TagInfo{Tag: Tag{0x0010, 0x9431}, VR: "FL", Name: "ExaminedBodyThickness", VM: "1"}
+	tagDict[Tag{0x0012, 0x0010}] = TagInfo{Tag: Tag{0x0012, 0x0010}, VR: "LO", Name: "ClinicalTrialSponsorName", VM: "1"}
+	tagDict[Tag{0x0012, 0x0020}] = TagInfo{Tag: Tag{0x0012, 0x0020}, VR: "LO", Name: "ClinicalTrialProtocolID", VM: "1"}
+	tagDict[Tag{0x0012, 0x0021}] = TagInfo{Tag: Tag{0x0012, 0x0021}, VR: "LO", Name: "ClinicalTrialProtocolName", VM: "1"}
+	tagDict[Tag{0x0012, 0x0030}] = TagInfo{Tag: Tag{0x0012, 0x0030}, VR: "LO", Name: "ClinicalTrialSiteID", VM: "1"}
+	tagDict[Tag{0x0012, 0x0031}] = TagInfo{Tag: Tag{0x0012, 0x0031}, VR: "LO", Name: "ClinicalTrialSiteName", VM: "1"}
+	tagDict[Tag{0x0012, 0x0040}] = TagInfo{Tag: Tag{0x0012, 0x0040}, VR: "LO", Name: "ClinicalTrialSubjectID", VM: "1"}
+	tagDict[Tag{0x0012, 0x0042}] = TagInfo{Tag: Tag{0x0012, 0x0042}, VR: "LO", Name: "ClinicalTrialSubjectReadingID", VM: "1"}
+	tagDict[Tag{0x0012, 0x0050}] = TagInfo{Tag: Tag{0x0012, 0x0050}, VR: "LO", Name: "ClinicalTrialTimePointID", VM: "1"}
+	tagDict[Tag{0x0012, 0x0051}] = TagInfo{Tag: Tag{0x0012, 0x0051}, VR: "ST", Name: "ClinicalTrialTimePointDescription", VM: "1"}
+	tagDict[Tag{0x0012, 0x0060}] = TagInfo{Tag: Tag{0x0012, 0x0060}, VR: "LO", Name: "ClinicalTrialCoordinatingCenterName", VM: "1"}
+	tagDict[Tag{0x0012, 0x0062}] = TagInfo{Tag: Tag{0x0012, 0x0062}, VR: "CS", Name: "PatientIdentityRemoved", VM: "1"}
+	tagDict[Tag{0x0012, 0x0063}] = TagInfo{Tag: Tag{0x0012, 0x0063}, VR: "LO", Name: "DeidentificationMethod", VM: "1-n"}
+	tagDict[Tag{0x0012, 0x0064}] = TagInfo{Tag: Tag{0x0012, 0x0064}, VR: "SQ", Name: "DeidentificationMethodCodeSequence", VM: "1"}
+	tagDict[Tag{0x0012, 0x0071}] = TagInfo{Tag: Tag{0x0012, 0x0071}, VR: "LO", Name: "ClinicalTrialSeriesID", VM: "1"}
+	tagDict[Tag{0x0012, 0x0072}] = TagInfo{Tag: Tag{0x0012, 0x0072}, VR: "LO", Name: "ClinicalTrialSeriesDescription", VM: "1"}
+	tagDict[Tag{0x0012, 0x0081}] = TagInfo{Tag: Tag{0x0012, 0x0081}, VR: "LO", Name: "ClinicalTrialProtocolEthicsCommitteeName", VM: "1"}
+	tagDict[Tag{0x0012, 0x0082}] = TagInfo{Tag: Tag{0x0012, 0x0082}, VR: "LO", Name: "ClinicalTrialProtocolEthicsCommitteeApprovalNumber", VM: "1"}
+	tagDict[Tag{0x0012, 0x0083}] = TagInfo{Tag: Tag{0x0012, 0x0083}, VR: "SQ", Name: "ConsentForClinicalTrialUseSequence", VM: "1"}
+	tagDict[Tag{0x0012, 0x0084}] = TagInfo{Tag: Tag{0x0012, 0x0084}, VR: "CS", Name: "DistributionType", VM: "1"}
+	tagDict[Tag{0x0012, 0x0085}] = TagInfo{Tag: Tag{0x0012, 0x0085}, VR: "CS", Name: "ConsentForDistributionFlag", VM: "1"}
+	tagDict[Tag{0x0014, 0x0023}] = TagInfo{Tag: Tag{0x0014, 0x0023}, VR: "ST", Name: "CADFileFormat", VM: "1-n"}
+	tagDict[Tag{0x0014, 0x0024}] = TagInfo{Tag: Tag{0x0014, 0x0024}, VR: "ST", Name: "ComponentReferenceSystem", VM: "1-n"}
+	tagDict[Tag{0x0014, 0x0025}] = TagInfo{Tag: Tag{0x0014, 0x0025}, VR: "ST", Name: "ComponentManufacturingProcedure", VM: "1-n"}
+	tagDict[Tag{0x0014, 0x0028}] = TagInfo{Tag: Tag{0x0014, 0x0028}, VR: "ST", Name: "ComponentManufacturer", VM: "1-n"}
+	tagDict[Tag{0x0014, 0x0030}] = TagInfo{Tag: Tag{0x0014, 0x0030}, VR: "DS", Name: "MaterialThickness", VM: "1-n"}
+	tagDict[Tag{0x0014, 0x0032}] = TagInfo{Tag: Tag{0x0014, 0x0032}, VR: "DS", Name: "MaterialPipeDiameter", VM: "1-n"}
+	tagDict[Tag{0x0014, 0x0034}] = TagInfo{Tag: Tag{0x0014, 0x0034}, VR: "DS", Name: "MaterialIsolationDiameter", VM: "1-n"}
+	tagDict[Tag{0x0014, 0x0042}] = TagInfo{Tag: Tag{0x0014, 0x0042}, VR: "ST", Name: "MaterialGrade", VM: "1"}
+	tagDict[Tag{0x0014, 0x0044}] = TagInfo{Tag: Tag{0x0014, 0x0044}, VR: "ST", Name: "MaterialPropertiesDescription", VM: "1-n"}
+	tagDict[Tag{0x0014, 0x0045}] = TagInfo{Tag: Tag{0x0014, 0x0045}, VR: "ST", Name: "MaterialPropertiesFileFormat", VM: "1-n"}
+	tagDict[Tag{0x0014, 0x0046}] = TagInfo{Tag: Tag{0x0014, 0x0046}, VR: "LT", Name: "MaterialNotes", VM: "1"}
+	tagDict[Tag{0x0014, 0x0050}] = TagInfo{Tag: Tag{0x0014, 0x0050}, VR: "CS", Name: "ComponentShape", VM: "1"}
+	tagDict[Tag{0x0014, 0x0052}] = TagInfo{Tag: Tag{0x0014, 0x0052}, VR: "CS", Name: "CurvatureType", VM: "1"}
+	tagDict[Tag{0x0014, 0x0054}] = TagInfo{Tag: Tag{0x0014, 0x0054}, VR: "DS", Name: "OuterDiameter", VM: "1"}
+	tagDict[Tag{0x0014, 0x0056}] = TagInfo{Tag: Tag{0x0014, 0x0056}, VR: "DS", Name: "InnerDiameter", VM: "1"}
+	tagDict[Tag{0x0014, 0x0100}] = TagInfo{Tag: Tag{0x0014, 0x0100}, VR: "LO", Name: "ComponentWelderIDs", VM: "1-n"}
+	tagDict[Tag{0x0014, 0x0101}] = TagInfo{Tag: Tag{0x0014, 0x0101}, VR: "CS", Name: "SecondaryApprovaStatus", VM: "1"}
+	tagDict[Tag{0x0014, 0x0102}] = TagInfo{Tag: Tag{0x0014, 0x0102}, VR: "DA", Name: "SecondaryReviewDate", VM: "1"}
+	tagDict[Tag{0x0014, 0x0103}] = TagInfo{Tag: Tag{0x0014, 0x0103}, VR: "TM", Name: "SecondaryReviewTime", VM: "1"}
+	tagDict[Tag{0x0014, 0x0104}] = TagInfo{Tag: Tag{0x0014, 0x0104}, VR: "PN", Name: "SecondaryReviewName", VM: "1"}
+	tagDict[Tag{0x0014, 0x0105}] = TagInfo{Tag: Tag{0x0014, 0x0105}, VR: "ST", Name: "RepairID", VM: "1"}
+	tagDict[Tag{0x0014, 0x0106}] = TagInfo{Tag: Tag{0x0014, 0x0106}, VR: "SQ", Name: "MultipleComponentApprovalSequence", VM: "1"}
+	tagDict[Tag{0x0014, 0x0107}] = TagInfo{Tag: Tag{0x0014, 0x0107}, VR: "CS", Name: "OtherApprovalStatus", VM: "1-n"}
+	tagDict[Tag{0x0014, 0x0108}] = TagInfo{Tag: Tag{0x0014, 0x0108}, VR: "CS", Name: "OtherSecondaryApprovalStatus", VM: "1-n"}
+	tagDict[Tag{0x0014, 0x1010}] = TagInfo{Tag: Tag{0x0014, 0x1010}, VR: "ST", Name: "ActualEnvironmentalConditions", VM: "1"}
+	tagDict[Tag{0x0014, 0x1020}] = TagInfo{Tag: Tag{0x0014, 0x1020}, VR: "DA", Name: "ExpiryDate", VM: "1"}
+	tagDict[Tag{0x0014, 0x1040}] = TagInfo{Tag: Tag{0x0014, 0x1040}, VR: "ST", Name: "EnvironmentalConditions", VM: "1"}
+	tagDict[Tag{0x0014, 0x2002}] = TagInfo{Tag: Tag{0x0014, 0x2002}, VR: "SQ", Name: "EvaluatorSequence", VM: "1"}
+	tagDict[Tag{0x0014, 0x2004}] = TagInfo{Tag: Tag{0x0014, 0x2004}, VR: "IS", Name: "EvaluatorNumber", VM: "1"}
+	tagDict[Tag{0x0014, 0x2006}] = TagInfo{Tag: Tag{0x0014, 0x2006}, VR: "PN", Name: "EvaluatorName", VM: "1"}
+	tagDict[Tag{0x0014, 0x2008}] = TagInfo{Tag: Tag{0x0014, 0x2008}, VR: "IS", Name: "EvaluationAttempt", VM: "1"}
+	tagDict[Tag{0x0014, 0x2012}] = TagInfo{Tag: Tag{0x0014, 0x2012}, VR: "SQ", Name: "IndicationSequence", VM: "1"}
+	tagDict[Tag{0x0014, 0x2014}] = TagInfo{Tag: Tag{0x0014, 0x2014}, VR: "IS", Name: "IndicationNumber", VM: "1"}
+	tagDict[Tag{0x0014, 0x2016}] = TagInfo{Tag: Tag{0x0014, 0x2016}, VR: "SH", Name: "IndicationLabel", VM: "1"}
+	tagDict[Tag{0x0014, 0x2018}] = TagInfo{Tag: Tag{0x0014, 0x2018}, VR: "ST", Name: "IndicationDescription", VM: "1"}
+	tagDict[Tag{0x0014, 0x201A}] = TagInfo{Tag: Tag{0x0014, 0x201A}, VR: "CS", Name: "IndicationType", VM: "1-n"}
+	tagDict[Tag{0x0014, 0x201C}] = TagInfo{Tag: Tag{0x0014, 0x201C}, VR: "CS", Name: "IndicationDisposition", VM: "1"}
+	tagDict[Tag{0x0014, 0x201E}] = TagInfo{Tag: Tag{0x0014, 0x201E}, VR: "SQ", Name: "IndicationROISequence", VM: "1"}
+	tagDict[Tag{0x0014, 0x2030}] = TagInfo{Tag: Tag{0x0014, 0x2030}, VR: "SQ", Name: "IndicationPhysicalPropertySequence", VM: "1"}
+	tagDict[Tag{0x0014, 0x2032}] = TagInfo{Tag: Tag{0x0014, 0x2032}, VR: "SH", Name: "PropertyLabel", VM: "1"}
+	tagDict[Tag{0x0014, 0x2202}] = TagInfo{Tag: Tag{0x0014, 0x2202}, VR: "IS", Name: "CoordinateSystemNumberOfAxes", VM: "1"}
+	tagDict[Tag{0x0014, 0x2204}] = TagInfo{Tag: Tag{0x0014, 0x2204}, VR: "SQ", Name: "CoordinateSystemAxesSequence", VM: "1"}
+	tagDict[Tag{0x0014, 0x2206}] = TagInfo{Tag: Tag{0x0014, 0x2206}, VR: "ST", Name: "CoordinateSystemAxisDescription", VM: "1"}
+	tagDict[Tag{0x0014, 0x2208}] = TagInfo{Tag: Tag{0x0014, 0x2208}, VR: "CS", Name: "CoordinateSystemDataSetMapping", VM: "1"}
+	tagDict[Tag{0x0014, 0x220A}] = TagInfo{Tag: Tag{0x0014, 0x220A}, VR: "IS", Name: "CoordinateSystemAxisNumber", VM: "1"}
+	tagDict[Tag{0x0014, 0x220C}] = TagInfo{Tag: Tag{0x0014, 0x220C}, VR: "CS", Name: "CoordinateSystemAxisType", VM: "1"}
+	tagDict[Tag{0x0014, 0x220E}] = TagInfo{Tag: Tag{0x0014, 0x220E}, VR: "CS", Name: "CoordinateSystemAxisUnits", VM: "1"}
+	tagDict[Tag{0x0014, 0x2210}] = TagInfo{Tag: Tag{0x0014, 0x2210}, VR: "OB", Name: "CoordinateSystemAxisValues", VM: "1"}
+	tagDict[Tag{0x0014, 0x2220}] = TagInfo{Tag: Tag{0x0014, 0x2220}, VR: "SQ", Name: "CoordinateSystemTransformSequence", VM: "1"}
+	tagDict[Tag{0x0014, 0x2222}] = TagInfo{Tag: Tag{0x0014, 0x2222}, VR: "ST", Name: "TransformDescription", VM: "1"}
+	tagDict[Tag{0x0014, 0x2224}] = TagInfo{Tag: Tag{0x0014, 0x2224}, VR: "IS", Name: "TransformNumberOfAxes", VM: "1"}
+	tagDict[Tag{0x0014, 0x2226}] = TagInfo{Tag: Tag{0x0014, 0x2226}, VR: "IS", Name: "TransformOrderOfAxes", VM: "1-n"}
+	tagDict[Tag{0x0014, 0x2228}] = TagInfo{Tag: Tag{0x0014, 0x2228}, VR: "CS", Name: "TransformedAxisUnits", VM: "1"}
+	tagDict[Tag{0x0014, 0x222A}] = TagInfo{Tag: Tag{0x0014, 0x222A}, VR: "DS", Name: "CoordinateSystemRotationAndScaleMatrix", VM: "1-n"}
+	tagDict[Tag{0x0014, 0x222C}] = TagInfo{Tag: Tag{0x0014, 0x222C}, VR: "DS", Name: "CoordinateSystemTranslationMatrix", VM: "1-n"}
+	tagDict[Tag{0x0014, 0x3011}] = TagInfo{Tag: Tag{0x0014, 0x3011}, VR: "DS", Name: "InternalDetectorFrameTime", VM: "1"}
+	tagDict[Tag{0x0014, 0x3012}] = TagInfo{Tag: Tag{0x0014, 0x3012}, VR: "DS", Name: "NumberOfFramesIntegrated", VM: "1"}
+	tagDict[Tag{0x0014, 0x3020}] = TagInfo{Tag: Tag{0x0014, 0x3020}, VR: "SQ", Name: "DetectorTemperatureSequence", VM: "1"}
+	tagDict[Tag{0x0014, 0x3022}] = TagInfo{Tag: Tag{0x0014, 0x3022}, VR: "ST", Name: "SensorName", VM: "1"}
+	tagDict[Tag{0x0014, 0x3024}] = TagInfo{Tag: Tag{0x0014, 0x3024}, VR: "DS", Name: "HorizontalOffsetOfSensor", VM: "1"}
+	tagDict[Tag{0x0014, 0x3026}] = TagInfo{Tag: Tag{0x0014, 0x3026}, VR: "DS", Name: "VerticalOffsetOfSensor", VM: "1"}
+	tagDict[Tag{0x0014, 0x3028}] = TagInfo{Tag: Tag{0x0014, 0x3028}, VR: "DS", Name: "SensorTemperature", VM: "1"}
+	tagDict[Tag{0x0014, 0x3040}] = TagInfo{Tag: Tag{0x0014, 0x3040}, VR: "SQ", Name: "DarkCurrentSequence", VM: "1"}
+	tagDict[Tag{0x0014, 0x3050}] = TagInfo{Tag: Tag{0x0014, 0x3050}, VR: "OW", VRs: []string{"OW", "OB"}, Name: "DarkCurrentCounts", VM: "1"}
+	tagDict[Tag{0x0014, 0x3060}] = TagInfo{Tag: Tag{0x0014, 0x3060}, VR: "SQ", Name: "GainCorrectionReferenceSequence", VM: "1"}
+	tagDict[Tag{0x0014, 0x3070}] = TagInfo{Tag: Tag{0x0014, 0x3070}, VR: "OW", VRs: []string{"OW", "OB"}, Name: "AirCounts", VM: "1"}
+	tagDict[Tag{0x0014, 0x3071}] = TagInfo{Tag: Tag{0x0014, 0x3071}, VR: "DS", Name: "KVUsedInGainCalibration", VM: "1"}
+	tagDict[Tag{0x0014, 0x3072}] = TagInfo{Tag: Tag{0x0014, 0x3072}, VR: "DS", Name: "MAUsedInGainCalibration", VM: "1"}
+	tagDict[Tag{0x0014, 0x3073}] = TagInfo{Tag: Tag{0x0014, 0x3073}, VR: "DS", Name: "NumberOfFramesUsedForIntegration", VM: "1"}
+	tagDict[Tag{0x0014, 0x3074}] = TagInfo{Tag: Tag{0x0014, 0x3074}, VR: "LO", Name: "FilterMaterialUsedInGainCalibration", VM: "1"}
+	tagDict[Tag{0x0014, 0x3075}] = TagInfo{Tag: Tag{0x0014, 0x3075}, VR: "DS", Name: "FilterThicknessUsedInGainCalibration", VM: "1"}
+	tagDict[Tag{0x0014, 0x3076}] = TagInfo{Tag: Tag{0x0014, 0x3076}, VR: "DA", Name: "DateOfGainCalibration", VM: "1"}
+	tagDict[Tag{0x0014, 0x3077}] = TagInfo{Tag: Tag{0x0014, 0x3077}, VR: "TM", Name: "TimeOfGainCalibration", VM: "1"}
+	tagDict[Tag{0x0014, 0x3080}] = TagInfo{Tag: Tag{0x0014, 0x3080}, VR: "OB", Name: "BadPixelImage", VM: "1"}
+	tagDict[Tag{0x0014, 0x3099}] = TagInfo{Tag: Tag{0x0014, 0x3099}, VR: "LT", Name: "CalibrationNotes", VM: "1"}
+	tagDict[Tag{0x0014, 0x4002}] = TagInfo{Tag: Tag{0x0014, 0x4002}, VR: "SQ", Name: "PulserEquipmentSequence", VM: "1"}
+	tagDict[Tag{0x0014, 0x4004}] = TagInfo{Tag: Tag{0x0014, 0x4004}, VR: "CS", Name: "PulserType", VM: "1"}
+	tagDict[Tag{0x0014, 0x4006}] = TagInfo{Tag: Tag{0x0014, 0x4006}, VR: "LT", Name: "PulserNotes", VM: "1"}
+	tagDict[Tag{0x0014, 0x4008}] = TagInfo{Tag: Tag{0x0014, 0x4008}, VR: "SQ", Name: "ReceiverEquipmentSequence", VM: "1"}
+	tagDict[Tag{0x0014, 0x400A}] = TagInfo{Tag: Tag{0x0014, 0x400A}, VR: "CS", Name: "AmplifierType", VM: "1"}
+	tagDict[Tag{0x0014, 0x400C}] = TagInfo{Tag: Tag{0x0014, 0x400C}, VR: "LT", Name: "ReceiverNotes", VM: "1"}
+	tagDict[Tag{0x0014, 0x400E}] = TagInfo{Tag: Tag{0x0014, 0x400E}, VR: "SQ", Name: "PreAmplifierEquipmentSequence", VM: "1"}
+	tagDict[Tag{0x0014, 0x400F}] = TagInfo{Tag: Tag{0x0014, 0x400F}, VR: "LT", Name: "PreAmplifierNotes", VM: "1"}
+	tagDict[Tag{0x0014, 0x4010}] = TagInfo{Tag: Tag{0x0014, 0x4010}, VR: "SQ", Name: "TransmitTransducerSequence", VM: "1"}
+	tagDict[Tag{0x0014, 0x4011}] = TagInfo{Tag: Tag{0x0014, 0x4011}, VR: "SQ", Name: "ReceiveTransducerSequence", VM: "1"}
+	tagDict[Tag{0x0014, 0x4012}] = TagInfo{Tag: Tag{0x0014, 0x4012}, VR: "US", Name: "NumberOfElements", VM: "1"}
+	tagDict[Tag{0x0014, 0x4013}] = TagInfo{Tag: Tag{0x0014, 0x4013}, VR: "CS", Name: "ElementShape", VM: "1"}
+	tagDict[Tag{0x0014, 0x4014}] = TagInfo{Tag: Tag{0x0014, 0x4014}, VR: "DS", Name: "ElementDimensionA", VM: "1"}
+	tagDict[Tag{0x0014, 0x4015}] = TagInfo{Tag: Tag{0x0014, 0x4015}, VR: "DS", Name: "ElementDimensionB", VM: "1"}
+	tagDict[Tag{0x0014, 0x4016}] = TagInfo{Tag: Tag{0x0014, 0x4016}, VR: "DS", Name: "ElementPitch", VM: "1"}
+	tagDict[Tag{0x0014, 0x4017}] = TagInfo{Tag: Tag{0x0014, 0x4017}, VR: "DS", Name: "MeasuredBeamDimensionA", VM: "1"}
+	tagDict[Tag{0x0014, 0x4018}] = TagInfo{Tag: Tag{0x0014, 0x4018}, VR: "DS", Name: "MeasuredBeamDimensionB", VM: "1"}
+	tagDict[Tag{0x0014, 0x4019}] = TagInfo{Tag: Tag{0x0014, 0x4019}, VR: "DS", Name: "LocationOfMeasuredBeamDiameter", VM: "1"}
+	tagDict[Tag{0x0014, 0x401A}] = TagInfo{Tag: Tag{0x0014, 0x401A}, VR: "DS", Name: "NominalFrequency", VM: "1"}
+	tagDict[Tag{0x0014, 0x401B}] = TagInfo{Tag: Tag{0x0014, 0x401B}, VR: "DS", Name: "MeasuredCenterFrequency", VM: "1"}
+	tagDict[Tag{0x0014, 0x401C}] = TagInfo{Tag: Tag{0x0014, 0x401C}, VR: "DS", Name: "MeasuredBandwidth", VM: "1"}
+	tagDict[Tag{0x0014, 0x4020}] = TagInfo{Tag: Tag{0x0014, 0x4020}, VR: "SQ", Name: "PulserSettingsSequence", VM: "1"}
+	tagDict[Tag{0x0014, 0x4022}] = TagInfo{Tag: Tag{0x0014, 0x4022}, VR: "DS", Name: "PulseWidth", VM: "1"}
+	tagDict[Tag{0x0014, 0x4024}] = TagInfo{Tag: Tag{0x0014, 0x4024}, VR: "DS", Name: "ExcitationFrequency", VM: "1"}
+	tagDict[Tag{0x0014, 0x4026}] = TagInfo{Tag: Tag{0x0014, 0x4026}, VR: "CS", Name: "ModulationType", VM: "1"}
+	tagDict[Tag{0x0014, 0x4028}] = TagInfo{Tag: Tag{0x0014, 0x4028}, VR: "DS", Name: "Damping", VM: "1"}
+	tagDict[Tag{0x0014, 0x4030}] = TagInfo{Tag: Tag{0x0014, 0x4030}, VR: "SQ", Name: "ReceiverSettingsSequence", VM: "1"}
+	tagDict[Tag{0x0014, 0x4031}] = TagInfo{Tag: Tag{0x0014, 0x4031}, VR: "DS", Name: "AcquiredSoundpathLength", VM: "1"}
+	tagDict[Tag{0x0014, 0x4032}] = TagInfo{Tag: Tag{0x0014, 0x4032}, VR: "CS", Name: "AcquisitionCompressionType", VM: "1"}
+	tagDict[Tag{0x0014, 0x4033}] = TagInfo{Tag: Tag{0x0014, 0x4033}, VR: "IS", Name: "AcquisitionSampleSize", VM: "1"}
+	tagDict[Tag{0x0014, 0x4034}] = TagInfo{Tag: Tag{0x0014, 0x4034}, VR: "DS", Name: "RectifierSmoothing", VM: "1"}
+	tagDict[Tag{0x0014, 0x4035}] = TagInfo{Tag: Tag{0x0014, 0x4035}, VR: "SQ", Name: "DACSequence", VM: "1"}
+	tagDict[Tag{0x0014, 0x4036}] = TagInfo{Tag: Tag{0x0014, 0x4036}, VR: "CS", Name: "DACType", VM: "1"}
+	tagDict[Tag{0x0014, 0x4038}] = TagInfo{Tag: Tag{0x0014, 0x4038}, VR: "DS", Name: "DACGainPoints", VM: "1-n"}
+	tagDict[Tag{0x0014, 0x403A}] = TagInfo{Tag: Tag{0x0014, 0x403A}, VR: "DS", Name: "DACTimePoints", VM: "1-n"}
+	tagDict[Tag{0x0014, 0x403C}] = TagInfo{Tag: Tag{0x0014, 0x403C}, VR: "DS", Name: "DACAmplitude", VM: "1-n"}
+	tagDict[Tag{0x0014, 0x4040}] = TagInfo{Tag: Tag{0x0014, 0x4040}, VR: "SQ", Name: "PreAmplifierSettingsSequence", VM: "1"}
+	tagDict[Tag{0x0014, 0x4050}] = TagInfo{Tag: Tag{0x0014, 0x4050}, VR: "SQ", Name: "TransmitTransducerSettingsSequence", VM: "1"}
+	tagDict[Tag{0x0014, 0x4051}] = TagInfo{Tag: Tag{0x0014, 0x4051}, VR: "SQ", Name: "ReceiveTransducerSettingsSequence", VM: "1"}
+	tagDict[Tag{0x0014, 0x4052}] = TagInfo{Tag: Tag{0x0014, 0x4052}, VR: "DS", Name: "IncidentAngle", VM: "1"}
+	tagDict[Tag{0x0014, 0x4054}] = TagInfo{Tag: Tag{0x0014, 0x4054}, VR: "ST", Name: "CouplingTechnique", VM: "1"}
+	tagDict[Tag{0x0014, 0x4056}] = TagInfo{Tag: Tag{0x0014, 0x4056}, VR: "ST", Name: "CouplingMedium", VM: "1"}
+	tagDict[Tag{0x0014, 0x4057}] = TagInfo{Tag: Tag{0x0014, 0x4057}, VR: "DS", Name: "CouplingVelocity", VM: "1"}
+	tagDict[Tag{0x0014, 0x4058}] = TagInfo{Tag: Tag{0x0014, 0x4058}, VR: "DS", Name: "CrystalCenterLocationX", VM: "1"}
+	tagDict[Tag{0x0014, 0x4059}] = TagInfo{Tag: Tag{0x0014, 0x4059}, VR: "DS", Name: "CrystalCenterLocationZ", VM: "1"}
+	tagDict[Tag{0x0014, 0x405A}] = TagInfo{Tag: Tag{0x0014, 0x405A}, VR: "DS", Name: "SoundPathLength", VM: "1"}
+	tagDict[Tag{0x0014, 0x405C}] = TagInfo{Tag: Tag{0x0014, 0x405C}, VR: "ST", Name: "DelayLawIdentifier", VM: "1"}
+	tagDict[Tag{0x0014, 0x4060}] = TagInfo{Tag: Tag{0x0014, 0x4060}, VR: "SQ", Name: "GateSettingsSequence", VM: "1"}
+	tagDict[Tag{0x0014, 0x4062}] = TagInfo{Tag: Tag{0x0014, 0x4062}, VR: "DS", Name: "GateThreshold", VM: "1"}
+	tagDict[Tag{0x0014, 0x4064}] = TagInfo{Tag: Tag{0x0014, 0x4064}, VR: "DS", Name: "VelocityOfSound", VM: "1"}
+	tagDict[Tag{0x0014, 0x4070}] = TagInfo{Tag: Tag{0x0014, 0x4070}, VR: "SQ", Name: "CalibrationSettingsSequence", VM: "1"}
+	tagDict[Tag{0x0014, 0x4072}] = TagInfo{Tag: Tag{0x0014, 0x4072}, VR: "ST", Name: "CalibrationProcedure", VM: "1"}
+	tagDict[Tag{0x0014, 0x4074}] = TagInfo{Tag: Tag{0x0014, 0x4074}, VR: "SH", Name: "ProcedureVersion", VM: "1"}
+	tagDict[Tag{0x0014, 0x4076}] = TagInfo{Tag: Tag{0x0014, 0x4076}, VR: "DA", Name: "ProcedureCreationDate", VM: "1"}
+	tagDict[Tag{0x0014, 0x4078}] = TagInfo{Tag: Tag{0x0014, 0x4078}, VR: "DA", Name: "ProcedureExpirationDate", VM: "1"}
+	tagDict[Tag{0x0014, 0x407A}] = TagInfo{Tag: Tag{0x0014, 0x407A}, VR: "DA", Name: "ProcedureLastModifiedDate", VM: "1"}
+	tagDict[Tag{0x0014, 0x407C}] = TagInfo{Tag: Tag{0x0014, 0x407C}, VR: "TM", Name: "CalibrationTime", VM: "1-n"}
+	tagDict[Tag{0x0014, 0x407E}] = TagInfo{Tag: Tag{0x0014, 0x407E}, VR: "DA", Name: "CalibrationDate", VM: "1-n"}
+	tagDict[Tag{0x0014, 0x40A0}] = TagInfo{Tag: Tag{0x0014, 0x40A0}, VR: "LO", Name: "ImageQualityIndicatorType", VM: "1-n"}
+	tagDict[Tag{0x0014, 0x40A1}] = TagInfo{Tag: Tag{0x0014, 0x40A1}, VR: "LO", Name: "ImageQualityIndicatorMaterial", VM: "1-n"}
+	tagDict[Tag{0x0014, 0x40A2}] = TagInfo{Tag: Tag{0x0014, 0x40A2}, VR: "LO", Name: "ImageQualityIndicatorSize", VM: "1-n"}
+	tagDict[Tag{0x0014, 0x5002}] = TagInfo{Tag: Tag{0x0014, 0x5002}, VR: "IS", Name: "LINACEnergy", VM: "1"}
+	tagDict[Tag{0x0014, 0x5004}] = TagInfo{Tag: Tag{0x0014, 0x5004}, VR: "IS", Name: "LINACOutput", VM: "1"}
+	tagDict[Tag{0x0018, 0x0010}] = TagInfo{Tag: Tag{0x0018, 0x0010}, VR: "LO", Name: "ContrastBolusAgent", VM: "1"}
+	tagDict[Tag{0x0018, 0x0012}] = TagInfo{Tag: Tag{0x0018, 0x0012}, VR: "SQ", Name: "ContrastBolusAgentSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x0014}] = TagInfo{Tag: Tag{0x0018, 0x0014}, VR: "SQ", Name: "ContrastBolusAdministrationRouteSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x0015}] = TagInfo{Tag: Tag{0x0018, 0x0015}, VR: "CS", Name: "BodyPartExamined", VM: "1"}
+	tagDict[Tag{0x0018, 0x0020}] = TagInfo{Tag: Tag{0x0018, 0x0020}, VR: "CS", Name: "ScanningSequence", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x0021}] = TagInfo{Tag: Tag{0x0018, 0x0021}, VR: "CS", Name: "SequenceVariant", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x0022}] = TagInfo{Tag: Tag{0x0018, 0x0022}, VR: "CS", Name: "ScanOptions", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x0023}] = TagInfo{Tag: Tag{0x0018, 0x0023}, VR: "CS", Name: "MRAcquisitionType", VM: "1"}
+	tagDict[Tag{0x0018, 0x0024}] = TagInfo{Tag: Tag{0x0018, 0x0024}, VR: "SH", Name: "SequenceName", VM: "1"}
+	tagDict[Tag{0x0018, 0x0025}] = TagInfo{Tag: Tag{0x0018, 0x0025}, VR: "CS", Name: "AngioFlag", VM: "1"}
+	tagDict[Tag{0x0018, 0x0026}] = TagInfo{Tag: Tag{0x0018, 0x0026}, VR: "SQ", Name: "InterventionDrugInformationSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x0027}] = TagInfo{Tag: Tag{0x0018, 0x0027}, VR: "TM", Name: "InterventionDrugStopTime", VM: "1"}
+	tagDict[Tag{0x0018, 0x0028}] = TagInfo{Tag: Tag{0x0018, 0x0028}, VR: "DS", Name: "InterventionDrugDose", VM: "1"}
+	tagDict[Tag{0x0018, 0x0029}] = TagInfo{Tag: Tag{0x0018, 0x0029}, VR: "SQ", Name: "InterventionDrugCodeSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x002A}] = TagInfo{Tag: Tag{0x0018, 0x002A}, VR: "SQ", Name: "AdditionalDrugSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x0031}] = TagInfo{Tag: Tag{0x0018, 0x0031}, VR: "LO", Name: "Radiopharmaceutical", VM: "1"}
+	tagDict[Tag{0x0018, 0x0034}] = TagInfo{Tag: Tag{0x0018, 0x0034}, VR: "LO", Name: "InterventionDrugName", VM: "1"}
+	tagDict[Tag{0x0018, 0x0035}] = TagInfo{Tag: Tag{0x0018, 0x0035}, VR: "TM", Name: "InterventionDrugStartTime", VM: "1"}
+	tagDict[Tag{0x0018, 0x0036}] = TagInfo{Tag: Tag{0x0018, 0x0036}, VR: "SQ", Name: "InterventionSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x0038}] = TagInfo{Tag: Tag{0x0018, 0x0038}, VR: "CS", Name: "InterventionStatus", VM: "1"}
+	tagDict[Tag{0x0018, 0x003A}] = TagInfo{Tag: Tag{0x0018, 0x003A}, VR: "ST", Name: "InterventionDescription", VM: "1"}
+	tagDict[Tag{0x0018, 0x0040}] = TagInfo{Tag: Tag{0x0018, 0x0040}, VR: "IS", Name: "CineRate", VM: "1"}
+	tagDict[Tag{0x0018, 0x0042}] = TagInfo{Tag: Tag{0x0018, 0x0042}, VR: "CS", Name: "InitialCineRunState", VM: "1"}
+	tagDict[Tag{0x0018, 0x0050}] = TagInfo{Tag: Tag{0x0018, 0x0050}, VR: "DS", Name: "SliceThickness", VM: "1"}
+	tagDict[Tag{0x0018, 0x0060}] = TagInfo{Tag: Tag{0x0018, 0x0060}, VR: "DS", Name: "KVP", VM: "1"}
+	tagDict[Tag{0x0018, 0x0070}] = TagInfo{Tag: Tag{0x0018, 0x0070}, VR: "IS", Name: "CountsAccumulated", VM: "1"}
+	tagDict[Tag{0x0018, 0x0071}] = TagInfo{Tag: Tag{0x0018, 0x0071}, VR: "CS", Name: "AcquisitionTerminationCondition", VM: "1"}
+	tagDict[Tag{0x0018, 0x0072}] = TagInfo{Tag: Tag{0x0018, 0x0072}, VR: "DS", Name: "EffectiveDuration", VM: "1"}
+	tagDict[Tag{0x0018, 0x0073}] = TagInfo{Tag: Tag{0x0018, 0x0073}, VR: "CS", Name: "AcquisitionStartCondition", VM: "1"}
+	tagDict[Tag{0x0018, 0x0074}] = TagInfo{Tag: Tag{0x0018, 0x0074}, VR: "IS", Name: "AcquisitionStartConditionData", VM: "1"}
+	tagDict[Tag{0x0018, 0x0075}] = TagInfo{Tag: Tag{0x0018, 0x0075}, VR: "IS", Name: "AcquisitionTerminationConditionData", VM: "1"}
+	tagDict[Tag{0x0018, 0x0080}] = TagInfo{Tag: Tag{0x0018, 0x0080}, VR: "DS", Name: "RepetitionTime", VM: "1"}
+	tagDict[Tag{0x0018, 0x0081}] = TagInfo{Tag: Tag{0x0018, 0x0081}, VR: "DS", Name: "EchoTime", VM: "1"}
+	tagDict[Tag{0x0018, 0x0082}] = TagInfo{Tag: Tag{0x0018, 0x0082}, VR: "DS", Name: "InversionTime", VM: "1"}
+	tagDict[Tag{0x0018, 0x0083}] = TagInfo{Tag: Tag{0x0018, 0x0083}, VR: "DS", Name: "NumberOfAverages", VM: "1"}
+	tagDict[Tag{0x0018, 0x0084}] = TagInfo{Tag: Tag{0x0018, 0x0084}, VR: "DS", Name: "ImagingFrequency", VM: "1"}
+	tagDict[Tag{0x0018, 0x0085}] = TagInfo{Tag: Tag{0x0018, 0x0085}, VR: "SH", Name: "ImagedNucleus", VM: "1"}
+	tagDict[Tag{0x0018, 0x0086}] = TagInfo{Tag: Tag{0x0018, 0x0086}, VR: "IS", Name: "EchoNumbers", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x0087}] = TagInfo{Tag: Tag{0x0018, 0x0087}, VR: "DS", Name: "MagneticFieldStrength", VM: "1"}
+	tagDict[Tag{0x0018, 0x0088}] = TagInfo{Tag: Tag{0x0018, 0x0088}, VR: "DS", Name: "SpacingBetweenSlices", VM: "1"}
+	tagDict[Tag{0x0018, 0x0089}] = TagInfo{Tag: Tag{0x0018, 0x0089}, VR: "IS", Name: "NumberOfPhaseEncodingSteps", VM: "1"}
+	tagDict[Tag{0x0018, 0x0090}] = TagInfo{Tag: Tag{0x0018, 0x0090}, VR: "DS", Name: "DataCollectionDiameter", VM: "1"}
+	tagDict[Tag{0x0018, 0x0091}] = TagInfo{Tag: Tag{0x0018, 0x0091}, VR: "IS", Name: "EchoTrainLength", VM: "1"}
+	tagDict[Tag{0x0018, 0x0093}] = TagInfo{Tag: Tag{0x0018, 0x0093}, VR: "DS", Name: "PercentSampling", VM: "1"}
+	tagDict[Tag{0x0018, 0x0094}] = TagInfo{Tag: Tag{0x0018, 0x0094}, VR: "DS", Name: "PercentPhaseFieldOfView", VM: "1"}
+	tagDict[Tag{0x0018, 0x0095}] = TagInfo{Tag: Tag{0x0018, 0x0095}, VR: "DS", Name: "PixelBandwidth", VM: "1"}
+	tagDict[Tag{0x0018, 0x1000}] = TagInfo{Tag: Tag{0x0018, 0x1000}, VR: "LO", Name: "DeviceSerialNumber", VM: "1"}
+	tagDict[Tag{0x0018, 0x1002}] = TagInfo{Tag: Tag{0x0018, 0x1002}, VR: "UI", Name: "DeviceUID", VM: "1"}
+	tagDict[Tag{0x0018, 0x1003}] = TagInfo{Tag: Tag{0x0018, 0x1003}, VR: "LO", Name: "DeviceID", VM: "1"}
+	tagDict[Tag{0x0018, 0x1004}] = TagInfo{Tag: Tag{0x0018, 0x1004}, VR: "LO", Name: "PlateID", VM: "1"}
+	tagDict[Tag{0x0018, 0x1005}] = TagInfo{Tag: Tag{0x0018, 0x1005}, VR: "LO", Name: "GeneratorID", VM: "1"}
+	tagDict[Tag{0x0018, 0x1006}] = TagInfo{Tag: Tag{0x0018, 0x1006}, VR: "LO", Name: "GridID", VM: "1"}
+	tagDict[Tag{0x0018, 0x1007}] = TagInfo{Tag: Tag{0x0018, 0x1007}, VR: "LO", Name: "CassetteID", VM: "1"}
+	tagDict[Tag{0x0018, 0x1008}] = TagInfo{Tag: Tag{0x0018, 0x1008}, VR: "LO", Name: "ScannerID", VM: "1"}
+	tagDict[Tag{0x0018, 0x1010}] = TagInfo{Tag: Tag{0x0018, 0x1010}, VR: "LO", Name: "SecondaryCaptureDeviceID", VM: "1"}
+	tagDict[Tag{0x0018, 0x1012}] = TagInfo{Tag: Tag{0x0018, 0x1012}, VR: "DA", Name: "DateOfSecondaryCapture", VM: "1"}
+	tagDict[Tag{0x0018, 0x1014}] = TagInfo{Tag: Tag{0x0018, 0x1014}, VR: "TM", Name: "TimeOfSecondaryCapture", VM: "1"}
+	tagDict[Tag{0x0018, 0x1016}] = TagInfo{Tag: Tag{0x0018, 0x1016}, VR: "LO", Name: "SecondaryCaptureDeviceManufacturer", VM: "1"}
+	tagDict[Tag{0x0018, 0x1018}] = TagInfo{Tag: Tag{0x0018, 0x1018}, VR: "LO", Name: "SecondaryCaptureDeviceManufacturerModelName", VM: "1"}
+	tagDict[Tag{0x0018, 0x1019}] = TagInfo{Tag: Tag{0x0018, 0x1019}, VR: "LO", Name: "SecondaryCaptureDeviceSoftwareVersions", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x1020}] = TagInfo{Tag: Tag{0x0018, 0x1020}, VR: "LO", Name: "SoftwareVersions", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x1022}] = TagInfo{Tag: Tag{0x0018, 0x1022}, VR: "SH", Name: "VideoImageFormatAcquired", VM: "1"}
+	tagDict[Tag{0x0018, 0x1023}] = TagInfo{Tag: Tag{0x0018, 0x1023}, VR: "LO", Name: "DigitalImageFormatAcquired", VM: "1"}
+	tagDict[Tag{0x0018, 0x1030}] = TagInfo{Tag: Tag{0x0018, 0x1030}, VR: "LO", Name: "ProtocolName", VM: "1"}
+	tagDict[Tag{0x0018, 0x1040}] = TagInfo{Tag: Tag{0x0018, 0x1040}, VR: "LO", Name: "ContrastBolusRoute", VM: "1"}
+	tagDict[Tag{0x0018, 0x1041}] = TagInfo{Tag: Tag{0x0018, 0x1041}, VR: "DS", Name: "ContrastBolusVolume", VM: "1"}
+	tagDict[Tag{0x0018, 0x1042}] = TagInfo{Tag: Tag{0x0018, 0x1042}, VR: "TM", Name: "ContrastBolusStartTime", VM: "1"}
+	tagDict[Tag{0x0018, 0x1043}] = TagInfo{Tag: Tag{0x0018, 0x1043}, VR: "TM", Name: "ContrastBolusStopTime", VM: "1"}
+	tagDict[Tag{0x0018, 0x1044}] = TagInfo{Tag: Tag{0x0018, 0x1044}, VR: "DS", Name: "ContrastBolusTotalDose", VM: "1"}
+	tagDict[Tag{0x0018, 0x1045}] = TagInfo{Tag: Tag{0x0018, 0x1045}, VR: "IS", Name: "SyringeCounts", VM: "1"}
+	tagDict[Tag{0x0018, 0x1046}] = TagInfo{Tag: Tag{0x0018, 0x1046}, VR: "DS", Name: "ContrastFlowRate", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x1047}] = TagInfo{Tag: Tag{0x0018, 0x1047}, VR: "DS", Name: "ContrastFlowDuration", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x1048}] = TagInfo{Tag: Tag{0x0018, 0x1048}, VR: "CS", Name: "ContrastBolusIngredient", VM: "1"}
+	tagDict[Tag{0x0018, 0x1049}] = TagInfo{Tag: Tag{0x0018, 0x1049}, VR: "DS", Name: "ContrastBolusIngredientConcentration", VM: "1"}
+	tagDict[Tag{0x0018, 0x1050}] = TagInfo{Tag: Tag{0x0018, 0x1050}, VR: "DS", Name: "SpatialResolution", VM: "1"}
+	tagDict[Tag{0x0018, 0x1060}] = TagInfo{Tag: Tag{0x0018, 0x1060}, VR: "DS", Name: "TriggerTime", VM: "1"}
+	tagDict[Tag{0x0018, 0x1061}] = TagInfo{Tag: Tag{0x0018, 0x1061}, VR: "LO", Name: "TriggerSourceOrType", VM: "1"}
+	tagDict[Tag{0x0018, 0x1062}] = TagInfo{Tag: Tag{0x0018, 0x1062}, VR: "IS", Name: "NominalInterval", VM: "1"}
+	tagDict[Tag{0x0018, 0x1063}] = TagInfo{Tag: Tag{0x0018, 0x1063}, VR: "DS", Name: "FrameTime", VM: "1"}
+	tagDict[Tag{0x0018, 0x1064}] = TagInfo{Tag: Tag{0x0018, 0x1064}, VR: "LO", Name: "CardiacFramingType", VM: "1"}
+	tagDict[Tag{0x0018, 0x1065}] = TagInfo{Tag: Tag{0x0018, 0x1065}, VR: "DS", Name: "FrameTimeVector", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x1066}] = TagInfo{Tag: Tag{0x0018, 0x1066}, VR: "DS", Name: "FrameDelay", VM: "1"}
+	tagDict[Tag{0x0018, 0x1067}] = TagInfo{Tag: Tag{0x0018, 0x1067}, VR: "DS", Name: "ImageTriggerDelay", VM: "1"}
+	tagDict[Tag{0x0018, 0x1068}] = TagInfo{Tag: Tag{0x0018, 0x1068}, VR: "DS", Name: "MultiplexGroupTimeOffset", VM: "1"}
+	tagDict[Tag{0x0018, 0x1069}] = TagInfo{Tag: Tag{0x0018, 0x1069}, VR: "DS", Name: "TriggerTimeOffset", VM: "1"}
+	tagDict[Tag{0x0018, 0x106A}] = TagInfo{Tag: Tag{0x0018, 0x106A}, VR: "CS", Name: "SynchronizationTrigger", VM: "1"}
+	tagDict[Tag{0x0018, 0x106C}] = TagInfo{Tag: Tag{0x0018, 0x106C}, VR: "US", Name: "SynchronizationChannel", VM: "2"}
+	tagDict[Tag{0x0018, 0x106E}] = TagInfo{Tag: Tag{0x0018, 0x106E}, VR: "UL", Name: "TriggerSamplePosition", VM: "1"}
+	tagDict[Tag{0x0018, 0x1070}] = TagInfo{Tag: Tag{0x0018, 0x1070}, VR: "LO", Name: "RadiopharmaceuticalRoute", VM: "1"}
+	tagDict[Tag{0x0018, 0x1071}] = TagInfo{Tag: Tag{0x0018, 0x1071}, VR: "DS", Name: "RadiopharmaceuticalVolume", VM: "1"}
+	tagDict[Tag{0x0018, 0x1072}] = TagInfo{Tag: Tag{0x0018, 0x1072}, VR: "TM", Name: "RadiopharmaceuticalStartTime", VM: "1"}
+	tagDict[Tag{0x0018, 0x1073}] = TagInfo{Tag: Tag{0x0018, 0x1073}, VR: "TM", Name: "RadiopharmaceuticalStopTime", VM: "1"}
+	tagDict[Tag{0x0018, 0x1074}] = TagInfo{Tag: Tag{0x0018, 0x1074}, VR: "DS", Name: "RadionuclideTotalDose", VM: "1"}
+	tagDict[Tag{0x0018, 0x1075}] = TagInfo{Tag: Tag{0x0018, 0x1075}, VR: "DS", Name: "RadionuclideHalfLife", VM: "1"}
+	tagDict[Tag{0x0018, 0x1076}] = TagInfo{Tag: Tag{0x0018, 0x1076}, VR: "DS", Name: "RadionuclidePositronFraction", VM: "1"}
+	tagDict[Tag{0x0018, 0x1077}] = TagInfo{Tag: Tag{0x0018, 0x1077}, VR: "DS", Name: "RadiopharmaceuticalSpecificActivity", VM: "1"}
+	tagDict[Tag{0x0018, 0x1078}] = TagInfo{Tag: Tag{0x0018, 0x1078}, VR: "DT", Name: "RadiopharmaceuticalStartDateTime", VM: "1"}
+	tagDict[Tag{0x0018, 0x1079}] = TagInfo{Tag: Tag{0x0018, 0x1079}, VR: "DT", Name: "RadiopharmaceuticalStopDateTime", VM: "1"}
+	tagDict[Tag{0x0018, 0x1080}] = TagInfo{Tag: Tag{0x0018, 0x1080}, VR: "CS", Name: "BeatRejectionFlag", VM: "1"}
+	tagDict[Tag{0x0018, 0x1081}] = TagInfo{Tag: Tag{0x0018, 0x1081}, VR: "IS", Name: "LowRRValue", VM: "1"}
+	tagDict[Tag{0x0018, 0x1082}] = TagInfo{Tag: Tag{0x0018, 0x1082}, VR: "IS", Name: "HighRRValue", VM: "1"}
+	tagDict[Tag{0x0018, 0x1083}] = TagInfo{Tag: Tag{0x0018, 0x1083}, VR: "IS", Name: "IntervalsAcquired", VM: "1"}
+	tagDict[Tag{0x0018, 0x1084}] = TagInfo{Tag: Tag{0x0018, 0x1084}, VR: "IS", Name: "IntervalsRejected", VM: "1"}
+	tagDict[Tag{0x0018, 0x1085}] = TagInfo{Tag: Tag{0x0018, 0x1085}, VR: "LO", Name: "PVCRejection", VM: "1"}
+	tagDict[Tag{0x0018, 0x1086}] = TagInfo{Tag: Tag{0x0018, 0x1086}, VR: "IS", Name: "SkipBeats", VM: "1"}
+	tagDict[Tag{0x0018, 0x1088}] = TagInfo{Tag: Tag{0x0018, 0x1088}, VR: "IS", Name: "HeartRate", VM: "1"}
+	tagDict[Tag{0x0018, 0x1090}] = TagInfo{Tag: Tag{0x0018, 0x1090}, VR: "IS", Name: "CardiacNumberOfImages", VM: "1"}
+	tagDict[Tag{0x0018, 0x1094}] = TagInfo{Tag: Tag{0x0018, 0x1094}, VR: "IS", Name: "TriggerWindow", VM: "1"}
+	tagDict[Tag{0x0018, 0x1100}] = TagInfo{Tag: Tag{0x0018, 0x1100}, VR: "DS", Name: "ReconstructionDiameter", VM: "1"}
+	tagDict[Tag{0x0018, 0x1110}] = TagInfo{Tag: Tag{0x0018, 0x1110}, VR: "DS", Name: "DistanceSourceToDetector", VM: "1"}
+	tagDict[Tag{0x0018, 0x1111}] = TagInfo{Tag: Tag{0x0018, 0x1111}, VR: "DS", Name: "DistanceSourceToPatient", VM: "1"}
+	tagDict[Tag{0x0018, 0x1114}] = TagInfo{Tag: Tag{0x0018, 0x1114}, VR: "DS", Name: "EstimatedRadiographicMagnificationFactor", VM: "1"}
+	tagDict[Tag{0x0018, 0x1120}] = TagInfo{Tag: Tag{0x0018, 0x1120}, VR: "DS", Name: "GantryDetectorTilt", VM: "1"}
+	tagDict[Tag{0x0018, 0x1121}] = TagInfo{Tag: Tag{0x0018, 0x1121}, VR: "DS", Name: "GantryDetectorSlew", VM: "1"}
+	tagDict[Tag{0x0018, 0x1130}] = TagInfo{Tag: Tag{0x0018, 0x1130}, VR: "DS", Name: "TableHeight", VM: "1"}
+	tagDict[Tag{0x0018, 0x1131}] = TagInfo{Tag: Tag{0x0018, 0x1131}, VR: "DS", Name: "TableTraverse", VM: "1"}
+	tagDict[Tag{0x0018, 0x1134}] = TagInfo{Tag: Tag{0x0018, 0x1134}, VR: "CS", Name: "TableMotion", VM: "1"}
+	tagDict[Tag{0x0018, 0x1135}] = TagInfo{Tag: Tag{0x0018, 0x1135}, VR: "DS", Name: "TableVerticalIncrement", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x1136}] = TagInfo{Tag: Tag{0x0018, 0x1136}, VR: "DS", Name: "TableLateralIncrement", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x1137}] = TagInfo{Tag: Tag{0x0018, 0x1137}, VR: "DS", Name: "TableLongitudinalIncrement", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x1138}] = TagInfo{Tag: Tag{0x0018, 0x1138}, VR: "DS", Name: "TableAngle", VM: "1"}
+	tagDict[Tag{0x0018, 0x113A}] = TagInfo{Tag: Tag{0x0018, 0x113A}, VR: "CS", Name: "TableType", VM: "1"}
+	tagDict[Tag{0x0018, 0x1140}] = TagInfo{Tag: Tag{0x0018, 0x1140}, VR: "CS", Name: "RotationDirection", VM: "1"}
+	tagDict[Tag{0x0018, 0x1142}] = TagInfo{Tag: Tag{0x0018, 0x1142}, VR: "DS", Name: "RadialPosition", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x1143}] = TagInfo{Tag: Tag{0x0018, 0x1143}, VR: "DS", Name: "ScanArc", VM: "1"}
+	tagDict[Tag{0x0018, 0x1144}] = TagInfo{Tag: Tag{0x0018, 0x1144}, VR: "DS", Name: "AngularStep", VM: "1"}
+	tagDict[Tag{0x0018, 0x1145}] = TagInfo{Tag: Tag{0x0018, 0x1145}, VR: "DS", Name: "CenterOfRotationOffset", VM: "1"}
+	tagDict[Tag{0x0018, 0x1147}] = TagInfo{Tag: Tag{0x0018, 0x1147}, VR: "CS", Name: "FieldOfViewShape", VM: "1"}
+	tagDict[Tag{0x0018, 0x1149}] = TagInfo{Tag: Tag{0x0018, 0x1149}, VR: "IS", Name: "FieldOfViewDimensions", VM: "1-2"}
+	tagDict[Tag{0x0018, 0x1150}] = TagInfo{Tag: Tag{0x0018, 0x1150}, VR: "IS", Name: "ExposureTime", VM: "1"}
+	tagDict[Tag{0x0018, 0x1151}] = TagInfo{Tag: Tag{0x0018, 0x1151}, VR: "IS", Name: "XRayTubeCurrent", VM: "1"}
+	tagDict[Tag{0x0018, 0x1152}] = TagInfo{Tag: Tag{0x0018, 0x1152}, VR: "IS", Name: "Exposure", VM: "1"}
+	tagDict[Tag{0x0018, 0x1153}] = TagInfo{Tag: Tag{0x0018, 0x1153}, VR: "IS", Name: "ExposureInuAs", VM: "1"}
+	tagDict[Tag{0x0018, 0x1154}] = TagInfo{Tag: Tag{0x0018, 0x1154}, VR: "DS", Name: "AveragePulseWidth", VM: "1"}
+	tagDict[Tag{0x0018, 0x1155}] = TagInfo{Tag: Tag{0x0018, 0x1155}, VR: "CS", Name: "RadiationSetting", VM: "1"}
+	tagDict[Tag{0x0018, 0x1156}] = TagInfo{Tag: Tag{0x0018, 0x1156}, VR: "CS", Name: "RectificationType", VM: "1"}
+	tagDict[Tag{0x0018, 0x115A}] = TagInfo{Tag: Tag{0x0018, 0x115A}, VR: "CS", Name: "RadiationMode", VM: "1"}
+	tagDict[Tag{0x0018, 0x115E}] = TagInfo{Tag: Tag{0x0018, 0x115E}, VR: "DS", Name: "ImageAndFluoroscopyAreaDoseProduct", VM: "1"}
+	tagDict[Tag{0x0018, 0x1160}] = TagInfo{Tag: Tag{0x0018, 0x1160}, VR: "SH", Name: "FilterType", VM: "1"}
+	tagDict[Tag{0x0018, 0x1161}] = TagInfo{Tag: Tag{0x0018, 0x1161}, VR: "LO", Name: "TypeOfFilters", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x1162}] = TagInfo{Tag: Tag{0x0018, 0x1162}, VR: "DS", Name: "IntensifierSize", VM: "1"}
+	tagDict[Tag{0x0018, 0x1164}] = TagInfo{Tag: Tag{0x0018, 0x1164}, VR: "DS", Name: "ImagerPixelSpacing", VM: "2"}
+	tagDict[Tag{0x0018, 0x1166}] = TagInfo{Tag: Tag{0x0018, 0x1166}, VR: "CS", Name: "Grid", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x1170}] = TagInfo{Tag: Tag{0x0018, 0x1170}, VR: "IS", Name: "GeneratorPower", VM: "1"}
+	tagDict[Tag{0x0018, 0x1180}] = TagInfo{Tag: Tag{0x0018, 0x1180}, VR: "SH", Name: "CollimatorGridName", VM: "1"}
+	tagDict[Tag{0x0018, 0x1181}] = TagInfo{Tag: Tag{0x0018, 0x1181}, VR: "CS", Name: "CollimatorType", VM: "1"}
+	tagDict[Tag{0x0018, 0x1182}] = TagInfo{Tag: Tag{0x0018, 0x1182}, VR: "IS", Name: "FocalDistance", VM: "1-2"}
+	tagDict[Tag{0x0018, 0x1183}] = TagInfo{Tag: Tag{0x0018, 0x1183}, VR: "DS", Name: "XFocusCenter", VM: "1-2"}
+	tagDict[Tag{0x0018, 0x1184}] = TagInfo{Tag: Tag{0x0018, 0x1184}, VR: "DS", Name: "YFocusCenter", VM: "1-2"}
+	tagDict[Tag{0x0018, 0x1190}] = TagInfo{Tag: Tag{0x0018, 0x1190}, VR: "DS", Name: "FocalSpots", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x1191}] = TagInfo{Tag: Tag{0x0018, 0x1191}, VR: "CS", Name: "AnodeTargetMaterial", VM: "1"}
+	tagDict[Tag{0x0018, 0x11A0}] = TagInfo{Tag: Tag{0x0018, 0x11A0}, VR: "DS", Name: "BodyPartThickness", VM: "1"}
+	tagDict[Tag{0x0018, 0x11A2}] = TagInfo{Tag: Tag{0x0018, 0x11A2}, VR: "DS", Name: "CompressionForce", VM: "1"}
+	tagDict[Tag{0x0018, 0x1200}] = TagInfo{Tag: Tag{0x0018, 0x1200}, VR: "DA", Name: "DateOfLastCalibration", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x1201}] = TagInfo{Tag: Tag{0x0018, 0x1201}, VR: "TM", Name: "TimeOfLastCalibration", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x1210}] = TagInfo{Tag: Tag{0x0018, 0x1210}, VR: "SH", Name: "ConvolutionKernel", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x1242}] = TagInfo{Tag: Tag{0x0018, 0x1242}, VR: "IS", Name: "ActualFrameDuration", VM: "1"}
+	tagDict[Tag{0x0018, 0x1243}] = TagInfo{Tag: Tag{0x0018, 0x1243}, VR: "IS", Name: "CountRate", VM: "1"}
+	tagDict[Tag{0x0018, 0x1244}] = TagInfo{Tag: Tag{0x0018, 0x1244}, VR: "US", Name: "PreferredPlaybackSequencing", VM: "1"}
+	tagDict[Tag{0x0018, 0x1250}] = TagInfo{Tag: Tag{0x0018, 0x1250}, VR: "SH", Name: "ReceiveCoilName", VM: "1"}
+	tagDict[Tag{0x0018, 0x1251}] = TagInfo{Tag: Tag{0x0018, 0x1251}, VR: "SH", Name: "TransmitCoilName", VM: "1"}
+	tagDict[Tag{0x0018, 0x1260}] = TagInfo{Tag: Tag{0x0018, 0x1260}, VR: "SH", Name: "PlateType", VM: "1"}
+	tagDict[Tag{0x0018, 0x1261}] = TagInfo{Tag: Tag{0x0018, 0x1261}, VR: "LO", Name: "PhosphorType", VM: "1"}
+	tagDict[Tag{0x0018, 0x1300}] = TagInfo{Tag: Tag{0x0018, 0x1300}, VR: "DS", Name: "ScanVelocity", VM: "1"}
+	tagDict[Tag{0x0018, 0x1301}] = TagInfo{Tag: Tag{0x0018, 0x1301}, VR: "CS", Name: "WholeBodyTechnique", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x1302}] = TagInfo{Tag: Tag{0x0018, 0x1302}, VR: "IS", Name: "ScanLength", VM: "1"}
+	tagDict[Tag{0x0018, 0x1310}] = TagInfo{Tag: Tag{0x0018, 0x1310}, VR: "US", Name: "AcquisitionMatrix", VM: "4"}
+	tagDict[Tag{0x0018, 0x1312}] = TagInfo{Tag: Tag{0x0018, 0x1312}, VR: "CS", Name: "InPlanePhaseEncodingDirection", VM: "1"}
+	tagDict[Tag{0x0018, 0x1314}] = TagInfo{Tag: Tag{0x0018, 0x1314}, VR: "DS", Name: "FlipAngle", VM: "1"}
+	tagDict[Tag{0x0018, 0x1315}] = TagInfo{Tag: Tag{0x0018, 0x1315}, VR: "CS", Name: "VariableFlipAngleFlag", VM: "1"}
+	tagDict[Tag{0x0018, 0x1316}] = TagInfo{Tag: Tag{0x0018, 0x1316}, VR: "DS", Name: "SAR", VM: "1"}
+	tagDict[Tag{0x0018, 0x1318}] = TagInfo{Tag: Tag{0x0018, 0x1318}, VR: "DS", Name: "dBdt", VM: "1"}
+	tagDict[Tag{0x0018, 0x1400}] = TagInfo{Tag: Tag{0x0018, 0x1400}, VR: "LO", Name: "AcquisitionDeviceProcessingDescription", VM: "1"}
+	tagDict[Tag{0x0018, 0x1401}] = TagInfo{Tag: Tag{0x0018, 0x1401}, VR: "LO", Name: "AcquisitionDeviceProcessingCode", VM: "1"}
+	tagDict[Tag{0x0018, 0x1402}] = TagInfo{Tag: Tag{0x0018, 0x1402}, VR: "CS", Name: "CassetteOrientation", VM: "1"}
+	tagDict[Tag{0x0018, 0x1403}] = TagInfo{Tag: Tag{0x0018, 0x1403}, VR: "CS", Name: "CassetteSize", VM: "1"}
+	tagDict[Tag{0x0018, 0x1404}] = TagInfo{Tag: Tag{0x0018, 0x1404}, VR: "US", Name: "ExposuresOnPlate", VM: "1"}
+	tagDict[Tag{0x0018, 0x1405}] = TagInfo{Tag: Tag{0x0018, 0x1405}, VR: "IS", Name: "RelativeXRayExposure", VM: "1"}
+	tagDict[Tag{0x0018, 0x1411}] = TagInfo{Tag: Tag{0x0018, 0x1411}, VR: "DS", Name: "ExposureIndex", VM: "1"}
+	tagDict[Tag{0x0018, 0x1412}] = TagInfo{Tag: Tag{0x0018, 0x1412}, VR: "DS", Name: "TargetExposureIndex", VM: "1"}
+	tagDict[Tag{0x0018, 0x1413}] = TagInfo{Tag: Tag{0x0018, 0x1413}, VR: "DS", Name: "DeviationIndex", VM: "1"}
+	tagDict[Tag{0x0018, 0x1450}] = TagInfo{Tag: Tag{0x0018, 0x1450}, VR: "DS", Name: "ColumnAngulation", VM: "1"}
+	tagDict[Tag{0x0018, 0x1460}] = TagInfo{Tag: Tag{0x0018, 0x1460}, VR: "DS", Name: "TomoLayerHeight", VM: "1"}
+	tagDict[Tag{0x0018, 0x1470}] = TagInfo{Tag: Tag{0x0018, 0x1470}, VR: "DS", Name: "TomoAngle", VM: "1"}
+	tagDict[Tag{0x0018, 0x1480}] = TagInfo{Tag: Tag{0x0018, 0x1480}, VR: "DS", Name: "TomoTime", VM: "1"}
+	tagDict[Tag{0x0018, 0x1490}] = TagInfo{Tag: Tag{0x0018, 0x1490}, VR: "CS", Name: "TomoType", VM: "1"}
+	tagDict[Tag{0x0018, 0x1491}] = TagInfo{Tag: Tag{0x0018, 0x1491}, VR: "CS", Name: "TomoClass", VM: "1"}
+	tagDict[Tag{0x0018, 0x1495}] = TagInfo{Tag: Tag{0x0018, 0x1495}, VR: "IS", Name: "NumberOfTomosynthesisSourceImages", VM: "1"}
+	tagDict[Tag{0x0018, 0x1500}] = TagInfo{Tag: Tag{0x0018, 0x1500}, VR: "CS", Name: "PositionerMotion", VM: "1"}
+	tagDict[Tag{0x0018, 0x1508}] = TagInfo{Tag: Tag{0x0018, 0x1508}, VR: "CS", Name: "PositionerType", VM: "1"}
+	tagDict[Tag{0x0018, 0x1510}] = TagInfo{Tag: Tag{0x0018, 0x1510}, VR: "DS", Name: "PositionerPrimaryAngle", VM: "1"}
+	tagDict[Tag{0x0018, 0x1511}] = TagInfo{Tag: Tag{0x0018, 0x1511}, VR: "DS", Name: "PositionerSecondaryAngle", VM: "1"}
+	tagDict[Tag{0x0018, 0x1520}] = TagInfo{Tag: Tag{0x0018, 0x1520}, VR: "DS", Name: "PositionerPrimaryAngleIncrement", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x1521}] = TagInfo{Tag: Tag{0x0018, 0x1521}, VR: "DS", Name: "PositionerSecondaryAngleIncrement", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x1530}] = TagInfo{Tag: Tag{0x0018, 0x1530}, VR: "DS", Name: "DetectorPrimaryAngle", VM: "1"}
+	tagDict[Tag{0x0018, 0x1531}] = TagInfo{Tag: Tag{0x0018, 0x1531}, VR: "DS", Name: "DetectorSecondaryAngle", VM: "1"}
+	tagDict[Tag{0x0018, 0x1600}] = TagInfo{Tag: Tag{0x0018, 0x1600}, VR: "CS", Name: "ShutterShape", VM: "1-3"}
+	tagDict[Tag{0x0018, 0x1602}] = TagInfo{Tag: Tag{0x0018, 0x1602}, VR: "IS", Name: "ShutterLeftVerticalEdge", VM: "1"}
+	tagDict[Tag{0x0018, 0x1604}] = TagInfo{Tag: Tag{0x0018, 0x1604}, VR: "IS", Name: "ShutterRightVerticalEdge", VM: "1"}
+	tagDict[Tag{0x0018, 0x1606}] = TagInfo{Tag: Tag{0x0018, 0x1606}, VR: "IS", Name: "ShutterUpperHorizontalEdge", VM: "1"}
+	tagDict[Tag{0x0018, 0x1608}] = TagInfo{Tag: Tag{0x0018, 0x1608}, VR: "IS", Name: "ShutterLowerHorizontalEdge", VM: "1"}
+	tagDict[Tag{0x0018, 0x1610}] = TagInfo{Tag: Tag{0x0018, 0x1610}, VR: "IS", Name: "CenterOfCircularShutter", VM: "2"}
+	tagDict[Tag{0x0018, 0x1612}] = TagInfo{Tag: Tag{0x0018, 0x1612}, VR: "IS", Name: "RadiusOfCircularShutter", VM: "1"}
+	tagDict[Tag{0x0018, 0x1620}] = TagInfo{Tag: Tag{0x0018, 0x1620}, VR: "IS", Name: "VerticesOfThePolygonalShutter", VM: "2-2n"}
+	tagDict[Tag{0x0018, 0x1622}] = TagInfo{Tag: Tag{0x0018, 0x1622}, VR: "US", Name: "ShutterPresentationValue", VM: "1"}
+	tagDict[Tag{0x0018, 0x1623}] = TagInfo{Tag: Tag{0x0018, 0x1623}, VR: "US", Name: "ShutterOverlayGroup", VM: "1"}
+	tagDict[Tag{0x0018, 0x1624}] = TagInfo{Tag: Tag{0x0018, 0x1624}, VR: "US", Name: "ShutterPresentationColorCIELabValue", VM: "3"}
+	tagDict[Tag{0x0018, 0x1700}] = TagInfo{Tag: Tag{0x0018, 0x1700}, VR: "CS", Name: "CollimatorShape", VM: "1-3"}
+	tagDict[Tag{0x0018, 0x1702}] = TagInfo{Tag: Tag{0x0018, 0x1702}, VR: "IS", Name: "CollimatorLeftVerticalEdge", VM: "1"}
+	tagDict[Tag{0x0018, 0x1704}] = TagInfo{Tag: Tag{0x0018, 0x1704}, VR: "IS", Name: "CollimatorRightVerticalEdge", VM: "1"}
+	tagDict[Tag{0x0018, 0x1706}] = TagInfo{Tag: Tag{0x0018, 0x1706}, VR: "IS", Name: "CollimatorUpperHorizontalEdge", VM: "1"}
+	tagDict[Tag{0x0018, 0x1708}] = TagInfo{Tag: Tag{0x0018, 0x1708}, VR: "IS", Name: "CollimatorLowerHorizontalEdge", VM: "1"}
+	tagDict[Tag{0x0018, 0x1710}] = TagInfo{Tag: Tag{0x0018, 0x1710}, VR: "IS", Name: "CenterOfCircularCollimator", VM: "2"}
+	tagDict[Tag{0x0018, 0x1712}] = TagInfo{Tag: Tag{0x0018, 0x1712}, VR: "IS", Name: "RadiusOfCircularCollimator", VM: "1"}
+	tagDict[Tag{0x0018, 0x1720}] = TagInfo{Tag: Tag{0x0018, 0x1720}, VR: "IS", Name: "VerticesOfThePolygonalCollimator", VM: "2-2n"}
+	tagDict[Tag{0x0018, 0x1800}] = TagInfo{Tag: Tag{0x0018, 0x1800}, VR: "CS", Name: "AcquisitionTimeSynchronized", VM: "1"}
+	tagDict[Tag{0x0018, 0x1801}] = TagInfo{Tag: Tag{0x0018, 0x1801}, VR: "SH", Name: "TimeSource", VM: "1"}
+	tagDict[Tag{0x0018, 0x1802}] = TagInfo{Tag: Tag{0x0018, 0x1802}, VR: "CS", Name: "TimeDistributionProtocol", VM: "1"}
+	tagDict[Tag{0x0018, 0x1803}] = TagInfo{Tag: Tag{0x0018, 0x1803}, VR: "LO", Name: "NTPSourceAddress", VM: "1"}
+	tagDict[Tag{0x0018, 0x2001}] = TagInfo{Tag: Tag{0x0018, 0x2001}, VR: "IS", Name: "PageNumberVector", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x2002}] = TagInfo{Tag: Tag{0x0018, 0x2002}, VR: "SH", Name: "FrameLabelVector", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x2003}] = TagInfo{Tag: Tag{0x0018, 0x2003}, VR: "DS", Name: "FramePrimaryAngleVector", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x2004}] = TagInfo{Tag: Tag{0x0018, 0x2004}, VR: "DS", Name: "FrameSecondaryAngleVector", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x2005}] = TagInfo{Tag: Tag{0x0018, 0x2005}, VR: "DS", Name: "SliceLocationVector", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x2006}] = TagInfo{Tag: Tag{0x0018, 0x2006}, VR: "SH", Name: "DisplayWindowLabelVector", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x2010}] = TagInfo{Tag: Tag{0x0018, 0x2010}, VR: "DS", Name: "NominalScannedPixelSpacing", VM: "2"}
+	tagDict[Tag{0x0018, 0x2020}] = TagInfo{Tag: Tag{0x0018, 0x2020}, VR: "CS", Name: "DigitizingDeviceTransportDirection", VM: "1"}
+	tagDict[Tag{0x0018, 0x2030}] = TagInfo{Tag: Tag{0x0018, 0x2030}, VR: "DS", Name: "RotationOfScannedFilm", VM: "1"}
+	tagDict[Tag{0x0018, 0x3100}] = TagInfo{Tag: Tag{0x0018, 0x3100}, VR: "CS", Name: "IVUSAcquisition", VM: "1"}
+	tagDict[Tag{0x0018, 0x3101}] = TagInfo{Tag: Tag{0x0018, 0x3101}, VR: "DS", Name: "IVUSPullbackRate", VM: "1"}
+	tagDict[Tag{0x0018, 0x3102}] = TagInfo{Tag: Tag{0x0018, 0x3102}, VR: "DS", Name: "IVUSGatedRate", VM: "1"}
+	tagDict[Tag{0x0018, 0x3103}] = TagInfo{Tag: Tag{0x0018, 0x3103}, VR: "IS", Name: "IVUSPullbackStartFrameNumber", VM: "1"}
+	tagDict[Tag{0x0018, 0x3104}] = TagInfo{Tag: Tag{0x0018, 0x3104}, VR: "IS", Name: "IVUSPullbackStopFrameNumber", VM: "1"}
+	tagDict[Tag{0x0018, 0x3105}] = TagInfo{Tag: Tag{0x0018, 0x3105}, VR: "IS", Name: "LesionNumber", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x5000}] = TagInfo{Tag: Tag{0x0018, 0x5000}, VR: "SH", Name: "OutputPower", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x5010}] = TagInfo{Tag: Tag{0x0018, 0x5010}, VR: "LO", Name: "TransducerData", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x5012}] = TagInfo{Tag: Tag{0x0018, 0x5012}, VR: "DS", Name: "FocusDepth", VM: "1"}
+	tagDict[Tag{0x0018, 0x5020}] = TagInfo{Tag: Tag{0x0018, 0x5020}, VR: "LO", Name: "ProcessingFunction", VM: "1"}
+	tagDict[Tag{0x0018, 0x5022}] = TagInfo{Tag: Tag{0x0018, 0x5022}, VR: "DS", Name: "MechanicalIndex", VM: "1"}
+	tagDict[Tag{0x0018, 0x5024}] = TagInfo{Tag: Tag{0x0018, 0x5024}, VR: "DS", Name: "BoneThermalIndex", VM: "1"}
+	tagDict[Tag{0x0018, 0x5026}] = TagInfo{Tag: Tag{0x0018, 0x5026}, VR: "DS", Name: "CranialThermalIndex", VM: "1"}
+	tagDict[Tag{0x0018, 0x5027}] = TagInfo{Tag: Tag{0x0018, 0x5027}, VR: "DS", Name: "SoftTissueThermalIndex", VM: "1"}
+	tagDict[Tag{0x0018, 0x5028}] = TagInfo{Tag: Tag{0x0018, 0x5028}, VR: "DS", Name: "SoftTissueFocusThermalIndex", VM: "1"}
+	tagDict[Tag{0x0018, 0x5029}] = TagInfo{Tag: Tag{0x0018, 0x5029}, VR: "DS", Name: "SoftTissueSurfaceThermalIndex", VM: "1"}
+	tagDict[Tag{0x0018, 0x5050}] = TagInfo{Tag: Tag{0x0018, 0x5050}, VR: "IS", Name: "DepthOfScanField", VM: "1"}
+	tagDict[Tag{0x0018, 0x5100}] = TagInfo{Tag: Tag{0x0018, 0x5100}, VR: "CS", Name: "PatientPosition", VM: "1"}
+	tagDict[Tag{0x0018, 0x5101}] = TagInfo{Tag: Tag{0x0018, 0x5101}, VR: "CS", Name: "ViewPosition", VM: "1"}
+	tagDict[Tag{0x0018, 0x5104}] = TagInfo{Tag: Tag{0x0018, 0x5104}, VR: "SQ", Name: "ProjectionEponymousNameCodeSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x6000}] = TagInfo{Tag: Tag{0x0018, 0x6000}, VR: "DS", Name: "Sensitivity", VM: "1"}
+	tagDict[Tag{0x0018, 0x6011}] = TagInfo{Tag: Tag{0x0018, 0x6011}, VR: "SQ", Name: "SequenceOfUltrasoundRegions", VM: "1"}
+	tagDict[Tag{0x0018, 0x6012}] = TagInfo{Tag: Tag{0x0018, 0x6012}, VR: "US", Name: "RegionSpatialFormat", VM: "1"}
+	tagDict[Tag{0x0018, 0x6014}] = TagInfo{Tag: Tag{0x0018, 0x6014}, VR: "US", Name: "RegionDataType", VM: "1"}
+	tagDict[Tag{0x0018, 0x6016}] = TagInfo{Tag: Tag{0x0018, 0x6016}, VR: "UL", Name: "RegionFlags", VM: "1"}
+	tagDict[Tag{0x0018, 0x6018}] = TagInfo{Tag: Tag{0x0018, 0x6018}, VR: "UL", Name: "RegionLocationMinX0", VM: "1"}
+	tagDict[Tag{0x0018, 0x601A}] = TagInfo{Tag: Tag{0x0018, 0x601A}, VR: "UL", Name: "RegionLocationMinY0", VM: "1"}
+	tagDict[Tag{0x0018, 0x601C}] = TagInfo{Tag: Tag{0x0018, 0x601C}, VR: "UL", Name: "RegionLocationMaxX1", VM: "1"}
+	tagDict[Tag{0x0018, 0x601E}] = TagInfo{Tag: Tag{0x0018, 0x601E}, VR: "UL", Name: "RegionLocationMaxY1", VM: "1"}
+	tagDict[Tag{0x0018, 0x6020}] = TagInfo{Tag: Tag{0x0018, 0x6020}, VR: "SL", Name: "ReferencePixelX0", VM: "1"}
+	tagDict[Tag{0x0018, 0x6022}] = TagInfo{Tag: Tag{0x0018, 0x6022}, VR: "SL", Name: "ReferencePixelY0", VM: "1"}
+	tagDict[Tag{0x0018, 0x6024}] = TagInfo{Tag: Tag{0x0018, 0x6024}, VR: "US", Name: "PhysicalUnitsXDirection", VM: "1"}
+	tagDict[Tag{0x0018, 0x6026}] = TagInfo{Tag: Tag{0x0018, 0x6026}, VR: "US", Name: "PhysicalUnitsYDirection", VM: "1"}
+	tagDict[Tag{0x0018, 0x6028}] = TagInfo{Tag: Tag{0x0018, 0x6028}, VR: "FD", Name: "ReferencePixelPhysicalValueX", VM: "1"}
+	tagDict[Tag{0x0018, 0x602A}] = TagInfo{Tag: Tag{0x0018, 0x602A}, VR: "FD", Name: "ReferencePixelPhysicalValueY", VM: "1"}
+	tagDict[Tag{0x0018, 0x602C}] = TagInfo{Tag: Tag{0x0018, 0x602C}, VR: "FD", Name: "PhysicalDeltaX", VM: "1"}
+	tagDict[Tag{0x0018, 0x602E}] = TagInfo{Tag: Tag{0x0018, 0x602E}, VR: "FD", Name: "PhysicalDeltaY", VM: "1"}
+	tagDict[Tag{0x0018, 0x6030}] = TagInfo{Tag: Tag{0x0018, 0x6030}, VR: "UL", Name: "TransducerFrequency", VM: "1"}
+	tagDict[Tag{0x0018, 0x6031}] = TagInfo{Tag: Tag{0x0018, 0x6031}, VR: "CS", Name: "TransducerType", VM: "1"}
+	tagDict[Tag{0x0018, 0x6032}] = TagInfo{Tag: Tag{0x0018, 0x6032}, VR: "UL", Name: "PulseRepetitionFrequency", VM: "1"}
+	tagDict[Tag{0x0018, 0x6034}] = TagInfo{Tag: Tag{0x0018, 0x6034}, VR: "FD", Name: "DopplerCorrectionAngle", VM: "1"}
+	tagDict[Tag{0x0018, 0x6036}] = TagInfo{Tag: Tag{0x0018, 0x6036}, VR: "FD", Name: "SteeringAngle", VM: "1"}
+	tagDict[Tag{0x0018, 0x6039}] = TagInfo{Tag: Tag{0x0018, 0x6039}, VR: "SL", Name: "DopplerSampleVolumeXPosition", VM: "1"}
+	tagDict[Tag{0x0018, 0x603B}] = TagInfo{Tag: Tag{0x0018, 0x603B}, VR: "SL", Name: "DopplerSampleVolumeYPosition", VM: "1"}
+	tagDict[Tag{0x0018, 0x603D}] = TagInfo{Tag: Tag{0x0018, 0x603D}, VR: "SL", Name: "TMLinePositionX0", VM: "1"}
+	tagDict[Tag{0x0018, 0x603F}] = TagInfo{Tag: Tag{0x0018, 0x603F}, VR: "SL", Name: "TMLinePositionY0", VM: "1"}
+	tagDict[Tag{0x0018, 0x6041}] = TagInfo{Tag: Tag{0x0018, 0x6041}, VR: "SL", Name: "TMLinePositionX1", VM: "1"}
+	tagDict[Tag{0x0018, 0x6043}] = TagInfo{Tag: Tag{0x0018, 0x6043}, VR: "SL", Name: "TMLinePositionY1", VM: "1"}
+	tagDict[Tag{0x0018, 0x6044}] = TagInfo{Tag: Tag{0x0018, 0x6044}, VR: "US", Name: "PixelComponentOrganization", VM: "1"}
+	tagDict[Tag{0x0018, 0x6046}] = TagInfo{Tag: Tag{0x0018, 0x6046}, VR: "UL", Name: "PixelComponentMask", VM: "1"}
+	tagDict[Tag{0x0018, 0x6048}] = TagInfo{Tag: Tag{0x0018, 0x6048}, VR: "UL", Name: "PixelComponentRangeStart", VM: "1"}
+	tagDict[Tag{0x0018, 0x604A}] = TagInfo{Tag: Tag{0x0018, 0x604A}, VR: "UL", Name: "PixelComponentRangeStop", VM: "1"}
+	tagDict[Tag{0x0018, 0x604C}] = TagInfo{Tag: Tag{0x0018, 0x604C}, VR: "US", Name: "PixelComponentPhysicalUnits", VM: "1"}
+	tagDict[Tag{0x0018, 0x604E}] = TagInfo{Tag: Tag{0x0018, 0x604E}, VR: "US", Name: "PixelComponentDataType", VM: "1"}
+	tagDict[Tag{0x0018, 0x6050}] = TagInfo{Tag: Tag{0x0018, 0x6050}, VR: "UL", Name: "NumberOfTableBreakPoints", VM: "1"}
+	tagDict[Tag{0x0018, 0x6052}] = TagInfo{Tag: Tag{0x0018, 0x6052}, VR: "UL", Name: "TableOfXBreakPoints", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x6054}] = TagInfo{Tag: Tag{0x0018, 0x6054}, VR: "FD", Name: "TableOfYBreakPoints", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x6056}] = TagInfo{Tag: Tag{0x0018, 0x6056}, VR: "UL", Name: "NumberOfTableEntries", VM: "1"}
+	tagDict[Tag{0x0018, 0x6058}] = TagInfo{Tag: Tag{0x0018, 0x6058}, VR: "UL", Name: "TableOfPixelValues", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x605A}] = TagInfo{Tag: Tag{0x0018, 0x605A}, VR: "FL", Name: "TableOfParameterValues", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x6060}] = TagInfo{Tag: Tag{0x0018, 0x6060}, VR: "FL", Name: "RWaveTimeVector", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x7000}] = TagInfo{Tag: Tag{0x0018, 0x7000}, VR: "CS", Name: "DetectorConditionsNominalFlag", VM: "1"}
+	tagDict[Tag{0x0018, 0x7001}] = TagInfo{Tag: Tag{0x0018, 0x7001}, VR: "DS", Name: "DetectorTemperature", VM: "1"}
+	tagDict[Tag{0x0018, 0x7004}] = TagInfo{Tag: Tag{0x0018, 0x7004}, VR: "CS", Name: "DetectorType", VM: "1"}
+	tagDict[Tag{0x0018, 0x7005}] = TagInfo{Tag: Tag{0x0018, 0x7005}, VR: "CS", Name: "DetectorConfiguration", VM: "1"}
+	tagDict[Tag{0x0018, 0x7006}] = TagInfo{Tag: Tag{0x0018, 0x7006}, VR: "LT", Name: "DetectorDescription", VM: "1"}
+	tagDict[Tag{0x0018, 0x7008}] = TagInfo{Tag: Tag{0x0018, 0x7008}, VR: "LT", Name: "DetectorMode", VM: "1"}
+	tagDict[Tag{0x0018, 0x700A}] = TagInfo{Tag: Tag{0x0018, 0x700A}, VR: "SH", Name: "DetectorID", VM: "1"}
+	tagDict[Tag{0x0018, 0x700C}] = TagInfo{Tag: Tag{0x0018, 0x700C}, VR: "DA", Name: "DateOfLastDetectorCalibration", VM: "1"}
+	tagDict[Tag{0x0018, 0x700E}] = TagInfo{Tag: Tag{0x0018, 0x700E}, VR: "TM", Name: "TimeOfLastDetectorCalibration", VM: "1"}
+	tagDict[Tag{0x0018, 0x7010}] = TagInfo{Tag: Tag{0x0018, 0x7010}, VR: "IS", Name: "ExposuresOnDetectorSinceLastCalibration", VM: "1"}
+	tagDict[Tag{0x0018, 0x7011}] = TagInfo{Tag: Tag{0x0018, 0x7011}, VR: "IS", Name: "ExposuresOnDetectorSinceManufactured", VM: "1"}
+	tagDict[Tag{0x0018, 0x7012}] = TagInfo{Tag: Tag{0x0018, 0x7012}, VR: "DS", Name: "DetectorTimeSinceLastExposure", VM: "1"}
+	tagDict[Tag{0x0018, 0x7014}] = TagInfo{Tag: Tag{0x0018, 0x7014}, VR: "DS", Name: "DetectorActiveTime", VM: "1"}
+	tagDict[Tag{0x0018, 0x7016}] = TagInfo{Tag: Tag{0x0018, 0x7016}, VR: "DS", Name: "DetectorActivationOffsetFromExposure", VM: "1"}
+	tagDict[Tag{0x0018, 0x701A}] = TagInfo{Tag: Tag{0x0018, 0x701A}, VR: "DS", Name: "DetectorBinning", VM: "2"}
+	tagDict[Tag{0x0018, 0x7020}] = TagInfo{Tag: Tag{0x0018, 0x7020}, VR: "DS", Name: "DetectorElementPhysicalSize", VM: "2"}
+	tagDict[Tag{0x0018, 0x7022}] = TagInfo{Tag: Tag{0x0018, 0x7022}, VR: "DS", Name: "DetectorElementSpacing", VM: "2"}
+	tagDict[Tag{0x0018, 0x7024}] = TagInfo{Tag: Tag{0x0018, 0x7024}, VR: "CS", Name: "DetectorActiveShape", VM: "1"}
+	tagDict[Tag{0x0018, 0x7026}] = TagInfo{Tag: Tag{0x0018, 0x7026}, VR: "DS", Name: "DetectorActiveDimensions", VM: "1-2"}
+	tagDict[Tag{0x0018, 0x7028}] = TagInfo{Tag: Tag{0x0018, 0x7028}, VR: "DS", Name: "DetectorActiveOrigin", VM: "2"}
+	tagDict[Tag{0x0018, 0x702A}] = TagInfo{Tag: Tag{0x0018, 0x702A}, VR: "LO", Name: "DetectorManufacturerName", VM: "1"}
+	tagDict[Tag{0x0018, 0x702B}] = TagInfo{Tag: Tag{0x0018, 0x702B}, VR: "LO", Name: "DetectorManufacturerModelName", VM: "1"}
+	tagDict[Tag{0x0018, 0x7030}] = TagInfo{Tag: Tag{0x0018, 0x7030}, VR: "DS", Name: "FieldOfViewOrigin", VM: "2"}
+	tagDict[Tag{0x0018, 0x7032}] = TagInfo{Tag: Tag{0x0018, 0x7032}, VR: "DS", Name: "FieldOfViewRotation", VM: "1"}
+	tagDict[Tag{0x0018, 0x7034}] = TagInfo{Tag: Tag{0x0018, 0x7034}, VR: "CS", Name: "FieldOfViewHorizontalFlip", VM: "1"}
+	tagDict[Tag{0x0018, 0x7036}] = TagInfo{Tag: Tag{0x0018, 0x7036}, VR: "FL", Name: "PixelDataAreaOriginRelativeToFOV", VM: "2"}
+	tagDict[Tag{0x0018, 0x7038}] = TagInfo{Tag: Tag{0x0018, 0x7038}, VR: "FL", Name: "PixelDataAreaRotationAngleRelativeToFOV", VM: "1"}
+	tagDict[Tag{0x0018, 0x7040}] = TagInfo{Tag: Tag{0x0018, 0x7040}, VR: "LT", Name: "GridAbsorbingMaterial", VM: "1"}
+	tagDict[Tag{0x0018, 0x7041}] = TagInfo{Tag: Tag{0x0018, 0x7041}, VR: "LT", Name: "GridSpacingMaterial", VM: "1"}
+	tagDict[Tag{0x0018, 0x7042}] = TagInfo{Tag: Tag{0x0018, 0x7042}, VR: "DS", Name: "GridThickness", VM: "1"}
+	tagDict[Tag{0x0018, 0x7044}] = TagInfo{Tag: Tag{0x0018, 0x7044}, VR: "DS", Name: "GridPitch", VM: "1"}
+	tagDict[Tag{0x0018, 0x7046}] = TagInfo{Tag: Tag{0x0018, 0x7046}, VR: "IS", Name: "GridAspectRatio", VM: "2"}
+	tagDict[Tag{0x0018, 0x7048}] = TagInfo{Tag: Tag{0x0018, 0x7048}, VR: "DS", Name: "GridPeriod", VM: "1"}
+	tagDict[Tag{0x0018, 0x704C}] = TagInfo{Tag: Tag{0x0018, 0x704C}, VR: "DS", Name: "GridFocalDistance", VM: "1"}
+	tagDict[Tag{0x0018, 0x7050}] = TagInfo{Tag: Tag{0x0018, 0x7050}, VR: "CS", Name: "FilterMaterial", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x7052}] = TagInfo{Tag: Tag{0x0018, 0x7052}, VR: "DS", Name: "FilterThicknessMinimum", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x7054}] = TagInfo{Tag: Tag{0x0018, 0x7054}, VR: "DS", Name: "FilterThicknessMaximum", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x7056}] = TagInfo{Tag: Tag{0x0018, 0x7056}, VR: "FL", Name: "FilterBeamPathLengthMinimum", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x7058}] = TagInfo{Tag: Tag{0x0018, 0x7058}, VR: "FL", Name: "FilterBeamPathLengthMaximum", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x7060}] = TagInfo{Tag: Tag{0x0018, 0x7060}, VR: "CS", Name: "ExposureControlMode", VM: "1"}
+	tagDict[Tag{0x0018, 0x7062}] = TagInfo{Tag: Tag{0x0018, 0x7062}, VR: "LT", Name: "ExposureControlModeDescription", VM: "1"}
+	tagDict[Tag{0x0018, 0x7064}] = TagInfo{Tag: Tag{0x0018, 0x7064}, VR: "CS", Name: "ExposureStatus", VM: "1"}
+	tagDict[Tag{0x0018, 0x7065}] = TagInfo{Tag: Tag{0x0018, 0x7065}, VR: "DS", Name: "PhototimerSetting", VM: "1"}
+	tagDict[Tag{0x0018, 0x8150}] = TagInfo{Tag: Tag{0x0018, 0x8150}, VR: "DS", Name: "ExposureTimeInuS", VM: "1"}
+	tagDict[Tag{0x0018, 0x8151}] = TagInfo{Tag: Tag{0x0018, 0x8151}, VR: "DS", Name: "XRayTubeCurrentInuA", VM: "1"}
+	tagDict[Tag{0x0018, 0x9004}] = TagInfo{Tag: Tag{0x0018, 0x9004}, VR: "CS", Name: "ContentQualification", VM: "1"}
+	tagDict[Tag{0x0018, 0x9005}] = TagInfo{Tag: Tag{0x0018, 0x9005}, VR: "SH", Name: "PulseSequenceName", VM: "1"}
+	tagDict[Tag{0x0018, 0x9006}] = TagInfo{Tag: Tag{0x0018, 0x9006}, VR: "SQ", Name: "MRImagingModifierSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9008}] = TagInfo{Tag: Tag{0x0018, 0x9008}, VR: "CS", Name: "EchoPulseSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9009}] = TagInfo{Tag: Tag{0x0018, 0x9009}, VR: "CS", Name: "InversionRecovery", VM: "1"}
+	tagDict[Tag{0x0018, 0x9010}] = TagInfo{Tag: Tag{0x0018, 0x9010}, VR: "CS", Name: "FlowCompensation", VM: "1"}
+	tagDict[Tag{0x0018, 0x9011}] = TagInfo{Tag: Tag{0x0018, 0x9011}, VR: "CS", Name: "MultipleSpinEcho", VM: "1"}
+	tagDict[Tag{0x0018, 0x9012}] = TagInfo{Tag: Tag{0x0018, 0x9012}, VR: "CS", Name: "MultiPlanarExcitation", VM: "1"}
+	tagDict[Tag{0x0018, 0x9014}] = TagInfo{Tag: Tag{0x0018, 0x9014}, VR: "CS", Name: "PhaseContrast", VM: "1"}
+	tagDict[Tag{0x0018, 0x9015}] = TagInfo{Tag: Tag{0x0018, 0x9015}, VR: "CS", Name: "TimeOfFlightContrast", VM: "1"}
+	tagDict[Tag{0x0018, 0x9016}] = TagInfo{Tag: Tag{0x0018, 0x9016}, VR: "CS", Name: "Spoiling", VM: "1"}
+	tagDict[Tag{0x0018, 0x9017}] = TagInfo{Tag: Tag{0x0018, 0x9017}, VR: "CS", Name: "SteadyStatePulseSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9018}] = TagInfo{Tag: Tag{0x0018, 0x9018}, VR: "CS", Name: "EchoPlanarPulseSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9019}] = TagInfo{Tag: Tag{0x0018, 0x9019}, VR: "FD", Name: "TagAngleFirstAxis", VM: "1"}
+	tagDict[Tag{0x0018, 0x9020}] = TagInfo{Tag: Tag{0x0018, 0x9020}, VR: "CS", Name: "MagnetizationTransfer", VM: "1"}
+	tagDict[Tag{0x0018, 0x9021}] = TagInfo{Tag: Tag{0x0018, 0x9021}, VR: "CS", Name: "T2Preparation", VM: "1"}
+	tagDict[Tag{0x0018, 0x9022}] = TagInfo{Tag: Tag{0x0018, 0x9022}, VR: "CS", Name: "BloodSignalNulling", VM: "1"}
+	tagDict[Tag{0x0018, 0x9024}] = TagInfo{Tag: Tag{0x0018, 0x9024}, VR: "CS", Name: "SaturationRecovery", VM: "1"}
+	tagDict[Tag{0x0018, 0x9025}] = TagInfo{Tag: Tag{0x0018, 0x9025}, VR: "CS", Name: "SpectrallySelectedSuppression", VM: "1"}
+	tagDict[Tag{0x0018, 0x9026}] = TagInfo{Tag: Tag{0x0018, 0x9026}, VR: "CS", Name: "SpectrallySelectedExcitation", VM: "1"}
+	tagDict[Tag{0x0018, 0x9027}] = TagInfo{Tag: Tag{0x0018, 0x9027}, VR: "CS", Name: "SpatialPresaturation", VM: "1"}
+	tagDict[Tag{0x0018, 0x9028}] = TagInfo{Tag: Tag{0x0018, 0x9028}, VR: "CS", Name: "Tagging", VM: "1"}
+	tagDict[Tag{0x0018, 0x9029}] = TagInfo{Tag: Tag{0x0018, 0x9029}, VR: "CS", Name: "OversamplingPhase", VM: "1"}
+	tagDict[Tag{0x0018, 0x9030}] = TagInfo{Tag: Tag{0x0018, 0x9030}, VR: "FD", Name: "TagSpacingFirstDimension", VM: "1"}
+	tagDict[Tag{0x0018, 0x9032}] = TagInfo{Tag: Tag{0x0018, 0x9032}, VR: "CS", Name: "GeometryOfKSpaceTraversal", VM: "1"}
+	tagDict[Tag{0x0018, 0x9033}] = TagInfo{Tag: Tag{0x0018, 0x9033}, VR: "CS", Name: "SegmentedKSpaceTraversal", VM: "1"}
+	tagDict[Tag{0x0018, 0x9034}] = TagInfo{Tag: Tag{0x0018, 0x9034}, VR: "CS", Name: "RectilinearPhaseEncodeReordering", VM: "1"}
+	tagDict[Tag{0x0018, 0x9035}] = TagInfo{Tag: Tag{0x0018, 0x9035}, VR: "FD", Name: "TagThickness", VM: "1"}
+	tagDict[Tag{0x0018, 0x9036}] = TagInfo{Tag: Tag{0x0018, 0x9036}, VR: "CS", Name: "PartialFourierDirection", VM: "1"}
+	tagDict[Tag{0x0018, 0x9037}] = TagInfo{Tag: Tag{0x0018, 0x9037}, VR: "CS", Name: "CardiacSynchronizationTechnique", VM: "1"}
+	tagDict[Tag{0x0018, 0x9041}] = TagInfo{Tag: Tag{0x0018, 0x9041}, VR: "LO", Name: "ReceiveCoilManufacturerName", VM: "1"}
+	tagDict[Tag{0x0018, 0x9042}] = TagInfo{Tag: Tag{0x0018, 0x9042}, VR: "SQ", Name: "MRReceiveCoilSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9043}] = TagInfo{Tag: Tag{0x0018, 0x9043}, VR: "CS", Name: "ReceiveCoilType", VM: "1"}
+	tagDict[Tag{0x0018, 0x9044}] = TagInfo{Tag: Tag{0x0018, 0x9044}, VR: "CS", Name: "QuadratureReceiveCoil", VM: "1"}
+	tagDict[Tag{0x0018, 0x9045}] = TagInfo{Tag: Tag{0x0018, 0x9045}, VR: "SQ", Name: "MultiCoilDefinitionSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9046}] = TagInfo{Tag: Tag{0x0018, 0x9046}, VR: "LO", Name: "MultiCoilConfiguration", VM: "1"}
+	tagDict[Tag{0x0018, 0x9047}] = TagInfo{Tag: Tag{0x0018, 0x9047}, VR: "SH", Name: "MultiCoilElementName", VM: "1"}
+	tagDict[Tag{0x0018, 0x9048}] = TagInfo{Tag: Tag{0x0018, 0x9048}, VR: "CS", Name: "MultiCoilElementUsed", VM: "1"}
+	tagDict[Tag{0x0018, 0x9049}] = TagInfo{Tag: Tag{0x0018, 0x9049}, VR: "SQ", Name: "MRTransmitCoilSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9050}] = TagInfo{Tag: Tag{0x0018, 0x9050}, VR: "LO", Name: "TransmitCoilManufacturerName", VM: "1"}
+	tagDict[Tag{0x0018, 0x9051}] = TagInfo{Tag: Tag{0x0018, 0x9051}, VR: "CS", Name: "TransmitCoilType", VM: "1"}
+	tagDict[Tag{0x0018, 0x9052}] = TagInfo{Tag: Tag{0x0018, 0x9052}, VR: "FD", Name: "SpectralWidth", VM: "1-2"}
+	tagDict[Tag{0x0018, 0x9053}] = TagInfo{Tag: Tag{0x0018, 0x9053}, VR: "FD", Name: "ChemicalShiftReference", VM: "1-2"}
+	tagDict[Tag{0x0018, 0x9054}] = TagInfo{Tag: Tag{0x0018, 0x9054}, VR: "CS", Name: "VolumeLocalizationTechnique", VM: "1"}
+	tagDict[Tag{0x0018, 0x9058}] = TagInfo{Tag: Tag{0x0018, 0x9058}, VR: "US", Name: "MRAcquisitionFrequencyEncodingSteps", VM: "1"}
+	tagDict[Tag{0x0018, 0x9059}] = TagInfo{Tag: Tag{0x0018, 0x9059}, VR: "CS", Name: "Decoupling", VM: "1"}
+	tagDict[Tag{0x0018, 0x9060}] = TagInfo{Tag: Tag{0x0018, 0x9060}, VR: "CS", Name: "DecoupledNucleus", VM: "1-2"}
+	tagDict[Tag{0x0018, 0x9061}] = TagInfo{Tag: Tag{0x0018, 0x9061}, VR: "FD", Name: "DecouplingFrequency", VM: "1-2"}
+	tagDict[Tag{0x0018, 0x9062}] = TagInfo{Tag: Tag{0x0018, 0x9062}, VR: "CS", Name: "DecouplingMethod", VM: "1"}
+	tagDict[Tag{0x0018, 0x9063}] = TagInfo{Tag: Tag{0x0018, 0x9063}, VR: "FD", Name: "DecouplingChemicalShiftReference", VM: "1-2"}
+	tagDict[Tag{0x0018, 0x9064}] = TagInfo{Tag: Tag{0x0018, 0x9064}, VR: "CS", Name: "KSpaceFiltering", VM: "1"}
+	tagDict[Tag{0x0018, 0x9065}] = TagInfo{Tag: Tag{0x0018, 0x9065}, VR: "CS", Name: "TimeDomainFiltering", VM: "1-2"}
+	tagDict[Tag{0x0018, 0x9066}] = TagInfo{Tag: Tag{0x0018, 0x9066}, VR: "US", Name: "NumberOfZeroFills", VM: "1-2"}
+	tagDict[Tag{0x0018, 0x9067}] = TagInfo{Tag: Tag{0x0018, 0x9067}, VR: "CS", Name: "BaselineCorrection", VM: "1"}
+	tagDict[Tag{0x0018, 0x9069}] = TagInfo{Tag: Tag{0x0018, 0x9069}, VR: "FD", Name: "ParallelReductionFactorInPlane", VM: "1"}
+	tagDict[Tag{0x0018, 0x9070}] = TagInfo{Tag: Tag{0x0018, 0x9070}, VR: "FD", Name: "CardiacRRIntervalSpecified", VM: "1"}
+	tagDict[Tag{0x0018, 0x9073}] = TagInfo{Tag: Tag{0x0018, 0x9073}, VR: "FD", Name: "AcquisitionDuration", VM: "1"}
+	tagDict[Tag{0x0018, 0x9074}] = TagInfo{Tag: Tag{0x0018, 0x9074}, VR: "DT", Name: "FrameAcquisitionDateTime", VM: "1"}
+	tagDict[Tag{0x0018, 0x9075}] = TagInfo{Tag: Tag{0x0018, 0x9075}, VR: "CS", Name: "DiffusionDirectionality", VM: "1"}
+	tagDict[Tag{0x0018, 0x9076}] = TagInfo{Tag: Tag{0x0018, 0x9076}, VR: "SQ", Name: "DiffusionGradientDirectionSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9077}] = TagInfo{Tag: Tag{0x0018, 0x9077}, VR: "CS", Name: "ParallelAcquisition", VM: "1"}
+	tagDict[Tag{0x0018, 0x9078}] = TagInfo{Tag: Tag{0x0018, 0x9078}, VR: "CS", Name: "ParallelAcquisitionTechnique", VM: "1"}
+	tagDict[Tag{0x0018, 0x9079}] = TagInfo{Tag: Tag{0x0018, 0x9079}, VR: "FD", Name: "InversionTimes", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x9080}] = TagInfo{Tag: Tag{0x0018, 0x9080}, VR: "ST", Name: "MetaboliteMapDescription", VM: "1"}
+	tagDict[Tag{0x0018, 0x9081}] = TagInfo{Tag: Tag{0x0018, 0x9081}, VR: "CS", Name: "PartialFourier", VM: "1"}
+	tagDict[Tag{0x0018, 0x9082}] = TagInfo{Tag: Tag{0x0018, 0x9082}, VR: "FD", Name: "EffectiveEchoTime", VM: "1"}
+	tagDict[Tag{0x0018, 0x9083}] = TagInfo{Tag: Tag{0x0018, 0x9083}, VR: "SQ", Name: "MetaboliteMapCodeSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9084}] = TagInfo{Tag: Tag{0x0018, 0x9084}, VR: "SQ", Name: "ChemicalShiftSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9085}] = TagInfo{Tag: Tag{0x0018, 0x9085}, VR: "CS", Name: "CardiacSignalSource", VM: "1"}
+	tagDict[Tag{0x0018, 0x9087}] = TagInfo{Tag: Tag{0x0018, 0x9087}, VR: "FD", Name: "DiffusionBValue", VM: "1"}
+	tagDict[Tag{0x0018, 0x9089}] = TagInfo{Tag: Tag{0x0018, 0x9089}, VR: "FD", Name: "DiffusionGradientOrientation", VM: "3"}
+	tagDict[Tag{0x0018, 0x9090}] = TagInfo{Tag: Tag{0x0018, 0x9090}, VR: "FD", Name: "VelocityEncodingDirection", VM: "3"}
+	tagDict[Tag{0x0018, 0x9091}] = TagInfo{Tag: Tag{0x0018, 0x9091}, VR: "FD", Name: "VelocityEncodingMinimumValue", VM: "1"}
+	tagDict[Tag{0x0018, 0x9092}] = TagInfo{Tag: Tag{0x0018, 0x9092}, VR: "SQ", Name: "VelocityEncodingAcquisitionSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9093}] = TagInfo{Tag: Tag{0x0018, 0x9093}, VR: "US", Name: "NumberOfKSpaceTrajectories", VM: "1"}
+	tagDict[Tag{0x0018, 0x9094}] = TagInfo{Tag: Tag{0x0018, 0x9094}, VR: "CS", Name: "CoverageOfKSpace", VM: "1"}
+	tagDict[Tag{0x0018, 0x9095}] = TagInfo{Tag: Tag{0x0018, 0x9095}, VR: "UL", Name: "SpectroscopyAcquisitionPhaseRows", VM: "1"}
+	tagDict[Tag{0x0018, 0x9098}] = TagInfo{Tag: Tag{0x0018, 0x9098}, VR: "FD", Name: "TransmitterFrequency", VM: "1-2"}
+	tagDict[Tag{0x0018, 0x9100}] = TagInfo{Tag: Tag{0x0018, 0x9100}, VR: "CS", Name: "ResonantNucleus", VM: "1-2"}
+	tagDict[Tag{0x0018, 0x9101}] = TagInfo{Tag: Tag{0x0018, 0x9101}, VR: "CS", Name: "FrequencyCorrection", VM: "1"}
+	tagDict[Tag{0x0018, 0x9103}] = TagInfo{Tag: Tag{0x0018, 0x9103}, VR: "SQ", Name: "MRSpectroscopyFOVGeometrySequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9104}] = TagInfo{Tag: Tag{0x0018, 0x9104}, VR: "FD", Name: "SlabThickness", VM: "1"}
+	tagDict[Tag{0x0018, 0x9105}] = TagInfo{Tag: Tag{0x0018, 0x9105}, VR: "FD", Name: "SlabOrientation", VM: "3"}
+	tagDict[Tag{0x0018, 0x9106}] = TagInfo{Tag: Tag{0x0018, 0x9106}, VR: "FD", Name: "MidSlabPosition", VM: "3"}
+	tagDict[Tag{0x0018, 0x9107}] = TagInfo{Tag: Tag{0x0018, 0x9107}, VR: "SQ", Name: "MRSpatialSaturationSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9112}] = TagInfo{Tag: Tag{0x0018, 0x9112}, VR: "SQ", Name: "MRTimingAndRelatedParametersSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9114}] = TagInfo{Tag: Tag{0x0018, 0x9114}, VR: "SQ", Name: "MREchoSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9115}] = TagInfo{Tag: Tag{0x0018, 0x9115}, VR: "SQ", Name: "MRModifierSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9117}] = TagInfo{Tag: Tag{0x0018, 0x9117}, VR: "SQ", Name: "MRDiffusionSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9118}] = TagInfo{Tag: Tag{0x0018, 0x9118}, VR: "SQ", Name: "CardiacSynchronizationSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9119}] = TagInfo{Tag: Tag{0x0018, 0x9119}, VR: "SQ", Name: "MRAveragesSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9125}] = TagInfo{Tag: Tag{0x0018, 0x9125}, VR: "SQ", Name: "MRFOVGeometrySequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9126}] = TagInfo{Tag: Tag{0x0018, 0x9126}, VR: "SQ", Name: "VolumeLocalizationSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9127}] = TagInfo{Tag: Tag{0x0018, 0x9127}, VR: "UL", Name: "SpectroscopyAcquisitionDataColumns", VM: "1"}
+	tagDict[Tag{0x0018, 0x9147}] = TagInfo{Tag: Tag{0x0018, 0x9147}, VR: "CS", Name: "DiffusionAnisotropyType", VM: "1"}
+	tagDict[Tag{0x0018, 0x9151}] = TagInfo{Tag: Tag{0x0018, 0x9151}, VR: "DT", Name: "FrameReferenceDateTime", VM: "1"}
+	tagDict[Tag{0x0018, 0x9152}] = TagInfo{Tag: Tag{0x0018, 0x9152}, VR: "SQ", Name: "MRMetaboliteMapSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9155}] = TagInfo{Tag: Tag{0x0018, 0x9155}, VR: "FD", Name: "ParallelReductionFactorOutOfPlane", VM: "1"}
+	tagDict[Tag{0x0018, 0x9159}] = TagInfo{Tag: Tag{0x0018, 0x9159}, VR: "UL", Name: "SpectroscopyAcquisitionOutOfPlanePhaseSteps", VM: "1"}
+	tagDict[Tag{0x0018, 0x9168}] = TagInfo{Tag: Tag{0x0018, 0x9168}, VR: "FD", Name: "ParallelReductionFactorSecondInPlane", VM: "1"}
+	tagDict[Tag{0x0018, 0x9169}] = TagInfo{Tag: Tag{0x0018, 0x9169}, VR: "CS", Name: "CardiacBeatRejectionTechnique", VM: "1"}
+	tagDict[Tag{0x0018, 0x9170}] = TagInfo{Tag: Tag{0x0018, 0x9170}, VR: "CS", Name: "RespiratoryMotionCompensationTechnique", VM: "1"}
+	tagDict[Tag{0x0018, 0x9171}] = TagInfo{Tag: Tag{0x0018, 0x9171}, VR: "CS", Name: "RespiratorySignalSource", VM: "1"}
+	tagDict[Tag{0x0018, 0x9172}] = TagInfo{Tag: Tag{0x0018, 0x9172}, VR: "CS", Name: "BulkMotionCompensationTechnique", VM: "1"}
+	tagDict[Tag{0x0018, 0x9173}] = TagInfo{Tag: Tag{0x0018, 0x9173}, VR: "CS", Name: "BulkMotionSignalSource", VM: "1"}
+	tagDict[Tag{0x0018, 0x9174}] = TagInfo{Tag: Tag{0x0018, 0x9174}, VR: "CS", Name: "ApplicableSafetyStandardAgency", VM: "1"}
+	tagDict[Tag{0x0018, 0x9175}] = TagInfo{Tag: Tag{0x0018, 0x9175}, VR: "LO", Name: "ApplicableSafetyStandardDescription", VM: "1"}
+	tagDict[Tag{0x0018, 0x9176}] = TagInfo{Tag: Tag{0x0018, 0x9176}, VR: "SQ", Name: "OperatingModeSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9177}] = TagInfo{Tag: Tag{0x0018, 0x9177}, VR: "CS", Name: "OperatingModeType", VM: "1"}
+	tagDict[Tag{0x0018, 0x9178}] = TagInfo{Tag: Tag{0x0018, 0x9178}, VR: "CS", Name: "OperatingMode", VM: "1"}
+	tagDict[Tag{0x0018, 0x9179}] = TagInfo{Tag: Tag{0x0018, 0x9179}, VR: "CS", Name: "SpecificAbsorptionRateDefinition", VM: "1"}
+	tagDict[Tag{0x0018, 0x9180}] = TagInfo{Tag: Tag{0x0018, 0x9180}, VR: "CS", Name: "GradientOutputType", VM: "1"}
+	tagDict[Tag{0x0018, 0x9181}] = TagInfo{Tag: Tag{0x0018, 0x9181}, VR: "FD", Name: "SpecificAbsorptionRateValue", VM: "1"}
+	tagDict[Tag{0x0018, 0x9182}] = TagInfo{Tag: Tag{0x0018, 0x9182}, VR: "FD", Name: "GradientOutput", VM: "1"}
+	tagDict[Tag{0x0018, 0x9183}] = TagInfo{Tag: Tag{0x0018, 0x9183}, VR: "CS", Name: "FlowCompensationDirection", VM: "1"}
+	tagDict[Tag{0x0018, 0x9184}] = TagInfo{Tag: Tag{0x0018, 0x9184}, VR: "FD", Name: "TaggingDelay", VM: "1"}
+	tagDict[Tag{0x0018, 0x9185}] = TagInfo{Tag: Tag{0x0018, 0x9185}, VR: "ST", Name: "RespiratoryMotionCompensationTechniqueDescription", VM: "1"}
+	tagDict[Tag{0x0018, 0x9186}] = TagInfo{Tag: Tag{0x0018, 0x9186}, VR: "SH", Name: "RespiratorySignalSourceID", VM: "1"}
+	tagDict[Tag{0x0018, 0x9197}] = TagInfo{Tag: Tag{0x0018, 0x9197}, VR: "SQ", Name: "MRVelocityEncodingSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9198}] = TagInfo{Tag: Tag{0x0018, 0x9198}, VR: "CS", Name: "FirstOrderPhaseCorrection", VM: "1"}
+	tagDict[Tag{0x0018, 0x9199}] = TagInfo{Tag: Tag{0x0018, 0x9199}, VR: "CS", Name: "WaterReferencedPhaseCorrection", VM: "1"}
+	tagDict[Tag{0x0018, 0x9200}] = TagInfo{Tag: Tag{0x0018, 0x9200}, VR: "CS", Name: "MRSpectroscopyAcquisitionType", VM: "1"}
+	tagDict[Tag{0x0018, 0x9214}] = TagInfo{Tag: Tag{0x0018, 0x9214}, VR: "CS", Name: "RespiratoryCyclePosition", VM: "1"}
+	tagDict[Tag{0x0018, 0x9217}] = TagInfo{Tag: Tag{0x0018, 0x9217}, VR: "FD", Name: "VelocityEncodingMaximumValue", VM: "1"}
+	tagDict[Tag{0x0018, 0x9218}] = TagInfo{Tag: Tag{0x0018, 0x9218}, VR: "FD", Name: "TagSpacingSecondDimension", VM: "1"}
+	tagDict[Tag{0x0018, 0x9219}] = TagInfo{Tag: Tag{0x0018, 0x9219}, VR: "SS", Name: "TagAngleSecondAxis", VM: "1"}
+	tagDict[Tag{0x0018, 0x9220}] = TagInfo{Tag: Tag{0x0018, 0x9220}, VR: "FD", Name: "FrameAcquisitionDuration", VM: "1"}
+	tagDict[Tag{0x0018, 0x9226}] = TagInfo{Tag: Tag{0x0018, 0x9226}, VR: "SQ", Name: "MRImageFrameTypeSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9227}] = TagInfo{Tag: Tag{0x0018, 0x9227}, VR: "SQ", Name: "MRSpectroscopyFrameTypeSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9231}] = TagInfo{Tag: Tag{0x0018, 0x9231}, VR: "US", Name: "MRAcquisitionPhaseEncodingStepsInPlane", VM: "1"}
+	tagDict[Tag{0x0018, 0x9232}] = TagInfo{Tag: Tag{0x0018, 0x9232}, VR: "US", Name: "MRAcquisitionPhaseEncodingStepsOutOfPlane", VM: "1"}
+	tagDict[Tag{0x0018, 0x9234}] = TagInfo{Tag: Tag{0x0018, 0x9234}, VR: "UL", Name: "SpectroscopyAcquisitionPhaseColumns", VM: "1"}
+	tagDict[Tag{0x0018, 0x9236}] = TagInfo{Tag: Tag{0x0018, 0x9236}, VR: "CS", Name: "CardiacCyclePosition", VM: "1"}
+	tagDict[Tag{0x0018, 0x9239}] = TagInfo{Tag: Tag{0x0018, 0x9239}, VR: "SQ", Name: "SpecificAbsorptionRateSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9240}] = TagInfo{Tag: Tag{0x0018, 0x9240}, VR: "US", Name: "RFEchoTrainLength", VM: "1"}
+	tagDict[Tag{0x0018, 0x9241}] = TagInfo{Tag: Tag{0x0018, 0x9241}, VR: "US", Name: "GradientEchoTrainLength", VM: "1"}
+	tagDict[Tag{0x0018, 0x9250}] = TagInfo{Tag: Tag{0x0018, 0x9250}, VR: "CS", Name: "ArterialSpinLabelingContrast", VM: "1"}
+	tagDict[Tag{0x0018, 0x9251}] = TagInfo{Tag: Tag{0x0018, 0x9251}, VR: "SQ", Name: "MRArterialSpinLabelingSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9252}] = TagInfo{Tag: Tag{0x0018, 0x9252}, VR: "LO", Name: "ASLTechniqueDescription", VM: "1"}
+	tagDict[Tag{0x0018, 0x9253}] = TagInfo{Tag: Tag{0x0018, 0x9253}, VR: "US", Name: "ASLSlabNumber", VM: "1"}
+	tagDict[Tag{0x0018, 0x9254}] = TagInfo{Tag: Tag{0x0018, 0x9254}, VR: "FD", Name: "ASLSlabThickness", VM: "1"}
+	tagDict[Tag{0x0018, 0x9255}] = TagInfo{Tag: Tag{0x0018, 0x9255}, VR: "FD", Name: "ASLSlabOrientation", VM: "3"}
+	tagDict[Tag{0x0018, 0x9256}] = TagInfo{Tag: Tag{0x0018, 0x9256}, VR: "FD", Name: "ASLMidSlabPosition", VM: "3"}
+	tagDict[Tag{0x0018, 0x9257}] = TagInfo{Tag: Tag{0x0018, 0x9257}, VR: "CS", Name: "ASLContext", VM: "1"}
+	tagDict[Tag{0x0018, 0x9258}] = TagInfo{Tag: Tag{0x0018, 0x9258}, VR: "UL", Name: "ASLPulseTrainDuration", VM: "1"}
+	tagDict[Tag{0x0018, 0x9259}] = TagInfo{Tag: Tag{0x0018, 0x9259}, VR: "CS", Name: "ASLCrusherFlag", VM: "1"}
+	tagDict[Tag{0x0018, 0x925A}] = TagInfo{Tag: Tag{0x0018, 0x925A}, VR: "FD", Name: "ASLCrusherFlow", VM: "1"}
+	tagDict[Tag{0x0018, 0x925B}] = TagInfo{Tag: Tag{0x0018, 0x925B}, VR: "LO", Name: "ASLCrusherDescription", VM: "1"}
+	tagDict[Tag{0x0018, 0x925C}] = TagInfo{Tag: Tag{0x0018, 0x925C}, VR: "CS", Name: "ASLBolusCutoffFlag", VM: "1"}
+	tagDict[Tag{0x0018, 0x925D}] = TagInfo{Tag: Tag{0x0018, 0x925D}, VR: "SQ", Name: "ASLBolusCutoffTimingSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x925E}] = TagInfo{Tag: Tag{0x0018, 0x925E}, VR: "LO", Name: "ASLBolusCutoffTechnique", VM: "1"}
+	tagDict[Tag{0x0018, 0x925F}] = TagInfo{Tag: Tag{0x0018, 0x925F}, VR: "UL", Name: "ASLBolusCutoffDelayTime", VM: "1"}
+	tagDict[Tag{0x0018, 0x9260}] = TagInfo{Tag: Tag{0x0018, 0x9260}, VR: "SQ", Name: "ASLSlabSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9295}] = TagInfo{Tag: Tag{0x0018, 0x9295}, VR: "FD", Name: "ChemicalShiftMinimumIntegrationLimitInppm", VM: "1"}
+	tagDict[Tag{0x0018, 0x9296}] = TagInfo{Tag: Tag{0x0018, 0x9296}, VR: "FD", Name: "ChemicalShiftMaximumIntegrationLimitInppm", VM: "1"}
+	tagDict[Tag{0x0018, 0x9301}] = TagInfo{Tag: Tag{0x0018, 0x9301}, VR: "SQ", Name: "CTAcquisitionTypeSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9302}] = TagInfo{Tag: Tag{0x0018, 0x9302}, VR: "CS", Name: "AcquisitionType", VM: "1"}
+	tagDict[Tag{0x0018, 0x9303}] = TagInfo{Tag: Tag{0x0018, 0x9303}, VR: "FD", Name: "TubeAngle", VM: "1"}
+	tagDict[Tag{0x0018, 0x9304}] = TagInfo{Tag: Tag{0x0018, 0x9304}, VR: "SQ", Name: "CTAcquisitionDetailsSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9305}] = TagInfo{Tag: Tag{0x0018, 0x9305}, VR: "FD", Name: "RevolutionTime", VM: "1"}
+	tagDict[Tag{0x0018, 0x9306}] = TagInfo{Tag: Tag{0x0018, 0x9306}, VR: "FD", Name: "SingleCollimationWidth", VM: "1"}
+	tagDict[Tag{0x0018, 0x9307}] = TagInfo{Tag: Tag{0x0018, 0x9307}, VR: "FD", Name: "TotalCollimationWidth", VM: "1"}
+	tagDict[Tag{0x0018, 0x9308}] = TagInfo{Tag: Tag{0x0018, 0x9308}, VR: "SQ", Name: "CTTableDynamicsSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9309}] = TagInfo{Tag: Tag{0x0018, 0x9309}, VR: "FD", Name: "TableSpeed", VM: "1"}
+	tagDict[Tag{0x0018, 0x9310}] = TagInfo{Tag: Tag{0x0018, 0x9310}, VR: "FD", Name: "TableFeedPerRotation", VM: "1"}
+	tagDict[Tag{0x0018, 0x9311}] = TagInfo{Tag: Tag{0x0018, 0x9311}, VR: "FD", Name: "SpiralPitchFactor", VM: "1"}
+	tagDict[Tag{0x0018, 0x9312}] = TagInfo{Tag: Tag{0x0018, 0x9312}, VR: "SQ", Name: "CTGeometrySequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9313}] = TagInfo{Tag: Tag{0x0018, 0x9313}, VR: "FD", Name: "DataCollectionCenterPatient", VM: "3"}
+	tagDict[Tag{0x0018, 0x9314}] = TagInfo{Tag: Tag{0x0018, 0x9314}, VR: "SQ", Name: "CTReconstructionSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9315}] = TagInfo{Tag: Tag{0x0018, 0x9315}, VR: "CS", Name: "ReconstructionAlgorithm", VM: "1"}
+	tagDict[Tag{0x0018, 0x9316}] = TagInfo{Tag: Tag{0x0018, 0x9316}, VR: "CS", Name: "ConvolutionKernelGroup", VM: "1"}
+	tagDict[Tag{0x0018, 0x9317}] = TagInfo{Tag: Tag{0x0018, 0x9317}, VR: "FD", Name: "ReconstructionFieldOfView", VM: "2"}
+	tagDict[Tag{0x0018, 0x9318}] = TagInfo{Tag: Tag{0x0018, 0x9318}, VR: "FD", Name: "ReconstructionTargetCenterPatient", VM: "3"}
+	tagDict[Tag{0x0018, 0x9319}] = TagInfo{Tag: Tag{0x0018, 0x9319}, VR: "FD", Name: "ReconstructionAngle", VM: "1"}
+	tagDict[Tag{0x0018, 0x9320}] = TagInfo{Tag: Tag{0x0018, 0x9320}, VR: "SH", Name: "ImageFilter", VM: "1"}
+	tagDict[Tag{0x0018, 0x9321}] = TagInfo{Tag: Tag{0x0018, 0x9321}, VR: "SQ", Name: "CTExposureSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9322}] = TagInfo{Tag: Tag{0x0018, 0x9322}, VR: "FD", Name: "ReconstructionPixelSpacing", VM: "2"}
+	tagDict[Tag{0x0018, 0x9323}] = TagInfo{Tag: Tag{0x0018, 0x9323}, VR: "CS", Name: "ExposureModulationType", VM: "1"}
+	tagDict[Tag{0x0018, 0x9324}] = TagInfo{Tag: Tag{0x0018, 0x9324}, VR: "FD", Name: "EstimatedDoseSaving", VM: "1"}
+	tagDict[Tag{0x0018, 0x9325}] = TagInfo{Tag: Tag{0x0018, 0x9325}, VR: "SQ", Name: "CTXRayDetailsSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9326}] = TagInfo{Tag: Tag{0x0018, 0x9326}, VR: "SQ", Name: "CTPositionSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9327}] = TagInfo{Tag: Tag{0x0018, 0x9327}, VR: "FD", Name: "TablePosition", VM: "1"}
+	tagDict[Tag{0x0018, 0x9328}] = TagInfo{Tag: Tag{0x0018, 0x9328}, VR: "FD", Name: "ExposureTimeInms", VM: "1"}
+	tagDict[Tag{0x0018, 0x9329}] = TagInfo{Tag: Tag{0x0018, 0x9329}, VR: "SQ", Name: "CTImageFrameTypeSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9330}] = TagInfo{Tag: Tag{0x0018, 0x9330}, VR: "FD", Name: "XRayTubeCurrentInmA", VM: "1"}
+	tagDict[Tag{0x0018, 0x9332}] = TagInfo{Tag: Tag{0x0018, 0x9332}, VR: "FD", Name: "ExposureInmAs", VM: "1"}
+	tagDict[Tag{0x0018, 0x9333}] = TagInfo{Tag: Tag{0x0018, 0x9333}, VR: "CS", Name: "ConstantVolumeFlag", VM: "1"}
+	tagDict[Tag{0x0018, 0x9334}] = TagInfo{Tag: Tag{0x0018, 0x9334}, VR: "CS", Name: "FluoroscopyFlag", VM: "1"}
+	tagDict[Tag{0x0018, 0x9335}] = TagInfo{Tag: Tag{0x0018, 0x9335}, VR: "FD", Name: "DistanceSourceToDataCollectionCenter", VM: "1"}
+	tagDict[Tag{0x0018, 0x9337}] = TagInfo{Tag: Tag{0x0018, 0x9337}, VR: "US", Name: "ContrastBolusAgentNumber", VM: "1"}
+	tagDict[Tag{0x0018, 0x9338}] = TagInfo{Tag: Tag{0x0018, 0x9338}, VR: "SQ", Name: "ContrastBolusIngredientCodeSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9340}] = TagInfo{Tag: Tag{0x0018, 0x9340}, VR: "SQ", Name: "ContrastAdministrationProfileSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9341}] = TagInfo{Tag: Tag{0x0018, 0x9341}, VR: "SQ", Name: "ContrastBolusUsageSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9342}] = TagInfo{Tag: Tag{0x0018, 0x9342}, VR: "CS", Name: "ContrastBolusAgentAdministered", VM: "1"}
+	tagDict[Tag{0x0018, 0x9343}] = TagInfo{Tag: Tag{0x0018, 0x9343}, VR: "CS", Name: "ContrastBolusAgentDetected", VM: "1"}
+	tagDict[Tag{0x0018, 0x9344}] = TagInfo{Tag: Tag{0x0018, 0x9344}, VR: "CS", Name: "ContrastBolusAgentPhase", VM: "1"}
+	tagDict[Tag{0x0018, 0x9345}] = TagInfo{Tag: Tag{0x0018, 0x9345}, VR: "FD", Name: "CTDIvol", VM: "1"}
+	tagDict[Tag{0x0018, 0x9346}] = TagInfo{Tag: Tag{0x0018, 0x9346}, VR: "SQ", Name: "CTDIPhantomTypeCodeSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9351}] = TagInfo{Tag: Tag{0x0018, 0x9351}, VR: "FL", Name: "CalciumScoringMassFactorPatient", VM: "1"}
+	tagDict[Tag{0x0018, 0x9352}] = TagInfo{Tag: Tag{0x0018, 0x9352}, VR: "FL", Name: "CalciumScoringMassFactorDevice", VM: "3"}
+	tagDict[Tag{0x0018, 0x9353}] = TagInfo{Tag: Tag{0x0018, 0x9353}, VR: "FL", Name: "EnergyWeightingFactor", VM: "1"}
+	tagDict[Tag{0x0018, 0x9360}] = TagInfo{Tag: Tag{0x0018, 0x9360}, VR: "SQ", Name: "CTAdditionalXRaySourceSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9401}] = TagInfo{Tag: Tag{0x0018, 0x9401}, VR: "SQ", Name: "ProjectionPixelCalibrationSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9402}] = TagInfo{Tag: Tag{0x0018, 0x9402}, VR: "FL", Name: "DistanceSourceToIsocenter", VM: "1"}
+	tagDict[Tag{0x0018, 0x9403}] = TagInfo{Tag: Tag{0x0018, 0x9403}, VR: "FL", Name: "DistanceObjectToTableTop", VM: "1"}
+	tagDict[Tag{0x0018, 0x9404}] = TagInfo{Tag: Tag{0x0018, 0x9404}, VR: "FL", Name: "ObjectPixelSpacingInCenterOfBeam", VM: "2"}
+	tagDict[Tag{0x0018, 0x9405}] = TagInfo{Tag: Tag{0x0018, 0x9405}, VR: "SQ", Name: "PositionerPositionSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9406}] = TagInfo{Tag: Tag{0x0018, 0x9406}, VR: "SQ", Name: "TablePositionSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9407}] = TagInfo{Tag: Tag{0x0018, 0x9407}, VR: "SQ", Name: "CollimatorShapeSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9410}] = TagInfo{Tag: Tag{0x0018, 0x9410}, VR: "CS", Name: "PlanesInAcquisition", VM: "1"}
+	tagDict[Tag{0x0018, 0x9412}] = TagInfo{Tag: Tag{0x0018, 0x9412}, VR: "SQ", Name: "XAXRFFrameCharacteristicsSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9417}] = TagInfo{Tag: Tag{0x0018, 0x9417}, VR: "SQ", Name: "FrameAcquisitionSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9420}] = TagInfo{Tag: Tag{0x0018, 0x9420}, VR: "CS", Name: "XRayReceptorType", VM: "1"}
+	tagDict[Tag{0x0018, 0x9423}] = TagInfo{Tag: Tag{0x0018, 0x9423}, VR: "LO", Name: "AcquisitionProtocolName", VM: "1"}
+	tagDict[Tag{0x0018, 0x9424}] = TagInfo{Tag: Tag{0x0018, 0x9424}, VR: "LT", Name: "AcquisitionProtocolDescription", VM: "1"}
+	tagDict[Tag{0x0018, 0x9425}] = TagInfo{Tag: Tag{0x0018, 0x9425}, VR: "CS", Name: "ContrastBolusIngredientOpaque", VM: "1"}
+	tagDict[Tag{0x0018, 0x9426}] = TagInfo{Tag: Tag{0x0018, 0x9426}, VR: "FL", Name: "DistanceReceptorPlaneToDetectorHousing", VM: "1"}
+	tagDict[Tag{0x0018, 0x9427}] = TagInfo{Tag: Tag{0x0018, 0x9427}, VR: "CS", Name: "IntensifierActiveShape", VM: "1"}
+	tagDict[Tag{0x0018, 0x9428}] = TagInfo{Tag: Tag{0x0018, 0x9428}, VR: "FL", Name: "IntensifierActiveDimensions", VM: "1-2"}
+	tagDict[Tag{0x0018, 0x9429}] = TagInfo{Tag: Tag{0x0018, 0x9429}, VR: "FL", Name: "PhysicalDetectorSize", VM: "2"}
+	tagDict[Tag{0x0018, 0x9430}] = TagInfo{Tag: Tag{0x0018, 0x9430}, VR: "FL", Name: "PositionOfIsocenterProjection", VM: "2"}
+	tagDict[Tag{0x0018, 0x9432}] = TagInfo{Tag: Tag{0x0018, 0x9432}, VR: "SQ", Name: "FieldOfViewSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9433}] = TagInfo{Tag: Tag{0x0018, 0x9433}, VR: "LO", Name: "FieldOfViewDescription", VM: "1"}
+	tagDict[Tag{0x0018, 0x9434}] = TagInfo{Tag: Tag{0x0018, 0x9434}, VR: "SQ", Name: "ExposureControlSensingRegionsSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9435}] = TagInfo{Tag: Tag{0x0018, 0x9435}, VR: "CS", Name: "ExposureControlSensingRegionShape", VM: "1"}
+	tagDict[Tag{0x0018, 0x9436}] = TagInfo{Tag: Tag{0x0018, 0x9436}, VR: "SS", Name: "ExposureControlSensingRegionLeftVerticalEdge", VM: "1"}
+	tagDict[Tag{0x0018, 0x9437}] = TagInfo{Tag: Tag{0x0018, 0x9437}, VR: "SS", Name: "ExposureControlSensingRegionRightVerticalEdge", VM: "1"}
+	tagDict[Tag{0x0018, 0x9438}] = TagInfo{Tag: Tag{0x0018, 0x9438}, VR: "SS", Name: "ExposureControlSensingRegionUpperHorizontalEdge", VM: "1"}
+	tagDict[Tag{0x0018, 0x9439}] = TagInfo{Tag: Tag{0x0018, 0x9439}, VR: "SS", Name: "ExposureControlSensingRegionLowerHorizontalEdge", VM: "1"}
+	tagDict[Tag{0x0018, 0x9440}] = TagInfo{Tag: Tag{0x0018, 0x9440}, VR: "SS", Name: "CenterOfCircularExposureControlSensingRegion", VM: "2"}
+	tagDict[Tag{0x0018, 0x9441}] = TagInfo{Tag: Tag{0x0018, 0x9441}, VR: "US", Name: "RadiusOfCircularExposureControlSensingRegion", VM: "1"}
+	tagDict[Tag{0x0018, 0x9442}] = TagInfo{Tag: Tag{0x0018, 0x9442}, VR: "SS", Name: "VerticesOfThePolygonalExposureControlSensingRegion", VM: "2-n"}
+	tagDict[Tag{0x0018, 0x9447}] = TagInfo{Tag: Tag{0x0018, 0x9447}, VR: "FL", Name: "ColumnAngulationPatient", VM: "1"}
+	tagDict[Tag{0x0018, 0x9449}] = TagInfo{Tag: Tag{0x0018, 0x9449}, VR: "FL", Name: "BeamAngle", VM: "1"}
+	tagDict[Tag{0x0018, 0x9451}] = TagInfo{Tag: Tag{0x0018, 0x9451}, VR: "SQ", Name: "FrameDetectorParametersSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9452}] = TagInfo{Tag: Tag{0x0018, 0x9452}, VR: "FL", Name: "CalculatedAnatomyThickness", VM: "1"}
+	tagDict[Tag{0x0018, 0x9455}] = TagInfo{Tag: Tag{0x0018, 0x9455}, VR: "SQ", Name: "CalibrationSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9456}] = TagInfo{Tag: Tag{0x0018, 0x9456}, VR: "SQ", Name: "ObjectThicknessSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9457}] = TagInfo{Tag: Tag{0x0018, 0x9457}, VR: "CS", Name: "PlaneIdentification", VM: "1"}
+	tagDict[Tag{0x0018, 0x9461}] = TagInfo{Tag: Tag{0x0018, 0x9461}, VR: "FL", Name: "FieldOfViewDimensionsInFloat", VM: "1-2"}
+	tagDict[Tag{0x0018, 0x9462}] = TagInfo{Tag: Tag{0x0018, 0x9462}, VR: "SQ", Name: "IsocenterReferenceSystemSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9463}] = TagInfo{Tag: Tag{0x0018, 0x9463}, VR: "FL", Name: "PositionerIsocenterPrimaryAngle", VM: "1"}
+	tagDict[Tag{0x0018, 0x9464}] = TagInfo{Tag: Tag{0x0018, 0x9464}, VR: "FL", Name: "PositionerIsocenterSecondaryAngle", VM: "1"}
+	tagDict[Tag{0x0018, 0x9465}] = TagInfo{Tag: Tag{0x0018, 0x9465}, VR: "FL", Name: "PositionerIsocenterDetectorRotationAngle", VM: "1"}
+	tagDict[Tag{0x0018, 0x9466}] = TagInfo{Tag: Tag{0x0018, 0x9466}, VR: "FL", Name: "TableXPositionToIsocenter", VM: "1"}
+	tagDict[Tag{0x0018, 0x9467}] = TagInfo{Tag: Tag{0x0018, 0x9467}, VR: "FL", Name: "TableYPositionToIsocenter", VM: "1"}
+	tagDict[Tag{0x0018, 0x9468}] = TagInfo{Tag: Tag{0x0018, 0x9468}, VR: "FL", Name: "TableZPositionToIsocenter", VM: "1"}
+	tagDict[Tag{0x0018, 0x9469}] = TagInfo{Tag: Tag{0x0018, 0x9469}, VR: "FL", Name: "TableHorizontalRotationAngle", VM: "1"}
+	tagDict[Tag{0x0018, 0x9470}] = TagInfo{Tag: Tag{0x0018, 0x9470}, VR: "FL", Name: "TableHeadTiltAngle", VM: "1"}
+	tagDict[Tag{0x0018, 0x9471}] = TagInfo{Tag: Tag{0x0018, 0x9471}, VR: "FL", Name: "TableCradleTiltAngle", VM: "1"}
+	tagDict[Tag{0x0018, 0x9472}] = TagInfo{Tag: Tag{0x0018, 0x9472}, VR: "SQ", Name: "FrameDisplayShutterSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9473}] = TagInfo{Tag: Tag{0x0018, 0x9473}, VR: "FL", Name: "AcquiredImageAreaDoseProduct", VM: "1"}
+	tagDict[Tag{0x0018, 0x9474}] = TagInfo{Tag: Tag{0x0018, 0x9474}, VR: "CS", Name: "CArmPositionerTabletopRelationship", VM: "1"}
+	tagDict[Tag{0x0018, 0x9476}] = TagInfo{Tag: Tag{0x0018, 0x9476}, VR: "SQ", Name: "XRayGeometrySequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9477}] = TagInfo{Tag: Tag{0x0018, 0x9477}, VR: "SQ", Name: "IrradiationEventIdentificationSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9504}] = TagInfo{Tag: Tag{0x0018, 0x9504}, VR: "SQ", Name: "XRay3DFrameTypeSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9506}] = TagInfo{Tag: Tag{0x0018, 0x9506}, VR: "SQ", Name: "ContributingSourcesSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9507}] = TagInfo{Tag: Tag{0x0018, 0x9507}, VR: "SQ", Name: "XRay3DAcquisitionSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9508}] = TagInfo{Tag: Tag{0x0018, 0x9508}, VR: "FL", Name: "PrimaryPositionerScanArc", VM: "1"}
+	tagDict[Tag{0x0018, 0x9509}] = TagInfo{Tag: Tag{0x0018, 0x9509}, VR: "FL", Name: "SecondaryPositionerScanArc", VM: "1"}
+	tagDict[Tag{0x0018, 0x9510}] = TagInfo{Tag: Tag{0x0018, 0x9510}, VR: "FL", Name: "PrimaryPositionerScanStartAngle", VM: "1"}
+	tagDict[Tag{0x0018, 0x9511}] = TagInfo{Tag: Tag{0x0018, 0x9511}, VR: "FL", Name: "SecondaryPositionerScanStartAngle", VM: "1"}
+	tagDict[Tag{0x0018, 0x9514}] = TagInfo{Tag: Tag{0x0018, 0x9514}, VR: "FL", Name: "PrimaryPositionerIncrement", VM: "1"}
+	tagDict[Tag{0x0018, 0x9515}] = TagInfo{Tag: Tag{0x0018, 0x9515}, VR: "FL", Name: "SecondaryPositionerIncrement", VM: "1"}
+	tagDict[Tag{0x0018, 0x9516}] = TagInfo{Tag: Tag{0x0018, 0x9516}, VR: "DT", Name: "StartAcquisitionDateTime", VM: "1"}
+	tagDict[Tag{0x0018, 0x9517}] = TagInfo{Tag: Tag{0x0018, 0x9517}, VR: "DT", Name: "EndAcquisitionDateTime", VM: "1"}
+	tagDict[Tag{0x0018, 0x9524}] = TagInfo{Tag: Tag{0x0018, 0x9524}, VR: "LO", Name: "ApplicationName", VM: "1"}
+	tagDict[Tag{0x0018, 0x9525}] = TagInfo{Tag: Tag{0x0018, 0x9525}, VR: "LO", Name: "ApplicationVersion", VM: "1"}
+	tagDict[Tag{0x0018, 0x9526}] = TagInfo{Tag: Tag{0x0018, 0x9526}, VR: "LO", Name: "ApplicationManufacturer", VM: "1"}
+	tagDict[Tag{0x0018, 0x9527}] = TagInfo{Tag: Tag{0x0018, 0x9527}, VR: "CS", Name: "AlgorithmType", VM: "1"}
+	tagDict[Tag{0x0018, 0x9528}] = TagInfo{Tag: Tag{0x0018, 0x9528}, VR: "LO", Name: "AlgorithmDescription", VM: "1"}
+	tagDict[Tag{0x0018, 0x9530}] = TagInfo{Tag: Tag{0x0018, 0x9530}, VR: "SQ", Name: "XRay3DReconstructionSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9531}] = TagInfo{Tag: Tag{0x0018, 0x9531}, VR: "LO", Name: "ReconstructionDescription", VM: "1"}
+	tagDict[Tag{0x0018, 0x9538}] = TagInfo{Tag: Tag{0x0018, 0x9538}, VR: "SQ", Name: "PerProjectionAcquisitionSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9601}] = TagInfo{Tag: Tag{0x0018, 0x9601}, VR: "SQ", Name: "DiffusionBMatrixSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9602}] = TagInfo{Tag: Tag{0x0018, 0x9602}, VR: "FD", Name: "DiffusionBValueXX", VM: "1"}
+	tagDict[Tag{0x0018, 0x9603}] = TagInfo{Tag: Tag{0x0018, 0x9603}, VR: "FD", Name: "DiffusionBValueXY", VM: "1"}
+	tagDict[Tag{0x0018, 0x9604}] = TagInfo{Tag: Tag{0x0018, 0x9604}, VR: "FD", Name: "DiffusionBValueXZ", VM: "1"}
+	tagDict[Tag{0x0018, 0x9605}] = TagInfo{Tag: Tag{0x0018, 0x9605}, VR: "FD", Name: "DiffusionBValueYY", VM: "1"}
+	tagDict[Tag{0x0018, 0x9606}] = TagInfo{Tag: Tag{0x0018, 0x9606}, VR: "FD", Name: "DiffusionBValueYZ", VM: "1"}
+	tagDict[Tag{0x0018, 0x9607}] = TagInfo{Tag: Tag{0x0018, 0x9607}, VR: "FD", Name: "DiffusionBValueZZ", VM: "1"}
+	tagDict[Tag{0x0018, 0x9701}] = TagInfo{Tag: Tag{0x0018, 0x9701}, VR: "DT", Name: "DecayCorrectionDateTime", VM: "1"}
+	tagDict[Tag{0x0018, 0x9715}] = TagInfo{Tag: Tag{0x0018, 0x9715}, VR: "FD", Name: "StartDensityThreshold", VM: "1"}
+	tagDict[Tag{0x0018, 0x9716}] = TagInfo{Tag: Tag{0x0018, 0x9716}, VR: "FD", Name: "StartRelativeDensityDifferenceThreshold", VM: "1"}
+	tagDict[Tag{0x0018, 0x9717}] = TagInfo{Tag: Tag{0x0018, 0x9717}, VR: "FD", Name: "StartCardiacTriggerCountThreshold", VM: "1"}
+	tagDict[Tag{0x0018, 0x9718}] = TagInfo{Tag: Tag{0x0018, 0x9718}, VR: "FD", Name: "StartRespiratoryTriggerCountThreshold", VM: "1"}
+	tagDict[Tag{0x0018, 0x9719}] = TagInfo{Tag: Tag{0x0018, 0x9719}, VR: "FD", Name: "TerminationCountsThreshold", VM: "1"}
+	tagDict[Tag{0x0018, 0x9720}] = TagInfo{Tag: Tag{0x0018, 0x9720}, VR: "FD", Name: "TerminationDensityThreshold", VM: "1"}
+	tagDict[Tag{0x0018, 0x9721}] = TagInfo{Tag: Tag{0x0018, 0x9721}, VR: "FD", Name: "TerminationRelativeDensityThreshold", VM: "1"}
+	tagDict[Tag{0x0018, 0x9722}] = TagInfo{Tag: Tag{0x0018, 0x9722}, VR: "FD", Name: "TerminationTimeThreshold", VM: "1"}
+	tagDict[Tag{0x0018, 0x9723}] = TagInfo{Tag: Tag{0x0018, 0x9723}, VR: "FD", Name: "TerminationCardiacTriggerCountThreshold", VM: "1"}
+	tagDict[Tag{0x0018, 0x9724}] = TagInfo{Tag: Tag{0x0018, 0x9724}, VR: "FD", Name: "TerminationRespiratoryTriggerCountThreshold", VM: "1"}
+	tagDict[Tag{0x0018, 0x9725}] = TagInfo{Tag: Tag{0x0018, 0x9725}, VR: "CS", Name: "DetectorGeometry", VM: "1"}
+	tagDict[Tag{0x0018, 0x9726}] = TagInfo{Tag: Tag{0x0018, 0x9726}, VR: "FD", Name: "TransverseDetectorSeparation", VM: "1"}
+	tagDict[Tag{0x0018, 0x9727}] = TagInfo{Tag: Tag{0x0018, 0x9727}, VR: "FD", Name: "AxialDetectorDimension", VM: "1"}
+	tagDict[Tag{0x0018, 0x9729}] = TagInfo{Tag: Tag{0x0018, 0x9729}, VR: "US", Name: "RadiopharmaceuticalAgentNumber", VM: "1"}
+	tagDict[Tag{0x0018, 0x9732}] = TagInfo{Tag: Tag{0x0018, 0x9732}, VR: "SQ", Name: "PETFrameAcquisitionSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9733}] = TagInfo{Tag: Tag{0x0018, 0x9733}, VR: "SQ", Name: "PETDetectorMotionDetailsSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9734}] = TagInfo{Tag: Tag{0x0018, 0x9734}, VR: "SQ", Name: "PETTableDynamicsSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9735}] = TagInfo{Tag: Tag{0x0018, 0x9735}, VR: "SQ", Name: "PETPositionSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9736}] = TagInfo{Tag: Tag{0x0018, 0x9736}, VR: "SQ", Name: "PETFrameCorrectionFactorsSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9737}] = TagInfo{Tag: Tag{0x0018, 0x9737}, VR: "SQ", Name: "RadiopharmaceuticalUsageSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9738}] = TagInfo{Tag: Tag{0x0018, 0x9738}, VR: "CS", Name: "AttenuationCorrectionSource", VM: "1"}
+	tagDict[Tag{0x0018, 0x9739}] = TagInfo{Tag: Tag{0x0018, 0x9739}, VR: "US", Name: "NumberOfIterations", VM: "1"}
+	tagDict[Tag{0x0018, 0x9740}] = TagInfo{Tag: Tag{0x0018, 0x9740}, VR: "US", Name: "NumberOfSubsets", VM: "1"}
+	tagDict[Tag{0x0018, 0x9749}] = TagInfo{Tag: Tag{0x0018, 0x9749}, VR: "SQ", Name: "PETReconstructionSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9751}] = TagInfo{Tag: Tag{0x0018, 0x9751}, VR: "SQ", Name: "PETFrameTypeSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9755}] = TagInfo{Tag: Tag{0x0018, 0x9755}, VR: "CS", Name: "TimeOfFlightInformationUsed", VM: "1"}
+	tagDict[Tag{0x0018, 0x9756}] = TagInfo{Tag: Tag{0x0018, 0x9756}, VR: "CS", Name: "ReconstructionType", VM: "1"}
+	tagDict[Tag{0x0018, 0x9758}] = TagInfo{Tag: Tag{0x0018, 0x9758}, VR: "CS", Name: "DecayCorrected", VM: "1"}
+	tagDict[Tag{0x0018, 0x9759}] = TagInfo{Tag: Tag{0x0018, 0x9759}, VR: "CS", Name: "AttenuationCorrected", VM: "1"}
+	tagDict[Tag{0x0018, 0x9760}] = TagInfo{Tag: Tag{0x0018, 0x9760}, VR: "CS", Name: "ScatterCorrected", VM: "1"}
+	tagDict[Tag{0x0018, 0x9761}] = TagInfo{Tag: Tag{0x0018, 0x9761}, VR: "CS", Name: "DeadTimeCorrected", VM: "1"}
+	tagDict[Tag{0x0018, 0x9762}] = TagInfo{Tag: Tag{0x0018, 0x9762}, VR: "CS", Name: "GantryMotionCorrected", VM: "1"}
+	tagDict[Tag{0x0018, 0x9763}] = TagInfo{Tag: Tag{0x0018, 0x9763}, VR: "CS", Name: "PatientMotionCorrected", VM: "1"}
+	tagDict[Tag{0x0018, 0x9764}] = TagInfo{Tag: Tag{0x0018, 0x9764}, VR: "CS", Name: "CountLossNormalizationCorrected", VM: "1"}
+	tagDict[Tag{0x0018, 0x9765}] = TagInfo{Tag: Tag{0x0018, 0x9765}, VR: "CS", Name: "RandomsCorrected", VM: "1"}
+	tagDict[Tag{0x0018, 0x9766}] = TagInfo{Tag: Tag{0x0018, 0x9766}, VR: "CS", Name: "NonUniformRadialSamplingCorrected", VM: "1"}
+	tagDict[Tag{0x0018, 0x9767}] = TagInfo{Tag: Tag{0x0018, 0x9767}, VR: "CS", Name: "SensitivityCalibrated", VM: "1"}
+	tagDict[Tag{0x0018, 0x9768}] = TagInfo{Tag: Tag{0x0018, 0x9768}, VR: "CS", Name: "DetectorNormalizationCorrection", VM: "1"}
+	tagDict[Tag{0x0018, 0x9769}] = TagInfo{Tag: Tag{0x0018, 0x9769}, VR: "CS", Name: "IterativeReconstructionMethod", VM: "1"}
+	tagDict[Tag{0x0018, 0x9770}] = TagInfo{Tag: Tag{0x0018, 0x9770}, VR: "CS", Name: "AttenuationCorrectionTemporalRelationship", VM: "1"}
+	tagDict[Tag{0x0018, 0x9771}] = TagInfo{Tag: Tag{0x0018, 0x9771}, VR: "SQ", Name: "PatientPhysiologicalStateSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9772}] = TagInfo{Tag: Tag{0x0018, 0x9772}, VR: "SQ", Name: "PatientPhysiologicalStateCodeSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9801}] = TagInfo{Tag: Tag{0x0018, 0x9801}, VR: "FD", Name: "DepthsOfFocus", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x9803}] = TagInfo{Tag: Tag{0x0018, 0x9803}, VR: "SQ", Name: "ExcludedIntervalsSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9804}] = TagInfo{Tag: Tag{0x0018, 0x9804}, VR: "DT", Name: "ExclusionStartDatetime", VM: "1"}
+	tagDict[Tag{0x0018, 0x9805}] = TagInfo{Tag: Tag{0x0018, 0x9805}, VR: "FD", Name: "ExclusionDuration", VM: "1"}
+	tagDict[Tag{0x0018, 0x9806}] = TagInfo{Tag: Tag{0x0018, 0x9806}, VR: "SQ", Name: "USImageDescriptionSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9807}] = TagInfo{Tag: Tag{0x0018, 0x9807}, VR: "SQ", Name: "ImageDataTypeSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x9808}] = TagInfo{Tag: Tag{0x0018, 0x9808}, VR: "CS", Name: "DataType", VM: "1"}
+	tagDict[Tag{0x0018, 0x9809}] = TagInfo{Tag: Tag{0x0018, 0x9809}, VR: "SQ", Name: "TransducerScanPatternCodeSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x980B}] = TagInfo{Tag: Tag{0x0018, 0x980B}, VR: "CS", Name: "AliasedDataType", VM: "1"}
+	tagDict[Tag{0x0018, 0x980C}] = TagInfo{Tag: Tag{0x0018, 0x980C}, VR: "CS", Name: "PositionMeasuringDeviceUsed", VM: "1"}
+	tagDict[Tag{0x0018, 0x980D}] = TagInfo{Tag: Tag{0x0018, 0x980D}, VR: "SQ", Name: "TransducerGeometryCodeSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x980E}] = TagInfo{Tag: Tag{0x0018, 0x980E}, VR: "SQ", Name: "TransducerBeamSteeringCodeSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0x980F}] = TagInfo{Tag: Tag{0x0018, 0x980F}, VR: "SQ", Name: "TransducerApplicationCodeSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0xA001}] = TagInfo{Tag: Tag{0x0018, 0xA001}, VR: "SQ", Name: "ContributingEquipmentSequence", VM: "1"}
+	tagDict[Tag{0x0018, 0xA002}] = TagInfo{Tag: Tag{0x0018, 0xA002}, VR: "DT", Name: "ContributionDateTime", VM: "1"}
+	tagDict[Tag{0x0018, 0xA003}] = TagInfo{Tag: Tag{0x0018, 0xA003}, VR: "ST", Name: "ContributionDescription", VM: "1"}
+	tagDict[Tag{0x0020, 0x000D}] = TagInfo{Tag: Tag{0x0020, 0x000D}, VR: "UI", Name: "StudyInstanceUID", VM: "1"}
+	tagDict[Tag{0x0020, 0x000E}] = TagInfo{Tag: Tag{0x0020, 0x000E}, VR: "UI", Name: "SeriesInstanceUID", VM: "1"}
+	tagDict[Tag{0x0020, 0x0010}] = TagInfo{Tag: Tag{0x0020, 0x0010}, VR: "SH", Name: "StudyID", VM: "1"}
+	tagDict[Tag{0x0020, 0x0011}] = TagInfo{Tag: Tag{0x0020, 0x0011}, VR: "IS", Name: "SeriesNumber", VM: "1"}
+	tagDict[Tag{0x0020, 0x0012}] = TagInfo{Tag: Tag{0x0020, 0x0012}, VR: "IS", Name: "AcquisitionNumber", VM: "1"}
+	tagDict[Tag{0x0020, 0x0013}] = TagInfo{Tag: Tag{0x0020, 0x0013}, VR: "IS", Name: "InstanceNumber", VM: "1"}
+	tagDict[Tag{0x0020, 0x0019}] = TagInfo{Tag: Tag{0x0020, 0x0019}, VR: "IS", Name: "ItemNumber", VM: "1"}
+	tagDict[Tag{0x0020, 0x0020}] = TagInfo{Tag: Tag{0x0020, 0x0020}, VR: "CS", Name: "PatientOrientation", VM: "2"}
+	tagDict[Tag{0x0020, 0x0032}] = TagInfo{Tag: Tag{0x0020, 0x0032}, VR: "DS", Name: "ImagePositionPatient", VM: "3"}
+	tagDict[Tag{0x0020, 0x0037}] = TagInfo{Tag: Tag{0x0020, 0x0037}, VR: "DS", Name: "ImageOrientationPatient", VM: "6"}
+	tagDict[Tag{0x0020, 0x0052}] = TagInfo{Tag: Tag{0x0020, 0x0052}, VR: "UI", Name: "FrameOfReferenceUID", VM: "1"}
+	tagDict[Tag{0x0020, 0x0060}] = TagInfo{Tag: Tag{0x0020, 0x0060}, VR: "CS", Name: "Laterality", VM: "1"}
+	tagDict[Tag{0x0020, 0x0062}] = TagInfo{Tag: Tag{0x0020, 0x0062}, VR: "CS", Name: "ImageLaterality", VM: "1"}
+	tagDict[Tag{0x0020, 0x0100}] = TagInfo{Tag: Tag{0x0020, 0x0100}, VR: "IS", Name: "TemporalPositionIdentifier", VM: "1"}
+	tagDict[Tag{0x0020, 0x0105}] = TagInfo{Tag: Tag{0x0020, 0x0105}, VR: "IS", Name: "NumberOfTemporalPositions", VM: "1"}
+	tagDict[Tag{0x0020, 0x0110}] = TagInfo{Tag: Tag{0x0020, 0x0110}, VR: "DS", Name: "TemporalResolution", VM: "1"}
+	tagDict[Tag{0x0020, 0x0200}] = TagInfo{Tag: Tag{0x0020, 0x0200}, VR: "UI", Name: "SynchronizationFrameOfReferenceUID", VM: "1"}
+	tagDict[Tag{0x0020, 0x0242}] = TagInfo{Tag: Tag{0x0020, 0x0242}, VR: "UI", Name: "SOPInstanceUIDOfConcatenationSource", VM: "1"}
+	tagDict[Tag{0x0020, 0x1002}] = TagInfo{Tag: Tag{0x0020, 0x1002}, VR: "IS", Name: "ImagesInAcquisition", VM: "1"}
+	tagDict[Tag{0x0020, 0x1040}] = TagInfo{Tag: Tag{0x0020, 0x1040}, VR: "LO", Name: "PositionReferenceIndicator", VM: "1"}
+	tagDict[Tag{0x0020, 0x1041}] = TagInfo{Tag: Tag{0x0020, 0x1041}, VR: "DS", Name: "SliceLocation", VM: "1"}
+	tagDict[Tag{0x0020, 0x1200}] = TagInfo{Tag: Tag{0x0020, 0x1200}, VR: "IS", Name: "NumberOfPatientRelatedStudies", VM: "1"}
+	tagDict[Tag{0x0020, 0x1202}] = TagInfo{Tag: Tag{0x0020, 0x1202}, VR: "IS", Name: "NumberOfPatientRelatedSeries", VM: "1"}
+	tagDict[Tag{0x0020, 0x1204}] = TagInfo{Tag: Tag{0x0020, 0x1204}, VR: "IS", Name: "NumberOfPatientRelatedInstances", VM: "1"}
+	tagDict[Tag{0x0020, 0x1206}] = TagInfo{Tag: Tag{0x0020, 0x1206}, VR: "IS", Name: "NumberOfStudyRelatedSeries", VM: "1"}
+	tagDict[Tag{0x0020, 0x1208}] = TagInfo{Tag: Tag{0x0020, 0x1208}, VR: "IS", Name: "NumberOfStudyRelatedInstances", VM: "1"}
+	tagDict[Tag{0x0020, 0x1209}] = TagInfo{Tag: Tag{0x0020, 0x1209}, VR: "IS", Name: "NumberOfSeriesRelatedInstances", VM: "1"}
+	tagDict[Tag{0x0020, 0x4000}] = TagInfo{Tag: Tag{0x0020, 0x4000}, VR: "LT", Name: "ImageComments", VM: "1"}
+	tagDict[Tag{0x0020, 0x9056}] = TagInfo{Tag: Tag{0x0020, 0x9056}, VR: "SH", Name: "StackID", VM: "1"}
+	tagDict[Tag{0x0020, 0x9057}] = TagInfo{Tag: Tag{0x0020, 0x9057}, VR: "UL", Name: "InStackPositionNumber", VM: "1"}
+	tagDict[Tag{0x0020, 0x9071}] = TagInfo{Tag: Tag{0x0020, 0x9071}, VR: "SQ", Name: "FrameAnatomySequence", VM: "1"}
+	tagDict[Tag{0x0020, 0x9072}] = TagInfo{Tag: Tag{0x0020, 0x9072}, VR: "CS", Name: "FrameLaterality", VM: "1"}
+	tagDict[Tag{0x0020, 0x9111}] = TagInfo{Tag: Tag{0x0020, 0x9111}, VR: "SQ", Name: "FrameContentSequence", VM: "1"}
+	tagDict[Tag{0x0020, 0x9113}] = TagInfo{Tag: Tag{0x0020, 0x9113}, VR: "SQ", Name: "PlanePositionSequence", VM: "1"}
+	tagDict[Tag{0x0020, 0x9116}] = TagInfo{Tag: Tag{0x0020, 0x9116}, VR: "SQ", Name: "PlaneOrientationSequence", VM: "1"}
+	tagDict[Tag{0x0020, 0x9128}] = TagInfo{Tag: Tag{0x0020, 0x9128}, VR: "UL", Name: "TemporalPositionIndex", VM: "1"}
+	tagDict[Tag{0x0020, 0x9153}] = TagInfo{Tag: Tag{0x0020, 0x9153}, VR: "FD", Name: "NominalCardiacTriggerDelayTime", VM: "1"}
+	tagDict[Tag{0x0020, 0x9154}] = TagInfo{Tag: Tag{0x0020, 0x9154}, VR: "FL", Name: "NominalCardiacTriggerTimePriorToRPeak", VM: "1"}
+	tagDict[Tag{0x0020, 0x9155}] = TagInfo{Tag: Tag{0x0020, 0x9155}, VR: "FL", Name: "ActualCardiacTriggerTimePriorToRPeak", VM: "1"}
+	tagDict[Tag{0x0020, 0x9156}] = TagInfo{Tag: Tag{0x0020, 0x9156}, VR: "US", Name: "FrameAcquisitionNumber", VM: "1"}
+	tagDict[Tag{0x0020, 0x9157}] = TagInfo{Tag: Tag{0x0020, 0x9157}, VR: "UL", Name: "DimensionIndexValues", VM: "1-n"}
+	tagDict[Tag{0x0020, 0x9158}] = TagInfo{Tag: Tag{0x0020, 0x9158}, VR: "LT", Name: "FrameComments", VM: "1"}
+	tagDict[Tag{0x0020, 0x9161}] = TagInfo{Tag: Tag{0x0020, 0x9161}, VR: "UI", Name: "ConcatenationUID", VM: "1"}
+	tagDict[Tag{0x0020, 0x9162}] = TagInfo{Tag: Tag{0x0020, 0x9162}, VR: "US", Name: "InConcatenationNumber", VM: "1"}
+	tagDict[Tag{0x0020, 0x9163}] = TagInfo{Tag: Tag{0x0020, 0x9163}, VR: "US", Name: "InConcatenationTotalNumber", VM: "1"}
+	tagDict[Tag{0x0020, 0x9164}] = TagInfo{Tag: Tag{0x0020, 0x9164}, VR: "UI", Name: "DimensionOrganizationUID", VM: "1"}
+	tagDict[Tag{0x0020, 0x9165}] = TagInfo{Tag: Tag{0x0020, 0x9165}, VR: "AT", Name: "DimensionIndexPointer", VM: "1"}
+	tagDict[Tag{0x0020, 0x9167}] = TagInfo{Tag: Tag{0x0020, 0x9167}, VR: "AT", Name: "FunctionalGroupPointer", VM: "1"}
+	tagDict[Tag{0x0020, 0x9213}] = TagInfo{Tag: Tag{0x0020, 0x9213}, VR: "LO", Name: "DimensionIndexPrivateCreator", VM: "1"}
+	tagDict[Tag{0x0020, 0x9221}] = TagInfo{Tag: Tag{0x0020, 0x9221}, VR: "SQ", Name: "DimensionOrganizationSequence", VM: "1"}
+	tagDict[Tag{0x0020, 0x9222}] = TagInfo{Tag: Tag{0x0020, 0x9222}, VR: "SQ", Name: "DimensionIndexSequence", VM: "1"}
+	tagDict[Tag{0x0020, 0x9228}] = TagInfo{Tag: Tag{0x0020, 0x9228}, VR: "UL", Name: "ConcatenationFrameOffsetNumber", VM: "1"}
+	tagDict[Tag{0x0020, 0x9238}] = TagInfo{Tag: Tag{0x0020, 0x9238}, VR: "LO", Name: "FunctionalGroupPrivateCreator", VM: "1"}
+	tagDict[Tag{0x0020, 0x9241}] = TagInfo{Tag: Tag{0x0020, 0x9241}, VR: "FL", Name: "NominalPercentageOfCardiacPhase", VM: "1"}
+	tagDict[Tag{0x0020, 0x9245}] = TagInfo{Tag: Tag{0x0020, 0x9245}, VR: "FL", Name: "NominalPercentageOfRespiratoryPhase", VM: "1"}
+	tagDict[Tag{0x0020, 0x9246}] = TagInfo{Tag: Tag{0x0020, 0x9246}, VR: "FL", Name: "StartingRespiratoryAmplitude", VM: "1"}
+	tagDict[Tag{0x0020, 0x9247}] = TagInfo{Tag: Tag{0x0020, 0x9247}, VR: "CS", Name: "StartingRespiratoryPhase", VM: "1"}
+	tagDict[Tag{0x0020, 0x9248}] = TagInfo{Tag: Tag{0x0020, 0x9248}, VR: "FL", Name: "EndingRespiratoryAmplitude", VM: "1"}
+	tagDict[Tag{0x0020, 0x9249}] = TagInfo{Tag: Tag{0x0020, 0x9249}, VR: "CS", Name: "EndingRespiratoryPhase", VM: "1"}
+	tagDict[Tag{0x0020, 0x9250}] = TagInfo{Tag: Tag{0x0020, 0x9250}, VR: "CS", Name: "RespiratoryTriggerType", VM: "1"}
+	tagDict[Tag{0x0020, 0x9251}] = TagInfo{Tag: Tag{0x0020, 0x9251}, VR: "FD", Name: "RRIntervalTimeNominal", VM: "1"}
+	tagDict[Tag{0x0020, 0x9252}] = TagInfo{Tag: Tag{0x0020, 0x9252}, VR: "FD", Name: "ActualCardiacTriggerDelayTime", VM: "1"}
+	tagDict[Tag{0x0020, 0x9253}] = TagInfo{Tag: Tag{0x0020, 0x9253}, VR: "SQ", Name: "RespiratorySynchronizationSequence", VM: "1"}
+	tagDict[Tag{0x0020, 0x9254}] = TagInfo{Tag: Tag{0x0020, 0x9254}, VR: "FD", Name: "RespiratoryIntervalTime", VM: "1"}
+	tagDict[Tag{0x0020, 0x9255}] = TagInfo{Tag: Tag{0x0020, 0x9255}, VR: "FD", Name: "NominalRespiratoryTriggerDelayTime", VM: "1"}
+	tagDict[Tag{0x0020, 0x9256}] = TagInfo{Tag: Tag{0x0020, 0x9256}, VR: "FD", Name: "RespiratoryTriggerDelayThreshold", VM: "1"}
+	tagDict[Tag{0x0020, 0x9257}] = TagInfo{Tag: Tag{0x0020, 0x9257}, VR: "FD", Name: "ActualRespiratoryTriggerDelayTime", VM: "1"}
+	tagDict[Tag{0x0020, 0x9301}] = TagInfo{Tag: Tag{0x0020, 0x9301}, VR: "FD", Name: "ImagePositionVolume", VM: "3"}
+	tagDict[Tag{0x0020, 0x9302}] = TagInfo{Tag: Tag{0x0020, 0x9302}, VR: "FD", Name: "ImageOrientationVolume", VM: "6"}
+	tagDict[Tag{0x0020, 0x9307}] = TagInfo{Tag: Tag{0x0020, 0x9307}, VR: "CS", Name: "UltrasoundAcquisitionGeometry", VM: "1"}
+	tagDict[Tag{0x0020, 0x9308}] = TagInfo{Tag: Tag{0x0020, 0x9308}, VR: "FD", Name: "ApexPosition", VM: "3"}
+	tagDict[Tag{0x0020, 0x9309}] = TagInfo{Tag: Tag{0x0020, 0x9309}, VR: "FD", Name: "VolumeToTransducerMappingMatrix", VM: "16"}
+	tagDict[Tag{0x0020, 0x930A}] = TagInfo{Tag: Tag{0x0020, 0x930A}, VR: "FD", Name: "VolumeToTableMappingMatrix", VM: "16"}
+	tagDict[Tag{0x0020, 0x930C}] = TagInfo{Tag: Tag{0x0020, 0x930C}, VR: "CS", Name: "PatientFrameOfReferenceSource", VM: "1"}
+	tagDict[Tag{0x0020, 0x930D}] = TagInfo{Tag: Tag{0x0020, 0x930D}, VR: "FD", Name: "TemporalPositionTimeOffset", VM: "1"}
+	tagDict[Tag{0x0020, 0x930E}] = TagInfo{Tag: Tag{0x0020, 0x930E}, VR: "SQ", Name: "PlanePositionVolumeSequence", VM: "1"}
+	tagDict[Tag{0x0020, 0x930F}] = TagInfo{Tag: Tag{0x0020, 0x930F}, VR: "SQ", Name: "PlaneOrientationVolumeSequence", VM: "1"}
+	tagDict[Tag{0x0020, 0x9310}] = TagInfo{Tag: Tag{0x0020, 0x9310}, VR: "SQ", Name: "TemporalPositionSequence", VM: "1"}
+	tagDict[Tag{0x0020, 0x9311}] = TagInfo{Tag: Tag{0x0020, 0x9311}, VR: "CS", Name: "DimensionOrganizationType", VM: "1"}
+	tagDict[Tag{0x0020, 0x9312}] = TagInfo{Tag: Tag{0x0020, 0x9312}, VR: "UI", Name: "VolumeFrameOfReferenceUID", VM: "1"}
+	tagDict[Tag{0x0020, 0x9313}] = TagInfo{Tag: Tag{0x0020, 0x9313}, VR: "UI", Name: "TableFrameOfReferenceUID", VM: "1"}
+	tagDict[Tag{0x0020, 0x9421}] = TagInfo{Tag: Tag{0x0020, 0x9421}, VR: "LO", Name: "DimensionDescriptionLabel", VM: "1"}
+	tagDict[Tag{0x0020, 0x9450}] = TagInfo{Tag: Tag{0x0020, 0x9450}, VR: "SQ", Name: "PatientOrientationInFrameSequence", VM: "1"}
+	tagDict[Tag{0x0020, 0x9453}] = TagInfo{Tag: Tag{0x0020, 0x9453}, VR: "LO", Name: "FrameLabel", VM: "1"}
+	tagDict[Tag{0x0020, 0x9518}] = TagInfo{Tag: Tag{0x0020, 0x9518}, VR: "US", Name: "AcquisitionIndex", VM: "1-n"}
+	tagDict[Tag{0x0020, 0x9529}] = TagInfo{Tag: Tag{0x0020, 0x9529}, VR: "SQ", Name: "ContributingSOPInstancesReferenceSequence", VM: "1"}
+	tagDict[Tag{0x0020, 0x9536}] = TagInfo{Tag: Tag{0x0020, 0x9536}, VR: "US", Name: "ReconstructionIndex", VM: "1"}
+	tagDict[Tag{0x0022, 0x0001}] = TagInfo{Tag: Tag{0x0022, 0x0001}, VR: "US", Name: "LightPathFilterPassThroughWavelength", VM: "1"}
+	tagDict[Tag{0x0022, 0x0002}] = TagInfo{Tag: Tag{0x0022, 0x0002}, VR: "US", Name: "LightPathFilterPassBand", VM: "2"}
+	tagDict[Tag{0x0022, 0x0003}] = TagInfo{Tag: Tag{0x0022, 0x0003}, VR: "US", Name: "ImagePathFilterPassThroughWavelength", VM: "1"}
+	tagDict[Tag{0x0022, 0x0004}] = TagInfo{Tag: Tag{0x0022, 0x0004}, VR: "US", Name: "ImagePathFilterPassBand", VM: "2"}
+	tagDict[Tag{0x0022, 0x0005}] = TagInfo{Tag: Tag{0x0022, 0x0005}, VR: "CS", Name: "PatientEyeMovementCommanded", VM: "1"}
+	tagDict[Tag{0x0022, 0x0006}] = TagInfo{Tag: Tag{0x0022, 0x0006}, VR: "SQ", Name: "PatientEyeMovementCommandCodeSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x0007}] = TagInfo{Tag: Tag{0x0022, 0x0007}, VR: "FL", Name: "SphericalLensPower", VM: "1"}
+	tagDict[Tag{0x0022, 0x0008}] = TagInfo{Tag: Tag{0x0022, 0x0008}, VR: "FL", Name: "CylinderLensPower", VM: "1"}
+	tagDict[Tag{0x0022, 0x0009}] = TagInfo{Tag: Tag{0x0022, 0x0009}, VR: "FL", Name: "CylinderAxis", VM: "1"}
+	tagDict[Tag{0x0022, 0x000A}] = TagInfo{Tag: Tag{0x0022, 0x000A}, VR: "FL", Name: "EmmetropicMagnification", VM: "1"}
+	tagDict[Tag{0x0022, 0x000B}] = TagInfo{Tag: Tag{0x0022, 0x000B}, VR: "FL", Name: "IntraOcularPressure", VM: "1"}
+	tagDict[Tag{0x0022, 0x000C}] = TagInfo{Tag: Tag{0x0022, 0x000C}, VR: "FL", Name: "HorizontalFieldOfView", VM: "1"}
+	tagDict[Tag{0x0022, 0x000D}] = TagInfo{Tag: Tag{0x0022, 0x000D}, VR: "CS", Name: "PupilDilated", VM: "1"}
+	tagDict[Tag{0x0022, 0x000E}] = TagInfo{Tag: Tag{0x0022, 0x000E}, VR: "FL", Name: "DegreeOfDilation", VM: "1"}
+	tagDict[Tag{0x0022, 0x0010}] = TagInfo{Tag: Tag{0x0022, 0x0010}, VR: "FL", Name: "StereoBaselineAngle", VM: "1"}
+	tagDict[Tag{0x0022, 0x0011}] = TagInfo{Tag: Tag{0x0022, 0x0011}, VR: "FL", Name: "StereoBaselineDisplacement", VM: "1"}
+	tagDict[Tag{0x0022, 0x0012}] = TagInfo{Tag: Tag{0x0022, 0x0012}, VR: "FL", Name: "StereoHorizontalPixelOffset", VM: "1"}
+	tagDict[Tag{0x0022, 0x0013}] = TagInfo{Tag: Tag{0x0022, 0x0013}, VR: "FL", Name: "StereoVerticalPixelOffset", VM: "1"}
+	tagDict[Tag{0x0022, 0x0014}] = TagInfo{Tag: Tag{0x0022, 0x0014}, VR: "FL", Name: "StereoRotation", VM: "1"}
+	tagDict[Tag{0x0022, 0x0015}] = TagInfo{Tag: Tag{0x0022, 0x0015}, VR: "SQ", Name: "AcquisitionDeviceTypeCodeSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x0016}] = TagInfo{Tag: Tag{0x0022, 0x0016}, VR: "SQ", Name: "IlluminationTypeCodeSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x0017}] = TagInfo{Tag: Tag{0x0022, 0x0017}, VR: "SQ", Name: "LightPathFilterTypeStackCodeSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x0018}] = TagInfo{Tag: Tag{0x0022, 0x0018}, VR: "SQ", Name: "ImagePathFilterTypeStackCodeSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x0019}] = TagInfo{Tag: Tag{0x0022, 0x0019}, VR: "SQ", Name: "LensesCodeSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x001A}] = TagInfo{Tag: Tag{0x0022, 0x001A}, VR: "SQ", Name: "ChannelDescriptionCodeSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x001B}] = TagInfo{Tag: Tag{0x0022, 0x001B}, VR: "SQ", Name: "RefractiveStateSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x001C}] = TagInfo{Tag: Tag{0x0022, 0x001C}, VR: "SQ", Name: "MydriaticAgentCodeSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x001D}] = TagInfo{Tag: Tag{0x0022, 0x001D}, VR: "SQ", Name: "RelativeImagePositionCodeSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x001E}] = TagInfo{Tag: Tag{0x0022, 0x001E}, VR: "FL", Name: "CameraAngleOfView", VM: "1"}
+	tagDict[Tag{0x0022, 0x0020}] = TagInfo{Tag: Tag{0x0022, 0x0020}, VR: "SQ", Name: "StereoPairsSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x0021}] = TagInfo{Tag: Tag{0x0022, 0x0021}, VR: "SQ", Name: "LeftImageSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x0022}] = TagInfo{Tag: Tag{0x0022, 0x0022}, VR: "SQ", Name: "RightImageSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x0030}] = TagInfo{Tag: Tag{0x0022, 0x0030}, VR: "FL", Name: "AxialLengthOfTheEye", VM: "1"}
+	tagDict[Tag{0x0022, 0x0031}] = TagInfo{Tag: Tag{0x0022, 0x0031}, VR: "SQ", Name: "OphthalmicFrameLocationSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x0032}] = TagInfo{Tag: Tag{0x0022, 0x0032}, VR: "FL", Name: "ReferenceCoordinates", VM: "2-2n"}
+	tagDict[Tag{0x0022, 0x0035}] = TagInfo{Tag: Tag{0x0022, 0x0035}, VR: "FL", Name: "DepthSpatialResolution", VM: "1"}
+	tagDict[Tag{0x0022, 0x0036}] = TagInfo{Tag: Tag{0x0022, 0x0036}, VR: "FL", Name: "MaximumDepthDistortion", VM: "1"}
+	tagDict[Tag{0x0022, 0x0037}] = TagInfo{Tag: Tag{0x0022, 0x0037}, VR: "FL", Name: "AlongScanSpatialResolution", VM: "1"}
+	tagDict[Tag{0x0022, 0x0038}] = TagInfo{Tag: Tag{0x0022, 0x0038}, VR: "FL", Name: "MaximumAlongScanDistortion", VM: "1"}
+	tagDict[Tag{0x0022, 0x0039}] = TagInfo{Tag: Tag{0x0022, 0x0039}, VR: "CS", Name: "OphthalmicImageOrientation", VM: "1"}
+	tagDict[Tag{0x0022, 0x0041}] = TagInfo{Tag: Tag{0x0022, 0x0041}, VR: "FL", Name: "DepthOfTransverseImage", VM: "1"}
+	tagDict[Tag{0x0022, 0x0042}] = TagInfo{Tag: Tag{0x0022, 0x0042}, VR: "SQ", Name: "MydriaticAgentConcentrationUnitsSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x0048}] = TagInfo{Tag: Tag{0x0022, 0x0048}, VR: "FL", Name: "AcrossScanSpatialResolution", VM: "1"}
+	tagDict[Tag{0x0022, 0x0049}] = TagInfo{Tag: Tag{0x0022, 0x0049}, VR: "FL", Name: "MaximumAcrossScanDistortion", VM: "1"}
+	tagDict[Tag{0x0022, 0x004E}] = TagInfo{Tag: Tag{0x0022, 0x004E}, VR: "DS", Name: "MydriaticAgentConcentration", VM: "1"}
+	tagDict[Tag{0x0022, 0x0055}] = TagInfo{Tag: Tag{0x0022, 0x0055}, VR: "FL", Name: "IlluminationWaveLength", VM: "1"}
+	tagDict[Tag{0x0022, 0x0056}] = TagInfo{Tag: Tag{0x0022, 0x0056}, VR: "FL", Name: "IlluminationPower", VM: "1"}
+	tagDict[Tag{0x0022, 0x0057}] = TagInfo{Tag: Tag{0x0022, 0x0057}, VR: "FL", Name: "IlluminationBandwidth", VM: "1"}
+	tagDict[Tag{0x0022, 0x0058}] = TagInfo{Tag: Tag{0x0022, 0x0058}, VR: "SQ", Name: "MydriaticAgentSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1007}] = TagInfo{Tag: Tag{0x0022, 0x1007}, VR: "SQ", Name: "OphthalmicAxialMeasurementsRightEyeSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1008}] = TagInfo{Tag: Tag{0x0022, 0x1008}, VR: "SQ", Name: "OphthalmicAxialMeasurementsLeftEyeSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1009}] = TagInfo{Tag: Tag{0x0022, 0x1009}, VR: "CS", Name: "OphthalmicAxialMeasurementsDeviceType", VM: "1"}
+	tagDict[Tag{0x0022, 0x1010}] = TagInfo{Tag: Tag{0x0022, 0x1010}, VR: "CS", Name: "OphthalmicAxialLengthMeasurementsType", VM: "1"}
+	tagDict[Tag{0x0022, 0x1012}] = TagInfo{Tag: Tag{0x0022, 0x1012}, VR: "SQ", Name: "OphthalmicAxialLengthSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1019}] = TagInfo{Tag: Tag{0x0022, 0x1019}, VR: "FL", Name: "OphthalmicAxialLength", VM: "1"}
+	tagDict[Tag{0x0022, 0x1024}] = TagInfo{Tag: Tag{0x0022, 0x1024}, VR: "SQ", Name: "LensStatusCodeSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1025}] = TagInfo{Tag: Tag{0x0022, 0x1025}, VR: "SQ", Name: "VitreousStatusCodeSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1028}] = TagInfo{Tag: Tag{0x0022, 0x1028}, VR: "SQ", Name: "IOLFormulaCodeSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1029}] = TagInfo{Tag: Tag{0x0022, 0x1029}, VR: "LO", Name: "IOLFormulaDetail", VM: "1"}
+	tagDict[Tag{0x0022, 0x1033}] = TagInfo{Tag: Tag{0x0022, 0x1033}, VR: "FL", Name: "KeratometerIndex", VM: "1"}
+	tagDict[Tag{0x0022, 0x1035}] = TagInfo{Tag: Tag{0x0022, 0x1035}, VR: "SQ", Name: "SourceOfOphthalmicAxialLengthCodeSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1037}] = TagInfo{Tag: Tag{0x0022, 0x1037}, VR: "FL", Name: "TargetRefraction", VM: "1"}
+	tagDict[Tag{0x0022, 0x1039}] = TagInfo{Tag: Tag{0x0022, 0x1039}, VR: "CS", Name: "RefractiveProcedureOccurred", VM: "1"}
+	tagDict[Tag{0x0022, 0x1040}] = TagInfo{Tag: Tag{0x0022, 0x1040}, VR: "SQ", Name: "RefractiveSurgeryTypeCodeSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1044}] = TagInfo{Tag: Tag{0x0022, 0x1044}, VR: "SQ", Name: "OphthalmicUltrasoundMethodCodeSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1050}] = TagInfo{Tag: Tag{0x0022, 0x1050}, VR: "SQ", Name: "OphthalmicAxialLengthMeasurementsSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1053}] = TagInfo{Tag: Tag{0x0022, 0x1053}, VR: "FL", Name: "IOLPower", VM: "1"}
+	tagDict[Tag{0x0022, 0x1054}] = TagInfo{Tag: Tag{0x0022, 0x1054}, VR: "FL", Name: "PredictedRefractiveError", VM: "1"}
+	tagDict[Tag{0x0022, 0x1059}] = TagInfo{Tag: Tag{0x0022, 0x1059}, VR: "FL", Name: "OphthalmicAxialLengthVelocity", VM: "1"}
+	tagDict[Tag{0x0022, 0x1065}] = TagInfo{Tag: Tag{0x0022, 0x1065}, VR: "LO", Name: "LensStatusDescription", VM: "1"}
+	tagDict[Tag{0x0022, 0x1066}] = TagInfo{Tag: Tag{0x0022, 0x1066}, VR: "LO", Name: "VitreousStatusDescription", VM: "1"}
+	tagDict[Tag{0x0022, 0x1090}] = TagInfo{Tag: Tag{0x0022, 0x1090}, VR: "SQ", Name: "IOLPowerSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1092}] = TagInfo{Tag: Tag{0x0022, 0x1092}, VR: "SQ", Name: "LensConstantSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1093}] = TagInfo{Tag: Tag{0x0022, 0x1093}, VR: "LO", Name: "IOLManufacturer", VM: "1"}
+	tagDict[Tag{0x0022, 0x1094}] = TagInfo{Tag: Tag{0x0022, 0x1094}, VR: "LO", Name: "LensConstantDescription", VM: "1"}
+	tagDict[Tag{0x0022, 0x1095}] = TagInfo{Tag: Tag{0x0022, 0x1095}, VR: "LO", Name: "ImplantName", VM: "1"}
+	tagDict[Tag{0x0022, 0x1096}] = TagInfo{Tag: Tag{0x0022, 0x1096}, VR: "SQ", Name: "KeratometryMeasurementTypeCodeSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1097}] = TagInfo{Tag: Tag{0x0022, 0x1097}, VR: "LO", Name: "ImplantPartNumber", VM: "1"}
+	tagDict[Tag{0x0022, 0x1100}] = TagInfo{Tag: Tag{0x0022, 0x1100}, VR: "SQ", Name: "ReferencedOphthalmicAxialMeasurementsSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1101}] = TagInfo{Tag: Tag{0x0022, 0x1101}, VR: "SQ", Name: "OphthalmicAxialLengthMeasurementsSegmentNameCodeSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1103}] = TagInfo{Tag: Tag{0x0022, 0x1103}, VR: "SQ", Name: "RefractiveErrorBeforeRefractiveSurgeryCodeSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1121}] = TagInfo{Tag: Tag{0x0022, 0x1121}, VR: "FL", Name: "IOLPowerForExactEmmetropia", VM: "1"}
+	tagDict[Tag{0x0022, 0x1122}] = TagInfo{Tag: Tag{0x0022, 0x1122}, VR: "FL", Name: "IOLPowerForExactTargetRefraction", VM: "1"}
+	tagDict[Tag{0x0022, 0x1125}] = TagInfo{Tag: Tag{0x0022, 0x1125}, VR: "SQ", Name: "AnteriorChamberDepthDefinitionCodeSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1127}] = TagInfo{Tag: Tag{0x0022, 0x1127}, VR: "SQ", Name: "LensThicknessSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1128}] = TagInfo{Tag: Tag{0x0022, 0x1128}, VR: "SQ", Name: "AnteriorChamberDepthSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1130}] = TagInfo{Tag: Tag{0x0022, 0x1130}, VR: "FL", Name: "LensThickness", VM: "1"}
+	tagDict[Tag{0x0022, 0x1131}] = TagInfo{Tag: Tag{0x0022, 0x1131}, VR: "FL", Name: "AnteriorChamberDepth", VM: "1"}
+	tagDict[Tag{0x0022, 0x1132}] = TagInfo{Tag: Tag{0x0022, 0x1132}, VR: "SQ", Name: "SourceOfLensThicknessDataCodeSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1133}] = TagInfo{Tag: Tag{0x0022, 0x1133}, VR: "SQ", Name: "SourceOfAnteriorChamberDepthDataCodeSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1134}] = TagInfo{Tag: Tag{0x0022, 0x1134}, VR: "SQ", Name: "SourceOfRefractiveMeasurementsSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1135}] = TagInfo{Tag: Tag{0x0022, 0x1135}, VR: "SQ", Name: "SourceOfRefractiveMeasurementsCodeSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1140}] = TagInfo{Tag: Tag{0x0022, 0x1140}, VR: "CS", Name: "OphthalmicAxialLengthMeasurementModified", VM: "1"}
+	tagDict[Tag{0x0022, 0x1150}] = TagInfo{Tag: Tag{0x0022, 0x1150}, VR: "SQ", Name: "OphthalmicAxialLengthDataSourceCodeSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1153}] = TagInfo{Tag: Tag{0x0022, 0x1153}, VR: "SQ", Name: "OphthalmicAxialLengthAcquisitionMethodCodeSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1155}] = TagInfo{Tag: Tag{0x0022, 0x1155}, VR: "FL", Name: "SignalToNoiseRatio", VM: "1"}
+	tagDict[Tag{0x0022, 0x1159}] = TagInfo{Tag: Tag{0x0022, 0x1159}, VR: "LO", Name: "OphthalmicAxialLengthDataSourceDescription", VM: "1"}
+	tagDict[Tag{0x0022, 0x1210}] = TagInfo{Tag: Tag{0x0022, 0x1210}, VR: "SQ", Name: "OphthalmicAxialLengthMeasurementsTotalLengthSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1211}] = TagInfo{Tag: Tag{0x0022, 0x1211}, VR: "SQ", Name: "OphthalmicAxialLengthMeasurementsSegmentalLengthSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1212}] = TagInfo{Tag: Tag{0x0022, 0x1212}, VR: "SQ", Name: "OphthalmicAxialLengthMeasurementsLengthSummationSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1220}] = TagInfo{Tag: Tag{0x0022, 0x1220}, VR: "SQ", Name: "UltrasoundOphthalmicAxialLengthMeasurementsSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1225}] = TagInfo{Tag: Tag{0x0022, 0x1225}, VR: "SQ", Name: "OpticalOphthalmicAxialLengthMeasurementsSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1230}] = TagInfo{Tag: Tag{0x0022, 0x1230}, VR: "SQ", Name: "UltrasoundSelectedOphthalmicAxialLengthSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1250}] = TagInfo{Tag: Tag{0x0022, 0x1250}, VR: "SQ", Name: "OphthalmicAxialLengthSelectionMethodCodeSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1255}] = TagInfo{Tag: Tag{0x0022, 0x1255}, VR: "SQ", Name: "OpticalSelectedOphthalmicAxialLengthSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1257}] = TagInfo{Tag: Tag{0x0022, 0x1257}, VR: "SQ", Name: "SelectedSegmentalOphthalmicAxialLengthSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1260}] = TagInfo{Tag: Tag{0x0022, 0x1260}, VR: "SQ", Name: "SelectedTotalOphthalmicAxialLengthSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1262}] = TagInfo{Tag: Tag{0x0022, 0x1262}, VR: "SQ", Name: "OphthalmicAxialLengthQualityMetricSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1265}] = TagInfo{Tag: Tag{0x0022, 0x1265}, VR: "SQ", Name: "OphthalmicAxialLengthQualityMetricTypeCodeSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1273}] = TagInfo{Tag: Tag{0x0022, 0x1273}, VR: "LO", Name: "OphthalmicAxialLengthQualityMetricTypeDescription", VM: "1"}
+	tagDict[Tag{0x0022, 0x1300}] = TagInfo{Tag: Tag{0x0022, 0x1300}, VR: "SQ", Name: "IntraocularLensCalculationsRightEyeSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1310}] = TagInfo{Tag: Tag{0x0022, 0x1310}, VR: "SQ", Name: "IntraocularLensCalculationsLeftEyeSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1330}] = TagInfo{Tag: Tag{0x0022, 0x1330}, VR: "SQ", Name: "ReferencedOphthalmicAxialLengthMeasurementQCImageSequence", VM: "1"}
+	tagDict[Tag{0x0024, 0x0010}] = TagInfo{Tag: Tag{0x0024, 0x0010}, VR: "FL", Name: "VisualFieldHorizontalExtent", VM: "1"}
+	tagDict[Tag{0x0024, 0x0011}] = TagInfo{Tag: Tag{0x0024, 0x0011}, VR: "FL", Name: "VisualFieldVerticalExtent", VM: "1"}
+	tagDict[Tag{0x0024, 0x0012}] = TagInfo{Tag: Tag{0x0024, 0x0012}, VR: "CS", Name: "VisualFieldShape", VM: "1"}
+	tagDict[Tag{0x0024, 0x0016}] = TagInfo{Tag: Tag{0x0024, 0x0016}, VR: "SQ", Name: "ScreeningTestModeCodeSequence", VM: "1"}
+	tagDict[Tag{0x0024, 0x0018}] = TagInfo{Tag: Tag{0x0024, 0x0018}, VR: "FL", Name: "MaximumStimulusLuminance", VM: "1"}
+	tagDict[Tag{0x0024, 0x0020}] = TagInfo{Tag: Tag{0x0024, 0x0020}, VR: "FL", Name: "BackgroundLuminance", VM: "1"}
+	tagDict[Tag{0x0024, 0x0021}] = TagInfo{Tag: Tag{0x0024, 0x0021}, VR: "SQ", Name: "StimulusColorCodeSequence", VM: "1"}
+	tagDict[Tag{0x0024, 0x0024}] = TagInfo{Tag: Tag{0x0024, 0x0024}, VR: "SQ", Name: "BackgroundIlluminationColorCodeSequence", VM: "1"}
+	tagDict[Tag{0x0024, 0x0025}] = TagInfo{Tag: Tag{0x0024, 0x0025}, VR: "FL", Name: "StimulusArea", VM: "1"}
+	tagDict[Tag{0x0024, 0x0028}] = TagInfo{Tag: Tag{0x0024, 0x0028}, VR: "FL", Name: "StimulusPresentationTime", VM: "1"}
+	tagDict[Tag{0x0024, 0x0032}] = TagInfo{Tag: Tag{0x0024, 0x0032}, VR: "SQ", Name: "FixationSequence", VM: "1"}
+	tagDict[Tag{0x0024, 0x0033}] = TagInfo{Tag: Tag{0x0024, 0x0033}, VR: "SQ", Name: "FixationMonitoringCodeSequence", VM: "1"}
+	tagDict[Tag{0x0024, 0x0034}] = TagInfo{Tag: Tag{0x0024, 0x0034}, VR: "SQ", Name: "VisualFieldCatchTrialSequence", VM: "1"}
+	tagDict[Tag{0x0024, 0x0035}] = TagInfo{Tag: Tag{0x0024, 0x0035}, VR: "US", Name: "FixationCheckedQuantity", VM: "1"}
+	tagDict[Tag{0x0024, 0x0036}] = TagInfo{Tag: Tag{0x0024, 0x0036}, VR: "US", Name: "PatientNotProperlyFixatedQuantity", VM: "1"}
+	tagDict[Tag{0x0024, 0x0037}] = TagInfo{Tag: Tag{0x0024, 0x0037}, VR: "CS", Name: "PresentedVisualStimuliDataFlag", VM: "1"}
+	tagDict[Tag{0x0024, 0x0038}] = TagInfo{Tag: Tag{0x0024, 0x0038}, VR: "US", Name: "NumberOfVisualStimuli", VM: "1"}
+	tagDict[Tag{0x0024, 0x0039}] = TagInfo{Tag: Tag{0x0024, 0x0039}, VR: "CS", Name: "ExcessiveFixationLossesDataFlag", VM: "1"}
+	tagDict[Tag{0x0024, 0x0040}] = TagInfo{Tag: Tag{0x0024, 0x0040}, VR: "CS", Name: "ExcessiveFixationLosses", VM: "1"}
+	tagDict[Tag{0x0024, 0x0042}] = TagInfo{Tag: Tag{0x0024, 0x0042}, VR: "US", Name: "StimuliRetestingQuantity", VM: "1"}
+	tagDict[Tag{0x0024, 0x0044}] = TagInfo{Tag: Tag{0x0024, 0x0044}, VR: "LT", Name: "CommentsOnPatientPerformanceOfVisualField", VM: "1"}
+	tagDict[Tag{0x0024, 0x0045}] = TagInfo{Tag: Tag{0x0024, 0x0045}, VR: "CS", Name: "FalseNegativesEstimateFlag", VM: "1"}
+	tagDict[Tag{0x0024, 0x0046}] = TagInfo{Tag: Tag{0x0024, 0x0046}, VR: "FL", Name: "FalseNegativesEstimate", VM: "1"}
+	tagDict[Tag{0x0024, 0x0048}] = TagInfo{Tag: Tag{0x0024, 0x0048}, VR: "US", Name: "NegativeCatchTrialsQuantity", VM: "1"}
+	tagDict[Tag{0x0024, 0x0050}] = TagInfo{Tag: Tag{0x0024, 0x0050}, VR: "US", Name: "FalseNegativesQuantity", VM: "1"}
+	tagDict[Tag{0x0024, 0x0051}] = TagInfo{Tag: Tag{0x0024, 0x0051}, VR: "CS", Name: "ExcessiveFalseNegativesDataFlag", VM: "1"}
+	tagDict[Tag{0x0024, 0x0052}] = TagInfo{Tag: Tag{0x0024, 0x0052}, VR: "CS", Name: "ExcessiveFalseNegatives", VM: "1"}
+	tagDict[Tag{0x0024, 0x0053}] = TagInfo{Tag: Tag{0x0024, 0x0053}, VR: "CS", Name: "FalsePositivesEstimateFlag", VM: "1"}
+	tagDict[Tag{0x0024, 0x0054}] = TagInfo{Tag: Tag{0x0024, 0x0054}, VR: "FL", Name: "FalsePositivesEstimate", VM: "1"}
+	tagDict[Tag{0x0024, 0x0055}] = TagInfo{Tag: Tag{0x0024, 0x0055}, VR: "CS", Name: "CatchTrialsDataFlag", VM: "1"}
+	tagDict[Tag{0x0024, 0x0056}] = TagInfo{Tag: Tag{0x0024, 0x0056}, VR: "US", Name: "PositiveCatchTrialsQuantity", VM: "1"}
+	tagDict[Tag{0x0024, 0x0057}] = TagInfo{Tag: Tag{0x0024, 0x0057}, VR: "CS", Name: "TestPointNormalsDataFlag", VM: "1"}
+	tagDict[Tag{0x0024, 0x0058}] = TagInfo{Tag: Tag{0x0024, 0x0058}, VR: "SQ", Name: "TestPointNormalsSequence", VM: "1"}
+	tagDict[Tag{0x0024, 0x0059}] = TagInfo{Tag: Tag{0x0024, 0x0059}, VR: "CS", Name: "GlobalDeviationProbabilityNormalsFlag", VM: "1"}
+	tagDict[Tag{0x0024, 0x0060}] = TagInfo{Tag: Tag{0x0024, 0x0060}, VR: "US", Name: "FalsePositivesQuantity", VM: "1"}
+	tagDict[Tag{0x0024, 0x0061}] = TagInfo{Tag: Tag{0x0024, 0x0061}, VR: "CS", Name: "ExcessiveFalsePositivesDataFlag", VM: "1"}
+	tagDict[Tag{0x0024, 0x0062}] = TagInfo{Tag: Tag{0x0024, 0x0062}, VR: "CS", Name: "ExcessiveFalsePositives", VM: "1"}
+	tagDict[Tag{0x0024, 0x0063}] = TagInfo{Tag: Tag{0x0024, 0x0063}, VR: "CS", Name: "VisualFieldTestNormalsFlag", VM: "1"}
+	tagDict[Tag{0x0024, 0x0064}] = TagInfo{Tag: Tag{0x0024, 0x0064}, VR: "SQ", Name: "ResultsNormalsSequence", VM: "1"}
+	tagDict[Tag{0x0024, 0x0065}] = TagInfo{Tag: Tag{0x0024, 0x0065}, VR: "SQ", Name: "AgeCorrectedSensitivityDeviationAlgorithmSequence", VM: "1"}
+	tagDict[Tag{0x0024, 0x0066}] = TagInfo{Tag: Tag{0x0024, 0x0066}, VR: "FL", Name: "GlobalDeviationFromNormal", VM: "1"}
+	tagDict[Tag{0x0024, 0x0067}] = TagInfo{Tag: Tag{0x0024, 0x0067}, VR: "SQ", Name: "GeneralizedDefectSensitivityDeviationAlgorithmSequence", VM: "1"}
+	tagDict[Tag{0x0024, 0x0068}] = TagInfo{Tag: Tag{0x0024, 0x0068}, VR: "FL", Name: "LocalizedDeviationfromNormal", VM: "1"}
+	tagDict[Tag{0x0024, 0x0069}] = TagInfo{Tag: Tag{0x0024, 0x0069}, VR: "LO", Name: "PatientReliabilityIndicator", VM: "1"}
+	tagDict[Tag{0x0024, 0x0070}] = TagInfo{Tag: Tag{0x0024, 0x0070}, VR: "FL", Name: "VisualFieldMeanSensitivity", VM: "1"}
+	tagDict[Tag{0x0024, 0x0071}] = TagInfo{Tag: Tag{0x0024, 0x0071}, VR: "FL", Name: "GlobalDeviationProbability", VM: "1"}
+	tagDict[Tag{0x0024, 0x0072}] = TagInfo{Tag: Tag{0x0024, 0x0072}, VR: "CS", Name: "LocalDeviationProbabilityNormalsFlag", VM: "1"}
+	tagDict[Tag{0x0024, 0x0073}] = TagInfo{Tag: Tag{0x0024, 0x0073}, VR: "FL", Name: "LocalizedDeviationProbability", VM: "1"}
+	tagDict[Tag{0x0024, 0x0074}] = TagInfo{Tag: Tag{0x0024, 0x0074}, VR: "CS", Name: "ShortTermFluctuationCalculated", VM: "1"}
+	tagDict[Tag{0x0024, 0x0075}] = TagInfo{Tag: Tag{0x0024, 0x0075}, VR: "FL", Name: "ShortTermFluctuation", VM: "1"}
+	tagDict[Tag{0x0024, 0x0076}] = TagInfo{Tag: Tag{0x0024, 0x0076}, VR: "CS", Name: "ShortTermFluctuationProbabilityCalculated", VM: "1"}
+	tagDict[Tag{0x0024, 0x0077}] = TagInfo{Tag: Tag{0x0024, 0x0077}, VR: "FL", Name: "ShortTermFluctuationProbability", VM: "1"}
+	tagDict[Tag{0x0024, 0x0078}] = TagInfo{Tag: Tag{0x0024, 0x0078}, VR: "CS", Name: "CorrectedLocalizedDeviationFromNormalCalculated", VM: "1"}
+	tagDict[Tag{0x0024, 0x0079}] = TagInfo{Tag: Tag{0x0024, 0x0079}, VR: "FL", Name: "CorrectedLocalizedDeviationFromNormal", VM: "1"}
+	tagDict[Tag{0x0024, 0x0080}] = TagInfo{Tag: Tag{0x0024, 0x0080}, VR: "CS", Name: "CorrectedLocalizedDeviationFromNormalProbabilityCalculated", VM: "1"}
+	tagDict[Tag{0x0024, 0x0081}] = TagInfo{Tag: Tag{0x0024, 0x0081}, VR: "FL", Name: "CorrectedLocalizedDeviationFromNormalProbability", VM: "1"}
+	tagDict[Tag{0x0024, 0x0083}] = TagInfo{Tag: Tag{0x0024, 0x0083}, VR: "SQ", Name: "GlobalDeviationProbabilitySequence", VM: "1"}
+	tagDict[Tag{0x0024, 0x0085}] = TagInfo{Tag: Tag{0x0024, 0x0085}, VR: "SQ", Name: "LocalizedDeviationProbabilitySequence", VM: "1"}
+	tagDict[Tag{0x0024, 0x0086}] = TagInfo{Tag: Tag{0x0024, 0x0086}, VR: "CS", Name: "FovealSensitivityMeasured", VM: "1"}
+	tagDict[Tag{0x0024, 0x0087}] = TagInfo{Tag: Tag{0x0024, 0x0087}, VR: "FL", Name: "FovealSensitivity", VM: "1"}
+	tagDict[Tag{0x0024, 0x0088}] = TagInfo{Tag: Tag{0x0024, 0x0088}, VR: "FL", Name: "VisualFieldTestDuration", VM: "1"}
+	tagDict[Tag{0x0024, 0x0089}] = TagInfo{Tag: Tag{0x0024, 0x0089}, VR: "SQ", Name: "VisualFieldTestPointSequence", VM: "1"}
+	tagDict[Tag{0x0024, 0x0090}] = TagInfo{Tag: Tag{0x0024, 0x0090}, VR: "FL", Name: "VisualFieldTestPointXCoordinate", VM: "1"}
+	tagDict[Tag{0x0024, 0x0091}] = TagInfo{Tag: Tag{0x0024, 0x0091}, VR: "FL", Name: "VisualFieldTestPointYCoordinate", VM: "1"}
+	tagDict[Tag{0x0024, 0x0092}] = TagInfo{Tag: Tag{0x0024, 0x0092}, VR: "FL", Name: "AgeCorrectedSensitivityDeviationValue", VM: "1"}
+	tagDict[Tag{0x0024, 0x0093}] = TagInfo{Tag: Tag{0x0024, 0x0093}, VR: "CS", Name: "StimulusResults", VM: "1"}
+	tagDict[Tag{0x0024, 0x0094}] = TagInfo{Tag: Tag{0x0024, 0x0094}, VR: "FL", Name: "SensitivityValue", VM: "1"}
+	tagDict[Tag{0x0024, 0x0095}] = TagInfo{Tag: Tag{0x0024, 0x0095}, VR: "CS", Name: "RetestStimulusSeen", VM: "1"}
+	tagDict[Tag{0x0024, 0x0096}] = TagInfo{Tag: Tag{0x0024, 0x0096}, VR: "FL", Name: "RetestSensitivityValue", VM: "1"}
+	tagDict[Tag{0x0024, 0x0097}] = TagInfo{Tag: Tag{0x0024, 0x0097}, VR: "SQ", Name: "VisualFieldTestPointNormalsSequence", VM: "1"}
+	tagDict[Tag{0x0024, 0x0098}] = TagInfo{Tag: Tag{0x0024, 0x0098}, VR: "FL", Name: "QuantifiedDefect", VM: "1"}
+	tagDict[Tag{0x0024, 0x0100}] = TagInfo{Tag: Tag{0x0024, 0x0100}, VR: "FL", Name: "AgeCorrectedSensitivityDeviationProbabilityValue", VM: "1"}
+	tagDict[Tag{0x0024, 0x0102}] = TagInfo{Tag: Tag{0x0024, 0x0102}, VR: "CS", Name: "GeneralizedDefectCorrectedSensitivityDeviationFlag", VM: "1"}
+	tagDict[Tag{0x0024, 0x0103}] = TagInfo{Tag: Tag{0x0024, 0x0103}, VR: "FL", Name: "GeneralizedDefectCorrectedSensitivityDeviationValue", VM: "1"}
+	tagDict[Tag{0x0024, 0x0104}] = TagInfo{Tag: Tag{0x0024, 0x0104}, VR: "FL", Name: "GeneralizedDefectCorrectedSensitivityDeviationProbabilityValue", VM: "1"}
+	tagDict[Tag{0x0024, 0x0105}] = TagInfo{Tag: Tag{0x0024, 0x0105}, VR: "FL", Name: "MinimumSensitivityValue", VM: "1"}
+	tagDict[Tag{0x0024, 0x0106}] = TagInfo{Tag: Tag{0x0024, 0x0106}, VR: "CS", Name: "BlindSpotLocalized", VM: "1"}
+	tagDict[Tag{0x0024, 0x0107}] = TagInfo{Tag: Tag{0x0024, 0x0107}, VR: "FL", Name: "BlindSpotXCoordinate", VM: "1"}
+	tagDict[Tag{0x0024, 0x0108}] = TagInfo{Tag: Tag{0x0024, 0x0108}, VR: "FL", Name: "BlindSpotYCoordinate", VM: "1"}
+	tagDict[Tag{0x0024, 0x0110}] = TagInfo{Tag: Tag{0x0024, 0x0110}, VR: "SQ", Name: "VisualAcuityMeasurementSequence", VM: "1"}
+	tagDict[Tag{0x0024, 0x0112}] = TagInfo{Tag: Tag{0x0024, 0x0112}, VR: "SQ", Name: "RefractiveParametersUsedOnPatientSequence", VM: "1"}
+	tagDict[Tag{0x0024, 0x0113}] = TagInfo{Tag: Tag{0x0024, 0x0113}, VR: "CS", Name: "MeasurementLaterality", VM: "1"}
+	tagDict[Tag{0x0024, 0x0114}] = TagInfo{Tag: Tag{0x0024, 0x0114}, VR: "SQ", Name: "OphthalmicPatientClinicalInformationLeftEyeSequence", VM: "1"}
+	tagDict[Tag{0x0024, 0x0115}] = TagInfo{Tag: Tag{0x0024, 0x0115}, VR: "SQ", Name: "OphthalmicPatientClinicalInformationRightEyeSequence", VM: "1"}
+	tagDict[Tag{0x0024, 0x0117}] = TagInfo{Tag: Tag{0x0024, 0x0117}, VR: "CS", Name: "FovealPointNormativeDataFlag", VM: "1"}
+	tagDict[Tag{0x0024, 0x0118}] = TagInfo{Tag: Tag{0x0024, 0x0118}, VR: "FL", Name: "FovealPointProbabilityValue", VM: "1"}
+	tagDict[Tag{0x0024, 0x0120}] = TagInfo{Tag: Tag{0x0024, 0x0120}, VR: "CS", Name: "ScreeningBaselineMeasured", VM: "1"}
+	tagDict[Tag{0x0024, 0x0122}] = TagInfo{Tag: Tag{0x0024, 0x0122}, VR: "SQ", Name: "ScreeningBaselineMeasuredSequence", VM: "1"}
+	tagDict[Tag{0x0024, 0x0124}] = TagInfo{Tag: Tag{0x0024, 0x0124}, VR: "CS", Name: "ScreeningBaselineType", VM: "1"}
+	tagDict[Tag{0x0024, 0x0126}] = TagInfo{Tag: Tag{0x0024, 0x0126}, VR: "FL", Name: "ScreeningBaselineValue", VM: "1"}
+	tagDict[Tag{0x0024, 0x0202}] = TagInfo{Tag: Tag{0x0024, 0x0202}, VR: "LO", Name: "AlgorithmSource", VM: "1"}
+	tagDict[Tag{0x0024, 0x0306}] = TagInfo{Tag: Tag{0x0024, 0x0306}, VR: "LO", Name: "DataSetName", VM: "1"}
+	tagDict[Tag{0x0024, 0x0307}] = TagInfo{Tag: Tag{0x0024, 0x0307}, VR: "LO", Name: "DataSetVersion", VM: "1"}
+	tagDict[Tag{0x0024, 0x0308}] = TagInfo{Tag: Tag{0x0024, 0x0308}, VR: "LO", Name: "DataSetSource", VM: "1"}
+	tagDict[Tag{0x0024, 0x0309}] = TagInfo{Tag: Tag{0x0024, 0x0309}, VR: "LO", Name: "DataSetDescription", VM: "1"}
+	tagDict[Tag{0x0024, 0x0317}] = TagInfo{Tag: Tag{0x0024, 0x0317}, VR: "SQ", Name: "VisualFieldTestReliabilityGlobalIndexSequence", VM: "1"}
+	tagDict[Tag{0x0024, 0x0320}] = TagInfo{Tag: Tag{0x0024, 0x0320}, VR: "SQ", Name: "VisualFieldGlobalResultsIndexSequence", VM: "1"}
+	tagDict[Tag{0x0024, 0x0325}] = TagInfo{Tag: Tag{0x0024, 0x0325}, VR: "SQ", Name: "DataObservationSequence", VM: "1"}
+	tagDict[Tag{0x0024, 0x0338}] = TagInfo{Tag: Tag{0x0024, 0x0338}, VR: "CS", Name: "IndexNormalsFlag", VM: "1"}
+	tagDict[Tag{0x0024, 0x0341}] = TagInfo{Tag: Tag{0x0024, 0x0341}, VR: "FL", Name: "IndexProbability", VM: "1"}
+	tagDict[Tag{0x0024, 0x0344}] = TagInfo{Tag: Tag{0x0024, 0x0344}, VR: "SQ", Name: "IndexProbabilitySequence", VM: "1"}
+	tagDict[Tag{0x0028, 0x0002}] = TagInfo{Tag: Tag{0x0028, 0x0002}, VR: "US", Name: "SamplesPerPixel", VM: "1"}
+	tagDict[Tag{0x0028, 0x0003}] = TagInfo{Tag: Tag{0x0028, 0x0003}, VR: "US", Name: "SamplesPerPixelUsed", VM: "1"}
+	tagDict[Tag{0x0028, 0x0004}] = TagInfo{Tag: Tag{0x0028, 0x0004}, VR: "CS", Name: "PhotometricInterpretation", VM: "1"}
+	tagDict[Tag{0x0028, 0x0006}] = TagInfo{Tag: Tag{0x0028, 0x0006}, VR: "US", Name: "PlanarConfiguration", VM: "1"}
+	tagDict[Tag{0x0028, 0x0008}] = TagInfo{Tag: Tag{0x0028, 0x0008}, VR: "IS", Name: "NumberOfFrames", VM: "1"}
+	tagDict[Tag{0x0028, 0x0009}] = TagInfo{Tag: Tag{0x0028, 0x0009}, VR: "AT", Name: "FrameIncrementPointer", VM: "1-n"}
+	tagDict[Tag{0x0028, 0x000A}] = TagInfo{Tag: Tag{0x0028, 0x000A}, VR: "AT", Name: "FrameDimensionPointer", VM: "1-n"}
+	tagDict[Tag{0x0028, 0x0010}] = TagInfo{Tag: Tag{0x0028, 0x0010}, VR: "US", Name: "Rows", VM: "1"}
+	tagDict[Tag{0x0028, 0x0011}] = TagInfo{Tag: Tag{0x0028, 0x0011}, VR: "US", Name: "Columns", VM: "1"}
+	tagDict[Tag{0x0028, 0x0014}] = TagInfo{Tag: Tag{0x0028, 0x0014}, VR: "US", Name: "UltrasoundColorDataPresent", VM: "1"}
+	tagDict[Tag{0x0028, 0x0030}] = TagInfo{Tag: Tag{0x0028, 0x0030}, VR: "DS", Name: "PixelSpacing", VM: "2"}
+	tagDict[Tag{0x0028, 0x0031}] = TagInfo{Tag: Tag{0x0028, 0x0031}, VR: "DS", Name: "ZoomFactor", VM: "2"}
+	tagDict[Tag{0x0028, 0x0032}] = TagInfo{Tag: Tag{0x0028, 0x0032}, VR: "DS", Name: "ZoomCenter", VM: "2"}
+	tagDict[Tag{0x0028, 0x0034}] = TagInfo{Tag: Tag{0x0028, 0x0034}, VR: "IS", Name: "PixelAspectRatio", VM: "2"}
+	tagDict[Tag{0x0028, 0x0051}] = TagInfo{Tag: Tag{0x0028, 0x0051}, VR: "CS", Name: "CorrectedImage", VM: "1-n"}
+	tagDict[Tag{0x0028, 0x0100}] = TagInfo{Tag: Tag{0x0028, 0x0100}, VR: "US", Name: "BitsAllocated", VM: "1"}
+	tagDict[Tag{0x0028, 0x0101}] = TagInfo{Tag: Tag{0x0028, 0x0101}, VR: "US", Name: "BitsStored", VM: "1"}
+	tagDict[Tag{0x0028, 0x0102}] = TagInfo{Tag: Tag{0x0028, 0x0102}, VR: "US", Name: "HighBit", VM: "1"}
+	tagDict[Tag{0x0028, 0x0103}] = TagInfo{Tag: Tag{0x0028, 0x0103}, VR: "US", Name: "PhotometricInterpretation2", VM: "1"}
+	tagDict[Tag{0x0028, 0x0106}] = TagInfo{Tag: Tag{0x0028, 0x0106}, VR: "US", VRs: []string{"US", "SS"}, Name: "SmallestImagePixelValue", VM: "1"}
+	tagDict[Tag{0x0028, 0x0107}] = TagInfo{Tag: Tag{0x0028, 0x0107}, VR: "US", VRs: []string{"US", "SS"}, Name: "LargestImagePixelValue", VM: "1"}
+	tagDict[Tag{0x0028, 0x0108}] = TagInfo{Tag: Tag{0x0028, 0x0108}, VR: "US", VRs: []string{"US", "SS"}, Name: "SmallestPixelValueInSeries", VM: "1"}
+	tagDict[Tag{0x0028, 0x0109}] = TagInfo{Tag: Tag{0x0028, 0x0109}, VR: "US", VRs: []string{"US", "SS"}, Name: "LargestPixelValueInSeries", VM: "1"}
+	tagDict[Tag{0x0028, 0x0120}] = TagInfo{Tag: Tag{0x0028, 0x0120}, VR: "US", VRs: []string{"US", "SS"}, Name: "PixelPaddingValue", VM: "1"}
+	tagDict[Tag{0x0028, 0x0121}] = TagInfo{Tag: Tag{0x0028, 0x0121}, VR: "US", VRs: []string{"US", "SS"}, Name: "PixelPaddingRangeLimit", VM: "1"}
+	tagDict[Tag{0x0028, 0x0300}] = TagInfo{Tag: Tag{0x0028, 0x0300}, VR: "CS", Name: "QualityControlImage", VM: "1"}
+	tagDict[Tag{0x0028, 0x0301}] = TagInfo{Tag: Tag{0x0028, 0x0301}, VR: "CS", Name: "BurnedInAnnotation", VM: "1"}
+	tagDict[Tag{0x0028, 0x0302}] = TagInfo{Tag: Tag{0x0028, 0x0302}, VR: "CS", Name: "RecognizableVisualFeatures", VM: "1"}
+	tagDict[Tag{0x0028, 0x0303}] = TagInfo{Tag: Tag{0x0028, 0x0303}, VR: "CS", Name: "LongitudinalTemporalInformationModified", VM: "1"}
+	tagDict[Tag{0x0028, 0x0304}] = TagInfo{Tag: Tag{0x0028, 0x0304}, VR: "UI", Name: "ReferencedColorPaletteInstanceUID", VM: "1"}
+	tagDict[Tag{0x0028, 0x0A02}] = TagInfo{Tag: Tag{0x0028, 0x0A02}, VR: "CS", Name: "PixelSpacingCalibrationType", VM: "1"}
+	tagDict[Tag{0x0028, 0x0A04}] = TagInfo{Tag: Tag{0x0028, 0x0A04}, VR: "LO", Name: "PixelSpacingCalibrationDescription", VM: "1"}
+	tagDict[Tag{0x0028, 0x1040}] = TagInfo{Tag: Tag{0x0028, 0x1040}, VR: "CS", Name: "PixelIntensityRelationship", VM: "1"}
+	tagDict[Tag{0x0028, 0x1041}] = TagInfo{Tag: Tag{0x0028, 0x1041}, VR: "SS", Name: "PixelIntensityRelationshipSign", VM: "1"}
+	tagDict[Tag{0x0028, 0x1050}] = TagInfo{Tag: Tag{0x0028, 0x1050}, VR: "DS", Name: "WindowCenter", VM: "1-n"}
+	tagDict[Tag{0x0028, 0x1051}] = TagInfo{Tag: Tag{0x0028, 0x1051}, VR: "DS", Name: "WindowWidth", VM: "1-n"}
+	tagDict[Tag{0x0028, 0x1052}] = TagInfo{Tag: Tag{0x0028, 0x1052}, VR: "DS", Name: "RescaleIntercept", VM: "1"}
+	tagDict[Tag{0x0028, 0x1053}] = TagInfo{Tag: Tag{0x0028, 0x1053}, VR: "DS", Name: "RescaleSlope", VM: "1"}
+	tagDict[Tag{0x0028, 0x1054}] = TagInfo{Tag: Tag{0x0028, 0x1054}, VR: "LO", Name: "RescaleType", VM: "1"}
+	tagDict[Tag{0x0028, 0x1055}] = TagInfo{Tag: Tag{0x0028, 0x1055}, VR: "LO", Name: "WindowCenterWidthExplanation", VM: "1-n"}
+	tagDict[Tag{0x0028, 0x1056}] = TagInfo{Tag: Tag{0x0028, 0x1056}, VR: "CS", Name: "VOILUTFunction", VM: "1"}
+	tagDict[Tag{0x0028, 0x1090}] = TagInfo{Tag: Tag{0x0028, 0x1090}, VR: "CS", Name: "RecommendedViewingMode", VM: "1"}
+	tagDict[Tag{0x0028, 0x1101}] = TagInfo{Tag: Tag{0x0028, 0x1101}, VR: "US", VRs: []string{"US", "SS"}, Name: "RedPaletteColorLookupTableDescriptor", VM: "3"}
+	tagDict[Tag{0x0028, 0x1102}] = TagInfo{Tag: Tag{0x0028, 0x1102}, VR: "US", VRs: []string{"US", "SS"}, Name: "GreenPaletteColorLookupTableDescriptor", VM: "3"}
+	tagDict[Tag{0x0028, 0x1103}] = TagInfo{Tag: Tag{0x0028, 0x1103}, VR: "US", VRs: []string{"US", "SS"}, Name: "BluePaletteColorLookupTableDescriptor", VM: "3"}
+	tagDict[Tag{0x0028, 0x1104}] = TagInfo{Tag: Tag{0x0028, 0x1104}, VR: "US", Name: "AlphaPaletteColorLookupTableDescriptor", VM: "3"}
+	tagDict[Tag{0x0028, 0x1199}] = TagInfo{Tag: Tag{0x0028, 0x1199}, VR: "UI", Name: "PaletteColorLookupTableUID", VM: "1"}
+	tagDict[Tag{0x0028, 0x1201}] = TagInfo{Tag: Tag{0x0028, 0x1201}, VR: "OW", Name: "RedPaletteColorLookupTableData", VM: "1"}
+	tagDict[Tag{0x0028, 0x1202}] = TagInfo{Tag: Tag{0x0028, 0x1202}, VR: "OW", Name: "GreenPaletteColorLookupTableData", VM: "1"}
+	tagDict[Tag{0x0028, 0x1203}] = TagInfo{Tag: Tag{0x0028, 0x1203}, VR: "OW", Name: "BluePaletteColorLookupTableData", VM: "1"}
+	tagDict[Tag{0x0028, 0x1204}] = TagInfo{Tag: Tag{0x0028, 0x1204}, VR: "OW", Name: "AlphaPaletteColorLookupTableData", VM: "1"}
+	tagDict[Tag{0x0028, 0x1221}] = TagInfo{Tag: Tag{0x0028, 0x1221}, VR: "OW", Name: "SegmentedRedPaletteColorLookupTableData", VM: "1"}
+	tagDict[Tag{0x0028, 0x1222}] = TagInfo{Tag: Tag{0x0028, 0x1222}, VR: "OW", Name: "SegmentedGreenPaletteColorLookupTableData", VM: "1"}
+	tagDict[Tag{0x0028, 0x1223}] = TagInfo{Tag: Tag{0x0028, 0x1223}, VR: "OW", Name: "SegmentedBluePaletteColorLookupTableData", VM: "1"}
+	tagDict[Tag{0x0028, 0x1300}] = TagInfo{Tag: Tag{0x0028, 0x1300}, VR: "CS", Name: "BreastImplantPresent", VM: "1"}
+	tagDict[Tag{0x0028, 0x1350}] = TagInfo{Tag: Tag{0x0028, 0x1350}, VR: "CS", Name: "PartialView", VM: "1"}
+	tagDict[Tag{0x0028, 0x1351}] = TagInfo{Tag: Tag{0x0028, 0x1351}, VR: "ST", Name: "PartialViewDescription", VM: "1"}
+	tagDict[Tag{0x0028, 0x1352}] = TagInfo{Tag: Tag{0x0028, 0x1352}, VR: "SQ", Name: "PartialViewCodeSequence", VM: "1"}
+	tagDict[Tag{0x0028, 0x135A}] = TagInfo{Tag: Tag{0x0028, 0x135A}, VR: "CS", Name: "SpatialLocationsPreserved", VM: "1"}
+	tagDict[Tag{0x0028, 0x1401}] = TagInfo{Tag: Tag{0x0028, 0x1401}, VR: "SQ", Name: "DataFrameAssignmentSequence", VM: "1"}
+	tagDict[Tag{0x0028, 0x1402}] = TagInfo{Tag: Tag{0x0028, 0x1402}, VR: "CS", Name: "DataPathAssignment", VM: "1"}
+	tagDict[Tag{0x0028, 0x1403}] = TagInfo{Tag: Tag{0x0028, 0x1403}, VR: "US", Name: "BitsMappedToColorLookupTable", VM: "1"}
+	tagDict[Tag{0x0028, 0x1404}] = TagInfo{Tag: Tag{0x0028, 0x1404}, VR: "SQ", Name: "BlendingLUT1Sequence", VM: "1"}
+	tagDict[Tag{0x0028, 0x1405}] = TagInfo{Tag: Tag{0x0028, 0x1405}, VR: "CS", Name: "BlendingLUT1TransferFunction", VM: "1"}
+	tagDict[Tag{0x0028, 0x1406}] = TagInfo{Tag: Tag{0x0028, 0x1406}, VR: "FD", Name: "BlendingWeightConstant", VM: "1"}
+	tagDict[Tag{0x0028, 0x1407}] = TagInfo{Tag: Tag{0x0028, 0x1407}, VR: "US", Name: "BlendingLookupTableDescriptor", VM: "3"}
+	tagDict[Tag{0x0028, 0x1408}] = TagInfo{Tag: Tag{0x0028, 0x1408}, VR: "OW", Name: "BlendingLookupTableData", VM: "1"}
+	tagDict[Tag{0x0028, 0x140B}] = TagInfo{Tag: Tag{0x0028, 0x140B}, VR: "SQ", Name: "EnhancedPaletteColorLookupTableSequence", VM: "1"}
+	tagDict[Tag{0x0028, 0x140C}] = TagInfo{Tag: Tag{0x0028, 0x140C}, VR: "SQ", Name: "BlendingLUT2Sequence", VM: "1"}
+	tagDict[Tag{0x0028, 0x140D}] = TagInfo{Tag: Tag{0x0028, 0x140D}, VR: "CS", Name: "BlendingLUT2TransferFunction", VM: "1"}
+	tagDict[Tag{0x0028, 0x140E}] = TagInfo{Tag: Tag{0x0028, 0x140E}, VR: "CS", Name: "DataPathID", VM: "1"}
+	tagDict[Tag{0x0028, 0x140F}] = TagInfo{Tag: Tag{0x0028, 0x140F}, VR: "CS", Name: "RGBLUTTransferFunction", VM: "1"}
+	tagDict[Tag{0x0028, 0x1410}] = TagInfo{Tag: Tag{0x0028, 0x1410}, VR: "CS", Name: "AlphaLUTTransferFunction", VM: "1"}
+	tagDict[Tag{0x0028, 0x2000}] = TagInfo{Tag: Tag{0x0028, 0x2000}, VR: "OB", Name: "ICCProfile", VM: "1"}
+	tagDict[Tag{0x0028, 0x2110}] = TagInfo{Tag: Tag{0x0028, 0x2110}, VR: "CS", Name: "LossyImageCompression", VM: "1"}
+	tagDict[Tag{0x0028, 0x2112}] = TagInfo{Tag: Tag{0x0028, 0x2112}, VR: "DS", Name: "LossyImageCompressionRatio", VM: "1-n"}
+	tagDict[Tag{0x0028, 0x2114}] = TagInfo{Tag: Tag{0x0028, 0x2114}, VR: "CS", Name: "LossyImageCompressionMethod", VM: "1-n"}
+	tagDict[Tag{0x0028, 0x3000}] = TagInfo{Tag: Tag{0x0028, 0x3000}, VR: "SQ", Name: "ModalityLUTSequence", VM: "1"}
+	tagDict[Tag{0x0028, 0x3002}] = TagInfo{Tag: Tag{0x0028, 0x3002}, VR: "US", VRs: []string{"US", "SS"}, Name: "LUTDescriptor", VM: "3"}
+	tagDict[Tag{0x0028, 0x3003}] = TagInfo{Tag: Tag{0x0028, 0x3003}, VR: "LO", Name: "LUTExplanation", VM: "1"}
+	tagDict[Tag{0x0028, 0x3004}] = TagInfo{Tag: Tag{0x0028, 0x3004}, VR: "LO", Name: "ModalityLUTType", VM: "1"}
+	tagDict[Tag{0x0028, 0x3006}] = TagInfo{Tag: Tag{0x0028, 0x3006}, VR: "OW", VRs: []string{"OW", "US"}, Name: "LUTData", VM: "1-n"}
+	tagDict[Tag{0x0028, 0x3010}] = TagInfo{Tag: Tag{0x0028, 0x3010}, VR: "SQ", Name: "VOILUTSequence", VM: "1"}
+	tagDict[Tag{0x0028, 0x3110}] = TagInfo{Tag: Tag{0x0028, 0x3110}, VR: "SQ", Name: "SoftcopyVOILUTSequence", VM: "1"}
+	tagDict[Tag{0x0028, 0x6010}] = TagInfo{Tag: Tag{0x0028, 0x6010}, VR: "US", Name: "RepresentativeFrameNumber", VM: "1"}
+	tagDict[Tag{0x0028, 0x6020}] = TagInfo{Tag: Tag{0x0028, 0x6020}, VR: "US", Name: "FrameNumbersOfInterest", VM: "1-n"}
+	tagDict[Tag{0x0028, 0x6022}] = TagInfo{Tag: Tag{0x0028, 0x6022}, VR: "LO", Name: "FrameOfInterestDescription", VM: "1-n"}
+	tagDict[Tag{0x0028, 0x6023}] = TagInfo{Tag: Tag{0x0028, 0x6023}, VR: "CS", Name: "FrameOfInterestType", VM: "1-n"}
+	tagDict[Tag{0x0028, 0x6040}] = TagInfo{Tag: Tag{0x0028, 0x6040}, VR: "US", Name: "RWavePointer", VM: "1-n"}
+	tagDict[Tag{0x0028, 0x6100}] = TagInfo{Tag: Tag{0x0028, 0x6100}, VR: "SQ", Name: "MaskSubtractionSequence", VM: "1"}
+	tagDict[Tag{0x0028, 0x6101}] = TagInfo{Tag: Tag{0x0028, 0x6101}, VR: "CS", Name: "MaskOperation", VM: "1"}
+	tagDict[Tag{0x0028, 0x6102}] = TagInfo{Tag: Tag{0x0028, 0x6102}, VR: "US", Name: "ApplicableFrameRange", VM: "2-2n"}
+	tagDict[Tag{0x0028, 0x6110}] = TagInfo{Tag: Tag{0x0028, 0x6110}, VR: "US", Name: "MaskFrameNumbers", VM: "1-n"}
+	tagDict[Tag{0x0028, 0x6112}] = TagInfo{Tag: Tag{0x0028, 0x6112}, VR: "US", Name: "ContrastFrameAveraging", VM: "1"}
+	tagDict[Tag{0x0028, 0x6114}] = TagInfo{Tag: Tag{0x0028, 0x6114}, VR: "FL", Name: "MaskSubPixelShift", VM: "2"}
+	tagDict[Tag{0x0028, 0x6120}] = TagInfo{Tag: Tag{0x0028, 0x6120}, VR: "SS", Name: "TIDOffset", VM: "1"}
+	tagDict[Tag{0x0028, 0x6190}] = TagInfo{Tag: Tag{0x0028, 0x6190}, VR: "ST", Name: "MaskOperationExplanation", VM: "1"}
+	tagDict[Tag{0x0028, 0x7FE0}] = TagInfo{Tag: Tag{0x0028, 0x7FE0}, VR: "UT", Name: "PixelDataProviderURL", VM: "1"}
+	tagDict[Tag{0x0028, 0x9001}] = TagInfo{Tag: Tag{0x0028, 0x9001}, VR: "UL", Name: "DataPointRows", VM: "1"}
+	tagDict[Tag{0x0028, 0x9002}] = TagInfo{Tag: Tag{0x0028, 0x9002}, VR: "UL", Name: "DataPointColumns", VM: "1"}
+	tagDict[Tag{0x0028, 0x9003}] = TagInfo{Tag: Tag{0x0028, 0x9003}, VR: "CS", Name: "SignalDomainColumns", VM: "1"}
+	tagDict[Tag{0x0028, 0x9108}] = TagInfo{Tag: Tag{0x0028, 0x9108}, VR: "CS", Name: "DataRepresentation", VM: "1"}
+	tagDict[Tag{0x0028, 0x9110}] = TagInfo{Tag: Tag{0x0028, 0x9110}, VR: "SQ", Name: "PixelMeasuresSequence", VM: "1"}
+	tagDict[Tag{0x0028, 0x9132}] = TagInfo{Tag: Tag{0x0028, 0x9132}, VR: "SQ", Name: "FrameVOILUTSequence", VM: "1"}
+	tagDict[Tag{0x0028, 0x9145}] = TagInfo{Tag: Tag{0x0028, 0x9145}, VR: "SQ", Name: "PixelValueTransformationSequence", VM: "1"}
+	tagDict[Tag{0x0028, 0x9235}] = TagInfo{Tag: Tag{0x0028, 0x9235}, VR: "CS", Name: "SignalDomainRows", VM: "1"}
+	tagDict[Tag{0x0028, 0x9411}] = TagInfo{Tag: Tag{0x0028, 0x9411}, VR: "FL", Name: "DisplayFilterPercentage", VM: "1"}
+	tagDict[Tag{0x0028, 0x9415}] = TagInfo{Tag: Tag{0x0028, 0x9415}, VR: "SQ", Name: "FramePixelShiftSequence", VM: "1"}
+	tagDict[Tag{0x0028, 0x9416}] = TagInfo{Tag: Tag{0x0028, 0x9416}, VR: "US", Name: "SubtractionItemID", VM: "1"}
+	tagDict[Tag{0x0028, 0x9422}] = TagInfo{Tag: Tag{0x0028, 0x9422}, VR: "SQ", Name: "PixelIntensityRelationshipLUTSequence", VM: "1"}
+	tagDict[Tag{0x0028, 0x9443}] = TagInfo{Tag: Tag{0x0028, 0x9443}, VR: "SQ", Name: "FramePixelDataPropertiesSequence", VM: "1"}
+	tagDict[Tag{0x0028, 0x9444}] = TagInfo{Tag: Tag{0x0028, 0x9444}, VR: "CS", Name: "GeometricalProperties", VM: "1"}
+	tagDict[Tag{0x0028, 0x9445}] = TagInfo{Tag: Tag{0x0028, 0x9445}, VR: "FL", Name: "GeometricMaximumDistortion", VM: "1"}
+	tagDict[Tag{0x0028, 0x9446}] = TagInfo{Tag: Tag{0x0028, 0x9446}, VR: "CS", Name: "ImageProcessingApplied", VM: "1-n"}
+	tagDict[Tag{0x0028, 0x9454}] = TagInfo{Tag: Tag{0x0028, 0x9454}, VR: "CS", Name: "MaskSelectionMode", VM: "1"}
+	tagDict[Tag{0x0028, 0x9474}] = TagInfo{Tag: Tag{0x0028, 0x9474}, VR: "CS", Name: "LUTFunction", VM: "1"}
+	tagDict[Tag{0x0028, 0x9478}] = TagInfo{Tag: Tag{0x0028, 0x9478}, VR: "FL", Name: "MaskVisibilityPercentage", VM: "1"}
+	tagDict[Tag{0x0028, 0x9501}] = TagInfo{Tag: Tag{0x0028, 0x9501}, VR: "SQ", Name: "PixelShiftSequence", VM: "1"}
+	tagDict[Tag{0x0028, 0x9502}] = TagInfo{Tag: Tag{0x0028, 0x9502}, VR: "SQ", Name: "RegionPixelShiftSequence", VM: "1"}
+	tagDict[Tag{0x0028, 0x9503}] = TagInfo{Tag: Tag{0x0028, 0x9503}, VR: "SS", Name: "VerticesOfTheRegion", VM: "2-2n"}
+	tagDict[Tag{0x0028, 0x9505}] = TagInfo{Tag: Tag{0x0028, 0x9505}, VR: "SQ", Name: "MultiFramePresentationSequence", VM: "1"}
+	tagDict[Tag{0x0028, 0x9506}] = TagInfo{Tag: Tag{0x0028, 0x9506}, VR: "US", Name: "PixelShiftFrameRange", VM: "2-2n"}
+	tagDict[Tag{0x0028, 0x9507}] = TagInfo{Tag: Tag{0x0028, 0x9507}, VR: "US", Name: "LUTFrameRange", VM: "2-2n"}
+	tagDict[Tag{0x0028, 0x9520}] = TagInfo{Tag: Tag{0x0028, 0x9520}, VR: "DS", Name: "ImageToEquipmentMappingMatrix", VM: "16"}
+	tagDict[Tag{0x0028, 0x9537}] = TagInfo{Tag: Tag{0x0028, 0x9537}, VR: "CS", Name: "EquipmentCoordinateSystemIdentification", VM: "1"}
+	tagDict[Tag{0x0032, 0x1031}] = TagInfo{Tag: Tag{0x0032, 0x1031}, VR: "SQ", Name: "RequestingPhysicianIdentificationSequence", VM: "1"}
+	tagDict[Tag{0x0032, 0x1032}] = TagInfo{Tag: Tag{0x0032, 0x1032}, VR: "PN", Name: "RequestingPhysician", VM: "1"}
+	tagDict[Tag{0x0032, 0x1033}] = TagInfo{Tag: Tag{0x0032, 0x1033}, VR: "LO", Name: "RequestingService", VM: "1"}
+	tagDict[Tag{0x0032, 0x1034}] = TagInfo{Tag: Tag{0x0032, 0x1034}, VR: "SQ", Name: "RequestingServiceCodeSequence", VM: "1"}
+	tagDict[Tag{0x0032, 0x1060}] = TagInfo{Tag: Tag{0x0032, 0x1060}, VR: "LO", Name: "RequestedProcedureDescription", VM: "1"}
+	tagDict[Tag{0x0032, 0x1064}] = TagInfo{Tag: Tag{0x0032, 0x1064}, VR: "SQ", Name: "RequestedProcedureCodeSequence", VM: "1"}
+	tagDict[Tag{0x0032, 0x1070}] = TagInfo{Tag: Tag{0x0032, 0x1070}, VR: "LO", Name: "RequestedContrastAgent", VM: "1"}
+	tagDict[Tag{0x0032, 0x4000}] = TagInfo{Tag: Tag{0x0032, 0x4000}, VR: "LT", Name: "ExaminationNotes", VM: "1"}
+	tagDict[Tag{0x0038, 0x0004}] = TagInfo{Tag: Tag{0x0038, 0x0004}, VR: "SQ", Name: "ReferencedPatientAliasSequence", VM: "1"}
+	tagDict[Tag{0x0038, 0x0008}] = TagInfo{Tag: Tag{0x0038, 0x0008}, VR: "CS", Name: "VisitStatusID", VM: "1"}
+	tagDict[Tag{0x0038, 0x0010}] = TagInfo{Tag: Tag{0x0038, 0x0010}, VR: "LO", Name: "AdmissionID", VM: "1"}
+	tagDict[Tag{0x0038, 0x0014}] = TagInfo{Tag: Tag{0x0038, 0x0014}, VR: "SQ", Name: "IssuerOfAdmissionIDSequence", VM: "1"}
+	tagDict[Tag{0x0038, 0x0016}] = TagInfo{Tag: Tag{0x0038, 0x0016}, VR: "LO", Name: "RouteOfAdmissions", VM: "1"}
+	tagDict[Tag{0x0038, 0x0020}] = TagInfo{Tag: Tag{0x0038, 0x0020}, VR: "DA", Name: "AdmittingDate", VM: "1"}
+	tagDict[Tag{0x0038, 0x0021}] = TagInfo{Tag: Tag{0x0038, 0x0021}, VR: "TM", Name: "AdmittingTime", VM: "1"}
+	tagDict[Tag{0x0038, 0x0050}] = TagInfo{Tag: Tag{0x0038, 0x0050}, VR: "LO", Name: "SpecialNeeds", VM: "1"}
+	tagDict[Tag{0x0038, 0x0060}] = TagInfo{Tag: Tag{0x0038, 0x0060}, VR: "LO", Name: "ServiceEpisodeID", VM: "1"}
+	tagDict[Tag{0x0038, 0x0062}] = TagInfo{Tag: Tag{0x0038, 0x0062}, VR: "LO", Name: "ServiceEpisodeDescription", VM: "1"}
+	tagDict[Tag{0x0038, 0x0064}] = TagInfo{Tag: Tag{0x0038, 0x0064}, VR: "SQ", Name: "IssuerOfServiceEpisodeIDSequence", VM: "1"}
+	tagDict[Tag{0x0038, 0x0100}] = TagInfo{Tag: Tag{0x0038, 0x0100}, VR: "SQ", Name: "PertinentDocumentsSequence", VM: "1"}
+	tagDict[Tag{0x0038, 0x0300}] = TagInfo{Tag: Tag{0x0038, 0x0300}, VR: "LO", Name: "CurrentPatientLocation", VM: "1"}
+	tagDict[Tag{0x0038, 0x0400}] = TagInfo{Tag: Tag{0x0038, 0x0400}, VR: "LO", Name: "PatientInstitutionResidence", VM: "1"}
+	tagDict[Tag{0x0038, 0x0500}] = TagInfo{Tag: Tag{0x0038, 0x0500}, VR: "LO", Name: "PatientState", VM: "1"}
+	tagDict[Tag{0x0038, 0x0502}] = TagInfo{Tag: Tag{0x0038, 0x0502}, VR: "SQ", Name: "PatientClinicalTrialParticipationSequence", VM: "1"}
+	tagDict[Tag{0x0038, 0x4000}] = TagInfo{Tag: Tag{0x0038, 0x4000}, VR: "LT", Name: "VisitComments", VM: "1"}
+	tagDict[Tag{0x003A, 0x0004}] = TagInfo{Tag: Tag{0x003A, 0x0004}, VR: "CS", Name: "WaveformOriginality", VM: "1"}
+	tagDict[Tag{0x003A, 0x0005}] = TagInfo{Tag: Tag{0x003A, 0x0005}, VR: "US", Name: "NumberOfWaveformChannels", VM: "1"}
+	tagDict[Tag{0x003A, 0x0010}] = TagInfo{Tag: Tag{0x003A, 0x0010}, VR: "UL", Name: "NumberOfWaveformSamples", VM: "1"}
+	tagDict[Tag{0x003A, 0x001A}] = TagInfo{Tag: Tag{0x003A, 0x001A}, VR: "DS", Name: "SamplingFrequency", VM: "1"}
+	tagDict[Tag{0x003A, 0x0020}] = TagInfo{Tag: Tag{0x003A, 0x0020}, VR: "SH", Name: "MultiplexGroupLabel", VM: "1"}
+	tagDict[Tag{0x003A, 0x0200}] = TagInfo{Tag: Tag{0x003A, 0x0200}, VR: "SQ", Name: "ChannelDefinitionSequence", VM: "1"}
+	tagDict[Tag{0x003A, 0x0202}] = TagInfo{Tag: Tag{0x003A, 0x0202}, VR: "IS", Name: "WaveformChannelNumber", VM: "1"}
+	tagDict[Tag{0x003A, 0x0203}] = TagInfo{Tag: Tag{0x003A, 0x0203}, VR: "SH", Name: "ChannelLabel", VM: "1"}
+	tagDict[Tag{0x003A, 0x0205}] = TagInfo{Tag: Tag{0x003A, 0x0205}, VR: "CS", Name: "ChannelStatus", VM: "1-n"}
+	tagDict[Tag{0x003A, 0x0208}] = TagInfo{Tag: Tag{0x003A, 0x0208}, VR: "SQ", Name: "ChannelSourceSequence", VM: "1"}
+	tagDict[Tag{0x003A, 0x0209}] = TagInfo{Tag: Tag{0x003A, 0x0209}, VR: "SQ", Name: "ChannelSourceModifiersSequence", VM: "1"}
+	tagDict[Tag{0x003A, 0x020A}] = TagInfo{Tag: Tag{0x003A, 0x020A}, VR: "SQ", Name: "SourceWaveformSequence", VM: "1"}
+	tagDict[Tag{0x003A, 0x020C}] = TagInfo{Tag: Tag{0x003A, 0x020C}, VR: "LO", Name: "ChannelDerivationDescription", VM: "1"}
+	tagDict[Tag{0x003A, 0x0210}] = TagInfo{Tag: Tag{0x003A, 0x0210}, VR: "DS", Name: "ChannelSensitivity", VM: "1"}
+	tagDict[Tag{0x003A, 0x0211}] = TagInfo{Tag: Tag{0x003A, 0x0211}, VR: "SQ", Name: "ChannelSensitivityUnitsSequence", VM: "1"}
+	tagDict[Tag{0x003A, 0x0212}] = TagInfo{Tag: Tag{0x003A, 0x0212}, VR: "DS", Name: "ChannelSensitivityCorrectionFactor", VM: "1"}
+	tagDict[Tag{0x003A, 0x0213}] = TagInfo{Tag: Tag{0x003A, 0x0213}, VR: "DS", Name: "ChannelBaseline", VM: "1"}
+	tagDict[Tag{0x003A, 0x0214}] = TagInfo{Tag: Tag{0x003A, 0x0214}, VR: "DS", Name: "ChannelTimeSkew", VM: "1"}
+	tagDict[Tag{0x003A, 0x0215}] = TagInfo{Tag: Tag{0x003A, 0x0215}, VR: "DS", Name: "ChannelSampleSkew", VM: "1"}
+	tagDict[Tag{0x003A, 0x0218}] = TagInfo{Tag: Tag{0x003A, 0x0218}, VR: "DS", Name: "ChannelOffset", VM: "1"}
+	tagDict[Tag{0x003A, 0x021A}] = TagInfo{Tag: Tag{0x003A, 0x021A}, VR: "US", Name: "WaveformBitsStored", VM: "1"}
+	tagDict[Tag{0x003A, 0x0220}] = TagInfo{Tag: Tag{0x003A, 0x0220}, VR: "DS", Name: "FilterLowFrequency", VM: "1"}
+	tagDict[Tag{0x003A, 0x0221}] = TagInfo{Tag: Tag{0x003A, 0x0221}, VR: "DS", Name: "FilterHighFrequency", VM: "1"}
+	tagDict[Tag{0x003A, 0x0222}] = TagInfo{Tag: Tag{0x003A, 0x0222}, VR: "DS", Name: "NotchFilterFrequency", VM: "1"}
+	tagDict[Tag{0x003A, 0x0223}] = TagInfo{Tag: Tag{0x003A, 0x0223}, VR: "DS", Name: "NotchFilterBandwidth", VM: "1"}
+	tagDict[Tag{0x003A, 0x0230}] = TagInfo{Tag: Tag{0x003A, 0x0230}, VR: "FL", Name: "WaveformDataDisplayScale", VM: "1"}
+	tagDict[Tag{0x003A, 0x0231}] = TagInfo{Tag: Tag{0x003A, 0x0231}, VR: "US", Name: "WaveformDisplayBackgroundCIELabValue", VM: "3"}
+	tagDict[Tag{0x003A, 0x0240}] = TagInfo{Tag: Tag{0x003A, 0x0240}, VR: "SQ", Name: "WaveformPresentationGroupSequence", VM: "1"}
+	tagDict[Tag{0x003A, 0x0241}] = TagInfo{Tag: Tag{0x003A, 0x0241}, VR: "US", Name: "PresentationGroupNumber", VM: "1"}
+	tagDict[Tag{0x003A, 0x0242}] = TagInfo{Tag: Tag{0x003A, 0x0242}, VR: "SQ", Name: "ChannelDisplaySequence", VM: "1"}
+	tagDict[Tag{0x003A, 0x0244}] = TagInfo{Tag: Tag{0x003A, 0x0244}, VR: "US", Name: "ChannelRecommendedDisplayCIELabValue", VM: "3"}
+	tagDict[Tag{0x003A, 0x0245}] = TagInfo{Tag: Tag{0x003A, 0x0245}, VR: "FL", Name: "ChannelPosition", VM: "1"}
+	tagDict[Tag{0x003A, 0x0246}] = TagInfo{Tag: Tag{0x003A, 0x0246}, VR: "CS", Name: "DisplayShadingFlag", VM: "1"}
+	tagDict[Tag{0x003A, 0x0247}] = TagInfo{Tag: Tag{0x003A, 0x0247}, VR: "FL", Name: "FractionalChannelDisplayScale", VM: "1"}
+	tagDict[Tag{0x003A, 0x0248}] = TagInfo{Tag: Tag{0x003A, 0x0248}, VR: "FL", Name: "AbsoluteChannelDisplayScale", VM: "1"}
+	tagDict[Tag{0x003A, 0x0300}] = TagInfo{Tag: Tag{0x003A, 0x0300}, VR: "SQ", Name: "MultiplexedAudioChannelsDescriptionCodeSequence", VM: "1"}
+	tagDict[Tag{0x003A, 0x0301}] = TagInfo{Tag: Tag{0x003A, 0x0301}, VR: "IS", Name: "ChannelIdentificationCode", VM: "1"}
+	tagDict[Tag{0x003A, 0x0302}] = TagInfo{Tag: Tag{0x003A, 0x0302}, VR: "CS", Name: "ChannelMode", VM: "1"}
+	tagDict[Tag{0x0040, 0x0001}] = TagInfo{Tag: Tag{0x0040, 0x0001}, VR: "AE", Name: "ScheduledStationAETitle", VM: "1-n"}
+	tagDict[Tag{0x0040, 0x0002}] = TagInfo{Tag: Tag{0x0040, 0x0002}, VR: "DA", Name: "ScheduledProcedureStepStartDate", VM: "1"}
+	tagDict[Tag{0x0040, 0x0003}] = TagInfo{Tag: Tag{0x0040, 0x0003}, VR: "TM", Name: "ScheduledProcedureStepStartTime", VM: "1"}
+	tagDict[Tag{0x0040, 0x0004}] = TagInfo{Tag: Tag{0x0040, 0x0004}, VR: "DA", Name: "ScheduledProcedureStepEndDate", VM: "1"}
+	tagDict[Tag{0x0040, 0x0005}] = TagInfo{Tag: Tag{0x0040, 0x0005}, VR: "TM", Name: "ScheduledProcedureStepEndTime", VM: "1"}
+	tagDict[Tag{0x0040, 0x0006}] = TagInfo{Tag: Tag{0x0040, 0x0006}, VR: "PN", Name: "ScheduledPerformingPhysicianName", VM: "1"}
+	tagDict[Tag{0x0040, 0x0007}] = TagInfo{Tag: Tag{0x0040, 0x0007}, VR: "LO", Name: "ScheduledProcedureStepDescription", VM: "1"}
+	tagDict[Tag{0x0040, 0x0008}] = TagInfo{Tag: Tag{0x0040, 0x0008}, VR: "SQ", Name: "ScheduledProtocolCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x0009}] = TagInfo{Tag: Tag{0x0040, 0x0009}, VR: "SH", Name: "ScheduledProcedureStepID", VM: "1"}
+	tagDict[Tag{0x0040, 0x000A}] = TagInfo{Tag: Tag{0x0040, 0x000A}, VR: "SQ", Name: "StageCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x000B}] = TagInfo{Tag: Tag{0x0040, 0x000B}, VR: "SQ", Name: "ScheduledPerformingPhysicianIdentificationSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x0010}] = TagInfo{Tag: Tag{0x0040, 0x0010}, VR: "SH", Name: "ScheduledStationName", VM: "1-n"}
+	tagDict[Tag{0x0040, 0x0011}] = TagInfo{Tag: Tag{0x0040, 0x0011}, VR: "SH", Name: "ScheduledProcedureStepLocation", VM: "1"}
+	tagDict[Tag{0x0040, 0x0012}] = TagInfo{Tag: Tag{0x0040, 0x0012}, VR: "LO", Name: "PreMedication", VM: "1"}
+	tagDict[Tag{0x0040, 0x0020}] = TagInfo{Tag: Tag{0x0040, 0x0020}, VR: "CS", Name: "ScheduledProcedureStepStatus", VM: "1"}
+	tagDict[Tag{0x0040, 0x0026}] = TagInfo{Tag: Tag{0x0040, 0x0026}, VR: "SQ", Name: "OrderPlacerIdentifierSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x0027}] = TagInfo{Tag: Tag{0x0040, 0x0027}, VR: "SQ", Name: "OrderFillerIdentifierSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x0031}] = TagInfo{Tag: Tag{0x0040, 0x0031}, VR: "UT", Name: "LocalNamespaceEntityID", VM: "1"}
+	tagDict[Tag{0x0040, 0x0032}] = TagInfo{Tag: Tag{0x0040, 0x0032}, VR: "UT", Name: "UniversalEntityID", VM: "1"}
+	tagDict[Tag{0x0040, 0x0033}] = TagInfo{Tag: Tag{0x0040, 0x0033}, VR: "CS", Name: "UniversalEntityIDType", VM: "1"}
+	tagDict[Tag{0x0040, 0x0035}] = TagInfo{Tag: Tag{0x0040, 0x0035}, VR: "CS", Name: "IdentifierTypeCode", VM: "1"}
+	tagDict[Tag{0x0040, 0x0036}] = TagInfo{Tag: Tag{0x0040, 0x0036}, VR: "SQ", Name: "AssigningFacilitySequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x0039}] = TagInfo{Tag: Tag{0x0040, 0x0039}, VR: "SQ", Name: "AssigningJurisdictionCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x003A}] = TagInfo{Tag: Tag{0x0040, 0x003A}, VR: "SQ", Name: "AssigningAgencyOrDepartmentCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x0100}] = TagInfo{Tag: Tag{0x0040, 0x0100}, VR: "SQ", Name: "ScheduledProcedureStepSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x0220}] = TagInfo{Tag: Tag{0x0040, 0x0220}, VR: "SQ", Name: "ReferencedNonImageCompositeSOPInstanceSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x0241}] = TagInfo{Tag: Tag{0x0040, 0x0241}, VR: "AE", Name: "PerformedStationAETitle", VM: "1"}
+	tagDict[Tag{0x0040, 0x0242}] = TagInfo{Tag: Tag{0x0040, 0x0242}, VR: "SH", Name: "PerformedStationName", VM: "1"}
+	tagDict[Tag{0x0040, 0x0243}] = TagInfo{Tag: Tag{0x0040, 0x0243}, VR: "SH", Name: "PerformedLocation", VM: "1"}
+	tagDict[Tag{0x0040, 0x0244}] = TagInfo{Tag: Tag{0x0040, 0x0244}, VR: "DA", Name: "PerformedProcedureStepStartDate", VM: "1"}
+	tagDict[Tag{0x0040, 0x0245}] = TagInfo{Tag: Tag{0x0040, 0x0245}, VR: "TM", Name: "PerformedProcedureStepStartTime", VM: "1"}
+	tagDict[Tag{0x0040, 0x0250}] = TagInfo{Tag: Tag{0x0040, 0x0250}, VR: "DA", Name: "PerformedProcedureStepEndDate", VM: "1"}
+	tagDict[Tag{0x0040, 0x0251}] = TagInfo{Tag: Tag{0x0040, 0x0251}, VR: "TM", Name: "PerformedProcedureStepEndTime", VM: "1"}
+	tagDict[Tag{0x0040, 0x0252}] = TagInfo{Tag: Tag{0x0040, 0x0252}, VR: "CS", Name: "PerformedProcedureStepStatus", VM: "1"}
+	tagDict[Tag{0x0040, 0x0253}] = TagInfo{Tag: Tag{0x0040, 0x0253}, VR: "SH", Name: "PerformedProcedureStepID", VM: "1"}
+	tagDict[Tag{0x0040, 0x0254}] = TagInfo{Tag: Tag{0x0040, 0x0254}, VR: "LO", Name: "PerformedProcedureStepDescription", VM: "1"}
+	tagDict[Tag{0x0040, 0x0255}] = TagInfo{Tag: Tag{0x0040, 0x0255}, VR: "LO", Name: "PerformedProcedureTypeDescription", VM: "1"}
+	tagDict[Tag{0x0040, 0x0260}] = TagInfo{Tag: Tag{0x0040, 0x0260}, VR: "SQ", Name: "PerformedProtocolCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x0261}] = TagInfo{Tag: Tag{0x0040, 0x0261}, VR: "CS", Name: "PerformedProtocolType", VM: "1"}
+	tagDict[Tag{0x0040, 0x0270}] = TagInfo{Tag: Tag{0x0040, 0x0270}, VR: "SQ", Name: "ScheduledStepAttributesSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x0275}] = TagInfo{Tag: Tag{0x0040, 0x0275}, VR: "SQ", Name: "RequestAttributesSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x0280}] = TagInfo{Tag: Tag{0x0040, 0x0280}, VR: "ST", Name: "CommentsOnThePerformedProcedureStep", VM: "1"}
+	tagDict[Tag{0x0040, 0x0281}] = TagInfo{Tag: Tag{0x0040, 0x0281}, VR: "SQ", Name: "PerformedProcedureStepDiscontinuationReasonCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x0293}] = TagInfo{Tag: Tag{0x0040, 0x0293}, VR: "SQ", Name: "QuantitySequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x0294}] = TagInfo{Tag: Tag{0x0040, 0x0294}, VR: "DS", Name: "Quantity", VM: "1"}
+	tagDict[Tag{0x0040, 0x0295}] = TagInfo{Tag: Tag{0x0040, 0x0295}, VR: "SQ", Name: "MeasuringUnitsSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x0296}] = TagInfo{Tag: Tag{0x0040, 0x0296}, VR: "SQ", Name: "BillingItemSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x0300}] = TagInfo{Tag: Tag{0x0040, 0x0300}, VR: "US", Name: "TotalTimeOfFluoroscopy", VM: "1"}
+	tagDict[Tag{0x0040, 0x0301}] = TagInfo{Tag: Tag{0x0040, 0x0301}, VR: "US", Name: "TotalNumberOfExposures", VM: "1"}
+	tagDict[Tag{0x0040, 0x0302}] = TagInfo{Tag: Tag{0x0040, 0x0302}, VR: "US", Name: "EntranceDose", VM: "1"}
+	tagDict[Tag{0x0040, 0x0303}] = TagInfo{Tag: Tag{0x0040, 0x0303}, VR: "US", Name: "ExposedArea", VM: "1-2"}
+	tagDict[Tag{0x0040, 0x0306}] = TagInfo{Tag: Tag{0x0040, 0x0306}, VR: "DS", Name: "DistanceSourceToEntrance", VM: "1"}
+	tagDict[Tag{0x0040, 0x030E}] = TagInfo{Tag: Tag{0x0040, 0x030E}, VR: "SQ", Name: "ExposureDoseSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x0310}] = TagInfo{Tag: Tag{0x0040, 0x0310}, VR: "ST", Name: "CommentsOnRadiationDose", VM: "1"}
+	tagDict[Tag{0x0040, 0x0312}] = TagInfo{Tag: Tag{0x0040, 0x0312}, VR: "DS", Name: "XRayOutput", VM: "1"}
+	tagDict[Tag{0x0040, 0x0314}] = TagInfo{Tag: Tag{0x0040, 0x0314}, VR: "DS", Name: "HalfValueLayer", VM: "1"}
+	tagDict[Tag{0x0040, 0x0316}] = TagInfo{Tag: Tag{0x0040, 0x0316}, VR: "DS", Name: "OrganDose", VM: "1"}
+	tagDict[Tag{0x0040, 0x0318}] = TagInfo{Tag: Tag{0x0040, 0x0318}, VR: "CS", Name: "OrganExposed", VM: "1"}
+	tagDict[Tag{0x0040, 0x0320}] = TagInfo{Tag: Tag{0x0040, 0x0320}, VR: "SQ", Name: "BillingProcedureStepSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x0321}] = TagInfo{Tag: Tag{0x0040, 0x0321}, VR: "SQ", Name: "FilmConsumptionSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x0324}] = TagInfo{Tag: Tag{0x0040, 0x0324}, VR: "SQ", Name: "BillingSuppliesAndDevicesSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x0340}] = TagInfo{Tag: Tag{0x0040, 0x0340}, VR: "SQ", Name: "PerformedSeriesSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x0400}] = TagInfo{Tag: Tag{0x0040, 0x0400}, VR: "LT", Name: "CommentsOnTheScheduledProcedureStep", VM: "1"}
+	tagDict[Tag{0x0040, 0x0440}] = TagInfo{Tag: Tag{0x0040, 0x0440}, VR: "SQ", Name: "ProtocolContextSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x0441}] = TagInfo{Tag: Tag{0x0040, 0x0441}, VR: "SQ", Name: "ContentItemModifierSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x0500}] = TagInfo{Tag: Tag{0x0040, 0x0500}, VR: "SQ", Name: "ScheduledSpecimenSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x0512}] = TagInfo{Tag: Tag{0x0040, 0x0512}, VR: "LO", Name: "ContainerIdentifier", VM: "1"}
+	tagDict[Tag{0x0040, 0x0513}] = TagInfo{Tag: Tag{0x0040, 0x0513}, VR: "SQ", Name: "IssuerOfTheContainerIdentifierSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x0515}] = TagInfo{Tag: Tag{0x0040, 0x0515}, VR: "SQ", Name: "AlternateContainerIdentifierSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x0518}] = TagInfo{Tag: Tag{0x0040, 0x0518}, VR: "SQ", Name: "ContainerTypeCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x051A}] = TagInfo{Tag: Tag{0x0040, 0x051A}, VR: "LO", Name: "ContainerDescription", VM: "1"}
+	tagDict[Tag{0x0040, 0x0520}] = TagInfo{Tag: Tag{0x0040, 0x0520}, VR: "SQ", Name: "ContainerComponentSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x0551}] = TagInfo{Tag: Tag{0x0040, 0x0551}, VR: "LO", Name: "SpecimenIdentifier", VM: "1"}
+	tagDict[Tag{0x0040, 0x0554}] = TagInfo{Tag: Tag{0x0040, 0x0554}, VR: "UI", Name: "SpecimenUID", VM: "1"}
+	tagDict[Tag{0x0040, 0x0555}] = TagInfo{Tag: Tag{0x0040, 0x0555}, VR: "SQ", Name: "AcquisitionContextSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x0556}] = TagInfo{Tag: Tag{0x0040, 0x0556}, VR: "ST", Name: "AcquisitionContextDescription", VM: "1"}
+	tagDict[Tag{0x0040, 0x0560}] = TagInfo{Tag: Tag{0x0040, 0x0560}, VR: "SQ", Name: "SpecimenDescriptionSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x0562}] = TagInfo{Tag: Tag{0x0040, 0x0562}, VR: "SQ", Name: "IssuerOfTheSpecimenIdentifierSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x059A}] = TagInfo{Tag: Tag{0x0040, 0x059A}, VR: "SQ", Name: "SpecimenTypeCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x0600}] = TagInfo{Tag: Tag{0x0040, 0x0600}, VR: "LO", Name: "SpecimenShortDescription", VM: "1"}
+	tagDict[Tag{0x0040, 0x0602}] = TagInfo{Tag: Tag{0x0040, 0x0602}, VR: "UT", Name: "SpecimenDetailedDescription", VM: "1"}
+	tagDict[Tag{0x0040, 0x0610}] = TagInfo{Tag: Tag{0x0040, 0x0610}, VR: "SQ", Name: "SpecimenPreparationSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x0612}] = TagInfo{Tag: Tag{0x0040, 0x0612}, VR: "SQ", Name: "SpecimenPreparationStepContentItemSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x0620}] = TagInfo{Tag: Tag{0x0040, 0x0620}, VR: "SQ", Name: "SpecimenLocalizationContentItemSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x071A}] = TagInfo{Tag: Tag{0x0040, 0x071A}, VR: "SQ", Name: "ImageCenterPointCoordinatesSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x072A}] = TagInfo{Tag: Tag{0x0040, 0x072A}, VR: "DS", Name: "XOffsetInSlideCoordinateSystem", VM: "1"}
+	tagDict[Tag{0x0040, 0x073A}] = TagInfo{Tag: Tag{0x0040, 0x073A}, VR: "DS", Name: "YOffsetInSlideCoordinateSystem", VM: "1"}
+	tagDict[Tag{0x0040, 0x074A}] = TagInfo{Tag: Tag{0x0040, 0x074A}, VR: "DS", Name: "ZOffsetInSlideCoordinateSystem", VM: "1"}
+	tagDict[Tag{0x0040, 0x08EA}] = TagInfo{Tag: Tag{0x0040, 0x08EA}, VR: "SQ", Name: "PropertyUnitsCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x1001}] = TagInfo{Tag: Tag{0x0040, 0x1001}, VR: "SH", Name: "RequestedProcedureID", VM: "1"}
+	tagDict[Tag{0x0040, 0x1002}] = TagInfo{Tag: Tag{0x0040, 0x1002}, VR: "LO", Name: "ReasonForTheRequestedProcedure", VM: "1"}
+	tagDict[Tag{0x0040, 0x1003}] = TagInfo{Tag: Tag{0x0040, 0x1003}, VR: "SH", Name: "RequestedProcedurePriority", VM: "1"}
+	tagDict[Tag{0x0040, 0x1004}] = TagInfo{Tag: Tag{0x0040, 0x1004}, VR: "LO", Name: "PatientTransportArrangements", VM: "1"}
+	tagDict[Tag{0x0040, 0x1005}] = TagInfo{Tag: Tag{0x0040, 0x1005}, VR: "LO", Name: "RequestedProcedureLocation", VM: "1"}
+	tagDict[Tag{0x0040, 0x1008}] = TagInfo{Tag: Tag{0x0040, 0x1008}, VR: "LO", Name: "ConfidentialityCode", VM: "1"}
+	tagDict[Tag{0x0040, 0x1009}] = TagInfo{Tag: Tag{0x0040, 0x1009}, VR: "SH", Name: "ReportingPriority", VM: "1"}
+	tagDict[Tag{0x0040, 0x100A}] = TagInfo{Tag: Tag{0x0040, 0x100A}, VR: "SQ", Name: "ReasonForRequestedProcedureCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x1010}] = TagInfo{Tag: Tag{0x0040, 0x1010}, VR: "PN", Name: "NamesOfIntendedRecipientsOfResults", VM: "1-n"}
+	tagDict[Tag{0x0040, 0x1011}] = TagInfo{Tag: Tag{0x0040, 0x1011}, VR: "SQ", Name: "IntendedRecipientsOfResultsIdentificationSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x1012}] = TagInfo{Tag: Tag{0x0040, 0x1012}, VR: "SQ", Name: "ReasonForPerformedProcedureCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x1101}] = TagInfo{Tag: Tag{0x0040, 0x1101}, VR: "SQ", Name: "PersonIdentificationCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x1102}] = TagInfo{Tag: Tag{0x0040, 0x1102}, VR: "ST", Name: "PersonAddress", VM: "1"}
+	tagDict[Tag{0x0040, 0x1103}] = TagInfo{Tag: Tag{0x0040, 0x1103}, VR: "LO", Name: "PersonTelephoneNumbers", VM: "1-n"}
+	tagDict[Tag{0x0040, 0x1400}] = TagInfo{Tag: Tag{0x0040, 0x1400}, VR: "LT", Name: "RequestedProcedureComments", VM: "1"}
+	tagDict[Tag{0x0040, 0x2004}] = TagInfo{Tag: Tag{0x0040, 0x2004}, VR: "DA", Name: "IssueDateOfImagingServiceRequest", VM: "1"}
+	tagDict[Tag{0x0040, 0x2005}] = TagInfo{Tag: Tag{0x0040, 0x2005}, VR: "TM", Name: "IssueTimeOfImagingServiceRequest", VM: "1"}
+	tagDict[Tag{0x0040, 0x2008}] = TagInfo{Tag: Tag{0x0040, 0x2008}, VR: "PN", Name: "OrderEnteredBy", VM: "1"}
+	tagDict[Tag{0x0040, 0x2009}] = TagInfo{Tag: Tag{0x0040, 0x2009}, VR: "SH", Name: "OrderEntererLocation", VM: "1"}
+	tagDict[Tag{0x0040, 0x2010}] = TagInfo{Tag: Tag{0x0040, 0x2010}, VR: "SH", Name: "OrderCallbackPhoneNumber", VM: "1"}
+	tagDict[Tag{0x0040, 0x2016}] = TagInfo{Tag: Tag{0x0040, 0x2016}, VR: "LO", Name: "PlacerOrderNumberImagingServiceRequest", VM: "1"}
+	tagDict[Tag{0x0040, 0x2017}] = TagInfo{Tag: Tag{0x0040, 0x2017}, VR: "LO", Name: "FillerOrderNumberImagingServiceRequest", VM: "1"}
+	tagDict[Tag{0x0040, 0x2400}] = TagInfo{Tag: Tag{0x0040, 0x2400}, VR: "LT", Name: "ImagingServiceRequestComments", VM: "1"}
+	tagDict[Tag{0x0040, 0x3001}] = TagInfo{Tag: Tag{0x0040, 0x3001}, VR: "LO", Name: "ConfidentialityConstraintOnPatientDataDescription", VM: "1"}
+	tagDict[Tag{0x0040, 0x4001}] = TagInfo{Tag: Tag{0x0040, 0x4001}, VR: "CS", Name: "GeneralPurposeScheduledProcedureStepStatus", VM: "1"}
+	tagDict[Tag{0x0040, 0x4002}] = TagInfo{Tag: Tag{0x0040, 0x4002}, VR: "CS", Name: "GeneralPurposePerformedProcedureStepStatus", VM: "1"}
+	tagDict[Tag{0x0040, 0x4003}] = TagInfo{Tag: Tag{0x0040, 0x4003}, VR: "CS", Name: "GeneralPurposeScheduledProcedureStepPriority", VM: "1"}
+	tagDict[Tag{0x0040, 0x4004}] = TagInfo{Tag: Tag{0x0040, 0x4004}, VR: "SQ", Name: "ScheduledProcessingApplicationsCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x4005}] = TagInfo{Tag: Tag{0x0040, 0x4005}, VR: "DT", Name: "ScheduledProcedureStepStartDateTime", VM: "1"}
+	tagDict[Tag{0x0040, 0x4006}] = TagInfo{Tag: Tag{0x0040, 0x4006}, VR: "CS", Name: "MultipleCopiesFlag", VM: "1"}
+	tagDict[Tag{0x0040, 0x4007}] = TagInfo{Tag: Tag{0x0040, 0x4007}, VR: "SQ", Name: "PerformedProcessingApplicationsCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x4009}] = TagInfo{Tag: Tag{0x0040, 0x4009}, VR: "SQ", Name: "HumanPerformerCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x4010}] = TagInfo{Tag: Tag{0x0040, 0x4010}, VR: "DT", Name: "ScheduledProcedureStepModificationDateTime", VM: "1"}
+	tagDict[Tag{0x0040, 0x4011}] = TagInfo{Tag: Tag{0x0040, 0x4011}, VR: "DT", Name: "ExpectedCompletionDateTime", VM: "1"}
+	tagDict[Tag{0x0040, 0x4015}] = TagInfo{Tag: Tag{0x0040, 0x4015}, VR: "SQ", Name: "ResultingGeneralPurposePerformedProcedureStepsSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x4016}] = TagInfo{Tag: Tag{0x0040, 0x4016}, VR: "SQ", Name: "ReferencedGeneralPurposeScheduledProcedureStepSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x4018}] = TagInfo{Tag: Tag{0x0040, 0x4018}, VR: "SQ", Name: "ScheduledWorkitemCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x4019}] = TagInfo{Tag: Tag{0x0040, 0x4019}, VR: "SQ", Name: "PerformedWorkitemCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x4020}] = TagInfo{Tag: Tag{0x0040, 0x4020}, VR: "CS", Name: "InputAvailabilityFlag", VM: "1"}
+	tagDict[Tag{0x0040, 0x4021}] = TagInfo{Tag: Tag{0x0040, 0x4021}, VR: "SQ", Name: "InputInformationSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x4022}] = TagInfo{Tag: Tag{0x0040, 0x4022}, VR: "SQ", Name: "RelevantInformationSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x4023}] = TagInfo{Tag: Tag{0x0040, 0x4023}, VR: "UI", Name: "ReferencedGeneralPurposeScheduledProcedureStepTransactionUID", VM: "1"}
+	tagDict[Tag{0x0040, 0x4025}] = TagInfo{Tag: Tag{0x0040, 0x4025}, VR: "SQ", Name: "ScheduledStationNameCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x4026}] = TagInfo{Tag: Tag{0x0040, 0x4026}, VR: "SQ", Name: "ScheduledStationClassCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x4027}] = TagInfo{Tag: Tag{0x0040, 0x4027}, VR: "SQ", Name: "ScheduledStationGeographicLocationCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x4028}] = TagInfo{Tag: Tag{0x0040, 0x4028}, VR: "SQ", Name: "PerformedStationNameCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x4029}] = TagInfo{Tag: Tag{0x0040, 0x4029}, VR: "SQ", Name: "PerformedStationClassCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x4030}] = TagInfo{Tag: Tag{0x0040, 0x4030}, VR: "SQ", Name: "PerformedStationGeographicLocationCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x4031}] = TagInfo{Tag: Tag{0x0040, 0x4031}, VR: "SQ", Name: "RequestedSubsequentWorkitemCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x4032}] = TagInfo{Tag: Tag{0x0040, 0x4032}, VR: "SQ", Name: "NonDICOMOutputCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x4033}] = TagInfo{Tag: Tag{0x0040, 0x4033}, VR: "SQ", Name: "OutputInformationSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x4034}] = TagInfo{Tag: Tag{0x0040, 0x4034}, VR: "SQ", Name: "ScheduledHumanPerformersSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x4035}] = TagInfo{Tag: Tag{0x0040, 0x4035}, VR: "SQ", Name: "ActualHumanPerformersSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x4036}] = TagInfo{Tag: Tag{0x0040, 0x4036}, VR: "LO", Name: "HumanPerformerOrganization", VM: "1"}
+	tagDict[Tag{0x0040, 0x4037}] = TagInfo{Tag: Tag{0x0040, 0x4037}, VR: "PN", Name: "HumanPerformerName", VM: "1"}
+	tagDict[Tag{0x0040, 0x4040}] = TagInfo{Tag: Tag{0x0040, 0x4040}, VR: "CS", Name: "RawDataHandling", VM: "1"}
+	tagDict[Tag{0x0040, 0x4041}] = TagInfo{Tag: Tag{0x0040, 0x4041}, VR: "CS", Name: "InputReadinessState", VM: "1"}
+	tagDict[Tag{0x0040, 0x4050}] = TagInfo{Tag: Tag{0x0040, 0x4050}, VR: "DT", Name: "PerformedProcedureStepStartDateTime", VM: "1"}
+	tagDict[Tag{0x0040, 0x4051}] = TagInfo{Tag: Tag{0x0040, 0x4051}, VR: "DT", Name: "PerformedProcedureStepEndDateTime", VM: "1"}
+	tagDict[Tag{0x0040, 0x4052}] = TagInfo{Tag: Tag{0x0040, 0x4052}, VR: "DT", Name: "ProcedureStepCancellationDateTime", VM: "1"}
+	tagDict[Tag{0x0040, 0x8302}] = TagInfo{Tag: Tag{0x0040, 0x8302}, VR: "DS", Name: "EntranceDoseInmGy", VM: "1"}
+	tagDict[Tag{0x0040, 0x9094}] = TagInfo{Tag: Tag{0x0040, 0x9094}, VR: "SQ", Name: "ReferencedImageRealWorldValueMappingSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x9096}] = TagInfo{Tag: Tag{0x0040, 0x9096}, VR: "SQ", Name: "RealWorldValueMappingSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x9098}] = TagInfo{Tag: Tag{0x0040, 0x9098}, VR: "SQ", Name: "PixelValueMappingCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x9210}] = TagInfo{Tag: Tag{0x0040, 0x9210}, VR: "SH", Name: "LUTLabel", VM: "1"}
+	tagDict[Tag{0x0040, 0x9211}] = TagInfo{Tag: Tag{0x0040, 0x9211}, VR: "US", VRs: []string{"US", "SS"}, Name: "RealWorldValueLastValueMapped", VM: "1"}
+	tagDict[Tag{0x0040, 0x9212}] = TagInfo{Tag: Tag{0x0040, 0x9212}, VR: "FD", Name: "RealWorldValueLUTData", VM: "1-n"}
+	tagDict[Tag{0x0040, 0x9216}] = TagInfo{Tag: Tag{0x0040, 0x9216}, VR: "US", VRs: []string{"US", "SS"}, Name: "RealWorldValueFirstValueMapped", VM: "1"}
+	tagDict[Tag{0x0040, 0x9224}] = TagInfo{Tag: Tag{0x0040, 0x9224}, VR: "FD", Name: "RealWorldValueIntercept", VM: "1"}
+	tagDict[Tag{0x0040, 0x9225}] = TagInfo{Tag: Tag{0x0040, 0x9225}, VR: "FD", Name: "RealWorldValueSlope", VM: "1"}
+	tagDict[Tag{0x0040, 0xA010}] = TagInfo{Tag: Tag{0x0040, 0xA010}, VR: "CS", Name: "RelationshipType", VM: "1"}
+	tagDict[Tag{0x0040, 0xA027}] = TagInfo{Tag: Tag{0x0040, 0xA027}, VR: "LO", Name: "VerifyingOrganization", VM: "1"}
+	tagDict[Tag{0x0040, 0xA030}] = TagInfo{Tag: Tag{0x0040, 0xA030}, VR: "DT", Name: "VerificationDateTime", VM: "1"}
+	tagDict[Tag{0x0040, 0xA032}] = TagInfo{Tag: Tag{0x0040, 0xA032}, VR: "DT", Name: "ObservationDateTime", VM: "1"}
+	tagDict[Tag{0x0040, 0xA040}] = TagInfo{Tag: Tag{0x0040, 0xA040}, VR: "CS", Name: "ValueType", VM: "1"}
+	tagDict[Tag{0x0040, 0xA043}] = TagInfo{Tag: Tag{0x0040, 0xA043}, VR: "SQ", Name: "ConceptNameCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0xA050}] = TagInfo{Tag: Tag{0x0040, 0xA050}, VR: "CS", Name: "ContinuityOfContent", VM: "1"}
+	tagDict[Tag{0x0040, 0xA073}] = TagInfo{Tag: Tag{0x0040, 0xA073}, VR: "SQ", Name: "VerifyingObserverSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0xA075}] = TagInfo{Tag: Tag{0x0040, 0xA075}, VR: "PN", Name: "VerifyingObserverName", VM: "1"}
+	tagDict[Tag{0x0040, 0xA078}] = TagInfo{Tag: Tag{0x0040, 0xA078}, VR: "SQ", Name: "AuthorObserverSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0xA07A}] = TagInfo{Tag: Tag{0x0040, 0xA07A}, VR: "SQ", Name: "ParticipantSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0xA07C}] = TagInfo{Tag: Tag{0x0040, 0xA07C}, VR: "SQ", Name: "CustodialOrganizationSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0xA080}] = TagInfo{Tag: Tag{0x0040, 0xA080}, VR: "CS", Name: "ParticipationType", VM: "1"}
+	tagDict[Tag{0x0040, 0xA082}] = TagInfo{Tag: Tag{0x0040, 0xA082}, VR: "DT", Name: "ParticipationDateTime", VM: "1"}
+	tagDict[Tag{0x0040, 0xA084}] = TagInfo{Tag: Tag{0x0040, 0xA084}, VR: "CS", Name: "ObserverType", VM: "1"}
+	tagDict[Tag{0x0040, 0xA088}] = TagInfo{Tag: Tag{0x0040, 0xA088}, VR: "SQ", Name: "VerifyingObserverIdentificationCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0xA0B0}] = TagInfo{Tag: Tag{0x0040, 0xA0B0}, VR: "US", Name: "ReferencedWaveformChannels", VM: "2-2n"}
+	tagDict[Tag{0x0040, 0xA120}] = TagInfo{Tag: Tag{0x0040, 0xA120}, VR: "DT", Name: "DateTime", VM: "1"}
+	tagDict[Tag{0x0040, 0xA121}] = TagInfo{Tag: Tag{0x0040, 0xA121}, VR: "DA", Name: "Date", VM: "1"}
+	tagDict[Tag{0x0040, 0xA122}] = TagInfo{Tag: Tag{0x0040, 0xA122}, VR: "TM", Name: "Time", VM: "1"}
+	tagDict[Tag{0x0040, 0xA123}] = TagInfo{Tag: Tag{0x0040, 0xA123}, VR: "PN", Name: "PersonName", VM: "1"}
+	tagDict[Tag{0x0040, 0xA124}] = TagInfo{Tag: Tag{0x0040, 0xA124}, VR: "UI", Name: "UID", VM: "1"}
+	tagDict[Tag{0x0040, 0xA130}] = TagInfo{Tag: Tag{0x0040, 0xA130}, VR: "CS", Name: "TemporalRangeType", VM: "1"}
+	tagDict[Tag{0x0040, 0xA132}] = TagInfo{Tag: Tag{0x0040, 0xA132}, VR: "UL", Name: "ReferencedSamplePositions", VM: "1-n"}
+	tagDict[Tag{0x0040, 0xA136}] = TagInfo{Tag: Tag{0x0040, 0xA136}, VR: "US", Name: "ReferencedFrameNumbers", VM: "1-n"}
+	tagDict[Tag{0x0040, 0xA138}] = TagInfo{Tag: Tag{0x0040, 0xA138}, VR: "DS", Name: "ReferencedTimeOffsets", VM: "1-n"}
+	tagDict[Tag{0x0040, 0xA13A}] = TagInfo{Tag: Tag{0x0040, 0xA13A}, VR: "DT", Name: "ReferencedDateTime", VM: "1-n"}
+	tagDict[Tag{0x0040, 0xA160}] = TagInfo{Tag: Tag{0x0040, 0xA160}, VR: "UT", Name: "TextValue", VM: "1"}
+	tagDict[Tag{0x0040, 0xA168}] = TagInfo{Tag: Tag{0x0040, 0xA168}, VR: "SQ", Name: "ConceptCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0xA170}] = TagInfo{Tag: Tag{0x0040, 0xA170}, VR: "SQ", Name: "PurposeOfReferenceCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0xA180}] = TagInfo{Tag: Tag{0x0040, 0xA180}, VR: "US", Name: "AnnotationGroupNumber", VM: "1"}
+	tagDict[Tag{0x0040, 0xA195}] = TagInfo{Tag: Tag{0x0040, 0xA195}, VR: "SQ", Name: "ModifierCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0xA300}] = TagInfo{Tag: Tag{0x0040, 0xA300}, VR: "SQ", Name: "MeasuredValueSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0xA301}] = TagInfo{Tag: Tag{0x0040, 0xA301}, VR: "SQ", Name: "NumericValueQualifierCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0xA30A}] = TagInfo{Tag: Tag{0x0040, 0xA30A}, VR: "DS", Name: "PropertyValue", VM: "1-n"}
+	tagDict[Tag{0x0040, 0xA360}] = TagInfo{Tag: Tag{0x0040, 0xA360}, VR: "SQ", Name: "PredecessorDocumentsSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0xA370}] = TagInfo{Tag: Tag{0x0040, 0xA370}, VR: "SQ", Name: "ReferencedRequestSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0xA372}] = TagInfo{Tag: Tag{0x0040, 0xA372}, VR: "SQ", Name: "PerformedProcedureCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0xA375}] = TagInfo{Tag: Tag{0x0040, 0xA375}, VR: "SQ", Name: "CurrentRequestedProcedureEvidenceSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0xA385}] = TagInfo{Tag: Tag{0x0040, 0xA385}, VR: "SQ", Name: "PertinentOtherEvidenceSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0xA390}] = TagInfo{Tag: Tag{0x0040, 0xA390}, VR: "SQ", Name: "HL7StructuredDocumentReferenceSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0xA491}] = TagInfo{Tag: Tag{0x0040, 0xA491}, VR: "CS", Name: "CompletionFlag", VM: "1"}
+	tagDict[Tag{0x0040, 0xA492}] = TagInfo{Tag: Tag{0x0040, 0xA492}, VR: "LO", Name: "CompletionFlagDescription", VM: "1"}
+	tagDict[Tag{0x0040, 0xA493}] = TagInfo{Tag: Tag{0x0040, 0xA493}, VR: "CS", Name: "VerificationFlag", VM: "1"}
+	tagDict[Tag{0x0040, 0xA494}] = TagInfo{Tag: Tag{0x0040, 0xA494}, VR: "CS", Name: "ArchiveRequested", VM: "1"}
+	tagDict[Tag{0x0040, 0xA496}] = TagInfo{Tag: Tag{0x0040, 0xA496}, VR: "CS", Name: "PreliminaryFlag", VM: "1"}
+	tagDict[Tag{0x0040, 0xA504}] = TagInfo{Tag: Tag{0x0040, 0xA504}, VR: "SQ", Name: "ContentTemplateSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0xA525}] = TagInfo{Tag: Tag{0x0040, 0xA525}, VR: "SQ", Name: "IdenticalDocumentsSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0xA730}] = TagInfo{Tag: Tag{0x0040, 0xA730}, VR: "SQ", Name: "ContentSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0xB020}] = TagInfo{Tag: Tag{0x0040, 0xB020}, VR: "SQ", Name: "WaveformAnnotationSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0xDB00}] = TagInfo{Tag: Tag{0x0040, 0xDB00}, VR: "CS", Name: "TemplateIdentifier", VM: "1"}
+	tagDict[Tag{0x0040, 0xDB73}] = TagInfo{Tag: Tag{0x0040, 0xDB73}, VR: "UL", Name: "ReferencedContentItemIdentifier", VM: "1-n"}
+	tagDict[Tag{0x0040, 0xE001}] = TagInfo{Tag: Tag{0x0040, 0xE001}, VR: "ST", Name: "HL7InstanceIdentifier", VM: "1"}
+	tagDict[Tag{0x0040, 0xE004}] = TagInfo{Tag: Tag{0x0040, 0xE004}, VR: "DT", Name: "HL7DocumentEffectiveTime", VM: "1"}
+	tagDict[Tag{0x0040, 0xE006}] = TagInfo{Tag: Tag{0x0040, 0xE006}, VR: "SQ", Name: "HL7DocumentTypeCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0xE008}] = TagInfo{Tag: Tag{0x0040, 0xE008}, VR: "SQ", Name: "DocumentClassCodeSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0xE010}] = TagInfo{Tag: Tag{0x0040, 0xE010}, VR: "UT", Name: "RetrieveURI", VM: "1"}
+	tagDict[Tag{0x0040, 0xE011}] = TagInfo{Tag: Tag{0x0040, 0xE011}, VR: "UI", Name: "RetrieveLocationUID", VM: "1"}
+	tagDict[Tag{0x0040, 0xE020}] = TagInfo{Tag: Tag{0x0040, 0xE020}, VR: "CS", Name: "TypeOfInstances", VM: "1"}
+	tagDict[Tag{0x0040, 0xE021}] = TagInfo{Tag: Tag{0x0040, 0xE021}, VR: "SQ", Name: "DICOMRetrievalSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0xE022}] = TagInfo{Tag: Tag{0x0040, 0xE022}, VR: "SQ", Name: "DICOMMediaRetrievalSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0xE023}] = TagInfo{Tag: Tag{0x0040, 0xE023}, VR: "SQ", Name: "WADORetrievalSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0xE024}] = TagInfo{Tag: Tag{0x0040, 0xE024}, VR: "SQ", Name: "XDSRetrievalSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0xE030}] = TagInfo{Tag: Tag{0x0040, 0xE030}, VR: "UI", Name: "RepositoryUniqueID", VM: "1"}
+	tagDict[Tag{0x0040, 0xE031}] = TagInfo{Tag: Tag{0x0040, 0xE031}, VR: "UI", Name: "HomeCommunityID", VM: "1"}
+	tagDict[Tag{0x0042, 0x0010}] = TagInfo{Tag: Tag{0x0042, 0x0010}, VR: "ST", Name: "DocumentTitle", VM: "1"}
+	tagDict[Tag{0x0042, 0x0011}] = TagInfo{Tag: Tag{0x0042, 0x0011}, VR: "OB", Name: "EncapsulatedDocument", VM: "1"}
+	tagDict[Tag{0x0042, 0x0012}] = TagInfo{Tag: Tag{0x0042, 0x0012}, VR: "LO", Name: "MIMETypeOfEncapsulatedDocument", VM: "1"}
+	tagDict[Tag{0x0042, 0x0013}] = TagInfo{Tag: Tag{0x0042, 0x0013}, VR: "SQ", Name: "SourceInstanceSequence", VM: "1"}
+	tagDict[Tag{0x0042, 0x0014}] = TagInfo{Tag: Tag{0x0042, 0x0014}, VR: "LO", Name: "ListOfMIMETypes", VM: "1-n"}
+	tagDict[Tag{0x0044, 0x0001}] = TagInfo{Tag: Tag{0x0044, 0x0001}, VR: "ST", Name: "ProductPackageIdentifier", VM: "1"}
+	tagDict[Tag{0x0044, 0x0002}] = TagInfo{Tag: Tag{0x0044, 0x0002}, VR: "CS", Name: "SubstanceAdministrationApproval", VM: "1"}
+	tagDict[Tag{0x0044, 0x0003}] = TagInfo{Tag: Tag{0x0044, 0x0003}, VR: "LT", Name: "ApprovalStatusFurtherDescription", VM: "1"}
+	tagDict[Tag{0x0044, 0x0004}] = TagInfo{Tag: Tag{0x0044, 0x0004}, VR: "DT", Name: "ApprovalStatusDateTime", VM: "1"}
+	tagDict[Tag{0x0044, 0x0007}] = TagInfo{Tag: Tag{0x0044, 0x0007}, VR: "SQ", Name: "ProductTypeCodeSequence", VM: "1"}
+	tagDict[Tag{0x0044, 0x0008}] = TagInfo{Tag: Tag{0x0044, 0x0008}, VR: "LO", Name: "ProductName", VM: "1-n"}
+	tagDict[Tag{0x0044, 0x0009}] = TagInfo{Tag: Tag{0x0044, 0x0009}, VR: "LT", Name: "ProductDescription", VM: "1"}
+	tagDict[Tag{0x0044, 0x000A}] = TagInfo{Tag: Tag{0x0044, 0x000A}, VR: "LO", Name: "ProductLotIdentifier", VM: "1"}
+	tagDict[Tag{0x0044, 0x000B}] = TagInfo{Tag: Tag{0x0044, 0x000B}, VR: "DT", Name: "ProductExpirationDateTime", VM: "1"}
+	tagDict[Tag{0x0044, 0x0010}] = TagInfo{Tag: Tag{0x0044, 0x0010}, VR: "DT", Name: "SubstanceAdministrationDateTime", VM: "1"}
+	tagDict[Tag{0x0044, 0x0011}] = TagInfo{Tag: Tag{0x0044, 0x0011}, VR: "LO", Name: "SubstanceAdministrationNotes", VM: "1"}
+	tagDict[Tag{0x0044, 0x0012}] = TagInfo{Tag: Tag{0x0044, 0x0012}, VR: "LO", Name: "SubstanceAdministrationDeviceID", VM: "1"}
+	tagDict[Tag{0x0044, 0x0013}] = TagInfo{Tag: Tag{0x0044, 0x0013}, VR: "SQ", Name: "ProductParameterSequence", VM: "1"}
+	tagDict[Tag{0x0044, 0x0019}] = TagInfo{Tag: Tag{0x0044, 0x0019}, VR: "SQ", Name: "SubstanceAdministrationParameterSequence", VM: "1"}
+	tagDict[Tag{0x0046, 0x0012}] = TagInfo{Tag: Tag{0x0046, 0x0012}, VR: "LO", Name: "LensDescription", VM: "1"}
+	tagDict[Tag{0x0046, 0x0014}] = TagInfo{Tag: Tag{0x0046, 0x0014}, VR: "SQ", Name: "RightLensSequence", VM: "1"}
+	tagDict[Tag{0x0046, 0x0015}] = TagInfo{Tag: Tag{0x0046, 0x0015}, VR: "SQ", Name: "LeftLensSequence", VM: "1"}
+	tagDict[Tag{0x0046, 0x0016}] = TagInfo{Tag: Tag{0x0046, 0x0016}, VR: "SQ", Name: "UnspecifiedLateralityLensSequence", VM: "1"}
+	tagDict[Tag{0x0046, 0x0018}] = TagInfo{Tag: Tag{0x0046, 0x0018}, VR: "SQ", Name: "CylinderSequence", VM: "1"}
+	tagDict[Tag{0x0046, 0x0028}] = TagInfo{Tag: Tag{0x0046, 0x0028}, VR: "SQ", Name: "PrismSequence", VM: "1"}
+	tagDict[Tag{0x0046, 0x0030}] = TagInfo{Tag: Tag{0x0046, 0x0030}, VR: "FD", Name: "HorizontalPrismPower", VM: "1"}
+	tagDict[Tag{0x0046, 0x0032}] = TagInfo{Tag: Tag{0x0046, 0x0032}, VR: "CS", Name: "HorizontalPrismBase", VM: "1"}
+	tagDict[Tag{0x0046, 0x0034}] = TagInfo{Tag: Tag{0x0046, 0x0034}, VR: "FD", Name: "VerticalPrismPower", VM: "1"}
+	tagDict[Tag{0x0046, 0x0036}] = TagInfo{Tag: Tag{0x0046, 0x0036}, VR: "CS", Name: "VerticalPrismBase", VM: "1"}
+	tagDict[Tag{0x0046, 0x0038}] = TagInfo{Tag: Tag{0x0046, 0x0038}, VR: "CS", Name: "LensSegmentType", VM: "1"}
+	tagDict[Tag{0x0046, 0x0040}] = TagInfo{Tag: Tag{0x0046, 0x0040}, VR: "FD", Name: "OpticalTransmittance", VM: "1"}
+	tagDict[Tag{0x0046, 0x0042}] = TagInfo{Tag: Tag{0x0046, 0x0042}, VR: "FD", Name: "ChannelWidth", VM: "1"}
+	tagDict[Tag{0x0046, 0x0044}] = TagInfo{Tag: Tag{0x0046, 0x0044}, VR: "FD", Name: "PupilSize", VM: "1"}
+	tagDict[Tag{0x0046, 0x0046}] = TagInfo{Tag: Tag{0x0046, 0x0046}, VR: "FD", Name: "CornealSize", VM: "1"}
+	tagDict[Tag{0x0046, 0x0050}] = TagInfo{Tag: Tag{0x0046, 0x0050}, VR: "SQ", Name: "AutorefractionRightEyeSequence", VM: "1"}
+	tagDict[Tag{0x0046, 0x0052}] = TagInfo{Tag: Tag{0x0046, 0x0052}, VR: "SQ", Name: "AutorefractionLeftEyeSequence", VM: "1"}
+	tagDict[Tag{0x0046, 0x0060}] = TagInfo{Tag: Tag{0x0046, 0x0060}, VR: "FD", Name: "DistancePupillaryDistance", VM: "1"}
+	tagDict[Tag{0x0046, 0x0062}] = TagInfo{Tag: Tag{0x0046, 0x0062}, VR: "FD", Name: "NearPupillaryDistance", VM: "1"}
+	tagDict[Tag{0x0046, 0x0063}] = TagInfo{Tag: Tag{0x0046, 0x0063}, VR: "FD", Name: "IntermediatePupillaryDistance", VM: "1"}
+	tagDict[Tag{0x0046, 0x0064}] = TagInfo{Tag: Tag{0x0046, 0x0064}, VR: "FD", Name: "OtherPupillaryDistance", VM: "1"}
+	tagDict[Tag{0x0046, 0x0070}] = TagInfo{Tag: Tag{0x0046, 0x0070}, VR: "SQ", Name: "KeratometryRightEyeSequence", VM: "1"}
+	tagDict[Tag{0x0046, 0x0071}] = TagInfo{Tag: Tag{0x0046, 0x0071}, VR: "SQ", Name: "KeratometryLeftEyeSequence", VM: "1"}
+	tagDict[Tag{0x0046, 0x0074}] = TagInfo{Tag: Tag{0x0046, 0x0074}, VR: "SQ", Name: "SteepKeratometricAxisSequence", VM: "1"}
+	tagDict[Tag{0x0046, 0x0075}] = TagInfo{Tag: Tag{0x0046, 0x0075}, VR: "FD", Name: "RadiusOfCurvature", VM: "1"}
+	tagDict[Tag{0x0046, 0x0076}] = TagInfo{Tag: Tag{0x0046, 0x0076}, VR: "FD", Name: "KeratometricPower", VM: "1"}
+	tagDict[Tag{0x0046, 0x0077}] = TagInfo{Tag: Tag{0x0046, 0x0077}, VR: "FD", Name: "KeratometricAxis", VM: "1"}
+	tagDict[Tag{0x0046, 0x0080}] = TagInfo{Tag: Tag{0x0046, 0x0080}, VR: "SQ", Name: "FlatKeratometricAxisSequence", VM: "1"}
+	tagDict[Tag{0x0046, 0x0092}] = TagInfo{Tag: Tag{0x0046, 0x0092}, VR: "CS", Name: "BackgroundColor", VM: "1"}
+	tagDict[Tag{0x0046, 0x0094}] = TagInfo{Tag: Tag{0x0046, 0x0094}, VR: "CS", Name: "Optotype", VM: "1"}
+	tagDict[Tag{0x0046, 0x0095}] = TagInfo{Tag: Tag{0x0046, 0x0095}, VR: "CS", Name: "OptotypePresentation", VM: "1"}
+	tagDict[Tag{0x0046, 0x0097}] = TagInfo{Tag: Tag{0x0046, 0x0097}, VR: "SQ", Name: "SubjectiveRefractionRightEyeSequence", VM: "1"}
+	tagDict[Tag{0x0046, 0x0098}] = TagInfo{Tag: Tag{0x0046, 0x0098}, VR: "SQ", Name: "SubjectiveRefractionLeftEyeSequence", VM: "1"}
+	tagDict[Tag{0x0046, 0x0100}] = TagInfo{Tag: Tag{0x0046, 0x0100}, VR: "SQ", Name: "AddNearSequence", VM: "1"}
+	tagDict[Tag{0x0046, 0x0101}] = TagInfo{Tag: Tag{0x0046, 0x0101}, VR: "SQ", Name: "AddIntermediateSequence", VM: "1"}
+	tagDict[Tag{0x0046, 0x0102}] = TagInfo{Tag: Tag{0x0046, 0x0102}, VR: "SQ", Name: "AddOtherSequence", VM: "1"}
+	tagDict[Tag{0x0046, 0x0104}] = TagInfo{Tag: Tag{0x0046, 0x0104}, VR: "FD", Name: "AddPower", VM: "1"}
+	tagDict[Tag{0x0046, 0x0106}] = TagInfo{Tag: Tag{0x0046, 0x0106}, VR: "FD", Name: "ViewingDistance", VM: "1"}
+	tagDict[Tag{0x0046, 0x0121}] = TagInfo{Tag: Tag{0x0046, 0x0121}, VR: "SQ", Name: "VisualAcuityTypeCodeSequence", VM: "1"}
+	tagDict[Tag{0x0046, 0x0122}] = TagInfo{Tag: Tag{0x0046, 0x0122}, VR: "SQ", Name: "VisualAcuityRightEyeSequence", VM: "1"}
+	tagDict[Tag{0x0046, 0x0123}] = TagInfo{Tag: Tag{0x0046, 0x0123}, VR: "SQ", Name: "VisualAcuityLeftEyeSequence", VM: "1"}
+	tagDict[Tag{0x0046, 0x0124}] = TagInfo{Tag: Tag{0x0046, 0x0124}, VR: "SQ", Name: "VisualAcuityBothEyesOpenSequence", VM: "1"}
+	tagDict[Tag{0x0046, 0x0125}] = TagInfo{Tag: Tag{0x0046, 0x0125}, VR: "CS", Name: "ViewingDistanceType", VM: "1"}
+	tagDict[Tag{0x0046, 0x0135}] = TagInfo{Tag: Tag{0x0046, 0x0135}, VR: "SS", Name: "VisualAcuityModifiers", VM: "2"}
+	tagDict[Tag{0x0046, 0x0137}] = TagInfo{Tag: Tag{0x0046, 0x0137}, VR: "FD", Name: "DecimalVisualAcuity", VM: "1"}
+	tagDict[Tag{0x0046, 0x0139}] = TagInfo{Tag: Tag{0x0046, 0x0139}, VR: "LO", Name: "OptotypeDetailedDefinition", VM: "1"}
+	tagDict[Tag{0x0046, 0x0145}] = TagInfo{Tag: Tag{0x0046, 0x0145}, VR: "SQ", Name: "ReferencedRefractiveMeasurementsSequence", VM: "1"}
+	tagDict[Tag{0x0046, 0x0146}] = TagInfo{Tag: Tag{0x0046, 0x0146}, VR: "FD", Name: "SpherePower", VM: "1"}
+	tagDict[Tag{0x0046, 0x0147}] = TagInfo{Tag: Tag{0x0046, 0x0147}, VR: "FD", Name: "CylinderPower", VM: "1"}
+	tagDict[Tag{0x0048, 0x0001}] = TagInfo{Tag: Tag{0x0048, 0x0001}, VR: "FL", Name: "ImagedVolumeWidth", VM: "1"}
+	tagDict[Tag{0x0048, 0x0002}] = TagInfo{Tag: Tag{0x0048, 0x0002}, VR: "FL", Name: "ImagedVolumeHeight", VM: "1"}
+	tagDict[Tag{0x0048, 0x0003}] = TagInfo{Tag: Tag{0x0048, 0x0003}, VR: "FL", Name: "ImagedVolumeDepth", VM: "1"}
+	tagDict[Tag{0x0048, 0x0006}] = TagInfo{Tag: Tag{0x0048, 0x0006}, VR: "UL", Name: "TotalPixelMatrixColumns", VM: "1"}
+	tagDict[Tag{0x0048, 0x0007}] = TagInfo{Tag: Tag{0x0048, 0x0007}, VR: "UL", Name: "TotalPixelMatrixRows", VM: "1"}
+	tagDict[Tag{0x0048, 0x0008}] = TagInfo{Tag: Tag{0x0048, 0x0008}, VR: "SQ", Name: "TotalPixelMatrixOriginSequence", VM: "1"}
+	tagDict[Tag{0x0048, 0x0010}] = TagInfo{Tag: Tag{0x0048, 0x0010}, VR: "CS", Name: "SpecimenLabelInImage", VM: "1"}
+	tagDict[Tag{0x0048, 0x0011}] = TagInfo{Tag: Tag{0x0048, 0x0011}, VR: "CS", Name: "FocusMethod", VM: "1"}
+	tagDict[Tag{0x0048, 0x0012}] = TagInfo{Tag: Tag{0x0048, 0x0012}, VR: "CS", Name: "ExtendedDepthOfField", VM: "1"}
+	tagDict[Tag{0x0048, 0x0013}] = TagInfo{Tag: Tag{0x0048, 0x0013}, VR: "US", Name: "NumberOfFocalPlanes", VM: "1"}
+	tagDict[Tag{0x0048, 0x0014}] = TagInfo{Tag: Tag{0x0048, 0x0014}, VR: "FL", Name: "DistanceBetweenFocalPlanes", VM: "1"}
+	tagDict[Tag{0x0048, 0x0015}] = TagInfo{Tag: Tag{0x0048, 0x0015}, VR: "US", Name: "RecommendedAbsentPixelCIELabValue", VM: "3"}
+	tagDict[Tag{0x0048, 0x0100}] = TagInfo{Tag: Tag{0x0048, 0x0100}, VR: "SQ", Name: "IlluminatorTypeCodeSequence", VM: "1"}
+	tagDict[Tag{0x0048, 0x0102}] = TagInfo{Tag: Tag{0x0048, 0x0102}, VR: "DS", Name: "ImageOrientationSlide", VM: "6"}
+	tagDict[Tag{0x0048, 0x0105}] = TagInfo{Tag: Tag{0x0048, 0x0105}, VR: "SQ", Name: "OpticalPathSequence", VM: "1"}
+	tagDict[Tag{0x0048, 0x0106}] = TagInfo{Tag: Tag{0x0048, 0x0106}, VR: "SH", Name: "OpticalPathIdentifier", VM: "1"}
+	tagDict[Tag{0x0048, 0x0107}] = TagInfo{Tag: Tag{0x0048, 0x0107}, VR: "ST", Name: "OpticalPathDescription", VM: "1"}
+	tagDict[Tag{0x0048, 0x0108}] = TagInfo{Tag: Tag{0x0048, 0x0108}, VR: "SQ", Name: "IlluminationColorCodeSequence", VM: "1"}
+	tagDict[Tag{0x0048, 0x0110}] = TagInfo{Tag: Tag{0x0048, 0x0110}, VR: "SQ", Name: "SpecimenReferenceSequence", VM: "1"}
+	tagDict[Tag{0x0048, 0x0111}] = TagInfo{Tag: Tag{0x0048, 0x0111}, VR: "DS", Name: "CondenserLensPower", VM: "1"}
+	tagDict[Tag{0x0048, 0x0112}] = TagInfo{Tag: Tag{0x0048, 0x0112}, VR: "DS", Name: "ObjectiveLensPower", VM: "1"}
+	tagDict[Tag{0x0048, 0x0113}] = TagInfo{Tag: Tag{0x0048, 0x0113}, VR: "DS", Name: "ObjectiveLensNumericalAperture", VM: "1"}
+	tagDict[Tag{0x0048, 0x0120}] = TagInfo{Tag: Tag{0x0048, 0x0120}, VR: "SQ", Name: "PaletteColorLookupTableSequence", VM: "1"}
+	tagDict[Tag{0x0048, 0x0200}] = TagInfo{Tag: Tag{0x0048, 0x0200}, VR: "SQ", Name: "ReferencedImageNavigationSequence", VM: "1"}
+	tagDict[Tag{0x0048, 0x0201}] = TagInfo{Tag: Tag{0x0048, 0x0201}, VR: "US", Name: "TopLeftHandCornerOfLocalizerArea", VM: "2"}
+	tagDict[Tag{0x0048, 0x0202}] = TagInfo{Tag: Tag{0x0048, 0x0202}, VR: "US", Name: "BottomRightHandCornerOfLocalizerArea", VM: "2"}
+	tagDict[Tag{0x0048, 0x0207}] = TagInfo{Tag: Tag{0x0048, 0x0207}, VR: "SQ", Name: "OpticalPathIdentificationSequence", VM: "1"}
+	tagDict[Tag{0x0048, 0x021A}] = TagInfo{Tag: Tag{0x0048, 0x021A}, VR: "SQ", Name: "PlanePositionSlideSequence", VM: "1"}
+	tagDict[Tag{0x0048, 0x021E}] = TagInfo{Tag: Tag{0x0048, 0x021E}, VR: "SL", Name: "ColumnPositionInTotalImagePixelMatrix", VM: "1"}
+	tagDict[Tag{0x0048, 0x021F}] = TagInfo{Tag: Tag{0x0048, 0x021F}, VR: "SL", Name: "RowPositionInTotalImagePixelMatrix", VM: "1"}
+	tagDict[Tag{0x0048, 0x0301}] = TagInfo{Tag: Tag{0x0048, 0x0301}, VR: "CS", Name: "PixelOriginInterpretation", VM: "1"}
+	tagDict[Tag{0x0050, 0x0004}] = TagInfo{Tag: Tag{0x0050, 0x0004}, VR: "CS", Name: "CalibrationImage", VM: "1"}
+	tagDict[Tag{0x0050, 0x0010}] = TagInfo{Tag: Tag{0x0050, 0x0010}, VR: "SQ", Name: "DeviceSequence", VM: "1"}
+	tagDict[Tag{0x0050, 0x0012}] = TagInfo{Tag: Tag{0x0050, 0x0012}, VR: "SQ", Name: "ContainerComponentTypeCodeSequence", VM: "1"}
+	tagDict[Tag{0x0050, 0x0013}] = TagInfo{Tag: Tag{0x0050, 0x0013}, VR: "FD", Name: "ContainerComponentThickness", VM: "1"}
+	tagDict[Tag{0x0050, 0x0014}] = TagInfo{Tag: Tag{0x0050, 0x0014}, VR: "DS", Name: "DeviceLength", VM: "1"}
+	tagDict[Tag{0x0050, 0x0015}] = TagInfo{Tag: Tag{0x0050, 0x0015}, VR: "FD", Name: "ContainerComponentWidth", VM: "1"}
+	tagDict[Tag{0x0050, 0x0016}] = TagInfo{Tag: Tag{0x0050, 0x0016}, VR: "DS", Name: "DeviceDiameter", VM: "1"}
+	tagDict[Tag{0x0050, 0x0017}] = TagInfo{Tag: Tag{0x0050, 0x0017}, VR: "CS", Name: "DeviceDiameterUnits", VM: "1"}
+	tagDict[Tag{0x0050, 0x0018}] = TagInfo{Tag: Tag{0x0050, 0x0018}, VR: "DS", Name: "DeviceVolume", VM: "1"}
+	tagDict[Tag{0x0050, 0x0019}] = TagInfo{Tag: Tag{0x0050, 0x0019}, VR: "DS", Name: "InterMarkerDistance", VM: "1"}
+	tagDict[Tag{0x0050, 0x001A}] = TagInfo{Tag: Tag{0x0050, 0x001A}, VR: "CS", Name: "ContainerComponentMaterial", VM: "1"}
+	tagDict[Tag{0x0050, 0x001B}] = TagInfo{Tag: Tag{0x0050, 0x001B}, VR: "LO", Name: "ContainerComponentID", VM: "1"}
+	tagDict[Tag{0x0050, 0x001C}] = TagInfo{Tag: Tag{0x0050, 0x001C}, VR: "FD", Name: "ContainerComponentLength", VM: "1"}
+	tagDict[Tag{0x0050, 0x001D}] = TagInfo{Tag: Tag{0x0050, 0x001D}, VR: "FD", Name: "ContainerComponentDiameter", VM: "1"}
+	tagDict[Tag{0x0050, 0x001E}] = TagInfo{Tag: Tag{0x0050, 0x001E}, VR: "LO", Name: "ContainerComponentDescription", VM: "1"}
+	tagDict[Tag{0x0050, 0x0020}] = TagInfo{Tag: Tag{0x0050, 0x0020}, VR: "LO", Name: "DeviceDescription", VM: "1"}
+	tagDict[Tag{0x0052, 0x0001}] = TagInfo{Tag: Tag{0x0052, 0x0001}, VR: "FL", Name: "ContrastBolusIngredientPercentByVolume", VM: "1"}
+	tagDict[Tag{0x0052, 0x0002}] = TagInfo{Tag: Tag{0x0052, 0x0002}, VR: "FD", Name: "OCTFocalDistance", VM: "1"}
+	tagDict[Tag{0x0052, 0x0003}] = TagInfo{Tag: Tag{0x0052, 0x0003}, VR: "FD", Name: "BeamSpotSize", VM: "1"}
+	tagDict[Tag{0x0052, 0x0004}] = TagInfo{Tag: Tag{0x0052, 0x0004}, VR: "FD", Name: "EffectiveRefractiveIndex", VM: "1"}
+	tagDict[Tag{0x0052, 0x0006}] = TagInfo{Tag: Tag{0x0052, 0x0006}, VR: "CS", Name: "OCTAcquisitionDomain", VM: "1"}
+	tagDict[Tag{0x0052, 0x0007}] = TagInfo{Tag: Tag{0x0052, 0x0007}, VR: "FD", Name: "OCTOpticalCenterWavelength", VM: "1"}
+	tagDict[Tag{0x0052, 0x0008}] = TagInfo{Tag: Tag{0x0052, 0x0008}, VR: "FD", Name: "AxialResolution", VM: "1"}
+	tagDict[Tag{0x0052, 0x0009}] = TagInfo{Tag: Tag{0x0052, 0x0009}, VR: "FD", Name: "RangingDepth", VM: "1"}
+	tagDict[Tag{0x0052, 0x0011}] = TagInfo{Tag: Tag{0x0052, 0x0011}, VR: "FD", Name: "ALineRate", VM: "1"}
+	tagDict[Tag{0x0052, 0x0012}] = TagInfo{Tag: Tag{0x0052, 0x0012}, VR: "US", Name: "ALinesPerFrame", VM: "1"}
+	tagDict[Tag{0x0052, 0x0013}] = TagInfo{Tag: Tag{0x0052, 0x0013}, VR: "FD", Name: "CatheterRotationalRate", VM: "1"}
+	tagDict[Tag{0x0052, 0x0014}] = TagInfo{Tag: Tag{0x0052, 0x0014}, VR: "FD", Name: "ALinePixelSpacing", VM: "1"}
+	tagDict[Tag{0x0052, 0x0016}] = TagInfo{Tag: Tag{0x0052, 0x0016}, VR: "SQ", Name: "ModeOfPercutaneousAccessSequence", VM: "1"}
+	tagDict[Tag{0x0052, 0x0025}] = TagInfo{Tag: Tag{0x0052, 0x0025}, VR: "SQ", Name: "IntravascularOCTFrameTypeSequence", VM: "1"}
+	tagDict[Tag{0x0052, 0x0026}] = TagInfo{Tag: Tag{0x0052, 0x0026}, VR: "CS", Name: "OCTZOffsetApplied", VM: "1"}
+	tagDict[Tag{0x0052, 0x0027}] = TagInfo{Tag: Tag{0x0052, 0x0027}, VR: "SQ", Name: "IntravascularFrameContentSequence", VM: "1"}
+	tagDict[Tag{0x0052, 0x0028}] = TagInfo{Tag: Tag{0x0052, 0x0028}, VR: "FD", Name: "IntravascularLongitudinalDistance", VM: "1"}
+	tagDict[Tag{0x0052, 0x0029}] = TagInfo{Tag: Tag{0x0052, 0x0029}, VR: "SQ", Name: "IntravascularOCTFrameContentSequence", VM: "1"}
+	tagDict[Tag{0x0052, 0x0030}] = TagInfo{Tag: Tag{0x0052, 0x0030}, VR: "SS", Name: "OCTZOffsetCorrection", VM: "1"}
+	tagDict[Tag{0x0052, 0x0031}] = TagInfo{Tag: Tag{0x0052, 0x0031}, VR: "CS", Name: "CatheterDirectionOfRotation", VM: "1"}
+	tagDict[Tag{0x0052, 0x0033}] = TagInfo{Tag: Tag{0x0052, 0x0033}, VR: "FD", Name: "SeamLineLocation", VM: "1"}
+	tagDict[Tag{0x0052, 0x0034}] = TagInfo{Tag: Tag{0x0052, 0x0034}, VR: "FD", Name: "FirstALineLocation", VM: "1"}
+	tagDict[Tag{0x0052, 0x0036}] = TagInfo{Tag: Tag{0x0052, 0x0036}, VR: "US", Name: "SeamLineIndex", VM: "1"}
+	tagDict[Tag{0x0052, 0x0038}] = TagInfo{Tag: Tag{0x0052, 0x0038}, VR: "US", Name: "NumberOfPaddedAlines", VM: "1"}
+	tagDict[Tag{0x0052, 0x0039}] = TagInfo{Tag: Tag{0x0052, 0x0039}, VR: "CS", Name: "InterpolationType", VM: "1"}
+	tagDict[Tag{0x0052, 0x003A}] = TagInfo{Tag: Tag{0x0052, 0x003A}, VR: "CS", Name: "RefractiveIndexApplied", VM: "1"}
+	tagDict[Tag{0x0054, 0x0010}] = TagInfo{Tag: Tag{0x0054, 0x0010}, VR: "US", Name: "EnergyWindowVector", VM: "1-n"}
+	tagDict[Tag{0x0054, 0x0011}] = TagInfo{Tag: Tag{0x0054, 0x0011}, VR: "US", Name: "NumberOfEnergyWindows", VM: "1"}
+	tagDict[Tag{0x0054, 0x0012}] = TagInfo{Tag: Tag{0x0054, 0x0012}, VR: "SQ", Name: "EnergyWindowInformationSequence", VM: "1"}
+	tagDict[Tag{0x0054, 0x0013}] = TagInfo{Tag: Tag{0x0054, 0x0013}, VR: "SQ", Name: "EnergyWindowRangeSequence", VM: "1"}
+	tagDict[Tag{0x0054, 0x0014}] = TagInfo{Tag: Tag{0x0054, 0x0014}, VR: "DS", Name: "EnergyWindowLowerLimit", VM: "1"}
+	tagDict[Tag{0x0054, 0x0015}] = TagInfo{Tag: Tag{0x0054, 0x0015}, VR: "DS", Name: "EnergyWindowUpperLimit", VM: "1"}
+	tagDict[Tag{0x0054, 0x0016}] = TagInfo{Tag: Tag{0x0054, 0x0016}, VR: "SQ", Name: "RadiopharmaceuticalInformationSequence", VM: "1"}
+	tagDict[Tag{0x0054, 0x0017}] = TagInfo{Tag: Tag{0x0054, 0x0017}, VR: "IS", Name: "ResidualSyringeCounts", VM: "1"}
+	tagDict[Tag{0x0054, 0x0018}] = TagInfo{Tag: Tag{0x0054, 0x0018}, VR: "SH", Name: "EnergyWindowName", VM: "1"}
+	tagDict[Tag{0x0054, 0x0020}] = TagInfo{Tag: Tag{0x0054, 0x0020}, VR: "US", Name: "DetectorVector", VM: "1-n"}
+	tagDict[Tag{0x0054, 0x0021}] = TagInfo{Tag: Tag{0x0054, 0x0021}, VR: "US", Name: "NumberOfDetectors", VM: "1"}
+	tagDict[Tag{0x0054, 0x0022}] = TagInfo{Tag: Tag{0x0054, 0x0022}, VR: "SQ", Name: "DetectorInformationSequence", VM: "1"}
+	tagDict[Tag{0x0054, 0x0030}] = TagInfo{Tag: Tag{0x0054, 0x0030}, VR: "US", Name: "PhaseVector", VM: "1-n"}
+	tagDict[Tag{0x0054, 0x0031}] = TagInfo{Tag: Tag{0x0054, 0x0031}, VR: "US", Name: "NumberOfPhases", VM: "1"}
+	tagDict[Tag{0x0054, 0x0032}] = TagInfo{Tag: Tag{0x0054, 0x0032}, VR: "SQ", Name: "PhaseInformationSequence", VM: "1"}
+	tagDict[Tag{0x0054, 0x0033}] = TagInfo{Tag: Tag{0x0054, 0x0033}, VR: "US", Name: "NumberOfFramesInPhase", VM: "1"}
+	tagDict[Tag{0x0054, 0x0036}] = TagInfo{Tag: Tag{0x0054, 0x0036}, VR: "IS", Name: "PhaseDelay", VM: "1"}
+	tagDict[Tag{0x0054, 0x0038}] = TagInfo{Tag: Tag{0x0054, 0x0038}, VR: "IS", Name: "PauseBetweenFrames", VM: "1"}
+	tagDict[Tag{0x0054, 0x0039}] = TagInfo{Tag: Tag{0x0054, 0x0039}, VR: "CS", Name: "PhaseDescription", VM: "1"}
+	tagDict[Tag{0x0054, 0x0050}] = TagInfo{Tag: Tag{0x0054, 0x0050}, VR: "US", Name: "RotationVector", VM: "1-n"}
+	tagDict[Tag{0x0054, 0x0051}] = TagInfo{Tag: Tag{0x0054, 0x0051}, VR: "US", Name: "NumberOfRotations", VM: "1"}
+	tagDict[Tag{0x0054, 0x0052}] = TagInfo{Tag: Tag{0x0054, 0x0052}, VR: "SQ", Name: "RotationInformationSequence", VM: "1"}
+	tagDict[Tag{0x0054, 0x0053}] = TagInfo{Tag: Tag{0x0054, 0x0053}, VR: "US", Name: "NumberOfFramesInRotation", VM: "1"}
+	tagDict[Tag{0x0054, 0x0060}] = TagInfo{Tag: Tag{0x0054, 0x0060}, VR: "US", Name: "RRIntervalVector", VM: "1-n"}
+	tagDict[Tag{0x0054, 0x0061}] = TagInfo{Tag: Tag{0x0054, 0x0061}, VR: "US", Name: "NumberOfRRIntervals", VM: "1"}
+	tagDict[Tag{0x0054, 0x0062}] = TagInfo{Tag: Tag{0x0054, 0x0062}, VR: "SQ", Name: "GatedInformationSequence", VM: "1"}
+	tagDict[Tag{0x0054, 0x0063}] = TagInfo{Tag: Tag{0x0054, 0x0063}, VR: "SQ", Name: "DataInformationSequence", VM: "1"}
+	tagDict[Tag{0x0054, 0x0070}] = TagInfo{Tag: Tag{0x0054, 0x0070}, VR: "US", Name: "TimeSlotVector", VM: "1-n"}
+	tagDict[Tag{0x0054, 0x0071}] = TagInfo{Tag: Tag{0x0054, 0x0071}, VR: "US", Name: "NumberOfTimeSlots", VM: "1"}
+	tagDict[Tag{0x0054, 0x0072}] = TagInfo{Tag: Tag{0x0054, 0x0072}, VR: "SQ", Name: "TimeSlotInformationSequence", VM: "1"}
+	tagDict[Tag{0x0054, 0x0073}] = TagInfo{Tag: Tag{0x0054, 0x0073}, VR: "DS", Name: "TimeSlotTime", VM: "1"}
+	tagDict[Tag{0x0054, 0x0080}] = TagInfo{Tag: Tag{0x0054, 0x0080}, VR: "US", Name: "SliceVector", VM: "1-n"}
+	tagDict[Tag{0x0054, 0x0081}] = TagInfo{Tag: Tag{0x0054, 0x0081}, VR: "US", Name: "NumberOfSlices", VM: "1"}
+	tagDict[Tag{0x0054, 0x0090}] = TagInfo{Tag: Tag{0x0054, 0x0090}, VR: "US", Name: "AngularViewVector", VM: "1-n"}
+	tagDict[Tag{0x0054, 0x0100}] = TagInfo{Tag: Tag{0x0054, 0x0100}, VR: "US", Name: "TimeSliceVector", VM: "1-n"}
+	tagDict[Tag{0x0054, 0x0101}] = TagInfo{Tag: Tag{0x0054, 0x0101}, VR: "US", Name: "NumberOfTimeSlices", VM: "1"}
+	tagDict[Tag{0x0054, 0x0200}] = TagInfo{Tag: Tag{0x0054, 0x0200}, VR: "DS", Name: "StartAngle", VM: "1"}
+	tagDict[Tag{0x0054, 0x0202}] = TagInfo{Tag: Tag{0x0054, 0x0202}, VR: "CS", Name: "TypeOfDetectorMotion", VM: "1"}
+	tagDict[Tag{0x0054, 0x0210}] = TagInfo{Tag: Tag{0x0054, 0x0210}, VR: "IS", Name: "TriggerVector", VM: "1-n"}
+	tagDict[Tag{0x0054, 0x0211}] = TagInfo{Tag: Tag{0x0054, 0x0211}, VR: "US", Name: "NumberOfTriggersInPhase", VM: "1"}
+	tagDict[Tag{0x0054, 0x0220}] = TagInfo{Tag: Tag{0x0054, 0x0220}, VR: "SQ", Name: "ViewCodeSequence", VM: "1"}
+	tagDict[Tag{0x0054, 0x0222}] = TagInfo{Tag: Tag{0x0054, 0x0222}, VR: "SQ", Name: "ViewModifierCodeSequence", VM: "1"}
+	tagDict[Tag{0x0054, 0x0300}] = TagInfo{Tag: Tag{0x0054, 0x0300}, VR: "SQ", Name: "RadionuclideCodeSequence", VM: "1"}
+	tagDict[Tag{0x0054, 0x0302}] = TagInfo{Tag: Tag{0x0054, 0x0302}, VR: "SQ", Name: "AdministrationRouteCodeSequence", VM: "1"}
+	tagDict[Tag{0x0054, 0x0304}] = TagInfo{Tag: Tag{0x0054, 0x0304}, VR: "SQ", Name: "RadiopharmaceuticalCodeSequence", VM: "1"}
+	tagDict[Tag{0x0054, 0x0306}] = TagInfo{Tag: Tag{0x0054, 0x0306}, VR: "SQ", Name: "CalibrationDataSequence", VM: "1"}
+	tagDict[Tag{0x0054, 0x0308}] = TagInfo{Tag: Tag{0x0054, 0x0308}, VR: "US", Name: "EnergyWindowNumber", VM: "1"}
+	tagDict[Tag{0x0054, 0x0400}] = TagInfo{Tag: Tag{0x0054, 0x0400}, VR: "SH", Name: "ImageID", VM: "1"}
+	tagDict[Tag{0x0054, 0x0410}] = TagInfo{Tag: Tag{0x0054, 0x0410}, VR: "SQ", Name: "PatientOrientationCodeSequence", VM: "1"}
+	tagDict[Tag{0x0054, 0x0412}] = TagInfo{Tag: Tag{0x0054, 0x0412}, VR: "SQ", Name: "PatientOrientationModifierCodeSequence", VM: "1"}
+	tagDict[Tag{0x0054, 0x0414}] = TagInfo{Tag: Tag{0x0054, 0x0414}, VR: "SQ", Name: "PatientGantryRelationshipCodeSequence", VM: "1"}
+	tagDict[Tag{0x0054, 0x0500}] = TagInfo{Tag: Tag{0x0054, 0x0500}, VR: "CS", Name: "SliceProgressionDirection", VM: "1"}
+	tagDict[Tag{0x0054, 0x1000}] = TagInfo{Tag: Tag{0x0054, 0x1000}, VR: "CS", Name: "SeriesType", VM: "2"}
+	tagDict[Tag{0x0054, 0x1001}] = TagInfo{Tag: Tag{0x0054, 0x1001}, VR: "CS", Name: "Units", VM: "1"}
+	tagDict[Tag{0x0054, 0x1002}] = TagInfo{Tag: Tag{0x0054, 0x1002}, VR: "CS", Name: "CountsSource", VM: "1"}
+	tagDict[Tag{0x0054, 0x1004}] = TagInfo{Tag: Tag{0x0054, 0x1004}, VR: "CS", Name: "ReprojectionMethod", VM: "1"}
+	tagDict[Tag{0x0054, 0x1006}] = TagInfo{Tag: Tag{0x0054, 0x1006}, VR: "CS", Name: "SUVType", VM: "1"}
+	tagDict[Tag{0x0054, 0x1100}] = TagInfo{Tag: Tag{0x0054, 0x1100}, VR: "CS", Name: "RandomsCorrectionMethod", VM: "1"}
+	tagDict[Tag{0x0054, 0x1101}] = TagInfo{Tag: Tag{0x0054, 0x1101}, VR: "LO", Name: "AttenuationCorrectionMethod", VM: "1"}
+	tagDict[Tag{0x0054, 0x1102}] = TagInfo{Tag: Tag{0x0054, 0x1102}, VR: "CS", Name: "DecayCorrection", VM: "1"}
+	tagDict[Tag{0x0054, 0x1103}] = TagInfo{Tag: Tag{0x0054, 0x1103}, VR: "LO", Name: "ReconstructionMethod", VM: "1"}
+	tagDict[Tag{0x0054, 0x1104}] = TagInfo{Tag: Tag{0x0054, 0x1104}, VR: "LO", Name: "DetectorLinesOfResponseUsed", VM: "1"}
+	tagDict[Tag{0x0054, 0x1105}] = TagInfo{Tag: Tag{0x0054, 0x1105}, VR: "LO", Name: "ScatterCorrectionMethod", VM: "1"}
+	tagDict[Tag{0x0054, 0x1200}] = TagInfo{Tag: Tag{0x0054, 0x1200}, VR: "DS", Name: "AxialAcceptance", VM: "1"}
+	tagDict[Tag{0x0054, 0x1201}] = TagInfo{Tag: Tag{0x0054, 0x1201}, VR: "IS", Name: "AxialMash", VM: "2"}
+	tagDict[Tag{0x0054, 0x1202}] = TagInfo{Tag: Tag{0x0054, 0x1202}, VR: "IS", Name: "TransverseMash", VM: "1"}
+	tagDict[Tag{0x0054, 0x1203}] = TagInfo{Tag: Tag{0x0054, 0x1203}, VR: "DS", Name: "DetectorElementSize", VM: "2"}
+	tagDict[Tag{0x0054, 0x1210}] = TagInfo{Tag: Tag{0x0054, 0x1210}, VR: "DS", Name: "CoincidenceWindowWidth", VM: "1"}
+	tagDict[Tag{0x0054, 0x1220}] = TagInfo{Tag: Tag{0x0054, 0x1220}, VR: "CS", Name: "SecondaryCountsType", VM: "1-n"}
+	tagDict[Tag{0x0054, 0x1300}] = TagInfo{Tag: Tag{0x0054, 0x1300}, VR: "DS", Name: "FrameReferenceTime", VM: "1"}
+	tagDict[Tag{0x0054, 0x1310}] = TagInfo{Tag: Tag{0x0054, 0x1310}, VR: "IS", Name: "PrimaryPromptsCountsAccumulated", VM: "1"}
+	tagDict[Tag{0x0054, 0x1311}] = TagInfo{Tag: Tag{0x0054, 0x1311}, VR: "IS", Name: "SecondaryCountsAccumulated", VM: "1-n"}
+	tagDict[Tag{0x0054, 0x1320}] = TagInfo{Tag: Tag{0x0054, 0x1320}, VR: "DS", Name: "SliceSensitivityFactor", VM: "1"}
+	tagDict[Tag{0x0054, 0x1321}] = TagInfo{Tag: Tag{0x0054, 0x1321}, VR: "DS", Name: "DecayFactor", VM: "1"}
+	tagDict[Tag{0x0054, 0x1322}] = TagInfo{Tag: Tag{0x0054, 0x1322}, VR: "DS", Name: "DoseCalibrationFactor", VM: "1"}
+	tagDict[Tag{0x0054, 0x1323}] = TagInfo{Tag: Tag{0x0054, 0x1323}, VR: "DS", Name: "ScatterFractionFactor", VM: "1"}
+	tagDict[Tag{0x0054, 0x1324}] = TagInfo{Tag: Tag{0x0054, 0x1324}, VR: "DS", Name: "DeadTimeFactor", VM: "1"}
+	tagDict[Tag{0x0054, 0x1330}] = TagInfo{Tag: Tag{0x0054, 0x1330}, VR: "US", Name: "ImageIndex", VM: "1"}
+	tagDict[Tag{0x0060, 0x3000}] = TagInfo{Tag: Tag{0x0060, 0x3000}, VR: "SQ", Name: "HistogramSequence", VM: "1"}
+	tagDict[Tag{0x0060, 0x3002}] = TagInfo{Tag: Tag{0x0060, 0x3002}, VR: "US", Name: "HistogramNumberOfBins", VM: "1"}
+	tagDict[Tag{0x0060, 0x3004}] = TagInfo{Tag: Tag{0x0060, 0x3004}, VR: "US", VRs: []string{"US", "SS"}, Name: "HistogramFirstBinValue", VM: "1"}
+	tagDict[Tag{0x0060, 0x3006}] = TagInfo{Tag: Tag{0x0060, 0x3006}, VR: "US", VRs: []string{"US", "SS"}, Name: "HistogramLastBinValue", VM: "1"}
+	tagDict[Tag{0x0060, 0x3008}] = TagInfo{Tag: Tag{0x0060, 0x3008}, VR: "US", Name: "HistogramBinWidth", VM: "1"}
+	tagDict[Tag{0x0060, 0x3010}] = TagInfo{Tag: Tag{0x0060, 0x3010}, VR: "LO", Name: "HistogramExplanation", VM: "1"}
+	tagDict[Tag{0x0060, 0x3020}] = TagInfo{Tag: Tag{0x0060, 0x3020}, VR: "UL", Name: "HistogramData", VM: "1-n"}
+	tagDict[Tag{0x0062, 0x0001}] = TagInfo{Tag: Tag{0x0062, 0x0001}, VR: "CS", Name: "SegmentationType", VM: "1"}
+	tagDict[Tag{0x0062, 0x0002}] = TagInfo{Tag: Tag{0x0062, 0x0002}, VR: "SQ", Name: "SegmentSequence", VM: "1"}
+	tagDict[Tag{0x0062, 0x0003}] = TagInfo{Tag: Tag{0x0062, 0x0003}, VR: "SQ", Name: "SegmentedPropertyCategoryCodeSequence", VM: "1"}
+	tagDict[Tag{0x0062, 0x0004}] = TagInfo{Tag: Tag{0x0062, 0x0004}, VR: "US", Name: "SegmentNumber", VM: "1"}
+	tagDict[Tag{0x0062, 0x0005}] = TagInfo{Tag: Tag{0x0062, 0x0005}, VR: "LO", Name: "SegmentLabel", VM: "1"}
+	tagDict[Tag{0x0062, 0x0006}] = TagInfo{Tag: Tag{0x0062, 0x0006}, VR: "ST", Name: "SegmentDescription", VM: "1"}
+	tagDict[Tag{0x0062, 0x0008}] = TagInfo{Tag: Tag{0x0062, 0x0008}, VR: "CS", Name: "SegmentAlgorithmType", VM: "1"}
+	tagDict[Tag{0x0062, 0x0009}] = TagInfo{Tag: Tag{0x0062, 0x0009}, VR: "LO", Name: "SegmentAlgorithmName", VM: "1"}
+	tagDict[Tag{0x0062, 0x000A}] = TagInfo{Tag: Tag{0x0062, 0x000A}, VR: "SQ", Name: "SegmentIdentificationSequence", VM: "1"}
+	tagDict[Tag{0x0062, 0x000B}] = TagInfo{Tag: Tag{0x0062, 0x000B}, VR: "US", Name: "ReferencedSegmentNumber", VM: "1-n"}
+	tagDict[Tag{0x0062, 0x000C}] = TagInfo{Tag: Tag{0x0062, 0x000C}, VR: "US", Name: "RecommendedDisplayGrayscaleValue", VM: "1"}
+	tagDict[Tag{0x0062, 0x000D}] = TagInfo{Tag: Tag{0x0062, 0x000D}, VR: "US", Name: "RecommendedDisplayCIELabValue", VM: "3"}
+	tagDict[Tag{0x0062, 0x000E}] = TagInfo{Tag: Tag{0x0062, 0x000E}, VR: "US", Name: "MaximumFractionalValue", VM: "1"}
+	tagDict[Tag{0x0062, 0x000F}] = TagInfo{Tag: Tag{0x0062, 0x000F}, VR: "SQ", Name: "SegmentedPropertyTypeCodeSequence", VM: "1"}
+	tagDict[Tag{0x0062, 0x0010}] = TagInfo{Tag: Tag{0x0062, 0x0010}, VR: "CS", Name: "SegmentationFractionalType", VM: "1"}
+	tagDict[Tag{0x0064, 0x0002}] = TagInfo{Tag: Tag{0x0064, 0x0002}, VR: "SQ", Name: "DeformableRegistrationSequence", VM: "1"}
+	tagDict[Tag{0x0064, 0x0003}] = TagInfo{Tag: Tag{0x0064, 0x0003}, VR: "UI", Name: "SourceFrameOfReferenceUID", VM: "1"}
+	tagDict[Tag{0x0064, 0x0005}] = TagInfo{Tag: Tag{0x0064, 0x0005}, VR: "SQ", Name: "DeformableRegistrationGridSequence", VM: "1"}
+	tagDict[Tag{0x0064, 0x0007}] = TagInfo{Tag: Tag{0x0064, 0x0007}, VR: "UL", Name: "GridDimensions", VM: "3"}
+	tagDict[Tag{0x0064, 0x0008}] = TagInfo{Tag: Tag{0x0064, 0x0008}, VR: "FD", Name: "GridResolution", VM: "3"}
+	tagDict[Tag{0x0064, 0x0009}] = TagInfo{Tag: Tag{0x0064, 0x0009}, VR: "OF", Name: "VectorGridData", VM: "1"}
+	tagDict[Tag{0x0064, 0x000F}] = TagInfo{Tag: Tag{0x0064, 0x000F}, VR: "SQ", Name: "PreDeformationMatrixRegistrationSequence", VM: "1"}
+	tagDict[Tag{0x0064, 0x0010}] = TagInfo{Tag: Tag{0x0064, 0x0010}, VR: "SQ", Name: "PostDeformationMatrixRegistrationSequence", VM: "1"}
+	tagDict[Tag{0x0066, 0x0001}] = TagInfo{Tag: Tag{0x0066, 0x0001}, VR: "UL", Name: "NumberOfSurfaces", VM: "1"}
+	tagDict[Tag{0x0066, 0x0002}] = TagInfo{Tag: Tag{0x0066, 0x0002}, VR: "SQ", Name: "SurfaceSequence", VM: "1"}
+	tagDict[Tag{0x0066, 0x0003}] = TagInfo{Tag: Tag{0x0066, 0x0003}, VR: "UL", Name: "SurfaceNumber", VM: "1"}
+	tagDict[Tag{0x0066, 0x0004}] = TagInfo{Tag: Tag{0x0066, 0x0004}, VR: "LT", Name: "SurfaceComments", VM: "1"}
+	tagDict[Tag{0x0066, 0x0009}] = TagInfo{Tag: Tag{0x0066, 0x0009}, VR: "CS", Name: "SurfaceProcessing", VM: "1"}
+	tagDict[Tag{0x0066, 0x000A}] = TagInfo{Tag: Tag{0x0066, 0x000A}, VR: "FL", Name: "SurfaceProcessingRatio", VM: "1"}
+	tagDict[Tag{0x0066, 0x000B}] = TagInfo{Tag: Tag{0x0066, 0x000B}, VR: "LO", Name: "SurfaceProcessingDescription", VM: "1"}
+	tagDict[Tag{0x0066, 0x000C}] = TagInfo{Tag: Tag{0x0066, 0x000C}, VR: "FL", Name: "RecommendedPresentationOpacity", VM: "1"}
+	tagDict[Tag{0x0066, 0x000D}] = TagInfo{Tag: Tag{0x0066, 0x000D}, VR: "CS", Name: "RecommendedPresentationType", VM: "1"}
+	tagDict[Tag{0x0066, 0x000E}] = TagInfo{Tag: Tag{0x0066, 0x000E}, VR: "CS", Name: "FiniteVolume", VM: "1"}
+	tagDict[Tag{0x0066, 0x0010}] = TagInfo{Tag: Tag{0x0066, 0x0010}, VR: "CS", Name: "Manifold", VM: "1"}
+	tagDict[Tag{0x0066, 0x0011}] = TagInfo{Tag: Tag{0x0066, 0x0011}, VR: "SQ", Name: "SurfacePointsSequence", VM: "1"}
+	tagDict[Tag{0x0066, 0x0012}] = TagInfo{Tag: Tag{0x0066, 0x0012}, VR: "SQ", Name: "SurfacePointsNormalsSequence", VM: "1"}
+	tagDict[Tag{0x0066, 0x0013}] = TagInfo{Tag: Tag{0x0066, 0x0013}, VR: "SQ", Name: "SurfaceMeshPrimitivesSequence", VM: "1"}
+	tagDict[Tag{0x0066, 0x0015}] = TagInfo{Tag: Tag{0x0066, 0x0015}, VR: "UL", Name: "NumberOfSurfacePoints", VM: "1"}
+	tagDict[Tag{0x0066, 0x0016}] = TagInfo{Tag: Tag{0x0066, 0x0016}, VR: "OF", Name: "PointCoordinatesData", VM: "1"}
+	tagDict[Tag{0x0066, 0x0017}] = TagInfo{Tag: Tag{0x0066, 0x0017}, VR: "FL", Name: "PointPositionAccuracy", VM: "3"}
+	tagDict[Tag{0x0066, 0x0018}] = TagInfo{Tag: Tag{0x0066, 0x0018}, VR: "FL", Name: "MeanPointDistance", VM: "1"}
+	tagDict[Tag{0x0066, 0x0019}] = TagInfo{Tag: Tag{0x0066, 0x0019}, VR: "FL", Name: "MaximumPointDistance", VM: "1"}
+	tagDict[Tag{0x0066, 0x001A}] = TagInfo{Tag: Tag{0x0066, 0x001A}, VR: "FL", Name: "PointsBoundingBoxCoordinates", VM: "6"}
+	tagDict[Tag{0x0066, 0x001B}] = TagInfo{Tag: Tag{0x0066, 0x001B}, VR: "FL", Name: "AxisOfRotation", VM: "3"}
+	tagDict[Tag{0x0066, 0x001C}] = TagInfo{Tag: Tag{0x0066, 0x001C}, VR: "FL", Name: "CenterOfRotation", VM: "3"}
+	tagDict[Tag{0x0066, 0x001E}] = TagInfo{Tag: Tag{0x0066, 0x001E}, VR: "UL", Name: "NumberOfVectors", VM: "1"}
+	tagDict[Tag{0x0066, 0x001F}] = TagInfo{Tag: Tag{0x0066, 0x001F}, VR: "US", Name: "VectorDimensionality", VM: "1"}
+	tagDict[Tag{0x0066, 0x0020}] = TagInfo{Tag: Tag{0x0066, 0x0020}, VR: "FL", Name: "VectorAccuracy", VM: "1-n"}
+	tagDict[Tag{0x0066, 0x0021}] = TagInfo{Tag: Tag{0x0066, 0x0021}, VR: "OF", Name: "VectorCoordinateData", VM: "1"}
+	tagDict[Tag{0x0066, 0x0023}] = TagInfo{Tag: Tag{0x0066, 0x0023}, VR: "OW", Name: "TrianglePointIndexList", VM: "1"}
+	tagDict[Tag{0x0066, 0x0024}] = TagInfo{Tag: Tag{0x0066, 0x0024}, VR: "OW", Name: "EdgePointIndexList", VM: "1"}
+	tagDict[Tag{0x0066, 0x0025}] = TagInfo{Tag: Tag{0x0066, 0x0025}, VR: "OW", Name: "VertexPointIndexList", VM: "1"}
+	tagDict[Tag{0x0066, 0x0026}] = TagInfo{Tag: Tag{0x0066, 0x0026}, VR: "SQ", Name: "TriangleStripSequence", VM: "1"}
+	tagDict[Tag{0x0066, 0x0027}] = TagInfo{Tag: Tag{0x0066, 0x0027}, VR: "SQ", Name: "TriangleFanSequence", VM: "1"}
+	tagDict[Tag{0x0066, 0x0028}] = TagInfo{Tag: Tag{0x0066, 0x0028}, VR: "SQ", Name: "LineSequence", VM: "1"}
+	tagDict[Tag{0x0066, 0x0029}] = TagInfo{Tag: Tag{0x0066, 0x0029}, VR: "OW", Name: "PrimitivePointIndexList", VM: "1"}
+	tagDict[Tag{0x0066, 0x002A}] = TagInfo{Tag: Tag{0x0066, 0x002A}, VR: "UL", Name: "SurfaceCount", VM: "1"}
+	tagDict[Tag{0x0066, 0x002B}] = TagInfo{Tag: Tag{0x0066, 0x002B}, VR: "SQ", Name: "ReferencedSurfaceSequence", VM: "1"}
+	tagDict[Tag{0x0066, 0x002C}] = TagInfo{Tag: Tag{0x0066, 0x002C}, VR: "UL", Name: "ReferencedSurfaceNumber", VM: "1"}
+	tagDict[Tag{0x0066, 0x002D}] = TagInfo{Tag: Tag{0x0066, 0x002D}, VR: "SQ", Name: "SegmentSurfaceGenerationAlgorithmIdentificationSequence", VM: "1"}
+	tagDict[Tag{0x0066, 0x002E}] = TagInfo{Tag: Tag{0x0066, 0x002E}, VR: "SQ", Name: "SegmentSurfaceSourceInstanceSequence", VM: "1"}
+	tagDict[Tag{0x0066, 0x002F}] = TagInfo{Tag: Tag{0x0066, 0x002F}, VR: "SQ", Name: "AlgorithmFamilyCodeSequence", VM: "1"}
+	tagDict[Tag{0x0066, 0x0030}] = TagInfo{Tag: Tag{0x0066, 0x0030}, VR: "SQ", Name: "AlgorithmNameCodeSequence", VM: "1"}
+	tagDict[Tag{0x0066, 0x0031}] = TagInfo{Tag: Tag{0x0066, 0x0031}, VR: "LO", Name: "AlgorithmVersion", VM: "1"}
+	tagDict[Tag{0x0066, 0x0032}] = TagInfo{Tag: Tag{0x0066, 0x0032}, VR: "LT", Name: "AlgorithmParameters", VM: "1"}
+	tagDict[Tag{0x0066, 0x0034}] = TagInfo{Tag: Tag{0x0066, 0x0034}, VR: "SQ", Name: "FacetSequence", VM: "1"}
+	tagDict[Tag{0x0066, 0x0035}] = TagInfo{Tag: Tag{0x0066, 0x0035}, VR: "SQ", Name: "SurfaceProcessingAlgorithmIdentificationSequence", VM: "1"}
+	tagDict[Tag{0x0066, 0x0036}] = TagInfo{Tag: Tag{0x0066, 0x0036}, VR: "LO", Name: "AlgorithmName", VM: "1"}
+	tagDict[Tag{0x0068, 0x6210}] = TagInfo{Tag: Tag{0x0068, 0x6210}, VR: "LO", Name: "ImplantSize", VM: "1"}
+	tagDict[Tag{0x0068, 0x6221}] = TagInfo{Tag: Tag{0x0068, 0x6221}, VR: "LO", Name: "ImplantTemplateVersion", VM: "1"}
+	tagDict[Tag{0x0068, 0x6222}] = TagInfo{Tag: Tag{0x0068, 0x6222}, VR: "SQ", Name: "ReplacedImplantTemplateSequence", VM: "1"}
+	tagDict[Tag{0x0068, 0x6223}] = TagInfo{Tag: Tag{0x0068, 0x6223}, VR: "CS", Name: "ImplantType", VM: "1"}
+	tagDict[Tag{0x0068, 0x6224}] = TagInfo{Tag: Tag{0x0068, 0x6224}, VR: "SQ", Name: "DerivationImplantTemplateSequence", VM: "1"}
+	tagDict[Tag{0x0068, 0x6225}] = TagInfo{Tag: Tag{0x0068, 0x6225}, VR: "SQ", Name: "OriginalImplantTemplateSequence", VM: "1"}
+	tagDict[Tag{0x0068, 0x6226}] = TagInfo{Tag: Tag{0x0068, 0x6226}, VR: "DT", Name: "EffectiveDateTime", VM: "1"}
+	tagDict[Tag{0x0068, 0x6230}] = TagInfo{Tag: Tag{0x0068, 0x6230}, VR: "SQ", Name: "ImplantTargetAnatomySequence", VM: "1"}
+	tagDict[Tag{0x0068, 0x6260}] = TagInfo{Tag: Tag{0x0068, 0x6260}, VR: "SQ", Name: "InformationFromManufacturerSequence", VM: "1"}
+	tagDict[Tag{0x0068, 0x6265}] = TagInfo{Tag: Tag{0x0068, 0x6265}, VR: "SQ", Name: "NotificationFromManufacturerSequence", VM: "1"}
+	tagDict[Tag{0x0068, 0x6270}] = TagInfo{Tag: Tag{0x0068, 0x6270}, VR: "DT", Name: "InformationIssueDateTime", VM: "1"}
+	tagDict[Tag{0x0068, 0x6280}] = TagInfo{Tag: Tag{0x0068, 0x6280}, VR: "ST", Name: "InformationSummary", VM: "1"}
+	tagDict[Tag{0x0068, 0x62A0}] = TagInfo{Tag: Tag{0x0068, 0x62A0}, VR: "SQ", Name: "ImplantRegulatoryDisapprovalCodeSequence", VM: "1"}
+	tagDict[Tag{0x0068, 0x62A5}] = TagInfo{Tag: Tag{0x0068, 0x62A5}, VR: "FD", Name: "OverallTemplateSpatialTolerance", VM: "1"}
+	tagDict[Tag{0x0068, 0x62C0}] = TagInfo{Tag: Tag{0x0068, 0x62C0}, VR: "SQ", Name: "HPGLDocumentSequence", VM: "1"}
+	tagDict[Tag{0x0068, 0x62D0}] = TagInfo{Tag: Tag{0x0068, 0x62D0}, VR: "US", Name: "HPGLDocumentID", VM: "1"}
+	tagDict[Tag{0x0068, 0x62D5}] = TagInfo{Tag: Tag{0x0068, 0x62D5}, VR: "LO", Name: "HPGLDocumentLabel", VM: "1"}
+	tagDict[Tag{0x0068, 0x62E0}] = TagInfo{Tag: Tag{0x0068, 0x62E0}, VR: "SQ", Name: "ViewOrientationCodeSequence", VM: "1"}
+	tagDict[Tag{0x0068, 0x62F0}] = TagInfo{Tag: Tag{0x0068, 0x62F0}, VR: "FD", Name: "ViewOrientationModifier", VM: "9"}
+	tagDict[Tag{0x0068, 0x62F2}] = TagInfo{Tag: Tag{0x0068, 0x62F2}, VR: "FD", Name: "HPGLDocumentScaling", VM: "1"}
+	tagDict[Tag{0x0068, 0x6300}] = TagInfo{Tag: Tag{0x0068, 0x6300}, VR: "OB", Name: "HPGLDocument", VM: "1"}
+	tagDict[Tag{0x0068, 0x6310}] = TagInfo{Tag: Tag{0x0068, 0x6310}, VR: "US", Name: "HPGLContourPenNumber", VM: "1"}
+	tagDict[Tag{0x0068, 0x6320}] = TagInfo{Tag: Tag{0x0068, 0x6320}, VR: "SQ", Name: "HPGLPenSequence", VM: "1"}
+	tagDict[Tag{0x0068, 0x6330}] = TagInfo{Tag: Tag{0x0068, 0x6330}, VR: "US", Name: "HPGLPenNumber", VM: "1"}
+	tagDict[Tag{0x0068, 0x6340}] = TagInfo{Tag: Tag{0x0068, 0x6340}, VR: "LO", Name: "HPGLPenLabel", VM: "1"}
+	tagDict[Tag{0x0068, 0x6345}] = TagInfo{Tag: Tag{0x0068, 0x6345}, VR: "ST", Name: "HPGLPenDescription", VM: "1"}
+	tagDict[Tag{0x0068, 0x6346}] = TagInfo{Tag: Tag{0x0068, 0x6346}, VR: "FD", Name: "RecommendedRotationPoint", VM: "2"}
+	tagDict[Tag{0x0068, 0x6347}] = TagInfo{Tag: Tag{0x0068, 0x6347}, VR: "FD", Name: "BoundingRectangle", VM: "4"}
+	tagDict[Tag{0x0068, 0x6350}] = TagInfo{Tag: Tag{0x0068, 0x6350}, VR: "US", Name: "ImplantTemplate3DModelSurfaceNumber", VM: "1-n"}
+	tagDict[Tag{0x0068, 0x6360}] = TagInfo{Tag: Tag{0x0068, 0x6360}, VR: "SQ", Name: "SurfaceModelDescriptionSequence", VM: "1"}
+	tagDict[Tag{0x0068, 0x6380}] = TagInfo{Tag: Tag{0x0068, 0x6380}, VR: "LO", Name: "SurfaceModelLabel", VM: "1"}
+	tagDict[Tag{0x0068, 0x6390}] = TagInfo{Tag: Tag{0x0068, 0x6390}, VR: "FD", Name: "SurfaceModelScalingFactor", VM: "1"}
+	tagDict[Tag{0x0068, 0x63A0}] = TagInfo{Tag: Tag{0x0068, 0x63A0}, VR: "SQ", Name: "MaterialsCodeSequence", VM: "1"}
+	tagDict[Tag{0x0068, 0x63A4}] = TagInfo{Tag: Tag{0x0068, 0x63A4}, VR: "SQ", Name: "CoatingMaterialsCodeSequence", VM: "1"}
+	tagDict[Tag{0x0068, 0x63A8}] = TagInfo{Tag: Tag{0x0068, 0x63A8}, VR: "SQ", Name: "ImplantTypeCodeSequence", VM: "1"}
+	tagDict[Tag{0x0068, 0x63AC}] = TagInfo{Tag: Tag{0x0068, 0x63AC}, VR: "SQ", Name: "FixationMethodCodeSequence", VM: "1"}
+	tagDict[Tag{0x0068, 0x63B0}] = TagInfo{Tag: Tag{0x0068, 0x63B0}, VR: "SQ", Name: "MatingFeatureSetsSequence", VM: "1"}
+	tagDict[Tag{0x0068, 0x63C0}] = TagInfo{Tag: Tag{0x0068, 0x63C0}, VR: "US", Name: "MatingFeatureSetID", VM: "1"}
+	tagDict[Tag{0x0068, 0x63D0}] = TagInfo{Tag: Tag{0x0068, 0x63D0}, VR: "LO", Name: "MatingFeatureSetLabel", VM: "1"}
+	tagDict[Tag{0x0068, 0x63E0}] = TagInfo{Tag: Tag{0x0068, 0x63E0}, VR: "SQ", Name: "MatingFeatureSequence", VM: "1"}
+	tagDict[Tag{0x0068, 0x63F0}] = TagInfo{Tag: Tag{0x0068, 0x63F0}, VR: "US", Name: "MatingFeatureID", VM: "1"}
+	tagDict[Tag{0x0068, 0x6400}] = TagInfo{Tag: Tag{0x0068, 0x6400}, VR: "SQ", Name: "MatingFeatureDegreeOfFreedomSequence", VM: "1"}
+	tagDict[Tag{0x0068, 0x6410}] = TagInfo{Tag: Tag{0x0068, 0x6410}, VR: "US", Name: "DegreeOfFreedomID", VM: "1"}
+	tagDict[Tag{0x0068, 0x6420}] = TagInfo{Tag: Tag{0x0068, 0x6420}, VR: "CS", Name: "DegreeOfFreedomType", VM: "1"}
+	tagDict[Tag{0x0068, 0x6430}] = TagInfo{Tag: Tag{0x0068, 0x6430}, VR: "SQ", Name: "TwoDMatingFeatureCoordinatesSequence", VM: "1"}
+	tagDict[Tag{0x0068, 0x6440}] = TagInfo{Tag: Tag{0x0068, 0x6440}, VR: "US", Name: "ReferencedHPGLDocumentID", VM: "1"}
+	tagDict[Tag{0x0068, 0x6450}] = TagInfo{Tag: Tag{0x0068, 0x6450}, VR: "FD", Name: "TwoDMatingPoint", VM: "2"}
+	tagDict[Tag{0x0068, 0x6460}] = TagInfo{Tag: Tag{0x0068, 0x6460}, VR: "FD", Name: "TwoDMatingAxes", VM: "4"}
+	tagDict[Tag{0x0068, 0x6470}] = TagInfo{Tag: Tag{0x0068, 0x6470}, VR: "SQ", Name: "TwoDDegreeOfFreedomSequence", VM: "1"}
+	tagDict[Tag{0x0068, 0x6490}] = TagInfo{Tag: Tag{0x0068, 0x6490}, VR: "FD", Name: "ThreeDDegreeOfFreedomAxis", VM: "3"}
+	tagDict[Tag{0x0068, 0x64A0}] = TagInfo{Tag: Tag{0x0068, 0x64A0}, VR: "FD", Name: "RangeOfFreedom", VM: "2"}
+	tagDict[Tag{0x0068, 0x64C0}] = TagInfo{Tag: Tag{0x0068, 0x64C0}, VR: "FD", Name: "ThreeDMatingPoint", VM: "3"}
+	tagDict[Tag{0x0068, 0x64D0}] = TagInfo{Tag: Tag{0x0068, 0x64D0}, VR: "FD", Name: "ThreeDMatingAxes", VM: "9"}
+	tagDict[Tag{0x0068, 0x64F0}] = TagInfo{Tag: Tag{0x0068, 0x64F0}, VR: "FD", Name: "TwoDDegreeOfFreedomAxis", VM: "3"}
+	tagDict[Tag{0x0068, 0x6500}] = TagInfo{Tag: Tag{0x0068, 0x6500}, VR: "SQ", Name: "PlanningLandmarkPointSequence", VM: "1"}
+	tagDict[Tag{0x0068, 0x6510}] = TagInfo{Tag: Tag{0x0068, 0x6510}, VR: "SQ", Name: "PlanningLandmarkLineSequence", VM: "1"}
+	tagDict[Tag{0x0068, 0x6520}] = TagInfo{Tag: Tag{0x0068, 0x6520}, VR: "SQ", Name: "PlanningLandmarkPlaneSequence", VM: "1"}
+	tagDict[Tag{0x0068, 0x6530}] = TagInfo{Tag: Tag{0x0068, 0x6530}, VR: "US", Name: "PlanningLandmarkID", VM: "1"}
+	tagDict[Tag{0x0068, 0x6540}] = TagInfo{Tag: Tag{0x0068, 0x6540}, VR: "LO", Name: "PlanningLandmarkDescription", VM: "1"}
+	tagDict[Tag{0x0068, 0x6545}] = TagInfo{Tag: Tag{0x0068, 0x6545}, VR: "SQ", Name: "PlanningLandmarkIdentificationCodeSequence", VM: "1"}
+	tagDict[Tag{0x0068, 0x6550}] = TagInfo{Tag: Tag{0x0068, 0x6550}, VR: "SQ", Name: "TwoDPointCoordinatesSequence", VM: "1"}
+	tagDict[Tag{0x0068, 0x6560}] = TagInfo{Tag: Tag{0x0068, 0x6560}, VR: "FD", Name: "TwoDPointCoordinates", VM: "2"}
+	tagDict[Tag{0x0068, 0x6590}] = TagInfo{Tag: Tag{0x0068, 0x6590}, VR: "FD", Name: "ThreeDPointCoordinates", VM: "3"}
+	tagDict[Tag{0x0068, 0x65A0}] = TagInfo{Tag: Tag{0x0068, 0x65A0}, VR: "SQ", Name: "TwoDLineCoordinatesSequence", VM: "1"}
+	tagDict[Tag{0x0068, 0x65B0}] = TagInfo{Tag: Tag{0x0068, 0x65B0}, VR: "FD", Name: "TwoDLineCoordinates", VM: "4"}
+	tagDict[Tag{0x0068, 0x65D0}] = TagInfo{Tag: Tag{0x0068, 0x65D0}, VR: "FD", Name: "ThreeDLineCoordinates", VM: "6"}
+	tagDict[Tag{0x0068, 0x65E0}] = TagInfo{Tag: Tag{0x0068, 0x65E0}, VR: "SQ", Name: "TwoDPlaneCoordinatesSequence", VM: "1"}
+	tagDict[Tag{0x0068, 0x65F0}] = TagInfo{Tag: Tag{0x0068, 0x65F0}, VR: "FD", Name: "TwoDPlaneIntersection", VM: "4"}
+	tagDict[Tag{0x0068, 0x6610}] = TagInfo{Tag: Tag{0x0068, 0x6610}, VR: "FD", Name: "ThreeDPlaneOrigin", VM: "3"}
+	tagDict[Tag{0x0068, 0x6620}] = TagInfo{Tag: Tag{0x0068, 0x6620}, VR: "FD", Name: "ThreeDPlaneNormal", VM: "3"}
+	tagDict[Tag{0x0070, 0x0001}] = TagInfo{Tag: Tag{0x0070, 0x0001}, VR: "SQ", Name: "GraphicAnnotationSequence", VM: "1"}
+	tagDict[Tag{0x0070, 0x0002}] = TagInfo{Tag: Tag{0x0070, 0x0002}, VR: "CS", Name: "GraphicLayer", VM: "1"}
+	tagDict[Tag{0x0070, 0x0003}] = TagInfo{Tag: Tag{0x0070, 0x0003}, VR: "CS", Name: "BoundingBoxAnnotationUnits", VM: "1"}
+	tagDict[Tag{0x0070, 0x0004}] = TagInfo{Tag: Tag{0x0070, 0x0004}, VR: "CS", Name: "AnchorPointAnnotationUnits", VM: "1"}
+	tagDict[Tag{0x0070, 0x0005}] = TagInfo{Tag: Tag{0x0070, 0x0005}, VR: "CS", Name: "GraphicAnnotationUnits", VM: "1"}
+	tagDict[Tag{0x0070, 0x0006}] = TagInfo{Tag: Tag{0x0070, 0x0006}, VR: "ST", Name: "UnformattedTextValue", VM: "1"}
+	tagDict[Tag{0x0070, 0x0008}] = TagInfo{Tag: Tag{0x0070, 0x0008}, VR: "SQ", Name: "TextObjectSequence", VM: "1"}
+	tagDict[Tag{0x0070, 0x0009}] = TagInfo{Tag: Tag{0x0070, 0x0009}, VR: "SQ", Name: "GraphicObjectSequence", VM: "1"}
+	tagDict[Tag{0x0070, 0x0010}] = TagInfo{Tag: Tag{0x0070, 0x0010}, VR: "FL", Name: "BoundingBoxTopLeftHandCorner", VM: "2"}
+	tagDict[Tag{0x0070, 0x0011}] = TagInfo{Tag: Tag{0x0070, 0x0011}, VR: "FL", Name: "BoundingBoxBottomRightHandCorner", VM: "2"}
+	tagDict[Tag{0x0070, 0x0012}] = TagInfo{Tag: Tag{0x0070, 0x0012}, VR: "CS", Name: "BoundingBoxTextHorizontalJustification", VM: "1"}
+	tagDict[Tag{0x0070, 0x0014}] = TagInfo{Tag: Tag{0x0070, 0x0014}, VR: "FL", Name: "AnchorPoint", VM: "2"}
+	tagDict[Tag{0x0070, 0x0015}] = TagInfo{Tag: Tag{0x0070, 0x0015}, VR: "CS", Name: "AnchorPointVisibility", VM: "1"}
+	tagDict[Tag{0x0070, 0x0020}] = TagInfo{Tag: Tag{0x0070, 0x0020}, VR: "US", Name: "GraphicDimensions", VM: "1"}
+	tagDict[Tag{0x0070, 0x0021}] = TagInfo{Tag: Tag{0x0070, 0x0021}, VR: "US", Name: "NumberOfROIContourPoints", VM: "1"}
+	tagDict[Tag{0x0070, 0x0022}] = TagInfo{Tag: Tag{0x0070, 0x0022}, VR: "FL", Name: "IndicationROIContourData", VM: "2-n"}
+	tagDict[Tag{0x0070, 0x0023}] = TagInfo{Tag: Tag{0x0070, 0x0023}, VR: "CS", Name: "GeometricType", VM: "1"}
+	tagDict[Tag{0x0070, 0x0024}] = TagInfo{Tag: Tag{0x0070, 0x0024}, VR: "CS", Name: "GraphicFilled", VM: "1"}
+	tagDict[Tag{0x0070, 0x0041}] = TagInfo{Tag: Tag{0x0070, 0x0041}, VR: "CS", Name: "ImageHorizontalFlip", VM: "1"}
+	tagDict[Tag{0x0070, 0x0042}] = TagInfo{Tag: Tag{0x0070, 0x0042}, VR: "US", Name: "ImageRotation", VM: "1"}
+	tagDict[Tag{0x0070, 0x0052}] = TagInfo{Tag: Tag{0x0070, 0x0052}, VR: "SL", Name: "DisplayedAreaTopLeftHandCorner", VM: "2"}
+	tagDict[Tag{0x0070, 0x0053}] = TagInfo{Tag: Tag{0x0070, 0x0053}, VR: "SL", Name: "DisplayedAreaBottomRightHandCorner", VM: "2"}
+	tagDict[Tag{0x0070, 0x005A}] = TagInfo{Tag: Tag{0x0070, 0x005A}, VR: "SQ", Name: "DisplayedAreaSelectionSequence", VM: "1"}
+	tagDict[Tag{0x0070, 0x0060}] = TagInfo{Tag: Tag{0x0070, 0x0060}, VR: "SQ", Name: "GraphicLayerSequence", VM: "1"}
+	tagDict[Tag{0x0070, 0x0062}] = TagInfo{Tag: Tag{0x0070, 0x0062}, VR: "IS", Name: "GraphicLayerOrder", VM: "1"}
+	tagDict[Tag{0x0070, 0x0066}] = TagInfo{Tag: Tag{0x0070, 0x0066}, VR: "US", Name: "GraphicLayerRecommendedDisplayGrayscaleValue", VM: "1"}
+	tagDict[Tag{0x0070, 0x0068}] = TagInfo{Tag: Tag{0x0070, 0x0068}, VR: "LO", Name: "GraphicLayerDescription", VM: "1"}
+	tagDict[Tag{0x0070, 0x0080}] = TagInfo{Tag: Tag{0x0070, 0x0080}, VR: "CS", Name: "ContentLabel", VM: "1"}
+	tagDict[Tag{0x0070, 0x0081}] = TagInfo{Tag: Tag{0x0070, 0x0081}, VR: "LO", Name: "ContentDescription", VM: "1"}
+	tagDict[Tag{0x0070, 0x0082}] = TagInfo{Tag: Tag{0x0070, 0x0082}, VR: "DA", Name: "PresentationCreationDate", VM: "1"}
+	tagDict[Tag{0x0070, 0x0083}] = TagInfo{Tag: Tag{0x0070, 0x0083}, VR: "TM", Name: "PresentationCreationTime", VM: "1"}
+	tagDict[Tag{0x0070, 0x0084}] = TagInfo{Tag: Tag{0x0070, 0x0084}, VR: "PN", Name: "ContentCreatorName", VM: "1"}
+	tagDict[Tag{0x0070, 0x0086}] = TagInfo{Tag: Tag{0x0070, 0x0086}, VR: "SQ", Name: "ContentCreatorIdentificationCodeSequence", VM: "1"}
+	tagDict[Tag{0x0070, 0x0087}] = TagInfo{Tag: Tag{0x0070, 0x0087}, VR: "SQ", Name: "AlternateContentDescriptionSequence", VM: "1"}
+	tagDict[Tag{0x0070, 0x0100}] = TagInfo{Tag: Tag{0x0070, 0x0100}, VR: "CS", Name: "PresentationSizeMode", VM: "1"}
+	tagDict[Tag{0x0070, 0x0101}] = TagInfo{Tag: Tag{0x0070, 0x0101}, VR: "DS", Name: "PresentationPixelSpacing", VM: "2"}
+	tagDict[Tag{0x0070, 0x0102}] = TagInfo{Tag: Tag{0x0070, 0x0102}, VR: "IS", Name: "PresentationPixelAspectRatio", VM: "2"}
+	tagDict[Tag{0x0070, 0x0103}] = TagInfo{Tag: Tag{0x0070, 0x0103}, VR: "FL", Name: "PresentationPixelMagnificationRatio", VM: "1"}
+	tagDict[Tag{0x0070, 0x0207}] = TagInfo{Tag: Tag{0x0070, 0x0207}, VR: "LO", Name: "GraphicGroupLabel", VM: "1"}
+	tagDict[Tag{0x0070, 0x0208}] = TagInfo{Tag: Tag{0x0070, 0x0208}, VR: "ST", Name: "GraphicGroupDescription", VM: "1"}
+	tagDict[Tag{0x0070, 0x0209}] = TagInfo{Tag: Tag{0x0070, 0x0209}, VR: "SQ", Name: "CompoundGraphicSequence", VM: "1"}
+	tagDict[Tag{0x0070, 0x0226}] = TagInfo{Tag: Tag{0x0070, 0x0226}, VR: "UL", Name: "CompoundGraphicInstanceID", VM: "1"}
+	tagDict[Tag{0x0070, 0x0227}] = TagInfo{Tag: Tag{0x0070, 0x0227}, VR: "LO", Name: "FontName", VM: "1"}
+	tagDict[Tag{0x0070, 0x0228}] = TagInfo{Tag: Tag{0x0070, 0x0228}, VR: "CS", Name: "FontNameType", VM: "1"}
+	tagDict[Tag{0x0070, 0x0229}] = TagInfo{Tag: Tag{0x0070, 0x0229}, VR: "LO", Name: "CSSFontName", VM: "1"}
+	tagDict[Tag{0x0070, 0x0230}] = TagInfo{Tag: Tag{0x0070, 0x0230}, VR: "FD", Name: "RotationAngle", VM: "1"}
+	tagDict[Tag{0x0070, 0x0231}] = TagInfo{Tag: Tag{0x0070, 0x0231}, VR: "SQ", Name: "TextStyleSequence", VM: "1"}
+	tagDict[Tag{0x0070, 0x0232}] = TagInfo{Tag: Tag{0x0070, 0x0232}, VR: "SQ", Name: "LineStyleSequence", VM: "1"}
+	tagDict[Tag{0x0070, 0x0233}] = TagInfo{Tag: Tag{0x0070, 0x0233}, VR: "SQ", Name: "FillStyleSequence", VM: "1"}
+	tagDict[Tag{0x0070, 0x0234}] = TagInfo{Tag: Tag{0x0070, 0x0234}, VR: "SQ", Name: "GraphicGroupSequence", VM: "1"}
+	tagDict[Tag{0x0070, 0x0241}] = TagInfo{Tag: Tag{0x0070, 0x0241}, VR: "US", Name: "TextColorCIELabValue", VM: "3"}
+	tagDict[Tag{0x0070, 0x0242}] = TagInfo{Tag: Tag{0x0070, 0x0242}, VR: "CS", Name: "HorizontalAlignment", VM: "1"}
+	tagDict[Tag{0x0070, 0x0243}] = TagInfo{Tag: Tag{0x0070, 0x0243}, VR: "CS", Name: "VerticalAlignment", VM: "1"}
+	tagDict[Tag{0x0070, 0x0244}] = TagInfo{Tag: Tag{0x0070, 0x0244}, VR: "CS", Name: "ShadowStyle", VM: "1"}
+	tagDict[Tag{0x0070, 0x0245}] = TagInfo{Tag: Tag{0x0070, 0x0245}, VR: "FL", Name: "ShadowOffsetX", VM: "1"}
+	tagDict[Tag{0x0070, 0x0246}] = TagInfo{Tag: Tag{0x0070, 0x0246}, VR: "FL", Name: "ShadowOffsetY", VM: "1"}
+	tagDict[Tag{0x0070, 0x0247}] = TagInfo{Tag: Tag{0x0070, 0x0247}, VR: "US", Name: "ShadowColorCIELabValue", VM: "3"}
+	tagDict[Tag{0x0070, 0x0248}] = TagInfo{Tag: Tag{0x0070, 0x0248}, VR: "CS", Name: "Underlined", VM: "1"}
+	tagDict[Tag{0x0070, 0x0249}] = TagInfo{Tag: Tag{0x0070, 0x0249}, VR: "CS", Name: "Bold", VM: "1"}
+	tagDict[Tag{0x0070, 0x0250}] = TagInfo{Tag: Tag{0x0070, 0x0250}, VR: "CS", Name: "Italic", VM: "1"}
+	tagDict[Tag{0x0070, 0x0251}] = TagInfo{Tag: Tag{0x0070, 0x0251}, VR: "US", Name: "PatternOnColorCIELabValue", VM: "3"}
+	tagDict[Tag{0x0070, 0x0252}] = TagInfo{Tag: Tag{0x0070, 0x0252}, VR: "US", Name: "PatternOffColorCIELabValue", VM: "3"}
+	tagDict[Tag{0x0070, 0x0253}] = TagInfo{Tag: Tag{0x0070, 0x0253}, VR: "FL", Name: "LineThickness", VM: "1"}
+	tagDict[Tag{0x0070, 0x0254}] = TagInfo{Tag: Tag{0x0070, 0x0254}, VR: "CS", Name: "LineDashingStyle", VM: "1"}
+	tagDict[Tag{0x0070, 0x0255}] = TagInfo{Tag: Tag{0x0070, 0x0255}, VR: "UL", Name: "LinePattern", VM: "1"}
+	tagDict[Tag{0x0070, 0x0256}] = TagInfo{Tag: Tag{0x0070, 0x0256}, VR: "OB", Name: "FillPattern", VM: "1"}
+	tagDict[Tag{0x0070, 0x0257}] = TagInfo{Tag: Tag{0x0070, 0x0257}, VR: "CS", Name: "FillMode", VM: "1"}
+	tagDict[Tag{0x0070, 0x0258}] = TagInfo{Tag: Tag{0x0070, 0x0258}, VR: "FL", Name: "ShadowOpacity", VM: "1"}
+	tagDict[Tag{0x0070, 0x0261}] = TagInfo{Tag: Tag{0x0070, 0x0261}, VR: "FL", Name: "GapLength", VM: "1"}
+	tagDict[Tag{0x0070, 0x0262}] = TagInfo{Tag: Tag{0x0070, 0x0262}, VR: "FL", Name: "DiameterOfVisibility", VM: "1"}
+	tagDict[Tag{0x0070, 0x0273}] = TagInfo{Tag: Tag{0x0070, 0x0273}, VR: "FL", Name: "RotationPoint", VM: "2"}
+	tagDict[Tag{0x0070, 0x0274}] = TagInfo{Tag: Tag{0x0070, 0x0274}, VR: "CS", Name: "TickAlignment", VM: "1"}
+	tagDict[Tag{0x0070, 0x0278}] = TagInfo{Tag: Tag{0x0070, 0x0278}, VR: "CS", Name: "ShowTickLabel", VM: "1"}
+	tagDict[Tag{0x0070, 0x0279}] = TagInfo{Tag: Tag{0x0070, 0x0279}, VR: "CS", Name: "TickLabelAlignment", VM: "1"}
+	tagDict[Tag{0x0070, 0x0282}] = TagInfo{Tag: Tag{0x0070, 0x0282}, VR: "CS", Name: "CompoundGraphicUnits", VM: "1"}
+	tagDict[Tag{0x0070, 0x0284}] = TagInfo{Tag: Tag{0x0070, 0x0284}, VR: "FL", Name: "PatternOnOpacity", VM: "1"}
+	tagDict[Tag{0x0070, 0x0285}] = TagInfo{Tag: Tag{0x0070, 0x0285}, VR: "FL", Name: "PatternOffOpacity", VM: "1"}
+	tagDict[Tag{0x0070, 0x0287}] = TagInfo{Tag: Tag{0x0070, 0x0287}, VR: "SQ", Name: "MajorTicksSequence", VM: "1"}
+	tagDict[Tag{0x0070, 0x0288}] = TagInfo{Tag: Tag{0x0070, 0x0288}, VR: "FL", Name: "TickPosition", VM: "1"}
+	tagDict[Tag{0x0070, 0x0289}] = TagInfo{Tag: Tag{0x0070, 0x0289}, VR: "SH", Name: "TickLabel", VM: "1"}
+	tagDict[Tag{0x0070, 0x0294}] = TagInfo{Tag: Tag{0x0070, 0x0294}, VR: "CS", Name: "CompoundGraphicType", VM: "1"}
+	tagDict[Tag{0x0070, 0x0295}] = TagInfo{Tag: Tag{0x0070, 0x0295}, VR: "UL", Name: "GraphicGroupID", VM: "1"}
+	tagDict[Tag{0x0070, 0x0306}] = TagInfo{Tag: Tag{0x0070, 0x0306}, VR: "CS", Name: "ShapeType", VM: "1"}
+	tagDict[Tag{0x0070, 0x0308}] = TagInfo{Tag: Tag{0x0070, 0x0308}, VR: "SQ", Name: "RegistrationSequence", VM: "1"}
+	tagDict[Tag{0x0070, 0x0309}] = TagInfo{Tag: Tag{0x0070, 0x0309}, VR: "SQ", Name: "MatrixRegistrationSequence", VM: "1"}
+	tagDict[Tag{0x0070, 0x030A}] = TagInfo{Tag: Tag{0x0070, 0x030A}, VR: "SQ", Name: "MatrixSequence", VM: "1"}
+	tagDict[Tag{0x0070, 0x030C}] = TagInfo{Tag: Tag{0x0070, 0x030C}, VR: "CS", Name: "FrameOfReferenceTransformationMatrixType", VM: "1"}
+	tagDict[Tag{0x0070, 0x030D}] = TagInfo{Tag: Tag{0x0070, 0x030D}, VR: "SQ", Name: "RegistrationTypeCodeSequence", VM: "1"}
+	tagDict[Tag{0x0070, 0x030F}] = TagInfo{Tag: Tag{0x0070, 0x030F}, VR: "ST", Name: "FiducialDescription", VM: "1"}
+	tagDict[Tag{0x0070, 0x0310}] = TagInfo{Tag: Tag{0x0070, 0x0310}, VR: "SH", Name: "FiducialIdentifier", VM: "1"}
+	tagDict[Tag{0x0070, 0x0311}] = TagInfo{Tag: Tag{0x0070, 0x0311}, VR: "SQ", Name: "FiducialIdentifierCodeSequence", VM: "1"}
+	tagDict[Tag{0x0070, 0x0312}] = TagInfo{Tag: Tag{0x0070, 0x0312}, VR: "FD", Name: "ContourUncertaintyRadius", VM: "1"}
+	tagDict[Tag{0x0070, 0x0314}] = TagInfo{Tag: Tag{0x0070, 0x0314}, VR: "SQ", Name: "UsedFiducialsSequence", VM: "1"}
+	tagDict[Tag{0x0070, 0x0318}] = TagInfo{Tag: Tag{0x0070, 0x0318}, VR: "SQ", Name: "GraphicCoordinatesDataSequence", VM: "1"}
+	tagDict[Tag{0x0070, 0x031A}] = TagInfo{Tag: Tag{0x0070, 0x031A}, VR: "UI", Name: "FiducialUID", VM: "1"}
+	tagDict[Tag{0x0070, 0x031C}] = TagInfo{Tag: Tag{0x0070, 0x031C}, VR: "SQ", Name: "FiducialSetSequence", VM: "1"}
+	tagDict[Tag{0x0070, 0x031E}] = TagInfo{Tag: Tag{0x0070, 0x031E}, VR: "SQ", Name: "FiducialSequence", VM: "1"}
+	tagDict[Tag{0x0070, 0x0401}] = TagInfo{Tag: Tag{0x0070, 0x0401}, VR: "US", Name: "GraphicLayerRecommendedDisplayCIELabValue", VM: "3"}
+	tagDict[Tag{0x0070, 0x0402}] = TagInfo{Tag: Tag{0x0070, 0x0402}, VR: "SQ", Name: "BlendingSequence", VM: "1"}
+	tagDict[Tag{0x0070, 0x0403}] = TagInfo{Tag: Tag{0x0070, 0x0403}, VR: "FL", Name: "RelativeOpacity", VM: "1"}
+	tagDict[Tag{0x0070, 0x0404}] = TagInfo{Tag: Tag{0x0070, 0x0404}, VR: "SQ", Name: "ReferencedSpatialRegistrationSequence", VM: "1"}
+	tagDict[Tag{0x0070, 0x0405}] = TagInfo{Tag: Tag{0x0070, 0x0405}, VR: "CS", Name: "BlendingPosition", VM: "1"}
+	tagDict[Tag{0x0072, 0x0002}] = TagInfo{Tag: Tag{0x0072, 0x0002}, VR: "SH", Name: "HangingProtocolName", VM: "1"}
+	tagDict[Tag{0x0072, 0x0004}] = TagInfo{Tag: Tag{0x0072, 0x0004}, VR: "LO", Name: "HangingProtocolDescription", VM: "1"}
+	tagDict[Tag{0x0072, 0x0006}] = TagInfo{Tag: Tag{0x0072, 0x0006}, VR: "CS", Name: "HangingProtocolLevel", VM: "1"}
+	tagDict[Tag{0x0072, 0x0008}] = TagInfo{Tag: Tag{0x0072, 0x0008}, VR: "LO", Name: "HangingProtocolCreator", VM: "1"}
+	tagDict[Tag{0x0072, 0x000A}] = TagInfo{Tag: Tag{0x0072, 0x000A}, VR: "DT", Name: "HangingProtocolCreationDateTime", VM: "1"}
+	tagDict[Tag{0x0072, 0x000C}] = TagInfo{Tag: Tag{0x0072, 0x000C}, VR: "SQ", Name: "HangingProtocolDefinitionSequence", VM: "1"}
+	tagDict[Tag{0x0072, 0x000E}] = TagInfo{Tag: Tag{0x0072, 0x000E}, VR: "SQ", Name: "HangingProtocolUserIdentificationCodeSequence", VM: "1"}
+	tagDict[Tag{0x0072, 0x0010}] = TagInfo{Tag: Tag{0x0072, 0x0010}, VR: "LO", Name: "HangingProtocolUserGroupName", VM: "1"}
+	tagDict[Tag{0x0072, 0x0012}] = TagInfo{Tag: Tag{0x0072, 0x0012}, VR: "SQ", Name: "SourceHangingProtocolSequence", VM: "1"}
+	tagDict[Tag{0x0072, 0x0014}] = TagInfo{Tag: Tag{0x0072, 0x0014}, VR: "US", Name: "NumberOfPriorsReferenced", VM: "1"}
+	tagDict[Tag{0x0072, 0x0020}] = TagInfo{Tag: Tag{0x0072, 0x0020}, VR: "SQ", Name: "ImageSetsSequence", VM: "1"}
+	tagDict[Tag{0x0072, 0x0022}] = TagInfo{Tag: Tag{0x0072, 0x0022}, VR: "SQ", Name: "ImageSetSelectorSequence", VM: "1"}
+	tagDict[Tag{0x0072, 0x0024}] = TagInfo{Tag: Tag{0x0072, 0x0024}, VR: "CS", Name: "ImageSetSelectorUsageFlag", VM: "1"}
+	tagDict[Tag{0x0072, 0x0026}] = TagInfo{Tag: Tag{0x0072, 0x0026}, VR: "AT", Name: "SelectorAttribute", VM: "1"}
+	tagDict[Tag{0x0072, 0x0028}] = TagInfo{Tag: Tag{0x0072, 0x0028}, VR: "US", Name: "SelectorValueNumber", VM: "1"}
+	tagDict[Tag{0x0072, 0x0030}] = TagInfo{Tag: Tag{0x0072, 0x0030}, VR: "SQ", Name: "TimeBasedImageSetsSequence", VM: "1"}
+	tagDict[Tag{0x0072, 0x0032}] = TagInfo{Tag: Tag{0x0072, 0x0032}, VR: "US", Name: "ImageSetNumber", VM: "1"}
+	tagDict[Tag{0x0072, 0x0034}] = TagInfo{Tag: Tag{0x0072, 0x0034}, VR: "CS", Name: "ImageSetSelectorCategory", VM: "1"}
+	tagDict[Tag{0x0072, 0x0038}] = TagInfo{Tag: Tag{0x0072, 0x0038}, VR: "US", Name: "RelativeTime", VM: "2"}
+	tagDict[Tag{0x0072, 0x003A}] = TagInfo{Tag: Tag{0x0072, 0x003A}, VR: "CS", Name: "RelativeTimeUnits", VM: "1"}
+	tagDict[Tag{0x0072, 0x003C}] = TagInfo{Tag: Tag{0x0072, 0x003C}, VR: "SS", Name: "AbstractPriorValue", VM: "2"}
+	tagDict[Tag{0x0072, 0x003E}] = TagInfo{Tag: Tag{0x0072, 0x003E}, VR: "SQ", Name: "AbstractPriorCodeSequence", VM: "1"}
+	tagDict[Tag{0x0072, 0x0040}] = TagInfo{Tag: Tag{0x0072, 0x0040}, VR: "LO", Name: "ImageSetLabel", VM: "1"}
+	tagDict[Tag{0x0072, 0x0050}] = TagInfo{Tag: Tag{0x0072, 0x0050}, VR: "CS", Name: "SelectorAttributeVR", VM: "1"}
+	tagDict[Tag{0x0072, 0x0052}] = TagInfo{Tag: Tag{0x0072, 0x0052}, VR: "AT", Name: "SelectorSequencePointer", VM: "1-n"}
+	tagDict[Tag{0x0072, 0x0054}] = TagInfo{Tag: Tag{0x0072, 0x0054}, VR: "LO", Name: "SelectorSequencePointerPrivateCreator", VM: "1-n"}
+	tagDict[Tag{0x0072, 0x0056}] = TagInfo{Tag: Tag{0x0072, 0x0056}, VR: "LO", Name: "SelectorAttributePrivateCreator", VM: "1"}
+	tagDict[Tag{0x0072, 0x0060}] = TagInfo{Tag: Tag{0x0072, 0x0060}, VR: "AT", Name: "SelectorATValue", VM: "1-n"}
+	tagDict[Tag{0x0072, 0x0062}] = TagInfo{Tag: Tag{0x0072, 0x0062}, VR: "CS", Name: "SelectorCSValue", VM: "1-n"}
+	tagDict[Tag{0x0072, 0x0064}] = TagInfo{Tag: Tag{0x0072, 0x0064}, VR: "IS", Name: "SelectorISValue", VM: "1-n"}
+	tagDict[Tag{0x0072, 0x0066}] = TagInfo{Tag: Tag{0x0072, 0x0066}, VR: "LO", Name: "SelectorLOValue", VM: "1-n"}
+	tagDict[Tag{0x0072, 0x0068}] = TagInfo{Tag: Tag{0x0072, 0x0068}, VR: "LT", Name: "SelectorLTValue", VM: "1"}
+	tagDict[Tag{0x0072, 0x006A}] = TagInfo{Tag: Tag{0x0072, 0x006A}, VR: "PN", Name: "SelectorPNValue", VM: "1-n"}
+	tagDict[Tag{0x0072, 0x006C}] = TagInfo{Tag: Tag{0x0072, 0x006C}, VR: "SH", Name: "SelectorSHValue", VM: "1-n"}
+	tagDict[Tag{0x0072, 0x006E}] = TagInfo{Tag: Tag{0x0072, 0x006E}, VR: "ST", Name: "SelectorSTValue", VM: "1"}
+	tagDict[Tag{0x0072, 0x0070}] = TagInfo{Tag: Tag{0x0072, 0x0070}, VR: "UT", Name: "SelectorUTValue", VM: "1"}
+	tagDict[Tag{0x0072, 0x0072}] = TagInfo{Tag: Tag{0x0072, 0x0072}, VR: "DS", Name: "SelectorDSValue", VM: "1-n"}
+	tagDict[Tag{0x0072, 0x0074}] = TagInfo{Tag: Tag{0x0072, 0x0074}, VR: "FD", Name: "SelectorFDValue", VM: "1-n"}
+	tagDict[Tag{0x0072, 0x0076}] = TagInfo{Tag: Tag{0x0072, 0x0076}, VR: "FL", Name: "SelectorFLValue", VM: "1-n"}
+	tagDict[Tag{0x0072, 0x0078}] = TagInfo{Tag: Tag{0x0072, 0x0078}, VR: "UL", Name: "SelectorULValue", VM: "1-n"}
+	tagDict[Tag{0x0072, 0x007A}] = TagInfo{Tag: Tag{0x0072, 0x007A}, VR: "US", Name: "SelectorUSValue", VM: "1-n"}
+	tagDict[Tag{0x0072, 0x007C}] = TagInfo{Tag: Tag{0x0072, 0x007C}, VR: "SL", Name: "SelectorSLValue", VM: "1-n"}
+	tagDict[Tag{0x0072, 0x007E}] = TagInfo{Tag: Tag{0x0072, 0x007E}, VR: "SS", Name: "SelectorSSValue", VM: "1-n"}
+	tagDict[Tag{0x0072, 0x0080}] = TagInfo{Tag: Tag{0x0072, 0x0080}, VR: "SQ", Name: "SelectorCodeSequenceValue", VM: "1"}
+	tagDict[Tag{0x0072, 0x0100}] = TagInfo{Tag: Tag{0x0072, 0x0100}, VR: "US", Name: "NumberOfScreens", VM: "1"}
+	tagDict[Tag{0x0072, 0x0102}] = TagInfo{Tag: Tag{0x0072, 0x0102}, VR: "SQ", Name: "NominalScreenDefinitionSequence", VM: "1"}
+	tagDict[Tag{0x0072, 0x0104}] = TagInfo{Tag: Tag{0x0072, 0x0104}, VR: "US", Name: "NumberOfVerticalPixels", VM: "1"}
+	tagDict[Tag{0x0072, 0x0106}] = TagInfo{Tag: Tag{0x0072, 0x0106}, VR: "US", Name: "NumberOfHorizontalPixels", VM: "1"}
+	tagDict[Tag{0x0072, 0x0108}] = TagInfo{Tag: Tag{0x0072, 0x0108}, VR: "FD", Name: "DisplayEnvironmentSpatialPosition", VM: "4"}
+	tagDict[Tag{0x0072, 0x010A}] = TagInfo{Tag: Tag{0x0072, 0x010A}, VR: "US", Name: "ScreenMinimumGrayscaleBitDepth", VM: "1"}
+	tagDict[Tag{0x0072, 0x010C}] = TagInfo{Tag: Tag{0x0072, 0x010C}, VR: "US", Name: "ScreenMinimumColorBitDepth", VM: "1"}
+	tagDict[Tag{0x0072, 0x010E}] = TagInfo{Tag: Tag{0x0072, 0x010E}, VR: "US", Name: "ApplicationMaximumRepaintTime", VM: "1"}
+	tagDict[Tag{0x0072, 0x0200}] = TagInfo{Tag: Tag{0x0072, 0x0200}, VR: "SQ", Name: "DisplaySetsSequence", VM: "1"}
+	tagDict[Tag{0x0072, 0x0202}] = TagInfo{Tag: Tag{0x0072, 0x0202}, VR: "US", Name: "DisplaySetNumber", VM: "1"}
+	tagDict[Tag{0x0072, 0x0203}] = TagInfo{Tag: Tag{0x0072, 0x0203}, VR: "LO", Name: "DisplaySetLabel", VM: "1"}
+	tagDict[Tag{0x0072, 0x0204}] = TagInfo{Tag: Tag{0x0072, 0x0204}, VR: "US", Name: "DisplaySetPresentationGroup", VM: "1"}
+	tagDict[Tag{0x0072, 0x0206}] = TagInfo{Tag: Tag{0x0072, 0x0206}, VR: "LO", Name: "DisplaySetPresentationGroupDescription", VM: "1"}
+	tagDict[Tag{0x0072, 0x0208}] = TagInfo{Tag: Tag{0x0072, 0x0208}, VR: "CS", Name: "PartialDataDisplayHandling", VM: "1"}
+	tagDict[Tag{0x0072, 0x0210}] = TagInfo{Tag: Tag{0x0072, 0x0210}, VR: "SQ", Name: "SynchronizedScrollingSequence", VM: "1"}
+	tagDict[Tag{0x0072, 0x0212}] = TagInfo{Tag: Tag{0x0072, 0x0212}, VR: "US", Name: "DisplaySetScrollingGroup", VM: "2-n"}
+	tagDict[Tag{0x0072, 0x0214}] = TagInfo{Tag: Tag{0x0072, 0x0214}, VR: "SQ", Name: "NavigationIndicatorSequence", VM: "1"}
+	tagDict[Tag{0x0072, 0x0216}] = TagInfo{Tag: Tag{0x0072, 0x0216}, VR: "US", Name: "NavigationDisplaySet", VM: "1"}
+	tagDict[Tag{0x0072, 0x0218}] = TagInfo{Tag: Tag{0x0072, 0x0218}, VR: "US", Name: "ReferenceDisplaySets", VM: "1-n"}
+	tagDict[Tag{0x0072, 0x0300}] = TagInfo{Tag: Tag{0x0072, 0x0300}, VR: "SQ", Name: "ImageBoxesSequence", VM: "1"}
+	tagDict[Tag{0x0072, 0x0302}] = TagInfo{Tag: Tag{0x0072, 0x0302}, VR: "US", Name: "ImageBoxNumber", VM: "1"}
+	tagDict[Tag{0x0072, 0x0304}] = TagInfo{Tag: Tag{0x0072, 0x0304}, VR: "CS", Name: "ImageBoxLayoutType", VM: "1"}
+	tagDict[Tag{0x0072, 0x0306}] = TagInfo{Tag: Tag{0x0072, 0x0306}, VR: "US", Name: "ImageBoxTileHorizontalDimension", VM: "1"}
+	tagDict[Tag{0x0072, 0x0308}] = TagInfo{Tag: Tag{0x0072, 0x0308}, VR: "US", Name: "ImageBoxTileVerticalDimension", VM: "1"}
+	tagDict[Tag{0x0072, 0x0310}] = TagInfo{Tag: Tag{0x0072, 0x0310}, VR: "CS", Name: "ImageBoxScrollDirection", VM: "1"}
+	tagDict[Tag{0x0072, 0x0312}] = TagInfo{Tag: Tag{0x0072, 0x0312}, VR: "CS", Name: "ImageBoxSmallScrollType", VM: "1"}
+	tagDict[Tag{0x0072, 0x0314}] = TagInfo{Tag: Tag{0x0072, 0x0314}, VR: "US", Name: "ImageBoxSmallScrollAmount", VM: "1"}
+	tagDict[Tag{0x0072, 0x0316}] = TagInfo{Tag: Tag{0x0072, 0x0316}, VR: "CS", Name: "ImageBoxLargeScrollType", VM: "1"}
+	tagDict[Tag{0x0072, 0x0318}] = TagInfo{Tag: Tag{0x0072, 0x0318}, VR: "US", Name: "ImageBoxLargeScrollAmount", VM: "1"}
+	tagDict[Tag{0x0072, 0x0320}] = TagInfo{Tag: Tag{0x0072, 0x0320}, VR: "US", Name: "ImageBoxOverlapPriority", VM: "1"}
+	tagDict[Tag{0x0072, 0x0330}] = TagInfo{Tag: Tag{0x0072, 0x0330}, VR: "FD", Name: "CineRelativeToRealTime", VM: "1"}
+	tagDict[Tag{0x0072, 0x0400}] = TagInfo{Tag: Tag{0x0072, 0x0400}, VR: "SQ", Name: "FilterOperationsSequence", VM: "1"}
+	tagDict[Tag{0x0072, 0x0402}] = TagInfo{Tag: Tag{0x0072, 0x0402}, VR: "CS", Name: "FilterByCategory", VM: "1"}
+	tagDict[Tag{0x0072, 0x0404}] = TagInfo{Tag: Tag{0x0072, 0x0404}, VR: "CS", Name: "FilterByAttributePresence", VM: "1"}
+	tagDict[Tag{0x0072, 0x0406}] = TagInfo{Tag: Tag{0x0072, 0x0406}, VR: "CS", Name: "FilterByOperator", VM: "1"}
+	tagDict[Tag{0x0072, 0x0420}] = TagInfo{Tag: Tag{0x0072, 0x0420}, VR: "US", Name: "StructuredDisplayBackgroundCIELabValue", VM: "3"}
+	tagDict[Tag{0x0072, 0x0421}] = TagInfo{Tag: Tag{0x0072, 0x0421}, VR: "US", Name: "EmptyImageBoxCIELabValue", VM: "3"}
+	tagDict[Tag{0x0072, 0x0422}] = TagInfo{Tag: Tag{0x0072, 0x0422}, VR: "SQ", Name: "StructuredDisplayImageBoxSequence", VM: "1"}
+	tagDict[Tag{0x0072, 0x0424}] = TagInfo{Tag: Tag{0x0072, 0x0424}, VR: "SQ", Name: "StructuredDisplayTextBoxSequence", VM: "1"}
+	tagDict[Tag{0x0072, 0x0427}] = TagInfo{Tag: Tag{0x0072, 0x0427}, VR: "SQ", Name: "ReferencedFirstFrameSequence", VM: "1"}
+	tagDict[Tag{0x0072, 0x0430}] = TagInfo{Tag: Tag{0x0072, 0x0430}, VR: "SQ", Name: "ImageBoxSynchronizationSequence", VM: "1"}
+	tagDict[Tag{0x0072, 0x0432}] = TagInfo{Tag: Tag{0x0072, 0x0432}, VR: "US", Name: "SynchronizedImageBoxList", VM: "2-n"}
+	tagDict[Tag{0x0072, 0x0434}] = TagInfo{Tag: Tag{0x0072, 0x0434}, VR: "CS", Name: "TypeOfSynchronization", VM: "1"}
+	tagDict[Tag{0x0072, 0x0500}] = TagInfo{Tag: Tag{0x0072, 0x0500}, VR: "CS", Name: "BlendingOperationType", VM: "1"}
+	tagDict[Tag{0x0072, 0x0510}] = TagInfo{Tag: Tag{0x0072, 0x0510}, VR: "CS", Name: "ReformattingOperationType", VM: "1"}
+	tagDict[Tag{0x0072, 0x0512}] = TagInfo{Tag: Tag{0x0072, 0x0512}, VR: "FD", Name: "ReformattingThickness", VM: "1"}
+	tagDict[Tag{0x0072, 0x0514}] = TagInfo{Tag: Tag{0x0072, 0x0514}, VR: "FD", Name: "ReformattingInterval", VM: "1"}
+	tagDict[Tag{0x0072, 0x0516}] = TagInfo{Tag: Tag{0x0072, 0x0516}, VR: "CS", Name: "ReformattingOperationInitialViewDirection", VM: "1"}
+	tagDict[Tag{0x0072, 0x0520}] = TagInfo{Tag: Tag{0x0072, 0x0520}, VR: "CS", Name: "ThreeDRenderingType", VM: "1-n"}
+	tagDict[Tag{0x0072, 0x0600}] = TagInfo{Tag: Tag{0x0072, 0x0600}, VR: "SQ", Name: "SortingOperationsSequence", VM: "1"}
+	tagDict[Tag{0x0072, 0x0602}] = TagInfo{Tag: Tag{0x0072, 0x0602}, VR: "CS", Name: "SortByCategory", VM: "1"}
+	tagDict[Tag{0x0072, 0x0604}] = TagInfo{Tag: Tag{0x0072, 0x0604}, VR: "CS", Name: "SortingDirection", VM: "1"}
+	tagDict[Tag{0x0072, 0x0700}] = TagInfo{Tag: Tag{0x0072, 0x0700}, VR: "CS", Name: "DisplaySetPatientOrientation", VM: "2"}
+	tagDict[Tag{0x0072, 0x0702}] = TagInfo{Tag: Tag{0x0072, 0x0702}, VR: "CS", Name: "VOIType", VM: "1"}
+	tagDict[Tag{0x0072, 0x0704}] = TagInfo{Tag: Tag{0x0072, 0x0704}, VR: "CS", Name: "PseudoColorType", VM: "1"}
+	tagDict[Tag{0x0072, 0x0705}] = TagInfo{Tag: Tag{0x0072, 0x0705}, VR: "SQ", Name: "PseudoColorPaletteInstanceReferenceSequence", VM: "1"}
+	tagDict[Tag{0x0072, 0x0706}] = TagInfo{Tag: Tag{0x0072, 0x0706}, VR: "CS", Name: "ShowGrayscaleInverted", VM: "1"}
+	tagDict[Tag{0x0072, 0x0710}] = TagInfo{Tag: Tag{0x0072, 0x0710}, VR: "CS", Name: "ShowImageTrueSizeFlag", VM: "1"}
+	tagDict[Tag{0x0072, 0x0712}] = TagInfo{Tag: Tag{0x0072, 0x0712}, VR: "CS", Name: "ShowGraphicAnnotationFlag", VM: "1"}
+	tagDict[Tag{0x0072, 0x0714}] = TagInfo{Tag: Tag{0x0072, 0x0714}, VR: "CS", Name: "ShowPatientDemographicsFlag", VM: "1"}
+	tagDict[Tag{0x0072, 0x0716}] = TagInfo{Tag: Tag{0x0072, 0x0716}, VR: "CS", Name: "ShowAcquisitionTechniquesFlag", VM: "1"}
+	tagDict[Tag{0x0072, 0x0717}] = TagInfo{Tag: Tag{0x0072, 0x0717}, VR: "CS", Name: "DisplaySetHorizontalJustification", VM: "1"}
+	tagDict[Tag{0x0072, 0x0718}] = TagInfo{Tag: Tag{0x0072, 0x0718}, VR: "CS", Name: "DisplaySetVerticalJustification", VM: "1"}
+	tagDict[Tag{0x0074, 0x0120}] = TagInfo{Tag: Tag{0x0074, 0x0120}, VR: "FD", Name: "ContinuationStartMeterset", VM: "1"}
+	tagDict[Tag{0x0074, 0x0121}] = TagInfo{Tag: Tag{0x0074, 0x0121}, VR: "FD", Name: "ContinuationEndMeterset", VM: "1"}
+	tagDict[Tag{0x0074, 0x1000}] = TagInfo{Tag: Tag{0x0074, 0x1000}, VR: "CS", Name: "ProcedureStepState", VM: "1"}
+	tagDict[Tag{0x0074, 0x1002}] = TagInfo{Tag: Tag{0x0074, 0x1002}, VR: "SQ", Name: "ProcedureStepProgressInformationSequence", VM: "1"}
+	tagDict[Tag{0x0074, 0x1004}] = TagInfo{Tag: Tag{0x0074, 0x1004}, VR: "DS", Name: "ProcedureStepProgress", VM: "1"}
+	tagDict[Tag{0x0074, 0x1006}] = TagInfo{Tag: Tag{0x0074, 0x1006}, VR: "ST", Name: "ProcedureStepProgressDescription", VM: "1"}
+	tagDict[Tag{0x0074, 0x1008}] = TagInfo{Tag: Tag{0x0074, 0x1008}, VR: "SQ", Name: "ProcedureStepCommunicationsURISequence", VM: "1"}
+	tagDict[Tag{0x0074, 0x100A}] = TagInfo{Tag: Tag{0x0074, 0x100A}, VR: "ST", Name: "ContactURI", VM: "1"}
+	tagDict[Tag{0x0074, 0x100C}] = TagInfo{Tag: Tag{0x0074, 0x100C}, VR: "LO", Name: "ContactDisplayName", VM: "1"}
+	tagDict[Tag{0x0074, 0x100E}] = TagInfo{Tag: Tag{0x0074, 0x100E}, VR: "SQ", Name: "ProcedureStepDiscontinuationReasonCodeSequence", VM: "1"}
+	tagDict[Tag{0x0074, 0x1020}] = TagInfo{Tag: Tag{0x0074, 0x1020}, VR: "SQ", Name: "BeamTaskSequence", VM: "1"}
+	tagDict[Tag{0x0074, 0x1022}] = TagInfo{Tag: Tag{0x0074, 0x1022}, VR: "CS", Name: "BeamTaskType", VM: "1"}
+	tagDict[Tag{0x0074, 0x1026}] = TagInfo{Tag: Tag{0x0074, 0x1026}, VR: "FD", Name: "TableTopVerticalAdjustedPosition", VM: "1"}
+	tagDict[Tag{0x0074, 0x1027}] = TagInfo{Tag: Tag{0x0074, 0x1027}, VR: "FD", Name: "TableTopLongitudinalAdjustedPosition", VM: "1"}
+	tagDict[Tag{0x0074, 0x1028}] = TagInfo{Tag: Tag{0x0074, 0x1028}, VR: "FD", Name: "TableTopLateralAdjustedPosition", VM: "1"}
+	tagDict[Tag{0x0074, 0x102A}] = TagInfo{Tag: Tag{0x0074, 0x102A}, VR: "FD", Name: "PatientSupportAdjustedAngle", VM: "1"}
+	tagDict[Tag{0x0074, 0x102B}] = TagInfo{Tag: Tag{0x0074, 0x102B}, VR: "FD", Name: "TableTopEccentricAdjustedAngle", VM: "1"}
+	tagDict[Tag{0x0074, 0x102C}] = TagInfo{Tag: Tag{0x0074, 0x102C}, VR: "FD", Name: "TableTopPitchAdjustedAngle", VM: "1"}
+	tagDict[Tag{0x0074, 0x102D}] = TagInfo{Tag: Tag{0x0074, 0x102D}, VR: "FD", Name: "TableTopRollAdjustedAngle", VM: "1"}
+	tagDict[Tag{0x0074, 0x1030}] = TagInfo{Tag: Tag{0x0074, 0x1030}, VR: "SQ", Name: "DeliveryVerificationImageSequence", VM: "1"}
+	tagDict[Tag{0x0074, 0x1032}] = TagInfo{Tag: Tag{0x0074, 0x1032}, VR: "CS", Name: "VerificationImageTiming", VM: "1"}
+	tagDict[Tag{0x0074, 0x1034}] = TagInfo{Tag: Tag{0x0074, 0x1034}, VR: "CS", Name: "DoubleExposureFlag", VM: "1"}
+	tagDict[Tag{0x0074, 0x1036}] = TagInfo{Tag: Tag{0x0074, 0x1036}, VR: "CS", Name: "DoubleExposureOrdering", VM: "1"}
+	tagDict[Tag{0x0074, 0x1040}] = TagInfo{Tag: Tag{0x0074, 0x1040}, VR: "SQ", Name: "RelatedReferenceRTImageSequence", VM: "1"}
+	tagDict[Tag{0x0074, 0x1042}] = TagInfo{Tag: Tag{0x0074, 0x1042}, VR: "SQ", Name: "GeneralMachineVerificationSequence", VM: "1"}
+	tagDict[Tag{0x0074, 0x1044}] = TagInfo{Tag: Tag{0x0074, 0x1044}, VR: "SQ", Name: "ConventionalMachineVerificationSequence", VM: "1"}
+	tagDict[Tag{0x0074, 0x1046}] = TagInfo{Tag: Tag{0x0074, 0x1046}, VR: "SQ", Name: "IonMachineVerificationSequence", VM: "1"}
+	tagDict[Tag{0x0074, 0x1048}] = TagInfo{Tag: Tag{0x0074, 0x1048}, VR: "SQ", Name: "FailedAttributesSequence", VM: "1"}
+	tagDict[Tag{0x0074, 0x104A}] = TagInfo{Tag: Tag{0x0074, 0x104A}, VR: "SQ", Name: "OverriddenAttributesSequence", VM: "1"}
+	tagDict[Tag{0x0074, 0x104C}] = TagInfo{Tag: Tag{0x0074, 0x104C}, VR: "SQ", Name: "ConventionalControlPointVerificationSequence", VM: "1"}
+	tagDict[Tag{0x0074, 0x104E}] = TagInfo{Tag: Tag{0x0074, 0x104E}, VR: "SQ", Name: "IonControlPointVerificationSequence", VM: "1"}
+	tagDict[Tag{0x0074, 0x1050}] = TagInfo{Tag: Tag{0x0074, 0x1050}, VR: "SQ", Name: "AttributeOccurrenceSequence", VM: "1"}
+	tagDict[Tag{0x0074, 0x1052}] = TagInfo{Tag: Tag{0x0074, 0x1052}, VR: "AT", Name: "AttributeOccurrencePointer", VM: "1"}
+	tagDict[Tag{0x0074, 0x1054}] = TagInfo{Tag: Tag{0x0074, 0x1054}, VR: "UL", Name: "AttributeItemSelector", VM: "1"}
+	tagDict[Tag{0x0074, 0x1056}] = TagInfo{Tag: Tag{0x0074, 0x1056}, VR: "LO", Name: "AttributeOccurrencePrivateCreator", VM: "1"}
+	tagDict[Tag{0x0074, 0x1057}] = TagInfo{Tag: Tag{0x0074, 0x1057}, VR: "IS", Name: "SelectorSequencePointerItems", VM: "1-n"}
+	tagDict[Tag{0x0074, 0x1200}] = TagInfo{Tag: Tag{0x0074, 0x1200}, VR: "CS", Name: "ScheduledProcedureStepPriority", VM: "1"}
+	tagDict[Tag{0x0074, 0x1202}] = TagInfo{Tag: Tag{0x0074, 0x1202}, VR: "LO", Name: "WorklistLabel", VM: "1"}
+	tagDict[Tag{0x0074, 0x1204}] = TagInfo{Tag: Tag{0x0074, 0x1204}, VR: "LO", Name: "ProcedureStepLabel", VM: "1"}
+	tagDict[Tag{0x0074, 0x1210}] = TagInfo{Tag: Tag{0x0074, 0x1210}, VR: "SQ", Name: "ScheduledProcessingParametersSequence", VM: "1"}
+	tagDict[Tag{0x0074, 0x1212}] = TagInfo{Tag: Tag{0x0074, 0x1212}, VR: "SQ", Name: "PerformedProcessingParametersSequence", VM: "1"}
+	tagDict[Tag{0x0074, 0x1216}] = TagInfo{Tag: Tag{0x0074, 0x1216}, VR: "SQ", Name: "UnifiedProcedureStepPerformedProcedureSequence", VM: "1"}
+	tagDict[Tag{0x0074, 0x1224}] = TagInfo{Tag: Tag{0x0074, 0x1224}, VR: "SQ", Name: "ReplacedProcedureStepSequence", VM: "1"}
+	tagDict[Tag{0x0074, 0x1230}] = TagInfo{Tag: Tag{0x0074, 0x1230}, VR: "LO", Name: "DeletionLock", VM: "1"}
+	tagDict[Tag{0x0074, 0x1234}] = TagInfo{Tag: Tag{0x0074, 0x1234}, VR: "AE", Name: "ReceivingAE", VM: "1"}
+	tagDict[Tag{0x0074, 0x1236}] = TagInfo{Tag: Tag{0x0074, 0x1236}, VR: "AE", Name: "RequestingAE", VM: "1"}
+	tagDict[Tag{0x0074, 0x1238}] = TagInfo{Tag: Tag{0x0074, 0x1238}, VR: "LT", Name: "ReasonForCancellation", VM: "1"}
+	tagDict[Tag{0x0074, 0x1242}] = TagInfo{Tag: Tag{0x0074, 0x1242}, VR: "CS", Name: "SCPStatus", VM: "1"}
+	tagDict[Tag{0x0074, 0x1244}] = TagInfo{Tag: Tag{0x0074, 0x1244}, VR: "CS", Name: "SubscriptionListStatus", VM: "1"}
+	tagDict[Tag{0x0074, 0x1246}] = TagInfo{Tag: Tag{0x0074, 0x1246}, VR: "CS", Name: "UnifiedProcedureStepListStatus", VM: "1"}
+	tagDict[Tag{0x0074, 0x1324}] = TagInfo{Tag: Tag{0x0074, 0x1324}, VR: "UL", Name: "BeamOrderIndex", VM: "1"}
+	tagDict[Tag{0x0074, 0x1338}] = TagInfo{Tag: Tag{0x0074, 0x1338}, VR: "FD", Name: "DoubleExposureMeterset", VM: "1"}
+	tagDict[Tag{0x0074, 0x133A}] = TagInfo{Tag: Tag{0x0074, 0x133A}, VR: "FD", Name: "DoubleExposureFieldDelta", VM: "4"}
+	tagDict[Tag{0x0076, 0x0001}] = TagInfo{Tag: Tag{0x0076, 0x0001}, VR: "LO", Name: "ImplantAssemblyTemplateName", VM: "1"}
+	tagDict[Tag{0x0076, 0x0003}] = TagInfo{Tag: Tag{0x0076, 0x0003}, VR: "LO", Name: "ImplantAssemblyTemplateIssuer", VM: "1"}
+	tagDict[Tag{0x0076, 0x0006}] = TagInfo{Tag: Tag{0x0076, 0x0006}, VR: "LO", Name: "ImplantAssemblyTemplateVersion", VM: "1"}
+	tagDict[Tag{0x0076, 0x0008}] = TagInfo{Tag: Tag{0x0076, 0x0008}, VR: "SQ", Name: "ReplacedImplantAssemblyTemplateSequence", VM: "1"}
+	tagDict[Tag{0x0076, 0x000A}] = TagInfo{Tag: Tag{0x0076, 0x000A}, VR: "CS", Name: "ImplantAssemblyTemplateType", VM: "1"}
+	tagDict[Tag{0x0076, 0x000C}] = TagInfo{Tag: Tag{0x0076, 0x000C}, VR: "SQ", Name: "OriginalImplantAssemblyTemplateSequence", VM: "1"}
+	tagDict[Tag{0x0076, 0x000E}] = TagInfo{Tag: Tag{0x0076, 0x000E}, VR: "SQ", Name: "DerivationImplantAssemblyTemplateSequence", VM: "1"}
+	tagDict[Tag{0x0076, 0x0010}] = TagInfo{Tag: Tag{0x0076, 0x0010}, VR: "SQ", Name: "ImplantAssemblyTemplateTargetAnatomySequence", VM: "1"}
+	tagDict[Tag{0x0076, 0x0020}] = TagInfo{Tag: Tag{0x0076, 0x0020}, VR: "SQ", Name: "ProcedureTypeCodeSequence", VM: "1"}
+	tagDict[Tag{0x0076, 0x0030}] = TagInfo{Tag: Tag{0x0076, 0x0030}, VR: "LO", Name: "SurgicalTechnique", VM: "1"}
+	tagDict[Tag{0x0076, 0x0032}] = TagInfo{Tag: Tag{0x0076, 0x0032}, VR: "SQ", Name: "ComponentTypesSequence", VM: "1"}
+	tagDict[Tag{0x0076, 0x0034}] = TagInfo{Tag: Tag{0x0076, 0x0034}, VR: "CS", Name: "ComponentTypeCodeSequence", VM: "1"}
+	tagDict[Tag{0x0076, 0x0036}] = TagInfo{Tag: Tag{0x0076, 0x0036}, VR: "CS", Name: "ExclusiveComponentType", VM: "1"}
+	tagDict[Tag{0x0076, 0x0038}] = TagInfo{Tag: Tag{0x0076, 0x0038}, VR: "CS", Name: "MandatoryComponentType", VM: "1"}
+	tagDict[Tag{0x0076, 0x0040}] = TagInfo{Tag: Tag{0x0076, 0x0040}, VR: "SQ", Name: "ComponentSequence", VM: "1"}
+	tagDict[Tag{0x0076, 0x0055}] = TagInfo{Tag: Tag{0x0076, 0x0055}, VR: "US", Name: "ComponentID", VM: "1"}
+	tagDict[Tag{0x0076, 0x0060}] = TagInfo{Tag: Tag{0x0076, 0x0060}, VR: "SQ", Name: "ComponentAssemblySequence", VM: "1"}
+	tagDict[Tag{0x0076, 0x0070}] = TagInfo{Tag: Tag{0x0076, 0x0070}, VR: "US", Name: "Component1ReferencedID", VM: "1"}
+	tagDict[Tag{0x0076, 0x0080}] = TagInfo{Tag: Tag{0x0076, 0x0080}, VR: "US", Name: "Component1ReferencedMatingFeatureSetID", VM: "1"}
+	tagDict[Tag{0x0076, 0x0090}] = TagInfo{Tag: Tag{0x0076, 0x0090}, VR: "US", Name: "Component1ReferencedMatingFeatureID", VM: "1"}
+	tagDict[Tag{0x0076, 0x00A0}] = TagInfo{Tag: Tag{0x0076, 0x00A0}, VR: "US", Name: "Component2ReferencedID", VM: "1"}
+	tagDict[Tag{0x0076, 0x00B0}] = TagInfo{Tag: Tag{0x0076, 0x00B0}, VR: "US", Name: "Component2ReferencedMatingFeatureSetID", VM: "1"}
+	tagDict[Tag{0x0076, 0x00C0}] = TagInfo{Tag: Tag{0x0076, 0x00C0}, VR: "US", Name: "Component2ReferencedMatingFeatureID", VM: "1"}
+	tagDict[Tag{0x0078, 0x0001}] = TagInfo{Tag: Tag{0x0078, 0x0001}, VR: "LO", Name: "ImplantTemplateGroupName", VM: "1"}
+	tagDict[Tag{0x0078, 0x0010}] = TagInfo{Tag: Tag{0x0078, 0x0010}, VR: "ST", Name: "ImplantTemplateGroupDescription", VM: "1"}
+	tagDict[Tag{0x0078, 0x0020}] = TagInfo{Tag: Tag{0x0078, 0x0020}, VR: "LO", Name: "ImplantTemplateGroupIssuer", VM: "1"}
+	tagDict[Tag{0x0078, 0x0024}] = TagInfo{Tag: Tag{0x0078, 0x0024}, VR: "LO", Name: "ImplantTemplateGroupVersion", VM: "1"}
+	tagDict[Tag{0x0078, 0x0026}] = TagInfo{Tag: Tag{0x0078, 0x0026}, VR: "SQ", Name: "ReplacedImplantTemplateGroupSequence", VM: "1"}
+	tagDict[Tag{0x0078, 0x0028}] = TagInfo{Tag: Tag{0x0078, 0x0028}, VR: "SQ", Name: "ImplantTemplateGroupTargetAnatomySequence", VM: "1"}
+	tagDict[Tag{0x0078, 0x002A}] = TagInfo{Tag: Tag{0x0078, 0x002A}, VR: "SQ", Name: "ImplantTemplateGroupMembersSequence", VM: "1"}
+	tagDict[Tag{0x0078, 0x002E}] = TagInfo{Tag: Tag{0x0078, 0x002E}, VR: "US", Name: "ImplantTemplateGroupMemberID", VM: "1"}
+	tagDict[Tag{0x0078, 0x0050}] = TagInfo{Tag: Tag{0x0078, 0x0050}, VR: "FD", Name: "ThreeDImplantTemplateGroupMemberMatchingPoint", VM: "3"}
+	tagDict[Tag{0x0078, 0x0060}] = TagInfo{Tag: Tag{0x0078, 0x0060}, VR: "FD", Name: "ThreeDImplantTemplateGroupMemberMatchingAxes", VM: "9"}
+	tagDict[Tag{0x0078, 0x0070}] = TagInfo{Tag: Tag{0x0078, 0x0070}, VR: "SQ", Name: "ImplantTemplateGroupMemberMatching2DCoordinatesSequence", VM: "1"}
+	tagDict[Tag{0x0078, 0x0090}] = TagInfo{Tag: Tag{0x0078, 0x0090}, VR: "FD", Name: "TwoDImplantTemplateGroupMemberMatchingPoint", VM: "2"}
+	tagDict[Tag{0x0078, 0x00A0}] = TagInfo{Tag: Tag{0x0078, 0x00A0}, VR: "FD", Name: "TwoDImplantTemplateGroupMemberMatchingAxes", VM: "4"}
+	tagDict[Tag{0x0078, 0x00B0}] = TagInfo{Tag: Tag{0x0078, 0x00B0}, VR: "SQ", Name: "ImplantTemplateGroupVariationDimensionSequence", VM: "1"}
+	tagDict[Tag{0x0078, 0x00B2}] = TagInfo{Tag: Tag{0x0078, 0x00B2}, VR: "LO", Name: "ImplantTemplateGroupVariationDimensionName", VM: "1"}
+	tagDict[Tag{0x0078, 0x00B4}] = TagInfo{Tag: Tag{0x0078, 0x00B4}, VR: "SQ", Name: "ImplantTemplateGroupVariationDimensionRankSequence", VM: "1"}
+	tagDict[Tag{0x0078, 0x00B6}] = TagInfo{Tag: Tag{0x0078, 0x00B6}, VR: "US", Name: "ReferencedImplantTemplateGroupMemberID", VM: "1"}
+	tagDict[Tag{0x0078, 0x00B8}] = TagInfo{Tag: Tag{0x0078, 0x00B8}, VR: "US", Name: "ImplantTemplateGroupVariationDimensionRank", VM: "1"}
+	tagDict[Tag{0x0088, 0x0130}] = TagInfo{Tag: Tag{0x0088, 0x0130}, VR: "SH", Name: "StorageMediaFileSetID", VM: "1"}
+	tagDict[Tag{0x0088, 0x0140}] = TagInfo{Tag: Tag{0x0088, 0x0140}, VR: "UI", Name: "StorageMediaFileSetUID", VM: "1"}
+	tagDict[Tag{0x0088, 0x0200}] = TagInfo{Tag: Tag{0x0088, 0x0200}, VR: "SQ", Name: "IconImageSequence", VM: "1"}
+	tagDict[Tag{0x0100, 0x0410}] = TagInfo{Tag: Tag{0x0100, 0x0410}, VR: "CS", Name: "SOPInstanceStatus", VM: "1"}
+	tagDict[Tag{0x0100, 0x0420}] = TagInfo{Tag: Tag{0x0100, 0x0420}, VR: "DT", Name: "SOPAuthorizationDateTime", VM: "1"}
+	tagDict[Tag{0x0100, 0x0424}] = TagInfo{Tag: Tag{0x0100, 0x0424}, VR: "LT", Name: "SOPAuthorizationComment", VM: "1"}
+	tagDict[Tag{0x0100, 0x0426}] = TagInfo{Tag: Tag{0x0100, 0x0426}, VR: "LO", Name: "AuthorizationEquipmentCertificationNumber", VM: "1"}
+	tagDict[Tag{0x0400, 0x0005}] = TagInfo{Tag: Tag{0x0400, 0x0005}, VR: "US", Name: "MACIDNumber", VM: "1"}
+	tagDict[Tag{0x0400, 0x0010}] = TagInfo{Tag: Tag{0x0400, 0x0010}, VR: "UI", Name: "MACCalculationTransferSyntaxUID", VM: "1"}
+	tagDict[Tag{0x0400, 0x0015}] = TagInfo{Tag: Tag{0x0400, 0x0015}, VR: "CS", Name: "MACAlgorithm", VM: "1"}
+	tagDict[Tag{0x0400, 0x0020}] = TagInfo{Tag: Tag{0x0400, 0x0020}, VR: "AT", Name: "DataElementsSigned", VM: "1-n"}
+	tagDict[Tag{0x0400, 0x0100}] = TagInfo{Tag: Tag{0x0400, 0x0100}, VR: "UI", Name: "DigitalSignatureUID", VM: "1"}
+	tagDict[Tag{0x0400, 0x0105}] = TagInfo{Tag: Tag{0x0400, 0x0105}, VR: "DT", Name: "DigitalSignatureDateTime", VM: "1"}
+	tagDict[Tag{0x0400, 0x0110}] = TagInfo{Tag: Tag{0x0400, 0x0110}, VR: "CS", Name: "CertificateType", VM: "1"}
+	tagDict[Tag{0x0400, 0x0115}] = TagInfo{Tag: Tag{0x0400, 0x0115}, VR: "OB", Name: "CertificateOfSigner", VM: "1"}
+	tagDict[Tag{0x0400, 0x0120}] = TagInfo{Tag: Tag{0x0400, 0x0120}, VR: "OB", Name: "Signature", VM: "1"}
+	tagDict[Tag{0x0400, 0x0305}] = TagInfo{Tag: Tag{0x0400, 0x0305}, VR: "CS", Name: "CertifiedTimestampType", VM: "1"}
+	tagDict[Tag{0x0400, 0x0310}] = TagInfo{Tag: Tag{0x0400, 0x0310}, VR: "OB", Name: "CertifiedTimestamp", VM: "1"}
+	tagDict[Tag{0x0400, 0x0401}] = TagInfo{Tag: Tag{0x0400, 0x0401}, VR: "SQ", Name: "DigitalSignaturePurposeCodeSequence", VM: "1"}
+	tagDict[Tag{0x0400, 0x0402}] = TagInfo{Tag: Tag{0x0400, 0x0402}, VR: "SQ", Name: "ReferencedDigitalSignatureSequence", VM: "1"}
+	tagDict[Tag{0x0400, 0x0403}] = TagInfo{Tag: Tag{0x0400, 0x0403}, VR: "SQ", Name: "ReferencedSOPInstanceMACSequence", VM: "1"}
+	tagDict[Tag{0x0400, 0x0404}] = TagInfo{Tag: Tag{0x0400, 0x0404}, VR: "OB", Name: "MAC", VM: "1"}
+	tagDict[Tag{0x0400, 0x0500}] = TagInfo{Tag: Tag{0x0400, 0x0500}, VR: "SQ", Name: "EncryptedAttributesSequence", VM: "1"}
+	tagDict[Tag{0x0400, 0x0510}] = TagInfo{Tag: Tag{0x0400, 0x0510}, VR: "UI", Name: "EncryptedContentTransferSyntaxUID", VM: "1"}
+	tagDict[Tag{0x0400, 0x0520}] = TagInfo{Tag: Tag{0x0400, 0x0520}, VR: "OB", Name: "EncryptedContent", VM: "1"}
+	tagDict[Tag{0x0400, 0x0550}] = TagInfo{Tag: Tag{0x0400, 0x0550}, VR: "SQ", Name: "ModifiedAttributesSequence", VM: "1"}
+	tagDict[Tag{0x0400, 0x0561}] = TagInfo{Tag: Tag{0x0400, 0x0561}, VR: "SQ", Name: "OriginalAttributesSequence", VM: "1"}
+	tagDict[Tag{0x0400, 0x0562}] = TagInfo{Tag: Tag{0x0400, 0x0562}, VR: "DT", Name: "AttributeModificationDateTime", VM: "1"}
+	tagDict[Tag{0x0400, 0x0563}] = TagInfo{Tag: Tag{0x0400, 0x0563}, VR: "LO", Name: "ModifyingSystem", VM: "1"}
+	tagDict[Tag{0x0400, 0x0564}] = TagInfo{Tag: Tag{0x0400, 0x0564}, VR: "LO", Name: "SourceOfPreviousValues", VM: "1"}
+	tagDict[Tag{0x0400, 0x0565}] = TagInfo{Tag: Tag{0x0400, 0x0565}, VR: "CS", Name: "ReasonForTheAttributeModification", VM: "1"}
+	tagDict[Tag{0x2000, 0x0010}] = TagInfo{Tag: Tag{0x2000, 0x0010}, VR: "IS", Name: "NumberOfCopies", VM: "1"}
+	tagDict[Tag{0x2000, 0x001E}] = TagInfo{Tag: Tag{0x2000, 0x001E}, VR: "SQ", Name: "PrinterConfigurationSequence", VM: "1"}
+	tagDict[Tag{0x2000, 0x0020}] = TagInfo{Tag: Tag{0x2000, 0x0020}, VR: "CS", Name: "PrintPriority", VM: "1"}
+	tagDict[Tag{0x2000, 0x0030}] = TagInfo{Tag: Tag{0x2000, 0x0030}, VR: "CS", Name: "MediumType", VM: "1"}
+	tagDict[Tag{0x2000, 0x0040}] = TagInfo{Tag: Tag{0x2000, 0x0040}, VR: "CS", Name: "FilmDestination", VM: "1"}
+	tagDict[Tag{0x2000, 0x0050}] = TagInfo{Tag: Tag{0x2000, 0x0050}, VR: "LO", Name: "FilmSessionLabel", VM: "1"}
+	tagDict[Tag{0x2000, 0x0060}] = TagInfo{Tag: Tag{0x2000, 0x0060}, VR: "IS", Name: "MemoryAllocation", VM: "1"}
+	tagDict[Tag{0x2000, 0x0061}] = TagInfo{Tag: Tag{0x2000, 0x0061}, VR: "IS", Name: "MaximumMemoryAllocation", VM: "1"}
+	tagDict[Tag{0x2000, 0x00A0}] = TagInfo{Tag: Tag{0x2000, 0x00A0}, VR: "US", Name: "MemoryBitDepth", VM: "1"}
+	tagDict[Tag{0x2000, 0x00A1}] = TagInfo{Tag: Tag{0x2000, 0x00A1}, VR: "US", Name: "PrintingBitDepth", VM: "1"}
+	tagDict[Tag{0x2000, 0x00A2}] = TagInfo{Tag: Tag{0x2000, 0x00A2}, VR: "SQ", Name: "MediaInstalledSequence", VM: "1"}
+	tagDict[Tag{0x2000, 0x00A4}] = TagInfo{Tag: Tag{0x2000, 0x00A4}, VR: "SQ", Name: "OtherMediaAvailableSequence", VM: "1"}
+	tagDict[Tag{0x2000, 0x00A8}] = TagInfo{Tag: Tag{0x2000, 0x00A8}, VR: "SQ", Name: "SupportedImageDisplayFormatsSequence", VM: "1"}
+	tagDict[Tag{0x2000, 0x0500}] = TagInfo{Tag: Tag{0x2000, 0x0500}, VR: "SQ", Name: "ReferencedFilmBoxSequence", VM: "1"}
+	tagDict[Tag{0x2010, 0x0010}] = TagInfo{Tag: Tag{0x2010, 0x0010}, VR: "ST", Name: "ImageDisplayFormat", VM: "1"}
+	tagDict[Tag{0x2010, 0x0030}] = TagInfo{Tag: Tag{0x2010, 0x0030}, VR: "CS", Name: "AnnotationDisplayFormatID", VM: "1"}
+	tagDict[Tag{0x2010, 0x0040}] = TagInfo{Tag: Tag{0x2010, 0x0040}, VR: "CS", Name: "FilmOrientation", VM: "1"}
+	tagDict[Tag{0x2010, 0x0050}] = TagInfo{Tag: Tag{0x2010, 0x0050}, VR: "CS", Name: "FilmSizeID", VM: "1"}
+	tagDict[Tag{0x2010, 0x0052}] = TagInfo{Tag: Tag{0x2010, 0x0052}, VR: "CS", Name: "PrinterResolutionID", VM: "1"}
+	tagDict[Tag{0x2010, 0x0054}] = TagInfo{Tag: Tag{0x2010, 0x0054}, VR: "CS", Name: "DefaultPrinterResolutionID", VM: "1"}
+	tagDict[Tag{0x2010, 0x0060}] = TagInfo{Tag: Tag{0x2010, 0x0060}, VR: "CS", Name: "MagnificationType", VM: "1"}
+	tagDict[Tag{0x2010, 0x0080}] = TagInfo{Tag: Tag{0x2010, 0x0080}, VR: "CS", Name: "SmoothingType", VM: "1"}
+	tagDict[Tag{0x2010, 0x00A6}] = TagInfo{Tag: Tag{0x2010, 0x00A6}, VR: "CS", Name: "DefaultMagnificationType", VM: "1"}
+	tagDict[Tag{0x2010, 0x00A7}] = TagInfo{Tag: Tag{0x2010, 0x00A7}, VR: "CS", Name: "OtherMagnificationTypesAvailable", VM: "1-n"}
+	tagDict[Tag{0x2010, 0x00A8}] = TagInfo{Tag: Tag{0x2010, 0x00A8}, VR: "CS", Name: "DefaultSmoothingType", VM: "1"}
+	tagDict[Tag{0x2010, 0x00A9}] = TagInfo{Tag: Tag{0x2010, 0x00A9}, VR: "CS", Name: "OtherSmoothingTypesAvailable", VM: "1-n"}
+	tagDict[Tag{0x2010, 0x0100}] = TagInfo{Tag: Tag{0x2010, 0x0100}, VR: "CS", Name: "BorderDensity", VM: "1"}
+	tagDict[Tag{0x2010, 0x0110}] = TagInfo{Tag: Tag{0x2010, 0x0110}, VR: "CS", Name: "EmptyImageDensity", VM: "1"}
+	tagDict[Tag{0x2010, 0x0120}] = TagInfo{Tag: Tag{0x2010, 0x0120}, VR: "US", Name: "MinDensity", VM: "1"}
+	tagDict[Tag{0x2010, 0x0130}] = TagInfo{Tag: Tag{0x2010, 0x0130}, VR: "US", Name: "MaxDensity", VM: "1"}
+	tagDict[Tag{0x2010, 0x0140}] = TagInfo{Tag: Tag{0x2010, 0x0140}, VR: "CS", Name: "Trim", VM: "1"}
+	tagDict[Tag{0x2010, 0x0150}] = TagInfo{Tag: Tag{0x2010, 0x0150}, VR: "ST", Name: "ConfigurationInformation", VM: "1"}
+	tagDict[Tag{0x2010, 0x0152}] = TagInfo{Tag: Tag{0x2010, 0x0152}, VR: "LT", Name: "ConfigurationInformationDescription", VM: "1"}
+	tagDict[Tag{0x2010, 0x0154}] = TagInfo{Tag: Tag{0x2010, 0x0154}, VR: "IS", Name: "MaximumCollatedFilms", VM: "1"}
+	tagDict[Tag{0x2010, 0x015E}] = TagInfo{Tag: Tag{0x2010, 0x015E}, VR: "US", Name: "Illumination", VM: "1"}
+	tagDict[Tag{0x2010, 0x0160}] = TagInfo{Tag: Tag{0x2010, 0x0160}, VR: "US", Name: "ReflectedAmbientLight", VM: "1"}
+	tagDict[Tag{0x2010, 0x0376}] = TagInfo{Tag: Tag{0x2010, 0x0376}, VR: "DS", Name: "PrinterPixelSpacing", VM: "2"}
+	tagDict[Tag{0x2010, 0x0500}] = TagInfo{Tag: Tag{0x2010, 0x0500}, VR: "SQ", Name: "ReferencedFilmSessionSequence", VM: "1"}
+	tagDict[Tag{0x2010, 0x0510}] = TagInfo{Tag: Tag{0x2010, 0x0510}, VR: "SQ", Name: "ReferencedImageBoxSequence", VM: "1"}
+	tagDict[Tag{0x2010, 0x0520}] = TagInfo{Tag: Tag{0x2010, 0x0520}, VR: "SQ", Name: "ReferencedBasicAnnotationBoxSequence", VM: "1"}
+	tagDict[Tag{0x2020, 0x0010}] = TagInfo{Tag: Tag{0x2020, 0x0010}, VR: "US", Name: "ImageBoxPosition", VM: "1"}
+	tagDict[Tag{0x2020, 0x0020}] = TagInfo{Tag: Tag{0x2020, 0x0020}, VR: "CS", Name: "Polarity", VM: "1"}
+	tagDict[Tag{0x2020, 0x0030}] = TagInfo{Tag: Tag{0x2020, 0x0030}, VR: "DS", Name: "RequestedImageSize", VM: "1"}
+	tagDict[Tag{0x2020, 0x0040}] = TagInfo{Tag: Tag{0x2020, 0x0040}, VR: "CS", Name: "RequestedDecimateCropBehavior", VM: "1"}
+	tagDict[Tag{0x2020, 0x0050}] = TagInfo{Tag: Tag{0x2020, 0x0050}, VR: "CS", Name: "RequestedResolutionID", VM: "1"}
+	tagDict[Tag{0x2020, 0x00A0}] = TagInfo{Tag: Tag{0x2020, 0x00A0}, VR: "CS", Name: "RequestedImageSizeFlag", VM: "1"}
+	tagDict[Tag{0x2020, 0x00A2}] = TagInfo{Tag: Tag{0x2020, 0x00A2}, VR: "CS", Name: "DecimateCropResult", VM: "1"}
+	tagDict[Tag{0x2020, 0x0110}] = TagInfo{Tag: Tag{0x2020, 0x0110}, VR: "SQ", Name: "BasicGrayscaleImageSequence", VM: "1"}
+	tagDict[Tag{0x2020, 0x0111}] = TagInfo{Tag: Tag{0x2020, 0x0111}, VR: "SQ", Name: "BasicColorImageSequence", VM: "1"}
+	tagDict[Tag{0x2030, 0x0010}] = TagInfo{Tag: Tag{0x2030, 0x0010}, VR: "US", Name: "AnnotationPosition", VM: "1"}
+	tagDict[Tag{0x2030, 0x0020}] = TagInfo{Tag: Tag{0x2030, 0x0020}, VR: "LO", Name: "TextString", VM: "1"}
+	tagDict[Tag{0x2050, 0x0010}] = TagInfo{Tag: Tag{0x2050, 0x0010}, VR: "SQ", Name: "PresentationLUTSequence", VM: "1"}
+	tagDict[Tag{0x2050, 0x0020}] = TagInfo{Tag: Tag{0x2050, 0x0020}, VR: "CS", Name: "PresentationLUTShape", VM: "1"}
+	tagDict[Tag{0x2050, 0x0500}] = TagInfo{Tag: Tag{0x2050, 0x0500}, VR: "SQ", Name: "ReferencedPresentationLUTSequence", VM: "1"}
+	tagDict[Tag{0x2100, 0x0020}] = TagInfo{Tag: Tag{0x2100, 0x0020}, VR: "CS", Name: "ExecutionStatus", VM: "1"}
+	tagDict[Tag{0x2100, 0x0030}] = TagInfo{Tag: Tag{0x2100, 0x0030}, VR: "CS", Name: "ExecutionStatusInfo", VM: "1"}
+	tagDict[Tag{0x2100, 0x0040}] = TagInfo{Tag: Tag{0x2100, 0x0040}, VR: "DA", Name: "CreationDate", VM: "1"}
+	tagDict[Tag{0x2100, 0x0050}] = TagInfo{Tag: Tag{0x2100, 0x0050}, VR: "TM", Name: "CreationTime", VM: "1"}
+	tagDict[Tag{0x2100, 0x0070}] = TagInfo{Tag: Tag{0x2100, 0x0070}, VR: "AE", Name: "Originator", VM: "1"}
+	tagDict[Tag{0x2100, 0x0160}] = TagInfo{Tag: Tag{0x2100, 0x0160}, VR: "SH", Name: "OwnerID", VM: "1"}
+	tagDict[Tag{0x2100, 0x0170}] = TagInfo{Tag: Tag{0x2100, 0x0170}, VR: "IS", Name: "NumberOfFilms", VM: "1"}
+	tagDict[Tag{0x2110, 0x0010}] = TagInfo{Tag: Tag{0x2110, 0x0010}, VR: "CS", Name: "PrinterStatus", VM: "1"}
+	tagDict[Tag{0x2110, 0x0020}] = TagInfo{Tag: Tag{0x2110, 0x0020}, VR: "CS", Name: "PrinterStatusInfo", VM: "1"}
+	tagDict[Tag{0x2110, 0x0030}] = TagInfo{Tag: Tag{0x2110, 0x0030}, VR: "LO", Name: "PrinterName", VM: "1"}
+	tagDict[Tag{0x2200, 0x0001}] = TagInfo{Tag: Tag{0x2200, 0x0001}, VR: "CS", Name: "LabelUsingInformationExtractedFromInstances", VM: "1"}
+	tagDict[Tag{0x2200, 0x0002}] = TagInfo{Tag: Tag{0x2200, 0x0002}, VR: "UT", Name: "LabelText", VM: "1"}
+	tagDict[Tag{0x2200, 0x0003}] = TagInfo{Tag: Tag{0x2200, 0x0003}, VR: "CS", Name: "LabelStyleSelection", VM: "1"}
+	tagDict[Tag{0x2200, 0x0004}] = TagInfo{Tag: Tag{0x2200, 0x0004}, VR: "LT", Name: "MediaDisposition", VM: "1"}
+	tagDict[Tag{0x2200, 0x0005}] = TagInfo{Tag: Tag{0x2200, 0x0005}, VR: "LT", Name: "BarcodeValue", VM: "1"}
+	tagDict[Tag{0x2200, 0x0006}] = TagInfo{Tag: Tag{0x2200, 0x0006}, VR: "CS", Name: "BarcodeSymbology", VM: "1"}
+	tagDict[Tag{0x2200, 0x0007}] = TagInfo{Tag: Tag{0x2200, 0x0007}, VR: "CS", Name: "AllowMediaSplitting", VM: "1"}
+	tagDict[Tag{0x2200, 0x0008}] = TagInfo{Tag: Tag{0x2200, 0x0008}, VR: "CS", Name: "IncludeNonDICOMObjects", VM: "1"}
+	tagDict[Tag{0x2200, 0x0009}] = TagInfo{Tag: Tag{0x2200, 0x0009}, VR: "CS", Name: "IncludeDisplayApplication", VM: "1"}
+	tagDict[Tag{0x2200, 0x000A}] = TagInfo{Tag: Tag{0x2200, 0x000A}, VR: "CS", Name: "PreserveCompositeInstancesAfterMediaCreation", VM: "1"}
+	tagDict[Tag{0x2200, 0x000B}] = TagInfo{Tag: Tag{0x2200, 0x000B}, VR: "US", Name: "TotalNumberOfPiecesOfMediaCreated", VM: "1"}
+	tagDict[Tag{0x2200, 0x000C}] = TagInfo{Tag: Tag{0x2200, 0x000C}, VR: "LO", Name: "RequestedMediaApplicationProfile", VM: "1"}
+	tagDict[Tag{0x2200, 0x000D}] = TagInfo{Tag: Tag{0x2200, 0x000D}, VR: "SQ", Name: "ReferencedStorageMediaSequence", VM: "1"}
+	tagDict[Tag{0x2200, 0x000E}] = TagInfo{Tag: Tag{0x2200, 0x000E}, VR: "AT", Name: "FailureAttributes", VM: "1-n"}
+	tagDict[Tag{0x2200, 0x000F}] = TagInfo{Tag: Tag{0x2200, 0x000F}, VR: "CS", Name: "AllowLossyCompression", VM: "1"}
+	tagDict[Tag{0x2200, 0x0020}] = TagInfo{Tag: Tag{0x2200, 0x0020}, VR: "CS", Name: "RequestPriority", VM: "1"}
+	tagDict[Tag{0x3002, 0x0002}] = TagInfo{Tag: Tag{0x3002, 0x0002}, VR: "SH", Name: "RTImageLabel", VM: "1"}
+	tagDict[Tag{0x3002, 0x0003}] = TagInfo{Tag: Tag{0x3002, 0x0003}, VR: "LO", Name: "RTImageName", VM: "1"}
+	tagDict[Tag{0x3002, 0x0004}] = TagInfo{Tag: Tag{0x3002, 0x0004}, VR: "ST", Name: "RTImageDescription", VM: "1"}
+	tagDict[Tag{0x3002, 0x000A}] = TagInfo{Tag: Tag{0x3002, 0x000A}, VR: "CS", Name: "ReportedValuesOrigin", VM: "1"}
+	tagDict[Tag{0x3002, 0x000C}] = TagInfo{Tag: Tag{0x3002, 0x000C}, VR: "CS", Name: "RTImagePlane", VM: "1"}
+	tagDict[Tag{0x3002, 0x000D}] = TagInfo{Tag: Tag{0x3002, 0x000D}, VR: "DS", Name: "XRayImageReceptorTranslation", VM: "3"}
+	tagDict[Tag{0x3002, 0x000E}] = TagInfo{Tag: Tag{0x3002, 0x000E}, VR: "DS", Name: "XRayImageReceptorAngle", VM: "1"}
+	tagDict[Tag{0x3002, 0x0010}] = TagInfo{Tag: Tag{0x3002, 0x0010}, VR: "DS", Name: "RTImageOrientation", VM: "6"}
+	tagDict[Tag{0x3002, 0x0011}] = TagInfo{Tag: Tag{0x3002, 0x0011}, VR: "DS", Name: "ImagePlanePixelSpacing", VM: "2"}
+	tagDict[Tag{0x3002, 0x0012}] = TagInfo{Tag: Tag{0x3002, 0x0012}, VR: "DS", Name: "RTImagePosition", VM: "2"}
+	tagDict[Tag{0x3002, 0x0020}] = TagInfo{Tag: Tag{0x3002, 0x0020}, VR: "SH", Name: "RadiationMachineName", VM: "1"}
+	tagDict[Tag{0x3002, 0x0022}] = TagInfo{Tag: Tag{0x3002, 0x0022}, VR: "DS", Name: "RadiationMachineSAD", VM: "1"}
+	tagDict[Tag{0x3002, 0x0024}] = TagInfo{Tag: Tag{0x3002, 0x0024}, VR: "DS", Name: "RadiationMachineSSD", VM: "1"}
+	tagDict[Tag{0x3002, 0x0026}] = TagInfo{Tag: Tag{0x3002, 0x0026}, VR: "DS", Name: "RTImageSID", VM: "1"}
+	tagDict[Tag{0x3002, 0x0028}] = TagInfo{Tag: Tag{0x3002, 0x0028}, VR: "DS", Name: "SourceToReferenceObjectDistance", VM: "1"}
+	tagDict[Tag{0x3002, 0x0029}] = TagInfo{Tag: Tag{0x3002, 0x0029}, VR: "IS", Name: "FractionNumber", VM: "1"}
+	tagDict[Tag{0x3002, 0x0030}] = TagInfo{Tag: Tag{0x3002, 0x0030}, VR: "SQ", Name: "ExposureSequence", VM: "1"}
+	tagDict[Tag{0x3002, 0x0032}] = TagInfo{Tag: Tag{0x3002, 0x0032}, VR: "DS", Name: "MetersetExposure", VM: "1"}
+	tagDict[Tag{0x3002, 0x0034}] = TagInfo{Tag: Tag{0x3002, 0x0034}, VR: "DS", Name: "DiaphragmPosition", VM: "4"}
+	tagDict[Tag{0x3002, 0x0040}] = TagInfo{Tag: Tag{0x3002, 0x0040}, VR: "SQ", Name: "FluenceMapSequence", VM: "1"}
+	tagDict[Tag{0x3002, 0x0041}] = TagInfo{Tag: Tag{0x3002, 0x0041}, VR: "CS", Name: "FluenceDataSource", VM: "1"}
+	tagDict[Tag{0x3002, 0x0042}] = TagInfo{Tag: Tag{0x3002, 0x0042}, VR: "DS", Name: "FluenceDataScale", VM: "1"}
+	tagDict[Tag{0x3002, 0x0050}] = TagInfo{Tag: Tag{0x3002, 0x0050}, VR: "SQ", Name: "PrimaryFluenceModeSequence", VM: "1"}
+	tagDict[Tag{0x3002, 0x0051}] = TagInfo{Tag: Tag{0x3002, 0x0051}, VR: "CS", Name: "FluenceMode", VM: "1"}
+	tagDict[Tag{0x3002, 0x0052}] = TagInfo{Tag: Tag{0x3002, 0x0052}, VR: "SH", Name: "FluenceModeID", VM: "1"}
+	tagDict[Tag{0x3004, 0x0001}] = TagInfo{Tag: Tag{0x3004, 0x0001}, VR: "CS", Name: "DVHType", VM: "1"}
+	tagDict[Tag{0x3004, 0x0002}] = TagInfo{Tag: Tag{0x3004, 0x0002}, VR: "CS", Name: "DoseUnits", VM: "1"}
+	tagDict[Tag{0x3004, 0x0004}] = TagInfo{Tag: Tag{0x3004, 0x0004}, VR: "CS", Name: "DoseType", VM: "1"}
+	tagDict[Tag{0x3004, 0x0006}] = TagInfo{Tag: Tag{0x3004, 0x0006}, VR: "LO", Name: "DoseComment", VM: "1"}
+	tagDict[Tag{0x3004, 0x0008}] = TagInfo{Tag: Tag{0x3004, 0x0008}, VR: "DS", Name: "NormalizationPoint", VM: "3"}
+	tagDict[Tag{0x3004, 0x000A}] = TagInfo{Tag: Tag{0x3004, 0x000A}, VR: "CS", Name: "DoseSummationType", VM: "1"}
+	tagDict[Tag{0x3004, 0x000C}] = TagInfo{Tag: Tag{0x3004, 0x000C}, VR: "DS", Name: "GridFrameOffsetVector", VM: "2-n"}
+	tagDict[Tag{0x3004, 0x000E}] = TagInfo{Tag: Tag{0x3004, 0x000E}, VR: "DS", Name: "DoseGridScaling", VM: "1"}
+	tagDict[Tag{0x3004, 0x0010}] = TagInfo{Tag: Tag{0x3004, 0x0010}, VR: "SQ", Name: "RTDoseROISequence", VM: "1"}
+	tagDict[Tag{0x3004, 0x0012}] = TagInfo{Tag: Tag{0x3004, 0x0012}, VR: "DS", Name: "DoseValue", VM: "1"}
+	tagDict[Tag{0x3004, 0x0014}] = TagInfo{Tag: Tag{0x3004, 0x0014}, VR: "CS", Name: "TissueHeterogeneityCorrection", VM: "1-3"}
+	tagDict[Tag{0x3004, 0x0040}] = TagInfo{Tag: Tag{0x3004, 0x0040}, VR: "DS", Name: "DVHNormalizationPoint", VM: "3"}
+	tagDict[Tag{0x3004, 0x0042}] = TagInfo{Tag: Tag{0x3004, 0x0042}, VR: "DS", Name: "DVHNormalizationDoseValue", VM: "1"}
+	tagDict[Tag{0x3004, 0x0050}] = TagInfo{Tag: Tag{0x3004, 0x0050}, VR: "SQ", Name: "DVHSequence", VM: "1"}
+	tagDict[Tag{0x3004, 0x0052}] = TagInfo{Tag: Tag{0x3004, 0x0052}, VR: "DS", Name: "DVHDoseScaling", VM: "1"}
+	tagDict[Tag{0x3004, 0x0054}] = TagInfo{Tag: Tag{0x3004, 0x0054}, VR: "CS", Name: "DVHVolumeUnits", VM: "1"}
+	tagDict[Tag{0x3004, 0x0056}] = TagInfo{Tag: Tag{0x3004, 0x0056}, VR: "IS", Name: "DVHNumberOfBins", VM: "1"}
+	tagDict[Tag{0x3004, 0x0058}] = TagInfo{Tag: Tag{0x3004, 0x0058}, VR: "DS", Name: "DVHData", VM: "2-2n"}
+	tagDict[Tag{0x3004, 0x0060}] = TagInfo{Tag: Tag{0x3004, 0x0060}, VR: "SQ", Name: "DVHReferencedROISequence", VM: "1"}
+	tagDict[Tag{0x3004, 0x0062}] = TagInfo{Tag: Tag{0x3004, 0x0062}, VR: "CS", Name: "DVHROIContributionType", VM: "1"}
+	tagDict[Tag{0x3004, 0x0070}] = TagInfo{Tag: Tag{0x3004, 0x0070}, VR: "DS", Name: "DVHMinimumDose", VM: "1"}
+	tagDict[Tag{0x3004, 0x0072}] = TagInfo{Tag: Tag{0x3004, 0x0072}, VR: "DS", Name: "DVHMaximumDose", VM: "1"}
+	tagDict[Tag{0x3004, 0x0074}] = TagInfo{Tag: Tag{0x3004, 0x0074}, VR: "DS", Name: "DVHMeanDose", VM: "1"}
+	tagDict[Tag{0x3006, 0x0002}] = TagInfo{Tag: Tag{0x3006, 0x0002}, VR: "SH", Name: "StructureSetLabel", VM: "1"}
+	tagDict[Tag{0x3006, 0x0004}] = TagInfo{Tag: Tag{0x3006, 0x0004}, VR: "LO", Name: "StructureSetName", VM: "1"}
+	tagDict[Tag{0x3006, 0x0006}] = TagInfo{Tag: Tag{0x3006, 0x0006}, VR: "ST", Name: "StructureSetDescription", VM: "1"}
+	tagDict[Tag{0x3006, 0x0008}] = TagInfo{Tag: Tag{0x3006, 0x0008}, VR: "DA", Name: "StructureSetDate", VM: "1"}
+	tagDict[Tag{0x3006, 0x0009}] = TagInfo{Tag: Tag{0x3006, 0x0009}, VR: "TM", Name: "StructureSetTime", VM: "1"}
+	tagDict[Tag{0x3006, 0x0010}] = TagInfo{Tag: Tag{0x3006, 0x0010}, VR: "SQ", Name: "ReferencedFrameOfReferenceSequence", VM: "1"}
+	tagDict[Tag{0x3006, 0x0012}] = TagInfo{Tag: Tag{0x3006, 0x0012}, VR: "SQ", Name: "RTReferencedStudySequence", VM: "1"}
+	tagDict[Tag{0x3006, 0x0014}] = TagInfo{Tag: Tag{0x3006, 0x0014}, VR: "SQ", Name: "RTReferencedSeriesSequence", VM: "1"}
+	tagDict[Tag{0x3006, 0x0016}] = TagInfo{Tag: Tag{0x3006, 0x0016}, VR: "SQ", Name: "ContourImageSequence", VM: "1"}
+	tagDict[Tag{0x3006, 0x0020}] = TagInfo{Tag: Tag{0x3006, 0x0020}, VR: "SQ", Name: "StructureSetROISequence", VM: "1"}
+	tagDict[Tag{0x3006, 0x0022}] = TagInfo{Tag: Tag{0x3006, 0x0022}, VR: "IS", Name: "ROINumber", VM: "1"}
+	tagDict[Tag{0x3006, 0x0024}] = TagInfo{Tag: Tag{0x3006, 0x0024}, VR: "UI", Name: "ReferencedFrameOfReferenceUID", VM: "1"}
+	tagDict[Tag{0x3006, 0x0026}] = TagInfo{Tag: Tag{0x3006, 0x0026}, VR: "LO", Name: "ROIName", VM: "1"}
+	tagDict[Tag{0x3006, 0x0028}] = TagInfo{Tag: Tag{0x3006, 0x0028}, VR: "ST", Name: "ROIDescription", VM: "1"}
+	tagDict[Tag{0x3006, 0x002A}] = TagInfo{Tag: Tag{0x3006, 0x002A}, VR: "IS", Name: "ROIDisplayColor", VM: "3"}
+	tagDict[Tag{0x3006, 0x002C}] = TagInfo{Tag: Tag{0x3006, 0x002C}, VR: "DS", Name: "ROIVolume", VM: "1"}
+	tagDict[Tag{0x3006, 0x0030}] = TagInfo{Tag: Tag{0x3006, 0x0030}, VR: "SQ", Name: "RTRelatedROISequence", VM: "1"}
+	tagDict[Tag{0x3006, 0x0033}] = TagInfo{Tag: Tag{0x3006, 0x0033}, VR: "CS", Name: "RTROIRelationship", VM: "1"}
+	tagDict[Tag{0x3006, 0x0036}] = TagInfo{Tag: Tag{0x3006, 0x0036}, VR: "CS", Name: "ROIGenerationAlgorithm", VM: "1"}
+	tagDict[Tag{0x3006, 0x0038}] = TagInfo{Tag: Tag{0x3006, 0x0038}, VR: "LO", Name: "ROIGenerationDescription", VM: "1"}
+	tagDict[Tag{0x3006, 0x0039}] = TagInfo{Tag: Tag{0x3006, 0x0039}, VR: "SQ", Name: "ROIContourSequence", VM: "1"}
+	tagDict[Tag{0x3006, 0x0040}] = TagInfo{Tag: Tag{0x3006, 0x0040}, VR: "SQ", Name: "ContourSequence", VM: "1"}
+	tagDict[Tag{0x3006, 0x0042}] = TagInfo{Tag: Tag{0x3006, 0x0042}, VR: "CS", Name: "ContourGeometricType", VM: "1"}
+	tagDict[Tag{0x3006, 0x0044}] = TagInfo{Tag: Tag{0x3006, 0x0044}, VR: "DS", Name: "ContourSlabThickness", VM: "1"}
+	tagDict[Tag{0x3006, 0x0045}] = TagInfo{Tag: Tag{0x3006, 0x0045}, VR: "DS", Name: "ContourOffsetVector", VM: "3"}
+	tagDict[Tag{0x3006, 0x0046}] = TagInfo{Tag: Tag{0x3006, 0x0046}, VR: "IS", Name: "NumberOfContourPoints", VM: "1"}
+	tagDict[Tag{0x3006, 0x0048}] = TagInfo{Tag: Tag{0x3006, 0x0048}, VR: "IS", Name: "ContourNumber", VM: "1"}
+	tagDict[Tag{0x3006, 0x0049}] = TagInfo{Tag: Tag{0x3006, 0x0049}, VR: "IS", Name: "AttachedContours", VM: "1-n"}
+	tagDict[Tag{0x3006, 0x0050}] = TagInfo{Tag: Tag{0x3006, 0x0050}, VR: "DS", Name: "ContourData", VM: "3-3n"}
+	tagDict[Tag{0x3006, 0x0080}] = TagInfo{Tag: Tag{0x3006, 0x0080}, VR: "SQ", Name: "RTROIObservationsSequence", VM: "1"}
+	tagDict[Tag{0x3006, 0x0082}] = TagInfo{Tag: Tag{0x3006, 0x0082}, VR: "IS", Name: "ObservationNumber", VM: "1"}
+	tagDict[Tag{0x3006, 0x0084}] = TagInfo{Tag: Tag{0x3006, 0x0084}, VR: "IS", Name: "ReferencedROINumber", VM: "1"}
+	tagDict[Tag{0x3006, 0x0085}] = TagInfo{Tag: Tag{0x3006, 0x0085}, VR: "SH", Name: "ROIObservationLabel", VM: "1"}
+	tagDict[Tag{0x3006, 0x0086}] = TagInfo{Tag: Tag{0x3006, 0x0086}, VR: "SQ", Name: "RTROIIdentificationCodeSequence", VM: "1"}
+	tagDict[Tag{0x3006, 0x0088}] = TagInfo{Tag: Tag{0x3006, 0x0088}, VR: "ST", Name: "ROIObservationDescription", VM: "1"}
+	tagDict[Tag{0x3006, 0x00A0}] = TagInfo{Tag: Tag{0x3006, 0x00A0}, VR: "SQ", Name: "RelatedRTROIObservationsSequence", VM: "1"}
+	tagDict[Tag{0x3006, 0x00A4}] = TagInfo{Tag: Tag{0x3006, 0x00A4}, VR: "CS", Name: "RTROIInterpretedType", VM: "1"}
+	tagDict[Tag{0x3006, 0x00A6}] = TagInfo{Tag: Tag{0x3006, 0x00A6}, VR: "PN", Name: "ROIInterpreter", VM: "1"}
+	tagDict[Tag{0x3006, 0x00B0}] = TagInfo{Tag: Tag{0x3006, 0x00B0}, VR: "SQ", Name: "ROIPhysicalPropertiesSequence", VM: "1"}
+	tagDict[Tag{0x3006, 0x00B2}] = TagInfo{Tag: Tag{0x3006, 0x00B2}, VR: "CS", Name: "ROIPhysicalProperty", VM: "1"}
+	tagDict[Tag{0x3006, 0x00B4}] = TagInfo{Tag: Tag{0x3006, 0x00B4}, VR: "DS", Name: "ROIPhysicalPropertyValue", VM: "1"}
+	tagDict[Tag{0x3006, 0x00B6}] = TagInfo{Tag: Tag{0x3006, 0x00B6}, VR: "SQ", Name: "ROIElementalCompositionSequence", VM: "1"}
+	tagDict[Tag{0x3006, 0x00B7}] = TagInfo{Tag: Tag{0x3006, 0x00B7}, VR: "US", Name: "ROIElementalCompositionAtomicNumber", VM: "1"}
+	tagDict[Tag{0x3006, 0x00B8}] = TagInfo{Tag: Tag{0x3006, 0x00B8}, VR: "FL", Name: "ROIElementalCompositionAtomicMassFraction", VM: "1"}
+	tagDict[Tag{0x3006, 0x00C0}] = TagInfo{Tag: Tag{0x3006, 0x00C0}, VR: "SQ", Name: "FrameOfReferenceRelationshipSequence", VM: "1"}
+	tagDict[Tag{0x3006, 0x00C2}] = TagInfo{Tag: Tag{0x3006, 0x00C2}, VR: "UI", Name: "RelatedFrameOfReferenceUID", VM: "1"}
+	tagDict[Tag{0x3006, 0x00C4}] = TagInfo{Tag: Tag{0x3006, 0x00C4}, VR: "CS", Name: "FrameOfReferenceTransformationType", VM: "1"}
+	tagDict[Tag{0x3006, 0x00C6}] = TagInfo{Tag: Tag{0x3006, 0x00C6}, VR: "DS", Name: "FrameOfReferenceTransformationMatrix", VM: "16"}
+	tagDict[Tag{0x3006, 0x00C8}] = TagInfo{Tag: Tag{0x3006, 0x00C8}, VR: "LO", Name: "FrameOfReferenceTransformationComment", VM: "1"}
+	tagDict[Tag{0x3008, 0x0010}] = TagInfo{Tag: Tag{0x3008, 0x0010}, VR: "SQ", Name: "MeasuredDoseReferenceSequence", VM: "1"}
+	tagDict[Tag{0x3008, 0x0012}] = TagInfo{Tag: Tag{0x3008, 0x0012}, VR: "ST", Name: "MeasuredDoseDescription", VM: "1"}
+	tagDict[Tag{0x3008, 0x0014}] = TagInfo{Tag: Tag{0x3008, 0x0014}, VR: "CS", Name: "MeasuredDoseType", VM: "1"}
+	tagDict[Tag{0x3008, 0x0016}] = TagInfo{Tag: Tag{0x3008, 0x0016}, VR: "DS", Name: "MeasuredDoseValue", VM: "1"}
+	tagDict[Tag{0x3008, 0x0020}] = TagInfo{Tag: Tag{0x3008, 0x0020}, VR: "SQ", Name: "TreatmentSessionBeamSequence", VM: "1"}
+	tagDict[Tag{0x3008, 0x0021}] = TagInfo{Tag: Tag{0x3008, 0x0021}, VR: "SQ", Name: "TreatmentSessionIonBeamSequence", VM: "1"}
+	tagDict[Tag{0x3008, 0x0022}] = TagInfo{Tag: Tag{0x3008, 0x0022}, VR: "IS", Name: "CurrentFractionNumber", VM: "1"}
+	tagDict[Tag{0x3008, 0x0024}] = TagInfo{Tag: Tag{0x3008, 0x0024}, VR: "DA", Name: "TreatmentControlPointDate", VM: "1"}
+	tagDict[Tag{0x3008, 0x0025}] = TagInfo{Tag: Tag{0x3008, 0x0025}, VR: "TM", Name: "TreatmentControlPointTime", VM: "1"}
+	tagDict[Tag{0x3008, 0x002A}] = TagInfo{Tag: Tag{0x3008, 0x002A}, VR: "CS", Name: "TreatmentTerminationStatus", VM: "1"}
+	tagDict[Tag{0x3008, 0x002B}] = TagInfo{Tag: Tag{0x3008, 0x002B}, VR: "SH", Name: "TreatmentTerminationCode", VM: "1"}
+	tagDict[Tag{0x3008, 0x002C}] = TagInfo{Tag: Tag{0x3008, 0x002C}, VR: "CS", Name: "TreatmentVerificationStatus", VM: "1"}
+	tagDict[Tag{0x3008, 0x0030}] = TagInfo{Tag: Tag{0x3008, 0x0030}, VR: "SQ", Name: "ReferencedTreatmentRecordSequence", VM: "1"}
+	tagDict[Tag{0x3008, 0x0032}] = TagInfo{Tag: Tag{0x3008, 0x0032}, VR: "DS", Name: "SpecifiedPrimaryMeterset", VM: "1"}
+	tagDict[Tag{0x3008, 0x0033}] = TagInfo{Tag: Tag{0x3008, 0x0033}, VR: "DS", Name: "SpecifiedSecondaryMeterset", VM: "1"}
+	tagDict[Tag{0x3008, 0x0036}] = TagInfo{Tag: Tag{0x3008, 0x0036}, VR: "DS", Name: "DeliveredPrimaryMeterset", VM: "1"}
+	tagDict[Tag{0x3008, 0x0037}] = TagInfo{Tag: Tag{0x3008, 0x0037}, VR: "DS", Name: "DeliveredSecondaryMeterset", VM: "1"}
+	tagDict[Tag{0x3008, 0x003A}] = TagInfo{Tag: Tag{0x3008, 0x003A}, VR: "DS", Name: "SpecifiedTreatmentTime", VM: "1"}
+	tagDict[Tag{0x3008, 0x003B}] = TagInfo{Tag: Tag{0x3008, 0x003B}, VR: "DS", Name: "DeliveredTreatmentTime", VM: "1"}
+	tagDict[Tag{0x3008, 0x0040}] = TagInfo{Tag: Tag{0x3008, 0x0040}, VR: "SQ", Name: "ControlPointDeliverySequence", VM: "1"}
+	tagDict[Tag{0x3008, 0x0041}] = TagInfo{Tag: Tag{0x3008, 0x0041}, VR: "SQ", Name: "IonControlPointDeliverySequence", VM: "1"}
+	tagDict[Tag{0x3008, 0x0042}] = TagInfo{Tag: Tag{0x3008, 0x0042}, VR: "DS", Name: "SpecifiedMeterset", VM: "1"}
+	tagDict[Tag{0x3008, 0x0044}] = TagInfo{Tag: Tag{0x3008, 0x0044}, VR: "DS", Name: "DeliveredMeterset", VM: "1"}
+	tagDict[Tag{0x3008, 0x0045}] = TagInfo{Tag: Tag{0x3008, 0x0045}, VR: "FL", Name: "MetersetRateSet", VM: "1"}
+	tagDict[Tag{0x3008, 0x0046}] = TagInfo{Tag: Tag{0x3008, 0x0046}, VR: "FL", Name: "MetersetRateDelivered", VM: "1"}
+	tagDict[Tag{0x3008, 0x0047}] = TagInfo{Tag: Tag{0x3008, 0x0047}, VR: "FL", Name: "ScanSpotMetersetsDelivered", VM: "1-n"}
+	tagDict[Tag{0x3008, 0x0048}] = TagInfo{Tag: Tag{0x3008, 0x0048}, VR: "DS", Name: "DoseRateDelivered", VM: "1"}
+	tagDict[Tag{0x3008, 0x0050}] = TagInfo{Tag: Tag{0x3008, 0x0050}, VR: "SQ", Name: "TreatmentSummaryCalculatedDoseReferenceSequence", VM: "1"}
+	tagDict[Tag{0x3008, 0x0052}] = TagInfo{Tag: Tag{0x3008, 0x0052}, VR: "DS", Name: "CumulativeDoseToDoseReference", VM: "1"}
+	tagDict[Tag{0x3008, 0x0054}] = TagInfo{Tag: Tag{0x3008, 0x0054}, VR: "DA", Name: "FirstTreatmentDate", VM: "1"}
+	tagDict[Tag{0x3008, 0x0056}] = TagInfo{Tag: Tag{0x3008, 0x0056}, VR: "DA", Name: "MostRecentTreatmentDate", VM: "1"}
+	tagDict[Tag{0x3008, 0x005A}] = TagInfo{Tag: Tag{0x3008, 0x005A}, VR: "IS", Name: "NumberOfFractionsDelivered", VM: "1"}
+	tagDict[Tag{0x3008, 0x0060}] = TagInfo{Tag: Tag{0x3008, 0x0060}, VR: "SQ", Name: "OverrideSequence", VM: "1"}
+	tagDict[Tag{0x3008, 0x0061}] = TagInfo{Tag: Tag{0x3008, 0x0061}, VR: "AT", Name: "ParameterSequencePointer", VM: "1"}
+	tagDict[Tag{0x3008, 0x0062}] = TagInfo{Tag: Tag{0x3008, 0x0062}, VR: "AT", Name: "OverrideParameterPointer", VM: "1"}
+	tagDict[Tag{0x3008, 0x0063}] = TagInfo{Tag: Tag{0x3008, 0x0063}, VR: "IS", Name: "ParameterItemIndex", VM: "1"}
+	tagDict[Tag{0x3008, 0x0064}] = TagInfo{Tag: Tag{0x3008, 0x0064}, VR: "IS", Name: "MeasuredDoseReferenceNumber", VM: "1"}
+	tagDict[Tag{0x3008, 0x0065}] = TagInfo{Tag: Tag{0x3008, 0x0065}, VR: "AT", Name: "ParameterPointer", VM: "1"}
+	tagDict[Tag{0x3008, 0x0066}] = TagInfo{Tag: Tag{0x3008, 0x0066}, VR: "ST", Name: "OverrideReason", VM: "1"}
+	tagDict[Tag{0x3008, 0x0068}] = TagInfo{Tag: Tag{0x3008, 0x0068}, VR: "SQ", Name: "CorrectedParameterSequence", VM: "1"}
+	tagDict[Tag{0x3008, 0x006A}] = TagInfo{Tag: Tag{0x3008, 0x006A}, VR: "FL", Name: "CorrectionValue", VM: "1"}
+	tagDict[Tag{0x3008, 0x0070}] = TagInfo{Tag: Tag{0x3008, 0x0070}, VR: "SQ", Name: "CalculatedDoseReferenceSequence", VM: "1"}
+	tagDict[Tag{0x3008, 0x0072}] = TagInfo{Tag: Tag{0x3008, 0x0072}, VR: "IS", Name: "CalculatedDoseReferenceNumber", VM: "1"}
+	tagDict[Tag{0x3008, 0x0074}] = TagInfo{Tag: Tag{0x3008, 0x0074}, VR: "ST", Name: "CalculatedDoseReferenceDescription", VM: "1"}
+	tagDict[Tag{0x3008, 0x0076}] = TagInfo{Tag: Tag{0x3008, 0x0076}, VR: "DS", Name: "CalculatedDoseReferenceDoseValue", VM: "1"}
+	tagDict[Tag{0x3008, 0x0078}] = TagInfo{Tag: Tag{0x3008, 0x0078}, VR: "DS", Name: "StartMeterset", VM: "1"}
+	tagDict[Tag{0x3008, 0x007A}] = TagInfo{Tag: Tag{0x3008, 0x007A}, VR: "DS", Name: "EndMeterset", VM: "1"}
+	tagDict[Tag{0x3008, 0x0080}] = TagInfo{Tag: Tag{0x3008, 0x0080}, VR: "SQ", Name: "ReferencedMeasuredDoseReferenceSequence", VM: "1"}
+	tagDict[Tag{0x3008, 0x0082}] = TagInfo{Tag: Tag{0x3008, 0x0082}, VR: "IS", Name: "ReferencedMeasuredDoseReferenceNumber", VM: "1"}
+	tagDict[Tag{0x3008, 0x0090}] = TagInfo{Tag: Tag{0x3008, 0x0090}, VR: "SQ", Name: "ReferencedCalculatedDoseReferenceSequence", VM: "1"}
+	tagDict[Tag{0x3008, 0x0092}] = TagInfo{Tag: Tag{0x3008, 0x0092}, VR: "IS", Name: "ReferencedCalculatedDoseReferenceNumber", VM: "1"}
+	tagDict[Tag{0x3008, 0x00A0}] = TagInfo{Tag: Tag{0x3008, 0x00A0}, VR: "SQ", Name: "BeamLimitingDeviceLeafPairsSequence", VM: "1"}
+	tagDict[Tag{0x3008, 0x00B0}] = TagInfo{Tag: Tag{0x3008, 0x00B0}, VR: "SQ", Name: "RecordedWedgeSequence", VM: "1"}
+	tagDict[Tag{0x3008, 0x00C0}] = TagInfo{Tag: Tag{0x3008, 0x00C0}, VR: "SQ", Name: "RecordedCompensatorSequence", VM: "1"}
+	tagDict[Tag{0x3008, 0x00D0}] = TagInfo{Tag: Tag{0x3008, 0x00D0}, VR: "SQ", Name: "RecordedBlockSequence", VM: "1"}
+	tagDict[Tag{0x3008, 0x00E0}] = TagInfo{Tag: Tag{0x3008, 0x00E0}, VR: "SQ", Name: "TreatmentSummaryMeasuredDoseReferenceSequence", VM: "1"}
+	tagDict[Tag{0x3008, 0x00F0}] = TagInfo{Tag: Tag{0x3008, 0x00F0}, VR: "SQ", Name: "RecordedSnoutSequence", VM: "1"}
+	tagDict[Tag{0x3008, 0x00F2}] = TagInfo{Tag: Tag{0x3008, 0x00F2}, VR: "SQ", Name: "RecordedRangeShifterSequence", VM: "1"}
+	tagDict[Tag{0x3008, 0x00F4}] = TagInfo{Tag: Tag{0x3008, 0x00F4}, VR: "SQ", Name: "RecordedLateralSpreadingDeviceSequence", VM: "1"}
+	tagDict[Tag{0x3008, 0x00F6}] = TagInfo{Tag: Tag{0x3008, 0x00F6}, VR: "SQ", Name: "RecordedRangeModulatorSequence", VM: "1"}
+	tagDict[Tag{0x3008, 0x0100}] = TagInfo{Tag: Tag{0x3008, 0x0100}, VR: "SQ", Name: "RecordedSourceSequence", VM: "1"}
+	tagDict[Tag{0x3008, 0x0105}] = TagInfo{Tag: Tag{0x3008, 0x0105}, VR: "LO", Name: "SourceSerialNumber", VM: "1"}
+	tagDict[Tag{0x3008, 0x0110}] = TagInfo{Tag: Tag{0x3008, 0x0110}, VR: "SQ", Name: "TreatmentSessionApplicationSetupSequence", VM: "1"}
+	tagDict[Tag{0x3008, 0x0116}] = TagInfo{Tag: Tag{0x3008, 0x0116}, VR: "CS", Name: "ApplicationSetupCheck", VM: "1"}
+	tagDict[Tag{0x3008, 0x0120}] = TagInfo{Tag: Tag{0x3008, 0x0120}, VR: "SQ", Name: "RecordedBrachyAccessoryDeviceSequence", VM: "1"}
+	tagDict[Tag{0x3008, 0x0122}] = TagInfo{Tag: Tag{0x3008, 0x0122}, VR: "IS", Name: "ReferencedBrachyAccessoryDeviceNumber", VM: "1"}
+	tagDict[Tag{0x3008, 0x0130}] = TagInfo{Tag: Tag{0x3008, 0x0130}, VR: "SQ", Name: "RecordedChannelSequence", VM: "1"}
+	tagDict[Tag{0x3008, 0x0132}] = TagInfo{Tag: Tag{0x3008, 0x0132}, VR: "DS", Name: "SpecifiedChannelTotalTime", VM: "1"}
+	tagDict[Tag{0x3008, 0x0134}] = TagInfo{Tag: Tag{0x3008, 0x0134}, VR: "DS", Name: "DeliveredChannelTotalTime", VM: "1"}
+	tagDict[Tag{0x3008, 0x0136}] = TagInfo{Tag: Tag{0x3008, 0x0136}, VR: "IS", Name: "SpecifiedNumberOfPulses", VM: "1"}
+	tagDict[Tag{0x3008, 0x0138}] = TagInfo{Tag: Tag{0x3008, 0x0138}, VR: "IS", Name: "DeliveredNumberOfPulses", VM: "1"}
+	tagDict[Tag{0x3008, 0x013A}] = TagInfo{Tag: Tag{0x3008, 0x013A}, VR: "DS", Name: "SpecifiedPulseRepetitionInterval", VM: "1"}
+	tagDict[Tag{0x3008, 0x013C}] = TagInfo{Tag: Tag{0x3008, 0x013C}, VR: "DS", Name: "DeliveredPulseRepetitionInterval", VM: "1"}
+	tagDict[Tag{0x3008, 0x0140}] = TagInfo{Tag: Tag{0x3008, 0x0140}, VR: "SQ", Name: "RecordedSourceApplicatorSequence", VM: "1"}
+	tagDict[Tag{0x3008, 0x0142}] = TagInfo{Tag: Tag{0x3008, 0x0142}, VR: "IS", Name: "ReferencedSourceApplicatorNumber", VM: "1"}
+	tagDict[Tag{0x3008, 0x0150}] = TagInfo{Tag: Tag{0x3008, 0x0150}, VR: "SQ", Name: "RecordedChannelShieldSequence", VM: "1"}
+	tagDict[Tag{0x3008, 0x0152}] = TagInfo{Tag: Tag{0x3008, 0x0152}, VR: "IS", Name: "ReferencedChannelShieldNumber", VM: "1"}
+	tagDict[Tag{0x3008, 0x0160}] = TagInfo{Tag: Tag{0x3008, 0x0160}, VR: "SQ", Name: "BrachyControlPointDeliveredSequence", VM: "1"}
+	tagDict[Tag{0x3008, 0x0162}] = TagInfo{Tag: Tag{0x3008, 0x0162}, VR: "DA", Name: "SafePositionExitDate", VM: "1"}
+	tagDict[Tag{0x3008, 0x0164}] = TagInfo{Tag: Tag{0x3008, 0x0164}, VR: "TM", Name: "SafePositionExitTime", VM: "1"}
+	tagDict[Tag{0x3008, 0x0166}] = TagInfo{Tag: Tag{0x3008, 0x0166}, VR: "DA", Name: "SafePositionReturnDate", VM: "1"}
+	tagDict[Tag{0x3008, 0x0168}] = TagInfo{Tag: Tag{0x3008, 0x0168}, VR: "TM", Name: "SafePositionReturnTime", VM: "1"}
+	tagDict[Tag{0x3008, 0x0200}] = TagInfo{Tag: Tag{0x3008, 0x0200}, VR: "CS", Name: "CurrentTreatmentStatus", VM: "1"}
+	tagDict[Tag{0x3008, 0x0202}] = TagInfo{Tag: Tag{0x3008, 0x0202}, VR: "ST", Name: "TreatmentStatusComment", VM: "1"}
+	tagDict[Tag{0x3008, 0x0220}] = TagInfo{Tag: Tag{0x3008, 0x0220}, VR: "SQ", Name: "FractionGroupSummarySequence", VM: "1"}
+	tagDict[Tag{0x3008, 0x0223}] = TagInfo{Tag: Tag{0x3008, 0x0223}, VR: "IS", Name: "ReferencedFractionNumber", VM: "1"}
+	tagDict[Tag{0x3008, 0x0224}] = TagInfo{Tag: Tag{0x3008, 0x0224}, VR: "CS", Name: "FractionGroupType", VM: "1"}
+	tagDict[Tag{0x3008, 0x0230}] = TagInfo{Tag: Tag{0x3008, 0x0230}, VR: "CS", Name: "BeamStopperPosition", VM: "1"}
+	tagDict[Tag{0x3008, 0x0240}] = TagInfo{Tag: Tag{0x3008, 0x0240}, VR: "SQ", Name: "FractionStatusSummarySequence", VM: "1"}
+	tagDict[Tag{0x3008, 0x0250}] = TagInfo{Tag: Tag{0x3008, 0x0250}, VR: "DA", Name: "TreatmentDate", VM: "1"}
+	tagDict[Tag{0x3008, 0x0251}] = TagInfo{Tag: Tag{0x3008, 0x0251}, VR: "TM", Name: "TreatmentTime", VM: "1"}
+	tagDict[Tag{0x300A, 0x0002}] = TagInfo{Tag: Tag{0x300A, 0x0002}, VR: "SH", Name: "RTPlanLabel", VM: "1"}
+	tagDict[Tag{0x300A, 0x0003}] = TagInfo{Tag: Tag{0x300A, 0x0003}, VR: "LO", Name: "RTPlanName", VM: "1"}
+	tagDict[Tag{0x300A, 0x0004}] = TagInfo{Tag: Tag{0x300A, 0x0004}, VR: "ST", Name: "RTPlanDescription", VM: "1"}
+	tagDict[Tag{0x300A, 0x0006}] = TagInfo{Tag: Tag{0x300A, 0x0006}, VR: "DA", Name: "RTPlanDate", VM: "1"}
+	tagDict[Tag{0x300A, 0x0007}] = TagInfo{Tag: Tag{0x300A, 0x0007}, VR: "TM", Name: "RTPlanTime", VM: "1"}
+	tagDict[Tag{0x300A, 0x0009}] = TagInfo{Tag: Tag{0x300A, 0x0009}, VR: "LO", Name: "TreatmentProtocols", VM: "1-n"}
+	tagDict[Tag{0x300A, 0x000A}] = TagInfo{Tag: Tag{0x300A, 0x000A}, VR: "CS", Name: "PlanIntent", VM: "1"}
+	tagDict[Tag{0x300A, 0x000B}] = TagInfo{Tag: Tag{0x300A, 0x000B}, VR: "LO", Name: "TreatmentSites", VM: "1-n"}
+	tagDict[Tag{0x300A, 0x000C}] = TagInfo{Tag: Tag{0x300A, 0x000C}, VR: "CS", Name: "RTPlanGeometry", VM: "1"}
+	tagDict[Tag{0x300A, 0x000E}] = TagInfo{Tag: Tag{0x300A, 0x000E}, VR: "ST", Name: "PrescriptionDescription", VM: "1"}
+	tagDict[Tag{0x300A, 0x0010}] = TagInfo{Tag: Tag{0x300A, 0x0010}, VR: "SQ", Name: "DoseReferenceSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x0012}] = TagInfo{Tag: Tag{0x300A, 0x0012}, VR: "IS", Name: "DoseReferenceNumber", VM: "1"}
+	tagDict[Tag{0x300A, 0x0013}] = TagInfo{Tag: Tag{0x300A, 0x0013}, VR: "UI", Name: "DoseReferenceUID", VM: "1"}
+	tagDict[Tag{0x300A, 0x0014}] = TagInfo{Tag: Tag{0x300A, 0x0014}, VR: "CS", Name: "DoseReferenceStructureType", VM: "1"}
+	tagDict[Tag{0x300A, 0x0015}] = TagInfo{Tag: Tag{0x300A, 0x0015}, VR: "CS", Name: "NominalBeamEnergyUnit", VM: "1"}
+	tagDict[Tag{0x300A, 0x0016}] = TagInfo{Tag: Tag{0x300A, 0x0016}, VR: "LO", Name: "DoseReferenceDescription", VM: "1"}
+	tagDict[Tag{0x300A, 0x0018}] = TagInfo{Tag: Tag{0x300A, 0x0018}, VR: "DS", Name: "DoseReferencePointCoordinates", VM: "3"}
+	tagDict[Tag{0x300A, 0x001A}] = TagInfo{Tag: Tag{0x300A, 0x001A}, VR: "DS", Name: "NominalPriorDose", VM: "1"}
+	tagDict[Tag{0x300A, 0x0020}] = TagInfo{Tag: Tag{0x300A, 0x0020}, VR: "CS", Name: "DoseReferenceType", VM: "1"}
+	tagDict[Tag{0x300A, 0x0021}] = TagInfo{Tag: Tag{0x300A, 0x0021}, VR: "DS", Name: "ConstraintWeight", VM: "1"}
+	tagDict[Tag{0x300A, 0x0022}] = TagInfo{Tag: Tag{0x300A, 0x0022}, VR: "DS", Name: "DeliveryWarningDose", VM: "1"}
+	tagDict[Tag{0x300A, 0x0023}] = TagInfo{Tag: Tag{0x300A, 0x0023}, VR: "DS", Name: "DeliveryMaximumDose", VM: "1"}
+	tagDict[Tag{0x300A, 0x0025}] = TagInfo{Tag: Tag{0x300A, 0x0025}, VR: "DS", Name: "TargetMinimumDose", VM: "1"}
+	tagDict[Tag{0x300A, 0x0026}] = TagInfo{Tag: Tag{0x300A, 0x0026}, VR: "DS", Name: "TargetPrescriptionDose", VM: "1"}
+	tagDict[Tag{0x300A, 0x0027}] = TagInfo{Tag: Tag{0x300A, 0x0027}, VR: "DS", Name: "TargetMaximumDose", VM: "1"}
+	tagDict[Tag{0x300A, 0x0028}] = TagInfo{Tag: Tag{0x300A, 0x0028}, VR: "DS", Name: "TargetUnderdoseVolumeFraction", VM: "1"}
+	tagDict[Tag{0x300A, 0x002A}] = TagInfo{Tag: Tag{0x300A, 0x002A}, VR: "DS", Name: "OrganAtRiskFullVolumeDose", VM: "1"}
+	tagDict[Tag{0x300A, 0x002B}] = TagInfo{Tag: Tag{0x300A, 0x002B}, VR: "DS", Name: "OrganAtRiskLimitDose", VM: "1"}
+	tagDict[Tag{0x300A, 0x002C}] = TagInfo{Tag: Tag{0x300A, 0x002C}, VR: "DS", Name: "OrganAtRiskMaximumDose", VM: "1"}
+	tagDict[Tag{0x300A, 0x002D}] = TagInfo{Tag: Tag{0x300A, 0x002D}, VR: "DS", Name: "OrganAtRiskOverdoseVolumeFraction", VM: "1"}
+	tagDict[Tag{0x300A, 0x0040}] = TagInfo{Tag: Tag{0x300A, 0x0040}, VR: "SQ", Name: "ToleranceTableSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x0042}] = TagInfo{Tag: Tag{0x300A, 0x0042}, VR: "IS", Name: "ToleranceTableNumber", VM: "1"}
+	tagDict[Tag{0x300A, 0x0043}] = TagInfo{Tag: Tag{0x300A, 0x0043}, VR: "SH", Name: "ToleranceTableLabel", VM: "1"}
+	tagDict[Tag{0x300A, 0x0044}] = TagInfo{Tag: Tag{0x300A, 0x0044}, VR: "DS", Name: "GantryAngleTolerance", VM: "1"}
+	tagDict[Tag{0x300A, 0x0046}] = TagInfo{Tag: Tag{0x300A, 0x0046}, VR: "DS", Name: "BeamLimitingDeviceAngleTolerance", VM: "1"}
+	tagDict[Tag{0x300A, 0x0048}] = TagInfo{Tag: Tag{0x300A, 0x0048}, VR: "SQ", Name: "BeamLimitingDeviceToleranceSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x004A}] = TagInfo{Tag: Tag{0x300A, 0x004A}, VR: "DS", Name: "BeamLimitingDevicePositionTolerance", VM: "1"}
+	tagDict[Tag{0x300A, 0x004B}] = TagInfo{Tag: Tag{0x300A, 0x004B}, VR: "FL", Name: "SnoutPositionTolerance", VM: "1"}
+	tagDict[Tag{0x300A, 0x004C}] = TagInfo{Tag: Tag{0x300A, 0x004C}, VR: "DS", Name: "PatientSupportAngleTolerance", VM: "1"}
+	tagDict[Tag{0x300A, 0x004E}] = TagInfo{Tag: Tag{0x300A, 0x004E}, VR: "DS", Name: "TableTopEccentricAngleTolerance", VM: "1"}
+	tagDict[Tag{0x300A, 0x004F}] = TagInfo{Tag: Tag{0x300A, 0x004F}, VR: "FL", Name: "TableTopPitchAngleTolerance", VM: "1"}
+	tagDict[Tag{0x300A, 0x0050}] = TagInfo{Tag: Tag{0x300A, 0x0050}, VR: "FL", Name: "TableTopRollAngleTolerance", VM: "1"}
+	tagDict[Tag{0x300A, 0x0051}] = TagInfo{Tag: Tag{0x300A, 0x0051}, VR: "DS", Name: "TableTopVerticalPositionTolerance", VM: "1"}
+	tagDict[Tag{0x300A, 0x0052}] = TagInfo{Tag: Tag{0x300A, 0x0052}, VR: "DS", Name: "TableTopLongitudinalPositionTolerance", VM: "1"}
+	tagDict[Tag{0x300A, 0x0053}] = TagInfo{Tag: Tag{0x300A, 0x0053}, VR: "DS", Name: "TableTopLateralPositionTolerance", VM: "1"}
+	tagDict[Tag{0x300A, 0x0055}] = TagInfo{Tag: Tag{0x300A, 0x0055}, VR: "CS", Name: "RTPlanRelationship", VM: "1"}
+	tagDict[Tag{0x300A, 0x0070}] = TagInfo{Tag: Tag{0x300A, 0x0070}, VR: "SQ", Name: "FractionGroupSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x0071}] = TagInfo{Tag: Tag{0x300A, 0x0071}, VR: "IS", Name: "FractionGroupNumber", VM: "1"}
+	tagDict[Tag{0x300A, 0x0072}] = TagInfo{Tag: Tag{0x300A, 0x0072}, VR: "LO", Name: "FractionGroupDescription", VM: "1"}
+	tagDict[Tag{0x300A, 0x0078}] = TagInfo{Tag: Tag{0x300A, 0x0078}, VR: "IS", Name: "NumberOfFractionsPlanned", VM: "1"}
+	tagDict[Tag{0x300A, 0x0079}] = TagInfo{Tag: Tag{0x300A, 0x0079}, VR: "IS", Name: "NumberOfFractionPatternDigitsPerDay", VM: "1"}
+	tagDict[Tag{0x300A, 0x007A}] = TagInfo{Tag: Tag{0x300A, 0x007A}, VR: "IS", Name: "RepeatFractionCycleLength", VM: "1"}
+	tagDict[Tag{0x300A, 0x007B}] = TagInfo{Tag: Tag{0x300A, 0x007B}, VR: "LT", Name: "FractionPattern", VM: "1"}
+	tagDict[Tag{0x300A, 0x0080}] = TagInfo{Tag: Tag{0x300A, 0x0080}, VR: "IS", Name: "NumberOfBeams", VM: "1"}
+	tagDict[Tag{0x300A, 0x0082}] = TagInfo{Tag: Tag{0x300A, 0x0082}, VR: "DS", Name: "BeamDoseSpecificationPoint", VM: "3"}
+	tagDict[Tag{0x300A, 0x0084}] = TagInfo{Tag: Tag{0x300A, 0x0084}, VR: "DS", Name: "BeamDose", VM: "1"}
+	tagDict[Tag{0x300A, 0x0086}] = TagInfo{Tag: Tag{0x300A, 0x0086}, VR: "DS", Name: "BeamMeterset", VM: "1"}
+	tagDict[Tag{0x300A, 0x0088}] = TagInfo{Tag: Tag{0x300A, 0x0088}, VR: "FL", Name: "BeamDosePointDepth", VM: "1"}
+	tagDict[Tag{0x300A, 0x0089}] = TagInfo{Tag: Tag{0x300A, 0x0089}, VR: "FL", Name: "BeamDosePointEquivalentDepth", VM: "1"}
+	tagDict[Tag{0x300A, 0x008A}] = TagInfo{Tag: Tag{0x300A, 0x008A}, VR: "FL", Name: "BeamDosePointSSD", VM: "1"}
+	tagDict[Tag{0x300A, 0x00A0}] = TagInfo{Tag: Tag{0x300A, 0x00A0}, VR: "IS", Name: "NumberOfBrachyApplicationSetups", VM: "1"}
+	tagDict[Tag{0x300A, 0x00A2}] = TagInfo{Tag: Tag{0x300A, 0x00A2}, VR: "DS", Name: "BrachyApplicationSetupDoseSpecificationPoint", VM: "3"}
+	tagDict[Tag{0x300A, 0x00A4}] = TagInfo{Tag: Tag{0x300A, 0x00A4}, VR: "DS", Name: "BrachyApplicationSetupDose", VM: "1"}
+	tagDict[Tag{0x300A, 0x00B0}] = TagInfo{Tag: Tag{0x300A, 0x00B0}, VR: "SQ", Name: "BeamSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x00B2}] = TagInfo{Tag: Tag{0x300A, 0x00B2}, VR: "SH", Name: "TreatmentMachineName", VM: "1"}
+	tagDict[Tag{0x300A, 0x00B3}] = TagInfo{Tag: Tag{0x300A, 0x00B3}, VR: "CS", Name: "PrimaryDosimeterUnit", VM: "1"}
+	tagDict[Tag{0x300A, 0x00B4}] = TagInfo{Tag: Tag{0x300A, 0x00B4}, VR: "DS", Name: "SourceAxisDistance", VM: "1"}
+	tagDict[Tag{0x300A, 0x00B6}] = TagInfo{Tag: Tag{0x300A, 0x00B6}, VR: "SQ", Name: "BeamLimitingDeviceSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x00B8}] = TagInfo{Tag: Tag{0x300A, 0x00B8}, VR: "CS", Name: "RTBeamLimitingDeviceType", VM: "1"}
+	tagDict[Tag{0x300A, 0x00BA}] = TagInfo{Tag: Tag{0x300A, 0x00BA}, VR: "DS", Name: "SourceToBeamLimitingDeviceDistance", VM: "1"}
+	tagDict[Tag{0x300A, 0x00BB}] = TagInfo{Tag: Tag{0x300A, 0x00BB}, VR: "FL", Name: "IsocenterToBeamLimitingDeviceDistance", VM: "1"}
+	tagDict[Tag{0x300A, 0x00BC}] = TagInfo{Tag: Tag{0x300A, 0x00BC}, VR: "IS", Name: "NumberOfLeafJawPairs", VM: "1"}
+	tagDict[Tag{0x300A, 0x00BE}] = TagInfo{Tag: Tag{0x300A, 0x00BE}, VR: "DS", Name: "LeafPositionBoundaries", VM: "3-n"}
+	tagDict[Tag{0x300A, 0x00C0}] = TagInfo{Tag: Tag{0x300A, 0x00C0}, VR: "IS", Name: "BeamNumber", VM: "1"}
+	tagDict[Tag{0x300A, 0x00C2}] = TagInfo{Tag: Tag{0x300A, 0x00C2}, VR: "LO", Name: "BeamName", VM: "1"}
+	tagDict[Tag{0x300A, 0x00C3}] = TagInfo{Tag: Tag{0x300A, 0x00C3}, VR: "ST", Name: "BeamDescription", VM: "1"}
+	tagDict[Tag{0x300A, 0x00C4}] = TagInfo{Tag: Tag{0x300A, 0x00C4}, VR: "CS", Name: "BeamType", VM: "1"}
+	tagDict[Tag{0x300A, 0x00C6}] = TagInfo{Tag: Tag{0x300A, 0x00C6}, VR: "CS", Name: "RadiationType", VM: "1"}
+	tagDict[Tag{0x300A, 0x00C7}] = TagInfo{Tag: Tag{0x300A, 0x00C7}, VR: "CS", Name: "HighDoseTechniqueType", VM: "1"}
+	tagDict[Tag{0x300A, 0x00C8}] = TagInfo{Tag: Tag{0x300A, 0x00C8}, VR: "IS", Name: "ReferenceImageNumber", VM: "1"}
+	tagDict[Tag{0x300A, 0x00CA}] = TagInfo{Tag: Tag{0x300A, 0x00CA}, VR: "SQ", Name: "PlannedVerificationImageSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x00CC}] = TagInfo{Tag: Tag{0x300A, 0x00CC}, VR: "LO", Name: "ImagingDeviceSpecificAcquisitionParameters", VM: "1-n"}
+	tagDict[Tag{0x300A, 0x00CE}] = TagInfo{Tag: Tag{0x300A, 0x00CE}, VR: "CS", Name: "TreatmentDeliveryType", VM: "1"}
+	tagDict[Tag{0x300A, 0x00D0}] = TagInfo{Tag: Tag{0x300A, 0x00D0}, VR: "IS", Name: "NumberOfWedges", VM: "1"}
+	tagDict[Tag{0x300A, 0x00D1}] = TagInfo{Tag: Tag{0x300A, 0x00D1}, VR: "SQ", Name: "WedgeSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x00D2}] = TagInfo{Tag: Tag{0x300A, 0x00D2}, VR: "IS", Name: "WedgeNumber", VM: "1"}
+	tagDict[Tag{0x300A, 0x00D3}] = TagInfo{Tag: Tag{0x300A, 0x00D3}, VR: "CS", Name: "WedgeType", VM: "1"}
+	tagDict[Tag{0x300A, 0x00D4}] = TagInfo{Tag: Tag{0x300A, 0x00D4}, VR: "SH", Name: "WedgeID", VM: "1"}
+	tagDict[Tag{0x300A, 0x00D5}] = TagInfo{Tag: Tag{0x300A, 0x00D5}, VR: "IS", Name: "WedgeAngle", VM: "1"}
+	tagDict[Tag{0x300A, 0x00D6}] = TagInfo{Tag: Tag{0x300A, 0x00D6}, VR: "DS", Name: "WedgeFactor", VM: "1"}
+	tagDict[Tag{0x300A, 0x00D7}] = TagInfo{Tag: Tag{0x300A, 0x00D7}, VR: "FL", Name: "TotalWedgeTrayWaterEquivalentThickness", VM: "1"}
+	tagDict[Tag{0x300A, 0x00D8}] = TagInfo{Tag: Tag{0x300A, 0x00D8}, VR: "DS", Name: "WedgeOrientation", VM: "1"}
+	tagDict[Tag{0x300A, 0x00D9}] = TagInfo{Tag: Tag{0x300A, 0x00D9}, VR: "FL", Name: "IsocenterToWedgeTrayDistance", VM: "1"}
+	tagDict[Tag{0x300A, 0x00DA}] = TagInfo{Tag: Tag{0x300A, 0x00DA}, VR: "DS", Name: "SourceToWedgeTrayDistance", VM: "1"}
+	tagDict[Tag{0x300A, 0x00DB}] = TagInfo{Tag: Tag{0x300A, 0x00DB}, VR: "FL", Name: "WedgeThinEdgePosition", VM: "1"}
+	tagDict[Tag{0x300A, 0x00DC}] = TagInfo{Tag: Tag{0x300A, 0x00DC}, VR: "SH", Name: "BolusID", VM: "1"}
+	tagDict[Tag{0x300A, 0x00DD}] = TagInfo{Tag: Tag{0x300A, 0x00DD}, VR: "ST", Name: "BolusDescription", VM: "1"}
+	tagDict[Tag{0x300A, 0x00E0}] = TagInfo{Tag: Tag{0x300A, 0x00E0}, VR: "IS", Name: "NumberOfCompensators", VM: "1"}
+	tagDict[Tag{0x300A, 0x00E1}] = TagInfo{Tag: Tag{0x300A, 0x00E1}, VR: "SH", Name: "MaterialID", VM: "1"}
+	tagDict[Tag{0x300A, 0x00E2}] = TagInfo{Tag: Tag{0x300A, 0x00E2}, VR: "DS", Name: "TotalCompensatorTrayFactor", VM: "1"}
+	tagDict[Tag{0x300A, 0x00E3}] = TagInfo{Tag: Tag{0x300A, 0x00E3}, VR: "SQ", Name: "CompensatorSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x00E4}] = TagInfo{Tag: Tag{0x300A, 0x00E4}, VR: "IS", Name: "CompensatorNumber", VM: "1"}
+	tagDict[Tag{0x300A, 0x00E5}] = TagInfo{Tag: Tag{0x300A, 0x00E5}, VR: "SH", Name: "CompensatorID", VM: "1"}
+	tagDict[Tag{0x300A, 0x00E6}] = TagInfo{Tag: Tag{0x300A, 0x00E6}, VR: "DS", Name: "SourceToCompensatorTrayDistance", VM: "1"}
+	tagDict[Tag{0x300A, 0x00E7}] = TagInfo{Tag: Tag{0x300A, 0x00E7}, VR: "IS", Name: "CompensatorRows", VM: "1"}
+	tagDict[Tag{0x300A, 0x00E8}] = TagInfo{Tag: Tag{0x300A, 0x00E8}, VR: "IS", Name: "CompensatorColumns", VM: "1"}
+	tagDict[Tag{0x300A, 0x00E9}] = TagInfo{Tag: Tag{0x300A, 0x00E9}, VR: "DS", Name: "CompensatorPixelSpacing", VM: "2"}
+	tagDict[Tag{0x300A, 0x00EA}] = TagInfo{Tag: Tag{0x300A, 0x00EA}, VR: "DS", Name: "CompensatorPosition", VM: "2"}
+	tagDict[Tag{0x300A, 0x00EB}] = TagInfo{Tag: Tag{0x300A, 0x00EB}, VR: "DS", Name: "CompensatorTransmissionData", VM: "1-n"}
+	tagDict[Tag{0x300A, 0x00EC}] = TagInfo{Tag: Tag{0x300A, 0x00EC}, VR: "DS", Name: "CompensatorThicknessData", VM: "1-n"}
+	tagDict[Tag{0x300A, 0x00ED}] = TagInfo{Tag: Tag{0x300A, 0x00ED}, VR: "IS", Name: "NumberOfBoli", VM: "1"}
+	tagDict[Tag{0x300A, 0x00EE}] = TagInfo{Tag: Tag{0x300A, 0x00EE}, VR: "CS", Name: "CompensatorType", VM: "1"}
+	tagDict[Tag{0x300A, 0x00F0}] = TagInfo{Tag: Tag{0x300A, 0x00F0}, VR: "IS", Name: "NumberOfBlocks", VM: "1"}
+	tagDict[Tag{0x300A, 0x00F2}] = TagInfo{Tag: Tag{0x300A, 0x00F2}, VR: "DS", Name: "TotalBlockTrayFactor", VM: "1"}
+	tagDict[Tag{0x300A, 0x00F3}] = TagInfo{Tag: Tag{0x300A, 0x00F3}, VR: "FL", Name: "TotalBlockTrayWaterEquivalentThickness", VM: "1"}
+	tagDict[Tag{0x300A, 0x00F4}] = TagInfo{Tag: Tag{0x300A, 0x00F4}, VR: "SQ", Name: "BlockSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x00F5}] = TagInfo{Tag: Tag{0x300A, 0x00F5}, VR: "SH", Name: "BlockTrayID", VM: "1"}
+	tagDict[Tag{0x300A, 0x00F6}] = TagInfo{Tag: Tag{0x300A, 0x00F6}, VR: "DS", Name: "SourceToBlockTrayDistance", VM: "1"}
+	tagDict[Tag{0x300A, 0x00F7}] = TagInfo{Tag: Tag{0x300A, 0x00F7}, VR: "FL", Name: "IsocenterToBlockTrayDistance", VM: "1"}
+	tagDict[Tag{0x300A, 0x00F8}] = TagInfo{Tag: Tag{0x300A, 0x00F8}, VR: "CS", Name: "BlockType", VM: "1"}
+	tagDict[Tag{0x300A, 0x00F9}] = TagInfo{Tag: Tag{0x300A, 0x00F9}, VR: "LO", Name: "AccessoryCode", VM: "1"}
+	tagDict[Tag{0x300A, 0x00FA}] = TagInfo{Tag: Tag{0x300A, 0x00FA}, VR: "CS", Name: "BlockDivergence", VM: "1"}
+	tagDict[Tag{0x300A, 0x00FB}] = TagInfo{Tag: Tag{0x300A, 0x00FB}, VR: "CS", Name: "BlockMountingPosition", VM: "1"}
+	tagDict[Tag{0x300A, 0x00FC}] = TagInfo{Tag: Tag{0x300A, 0x00FC}, VR: "IS", Name: "BlockNumber", VM: "1"}
+	tagDict[Tag{0x300A, 0x00FE}] = TagInfo{Tag: Tag{0x300A, 0x00FE}, VR: "LO", Name: "BlockName", VM: "1"}
+	tagDict[Tag{0x300A, 0x0100}] = TagInfo{Tag: Tag{0x300A, 0x0100}, VR: "DS", Name: "BlockThickness", VM: "1"}
+	tagDict[Tag{0x300A, 0x0102}] = TagInfo{Tag: Tag{0x300A, 0x0102}, VR: "DS", Name: "BlockTransmission", VM: "1"}
+	tagDict[Tag{0x300A, 0x0104}] = TagInfo{Tag: Tag{0x300A, 0x0104}, VR: "IS", Name: "BlockNumberOfPoints", VM: "1"}
+	tagDict[Tag{0x300A, 0x0106}] = TagInfo{Tag: Tag{0x300A, 0x0106}, VR: "DS", Name: "BlockData", VM: "2-2n"}
+	tagDict[Tag{0x300A, 0x0107}] = TagInfo{Tag: Tag{0x300A, 0x0107}, VR: "SQ", Name: "ApplicatorSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x0108}] = TagInfo{Tag: Tag{0x300A, 0x0108}, VR: "SH", Name: "ApplicatorID", VM: "1"}
+	tagDict[Tag{0x300A, 0x0109}] = TagInfo{Tag: Tag{0x300A, 0x0109}, VR: "CS", Name: "ApplicatorType", VM: "1"}
+	tagDict[Tag{0x300A, 0x010A}] = TagInfo{Tag: Tag{0x300A, 0x010A}, VR: "LO", Name: "ApplicatorDescription", VM: "1"}
+	tagDict[Tag{0x300A, 0x010C}] = TagInfo{Tag: Tag{0x300A, 0x010C}, VR: "DS", Name: "CumulativeDoseReferenceCoefficient", VM: "1"}
+	tagDict[Tag{0x300A, 0x010E}] = TagInfo{Tag: Tag{0x300A, 0x010E}, VR: "DS", Name: "FinalCumulativeMetersetWeight", VM: "1"}
+	tagDict[Tag{0x300A, 0x0110}] = TagInfo{Tag: Tag{0x300A, 0x0110}, VR: "IS", Name: "NumberOfControlPoints", VM: "1"}
+	tagDict[Tag{0x300A, 0x0111}] = TagInfo{Tag: Tag{0x300A, 0x0111}, VR: "SQ", Name: "ControlPointSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x0112}] = TagInfo{Tag: Tag{0x300A, 0x0112}, VR: "IS", Name: "ControlPointIndex", VM: "1"}
+	tagDict[Tag{0x300A, 0x0114}] = TagInfo{Tag: Tag{0x300A, 0x0114}, VR: "DS", Name: "NominalBeamEnergy", VM: "1"}
+	tagDict[Tag{0x300A, 0x0115}] = TagInfo{Tag: Tag{0x300A, 0x0115}, VR: "DS", Name: "DoseRateSet", VM: "1"}
+	tagDict[Tag{0x300A, 0x0116}] = TagInfo{Tag: Tag{0x300A, 0x0116}, VR: "SQ", Name: "WedgePositionSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x0118}] = TagInfo{Tag: Tag{0x300A, 0x0118}, VR: "CS", Name: "WedgePosition", VM: "1"}
+	tagDict[Tag{0x300A, 0x011A}] = TagInfo{Tag: Tag{0x300A, 0x011A}, VR: "SQ", Name: "BeamLimitingDevicePositionSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x011C}] = TagInfo{Tag: Tag{0x300A, 0x011C}, VR: "DS", Name: "LeafJawPositions", VM: "2-2n"}
+	tagDict[Tag{0x300A, 0x011E}] = TagInfo{Tag: Tag{0x300A, 0x011E}, VR: "DS", Name: "GantryAngle", VM: "1"}
+	tagDict[Tag{0x300A, 0x011F}] = TagInfo{Tag: Tag{0x300A, 0x011F}, VR: "CS", Name: "GantryRotationDirection", VM: "1"}
+	tagDict[Tag{0x300A, 0x0120}] = TagInfo{Tag: Tag{0x300A, 0x0120}, VR: "DS", Name: "BeamLimitingDeviceAngle", VM: "1"}
+	tagDict[Tag{0x300A, 0x0121}] = TagInfo{Tag: Tag{0x300A, 0x0121}, VR: "CS", Name: "BeamLimitingDeviceRotationDirection", VM: "1"}
+	tagDict[Tag{0x300A, 0x0122}] = TagInfo{Tag: Tag{0x300A, 0x0122}, VR: "DS", Name: "PatientSupportAngle", VM: "1"}
+	tagDict[Tag{0x300A, 0x0123}] = TagInfo{Tag: Tag{0x300A, 0x0123}, VR: "CS", Name: "PatientSupportRotationDirection", VM: "1"}
+	tagDict[Tag{0x300A, 0x0124}] = TagInfo{Tag: Tag{0x300A, 0x0124}, VR: "DS", Name: "TableTopEccentricAxisDistance", VM: "1"}
+	tagDict[Tag{0x300A, 0x0125}] = TagInfo{Tag: Tag{0x300A, 0x0125}, VR: "DS", Name: "TableTopEccentricAngle", VM: "1"}
+	tagDict[Tag{0x300A, 0x0126}] = TagInfo{Tag: Tag{0x300A, 0x0126}, VR: "CS", Name: "TableTopEccentricRotationDirection", VM: "1"}
+	tagDict[Tag{0x300A, 0x0128}] = TagInfo{Tag: Tag{0x300A, 0x0128}, VR: "DS", Name: "TableTopVerticalPosition", VM: "1"}
+	tagDict[Tag{0x300A, 0x0129}] = TagInfo{Tag: Tag{0x300A, 0x0129}, VR: "DS", Name: "TableTopLongitudinalPosition", VM: "1"}
+	tagDict[Tag{0x300A, 0x012A}] = TagInfo{Tag: Tag{0x300A, 0x012A}, VR: "DS", Name: "TableTopLateralPosition", VM: "1"}
+	tagDict[Tag{0x300A, 0x012C}] = TagInfo{Tag: Tag{0x300A, 0x012C}, VR: "DS", Name: "IsocenterPosition", VM: "3"}
+	tagDict[Tag{0x300A, 0x012E}] = TagInfo{Tag: Tag{0x300A, 0x012E}, VR: "DS", Name: "SurfaceEntryPoint", VM: "3"}
+	tagDict[Tag{0x300A, 0x0130}] = TagInfo{Tag: Tag{0x300A, 0x0130}, VR: "DS", Name: "SourceToSurfaceDistance", VM: "1"}
+	tagDict[Tag{0x300A, 0x0134}] = TagInfo{Tag: Tag{0x300A, 0x0134}, VR: "DS", Name: "CumulativeMetersetWeight", VM: "1"}
+	tagDict[Tag{0x300A, 0x0140}] = TagInfo{Tag: Tag{0x300A, 0x0140}, VR: "FL", Name: "TableTopPitchAngle", VM: "1"}
+	tagDict[Tag{0x300A, 0x0142}] = TagInfo{Tag: Tag{0x300A, 0x0142}, VR: "CS", Name: "TableTopPitchRotationDirection", VM: "1"}
+	tagDict[Tag{0x300A, 0x0144}] = TagInfo{Tag: Tag{0x300A, 0x0144}, VR: "FL", Name: "TableTopRollAngle", VM: "1"}
+	tagDict[Tag{0x300A, 0x0146}] = TagInfo{Tag: Tag{0x300A, 0x0146}, VR: "CS", Name: "TableTopRollRotationDirection", VM: "1"}
+	tagDict[Tag{0x300A, 0x0148}] = TagInfo{Tag: Tag{0x300A, 0x0148}, VR: "FL", Name: "HeadFixationAngle", VM: "1"}
+	tagDict[Tag{0x300A, 0x014A}] = TagInfo{Tag: Tag{0x300A, 0x014A}, VR: "FL", Name: "GantryPitchAngle", VM: "1"}
+	tagDict[Tag{0x300A, 0x014C}] = TagInfo{Tag: Tag{0x300A, 0x014C}, VR: "CS", Name: "GantryPitchRotationDirection", VM: "1"}
+	tagDict[Tag{0x300A, 0x014E}] = TagInfo{Tag: Tag{0x300A, 0x014E}, VR: "FL", Name: "GantryPitchAngleTolerance", VM: "1"}
+	tagDict[Tag{0x300A, 0x0180}] = TagInfo{Tag: Tag{0x300A, 0x0180}, VR: "SQ", Name: "PatientSetupSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x0182}] = TagInfo{Tag: Tag{0x300A, 0x0182}, VR: "IS", Name: "PatientSetupNumber", VM: "1"}
+	tagDict[Tag{0x300A, 0x0183}] = TagInfo{Tag: Tag{0x300A, 0x0183}, VR: "LO", Name: "PatientSetupLabel", VM: "1"}
+	tagDict[Tag{0x300A, 0x0184}] = TagInfo{Tag: Tag{0x300A, 0x0184}, VR: "LO", Name: "PatientAdditionalPosition", VM: "1"}
+	tagDict[Tag{0x300A, 0x0190}] = TagInfo{Tag: Tag{0x300A, 0x0190}, VR: "SQ", Name: "FixationDeviceSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x0192}] = TagInfo{Tag: Tag{0x300A, 0x0192}, VR: "CS", Name: "FixationDeviceType", VM: "1"}
+	tagDict[Tag{0x300A, 0x0194}] = TagInfo{Tag: Tag{0x300A, 0x0194}, VR: "SH", Name: "FixationDeviceLabel", VM: "1"}
+	tagDict[Tag{0x300A, 0x0196}] = TagInfo{Tag: Tag{0x300A, 0x0196}, VR: "ST", Name: "FixationDeviceDescription", VM: "1"}
+	tagDict[Tag{0x300A, 0x0198}] = TagInfo{Tag: Tag{0x300A, 0x0198}, VR: "SH", Name: "FixationDevicePosition", VM: "1"}
+	tagDict[Tag{0x300A, 0x0199}] = TagInfo{Tag: Tag{0x300A, 0x0199}, VR: "FL", Name: "FixationDevicePitchAngle", VM: "1"}
+	tagDict[Tag{0x300A, 0x019A}] = TagInfo{Tag: Tag{0x300A, 0x019A}, VR: "FL", Name: "FixationDeviceRollAngle", VM: "1"}
+	tagDict[Tag{0x300A, 0x01A0}] = TagInfo{Tag: Tag{0x300A, 0x01A0}, VR: "SQ", Name: "ShieldingDeviceSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x01A2}] = TagInfo{Tag: Tag{0x300A, 0x01A2}, VR: "CS", Name: "ShieldingDeviceType", VM: "1"}
+	tagDict[Tag{0x300A, 0x01A4}] = TagInfo{Tag: Tag{0x300A, 0x01A4}, VR: "SH", Name: "ShieldingDeviceLabel", VM: "1"}
+	tagDict[Tag{0x300A, 0x01A6}] = TagInfo{Tag: Tag{0x300A, 0x01A6}, VR: "ST", Name: "ShieldingDeviceDescription", VM: "1"}
+	tagDict[Tag{0x300A, 0x01A8}] = TagInfo{Tag: Tag{0x300A, 0x01A8}, VR: "SH", Name: "ShieldingDevicePosition", VM: "1"}
+	tagDict[Tag{0x300A, 0x01B0}] = TagInfo{Tag: Tag{0x300A, 0x01B0}, VR: "CS", Name: "SetupTechnique", VM: "1"}
+	tagDict[Tag{0x300A, 0x01B2}] = TagInfo{Tag: Tag{0x300A, 0x01B2}, VR: "ST", Name: "SetupTechniqueDescription", VM: "1"}
+	tagDict[Tag{0x300A, 0x01B4}] = TagInfo{Tag: Tag{0x300A, 0x01B4}, VR: "SQ", Name: "SetupDeviceSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x01B6}] = TagInfo{Tag: Tag{0x300A, 0x01B6}, VR: "CS", Name: "SetupDeviceType", VM: "1"}
+	tagDict[Tag{0x300A, 0x01B8}] = TagInfo{Tag: Tag{0x300A, 0x01B8}, VR: "SH", Name: "SetupDeviceLabel", VM: "1"}
+	tagDict[Tag{0x300A, 0x01BA}] = TagInfo{Tag: Tag{0x300A, 0x01BA}, VR: "ST", Name: "SetupDeviceDescription", VM: "1"}
+	tagDict[Tag{0x300A, 0x01BC}] = TagInfo{Tag: Tag{0x300A, 0x01BC}, VR: "DS", Name: "SetupDeviceParameter", VM: "1"}
+	tagDict[Tag{0x300A, 0x01D0}] = TagInfo{Tag: Tag{0x300A, 0x01D0}, VR: "ST", Name: "SetupReferenceDescription", VM: "1"}
+	tagDict[Tag{0x300A, 0x01D2}] = TagInfo{Tag: Tag{0x300A, 0x01D2}, VR: "DS", Name: "TableTopVerticalSetupDisplacement", VM: "1"}
+	tagDict[Tag{0x300A, 0x01D4}] = TagInfo{Tag: Tag{0x300A, 0x01D4}, VR: "DS", Name: "TableTopLongitudinalSetupDisplacement", VM: "1"}
+	tagDict[Tag{0x300A, 0x01D6}] = TagInfo{Tag: Tag{0x300A, 0x01D6}, VR: "DS", Name: "TableTopLateralSetupDisplacement", VM: "1"}
+	tagDict[Tag{0x300A, 0x0200}] = TagInfo{Tag: Tag{0x300A, 0x0200}, VR: "CS", Name: "BrachyTreatmentTechnique", VM: "1"}
+	tagDict[Tag{0x300A, 0x0202}] = TagInfo{Tag: Tag{0x300A, 0x0202}, VR: "CS", Name: "BrachyTreatmentType", VM: "1"}
+	tagDict[Tag{0x300A, 0x0206}] = TagInfo{Tag: Tag{0x300A, 0x0206}, VR: "SQ", Name: "TreatmentMachineSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x0210}] = TagInfo{Tag: Tag{0x300A, 0x0210}, VR: "SQ", Name: "SourceSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x0212}] = TagInfo{Tag: Tag{0x300A, 0x0212}, VR: "IS", Name: "SourceNumber", VM: "1"}
+	tagDict[Tag{0x300A, 0x0214}] = TagInfo{Tag: Tag{0x300A, 0x0214}, VR: "CS", Name: "SourceType", VM: "1"}
+	tagDict[Tag{0x300A, 0x0216}] = TagInfo{Tag: Tag{0x300A, 0x0216}, VR: "LO", Name: "SourceManufacturer", VM: "1"}
+	tagDict[Tag{0x300A, 0x0218}] = TagInfo{Tag: Tag{0x300A, 0x0218}, VR: "DS", Name: "ActiveSourceDiameter", VM: "1"}
+	tagDict[Tag{0x300A, 0x021A}] = TagInfo{Tag: Tag{0x300A, 0x021A}, VR: "DS", Name: "ActiveSourceLength", VM: "1"}
+	tagDict[Tag{0x300A, 0x0220}] = TagInfo{Tag: Tag{0x300A, 0x0220}, VR: "DS", Name: "ActiveSourceLength2", VM: "1"}
+	tagDict[Tag{0x300A, 0x0222}] = TagInfo{Tag: Tag{0x300A, 0x0222}, VR: "DS", Name: "SourceEncapsulationNominalThickness", VM: "1"}
+	tagDict[Tag{0x300A, 0x0224}] = TagInfo{Tag: Tag{0x300A, 0x0224}, VR: "DS", Name: "SourceEncapsulationNominalTransmission", VM: "1"}
+	tagDict[Tag{0x300A, 0x0226}] = TagInfo{Tag: Tag{0x300A, 0x0226}, VR: "LO", Name: "SourceIsotopeName", VM: "1"}
+	tagDict[Tag{0x300A, 0x0228}] = TagInfo{Tag: Tag{0x300A, 0x0228}, VR: "DS", Name: "SourceIsotopeHalfLife", VM: "1"}
+	tagDict[Tag{0x300A, 0x0229}] = TagInfo{Tag: Tag{0x300A, 0x0229}, VR: "CS", Name: "SourceStrengthUnits", VM: "1"}
+	tagDict[Tag{0x300A, 0x022A}] = TagInfo{Tag: Tag{0x300A, 0x022A}, VR: "DS", Name: "ReferenceAirKermaRate", VM: "1"}
+	tagDict[Tag{0x300A, 0x022B}] = TagInfo{Tag: Tag{0x300A, 0x022B}, VR: "DS", Name: "SourceStrength", VM: "1"}
+	tagDict[Tag{0x300A, 0x022C}] = TagInfo{Tag: Tag{0x300A, 0x022C}, VR: "DA", Name: "SourceStrengthReferenceDate", VM: "1"}
+	tagDict[Tag{0x300A, 0x022E}] = TagInfo{Tag: Tag{0x300A, 0x022E}, VR: "TM", Name: "SourceStrengthReferenceTime", VM: "1"}
+	tagDict[Tag{0x300A, 0x0230}] = TagInfo{Tag: Tag{0x300A, 0x0230}, VR: "SQ", Name: "ApplicationSetupSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x0232}] = TagInfo{Tag: Tag{0x300A, 0x0232}, VR: "CS", Name: "ApplicationSetupType", VM: "1"}
+	tagDict[Tag{0x300A, 0x0234}] = TagInfo{Tag: Tag{0x300A, 0x0234}, VR: "IS", Name: "ApplicationSetupNumber", VM: "1"}
+	tagDict[Tag{0x300A, 0x0236}] = TagInfo{Tag: Tag{0x300A, 0x0236}, VR: "LO", Name: "ApplicationSetupName", VM: "1"}
+	tagDict[Tag{0x300A, 0x0238}] = TagInfo{Tag: Tag{0x300A, 0x0238}, VR: "LO", Name: "ApplicationSetupManufacturer", VM: "1"}
+	tagDict[Tag{0x300A, 0x0240}] = TagInfo{Tag: Tag{0x300A, 0x0240}, VR: "IS", Name: "TemplateNumber", VM: "1"}
+	tagDict[Tag{0x300A, 0x0242}] = TagInfo{Tag: Tag{0x300A, 0x0242}, VR: "SH", Name: "TemplateType", VM: "1"}
+	tagDict[Tag{0x300A, 0x0244}] = TagInfo{Tag: Tag{0x300A, 0x0244}, VR: "LO", Name: "TemplateName", VM: "1"}
+	tagDict[Tag{0x300A, 0x0250}] = TagInfo{Tag: Tag{0x300A, 0x0250}, VR: "DS", Name: "TotalReferenceAirKerma", VM: "1"}
+	tagDict[Tag{0x300A, 0x0260}] = TagInfo{Tag: Tag{0x300A, 0x0260}, VR: "SQ", Name: "BrachyAccessoryDeviceSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x0262}] = TagInfo{Tag: Tag{0x300A, 0x0262}, VR: "IS", Name: "BrachyAccessoryDeviceNumber", VM: "1"}
+	tagDict[Tag{0x300A, 0x0263}] = TagInfo{Tag: Tag{0x300A, 0x0263}, VR: "SH", Name: "BrachyAccessoryDeviceID", VM: "1"}
+	tagDict[Tag{0x300A, 0x0264}] = TagInfo{Tag: Tag{0x300A, 0x0264}, VR: "CS", Name: "BrachyAccessoryDeviceType", VM: "1"}
+	tagDict[Tag{0x300A, 0x0266}] = TagInfo{Tag: Tag{0x300A, 0x0266}, VR: "LO", Name: "BrachyAccessoryDeviceName", VM: "1"}
+	tagDict[Tag{0x300A, 0x026A}] = TagInfo{Tag: Tag{0x300A, 0x026A}, VR: "DS", Name: "BrachyAccessoryDeviceNominalThickness", VM: "1"}
+	tagDict[Tag{0x300A, 0x026C}] = TagInfo{Tag: Tag{0x300A, 0x026C}, VR: "DS", Name: "BrachyAccessoryDeviceNominalTransmission", VM: "1"}
+	tagDict[Tag{0x300A, 0x0280}] = TagInfo{Tag: Tag{0x300A, 0x0280}, VR: "SQ", Name: "ChannelSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x0282}] = TagInfo{Tag: Tag{0x300A, 0x0282}, VR: "IS", Name: "ChannelNumber", VM: "1"}
+	tagDict[Tag{0x300A, 0x0284}] = TagInfo{Tag: Tag{0x300A, 0x0284}, VR: "DS", Name: "ChannelLength", VM: "1"}
+	tagDict[Tag{0x300A, 0x0286}] = TagInfo{Tag: Tag{0x300A, 0x0286}, VR: "DS", Name: "ChannelTotalTime", VM: "1"}
+	tagDict[Tag{0x300A, 0x0288}] = TagInfo{Tag: Tag{0x300A, 0x0288}, VR: "CS", Name: "SourceMovementType", VM: "1"}
+	tagDict[Tag{0x300A, 0x028A}] = TagInfo{Tag: Tag{0x300A, 0x028A}, VR: "IS", Name: "NumberOfPulses", VM: "1"}
+	tagDict[Tag{0x300A, 0x028C}] = TagInfo{Tag: Tag{0x300A, 0x028C}, VR: "DS", Name: "PulseRepetitionInterval", VM: "1"}
+	tagDict[Tag{0x300A, 0x0290}] = TagInfo{Tag: Tag{0x300A, 0x0290}, VR: "IS", Name: "SourceApplicatorNumber", VM: "1"}
+	tagDict[Tag{0x300A, 0x0291}] = TagInfo{Tag: Tag{0x300A, 0x0291}, VR: "SH", Name: "SourceApplicatorID", VM: "1"}
+	tagDict[Tag{0x300A, 0x0292}] = TagInfo{Tag: Tag{0x300A, 0x0292}, VR: "CS", Name: "SourceApplicatorType", VM: "1"}
+	tagDict[Tag{0x300A, 0x0294}] = TagInfo{Tag: Tag{0x300A, 0x0294}, VR: "LO", Name: "SourceApplicatorName", VM: "1"}
+	tagDict[Tag{0x300A, 0x0296}] = TagInfo{Tag: Tag{0x300A, 0x0296}, VR: "DS", Name: "SourceApplicatorLength", VM: "1"}
+	tagDict[Tag{0x300A, 0x0298}] = TagInfo{Tag: Tag{0x300A, 0x0298}, VR: "LO", Name: "SourceApplicatorManufacturer", VM: "1"}
+	tagDict[Tag{0x300A, 0x029C}] = TagInfo{Tag: Tag{0x300A, 0x029C}, VR: "DS", Name: "SourceApplicatorWallNominalThickness", VM: "1"}
+	tagDict[Tag{0x300A, 0x029E}] = TagInfo{Tag: Tag{0x300A, 0x029E}, VR: "DS", Name: "SourceApplicatorWallNominalTransmission", VM: "1"}
+	tagDict[Tag{0x300A, 0x02A0}] = TagInfo{Tag: Tag{0x300A, 0x02A0}, VR: "DS", Name: "SourceApplicatorStepSize", VM: "1"}
+	tagDict[Tag{0x300A, 0x02A2}] = TagInfo{Tag: Tag{0x300A, 0x02A2}, VR: "IS", Name: "TransferTubeNumber", VM: "1"}
+	tagDict[Tag{0x300A, 0x02A4}] = TagInfo{Tag: Tag{0x300A, 0x02A4}, VR: "DS", Name: "TransferTubeLength", VM: "1"}
+	tagDict[Tag{0x300A, 0x02B0}] = TagInfo{Tag: Tag{0x300A, 0x02B0}, VR: "SQ", Name: "ChannelShieldSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x02B2}] = TagInfo{Tag: Tag{0x300A, 0x02B2}, VR: "IS", Name: "ChannelShieldNumber", VM: "1"}
+	tagDict[Tag{0x300A, 0x02B3}] = TagInfo{Tag: Tag{0x300A, 0x02B3}, VR: "SH", Name: "ChannelShieldID", VM: "1"}
+	tagDict[Tag{0x300A, 0x02B4}] = TagInfo{Tag: Tag{0x300A, 0x02B4}, VR: "LO", Name: "ChannelShieldName", VM: "1"}
+	tagDict[Tag{0x300A, 0x02B8}] = TagInfo{Tag: Tag{0x300A, 0x02B8}, VR: "DS", Name: "ChannelShieldNominalThickness", VM: "1"}
+	tagDict[Tag{0x300A, 0x02BA}] = TagInfo{Tag: Tag{0x300A, 0x02BA}, VR: "DS", Name: "ChannelShieldNominalTransmission", VM: "1"}
+	tagDict[Tag{0x300A, 0x02C8}] = TagInfo{Tag: Tag{0x300A, 0x02C8}, VR: "DS", Name: "FinalCumulativeTimeWeight", VM: "1"}
+	tagDict[Tag{0x300A, 0x02D0}] = TagInfo{Tag: Tag{0x300A, 0x02D0}, VR: "SQ", Name: "BrachyControlPointSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x02D2}] = TagInfo{Tag: Tag{0x300A, 0x02D2}, VR: "DS", Name: "ControlPointRelativePosition", VM: "1"}
+	tagDict[Tag{0x300A, 0x02D4}] = TagInfo{Tag: Tag{0x300A, 0x02D4}, VR: "DS", Name: "ControlPoint3DPosition", VM: "3"}
+	tagDict[Tag{0x300A, 0x02D6}] = TagInfo{Tag: Tag{0x300A, 0x02D6}, VR: "DS", Name: "CumulativeTimeWeight", VM: "1"}
+	tagDict[Tag{0x300A, 0x02E0}] = TagInfo{Tag: Tag{0x300A, 0x02E0}, VR: "CS", Name: "CompensatorDivergence", VM: "1"}
+	tagDict[Tag{0x300A, 0x02E1}] = TagInfo{Tag: Tag{0x300A, 0x02E1}, VR: "CS", Name: "CompensatorMountingPosition", VM: "1"}
+	tagDict[Tag{0x300A, 0x02E2}] = TagInfo{Tag: Tag{0x300A, 0x02E2}, VR: "DS", Name: "SourceToCompensatorDistance", VM: "1-n"}
+	tagDict[Tag{0x300A, 0x02E3}] = TagInfo{Tag: Tag{0x300A, 0x02E3}, VR: "FL", Name: "TotalCompensatorTrayWaterEquivalentThickness", VM: "1"}
+	tagDict[Tag{0x300A, 0x02E4}] = TagInfo{Tag: Tag{0x300A, 0x02E4}, VR: "FL", Name: "IsocenterToCompensatorTrayDistance", VM: "1"}
+	tagDict[Tag{0x300A, 0x02E5}] = TagInfo{Tag: Tag{0x300A, 0x02E5}, VR: "FL", Name: "CompensatorColumnOffset", VM: "1"}
+	tagDict[Tag{0x300A, 0x02E6}] = TagInfo{Tag: Tag{0x300A, 0x02E6}, VR: "FL", Name: "IsocenterToCompensatorDistances", VM: "1-n"}
+	tagDict[Tag{0x300A, 0x02E7}] = TagInfo{Tag: Tag{0x300A, 0x02E7}, VR: "FL", Name: "CompensatorRelativeStoppingPowerRatio", VM: "1"}
+	tagDict[Tag{0x300A, 0x02E8}] = TagInfo{Tag: Tag{0x300A, 0x02E8}, VR: "FL", Name: "CompensatorMillingToolDiameter", VM: "1"}
+	tagDict[Tag{0x300A, 0x02EA}] = TagInfo{Tag: Tag{0x300A, 0x02EA}, VR: "SQ", Name: "IonRangeCompensatorSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x02EB}] = TagInfo{Tag: Tag{0x300A, 0x02EB}, VR: "LT", Name: "CompensatorDescription", VM: "1"}
+	tagDict[Tag{0x300A, 0x0302}] = TagInfo{Tag: Tag{0x300A, 0x0302}, VR: "IS", Name: "RadiationMassNumber", VM: "1"}
+	tagDict[Tag{0x300A, 0x0304}] = TagInfo{Tag: Tag{0x300A, 0x0304}, VR: "IS", Name: "RadiationAtomicNumber", VM: "1"}
+	tagDict[Tag{0x300A, 0x0306}] = TagInfo{Tag: Tag{0x300A, 0x0306}, VR: "SS", Name: "RadiationChargeState", VM: "1"}
+	tagDict[Tag{0x300A, 0x0308}] = TagInfo{Tag: Tag{0x300A, 0x0308}, VR: "CS", Name: "ScanMode", VM: "1"}
+	tagDict[Tag{0x300A, 0x030A}] = TagInfo{Tag: Tag{0x300A, 0x030A}, VR: "FL", Name: "VirtualSourceAxisDistances", VM: "2"}
+	tagDict[Tag{0x300A, 0x030C}] = TagInfo{Tag: Tag{0x300A, 0x030C}, VR: "SQ", Name: "SnoutSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x030D}] = TagInfo{Tag: Tag{0x300A, 0x030D}, VR: "FL", Name: "SnoutPosition", VM: "1"}
+	tagDict[Tag{0x300A, 0x030F}] = TagInfo{Tag: Tag{0x300A, 0x030F}, VR: "SH", Name: "SnoutID", VM: "1"}
+	tagDict[Tag{0x300A, 0x0312}] = TagInfo{Tag: Tag{0x300A, 0x0312}, VR: "IS", Name: "NumberOfRangeShifters", VM: "1"}
+	tagDict[Tag{0x300A, 0x0314}] = TagInfo{Tag: Tag{0x300A, 0x0314}, VR: "SQ", Name: "RangeShifterSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x0316}] = TagInfo{Tag: Tag{0x300A, 0x0316}, VR: "IS", Name: "RangeShifterNumber", VM: "1"}
+	tagDict[Tag{0x300A, 0x0318}] = TagInfo{Tag: Tag{0x300A, 0x0318}, VR: "SH", Name: "RangeShifterID", VM: "1"}
+	tagDict[Tag{0x300A, 0x0320}] = TagInfo{Tag: Tag{0x300A, 0x0320}, VR: "CS", Name: "RangeShifterType", VM: "1"}
+	tagDict[Tag{0x300A, 0x0322}] = TagInfo{Tag: Tag{0x300A, 0x0322}, VR: "LO", Name: "RangeShifterDescription", VM: "1"}
+	tagDict[Tag{0x300A, 0x0330}] = TagInfo{Tag: Tag{0x300A, 0x0330}, VR: "IS", Name: "NumberOfLateralSpreadingDevices", VM: "1"}
+	tagDict[Tag{0x300A, 0x0332}] = TagInfo{Tag: Tag{0x300A, 0x0332}, VR: "SQ", Name: "LateralSpreadingDeviceSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x0334}] = TagInfo{Tag: Tag{0x300A, 0x0334}, VR: "IS", Name: "LateralSpreadingDeviceNumber", VM: "1"}
+	tagDict[Tag{0x300A, 0x0336}] = TagInfo{Tag: Tag{0x300A, 0x0336}, VR: "SH", Name: "LateralSpreadingDeviceID", VM: "1"}
+	tagDict[Tag{0x300A, 0x0338}] = TagInfo{Tag: Tag{0x300A, 0x0338}, VR: "CS", Name: "LateralSpreadingDeviceType", VM: "1"}
+	tagDict[Tag{0x300A, 0x033A}] = TagInfo{Tag: Tag{0x300A, 0x033A}, VR: "LO", Name: "LateralSpreadingDeviceDescription", VM: "1"}
+	tagDict[Tag{0x300A, 0x033C}] = TagInfo{Tag: Tag{0x300A, 0x033C}, VR: "FL", Name: "LateralSpreadingDeviceWaterEquivalentThickness", VM: "1"}
+	tagDict[Tag{0x300A, 0x0340}] = TagInfo{Tag: Tag{0x300A, 0x0340}, VR: "IS", Name: "NumberOfRangeModulators", VM: "1"}
+	tagDict[Tag{0x300A, 0x0342}] = TagInfo{Tag: Tag{0x300A, 0x0342}, VR: "SQ", Name: "RangeModulatorSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x0344}] = TagInfo{Tag: Tag{0x300A, 0x0344}, VR: "IS", Name: "RangeModulatorNumber", VM: "1"}
+	tagDict[Tag{0x300A, 0x0346}] = TagInfo{Tag: Tag{0x300A, 0x0346}, VR: "SH", Name: "RangeModulatorID", VM: "1"}
+	tagDict[Tag{0x300A, 0x0348}] = TagInfo{Tag: Tag{0x300A, 0x0348}, VR: "CS", Name: "RangeModulatorType", VM: "1"}
+	tagDict[Tag{0x300A, 0x034A}] = TagInfo{Tag: Tag{0x300A, 0x034A}, VR: "LO", Name: "RangeModulatorDescription", VM: "1"}
+	tagDict[Tag{0x300A, 0x034C}] = TagInfo{Tag: Tag{0x300A, 0x034C}, VR: "SH", Name: "BeamCurrentModulationID", VM: "1"}
+	tagDict[Tag{0x300A, 0x0350}] = TagInfo{Tag: Tag{0x300A, 0x0350}, VR: "CS", Name: "PatientSupportType", VM: "1"}
+	tagDict[Tag{0x300A, 0x0352}] = TagInfo{Tag: Tag{0x300A, 0x0352}, VR: "SH", Name: "PatientSupportID", VM: "1"}
+	tagDict[Tag{0x300A, 0x0354}] = TagInfo{Tag: Tag{0x300A, 0x0354}, VR: "LO", Name: "PatientSupportAccessoryCode", VM: "1"}
+	tagDict[Tag{0x300A, 0x0356}] = TagInfo{Tag: Tag{0x300A, 0x0356}, VR: "FL", Name: "FixationLightAzimuthalAngle", VM: "1"}
+	tagDict[Tag{0x300A, 0x0358}] = TagInfo{Tag: Tag{0x300A, 0x0358}, VR: "FL", Name: "FixationLightPolarAngle", VM: "1"}
+	tagDict[Tag{0x300A, 0x035A}] = TagInfo{Tag: Tag{0x300A, 0x035A}, VR: "FL", Name: "MetersetRate", VM: "1"}
+	tagDict[Tag{0x300A, 0x0360}] = TagInfo{Tag: Tag{0x300A, 0x0360}, VR: "SQ", Name: "RangeShifterSettingsSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x0362}] = TagInfo{Tag: Tag{0x300A, 0x0362}, VR: "LO", Name: "RangeShifterSetting", VM: "1"}
+	tagDict[Tag{0x300A, 0x0364}] = TagInfo{Tag: Tag{0x300A, 0x0364}, VR: "FL", Name: "IsocenterToRangeShifterDistance", VM: "1"}
+	tagDict[Tag{0x300A, 0x0366}] = TagInfo{Tag: Tag{0x300A, 0x0366}, VR: "FL", Name: "RangeShifterWaterEquivalentThickness", VM: "1"}
+	tagDict[Tag{0x300A, 0x0370}] = TagInfo{Tag: Tag{0x300A, 0x0370}, VR: "SQ", Name: "LateralSpreadingDeviceSettingsSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x0372}] = TagInfo{Tag: Tag{0x300A, 0x0372}, VR: "LO", Name: "LateralSpreadingDeviceSetting", VM: "1"}
+	tagDict[Tag{0x300A, 0x0374}] = TagInfo{Tag: Tag{0x300A, 0x0374}, VR: "FL", Name: "IsocenterToLateralSpreadingDeviceDistance", VM: "1"}
+	tagDict[Tag{0x300A, 0x0380}] = TagInfo{Tag: Tag{0x300A, 0x0380}, VR: "SQ", Name: "RangeModulatorSettingsSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x0382}] = TagInfo{Tag: Tag{0x300A, 0x0382}, VR: "FL", Name: "RangeModulatorGatingStartValue", VM: "1"}
+	tagDict[Tag{0x300A, 0x0384}] = TagInfo{Tag: Tag{0x300A, 0x0384}, VR: "FL", Name: "RangeModulatorGatingStopValue", VM: "1"}
+	tagDict[Tag{0x300A, 0x0386}] = TagInfo{Tag: Tag{0x300A, 0x0386}, VR: "FL", Name: "RangeModulatorGatingStartWaterEquivalentThickness", VM: "1"}
+	tagDict[Tag{0x300A, 0x0388}] = TagInfo{Tag: Tag{0x300A, 0x0388}, VR: "FL", Name: "RangeModulatorGatingStopWaterEquivalentThickness", VM: "1"}
+	tagDict[Tag{0x300A, 0x038A}] = TagInfo{Tag: Tag{0x300A, 0x038A}, VR: "FL", Name: "IsocenterToRangeModulatorDistance", VM: "1"}
+	tagDict[Tag{0x300A, 0x0390}] = TagInfo{Tag: Tag{0x300A, 0x0390}, VR: "SH", Name: "ScanSpotTuneID", VM: "1"}
+	tagDict[Tag{0x300A, 0x0392}] = TagInfo{Tag: Tag{0x300A, 0x0392}, VR: "IS", Name: "NumberOfScanSpotPositions", VM: "1"}
+	tagDict[Tag{0x300A, 0x0394}] = TagInfo{Tag: Tag{0x300A, 0x0394}, VR: "FL", Name: "ScanSpotPositionMap", VM: "1-n"}
+	tagDict[Tag{0x300A, 0x0396}] = TagInfo{Tag: Tag{0x300A, 0x0396}, VR: "FL", Name: "ScanSpotMetersetWeights", VM: "1-n"}
+	tagDict[Tag{0x300A, 0x0398}] = TagInfo{Tag: Tag{0x300A, 0x0398}, VR: "FL", Name: "ScanningSpotSize", VM: "2"}
+	tagDict[Tag{0x300A, 0x039A}] = TagInfo{Tag: Tag{0x300A, 0x039A}, VR: "IS", Name: "NumberOfPaintings", VM: "1"}
+	tagDict[Tag{0x300A, 0x03A0}] = TagInfo{Tag: Tag{0x300A, 0x03A0}, VR: "SQ", Name: "IonToleranceTableSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x03A2}] = TagInfo{Tag: Tag{0x300A, 0x03A2}, VR: "SQ", Name: "IonBeamSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x03A4}] = TagInfo{Tag: Tag{0x300A, 0x03A4}, VR: "SQ", Name: "IonBeamLimitingDeviceSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x03A6}] = TagInfo{Tag: Tag{0x300A, 0x03A6}, VR: "SQ", Name: "IonBlockSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x03A8}] = TagInfo{Tag: Tag{0x300A, 0x03A8}, VR: "SQ", Name: "IonControlPointSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x03AA}] = TagInfo{Tag: Tag{0x300A, 0x03AA}, VR: "SQ", Name: "IonWedgeSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x03AC}] = TagInfo{Tag: Tag{0x300A, 0x03AC}, VR: "SQ", Name: "IonWedgePositionSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x0401}] = TagInfo{Tag: Tag{0x300A, 0x0401}, VR: "SQ", Name: "ReferencedSetupImageSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x0402}] = TagInfo{Tag: Tag{0x300A, 0x0402}, VR: "ST", Name: "SetupImageComment", VM: "1"}
+	tagDict[Tag{0x300A, 0x0410}] = TagInfo{Tag: Tag{0x300A, 0x0410}, VR: "SQ", Name: "MotionSynchronizationSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x0412}] = TagInfo{Tag: Tag{0x300A, 0x0412}, VR: "FL", Name: "ControlPointOrientation", VM: "3"}
+	tagDict[Tag{0x300A, 0x0420}] = TagInfo{Tag: Tag{0x300A, 0x0420}, VR: "SQ", Name: "GeneralAccessorySequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x0421}] = TagInfo{Tag: Tag{0x300A, 0x0421}, VR: "SH", Name: "GeneralAccessoryID", VM: "1"}
+	tagDict[Tag{0x300A, 0x0422}] = TagInfo{Tag: Tag{0x300A, 0x0422}, VR: "ST", Name: "GeneralAccessoryDescription", VM: "1"}
+	tagDict[Tag{0x300A, 0x0423}] = TagInfo{Tag: Tag{0x300A, 0x0423}, VR: "CS", Name: "GeneralAccessoryType", VM: "1"}
+	tagDict[Tag{0x300A, 0x0424}] = TagInfo{Tag: Tag{0x300A, 0x0424}, VR: "IS", Name: "GeneralAccessoryNumber", VM: "1"}
+	tagDict[Tag{0x300A, 0x0431}] = TagInfo{Tag: Tag{0x300A, 0x0431}, VR: "SQ", Name: "ApplicatorGeometrySequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x0432}] = TagInfo{Tag: Tag{0x300A, 0x0432}, VR: "CS", Name: "ApplicatorApertureShape", VM: "1"}
+	tagDict[Tag{0x300A, 0x0433}] = TagInfo{Tag: Tag{0x300A, 0x0433}, VR: "FL", Name: "ApplicatorOpening", VM: "1"}
+	tagDict[Tag{0x300A, 0x0434}] = TagInfo{Tag: Tag{0x300A, 0x0434}, VR: "FL", Name: "ApplicatorOpeningX", VM: "1"}
+	tagDict[Tag{0x300A, 0x0435}] = TagInfo{Tag: Tag{0x300A, 0x0435}, VR: "FL", Name: "ApplicatorOpeningY", VM: "1"}
+	tagDict[Tag{0x300A, 0x0436}] = TagInfo{Tag: Tag{0x300A, 0x0436}, VR: "FL", Name: "SourceToApplicatorMountingPositionDistance", VM: "1"}
+	tagDict[Tag{0x300C, 0x0002}] = TagInfo{Tag: Tag{0x300C, 0x0002}, VR: "SQ", Name: "ReferencedRTPlanSequence", VM: "1"}
+	tagDict[Tag{0x300C, 0x0004}] = TagInfo{Tag: Tag{0x300C, 0x0004}, VR: "SQ", Name: "ReferencedBeamSequence", VM: "1"}
+	tagDict[Tag{0x300C, 0x0006}] = TagInfo{Tag: Tag{0x300C, 0x0006}, VR: "IS", Name: "ReferencedBeamNumber", VM: "1"}
+	tagDict[Tag{0x300C, 0x0007}] = TagInfo{Tag: Tag{0x300C, 0x0007}, VR: "IS", Name: "ReferencedReferenceImageNumber", VM: "1"}
+	tagDict[Tag{0x300C, 0x0008}] = TagInfo{Tag: Tag{0x300C, 0x0008}, VR: "DS", Name: "StartCumulativeMetersetWeight", VM: "1"}
+	tagDict[Tag{0x300C, 0x0009}] = TagInfo{Tag: Tag{0x300C, 0x0009}, VR: "DS", Name: "EndCumulativeMetersetWeight", VM: "1"}
+	tagDict[Tag{0x300C, 0x000A}] = TagInfo{Tag: Tag{0x300C, 0x000A}, VR: "SQ", Name: "ReferencedBrachyApplicationSetupSequence", VM: "1"}
+	tagDict[Tag{0x300C, 0x000C}] = TagInfo{Tag: Tag{0x300C, 0x000C}, VR: "IS", Name: "ReferencedBrachyApplicationSetupNumber", VM: "1"}
+	tagDict[Tag{0x300C, 0x000E}] = TagInfo{Tag: Tag{0x300C, 0x000E}, VR: "IS", Name: "ReferencedSourceNumber", VM: "1"}
+	tagDict[Tag{0x300C, 0x0020}] = TagInfo{Tag: Tag{0x300C, 0x0020}, VR: "SQ", Name: "ReferencedFractionGroupSequence", VM: "1"}
+	tagDict[Tag{0x300C, 0x0022}] = TagInfo{Tag: Tag{0x300C, 0x0022}, VR: "IS", Name: "ReferencedFractionGroupNumber", VM: "1"}
+	tagDict[Tag{0x300C, 0x0040}] = TagInfo{Tag: Tag{0x300C, 0x0040}, VR: "SQ", Name: "ReferencedVerificationImageSequence", VM: "1"}
+	tagDict[Tag{0x300C, 0x0042}] = TagInfo{Tag: Tag{0x300C, 0x0042}, VR: "SQ", Name: "ReferencedReferenceImageSequence", VM: "1"}
+	tagDict[Tag{0x300C, 0x0050}] = TagInfo{Tag: Tag{0x300C, 0x0050}, VR: "SQ", Name: "ReferencedDoseReferenceSequence", VM: "1"}
+	tagDict[Tag{0x300C, 0x0051}] = TagInfo{Tag: Tag{0x300C, 0x0051}, VR: "IS", Name: "ReferencedDoseReferenceNumber", VM: "1"}
+	tagDict[Tag{0x300C, 0x0055}] = TagInfo{Tag: Tag{0x300C, 0x0055}, VR: "SQ", Name: "BrachyReferencedDoseReferenceSequence", VM: "1"}
+	tagDict[Tag{0x300C, 0x0060}] = TagInfo{Tag: Tag{0x300C, 0x0060}, VR: "SQ", Name: "ReferencedStructureSetSequence", VM: "1"}
+	tagDict[Tag{0x300C, 0x006A}] = TagInfo{Tag: Tag{0x300C, 0x006A}, VR: "IS", Name: "ReferencedPatientSetupNumber", VM: "1"}
+	tagDict[Tag{0x300C, 0x0080}] = TagInfo{Tag: Tag{0x300C, 0x0080}, VR: "SQ", Name: "ReferencedDoseSequence", VM: "1"}
+	tagDict[Tag{0x300C, 0x00A0}] = TagInfo{Tag: Tag{0x300C, 0x00A0}, VR: "IS", Name: "ReferencedToleranceTableNumber", VM: "1"}
+	tagDict[Tag{0x300C, 0x00B0}] = TagInfo{Tag: Tag{0x300C, 0x00B0}, VR: "SQ", Name: "ReferencedBolusSequence", VM: "1"}
+	tagDict[Tag{0x300C, 0x00C0}] = TagInfo{Tag: Tag{0x300C, 0x00C0}, VR: "IS", Name: "ReferencedWedgeNumber", VM: "1"}
+	tagDict[Tag{0x300C, 0x00D0}] = TagInfo{Tag: Tag{0x300C, 0x00D0}, VR: "IS", Name: "ReferencedCompensatorNumber", VM: "1"}
+	tagDict[Tag{0x300C, 0x00E0}] = TagInfo{Tag: Tag{0x300C, 0x00E0}, VR: "IS", Name: "ReferencedBlockNumber", VM: "1"}
+	tagDict[Tag{0x300C, 0x00F0}] = TagInfo{Tag: Tag{0x300C, 0x00F0}, VR: "IS", Name: "ReferencedControlPointIndex", VM: "1"}
+	tagDict[Tag{0x300C, 0x00F2}] = TagInfo{Tag: Tag{0x300C, 0x00F2}, VR: "SQ", Name: "ReferencedControlPointSequence", VM: "1"}
+	tagDict[Tag{0x300C, 0x00F4}] = TagInfo{Tag: Tag{0x300C, 0x00F4}, VR: "IS", Name: "ReferencedStartControlPointIndex", VM: "1"}
+	tagDict[Tag{0x300C, 0x00F6}] = TagInfo{Tag: Tag{0x300C, 0x00F6}, VR: "IS", Name: "ReferencedStopControlPointIndex", VM: "1"}
+	tagDict[Tag{0x300C, 0x0100}] = TagInfo{Tag: Tag{0x300C, 0x0100}, VR: "IS", Name: "ReferencedRangeShifterNumber", VM: "1"}
+	tagDict[Tag{0x300C, 0x0102}] = TagInfo{Tag: Tag{0x300C, 0x0102}, VR: "IS", Name: "ReferencedLateralSpreadingDeviceNumber", VM: "1"}
+	tagDict[Tag{0x300C, 0x0104}] = TagInfo{Tag: Tag{0x300C, 0x0104}, VR: "IS", Name: "ReferencedRangeModulatorNumber", VM: "1"}
+	tagDict[Tag{0x300E, 0x0002}] = TagInfo{Tag: Tag{0x300E, 0x0002}, VR: "CS", Name: "ApprovalStatus", VM: "1"}
+	tagDict[Tag{0x300E, 0x0004}] = TagInfo{Tag: Tag{0x300E, 0x0004}, VR: "DA", Name: "ReviewDate", VM: "1"}
+	tagDict[Tag{0x300E, 0x0005}] = TagInfo{Tag: Tag{0x300E, 0x0005}, VR: "TM", Name: "ReviewTime", VM: "1"}
+	tagDict[Tag{0x300E, 0x0008}] = TagInfo{Tag: Tag{0x300E, 0x0008}, VR: "PN", Name: "ReviewerName", VM: "1"}
+	tagDict[Tag{0x4010, 0x0001}] = TagInfo{Tag: Tag{0x4010, 0x0001}, VR: "CS", Name: "LowEnergyDetectors", VM: "1"}
+	tagDict[Tag{0x4010, 0x0002}] = TagInfo{Tag: Tag{0x4010, 0x0002}, VR: "CS", Name: "HighEnergyDetectors", VM: "1"}
+	tagDict[Tag{0x4010, 0x0004}] = TagInfo{Tag: Tag{0x4010, 0x0004}, VR: "SQ", Name: "DetectorGeometrySequence", VM: "1"}
+	tagDict[Tag{0x4010, 0x1001}] = TagInfo{Tag: Tag{0x4010, 0x1001}, VR: "SQ", Name: "ThreatROIVoxelSequence", VM: "1"}
+	tagDict[Tag{0x4010, 0x1004}] = TagInfo{Tag: Tag{0x4010, 0x1004}, VR: "FL", Name: "ThreatROIBase", VM: "3"}
+	tagDict[Tag{0x4010, 0x1005}] = TagInfo{Tag: Tag{0x4010, 0x1005}, VR: "FL", Name: "ThreatROIExtents", VM: "3"}
+	tagDict[Tag{0x4010, 0x1006}] = TagInfo{Tag: Tag{0x4010, 0x1006}, VR: "OB", Name: "ThreatROIBitmap", VM: "1"}
+	tagDict[Tag{0x4010, 0x1007}] = TagInfo{Tag: Tag{0x4010, 0x1007}, VR: "SH", Name: "RouteSegmentID", VM: "1"}
+	tagDict[Tag{0x4010, 0x1008}] = TagInfo{Tag: Tag{0x4010, 0x1008}, VR: "CS", Name: "GantryType", VM: "1"}
+	tagDict[Tag{0x4010, 0x1009}] = TagInfo{Tag: Tag{0x4010, 0x1009}, VR: "CS", Name: "OOIOwnerType", VM: "1"}
+	tagDict[Tag{0x4010, 0x100A}] = TagInfo{Tag: Tag{0x4010, 0x100A}, VR: "SQ", Name: "RouteSegmentSequence", VM: "1"}
+	tagDict[Tag{0x4010, 0x1010}] = TagInfo{Tag: Tag{0x4010, 0x1010}, VR: "US", Name: "PotentialThreatObjectID", VM: "1"}
+	tagDict[Tag{0x4010, 0x1011}] = TagInfo{Tag: Tag{0x4010, 0x1011}, VR: "SQ", Name: "ThreatSequence", VM: "1"}
+	tagDict[Tag{0x4010, 0x1012}] = TagInfo{Tag: Tag{0x4010, 0x1012}, VR: "CS", Name: "ThreatCategory", VM: "1"}
+	tagDict[Tag{0x4010, 0x1013}] = TagInfo{Tag: Tag{0x4010, 0x1013}, VR: "LT", Name: "ThreatCategoryDescription", VM: "1"}
+	tagDict[Tag{0x4010, 0x1014}] = TagInfo{Tag: Tag{0x4010, 0x1014}, VR: "CS", Name: "ATDAbilityAssessment", VM: "1"}
+	tagDict[Tag{0x4010, 0x1015}] = TagInfo{Tag: Tag{0x4010, 0x1015}, VR: "CS", Name: "ATDAssessmentFlag", VM: "1"}
+	tagDict[Tag{0x4010, 0x1016}] = TagInfo{Tag: Tag{0x4010, 0x1016}, VR: "FL", Name: "ATDAssessmentProbability", VM: "1"}
+	tagDict[Tag{0x4010, 0x1017}] = TagInfo{Tag: Tag{0x4010, 0x1017}, VR: "FL", Name: "Mass", VM: "1"}
+	tagDict[Tag{0x4010, 0x1018}] = TagInfo{Tag: Tag{0x4010, 0x1018}, VR: "FL", Name: "Density", VM: "1"}
+	tagDict[Tag{0x4010, 0x1019}] = TagInfo{Tag: Tag{0x4010, 0x1019}, VR: "FL", Name: "ZEffective", VM: "1"}
+	tagDict[Tag{0x4010, 0x101A}] = TagInfo{Tag: Tag{0x4010, 0x101A}, VR: "SH", Name: "BoardingPassID", VM: "1"}
+	tagDict[Tag{0x4010, 0x101B}] = TagInfo{Tag: Tag{0x4010, 0x101B}, VR: "FL", Name: "CenterOfMass", VM: "3"}
+	tagDict[Tag{0x4010, 0x101C}] = TagInfo{Tag: Tag{0x4010, 0x101C}, VR: "FL", Name: "CenterOfPTO", VM: "3"}
+	tagDict[Tag{0x4010, 0x101D}] = TagInfo{Tag: Tag{0x4010, 0x101D}, VR: "FL", Name: "BoundingPolygon", VM: "6-n"}
+	tagDict[Tag{0x4010, 0x101E}] = TagInfo{Tag: Tag{0x4010, 0x101E}, VR: "SH", Name: "RouteSegmentStartLocationID", VM: "1"}
+	tagDict[Tag{0x4010, 0x101F}] = TagInfo{Tag: Tag{0x4010, 0x101F}, VR: "SH", Name: "RouteSegmentEndLocationID", VM: "1"}
+	tagDict[Tag{0x4010, 0x1020}] = TagInfo{Tag: Tag{0x4010, 0x1020}, VR: "CS", Name: "RouteSegmentLocationIDType", VM: "1"}
+	tagDict[Tag{0x4010, 0x1021}] = TagInfo{Tag: Tag{0x4010, 0x1021}, VR: "CS", Name: "AbortReason", VM: "1-n"}
+	tagDict[Tag{0x4010, 0x1023}] = TagInfo{Tag: Tag{0x4010, 0x1023}, VR: "FL", Name: "VolumeOfPTO", VM: "1"}
+	tagDict[Tag{0x4010, 0x1024}] = TagInfo{Tag: Tag{0x4010, 0x1024}, VR: "CS", Name: "AbortFlag", VM: "1"}
+	tagDict[Tag{0x4010, 0x1025}] = TagInfo{Tag: Tag{0x4010, 0x1025}, VR: "DT", Name: "RouteSegmentStartTime", VM: "1"}
+	tagDict[Tag{0x4010, 0x1026}] = TagInfo{Tag: Tag{0x4010, 0x1026}, VR: "DT", Name: "RouteSegmentEndTime", VM: "1"}
+	tagDict[Tag{0x4010, 0x1027}] = TagInfo{Tag: Tag{0x4010, 0x1027}, VR: "CS", Name: "TDRType", VM: "1"}
+	tagDict[Tag{0x4010, 0x1028}] = TagInfo{Tag: Tag{0x4010, 0x1028}, VR: "CS", Name: "InternationalRouteSegment", VM: "1"}
+	tagDict[Tag{0x4010, 0x1029}] = TagInfo{Tag: Tag{0x4010, 0x1029}, VR: "LO", Name: "ThreatDetectionAlgorithmandVersion", VM: "1-n"}
+	tagDict[Tag{0x4010, 0x102A}] = TagInfo{Tag: Tag{0x4010, 0x102A}, VR: "SH", Name: "AssignedLocation", VM: "1"}
+	tagDict[Tag{0x4010, 0x102B}] = TagInfo{Tag: Tag{0x4010, 0x102B}, VR: "DT", Name: "AlarmDecisionTime", VM: "1"}
+	tagDict[Tag{0x4010, 0x1031}] = TagInfo{Tag: Tag{0x4010, 0x1031}, VR: "CS", Name: "AlarmDecision", VM: "1"}
+	tagDict[Tag{0x4010, 0x1033}] = TagInfo{Tag: Tag{0x4010, 0x1033}, VR: "US", Name: "NumberOfTotalObjects", VM: "1"}
+	tagDict[Tag{0x4010, 0x1034}] = TagInfo{Tag: Tag{0x4010, 0x1034}, VR: "US", Name: "NumberOfAlarmObjects", VM: "1"}
+	tagDict[Tag{0x4010, 0x1037}] = TagInfo{Tag: Tag{0x4010, 0x1037}, VR: "SQ", Name: "PTORepresentationSequence", VM: "1"}
+	tagDict[Tag{0x4010, 0x1038}] = TagInfo{Tag: Tag{0x4010, 0x1038}, VR: "SQ", Name: "ATDAssessmentSequence", VM: "1"}
+	tagDict[Tag{0x4010, 0x1039}] = TagInfo{Tag: Tag{0x4010, 0x1039}, VR: "CS", Name: "TIPType", VM: "1"}
+	tagDict[Tag{0x4010, 0x103A}] = TagInfo{Tag: Tag{0x4010, 0x103A}, VR: "CS", Name: "DICOSVersion", VM: "1"}
+	tagDict[Tag{0x4010, 0x1041}] = TagInfo{Tag: Tag{0x4010, 0x1041}, VR: "DT", Name: "OOIOwnerCreationTime", VM: "1"}
+	tagDict[Tag{0x4010, 0x1042}] = TagInfo{Tag: Tag{0x4010, 0x1042}, VR: "CS", Name: "OOIType", VM: "1"}
+	tagDict[Tag{0x4010, 0x1043}] = TagInfo{Tag: Tag{0x4010, 0x1043}, VR: "FL", Name: "OOISize", VM: "3"}
+	tagDict[Tag{0x4010, 0x1044}] = TagInfo{Tag: Tag{0x4010, 0x1044}, VR: "CS", Name: "AcquisitionStatus", VM: "1"}
+	tagDict[Tag{0x4010, 0x1045}] = TagInfo{Tag: Tag{0x4010, 0x1045}, VR: "SQ", Name: "BasisMaterialsCodeSequence", VM: "1"}
+	tagDict[Tag{0x4010, 0x1046}] = TagInfo{Tag: Tag{0x4010, 0x1046}, VR: "CS", Name: "PhantomType", VM: "1"}
+	tagDict[Tag{0x4010, 0x1047}] = TagInfo{Tag: Tag{0x4010, 0x1047}, VR: "SQ", Name: "OOIOwnerSequence", VM: "1"}
+	tagDict[Tag{0x4010, 0x1048}] = TagInfo{Tag: Tag{0x4010, 0x1048}, VR: "CS", Name: "ScanType", VM: "1"}
+	tagDict[Tag{0x4010, 0x1051}] = TagInfo{Tag: Tag{0x4010, 0x1051}, VR: "LO", Name: "ItineraryID", VM: "1"}
+	tagDict[Tag{0x4010, 0x1052}] = TagInfo{Tag: Tag{0x4010, 0x1052}, VR: "SH", Name: "ItineraryIDType", VM: "1"}
+	tagDict[Tag{0x4010, 0x1053}] = TagInfo{Tag: Tag{0x4010, 0x1053}, VR: "LO", Name: "ItineraryIDAssigningAuthority", VM: "1"}
+	tagDict[Tag{0x4010, 0x1054}] = TagInfo{Tag: Tag{0x4010, 0x1054}, VR: "SH", Name: "RouteID", VM: "1"}
+	tagDict[Tag{0x4010, 0x1055}] = TagInfo{Tag: Tag{0x4010, 0x1055}, VR: "SH", Name: "RouteIDAssigningAuthority", VM: "1"}
+	tagDict[Tag{0x4010, 0x1056}] = TagInfo{Tag: Tag{0x4010, 0x1056}, VR: "CS", Name: "InboundArrivalType", VM: "1"}
+	tagDict[Tag{0x4010, 0x1058}] = TagInfo{Tag: Tag{0x4010, 0x1058}, VR: "SH", Name: "CarrierID", VM: "1"}
+	tagDict[Tag{0x4010, 0x1059}] = TagInfo{Tag: Tag{0x4010, 0x1059}, VR: "CS", Name: "CarrierIDAssigningAuthority", VM: "1"}
+	tagDict[Tag{0x4010, 0x1060}] = TagInfo{Tag: Tag{0x4010, 0x1060}, VR: "FL", Name: "SourceOrientation", VM: "3"}
+	tagDict[Tag{0x4010, 0x1061}] = TagInfo{Tag: Tag{0x4010, 0x1061}, VR: "FL", Name: "SourcePosition", VM: "3"}
+	tagDict[Tag{0x4010, 0x1062}] = TagInfo{Tag: Tag{0x4010, 0x1062}, VR: "FL", Name: "BeltHeight", VM: "1"}
+	tagDict[Tag{0x4010, 0x1064}] = TagInfo{Tag: Tag{0x4010, 0x1064}, VR: "SQ", Name: "AlgorithmRoutingCodeSequence", VM: "1"}
+	tagDict[Tag{0x4010, 0x1067}] = TagInfo{Tag: Tag{0x4010, 0x1067}, VR: "CS", Name: "TransportClassification", VM: "1"}
+	tagDict[Tag{0x4010, 0x1068}] = TagInfo{Tag: Tag{0x4010, 0x1068}, VR: "LT", Name: "OOITypeDescriptor", VM: "1"}
+	tagDict[Tag{0x4010, 0x1069}] = TagInfo{Tag: Tag{0x4010, 0x1069}, VR: "FL", Name: "TotalProcessingTime", VM: "1"}
+	tagDict[Tag{0x4010, 0x106C}] = TagInfo{Tag: Tag{0x4010, 0x106C}, VR: "OB", Name: "DetectorCalibrationData", VM: "1"}
+	tagDict[Tag{0x4FFE, 0x0001}] = TagInfo{Tag: Tag{0x4FFE, 0x0001}, VR: "SQ", Name: "MACParametersSequence", VM: "1"}
+	tagDict[Tag{0x5200, 0x9229}] = TagInfo{Tag: Tag{0x5200, 0x9229}, VR: "SQ", Name: "SharedFunctionalGroupsSequence", VM: "1"}
+	tagDict[Tag{0x5200, 0x9230}] = TagInfo{Tag: Tag{0x5200, 0x9230}, VR: "SQ", Name: "PerFrameFunctionalGroupsSequence", VM: "1"}
+	tagDict[Tag{0x5400, 0x0100}] = TagInfo{Tag: Tag{0x5400, 0x0100}, VR: "SQ", Name: "WaveformSequence", VM: "1"}
+	tagDict[Tag{0x5400, 0x0110}] = TagInfo{Tag: Tag{0x5400, 0x0110}, VR: "OW", VRs: []string{"OW", "OB"}, Name: "ChannelMinimumValue", VM: "1"}
+	tagDict[Tag{0x5400, 0x0112}] = TagInfo{Tag: Tag{0x5400, 0x0112}, VR: "OW", VRs: []string{"OW", "OB"}, Name: "ChannelMaximumValue", VM: "1"}
+	tagDict[Tag{0x5400, 0x1004}] = TagInfo{Tag: Tag{0x5400, 0x1004}, VR: "US", Name: "WaveformBitsAllocated", VM: "1"}
+	tagDict[Tag{0x5400, 0x1006}] = TagInfo{Tag: Tag{0x5400, 0x1006}, VR: "CS", Name: "WaveformSampleInterpretation", VM: "1"}
+	tagDict[Tag{0x5400, 0x100A}] = TagInfo{Tag: Tag{0x5400, 0x100A}, VR: "OW", VRs: []string{"OW", "OB"}, Name: "WaveformPaddingValue", VM: "1"}
+	tagDict[Tag{0x5400, 0x1010}] = TagInfo{Tag: Tag{0x5400, 0x1010}, VR: "OW", VRs: []string{"OW", "OB"}, Name: "WaveformData", VM: "1"}
+	tagDict[Tag{0x5600, 0x0010}] = TagInfo{Tag: Tag{0x5600, 0x0010}, VR: "OF", Name: "FirstOrderPhaseCorrectionAngle", VM: "1"}
+	tagDict[Tag{0x5600, 0x0020}] = TagInfo{Tag: Tag{0x5600, 0x0020}, VR: "OF", Name: "SpectroscopyData", VM: "1"}
+	tagDict[Tag{0x7FE0, 0x0010}] = TagInfo{Tag: Tag{0x7FE0, 0x0010}, VR: "OW", VRs: []string{"OW", "OB"}, Name: "PixelData", VM: "1"}
+	tagDict[Tag{0xFFFA, 0xFFFA}] = TagInfo{Tag: Tag{0xFFFA, 0xFFFA}, VR: "SQ", Name: "DigitalSignaturesSequence", VM: "1"}
+	tagDict[Tag{0xFFFC, 0xFFFC}] = TagInfo{Tag: Tag{0xFFFC, 0xFFFC}, VR: "OB", Name: "DataSetTrailingPadding", VM: "1"}
+	tagDict[Tag{0xFFFE, 0xE000}] = TagInfo{Tag: Tag{0xFFFE, 0xE000}, VR: "NA", Name: "Item", VM: "1"}
+	tagDict[Tag{0xFFFE, 0xE00D}] = TagInfo{Tag: Tag{0xFFFE, 0xE00D}, VR: "NA", Name: "ItemDelimitationItem", VM: "1"}
+	tagDict[Tag{0xFFFE, 0xE0DD}] = TagInfo{Tag: Tag{0xFFFE, 0xE0DD}, VR: "NA", Name: "SequenceDelimitationItem", VM: "1"}
+	tagDict[Tag{0x0022, 0x1415}] = TagInfo{Tag: Tag{0x0022, 0x1415}, VR: "CS", Name: "OphthalmicMappingDeviceType", VM: "1"}
+	tagDict[Tag{0x0022, 0x1420}] = TagInfo{Tag: Tag{0x0022, 0x1420}, VR: "SQ", Name: "AcquisitionTypeMethodSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1423}] = TagInfo{Tag: Tag{0x0022, 0x1423}, VR: "SQ", Name: "AcquisitonMethodAlgorithmSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1436}] = TagInfo{Tag: Tag{0x0022, 0x1436}, VR: "SQ", Name: "OphthalmicThicknessMapTypeCodeSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1443}] = TagInfo{Tag: Tag{0x0022, 0x1443}, VR: "SQ", Name: "OphthalmicThicknessMappingNormalsSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1445}] = TagInfo{Tag: Tag{0x0022, 0x1445}, VR: "SQ", Name: "RetinalThicknessDefinitionCodeSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1450}] = TagInfo{Tag: Tag{0x0022, 0x1450}, VR: "SQ", Name: "PixelValueMappingtoCodedConceptSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1452}] = TagInfo{Tag: Tag{0x0022, 0x1452}, VR: "US", VRs: []string{"US", "SS"}, Name: "MappedPixelValue", VM: "1"}
+	tagDict[Tag{0x0022, 0x1454}] = TagInfo{Tag: Tag{0x0022, 0x1454}, VR: "LO", Name: "PixelValueMappingExplanation", VM: "1"}
+	tagDict[Tag{0x0022, 0x1458}] = TagInfo{Tag: Tag{0x0022, 0x1458}, VR: "SQ", Name: "OphthalmicThicknessMapQualityThresholdSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1460}] = TagInfo{Tag: Tag{0x0022, 0x1460}, VR: "FL", Name: "OphthalmicThicknessMapThresholdQualityRating", VM: "1"}
+	tagDict[Tag{0x0022, 0x1463}] = TagInfo{Tag: Tag{0x0022, 0x1463}, VR: "FL", Name: "AnatomicStructureReferencePoint", VM: "2"}
+	tagDict[Tag{0x0022, 0x1465}] = TagInfo{Tag: Tag{0x0022, 0x1465}, VR: "SQ", Name: "RegistrationtoLocalizerSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1466}] = TagInfo{Tag: Tag{0x0022, 0x1466}, VR: "CS", Name: "RegisteredLocalizerUnits", VM: "1"}
+	tagDict[Tag{0x0022, 0x1467}] = TagInfo{Tag: Tag{0x0022, 0x1467}, VR: "FL", Name: "RegisteredTopLeftHandCorner", VM: "2"}
+	tagDict[Tag{0x0022, 0x1468}] = TagInfo{Tag: Tag{0x0022, 0x1468}, VR: "FL", Name: "RegisteredBottomRightHandCorner", VM: "2"}
+	tagDict[Tag{0x0022, 0x1470}] = TagInfo{Tag: Tag{0x0022, 0x1470}, VR: "SQ", Name: "OphthalmicThicknessMapQualityRatingSequence", VM: "1"}
+	tagDict[Tag{0x0022, 0x1472}] = TagInfo{Tag: Tag{0x0022, 0x1472}, VR: "SQ", Name: "RelevantOPTAttributesSequence", VM: "1"}
+	tagDict[Tag{0x0040, 0x4001}] = TagInfo{Tag: Tag{0x0040, 0x4001}, VR: "CS", Name: "RETIRED_GeneralPurposeScheduledProcedureStepStatus", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0x4002}] = TagInfo{Tag: Tag{0x0040, 0x4002}, VR: "CS", Name: "RETIRED_GeneralPurposePerformedProcedureStepStatus", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0x4003}] = TagInfo{Tag: Tag{0x0040, 0x4003}, VR: "CS", Name: "RETIRED_GeneralPurposeScheduledProcedureStepPriority", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0x4004}] = TagInfo{Tag: Tag{0x0040, 0x4004}, VR: "SQ", Name: "RETIRED_ScheduledProcessingApplicationsCodeSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0x4006}] = TagInfo{Tag: Tag{0x0040, 0x4006}, VR: "CS", Name: "RETIRED_MultipleCopiesFlag", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0x4015}] = TagInfo{Tag: Tag{0x0040, 0x4015}, VR: "SQ", Name: "RETIRED_ResultingGeneralPurposePerformedProcedureStepsSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0x4016}] = TagInfo{Tag: Tag{0x0040, 0x4016}, VR: "SQ", Name: "RETIRED_ReferencedGeneralPurposeScheduledProcedureStepSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0x4022}] = TagInfo{Tag: Tag{0x0040, 0x4022}, VR: "SQ", Name: "RETIRED_RelevantInformationSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0x4023}] = TagInfo{Tag: Tag{0x0040, 0x4023}, VR: "UI", Name: "RETIRED_ReferencedGeneralPurposeScheduledProcedureStepTransactionUID", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0x4031}] = TagInfo{Tag: Tag{0x0040, 0x4031}, VR: "SQ", Name: "RETIRED_RequestedSubsequentWorkitemCodeSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0x4032}] = TagInfo{Tag: Tag{0x0040, 0x4032}, VR: "SQ", Name: "RETIRED_NonDICOMOutputCodeSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA161}] = TagInfo{Tag: Tag{0x0040, 0xA161}, VR: "FD", Name: "FloatingPointValue", VM: "1-n"}
+	tagDict[Tag{0x0040, 0xA162}] = TagInfo{Tag: Tag{0x0040, 0xA162}, VR: "SL", Name: "RationalNumeratorValue", VM: "1-n"}
+	tagDict[Tag{0x0040, 0xA163}] = TagInfo{Tag: Tag{0x0040, 0xA163}, VR: "UL", Name: "RationalDenominatorValue", VM: "1-n"}
+	tagDict[Tag{0x0010, 0x0200}] = TagInfo{Tag: Tag{0x0010, 0x0200}, VR: "CS", Name: "QualityControlSubject", VM: "1"}
+	tagDict[Tag{0x3006, 0x0018}] = TagInfo{Tag: Tag{0x3006, 0x0018}, VR: "SQ", Name: "PredecessorStructureSetSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x0088}] = TagInfo{Tag: Tag{0x300A, 0x0088}, VR: "FL", Name: "RETIRED_BeamDosePointDepth", VM: "1", Retired: true}
+	tagDict[Tag{0x300A, 0x0089}] = TagInfo{Tag: Tag{0x300A, 0x0089}, VR: "FL", Name: "RETIRED_BeamDosePointEquivalentDepth", VM: "1", Retired: true}
+	tagDict[Tag{0x300A, 0x008A}] = TagInfo{Tag: Tag{0x300A, 0x008A}, VR: "FL", Name: "RETIRED_BeamDosePointSSD", VM: "1", Retired: true}
+	tagDict[Tag{0x300A, 0x008C}] = TagInfo{Tag: Tag{0x300A, 0x008C}, VR: "SQ", Name: "BeamDoseVerificationControlPointSequence", VM: "1"}
+	tagDict[Tag{0x300A, 0x008D}] = TagInfo{Tag: Tag{0x300A, 0x008D}, VR: "FL", Name: "AverageBeamDosePointDepth", VM: "1"}
+	tagDict[Tag{0x300A, 0x008E}] = TagInfo{Tag: Tag{0x300A, 0x008E}, VR: "FL", Name: "AverageBeamDosePointEquivalentDepth", VM: "1"}
+	tagDict[Tag{0x300A, 0x008F}] = TagInfo{Tag: Tag{0x300A, 0x008F}, VR: "FL", Name: "AverageBeamDosePointSSD", VM: "1"}
+	tagDict[Tag{0x0040, 0xA171}] = TagInfo{Tag: Tag{0x0040, 0xA171}, VR: "UI", Name: "ObservationUID", VM: "1"}
+	tagDict[Tag{0x300A, 0x00EF}] = TagInfo{Tag: Tag{0x300A, 0x00EF}, VR: "SH", Name: "CompensatorTrayID", VM: "1"}
+	tagDict[Tag{0x0066, 0x0037}] = TagInfo{Tag: Tag{0x0066, 0x0037}, VR: "FL", Name: "RecommendedPointRadius", VM: "1"}
+	tagDict[Tag{0x0066, 0x0038}] = TagInfo{Tag: Tag{0x0066, 0x0038}, VR: "FL", Name: "RecommendedLineThickness", VM: "1"}
+	tagDict[Tag{0x300A, 0x021B}] = TagInfo{Tag: Tag{0x300A, 0x021B}, VR: "SH", Name: "SourceModel", VM: "1"}
+	tagDict[Tag{0x300A, 0x021C}] = TagInfo{Tag: Tag{0x300A, 0x021C}, VR: "LO", Name: "SourceDescription", VM: "1"}
+	tagDict[Tag{0x0008, 0x0015}] = TagInfo{Tag: Tag{0x0008, 0x0015}, VR: "DT", Name: "InstanceCoercionDateTime", VM: "1"}
+	tagDict[Tag{0x0000, 0x0001}] = TagInfo{Tag: Tag{0x0000, 0x0001}, VR: "UL", Name: "ACR_NEMA_CommandGroupLengthToEnd", VM: "1"}
+	tagDict[Tag{0x0000, 0x0010}] = TagInfo{Tag: Tag{0x0000, 0x0010}, VR: "CS", Name: "ACR_NEMA_CommandRecognitionCode", VM: "1"}
+	tagDict[Tag{0x0000, 0x0200}] = TagInfo{Tag: Tag{0x0000, 0x0200}, VR: "LO", Name: "ACR_NEMA_Initiator", VM: "1"}
+	tagDict[Tag{0x0000, 0x0300}] = TagInfo{Tag: Tag{0x0000, 0x0300}, VR: "LO", Name: "ACR_NEMA_Receiver", VM: "1"}
+	tagDict[Tag{0x0000, 0x0400}] = TagInfo{Tag: Tag{0x0000, 0x0400}, VR: "LO", Name: "ACR_NEMA_FindLocation", VM: "1"}
+	tagDict[Tag{0x0000, 0x0850}] = TagInfo{Tag: Tag{0x0000, 0x0850}, VR: "US", Name: "ACR_NEMA_NumberOfMatches", VM: "1"}
+	tagDict[Tag{0x0000, 0x0860}] = TagInfo{Tag: Tag{0x0000, 0x0860}, VR: "US", Name: "ACR_NEMA_ResponseSequenceNumber", VM: "1"}
+	tagDict[Tag{0x0000, 0x4000}] = TagInfo{Tag: Tag{0x0000, 0x4000}, VR: "LO", Name: "ACR_NEMA_DialogReceiver", VM: "1"}
+	tagDict[Tag{0x0000, 0x4010}] = TagInfo{Tag: Tag{0x0000, 0x4010}, VR: "LO", Name: "ACR_NEMA_TerminalType", VM: "1"}
+	tagDict[Tag{0x0000, 0x5010}] = TagInfo{Tag: Tag{0x0000, 0x5010}, VR: "LO", Name: "ACR_NEMA_MessageSetID", VM: "1"}
+	tagDict[Tag{0x0000, 0x5020}] = TagInfo{Tag: Tag{0x0000, 0x5020}, VR: "LO", Name: "ACR_NEMA_EndMessageSet", VM: "1"}
+	tagDict[Tag{0x0000, 0x5110}] = TagInfo{Tag: Tag{0x0000, 0x5110}, VR: "LO", Name: "ACR_NEMA_DisplayFormat", VM: "1"}
+	tagDict[Tag{0x0000, 0x5120}] = TagInfo{Tag: Tag{0x0000, 0x5120}, VR: "LO", Name: "ACR_NEMA_PagePositionID", VM: "1"}
+	tagDict[Tag{0x0000, 0x5130}] = TagInfo{Tag: Tag{0x0000, 0x5130}, VR: "LO", Name: "ACR_NEMA_TextFormatID", VM: "1"}
+	tagDict[Tag{0x0000, 0x5140}] = TagInfo{Tag: Tag{0x0000, 0x5140}, VR: "CS", Name: "ACR_NEMA_NormalReverse", VM: "1"}
+	tagDict[Tag{0x0000, 0x5150}] = TagInfo{Tag: Tag{0x0000, 0x5150}, VR: "CS", Name: "ACR_NEMA_AddGrayScale", VM: "1"}
+	tagDict[Tag{0x0000, 0x5160}] = TagInfo{Tag: Tag{0x0000, 0x5160}, VR: "CS", Name: "ACR_NEMA_Borders", VM: "1"}
+	tagDict[Tag{0x0000, 0x5170}] = TagInfo{Tag: Tag{0x0000, 0x5170}, VR: "IS", Name: "ACR_NEMA_Copies", VM: "1"}
+	tagDict[Tag{0x0000, 0x5180}] = TagInfo{Tag: Tag{0x0000, 0x5180}, VR: "LO", Name: "ACR_NEMA_MagnificationType", VM: "1"}
+	tagDict[Tag{0x0000, 0x5190}] = TagInfo{Tag: Tag{0x0000, 0x5190}, VR: "LO", Name: "ACR_NEMA_Erase", VM: "1-n"}
+	tagDict[Tag{0x0000, 0x51A0}] = TagInfo{Tag: Tag{0x0000, 0x51A0}, VR: "CS", Name: "ACR_NEMA_Print", VM: "1"}
+	tagDict[Tag{0x0000, 0x51B0}] = TagInfo{Tag: Tag{0x0000, 0x51B0}, VR: "US", Name: "ACR_NEMA_Overlays", VM: "1-n"}
+	tagDict[Tag{0x0008, 0x0001}] = TagInfo{Tag: Tag{0x0008, 0x0001}, VR: "UL", Name: "ACR_NEMA_IdentifyingGroupLengthToEnd", VM: "1"}
+	tagDict[Tag{0x0008, 0x0010}] = TagInfo{Tag: Tag{0x0008, 0x0010}, VR: "LO", Name: "ACR_NEMA_RecognitionCode", VM: "1"}
+	tagDict[Tag{0x0008, 0x0040}] = TagInfo{Tag: Tag{0x0008, 0x0040}, VR: "US", Name: "ACR_NEMA_OldDataSetType", VM: "1"}
+	tagDict[Tag{0x0008, 0x0041}] = TagInfo{Tag: Tag{0x0008, 0x0041}, VR: "LO", Name: "ACR_NEMA_DataSetSubtype", VM: "1"}
+	tagDict[Tag{0x0008, 0x1000}] = TagInfo{Tag: Tag{0x0008, 0x1000}, VR: "LO", Name: "ACR_NEMA_NetworkID", VM: "1"}
+	tagDict[Tag{0x0008, 0x4000}] = TagInfo{Tag: Tag{0x0008, 0x4000}, VR: "LT", Name: "ACR_NEMA_IdentifyingComments", VM: "1-n"}
+	tagDict[Tag{0x0010, 0x1050}] = TagInfo{Tag: Tag{0x0010, 0x1050}, VR: "LT", Name: "ACR_NEMA_InsurancePlanIdentification", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x1240}] = TagInfo{Tag: Tag{0x0018, 0x1240}, VR: "IS", Name: "ACR_NEMA_UpperLowerPixelValues", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x4000}] = TagInfo{Tag: Tag{0x0018, 0x4000}, VR: "LT", Name: "ACR_NEMA_AcquisitionComments", VM: "1-n"}
+	tagDict[Tag{0x0018, 0x5030}] = TagInfo{Tag: Tag{0x0018, 0x5030}, VR: "DS", Name: "ACR_NEMA_DynamicRange", VM: "1"}
+	tagDict[Tag{0x0018, 0x5040}] = TagInfo{Tag: Tag{0x0018, 0x5040}, VR: "DS", Name: "ACR_NEMA_TotalGain", VM: "1"}
+	tagDict[Tag{0x0020, 0x0030}] = TagInfo{Tag: Tag{0x0020, 0x0030}, VR: "DS", Name: "ACR_NEMA_ImagePosition", VM: "3"}
+	tagDict[Tag{0x0020, 0x0035}] = TagInfo{Tag: Tag{0x0020, 0x0035}, VR: "DS", Name: "ACR_NEMA_ImageOrientation", VM: "6"}
+	tagDict[Tag{0x0020, 0x0050}] = TagInfo{Tag: Tag{0x0020, 0x0050}, VR: "DS", Name: "ACR_NEMA_Location", VM: "1"}
+	tagDict[Tag{0x0020, 0x0070}] = TagInfo{Tag: Tag{0x0020, 0x0070}, VR: "LO", Name: "ACR_NEMA_ImageGeometryType", VM: "1"}
+	tagDict[Tag{0x0020, 0x0080}] = TagInfo{Tag: Tag{0x0020, 0x0080}, VR: "LO", Name: "ACR_NEMA_MaskingImage", VM: "1-n"}
+	tagDict[Tag{0x0020, 0x1001}] = TagInfo{Tag: Tag{0x0020, 0x1001}, VR: "IS", Name: "ACR_NEMA_AcquisitionsInSeries", VM: "1"}
+	tagDict[Tag{0x0020, 0x1003}] = TagInfo{Tag: Tag{0x0020, 0x1003}, VR: "IS", Name: "ACR_NEMA_ImagesInSeries", VM: "1"}
+	tagDict[Tag{0x0020, 0x1005}] = TagInfo{Tag: Tag{0x0020, 0x1005}, VR: "IS", Name: "ACR_NEMA_ImagesInStudy", VM: "1"}
+	tagDict[Tag{0x0020, 0x1020}] = TagInfo{Tag: Tag{0x0020, 0x1020}, VR: "LO", Name: "ACR_NEMA_Reference", VM: "1-n"}
+	tagDict[Tag{0x0020, 0x3401}] = TagInfo{Tag: Tag{0x0020, 0x3401}, VR: "LO", Name: "ACR_NEMA_ModifyingDeviceID", VM: "1"}
+	tagDict[Tag{0x0020, 0x3402}] = TagInfo{Tag: Tag{0x0020, 0x3402}, VR: "LO", Name: "ACR_NEMA_ModifiedImageID", VM: "1"}
+	tagDict[Tag{0x0020, 0x3403}] = TagInfo{Tag: Tag{0x0020, 0x3403}, VR: "DA", Name: "ACR_NEMA_ModifiedImageDate", VM: "1"}
+	tagDict[Tag{0x0020, 0x3404}] = TagInfo{Tag: Tag{0x0020, 0x3404}, VR: "LO", Name: "ACR_NEMA_ModifyingDeviceManufacturer", VM: "1"}
+	tagDict[Tag{0x0020, 0x3405}] = TagInfo{Tag: Tag{0x0020, 0x3405}, VR: "TM", Name: "ACR_NEMA_ModifiedImageTime", VM: "1"}
+	tagDict[Tag{0x0020, 0x3406}] = TagInfo{Tag: Tag{0x0020, 0x3406}, VR: "LO", Name: "ACR_NEMA_ModifiedImageDescription", VM: "1"}
+	tagDict[Tag{0x0020, 0x5000}] = TagInfo{Tag: Tag{0x0020, 0x5000}, VR: "AT", Name: "ACR_NEMA_OriginalImageIdentification", VM: "1-n"}
+	tagDict[Tag{0x0020, 0x5002}] = TagInfo{Tag: Tag{0x0020, 0x5002}, VR: "LO", Name: "ACR_NEMA_OriginalImageIdentificationNomenclature", VM: "1-n"}
+	tagDict[Tag{0x0028, 0x0005}] = TagInfo{Tag: Tag{0x0028, 0x0005}, VR: "US", Name: "ACR_NEMA_ImageDimensions", VM: "1"}
+	tagDict[Tag{0x0028, 0x0040}] = TagInfo{Tag: Tag{0x0028, 0x0040}, VR: "CS", Name: "ACR_NEMA_ImageFormat", VM: "1"}
+	tagDict[Tag{0x0028, 0x0050}] = TagInfo{Tag: Tag{0x0028, 0x0050}, VR: "LO", Name: "ACR_NEMA_ManipulatedImage", VM: "1-n"}
+	tagDict[Tag{0x0028, 0x0060}] = TagInfo{Tag: Tag{0x0028, 0x0060}, VR: "CS", Name: "ACR_NEMA_CompressionCode", VM: "1"}
+	tagDict[Tag{0x0028, 0x0104}] = TagInfo{Tag: Tag{0x0028, 0x0104}, VR: "US", VRs: []string{"US", "SS"}, Name: "ACR_NEMA_SmallestValidPixelValue", VM: "1"}
+	tagDict[Tag{0x0028, 0x0105}] = TagInfo{Tag: Tag{0x0028, 0x0105}, VR: "US", VRs: []string{"US", "SS"}, Name: "ACR_NEMA_LargestValidPixelValue", VM: "1"}
+	tagDict[Tag{0x0028, 0x0200}] = TagInfo{Tag: Tag{0x0028, 0x0200}, VR: "US", Name: "ACR_NEMA_ImageLocation", VM: "1"}
+	tagDict[Tag{0x0028, 0x1080}] = TagInfo{Tag: Tag{0x0028, 0x1080}, VR: "CS", Name: "ACR_NEMA_GrayScale", VM: "1"}
+	tagDict[Tag{0x0028, 0x1100}] = TagInfo{Tag: Tag{0x0028, 0x1100}, VR: "US", VRs: []string{"US", "SS"}, Name: "ACR_NEMA_GrayLookupTableDescriptor", VM: "3"}
+	tagDict[Tag{0x0028, 0x1200}] = TagInfo{Tag: Tag{0x0028, 0x1200}, VR: "US", VRs: []string{"US", "SS"}, Name: "ACR_NEMA_GrayLookupTableData", VM: "1-n"}
+	tagDict[Tag{0x0028, 0x4000}] = TagInfo{Tag: Tag{0x0028, 0x4000}, VR: "LT", Name: "ACR_NEMA_ImagePresentationComments", VM: "1-n"}
+	tagDict[Tag{0x4000, 0x0000}] = TagInfo{Tag: Tag{0x4000, 0x0000}, VR: "UL", Name: "ACR_NEMA_TextGroupLength", VM: "1"}
+	tagDict[Tag{0x4000, 0x0010}] = TagInfo{Tag: Tag{0x4000, 0x0010}, VR: "LT", Name: "ACR_NEMA_TextArbitrary", VM: "1-n"}
+	tagDict[Tag{0x4000, 0x4000}] = TagInfo{Tag: Tag{0x4000, 0x4000}, VR: "LT", Name: "ACR_NEMA_TextComments", VM: "1-n"}
+	tagDict[Tag{0x0028, 0x005F}] = TagInfo{Tag: Tag{0x0028, 0x005F}, VR: "CS", Name: "ACR_NEMA_2C_CompressionRecognitionCode", VM: "1"}
+	tagDict[Tag{0x0028, 0x0061}] = TagInfo{Tag: Tag{0x0028, 0x0061}, VR: "SH", Name: "ACR_NEMA_2C_CompressionOriginator", VM: "1"}
+	tagDict[Tag{0x0028, 0x0062}] = TagInfo{Tag: Tag{0x0028, 0x0062}, VR: "SH", Name: "ACR_NEMA_2C_CompressionLabel", VM: "1"}
+	tagDict[Tag{0x0028, 0x0063}] = TagInfo{Tag: Tag{0x0028, 0x0063}, VR: "SH", Name: "ACR_NEMA_2C_CompressionDescription", VM: "1"}
+	tagDict[Tag{0x0028, 0x0065}] = TagInfo{Tag: Tag{0x0028, 0x0065}, VR: "CS", Name: "ACR_NEMA_2C_CompressionSequence", VM: "1-n"}
+	tagDict[Tag{0x0028, 0x0066}] = TagInfo{Tag: Tag{0x0028, 0x0066}, VR: "AT", Name: "ACR_NEMA_2C_CompressionStepPointers", VM: "1-n"}
+	tagDict[Tag{0x0028, 0x0068}] = TagInfo{Tag: Tag{0x0028, 0x0068}, VR: "US", Name: "ACR_NEMA_2C_RepeatInterval", VM: "1"}
+	tagDict[Tag{0x0028, 0x0069}] = TagInfo{Tag: Tag{0x0028, 0x0069}, VR: "US", Name: "ACR_NEMA_2C_BitsGrouped", VM: "1"}
+	tagDict[Tag{0x0028, 0x0070}] = TagInfo{Tag: Tag{0x0028, 0x0070}, VR: "US", Name: "ACR_NEMA_2C_PerimeterTable", VM: "1-n"}
+	tagDict[Tag{0x0028, 0x0071}] = TagInfo{Tag: Tag{0x0028, 0x0071}, VR: "US", VRs: []string{"US", "SS"}, Name: "ACR_NEMA_2C_PerimeterValue", VM: "1"}
+	tagDict[Tag{0x0028, 0x0080}] = TagInfo{Tag: Tag{0x0028, 0x0080}, VR: "US", Name: "ACR_NEMA_2C_PredictorRows", VM: "1"}
+	tagDict[Tag{0x0028, 0x0081}] = TagInfo{Tag: Tag{0x0028, 0x0081}, VR: "US", Name: "ACR_NEMA_2C_PredictorColumns", VM: "1"}
+	tagDict[Tag{0x0028, 0x0082}] = TagInfo{Tag: Tag{0x0028, 0x0082}, VR: "US", Name: "ACR_NEMA_2C_PredictorConstants", VM: "1-n"}
+	tagDict[Tag{0x0028, 0x0090}] = TagInfo{Tag: Tag{0x0028, 0x0090}, VR: "CS", Name: "ACR_NEMA_2C_BlockedPixels", VM: "1"}
+	tagDict[Tag{0x0028, 0x0091}] = TagInfo{Tag: Tag{0x0028, 0x0091}, VR: "US", Name: "ACR_NEMA_2C_BlockRows", VM: "1"}
+	tagDict[Tag{0x0028, 0x0092}] = TagInfo{Tag: Tag{0x0028, 0x0092}, VR: "US", Name: "ACR_NEMA_2C_BlockColumns", VM: "1"}
+	tagDict[Tag{0x0028, 0x0093}] = TagInfo{Tag: Tag{0x0028, 0x0093}, VR: "US", Name: "ACR_NEMA_2C_RowOverlap", VM: "1"}
+	tagDict[Tag{0x0028, 0x0094}] = TagInfo{Tag: Tag{0x0028, 0x0094}, VR: "US", Name: "ACR_NEMA_2C_ColumnOverlap", VM: "1"}
+	tagDict[Tag{0x0028, 0x0400}] = TagInfo{Tag: Tag{0x0028, 0x0400}, VR: "CS", Name: "ACR_NEMA_2C_TransformLabel", VM: "1"}
+	tagDict[Tag{0x0028, 0x0401}] = TagInfo{Tag: Tag{0x0028, 0x0401}, VR: "CS", Name: "ACR_NEMA_2C_TransformVersionNumber", VM: "1"}
+	tagDict[Tag{0x0028, 0x0402}] = TagInfo{Tag: Tag{0x0028, 0x0402}, VR: "US", Name: "ACR_NEMA_2C_NumberOfTransformSteps", VM: "1"}
+	tagDict[Tag{0x0028, 0x0403}] = TagInfo{Tag: Tag{0x0028, 0x0403}, VR: "CS", Name: "ACR_NEMA_2C_SequenceOfCompressedData", VM: "1-n"}
+	tagDict[Tag{0x0028, 0x0404}] = TagInfo{Tag: Tag{0x0028, 0x0404}, VR: "AT", Name: "ACR_NEMA_2C_DetailsOfCoefficients", VM: "1-n"}
+	tagDict[Tag{0x0028, 0x0410}] = TagInfo{Tag: Tag{0x0028, 0x0410}, VR: "US", Name: "ACR_NEMA_2C_RowsForNthOrderCoefficients", VM: "1"}
+	tagDict[Tag{0x0028, 0x0411}] = TagInfo{Tag: Tag{0x0028, 0x0411}, VR: "US", Name: "ACR_NEMA_2C_ColumnsForNthOrderCoefficients", VM: "1"}
+	tagDict[Tag{0x0028, 0x0412}] = TagInfo{Tag: Tag{0x0028, 0x0412}, VR: "CS", Name: "ACR_NEMA_2C_CoefficientCoding", VM: "1-n"}
+	tagDict[Tag{0x0028, 0x0413}] = TagInfo{Tag: Tag{0x0028, 0x0413}, VR: "AT", Name: "ACR_NEMA_2C_CoefficientCodingPointers", VM: "1-n"}
+	tagDict[Tag{0x0028, 0x0700}] = TagInfo{Tag: Tag{0x0028, 0x0700}, VR: "CS", Name: "ACR_NEMA_2C_DCTLabel", VM: "1"}
+	tagDict[Tag{0x0028, 0x0701}] = TagInfo{Tag: Tag{0x0028, 0x0701}, VR: "CS", Name: "ACR_NEMA_2C_DataBlockDescription", VM: "1-n"}
+	tagDict[Tag{0x0028, 0x0702}] = TagInfo{Tag: Tag{0x0028, 0x0702}, VR: "AT", Name: "ACR_NEMA_2C_DataBlock", VM: "1-n"}
+	tagDict[Tag{0x0028, 0x0710}] = TagInfo{Tag: Tag{0x0028, 0x0710}, VR: "US", Name: "ACR_NEMA_2C_NormalizationFactorFormat", VM: "1"}
+	tagDict[Tag{0x0028, 0x0720}] = TagInfo{Tag: Tag{0x0028, 0x0720}, VR: "US", Name: "ACR_NEMA_2C_ZonalMapNumberFormat", VM: "1"}
+	tagDict[Tag{0x0028, 0x0721}] = TagInfo{Tag: Tag{0x0028, 0x0721}, VR: "AT", Name: "ACR_NEMA_2C_ZonalMapLocation", VM: "1-n"}
+	tagDict[Tag{0x0028, 0x0722}] = TagInfo{Tag: Tag{0x0028, 0x0722}, VR: "US", Name: "ACR_NEMA_2C_ZonalMapFormat", VM: "1"}
+	tagDict[Tag{0x0028, 0x0730}] = TagInfo{Tag: Tag{0x0028, 0x0730}, VR: "US", Name: "ACR_NEMA_2C_AdaptiveMapFormat", VM: "1"}
+	tagDict[Tag{0x0028, 0x0740}] = TagInfo{Tag: Tag{0x0028, 0x0740}, VR: "US", Name: "ACR_NEMA_2C_CodeNumberFormat", VM: "1"}
+	tagDict[Tag{0x0028, 0x0800}] = TagInfo{Tag: Tag{0x0028, 0x0800}, VR: "CS", Name: "ACR_NEMA_2C_CodeLabel", VM: "1-n"}
+	tagDict[Tag{0x0028, 0x0802}] = TagInfo{Tag: Tag{0x0028, 0x0802}, VR: "US", Name: "ACR_NEMA_2C_NumberOfTables", VM: "1"}
+	tagDict[Tag{0x0028, 0x0803}] = TagInfo{Tag: Tag{0x0028, 0x0803}, VR: "AT", Name: "ACR_NEMA_2C_CodeTableLocation", VM: "1-n"}
+	tagDict[Tag{0x0028, 0x0804}] = TagInfo{Tag: Tag{0x0028, 0x0804}, VR: "US", Name: "ACR_NEMA_2C_BitsForCodeWord", VM: "1"}
+	tagDict[Tag{0x0028, 0x0808}] = TagInfo{Tag: Tag{0x0028, 0x0808}, VR: "AT", Name: "ACR_NEMA_2C_ImageDataLocation", VM: "1-n"}
+	tagDict[Tag{0x1000, 0x0000}] = TagInfo{Tag: Tag{0x1000, 0x0000}, VR: "UL", Name: "ACR_NEMA_2C_CodeTableGroupLength", VM: "1"}
+	tagDict[Tag{0x1000, 0x0010}] = TagInfo{Tag: Tag{0x1000, 0x0010}, VR: "US", Name: "ACR_NEMA_2C_EscapeTriplet", VM: "3"}
+	tagDict[Tag{0x1000, 0x0011}] = TagInfo{Tag: Tag{0x1000, 0x0011}, VR: "US", Name: "ACR_NEMA_2C_RunLengthTriplet", VM: "3"}
+	tagDict[Tag{0x1000, 0x0012}] = TagInfo{Tag: Tag{0x1000, 0x0012}, VR: "US", Name: "ACR_NEMA_2C_HuffmanTableSize", VM: "1"}
+	tagDict[Tag{0x1000, 0x0013}] = TagInfo{Tag: Tag{0x1000, 0x0013}, VR: "US", Name: "ACR_NEMA_2C_HuffmanTableTriplet", VM: "3"}
+	tagDict[Tag{0x1000, 0x0014}] = TagInfo{Tag: Tag{0x1000, 0x0014}, VR: "US", Name: "ACR_NEMA_2C_ShiftTableSize", VM: "1"}
+	tagDict[Tag{0x1000, 0x0015}] = TagInfo{Tag: Tag{0x1000, 0x0015}, VR: "US", Name: "ACR_NEMA_2C_ShiftTableTriplet", VM: "3"}
+	tagDict[Tag{0x1010, 0x0000}] = TagInfo{Tag: Tag{0x1010, 0x0000}, VR: "UL", Name: "ACR_NEMA_2C_ZonalMapGroupLength", VM: "1"}
+	tagDict[Tag{0x1010, 0x0004}] = TagInfo{Tag: Tag{0x1010, 0x0004}, VR: "US", Name: "ACR_NEMA_2C_ZonalMap", VM: "1-n"}
+	tagDict[Tag{0x7FE0, 0x0020}] = TagInfo{Tag: Tag{0x7FE0, 0x0020}, VR: "OW", Name: "ACR_NEMA_2C_CoefficientsSDVN", VM: "1-n"}
+	tagDict[Tag{0x7FE0, 0x0030}] = TagInfo{Tag: Tag{0x7FE0, 0x0030}, VR: "OW", Name: "ACR_NEMA_2C_CoefficientsSDHN", VM: "1-n"}
+	tagDict[Tag{0x7FE0, 0x0040}] = TagInfo{Tag: Tag{0x7FE0, 0x0040}, VR: "OW", Name: "ACR_NEMA_2C_CoefficientsSDDN", VM: "1-n"}
+	tagDict[Tag{0x0000, 0x0001}] = TagInfo{Tag: Tag{0x0000, 0x0001}, VR: "UL", Name: "RETIRED_CommandLengthToEnd", VM: "1", Retired: true}
+	tagDict[Tag{0x0000, 0x0010}] = TagInfo{Tag: Tag{0x0000, 0x0010}, VR: "SH", Name: "RETIRED_CommandRecognitionCode", VM: "1", Retired: true}
+	tagDict[Tag{0x0000, 0x0200}] = TagInfo{Tag: Tag{0x0000, 0x0200}, VR: "AE", Name: "RETIRED_Initiator", VM: "1", Retired: true}
+	tagDict[Tag{0x0000, 0x0300}] = TagInfo{Tag: Tag{0x0000, 0x0300}, VR: "AE", Name: "RETIRED_Receiver", VM: "1", Retired: true}
+	tagDict[Tag{0x0000, 0x0400}] = TagInfo{Tag: Tag{0x0000, 0x0400}, VR: "AE", Name: "RETIRED_FindLocation", VM: "1", Retired: true}
+	tagDict[Tag{0x0000, 0x0850}] = TagInfo{Tag: Tag{0x0000, 0x0850}, VR: "US", Name: "RETIRED_NumberOfMatches", VM: "1", Retired: true}
+	tagDict[Tag{0x0000, 0x0860}] = TagInfo{Tag: Tag{0x0000, 0x0860}, VR: "US", Name: "RETIRED_ResponseSequenceNumber", VM: "1", Retired: true}
+	tagDict[Tag{0x0000, 0x4000}] = TagInfo{Tag: Tag{0x0000, 0x4000}, VR: "AT", Name: "RETIRED_DialogReceiver", VM: "1", Retired: true}
+	tagDict[Tag{0x0000, 0x4010}] = TagInfo{Tag: Tag{0x0000, 0x4010}, VR: "AT", Name: "RETIRED_TerminalType", VM: "1", Retired: true}
+	tagDict[Tag{0x0000, 0x5010}] = TagInfo{Tag: Tag{0x0000, 0x5010}, VR: "SH", Name: "RETIRED_MessageSetID", VM: "1", Retired: true}
+	tagDict[Tag{0x0000, 0x5020}] = TagInfo{Tag: Tag{0x0000, 0x5020}, VR: "SH", Name: "RETIRED_EndMessageID", VM: "1", Retired: true}
+	tagDict[Tag{0x0000, 0x5110}] = TagInfo{Tag: Tag{0x0000, 0x5110}, VR: "AT", Name: "RETIRED_DisplayFormat", VM: "1", Retired: true}
+	tagDict[Tag{0x0000, 0x5120}] = TagInfo{Tag: Tag{0x0000, 0x5120}, VR: "AT", Name: "RETIRED_PagePositionID", VM: "1", Retired: true}
+	tagDict[Tag{0x0000, 0x5130}] = TagInfo{Tag: Tag{0x0000, 0x5130}, VR: "CS", Name: "RETIRED_TextFormatID", VM: "1", Retired: true}
+	tagDict[Tag{0x0000, 0x5140}] = TagInfo{Tag: Tag{0x0000, 0x5140}, VR: "CS", Name: "RETIRED_NormalReverse", VM: "1", Retired: true}
+	tagDict[Tag{0x0000, 0x5150}] = TagInfo{Tag: Tag{0x0000, 0x5150}, VR: "CS", Name: "RETIRED_AddGrayScale", VM: "1", Retired: true}
+	tagDict[Tag{0x0000, 0x5160}] = TagInfo{Tag: Tag{0x0000, 0x5160}, VR: "CS", Name: "RETIRED_Borders", VM: "1", Retired: true}
+	tagDict[Tag{0x0000, 0x5170}] = TagInfo{Tag: Tag{0x0000, 0x5170}, VR: "IS", Name: "RETIRED_Copies", VM: "1", Retired: true}
+	tagDict[Tag{0x0000, 0x5180}] = TagInfo{Tag: Tag{0x0000, 0x5180}, VR: "CS", Name: "RETIRED_CommandMagnificationType", VM: "1", Retired: true}
+	tagDict[Tag{0x0000, 0x5190}] = TagInfo{Tag: Tag{0x0000, 0x5190}, VR: "CS", Name: "RETIRED_Erase", VM: "1", Retired: true}
+	tagDict[Tag{0x0000, 0x51A0}] = TagInfo{Tag: Tag{0x0000, 0x51A0}, VR: "CS", Name: "RETIRED_Print", VM: "1", Retired: true}
+	tagDict[Tag{0x0000, 0x51B0}] = TagInfo{Tag: Tag{0x0000, 0x51B0}, VR: "US", Name: "RETIRED_Overlays", VM: "1-n", Retired: true}
+	tagDict[Tag{0x0004, 0x1504}] = TagInfo{Tag: Tag{0x0004, 0x1504}, VR: "UL", Name: "RETIRED_MRDRDirectoryRecordOffset", VM: "1", Retired: true}
+	tagDict[Tag{0x0004, 0x1600}] = TagInfo{Tag: Tag{0x0004, 0x1600}, VR: "UL", Name: "RETIRED_NumberOfReferences", VM: "1", Retired: true}
+	tagDict[Tag{0x0008, 0x0001}] = TagInfo{Tag: Tag{0x0008, 0x0001}, VR: "UL", Name: "RETIRED_LengthToEnd", VM: "1", Retired: true}
+	tagDict[Tag{0x0008, 0x0010}] = TagInfo{Tag: Tag{0x0008, 0x0010}, VR: "SH", Name: "RETIRED_RecognitionCode", VM: "1", Retired: true}
+	tagDict[Tag{0x0008, 0x0024}] = TagInfo{Tag: Tag{0x0008, 0x0024}, VR: "DA", Name: "RETIRED_OverlayDate", VM: "1", Retired: true}
+	tagDict[Tag{0x0008, 0x0025}] = TagInfo{Tag: Tag{0x0008, 0x0025}, VR: "DA", Name: "RETIRED_CurveDate", VM: "1", Retired: true}
+	tagDict[Tag{0x0008, 0x0034}] = TagInfo{Tag: Tag{0x0008, 0x0034}, VR: "TM", Name: "RETIRED_OverlayTime", VM: "1", Retired: true}
+	tagDict[Tag{0x0008, 0x0035}] = TagInfo{Tag: Tag{0x0008, 0x0035}, VR: "TM", Name: "RETIRED_CurveTime", VM: "1", Retired: true}
+	tagDict[Tag{0x0008, 0x0040}] = TagInfo{Tag: Tag{0x0008, 0x0040}, VR: "US", Name: "RETIRED_DataSetType", VM: "1", Retired: true}
+	tagDict[Tag{0x0008, 0x0041}] = TagInfo{Tag: Tag{0x0008, 0x0041}, VR: "LO", Name: "RETIRED_DataSetSubtype", VM: "1", Retired: true}
+	tagDict[Tag{0x0008, 0x0042}] = TagInfo{Tag: Tag{0x0008, 0x0042}, VR: "CS", Name: "RETIRED_NuclearMedicineSeriesType", VM: "1", Retired: true}
+	tagDict[Tag{0x0008, 0x1000}] = TagInfo{Tag: Tag{0x0008, 0x1000}, VR: "AE", Name: "RETIRED_NetworkID", VM: "1", Retired: true}
+	tagDict[Tag{0x0008, 0x1100}] = TagInfo{Tag: Tag{0x0008, 0x1100}, VR: "SQ", Name: "RETIRED_ReferencedResultsSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x0008, 0x1130}] = TagInfo{Tag: Tag{0x0008, 0x1130}, VR: "SQ", Name: "RETIRED_ReferencedOverlaySequence", VM: "1", Retired: true}
+	tagDict[Tag{0x0008, 0x1145}] = TagInfo{Tag: Tag{0x0008, 0x1145}, VR: "SQ", Name: "RETIRED_ReferencedCurveSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x0008, 0x2110}] = TagInfo{Tag: Tag{0x0008, 0x2110}, VR: "CS", Name: "RETIRED_LossyImageCompressionRetired", VM: "1", Retired: true}
+	tagDict[Tag{0x0008, 0x2200}] = TagInfo{Tag: Tag{0x0008, 0x2200}, VR: "CS", Name: "RETIRED_TransducerPosition", VM: "1", Retired: true}
+	tagDict[Tag{0x0008, 0x2204}] = TagInfo{Tag: Tag{0x0008, 0x2204}, VR: "CS", Name: "RETIRED_TransducerOrientation", VM: "1", Retired: true}
+	tagDict[Tag{0x0008, 0x2208}] = TagInfo{Tag: Tag{0x0008, 0x2208}, VR: "CS", Name: "RETIRED_AnatomicStructure", VM: "1", Retired: true}
+	tagDict[Tag{0x0008, 0x2240}] = TagInfo{Tag: Tag{0x0008, 0x2240}, VR: "SQ", Name: "RETIRED_TransducerPositionSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x0008, 0x2242}] = TagInfo{Tag: Tag{0x0008, 0x2242}, VR: "SQ", Name: "RETIRED_TransducerPositionModifierSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x0008, 0x2244}] = TagInfo{Tag: Tag{0x0008, 0x2244}, VR: "SQ", Name: "RETIRED_TransducerOrientationSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x0008, 0x2246}] = TagInfo{Tag: Tag{0x0008, 0x2246}, VR: "SQ", Name: "RETIRED_TransducerOrientationModifierSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x0008, 0x2251}] = TagInfo{Tag: Tag{0x0008, 0x2251}, VR: "SQ", Name: "RETIRED_AnatomicStructureSpaceOrRegionCodeSequenceTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0008, 0x2253}] = TagInfo{Tag: Tag{0x0008, 0x2253}, VR: "SQ", Name: "RETIRED_AnatomicPortalOfEntranceCodeSequenceTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0008, 0x2255}] = TagInfo{Tag: Tag{0x0008, 0x2255}, VR: "SQ", Name: "RETIRED_AnatomicApproachDirectionCodeSequenceTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0008, 0x2256}] = TagInfo{Tag: Tag{0x0008, 0x2256}, VR: "ST", Name: "RETIRED_AnatomicPerspectiveDescriptionTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0008, 0x2257}] = TagInfo{Tag: Tag{0x0008, 0x2257}, VR: "SQ", Name: "RETIRED_AnatomicPerspectiveCodeSequenceTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0008, 0x2258}] = TagInfo{Tag: Tag{0x0008, 0x2258}, VR: "ST", Name: "RETIRED_AnatomicLocationOfExaminingInstrumentDescriptionTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0008, 0x2259}] = TagInfo{Tag: Tag{0x0008, 0x2259}, VR: "SQ", Name: "RETIRED_AnatomicLocationOfExaminingInstrumentCodeSequenceTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0008, 0x225A}] = TagInfo{Tag: Tag{0x0008, 0x225A}, VR: "SQ", Name: "RETIRED_AnatomicStructureSpaceOrRegionModifierCodeSequenceTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0008, 0x225C}] = TagInfo{Tag: Tag{0x0008, 0x225C}, VR: "SQ", Name: "RETIRED_OnAxisBackgroundAnatomicStructureCodeSequenceTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0008, 0x4000}] = TagInfo{Tag: Tag{0x0008, 0x4000}, VR: "LT", Name: "RETIRED_IdentifyingComments", VM: "1", Retired: true}
+	tagDict[Tag{0x0010, 0x1050}] = TagInfo{Tag: Tag{0x0010, 0x1050}, VR: "LO", Name: "RETIRED_InsurancePlanIdentification", VM: "1-n", Retired: true}
+	tagDict[Tag{0x0018, 0x0030}] = TagInfo{Tag: Tag{0x0018, 0x0030}, VR: "LO", Name: "RETIRED_Radionuclide", VM: "1-n", Retired: true}
+	tagDict[Tag{0x0018, 0x0032}] = TagInfo{Tag: Tag{0x0018, 0x0032}, VR: "DS", Name: "RETIRED_EnergyWindowCenterline", VM: "1", Retired: true}
+	tagDict[Tag{0x0018, 0x0033}] = TagInfo{Tag: Tag{0x0018, 0x0033}, VR: "DS", Name: "RETIRED_EnergyWindowTotalWidth", VM: "1-n", Retired: true}
+	tagDict[Tag{0x0018, 0x0037}] = TagInfo{Tag: Tag{0x0018, 0x0037}, VR: "CS", Name: "RETIRED_TherapyType", VM: "1", Retired: true}
+	tagDict[Tag{0x0018, 0x0039}] = TagInfo{Tag: Tag{0x0018, 0x0039}, VR: "CS", Name: "RETIRED_TherapyDescription", VM: "1", Retired: true}
+	tagDict[Tag{0x0018, 0x1011}] = TagInfo{Tag: Tag{0x0018, 0x1011}, VR: "LO", Name: "RETIRED_HardcopyCreationDeviceID", VM: "1", Retired: true}
+	tagDict[Tag{0x0018, 0x1017}] = TagInfo{Tag: Tag{0x0018, 0x1017}, VR: "LO", Name: "RETIRED_HardcopyDeviceManufacturer", VM: "1", Retired: true}
+	tagDict[Tag{0x0018, 0x101A}] = TagInfo{Tag: Tag{0x0018, 0x101A}, VR: "LO", Name: "RETIRED_HardcopyDeviceSoftwareVersion", VM: "1-n", Retired: true}
+	tagDict[Tag{0x0018, 0x101B}] = TagInfo{Tag: Tag{0x0018, 0x101B}, VR: "LO", Name: "RETIRED_HardcopyDeviceManufacturerModelName", VM: "1", Retired: true}
+	tagDict[Tag{0x0018, 0x1141}] = TagInfo{Tag: Tag{0x0018, 0x1141}, VR: "DS", Name: "RETIRED_AngularPosition", VM: "1", Retired: true}
+	tagDict[Tag{0x0018, 0x1146}] = TagInfo{Tag: Tag{0x0018, 0x1146}, VR: "DS", Name: "RETIRED_RotationOffset", VM: "1-n", Retired: true}
+	tagDict[Tag{0x0018, 0x1240}] = TagInfo{Tag: Tag{0x0018, 0x1240}, VR: "IS", Name: "RETIRED_UpperLowerPixelValues", VM: "1-n", Retired: true}
+	tagDict[Tag{0x0018, 0x4000}] = TagInfo{Tag: Tag{0x0018, 0x4000}, VR: "LT", Name: "RETIRED_AcquisitionComments", VM: "1", Retired: true}
+	tagDict[Tag{0x0018, 0x5021}] = TagInfo{Tag: Tag{0x0018, 0x5021}, VR: "LO", Name: "RETIRED_PostprocessingFunction", VM: "1", Retired: true}
+	tagDict[Tag{0x0018, 0x5030}] = TagInfo{Tag: Tag{0x0018, 0x5030}, VR: "DS", Name: "RETIRED_DynamicRange", VM: "1", Retired: true}
+	tagDict[Tag{0x0018, 0x5040}] = TagInfo{Tag: Tag{0x0018, 0x5040}, VR: "DS", Name: "RETIRED_TotalGain", VM: "1", Retired: true}
+	tagDict[Tag{0x0018, 0x5210}] = TagInfo{Tag: Tag{0x0018, 0x5210}, VR: "DS", Name: "RETIRED_ImageTransformationMatrix", VM: "6", Retired: true}
+	tagDict[Tag{0x0018, 0x5212}] = TagInfo{Tag: Tag{0x0018, 0x5212}, VR: "DS", Name: "RETIRED_ImageTranslationVector", VM: "3", Retired: true}
+	tagDict[Tag{0x0018, 0x6038}] = TagInfo{Tag: Tag{0x0018, 0x6038}, VR: "UL", Name: "RETIRED_DopplerSampleVolumeXPositionRetired", VM: "1", Retired: true}
+	tagDict[Tag{0x0018, 0x603A}] = TagInfo{Tag: Tag{0x0018, 0x603A}, VR: "UL", Name: "RETIRED_DopplerSampleVolumeYPositionRetired", VM: "1", Retired: true}
+	tagDict[Tag{0x0018, 0x603C}] = TagInfo{Tag: Tag{0x0018, 0x603C}, VR: "UL", Name: "RETIRED_TMLinePositionX0Retired", VM: "1", Retired: true}
+	tagDict[Tag{0x0018, 0x603E}] = TagInfo{Tag: Tag{0x0018, 0x603E}, VR: "UL", Name: "RETIRED_TMLinePositionY0Retired", VM: "1", Retired: true}
+	tagDict[Tag{0x0018, 0x6040}] = TagInfo{Tag: Tag{0x0018, 0x6040}, VR: "UL", Name: "RETIRED_TMLinePositionX1Retired", VM: "1", Retired: true}
+	tagDict[Tag{0x0018, 0x6042}] = TagInfo{Tag: Tag{0x0018, 0x6042}, VR: "UL", Name: "RETIRED_TMLinePositionY1Retired", VM: "1", Retired: true}
+	tagDict[Tag{0x0018, 0x9096}] = TagInfo{Tag: Tag{0x0018, 0x9096}, VR: "FD", Name: "RETIRED_ParallelReductionFactorInPlaneRetired", VM: "1", Retired: true}
+	tagDict[Tag{0x0018, 0x9166}] = TagInfo{Tag: Tag{0x0018, 0x9166}, VR: "CS", Name: "RETIRED_BulkMotionStatus", VM: "1", Retired: true}
+	tagDict[Tag{0x0018, 0x9195}] = TagInfo{Tag: Tag{0x0018, 0x9195}, VR: "FD", Name: "RETIRED_ChemicalShiftMinimumIntegrationLimitInHz", VM: "1", Retired: true}
+	tagDict[Tag{0x0018, 0x9196}] = TagInfo{Tag: Tag{0x0018, 0x9196}, VR: "FD", Name: "RETIRED_ChemicalShiftMaximumIntegrationLimitInHz", VM: "1", Retired: true}
+	tagDict[Tag{0x0020, 0x0014}] = TagInfo{Tag: Tag{0x0020, 0x0014}, VR: "IS", Name: "RETIRED_IsotopeNumber", VM: "1", Retired: true}
+	tagDict[Tag{0x0020, 0x0015}] = TagInfo{Tag: Tag{0x0020, 0x0015}, VR: "IS", Name: "RETIRED_PhaseNumber", VM: "1", Retired: true}
+	tagDict[Tag{0x0020, 0x0016}] = TagInfo{Tag: Tag{0x0020, 0x0016}, VR: "IS", Name: "RETIRED_IntervalNumber", VM: "1", Retired: true}
+	tagDict[Tag{0x0020, 0x0017}] = TagInfo{Tag: Tag{0x0020, 0x0017}, VR: "IS", Name: "RETIRED_TimeSlotNumber", VM: "1", Retired: true}
+	tagDict[Tag{0x0020, 0x0018}] = TagInfo{Tag: Tag{0x0020, 0x0018}, VR: "IS", Name: "RETIRED_AngleNumber", VM: "1", Retired: true}
+	tagDict[Tag{0x0020, 0x0022}] = TagInfo{Tag: Tag{0x0020, 0x0022}, VR: "IS", Name: "RETIRED_OverlayNumber", VM: "1", Retired: true}
+	tagDict[Tag{0x0020, 0x0024}] = TagInfo{Tag: Tag{0x0020, 0x0024}, VR: "IS", Name: "RETIRED_CurveNumber", VM: "1", Retired: true}
+	tagDict[Tag{0x0020, 0x0026}] = TagInfo{Tag: Tag{0x0020, 0x0026}, VR: "IS", Name: "RETIRED_LUTNumber", VM: "1", Retired: true}
+	tagDict[Tag{0x0020, 0x0030}] = TagInfo{Tag: Tag{0x0020, 0x0030}, VR: "DS", Name: "RETIRED_ImagePosition", VM: "3", Retired: true}
+	tagDict[Tag{0x0020, 0x0035}] = TagInfo{Tag: Tag{0x0020, 0x0035}, VR: "DS", Name: "RETIRED_ImageOrientation", VM: "6", Retired: true}
+	tagDict[Tag{0x0020, 0x0050}] = TagInfo{Tag: Tag{0x0020, 0x0050}, VR: "DS", Name: "RETIRED_Location", VM: "1", Retired: true}
+	tagDict[Tag{0x0020, 0x0070}] = TagInfo{Tag: Tag{0x0020, 0x0070}, VR: "LO", Name: "RETIRED_ImageGeometryType", VM: "1", Retired: true}
+	tagDict[Tag{0x0020, 0x0080}] = TagInfo{Tag: Tag{0x0020, 0x0080}, VR: "CS", Name: "RETIRED_MaskingImage", VM: "1-n", Retired: true}
+	tagDict[Tag{0x0020, 0x00AA}] = TagInfo{Tag: Tag{0x0020, 0x00AA}, VR: "IS", Name: "RETIRED_ReportNumber", VM: "1", Retired: true}
+	tagDict[Tag{0x0020, 0x1000}] = TagInfo{Tag: Tag{0x0020, 0x1000}, VR: "IS", Name: "RETIRED_SeriesInStudy", VM: "1", Retired: true}
+	tagDict[Tag{0x0020, 0x1001}] = TagInfo{Tag: Tag{0x0020, 0x1001}, VR: "IS", Name: "RETIRED_AcquisitionsInSeries", VM: "1", Retired: true}
+	tagDict[Tag{0x0020, 0x1003}] = TagInfo{Tag: Tag{0x0020, 0x1003}, VR: "IS", Name: "RETIRED_ImagesInSeries", VM: "1", Retired: true}
+	tagDict[Tag{0x0020, 0x1004}] = TagInfo{Tag: Tag{0x0020, 0x1004}, VR: "IS", Name: "RETIRED_AcquisitionsInStudy", VM: "1", Retired: true}
+	tagDict[Tag{0x0020, 0x1005}] = TagInfo{Tag: Tag{0x0020, 0x1005}, VR: "IS", Name: "RETIRED_ImagesInStudy", VM: "1", Retired: true}
+	tagDict[Tag{0x0020, 0x1020}] = TagInfo{Tag: Tag{0x0020, 0x1020}, VR: "LO", Name: "RETIRED_Reference", VM: "1-n", Retired: true}
+	tagDict[Tag{0x0020, 0x1070}] = TagInfo{Tag: Tag{0x0020, 0x1070}, VR: "IS", Name: "RETIRED_OtherStudyNumbers", VM: "1-n", Retired: true}
+	tagDict[Tag{0x0020, 0x3401}] = TagInfo{Tag: Tag{0x0020, 0x3401}, VR: "CS", Name: "RETIRED_ModifyingDeviceID", VM: "1", Retired: true}
+	tagDict[Tag{0x0020, 0x3402}] = TagInfo{Tag: Tag{0x0020, 0x3402}, VR: "CS", Name: "RETIRED_ModifiedImageID", VM: "1", Retired: true}
+	tagDict[Tag{0x0020, 0x3403}] = TagInfo{Tag: Tag{0x0020, 0x3403}, VR: "DA", Name: "RETIRED_ModifiedImageDate", VM: "1", Retired: true}
+	tagDict[Tag{0x0020, 0x3404}] = TagInfo{Tag: Tag{0x0020, 0x3404}, VR: "LO", Name: "RETIRED_ModifyingDeviceManufacturer", VM: "1", Retired: true}
+	tagDict[Tag{0x0020, 0x3405}] = TagInfo{Tag: Tag{0x0020, 0x3405}, VR: "TM", Name: "RETIRED_ModifiedImageTime", VM: "1", Retired: true}
+	tagDict[Tag{0x0020, 0x3406}] = TagInfo{Tag: Tag{0x0020, 0x3406}, VR: "LO", Name: "RETIRED_ModifiedImageDescription", VM: "1", Retired: true}
+	tagDict[Tag{0x0020, 0x5000}] = TagInfo{Tag: Tag{0x0020, 0x5000}, VR: "AT", Name: "RETIRED_OriginalImageIdentification", VM: "1-n", Retired: true}
+	tagDict[Tag{0x0020, 0x5002}] = TagInfo{Tag: Tag{0x0020, 0x5002}, VR: "LO", Name: "RETIRED_OriginalImageIdentificationNomenclature", VM: "1-n", Retired: true}
+	tagDict[Tag{0x0028, 0x0005}] = TagInfo{Tag: Tag{0x0028, 0x0005}, VR: "US", Name: "RETIRED_ImageDimensions", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x0012}] = TagInfo{Tag: Tag{0x0028, 0x0012}, VR: "US", Name: "RETIRED_Planes", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x0040}] = TagInfo{Tag: Tag{0x0028, 0x0040}, VR: "CS", Name: "RETIRED_ImageFormat", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x0050}] = TagInfo{Tag: Tag{0x0028, 0x0050}, VR: "LO", Name: "RETIRED_ManipulatedImage", VM: "1-n", Retired: true}
+	tagDict[Tag{0x0028, 0x005F}] = TagInfo{Tag: Tag{0x0028, 0x005F}, VR: "LO", Name: "RETIRED_CompressionRecognitionCode", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x0060}] = TagInfo{Tag: Tag{0x0028, 0x0060}, VR: "CS", Name: "RETIRED_CompressionCode", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x0061}] = TagInfo{Tag: Tag{0x0028, 0x0061}, VR: "SH", Name: "RETIRED_CompressionOriginator", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x0062}] = TagInfo{Tag: Tag{0x0028, 0x0062}, VR: "LO", Name: "RETIRED_CompressionLabel", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x0063}] = TagInfo{Tag: Tag{0x0028, 0x0063}, VR: "SH", Name: "RETIRED_CompressionDescription", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x0065}] = TagInfo{Tag: Tag{0x0028, 0x0065}, VR: "CS", Name: "RETIRED_CompressionSequence", VM: "1-n", Retired: true}
+	tagDict[Tag{0x0028, 0x0066}] = TagInfo{Tag: Tag{0x0028, 0x0066}, VR: "AT", Name: "RETIRED_CompressionStepPointers", VM: "1-n", Retired: true}
+	tagDict[Tag{0x0028, 0x0068}] = TagInfo{Tag: Tag{0x0028, 0x0068}, VR: "US", Name: "RETIRED_RepeatInterval", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x0069}] = TagInfo{Tag: Tag{0x0028, 0x0069}, VR: "US", Name: "RETIRED_BitsGrouped", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x0070}] = TagInfo{Tag: Tag{0x0028, 0x0070}, VR: "US", Name: "RETIRED_PerimeterTable", VM: "1-n", Retired: true}
+	tagDict[Tag{0x0028, 0x0071}] = TagInfo{Tag: Tag{0x0028, 0x0071}, VR: "US", VRs: []string{"US", "SS"}, Name: "RETIRED_PerimeterValue", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x0080}] = TagInfo{Tag: Tag{0x0028, 0x0080}, VR: "US", Name: "RETIRED_PredictorRows", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x0081}] = TagInfo{Tag: Tag{0x0028, 0x0081}, VR: "US", Name: "RETIRED_PredictorColumns", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x0082}] = TagInfo{Tag: Tag{0x0028, 0x0082}, VR: "US", Name: "RETIRED_PredictorConstants", VM: "1-n", Retired: true}
+	tagDict[Tag{0x0028, 0x0090}] = TagInfo{Tag: Tag{0x0028, 0x0090}, VR: "CS", Name: "RETIRED_BlockedPixels", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x0091}] = TagInfo{Tag: Tag{0x0028, 0x0091}, VR: "US", Name: "RETIRED_BlockRows", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x0092}] = TagInfo{Tag: Tag{0x0028, 0x0092}, VR: "US", Name: "RETIRED_BlockColumns", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x0093}] = TagInfo{Tag: Tag{0x0028, 0x0093}, VR: "US", Name: "RETIRED_RowOverlap", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x0094}] = TagInfo{Tag: Tag{0x0028, 0x0094}, VR: "US", Name: "RETIRED_ColumnOverlap", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x0104}] = TagInfo{Tag: Tag{0x0028, 0x0104}, VR: "US", VRs: []string{"US", "SS"}, Name: "RETIRED_SmallestValidPixelValue", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x0105}] = TagInfo{Tag: Tag{0x0028, 0x0105}, VR: "US", VRs: []string{"US", "SS"}, Name: "RETIRED_LargestValidPixelValue", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x0110}] = TagInfo{Tag: Tag{0x0028, 0x0110}, VR: "US", VRs: []string{"US", "SS"}, Name: "RETIRED_SmallestImagePixelValueInPlane", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x0111}] = TagInfo{Tag: Tag{0x0028, 0x0111}, VR: "US", VRs: []string{"US", "SS"}, Name: "RETIRED_LargestImagePixelValueInPlane", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x0200}] = TagInfo{Tag: Tag{0x0028, 0x0200}, VR: "US", Name: "RETIRED_ImageLocation", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x0400}] = TagInfo{Tag: Tag{0x0028, 0x0400}, VR: "LO", Name: "RETIRED_TransformLabel", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x0401}] = TagInfo{Tag: Tag{0x0028, 0x0401}, VR: "LO", Name: "RETIRED_TransformVersionNumber", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x0402}] = TagInfo{Tag: Tag{0x0028, 0x0402}, VR: "US", Name: "RETIRED_NumberOfTransformSteps", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x0403}] = TagInfo{Tag: Tag{0x0028, 0x0403}, VR: "LO", Name: "RETIRED_SequenceOfCompressedData", VM: "1-n", Retired: true}
+	tagDict[Tag{0x0028, 0x0404}] = TagInfo{Tag: Tag{0x0028, 0x0404}, VR: "AT", Name: "RETIRED_DetailsOfCoefficients", VM: "1-n", Retired: true}
+	tagDict[Tag{0x0028, 0x0700}] = TagInfo{Tag: Tag{0x0028, 0x0700}, VR: "LO", Name: "RETIRED_DCTLabel", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x0701}] = TagInfo{Tag: Tag{0x0028, 0x0701}, VR: "CS", Name: "RETIRED_DataBlockDescription", VM: "1-n", Retired: true}
+	tagDict[Tag{0x0028, 0x0702}] = TagInfo{Tag: Tag{0x0028, 0x0702}, VR: "AT", Name: "RETIRED_DataBlock", VM: "1-n", Retired: true}
+	tagDict[Tag{0x0028, 0x0710}] = TagInfo{Tag: Tag{0x0028, 0x0710}, VR: "US", Name: "RETIRED_NormalizationFactorFormat", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x0720}] = TagInfo{Tag: Tag{0x0028, 0x0720}, VR: "US", Name: "RETIRED_ZonalMapNumberFormat", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x0721}] = TagInfo{Tag: Tag{0x0028, 0x0721}, VR: "AT", Name: "RETIRED_ZonalMapLocation", VM: "1-n", Retired: true}
+	tagDict[Tag{0x0028, 0x0722}] = TagInfo{Tag: Tag{0x0028, 0x0722}, VR: "US", Name: "RETIRED_ZonalMapFormat", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x0730}] = TagInfo{Tag: Tag{0x0028, 0x0730}, VR: "US", Name: "RETIRED_AdaptiveMapFormat", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x0740}] = TagInfo{Tag: Tag{0x0028, 0x0740}, VR: "US", Name: "RETIRED_CodeNumberFormat", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x1080}] = TagInfo{Tag: Tag{0x0028, 0x1080}, VR: "CS", Name: "RETIRED_GrayScale", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x1100}] = TagInfo{Tag: Tag{0x0028, 0x1100}, VR: "US", VRs: []string{"US", "SS"}, Name: "RETIRED_GrayLookupTableDescriptor", VM: "3", Retired: true}
+	tagDict[Tag{0x0028, 0x1111}] = TagInfo{Tag: Tag{0x0028, 0x1111}, VR: "US", VRs: []string{"US", "SS"}, Name: "RETIRED_LargeRedPaletteColorLookupTableDescriptor", VM: "4", Retired: true}
+	tagDict[Tag{0x0028, 0x1112}] = TagInfo{Tag: Tag{0x0028, 0x1112}, VR: "US", VRs: []string{"US", "SS"}, Name: "RETIRED_LargeGreenPaletteColorLookupTableDescriptor", VM: "4", Retired: true}
+	tagDict[Tag{0x0028, 0x1113}] = TagInfo{Tag: Tag{0x0028, 0x1113}, VR: "US", VRs: []string{"US", "SS"}, Name: "RETIRED_LargeBluePaletteColorLookupTableDescriptor", VM: "4", Retired: true}
+	tagDict[Tag{0x0028, 0x1200}] = TagInfo{Tag: Tag{0x0028, 0x1200}, VR: "OW", VRs: []string{"OW", "US"}, Name: "RETIRED_GrayLookupTableData", VM: "1-n", Retired: true}
+	tagDict[Tag{0x0028, 0x1211}] = TagInfo{Tag: Tag{0x0028, 0x1211}, VR: "OW", Name: "RETIRED_LargeRedPaletteColorLookupTableData", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x1212}] = TagInfo{Tag: Tag{0x0028, 0x1212}, VR: "OW", Name: "RETIRED_LargeGreenPaletteColorLookupTableData", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x1213}] = TagInfo{Tag: Tag{0x0028, 0x1213}, VR: "OW", Name: "RETIRED_LargeBluePaletteColorLookupTableData", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x1214}] = TagInfo{Tag: Tag{0x0028, 0x1214}, VR: "UI", Name: "RETIRED_LargePaletteColorLookupTableUID", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x4000}] = TagInfo{Tag: Tag{0x0028, 0x4000}, VR: "LT", Name: "RETIRED_ImagePresentationComments", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x5000}] = TagInfo{Tag: Tag{0x0028, 0x5000}, VR: "SQ", Name: "RETIRED_BiPlaneAcquisitionSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x0028, 0x6030}] = TagInfo{Tag: Tag{0x0028, 0x6030}, VR: "US", Name: "RETIRED_MaskPointers", VM: "1-n", Retired: true}
+	tagDict[Tag{0x0028, 0x9099}] = TagInfo{Tag: Tag{0x0028, 0x9099}, VR: "US", Name: "RETIRED_LargestMonochromePixelValue", VM: "1", Retired: true}
+	tagDict[Tag{0x0032, 0x000A}] = TagInfo{Tag: Tag{0x0032, 0x000A}, VR: "CS", Name: "RETIRED_StudyStatusID", VM: "1", Retired: true}
+	tagDict[Tag{0x0032, 0x000C}] = TagInfo{Tag: Tag{0x0032, 0x000C}, VR: "CS", Name: "RETIRED_StudyPriorityID", VM: "1", Retired: true}
+	tagDict[Tag{0x0032, 0x0012}] = TagInfo{Tag: Tag{0x0032, 0x0012}, VR: "LO", Name: "RETIRED_StudyIDIssuer", VM: "1", Retired: true}
+	tagDict[Tag{0x0032, 0x0032}] = TagInfo{Tag: Tag{0x0032, 0x0032}, VR: "DA", Name: "RETIRED_StudyVerifiedDate", VM: "1", Retired: true}
+	tagDict[Tag{0x0032, 0x0033}] = TagInfo{Tag: Tag{0x0032, 0x0033}, VR: "TM", Name: "RETIRED_StudyVerifiedTime", VM: "1", Retired: true}
+	tagDict[Tag{0x0032, 0x0034}] = TagInfo{Tag: Tag{0x0032, 0x0034}, VR: "DA", Name: "RETIRED_StudyReadDate", VM: "1", Retired: true}
+	tagDict[Tag{0x0032, 0x0035}] = TagInfo{Tag: Tag{0x0032, 0x0035}, VR: "TM", Name: "RETIRED_StudyReadTime", VM: "1", Retired: true}
+	tagDict[Tag{0x0032, 0x1000}] = TagInfo{Tag: Tag{0x0032, 0x1000}, VR: "DA", Name: "RETIRED_ScheduledStudyStartDate", VM: "1", Retired: true}
+	tagDict[Tag{0x0032, 0x1001}] = TagInfo{Tag: Tag{0x0032, 0x1001}, VR: "TM", Name: "RETIRED_ScheduledStudyStartTime", VM: "1", Retired: true}
+	tagDict[Tag{0x0032, 0x1010}] = TagInfo{Tag: Tag{0x0032, 0x1010}, VR: "DA", Name: "RETIRED_ScheduledStudyStopDate", VM: "1", Retired: true}
+	tagDict[Tag{0x0032, 0x1011}] = TagInfo{Tag: Tag{0x0032, 0x1011}, VR: "TM", Name: "RETIRED_ScheduledStudyStopTime", VM: "1", Retired: true}
+	tagDict[Tag{0x0032, 0x1020}] = TagInfo{Tag: Tag{0x0032, 0x1020}, VR: "LO", Name: "RETIRED_ScheduledStudyLocation", VM: "1", Retired: true}
+	tagDict[Tag{0x0032, 0x1021}] = TagInfo{Tag: Tag{0x0032, 0x1021}, VR: "AE", Name: "RETIRED_ScheduledStudyLocationAETitle", VM: "1-n", Retired: true}
+	tagDict[Tag{0x0032, 0x1030}] = TagInfo{Tag: Tag{0x0032, 0x1030}, VR: "LO", Name: "RETIRED_ReasonForStudy", VM: "1", Retired: true}
+	tagDict[Tag{0x0032, 0x1040}] = TagInfo{Tag: Tag{0x0032, 0x1040}, VR: "DA", Name: "RETIRED_StudyArrivalDate", VM: "1", Retired: true}
+	tagDict[Tag{0x0032, 0x1041}] = TagInfo{Tag: Tag{0x0032, 0x1041}, VR: "TM", Name: "RETIRED_StudyArrivalTime", VM: "1", Retired: true}
+	tagDict[Tag{0x0032, 0x1050}] = TagInfo{Tag: Tag{0x0032, 0x1050}, VR: "DA", Name: "RETIRED_StudyCompletionDate", VM: "1", Retired: true}
+	tagDict[Tag{0x0032, 0x1051}] = TagInfo{Tag: Tag{0x0032, 0x1051}, VR: "TM", Name: "RETIRED_StudyCompletionTime", VM: "1", Retired: true}
+	tagDict[Tag{0x0032, 0x1055}] = TagInfo{Tag: Tag{0x0032, 0x1055}, VR: "CS", Name: "RETIRED_StudyComponentStatusID", VM: "1", Retired: true}
+	tagDict[Tag{0x0032, 0x4000}] = TagInfo{Tag: Tag{0x0032, 0x4000}, VR: "LT", Name: "RETIRED_StudyComments", VM: "1", Retired: true}
+	tagDict[Tag{0x0038, 0x0011}] = TagInfo{Tag: Tag{0x0038, 0x0011}, VR: "LO", Name: "RETIRED_IssuerOfAdmissionID", VM: "1", Retired: true}
+	tagDict[Tag{0x0038, 0x001A}] = TagInfo{Tag: Tag{0x0038, 0x001A}, VR: "DA", Name: "RETIRED_ScheduledAdmissionDate", VM: "1", Retired: true}
+	tagDict[Tag{0x0038, 0x001B}] = TagInfo{Tag: Tag{0x0038, 0x001B}, VR: "TM", Name: "RETIRED_ScheduledAdmissionTime", VM: "1", Retired: true}
+	tagDict[Tag{0x0038, 0x001C}] = TagInfo{Tag: Tag{0x0038, 0x001C}, VR: "DA", Name: "RETIRED_ScheduledDischargeDate", VM: "1", Retired: true}
+	tagDict[Tag{0x0038, 0x001D}] = TagInfo{Tag: Tag{0x0038, 0x001D}, VR: "TM", Name: "RETIRED_ScheduledDischargeTime", VM: "1", Retired: true}
+	tagDict[Tag{0x0038, 0x001E}] = TagInfo{Tag: Tag{0x0038, 0x001E}, VR: "LO", Name: "RETIRED_ScheduledPatientInstitutionResidence", VM: "1", Retired: true}
+	tagDict[Tag{0x0038, 0x0030}] = TagInfo{Tag: Tag{0x0038, 0x0030}, VR: "DA", Name: "RETIRED_DischargeDate", VM: "1", Retired: true}
+	tagDict[Tag{0x0038, 0x0032}] = TagInfo{Tag: Tag{0x0038, 0x0032}, VR: "TM", Name: "RETIRED_DischargeTime", VM: "1", Retired: true}
+	tagDict[Tag{0x0038, 0x0040}] = TagInfo{Tag: Tag{0x0038, 0x0040}, VR: "LO", Name: "RETIRED_DischargeDiagnosisDescription", VM: "1", Retired: true}
+	tagDict[Tag{0x0038, 0x0044}] = TagInfo{Tag: Tag{0x0038, 0x0044}, VR: "SQ", Name: "RETIRED_DischargeDiagnosisCodeSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x0038, 0x0061}] = TagInfo{Tag: Tag{0x0038, 0x0061}, VR: "LO", Name: "RETIRED_IssuerOfServiceEpisodeID", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0x0307}] = TagInfo{Tag: Tag{0x0040, 0x0307}, VR: "DS", Name: "RETIRED_DistanceSourceToSupport", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0x0330}] = TagInfo{Tag: Tag{0x0040, 0x0330}, VR: "SQ", Name: "RETIRED_ReferencedProcedureStepSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0x050A}] = TagInfo{Tag: Tag{0x0040, 0x050A}, VR: "LO", Name: "RETIRED_SpecimenAccessionNumber", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0x0550}] = TagInfo{Tag: Tag{0x0040, 0x0550}, VR: "SQ", Name: "RETIRED_SpecimenSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0x0552}] = TagInfo{Tag: Tag{0x0040, 0x0552}, VR: "SQ", Name: "RETIRED_SpecimenDescriptionSequenceTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0x0553}] = TagInfo{Tag: Tag{0x0040, 0x0553}, VR: "ST", Name: "RETIRED_SpecimenDescriptionTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0x06FA}] = TagInfo{Tag: Tag{0x0040, 0x06FA}, VR: "LO", Name: "RETIRED_SlideIdentifier", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0x08D8}] = TagInfo{Tag: Tag{0x0040, 0x08D8}, VR: "SQ", Name: "RETIRED_PixelSpacingSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0x08DA}] = TagInfo{Tag: Tag{0x0040, 0x08DA}, VR: "SQ", Name: "RETIRED_CoordinateSystemAxisCodeSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0x09F8}] = TagInfo{Tag: Tag{0x0040, 0x09F8}, VR: "SQ", Name: "RETIRED_VitalStainCodeSequenceTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0x1006}] = TagInfo{Tag: Tag{0x0040, 0x1006}, VR: "SH", Name: "RETIRED_PlacerOrderNumberProcedure", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0x1007}] = TagInfo{Tag: Tag{0x0040, 0x1007}, VR: "SH", Name: "RETIRED_FillerOrderNumberProcedure", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0x1060}] = TagInfo{Tag: Tag{0x0040, 0x1060}, VR: "LO", Name: "RETIRED_RequestedProcedureDescriptionTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0x2001}] = TagInfo{Tag: Tag{0x0040, 0x2001}, VR: "LO", Name: "RETIRED_ReasonForTheImagingServiceRequest", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0x2006}] = TagInfo{Tag: Tag{0x0040, 0x2006}, VR: "SH", Name: "RETIRED_PlacerOrderNumberImagingServiceRequestRetired", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0x2007}] = TagInfo{Tag: Tag{0x0040, 0x2007}, VR: "SH", Name: "RETIRED_FillerOrderNumberImagingServiceRequestRetired", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA007}] = TagInfo{Tag: Tag{0x0040, 0xA007}, VR: "CS", Name: "RETIRED_FindingsFlagTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA020}] = TagInfo{Tag: Tag{0x0040, 0xA020}, VR: "SQ", Name: "RETIRED_FindingsSequenceTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA021}] = TagInfo{Tag: Tag{0x0040, 0xA021}, VR: "UI", Name: "RETIRED_FindingsGroupUIDTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA022}] = TagInfo{Tag: Tag{0x0040, 0xA022}, VR: "UI", Name: "RETIRED_ReferencedFindingsGroupUIDTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA023}] = TagInfo{Tag: Tag{0x0040, 0xA023}, VR: "DA", Name: "RETIRED_FindingsGroupRecordingDateTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA024}] = TagInfo{Tag: Tag{0x0040, 0xA024}, VR: "TM", Name: "RETIRED_FindingsGroupRecordingTimeTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA026}] = TagInfo{Tag: Tag{0x0040, 0xA026}, VR: "SQ", Name: "RETIRED_FindingsSourceCategoryCodeSequenceTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA028}] = TagInfo{Tag: Tag{0x0040, 0xA028}, VR: "SQ", Name: "RETIRED_DocumentingOrganizationIdentifierCodeSequenceTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA047}] = TagInfo{Tag: Tag{0x0040, 0xA047}, VR: "LO", Name: "RETIRED_MeasurementPrecisionDescriptionTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA057}] = TagInfo{Tag: Tag{0x0040, 0xA057}, VR: "CS", Name: "RETIRED_UrgencyOrPriorityAlertsTrial", VM: "1-n", Retired: true}
+	tagDict[Tag{0x0040, 0xA060}] = TagInfo{Tag: Tag{0x0040, 0xA060}, VR: "LO", Name: "RETIRED_SequencingIndicatorTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA066}] = TagInfo{Tag: Tag{0x0040, 0xA066}, VR: "SQ", Name: "RETIRED_DocumentIdentifierCodeSequenceTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA067}] = TagInfo{Tag: Tag{0x0040, 0xA067}, VR: "PN", Name: "RETIRED_DocumentAuthorTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA068}] = TagInfo{Tag: Tag{0x0040, 0xA068}, VR: "SQ", Name: "RETIRED_DocumentAuthorIdentifierCodeSequenceTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA070}] = TagInfo{Tag: Tag{0x0040, 0xA070}, VR: "SQ", Name: "RETIRED_IdentifierCodeSequenceTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA074}] = TagInfo{Tag: Tag{0x0040, 0xA074}, VR: "OB", Name: "RETIRED_ObjectBinaryIdentifierTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA076}] = TagInfo{Tag: Tag{0x0040, 0xA076}, VR: "SQ", Name: "RETIRED_DocumentingObserverIdentifierCodeSequenceTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA085}] = TagInfo{Tag: Tag{0x0040, 0xA085}, VR: "SQ", Name: "RETIRED_ProcedureIdentifierCodeSequenceTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA089}] = TagInfo{Tag: Tag{0x0040, 0xA089}, VR: "OB", Name: "RETIRED_ObjectDirectoryBinaryIdentifierTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA090}] = TagInfo{Tag: Tag{0x0040, 0xA090}, VR: "SQ", Name: "RETIRED_EquivalentCDADocumentSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA110}] = TagInfo{Tag: Tag{0x0040, 0xA110}, VR: "DA", Name: "RETIRED_DateOfDocumentOrVerbalTransactionTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA112}] = TagInfo{Tag: Tag{0x0040, 0xA112}, VR: "TM", Name: "RETIRED_TimeOfDocumentCreationOrVerbalTransactionTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA125}] = TagInfo{Tag: Tag{0x0040, 0xA125}, VR: "CS", Name: "RETIRED_ReportStatusIDTrial", VM: "2", Retired: true}
+	tagDict[Tag{0x0040, 0xA167}] = TagInfo{Tag: Tag{0x0040, 0xA167}, VR: "SQ", Name: "RETIRED_ObservationCategoryCodeSequenceTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA16A}] = TagInfo{Tag: Tag{0x0040, 0xA16A}, VR: "ST", Name: "RETIRED_BibliographicCitationTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA172}] = TagInfo{Tag: Tag{0x0040, 0xA172}, VR: "UI", Name: "RETIRED_ReferencedObservationUIDTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA173}] = TagInfo{Tag: Tag{0x0040, 0xA173}, VR: "CS", Name: "RETIRED_ReferencedObservationClassTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA174}] = TagInfo{Tag: Tag{0x0040, 0xA174}, VR: "CS", Name: "RETIRED_ReferencedObjectObservationClassTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA192}] = TagInfo{Tag: Tag{0x0040, 0xA192}, VR: "DA", Name: "RETIRED_ObservationDateTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA193}] = TagInfo{Tag: Tag{0x0040, 0xA193}, VR: "TM", Name: "RETIRED_ObservationTimeTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA194}] = TagInfo{Tag: Tag{0x0040, 0xA194}, VR: "CS", Name: "RETIRED_MeasurementAutomationTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA224}] = TagInfo{Tag: Tag{0x0040, 0xA224}, VR: "ST", Name: "RETIRED_IdentificationDescriptionTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA290}] = TagInfo{Tag: Tag{0x0040, 0xA290}, VR: "CS", Name: "RETIRED_CoordinatesSetGeometricTypeTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA296}] = TagInfo{Tag: Tag{0x0040, 0xA296}, VR: "SQ", Name: "RETIRED_AlgorithmCodeSequenceTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA297}] = TagInfo{Tag: Tag{0x0040, 0xA297}, VR: "ST", Name: "RETIRED_AlgorithmDescriptionTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA29A}] = TagInfo{Tag: Tag{0x0040, 0xA29A}, VR: "SL", Name: "RETIRED_PixelCoordinatesSetTrial", VM: "2-2n", Retired: true}
+	tagDict[Tag{0x0040, 0xA307}] = TagInfo{Tag: Tag{0x0040, 0xA307}, VR: "PN", Name: "RETIRED_CurrentObserverTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA313}] = TagInfo{Tag: Tag{0x0040, 0xA313}, VR: "SQ", Name: "RETIRED_ReferencedAccessionSequenceTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA33A}] = TagInfo{Tag: Tag{0x0040, 0xA33A}, VR: "ST", Name: "RETIRED_ReportStatusCommentTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA340}] = TagInfo{Tag: Tag{0x0040, 0xA340}, VR: "SQ", Name: "RETIRED_ProcedureContextSequenceTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA352}] = TagInfo{Tag: Tag{0x0040, 0xA352}, VR: "PN", Name: "RETIRED_VerbalSourceTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA353}] = TagInfo{Tag: Tag{0x0040, 0xA353}, VR: "ST", Name: "RETIRED_AddressTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA354}] = TagInfo{Tag: Tag{0x0040, 0xA354}, VR: "LO", Name: "RETIRED_TelephoneNumberTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA358}] = TagInfo{Tag: Tag{0x0040, 0xA358}, VR: "SQ", Name: "RETIRED_VerbalSourceIdentifierCodeSequenceTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA380}] = TagInfo{Tag: Tag{0x0040, 0xA380}, VR: "SQ", Name: "RETIRED_ReportDetailSequenceTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA402}] = TagInfo{Tag: Tag{0x0040, 0xA402}, VR: "UI", Name: "RETIRED_ObservationSubjectUIDTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA403}] = TagInfo{Tag: Tag{0x0040, 0xA403}, VR: "CS", Name: "RETIRED_ObservationSubjectClassTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA404}] = TagInfo{Tag: Tag{0x0040, 0xA404}, VR: "SQ", Name: "RETIRED_ObservationSubjectTypeCodeSequenceTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA600}] = TagInfo{Tag: Tag{0x0040, 0xA600}, VR: "CS", Name: "RETIRED_ObservationSubjectContextFlagTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA601}] = TagInfo{Tag: Tag{0x0040, 0xA601}, VR: "CS", Name: "RETIRED_ObserverContextFlagTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA603}] = TagInfo{Tag: Tag{0x0040, 0xA603}, VR: "CS", Name: "RETIRED_ProcedureContextFlagTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA731}] = TagInfo{Tag: Tag{0x0040, 0xA731}, VR: "SQ", Name: "RETIRED_RelationshipSequenceTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA732}] = TagInfo{Tag: Tag{0x0040, 0xA732}, VR: "SQ", Name: "RETIRED_RelationshipTypeCodeSequenceTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA744}] = TagInfo{Tag: Tag{0x0040, 0xA744}, VR: "SQ", Name: "RETIRED_LanguageCodeSequenceTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xA992}] = TagInfo{Tag: Tag{0x0040, 0xA992}, VR: "ST", Name: "RETIRED_UniformResourceLocatorTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xDB06}] = TagInfo{Tag: Tag{0x0040, 0xDB06}, VR: "DT", Name: "RETIRED_TemplateVersion", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xDB07}] = TagInfo{Tag: Tag{0x0040, 0xDB07}, VR: "DT", Name: "RETIRED_TemplateLocalVersion", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xDB0B}] = TagInfo{Tag: Tag{0x0040, 0xDB0B}, VR: "CS", Name: "RETIRED_TemplateExtensionFlag", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xDB0C}] = TagInfo{Tag: Tag{0x0040, 0xDB0C}, VR: "UI", Name: "RETIRED_TemplateExtensionOrganizationUID", VM: "1", Retired: true}
+	tagDict[Tag{0x0040, 0xDB0D}] = TagInfo{Tag: Tag{0x0040, 0xDB0D}, VR: "UI", Name: "RETIRED_TemplateExtensionCreatorUID", VM: "1", Retired: true}
+	tagDict[Tag{0x0054, 0x1400}] = TagInfo{Tag: Tag{0x0054, 0x1400}, VR: "CS", Name: "RETIRED_CountsIncluded", VM: "1-n", Retired: true}
+	tagDict[Tag{0x0054, 0x1401}] = TagInfo{Tag: Tag{0x0054, 0x1401}, VR: "CS", Name: "RETIRED_DeadTimeCorrectionFlag", VM: "1", Retired: true}
+	tagDict[Tag{0x0070, 0x0040}] = TagInfo{Tag: Tag{0x0070, 0x0040}, VR: "IS", Name: "RETIRED_ImageRotationRetired", VM: "1", Retired: true}
+	tagDict[Tag{0x0070, 0x0050}] = TagInfo{Tag: Tag{0x0070, 0x0050}, VR: "US", Name: "RETIRED_DisplayedAreaTopLeftHandCornerTrial", VM: "2", Retired: true}
+	tagDict[Tag{0x0070, 0x0051}] = TagInfo{Tag: Tag{0x0070, 0x0051}, VR: "US", Name: "RETIRED_DisplayedAreaBottomRightHandCornerTrial", VM: "2", Retired: true}
+	tagDict[Tag{0x0070, 0x0067}] = TagInfo{Tag: Tag{0x0070, 0x0067}, VR: "US", Name: "RETIRED_GraphicLayerRecommendedDisplayRGBValue", VM: "3", Retired: true}
+	tagDict[Tag{0x0074, 0x1024}] = TagInfo{Tag: Tag{0x0074, 0x1024}, VR: "IS", Name: "RETIRED_BeamOrderIndexTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0074, 0x1038}] = TagInfo{Tag: Tag{0x0074, 0x1038}, VR: "DS", Name: "RETIRED_DoubleExposureMetersetTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x0074, 0x103A}] = TagInfo{Tag: Tag{0x0074, 0x103A}, VR: "DS", Name: "RETIRED_DoubleExposureFieldDeltaTrial", VM: "4", Retired: true}
+	tagDict[Tag{0x0074, 0x1220}] = TagInfo{Tag: Tag{0x0074, 0x1220}, VR: "SQ", Name: "RETIRED_RelatedProcedureStepSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x0074, 0x1222}] = TagInfo{Tag: Tag{0x0074, 0x1222}, VR: "LO", Name: "RETIRED_ProcedureStepRelationshipType", VM: "1", Retired: true}
+	tagDict[Tag{0x0088, 0x0904}] = TagInfo{Tag: Tag{0x0088, 0x0904}, VR: "LO", Name: "RETIRED_TopicTitle", VM: "1", Retired: true}
+	tagDict[Tag{0x0088, 0x0906}] = TagInfo{Tag: Tag{0x0088, 0x0906}, VR: "ST", Name: "RETIRED_TopicSubject", VM: "1", Retired: true}
+	tagDict[Tag{0x0088, 0x0910}] = TagInfo{Tag: Tag{0x0088, 0x0910}, VR: "LO", Name: "RETIRED_TopicAuthor", VM: "1", Retired: true}
+	tagDict[Tag{0x0088, 0x0912}] = TagInfo{Tag: Tag{0x0088, 0x0912}, VR: "LO", Name: "RETIRED_TopicKeywords", VM: "1-32", Retired: true}
+	tagDict[Tag{0x2000, 0x0062}] = TagInfo{Tag: Tag{0x2000, 0x0062}, VR: "CS", Name: "RETIRED_ColorImagePrintingFlag", VM: "1", Retired: true}
+	tagDict[Tag{0x2000, 0x0063}] = TagInfo{Tag: Tag{0x2000, 0x0063}, VR: "CS", Name: "RETIRED_CollationFlag", VM: "1", Retired: true}
+	tagDict[Tag{0x2000, 0x0065}] = TagInfo{Tag: Tag{0x2000, 0x0065}, VR: "CS", Name: "RETIRED_AnnotationFlag", VM: "1", Retired: true}
+	tagDict[Tag{0x2000, 0x0067}] = TagInfo{Tag: Tag{0x2000, 0x0067}, VR: "CS", Name: "RETIRED_ImageOverlayFlag", VM: "1", Retired: true}
+	tagDict[Tag{0x2000, 0x0069}] = TagInfo{Tag: Tag{0x2000, 0x0069}, VR: "CS", Name: "RETIRED_PresentationLUTFlag", VM: "1", Retired: true}
+	tagDict[Tag{0x2000, 0x006A}] = TagInfo{Tag: Tag{0x2000, 0x006A}, VR: "CS", Name: "RETIRED_ImageBoxPresentationLUTFlag", VM: "1", Retired: true}
+	tagDict[Tag{0x2000, 0x0510}] = TagInfo{Tag: Tag{0x2000, 0x0510}, VR: "SQ", Name: "RETIRED_ReferencedStoredPrintSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x2020, 0x0130}] = TagInfo{Tag: Tag{0x2020, 0x0130}, VR: "SQ", Name: "RETIRED_ReferencedImageOverlayBoxSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x2020, 0x0140}] = TagInfo{Tag: Tag{0x2020, 0x0140}, VR: "SQ", Name: "RETIRED_ReferencedVOILUTBoxSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x2040, 0x0010}] = TagInfo{Tag: Tag{0x2040, 0x0010}, VR: "SQ", Name: "RETIRED_ReferencedOverlayPlaneSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x2040, 0x0011}] = TagInfo{Tag: Tag{0x2040, 0x0011}, VR: "US", Name: "RETIRED_ReferencedOverlayPlaneGroups", VM: "1-99", Retired: true}
+	tagDict[Tag{0x2040, 0x0020}] = TagInfo{Tag: Tag{0x2040, 0x0020}, VR: "SQ", Name: "RETIRED_OverlayPixelDataSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x2040, 0x0060}] = TagInfo{Tag: Tag{0x2040, 0x0060}, VR: "CS", Name: "RETIRED_OverlayMagnificationType", VM: "1", Retired: true}
+	tagDict[Tag{0x2040, 0x0070}] = TagInfo{Tag: Tag{0x2040, 0x0070}, VR: "CS", Name: "RETIRED_OverlaySmoothingType", VM: "1", Retired: true}
+	tagDict[Tag{0x2040, 0x0072}] = TagInfo{Tag: Tag{0x2040, 0x0072}, VR: "CS", Name: "RETIRED_OverlayOrImageMagnification", VM: "1", Retired: true}
+	tagDict[Tag{0x2040, 0x0074}] = TagInfo{Tag: Tag{0x2040, 0x0074}, VR: "US", Name: "RETIRED_MagnifyToNumberOfColumns", VM: "1", Retired: true}
+	tagDict[Tag{0x2040, 0x0080}] = TagInfo{Tag: Tag{0x2040, 0x0080}, VR: "CS", Name: "RETIRED_OverlayForegroundDensity", VM: "1", Retired: true}
+	tagDict[Tag{0x2040, 0x0082}] = TagInfo{Tag: Tag{0x2040, 0x0082}, VR: "CS", Name: "RETIRED_OverlayBackgroundDensity", VM: "1", Retired: true}
+	tagDict[Tag{0x2040, 0x0090}] = TagInfo{Tag: Tag{0x2040, 0x0090}, VR: "CS", Name: "RETIRED_OverlayMode", VM: "1", Retired: true}
+	tagDict[Tag{0x2040, 0x0100}] = TagInfo{Tag: Tag{0x2040, 0x0100}, VR: "CS", Name: "RETIRED_ThresholdDensity", VM: "1", Retired: true}
+	tagDict[Tag{0x2040, 0x0500}] = TagInfo{Tag: Tag{0x2040, 0x0500}, VR: "SQ", Name: "RETIRED_ReferencedImageBoxSequenceRetired", VM: "1", Retired: true}
+	tagDict[Tag{0x2100, 0x0010}] = TagInfo{Tag: Tag{0x2100, 0x0010}, VR: "SH", Name: "RETIRED_PrintJobID", VM: "1", Retired: true}
+	tagDict[Tag{0x2100, 0x0140}] = TagInfo{Tag: Tag{0x2100, 0x0140}, VR: "AE", Name: "RETIRED_DestinationAE", VM: "1", Retired: true}
+	tagDict[Tag{0x2100, 0x0500}] = TagInfo{Tag: Tag{0x2100, 0x0500}, VR: "SQ", Name: "RETIRED_ReferencedPrintJobSequencePullStoredPrint", VM: "1", Retired: true}
+	tagDict[Tag{0x2110, 0x0099}] = TagInfo{Tag: Tag{0x2110, 0x0099}, VR: "SH", Name: "RETIRED_PrintQueueID", VM: "1", Retired: true}
+	tagDict[Tag{0x2120, 0x0010}] = TagInfo{Tag: Tag{0x2120, 0x0010}, VR: "CS", Name: "RETIRED_QueueStatus", VM: "1", Retired: true}
+	tagDict[Tag{0x2120, 0x0050}] = TagInfo{Tag: Tag{0x2120, 0x0050}, VR: "SQ", Name: "RETIRED_PrintJobDescriptionSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x2120, 0x0070}] = TagInfo{Tag: Tag{0x2120, 0x0070}, VR: "SQ", Name: "RETIRED_ReferencedPrintJobSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x2130, 0x0010}] = TagInfo{Tag: Tag{0x2130, 0x0010}, VR: "SQ", Name: "RETIRED_PrintManagementCapabilitiesSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x2130, 0x0015}] = TagInfo{Tag: Tag{0x2130, 0x0015}, VR: "SQ", Name: "RETIRED_PrinterCharacteristicsSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x2130, 0x0030}] = TagInfo{Tag: Tag{0x2130, 0x0030}, VR: "SQ", Name: "RETIRED_FilmBoxContentSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x2130, 0x0040}] = TagInfo{Tag: Tag{0x2130, 0x0040}, VR: "SQ", Name: "RETIRED_ImageBoxContentSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x2130, 0x0050}] = TagInfo{Tag: Tag{0x2130, 0x0050}, VR: "SQ", Name: "RETIRED_AnnotationContentSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x2130, 0x0060}] = TagInfo{Tag: Tag{0x2130, 0x0060}, VR: "SQ", Name: "RETIRED_ImageOverlayBoxContentSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x2130, 0x0080}] = TagInfo{Tag: Tag{0x2130, 0x0080}, VR: "SQ", Name: "RETIRED_PresentationLUTContentSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x2130, 0x00A0}] = TagInfo{Tag: Tag{0x2130, 0x00A0}, VR: "SQ", Name: "RETIRED_ProposedStudySequence", VM: "1", Retired: true}
+	tagDict[Tag{0x2130, 0x00C0}] = TagInfo{Tag: Tag{0x2130, 0x00C0}, VR: "SQ", Name: "RETIRED_OriginalImageSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x4000, 0x0010}] = TagInfo{Tag: Tag{0x4000, 0x0010}, VR: "LT", Name: "RETIRED_Arbitrary", VM: "1", Retired: true}
+	tagDict[Tag{0x4000, 0x4000}] = TagInfo{Tag: Tag{0x4000, 0x4000}, VR: "LT", Name: "RETIRED_TextComments", VM: "1", Retired: true}
+	tagDict[Tag{0x4008, 0x0040}] = TagInfo{Tag: Tag{0x4008, 0x0040}, VR: "SH", Name: "RETIRED_ResultsID", VM: "1", Retired: true}
+	tagDict[Tag{0x4008, 0x0042}] = TagInfo{Tag: Tag{0x4008, 0x0042}, VR: "LO", Name: "RETIRED_ResultsIDIssuer", VM: "1", Retired: true}
+	tagDict[Tag{0x4008, 0x0050}] = TagInfo{Tag: Tag{0x4008, 0x0050}, VR: "SQ", Name: "RETIRED_ReferencedInterpretationSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x4008, 0x00FF}] = TagInfo{Tag: Tag{0x4008, 0x00FF}, VR: "CS", Name: "RETIRED_ReportProductionStatusTrial", VM: "1", Retired: true}
+	tagDict[Tag{0x4008, 0x0100}] = TagInfo{Tag: Tag{0x4008, 0x0100}, VR: "DA", Name: "RETIRED_InterpretationRecordedDate", VM: "1", Retired: true}
+	tagDict[Tag{0x4008, 0x0101}] = TagInfo{Tag: Tag{0x4008, 0x0101}, VR: "TM", Name: "RETIRED_InterpretationRecordedTime", VM: "1", Retired: true}
+	tagDict[Tag{0x4008, 0x0102}] = TagInfo{Tag: Tag{0x4008, 0x0102}, VR: "PN", Name: "RETIRED_InterpretationRecorder", VM: "1", Retired: true}
+	tagDict[Tag{0x4008, 0x0103}] = TagInfo{Tag: Tag{0x4008, 0x0103}, VR: "LO", Name: "RETIRED_ReferenceToRecordedSound", VM: "1", Retired: true}
+	tagDict[Tag{0x4008, 0x0108}] = TagInfo{Tag: Tag{0x4008, 0x0108}, VR: "DA", Name: "RETIRED_InterpretationTranscriptionDate", VM: "1", Retired: true}
+	tagDict[Tag{0x4008, 0x0109}] = TagInfo{Tag: Tag{0x4008, 0x0109}, VR: "TM", Name: "RETIRED_InterpretationTranscriptionTime", VM: "1", Retired: true}
+	tagDict[Tag{0x4008, 0x010A}] = TagInfo{Tag: Tag{0x4008, 0x010A}, VR: "PN", Name: "RETIRED_InterpretationTranscriber", VM: "1", Retired: true}
+	tagDict[Tag{0x4008, 0x010B}] = TagInfo{Tag: Tag{0x4008, 0x010B}, VR: "ST", Name: "RETIRED_InterpretationText", VM: "1", Retired: true}
+	tagDict[Tag{0x4008, 0x010C}] = TagInfo{Tag: Tag{0x4008, 0x010C}, VR: "PN", Name: "RETIRED_InterpretationAuthor", VM: "1", Retired: true}
+	tagDict[Tag{0x4008, 0x0111}] = TagInfo{Tag: Tag{0x4008, 0x0111}, VR: "SQ", Name: "RETIRED_InterpretationApproverSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x4008, 0x0112}] = TagInfo{Tag: Tag{0x4008, 0x0112}, VR: "DA", Name: "RETIRED_InterpretationApprovalDate", VM: "1", Retired: true}
+	tagDict[Tag{0x4008, 0x0113}] = TagInfo{Tag: Tag{0x4008, 0x0113}, VR: "TM", Name: "RETIRED_InterpretationApprovalTime", VM: "1", Retired: true}
+	tagDict[Tag{0x4008, 0x0114}] = TagInfo{Tag: Tag{0x4008, 0x0114}, VR: "PN", Name: "RETIRED_PhysicianApprovingInterpretation", VM: "1", Retired: true}
+	tagDict[Tag{0x4008, 0x0115}] = TagInfo{Tag: Tag{0x4008, 0x0115}, VR: "LT", Name: "RETIRED_InterpretationDiagnosisDescription", VM: "1", Retired: true}
+	tagDict[Tag{0x4008, 0x0117}] = TagInfo{Tag: Tag{0x4008, 0x0117}, VR: "SQ", Name: "RETIRED_InterpretationDiagnosisCodeSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x4008, 0x0118}] = TagInfo{Tag: Tag{0x4008, 0x0118}, VR: "SQ", Name: "RETIRED_ResultsDistributionListSequence", VM: "1", Retired: true}
+	tagDict[Tag{0x4008, 0x0119}] = TagInfo{Tag: Tag{0x4008, 0x0119}, VR: "PN", Name: "RETIRED_DistributionName", VM: "1", Retired: true}
+	tagDict[Tag{0x4008, 0x011A}] = TagInfo{Tag: Tag{0x4008, 0x011A}, VR: "LO", Name: "RETIRED_DistributionAddress", VM: "1", Retired: true}
+	tagDict[Tag{0x4008, 0x0200}] = TagInfo{Tag: Tag{0x4008, 0x0200}, VR: "SH", Name: "RETIRED_InterpretationID", VM: "1", Retired: true}
+	tagDict[Tag{0x4008, 0x0202}] = TagInfo{Tag: Tag{0x4008, 0x0202}, VR: "LO", Name: "RETIRED_InterpretationIDIssuer", VM: "1", Retired: true}
+	tagDict[Tag{0x4008, 0x0210}] = TagInfo{Tag: Tag{0x4008, 0x0210}, VR: "CS", Name: "RETIRED_InterpretationTypeID", VM: "1", Retired: true}
+	tagDict[Tag{0x4008, 0x0212}] = TagInfo{Tag: Tag{0x4008, 0x0212}, VR: "CS", Name: "RETIRED_InterpretationStatusID", VM: "1", Retired: true}
+	tagDict[Tag{0x4008, 0x0300}] = TagInfo{Tag: Tag{0x4008, 0x0300}, VR: "ST", Name: "RETIRED_Impressions", VM: "1", Retired: true}
+	tagDict[Tag{0x4008, 0x4000}] = TagInfo{Tag: Tag{0x4008, 0x4000}, VR: "ST", Name: "RETIRED_ResultsComments", VM: "1", Retired: true}
+	tagDict[Tag{0x7FE0, 0x0020}] = TagInfo{Tag: Tag{0x7FE0, 0x0020}, VR: "OW", Name: "RETIRED_CoefficientsSDVN", VM: "1", Retired: true}
+	tagDict[Tag{0x7FE0, 0x0030}] = TagInfo{Tag: Tag{0x7FE0, 0x0030}, VR: "OW", Name: "RETIRED_CoefficientsSDHN", VM: "1", Retired: true}
+	tagDict[Tag{0x7FE0, 0x0040}] = TagInfo{Tag: Tag{0x7FE0, 0x0040}, VR: "OW", Name: "RETIRED_CoefficientsSDDN", VM: "1", Retired: true}
+}
+
+var repeatingDict = []repeatingTagInfo{
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0010, 0x0010, 1}, TagInfo{Tag: Tag{0x6000, 0x0010}, VR: "US", Name: "OverlayRows", VM: "1"}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0011, 0x0011, 1}, TagInfo{Tag: Tag{0x6000, 0x0011}, VR: "US", Name: "OverlayColumns", VM: "1"}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0015, 0x0015, 1}, TagInfo{Tag: Tag{0x6000, 0x0015}, VR: "IS", Name: "NumberOfFramesInOverlay", VM: "1"}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0022, 0x0022, 1}, TagInfo{Tag: Tag{0x6000, 0x0022}, VR: "LO", Name: "OverlayDescription", VM: "1"}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0040, 0x0040, 1}, TagInfo{Tag: Tag{0x6000, 0x0040}, VR: "CS", Name: "OverlayType", VM: "1"}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0045, 0x0045, 1}, TagInfo{Tag: Tag{0x6000, 0x0045}, VR: "LO", Name: "OverlaySubtype", VM: "1"}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0050, 0x0050, 1}, TagInfo{Tag: Tag{0x6000, 0x0050}, VR: "SS", Name: "OverlayOrigin", VM: "2"}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0051, 0x0051, 1}, TagInfo{Tag: Tag{0x6000, 0x0051}, VR: "US", Name: "ImageFrameOrigin", VM: "1"}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0100, 0x0100, 1}, TagInfo{Tag: Tag{0x6000, 0x0100}, VR: "US", Name: "OverlayBitsAllocated", VM: "1"}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0102, 0x0102, 1}, TagInfo{Tag: Tag{0x6000, 0x0102}, VR: "US", Name: "OverlayBitPosition", VM: "1"}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x1001, 0x1001, 1}, TagInfo{Tag: Tag{0x6000, 0x1001}, VR: "CS", Name: "OverlayActivationLayer", VM: "1"}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x1301, 0x1301, 1}, TagInfo{Tag: Tag{0x6000, 0x1301}, VR: "IS", Name: "ROIArea", VM: "1"}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x1302, 0x1302, 1}, TagInfo{Tag: Tag{0x6000, 0x1302}, VR: "DS", Name: "ROIMean", VM: "1"}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x1303, 0x1303, 1}, TagInfo{Tag: Tag{0x6000, 0x1303}, VR: "DS", Name: "ROIStandardDeviation", VM: "1"}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x1500, 0x1500, 1}, TagInfo{Tag: Tag{0x6000, 0x1500}, VR: "LO", Name: "OverlayLabel", VM: "1"}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x3000, 0x3000, 1}, TagInfo{Tag: Tag{0x6000, 0x3000}, VR: "OW", VRs: []string{"OW", "OB"}, Name: "OverlayData", VM: "1"}},
+	{tagRange{0x0020, 0x0020, 1}, tagRange{0x3100, 0x31FF, 2}, TagInfo{Tag: Tag{0x0020, 0x3100}, VR: "LO", Name: "ACR_NEMA_SourceImageID", VM: "1-n"}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0110, 0x0110, 1}, TagInfo{Tag: Tag{0x6000, 0x0110}, VR: "CS", Name: "ACR_NEMA_OverlayFormat", VM: "1"}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0200, 0x0200, 1}, TagInfo{Tag: Tag{0x6000, 0x0200}, VR: "US", Name: "ACR_NEMA_OverlayLocation", VM: "1"}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x4000, 0x4000, 1}, TagInfo{Tag: Tag{0x6000, 0x4000}, VR: "LT", Name: "ACR_NEMA_OverlayComments", VM: "1-n"}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0060, 0x0060, 1}, TagInfo{Tag: Tag{0x6000, 0x0060}, VR: "CS", Name: "ACR_NEMA_2C_OverlayCompressionCode", VM: "1"}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0061, 0x0061, 1}, TagInfo{Tag: Tag{0x6000, 0x0061}, VR: "SH", Name: "ACR_NEMA_2C_OverlayCompressionOriginator", VM: "1"}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0062, 0x0062, 1}, TagInfo{Tag: Tag{0x6000, 0x0062}, VR: "SH", Name: "ACR_NEMA_2C_OverlayCompressionLabel", VM: "1"}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0063, 0x0063, 1}, TagInfo{Tag: Tag{0x6000, 0x0063}, VR: "SH", Name: "ACR_NEMA_2C_OverlayCompressionDescription", VM: "1"}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0066, 0x0066, 1}, TagInfo{Tag: Tag{0x6000, 0x0066}, VR: "AT", Name: "ACR_NEMA_2C_OverlayCompressionStepPointers", VM: "1-n"}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0068, 0x0068, 1}, TagInfo{Tag: Tag{0x6000, 0x0068}, VR: "US", Name: "ACR_NEMA_2C_OverlayRepeatInterval", VM: "1"}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0069, 0x0069, 1}, TagInfo{Tag: Tag{0x6000, 0x0069}, VR: "US", Name: "ACR_NEMA_2C_OverlayBitsGrouped", VM: "1"}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0800, 0x0800, 1}, TagInfo{Tag: Tag{0x6000, 0x0800}, VR: "CS", Name: "ACR_NEMA_2C_OverlayCodeLabel", VM: "1-n"}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0802, 0x0802, 1}, TagInfo{Tag: Tag{0x6000, 0x0802}, VR: "US", Name: "ACR_NEMA_2C_OverlayNumberOfTables", VM: "1"}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0803, 0x0803, 1}, TagInfo{Tag: Tag{0x6000, 0x0803}, VR: "AT", Name: "ACR_NEMA_2C_OverlayCodeTableLocation", VM: "1-n"}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0804, 0x0804, 1}, TagInfo{Tag: Tag{0x6000, 0x0804}, VR: "US", Name: "ACR_NEMA_2C_OverlayBitsForCodeWord", VM: "1"}},
+	{tagRange{0x7F00, 0x7FFF, 2}, tagRange{0x0000, 0x0000, 1}, TagInfo{Tag: Tag{0x7F00, 0x0000}, VR: "UL", Name: "ACR_NEMA_2C_VariablePixelDataGroupLength", VM: "1"}},
+	{tagRange{0x7F00, 0x7FFF, 2}, tagRange{0x0010, 0x0010, 1}, TagInfo{Tag: Tag{0x7F00, 0x0010}, VR: "OW", VRs: []string{"OW", "OB"}, Name: "ACR_NEMA_2C_VariablePixelData", VM: "1"}},
+	{tagRange{0x7F00, 0x7FFF, 2}, tagRange{0x0011, 0x0011, 1}, TagInfo{Tag: Tag{0x7F00, 0x0011}, VR: "AT", Name: "ACR_NEMA_2C_VariableNextDataGroup", VM: "1"}},
+	{tagRange{0x7F00, 0x7FFF, 2}, tagRange{0x0020, 0x0020, 1}, TagInfo{Tag: Tag{0x7F00, 0x0020}, VR: "OW", Name: "ACR_NEMA_2C_VariableCoefficientsSDVN", VM: "1-n"}},
+	{tagRange{0x7F00, 0x7FFF, 2}, tagRange{0x0030, 0x0030, 1}, TagInfo{Tag: Tag{0x7F00, 0x0030}, VR: "OW", Name: "ACR_NEMA_2C_VariableCoefficientsSDHN", VM: "1-n"}},
+	{tagRange{0x7F00, 0x7FFF, 2}, tagRange{0x0040, 0x0040, 1}, TagInfo{Tag: Tag{0x7F00, 0x0040}, VR: "OW", Name: "ACR_NEMA_2C_VariableCoefficientsSDDN", VM: "1-n"}},
+	{tagRange{0x0020, 0x0020, 1}, tagRange{0x3100, 0x31FF, 2}, TagInfo{Tag: Tag{0x0020, 0x3100}, VR: "CS", Name: "RETIRED_SourceImageIDs", VM: "1-n", Retired: true}},
+	{tagRange{0x5000, 0x50FF, 2}, tagRange{0x0005, 0x0005, 1}, TagInfo{Tag: Tag{0x5000, 0x0005}, VR: "US", Name: "RETIRED_CurveDimensions", VM: "1", Retired: true}},
+	{tagRange{0x5000, 0x50FF, 2}, tagRange{0x0010, 0x0010, 1}, TagInfo{Tag: Tag{0x5000, 0x0010}, VR: "US", Name: "RETIRED_NumberOfPoints", VM: "1", Retired: true}},
+	{tagRange{0x5000, 0x50FF, 2}, tagRange{0x0020, 0x0020, 1}, TagInfo{Tag: Tag{0x5000, 0x0020}, VR: "CS", Name: "RETIRED_TypeOfData", VM: "1", Retired: true}},
+	{tagRange{0x5000, 0x50FF, 2}, tagRange{0x0022, 0x0022, 1}, TagInfo{Tag: Tag{0x5000, 0x0022}, VR: "LO", Name: "RETIRED_CurveDescription", VM: "1", Retired: true}},
+	{tagRange{0x5000, 0x50FF, 2}, tagRange{0x0030, 0x0030, 1}, TagInfo{Tag: Tag{0x5000, 0x0030}, VR: "SH", Name: "RETIRED_AxisUnits", VM: "1-n", Retired: true}},
+	{tagRange{0x5000, 0x50FF, 2}, tagRange{0x0040, 0x0040, 1}, TagInfo{Tag: Tag{0x5000, 0x0040}, VR: "SH", Name: "RETIRED_AxisLabels", VM: "1-n", Retired: true}},
+	{tagRange{0x5000, 0x50FF, 2}, tagRange{0x0103, 0x0103, 1}, TagInfo{Tag: Tag{0x5000, 0x0103}, VR: "US", Name: "RETIRED_DataValueRepresentation", VM: "1", Retired: true}},
+	{tagRange{0x5000, 0x50FF, 2}, tagRange{0x0104, 0x0104, 1}, TagInfo{Tag: Tag{0x5000, 0x0104}, VR: "US", Name: "RETIRED_MinimumCoordinateValue", VM: "1-n", Retired: true}},
+	{tagRange{0x5000, 0x50FF, 2}, tagRange{0x0105, 0x0105, 1}, TagInfo{Tag: Tag{0x5000, 0x0105}, VR: "US", Name: "RETIRED_MaximumCoordinateValue", VM: "1-n", Retired: true}},
+	{tagRange{0x5000, 0x50FF, 2}, tagRange{0x0106, 0x0106, 1}, TagInfo{Tag: Tag{0x5000, 0x0106}, VR: "SH", Name: "RETIRED_CurveRange", VM: "1-n", Retired: true}},
+	{tagRange{0x5000, 0x50FF, 2}, tagRange{0x0110, 0x0110, 1}, TagInfo{Tag: Tag{0x5000, 0x0110}, VR: "US", Name: "RETIRED_CurveDataDescriptor", VM: "1-n", Retired: true}},
+	{tagRange{0x5000, 0x50FF, 2}, tagRange{0x0112, 0x0112, 1}, TagInfo{Tag: Tag{0x5000, 0x0112}, VR: "US", Name: "RETIRED_CoordinateStartValue", VM: "1-n", Retired: true}},
+	{tagRange{0x5000, 0x50FF, 2}, tagRange{0x0114, 0x0114, 1}, TagInfo{Tag: Tag{0x5000, 0x0114}, VR: "US", Name: "RETIRED_CoordinateStepValue", VM: "1-n", Retired: true}},
+	{tagRange{0x5000, 0x50FF, 2}, tagRange{0x1001, 0x1001, 1}, TagInfo{Tag: Tag{0x5000, 0x1001}, VR: "CS", Name: "RETIRED_CurveActivationLayer", VM: "1", Retired: true}},
+	{tagRange{0x5000, 0x50FF, 2}, tagRange{0x2000, 0x2000, 1}, TagInfo{Tag: Tag{0x5000, 0x2000}, VR: "US", Name: "RETIRED_AudioType", VM: "1", Retired: true}},
+	{tagRange{0x5000, 0x50FF, 2}, tagRange{0x2002, 0x2002, 1}, TagInfo{Tag: Tag{0x5000, 0x2002}, VR: "US", Name: "RETIRED_AudioSampleFormat", VM: "1", Retired: true}},
+	{tagRange{0x5000, 0x50FF, 2}, tagRange{0x2004, 0x2004, 1}, TagInfo{Tag: Tag{0x5000, 0x2004}, VR: "US", Name: "RETIRED_NumberOfChannels", VM: "1", Retired: true}},
+	{tagRange{0x5000, 0x50FF, 2}, tagRange{0x2006, 0x2006, 1}, TagInfo{Tag: Tag{0x5000, 0x2006}, VR: "UL", Name: "RETIRED_NumberOfSamples", VM: "1", Retired: true}},
+	{tagRange{0x5000, 0x50FF, 2}, tagRange{0x2008, 0x2008, 1}, TagInfo{Tag: Tag{0x5000, 0x2008}, VR: "UL", Name: "RETIRED_SampleRate", VM: "1", Retired: true}},
+	{tagRange{0x5000, 0x50FF, 2}, tagRange{0x200A, 0x200A, 1}, TagInfo{Tag: Tag{0x5000, 0x200A}, VR: "UL", Name: "RETIRED_TotalTime", VM: "1", Retired: true}},
+	{tagRange{0x5000, 0x50FF, 2}, tagRange{0x200C, 0x200C, 1}, TagInfo{Tag: Tag{0x5000, 0x200C}, VR: "OW", VRs: []string{"OW", "OB"}, Name: "RETIRED_AudioSampleData", VM: "1", Retired: true}},
+	{tagRange{0x5000, 0x50FF, 2}, tagRange{0x200E, 0x200E, 1}, TagInfo{Tag: Tag{0x5000, 0x200E}, VR: "LT", Name: "RETIRED_AudioComments", VM: "1", Retired: true}},
+	{tagRange{0x5000, 0x50FF, 2}, tagRange{0x2500, 0x2500, 1}, TagInfo{Tag: Tag{0x5000, 0x2500}, VR: "LO", Name: "RETIRED_CurveLabel", VM: "1", Retired: true}},
+	{tagRange{0x5000, 0x50FF, 2}, tagRange{0x2600, 0x2600, 1}, TagInfo{Tag: Tag{0x5000, 0x2600}, VR: "SQ", Name: "RETIRED_CurveReferencedOverlaySequence", VM: "1", Retired: true}},
+	{tagRange{0x5000, 0x50FF, 2}, tagRange{0x2610, 0x2610, 1}, TagInfo{Tag: Tag{0x5000, 0x2610}, VR: "US", Name: "RETIRED_CurveReferencedOverlayGroup", VM: "1", Retired: true}},
+	{tagRange{0x5000, 0x50FF, 2}, tagRange{0x3000, 0x3000, 1}, TagInfo{Tag: Tag{0x5000, 0x3000}, VR: "OW", VRs: []string{"OW", "OB"}, Name: "RETIRED_CurveData", VM: "1", Retired: true}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0012, 0x0012, 1}, TagInfo{Tag: Tag{0x6000, 0x0012}, VR: "US", Name: "RETIRED_OverlayPlanes", VM: "1", Retired: true}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0052, 0x0052, 1}, TagInfo{Tag: Tag{0x6000, 0x0052}, VR: "US", Name: "RETIRED_OverlayPlaneOrigin", VM: "1", Retired: true}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0060, 0x0060, 1}, TagInfo{Tag: Tag{0x6000, 0x0060}, VR: "CS", Name: "RETIRED_OverlayCompressionCode", VM: "1", Retired: true}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0061, 0x0061, 1}, TagInfo{Tag: Tag{0x6000, 0x0061}, VR: "SH", Name: "RETIRED_OverlayCompressionOriginator", VM: "1", Retired: true}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0062, 0x0062, 1}, TagInfo{Tag: Tag{0x6000, 0x0062}, VR: "SH", Name: "RETIRED_OverlayCompressionLabel", VM: "1", Retired: true}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0063, 0x0063, 1}, TagInfo{Tag: Tag{0x6000, 0x0063}, VR: "CS", Name: "RETIRED_OverlayCompressionDescription", VM: "1", Retired: true}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0066, 0x0066, 1}, TagInfo{Tag: Tag{0x6000, 0x0066}, VR: "AT", Name: "RETIRED_OverlayCompressionStepPointers", VM: "1-n", Retired: true}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0068, 0x0068, 1}, TagInfo{Tag: Tag{0x6000, 0x0068}, VR: "US", Name: "RETIRED_OverlayRepeatInterval", VM: "1", Retired: true}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0069, 0x0069, 1}, TagInfo{Tag: Tag{0x6000, 0x0069}, VR: "US", Name: "RETIRED_OverlayBitsGrouped", VM: "1", Retired: true}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0110, 0x0110, 1}, TagInfo{Tag: Tag{0x6000, 0x0110}, VR: "CS", Name: "RETIRED_OverlayFormat", VM: "1", Retired: true}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0200, 0x0200, 1}, TagInfo{Tag: Tag{0x6000, 0x0200}, VR: "US", Name: "RETIRED_OverlayLocation", VM: "1", Retired: true}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0800, 0x0800, 1}, TagInfo{Tag: Tag{0x6000, 0x0800}, VR: "CS", Name: "RETIRED_OverlayCodeLabel", VM: "1-n", Retired: true}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0802, 0x0802, 1}, TagInfo{Tag: Tag{0x6000, 0x0802}, VR: "US", Name: "RETIRED_OverlayNumberOfTables", VM: "1", Retired: true}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0803, 0x0803, 1}, TagInfo{Tag: Tag{0x6000, 0x0803}, VR: "AT", Name: "RETIRED_OverlayCodeTableLocation", VM: "1-n", Retired: true}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x0804, 0x0804, 1}, TagInfo{Tag: Tag{0x6000, 0x0804}, VR: "US", Name: "RETIRED_OverlayBitsForCodeWord", VM: "1", Retired: true}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x1100, 0x1100, 1}, TagInfo{Tag: Tag{0x6000, 0x1100}, VR: "US", Name: "RETIRED_OverlayDescriptorGray", VM: "1", Retired: true}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x1101, 0x1101, 1}, TagInfo{Tag: Tag{0x6000, 0x1101}, VR: "US", Name: "RETIRED_OverlayDescriptorRed", VM: "1", Retired: true}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x1102, 0x1102, 1}, TagInfo{Tag: Tag{0x6000, 0x1102}, VR: "US", Name: "RETIRED_OverlayDescriptorGreen", VM: "1", Retired: true}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x1103, 0x1103, 1}, TagInfo{Tag: Tag{0x6000, 0x1103}, VR: "US", Name: "RETIRED_OverlayDescriptorBlue", VM: "1", Retired: true}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x1200, 0x1200, 1}, TagInfo{Tag: Tag{0x6000, 0x1200}, VR: "US", Name: "RETIRED_OverlaysGray", VM: "1-n", Retired: true}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x1201, 0x1201, 1}, TagInfo{Tag: Tag{0x6000, 0x1201}, VR: "US", Name: "RETIRED_OverlaysRed", VM: "1-n", Retired: true}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x1202, 0x1202, 1}, TagInfo{Tag: Tag{0x6000, 0x1202}, VR: "US", Name: "RETIRED_OverlaysGreen", VM: "1-n", Retired: true}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x1203, 0x1203, 1}, TagInfo{Tag: Tag{0x6000, 0x1203}, VR: "US", Name: "RETIRED_OverlaysBlue", VM: "1-n", Retired: true}},
+	{tagRange{0x6000, 0x60FF, 2}, tagRange{0x4000, 0x4000, 1}, TagInfo{Tag: Tag{0x6000, 0x4000}, VR: "LT", Name: "RETIRED_OverlayComments", VM: "1", Retired: true}},
+	{tagRange{0x7F00, 0x7FFF, 2}, tagRange{0x0010, 0x0010, 1}, TagInfo{Tag: Tag{0x7F00, 0x0010}, VR: "OW", VRs: []string{"OW", "OB"}, Name: "RETIRED_VariablePixelData", VM: "1", Retired: true}},
+	{tagRange{0x7F00, 0x7FFF, 2}, tagRange{0x0011, 0x0011, 1}, TagInfo{Tag: Tag{0x7F00, 0x0011}, VR: "US", Name: "RETIRED_VariableNextDataGroup", VM: "1", Retired: true}},
+	{tagRange{0x7F00, 0x7FFF, 2}, tagRange{0x0020, 0x0020, 1}, TagInfo{Tag: Tag{0x7F00, 0x0020}, VR: "OW", Name: "RETIRED_VariableCoefficientsSDVN", VM: "1", Retired: true}},
+	{tagRange{0x7F00, 0x7FFF, 2}, tagRange{0x0030, 0x0030, 1}, TagInfo{Tag: Tag{0x7F00, 0x0030}, VR: "OW", Name: "RETIRED_VariableCoefficientsSDHN", VM: "1", Retired: true}},
+	{tagRange{0x7F00, 0x7FFF, 2}, tagRange{0x0040, 0x0040, 1}, TagInfo{Tag: Tag{0x7F00, 0x0040}, VR: "OW", Name: "RETIRED_VariableCoefficientsSDDN", VM: "1", Retired: true}},
 }
@@ -62,3 +62,167 @@ func BenchmarkFindPixelDataTag(b *testing.B) {
 
 	}
 }
+
+func TestFindPrivate(t *testing.T) {
+	SetPrivateDict(map[PrivateTag]TagInfo{
+		{Creator: "ACME 1.0", Group: 0x0029, Element: 0x10}: {VR: "DS", Name: "AcmeScale", VM: "1"},
+	})
+	defer SetPrivateDict(nil)
+
+	// The element is found in whichever block the creator reserved.
+	for _, tg := range []Tag{{0x0029, 0x1010}, {0x0029, 0x2310}} {
+		elem, err := FindPrivate(tg, "ACME 1.0")
+		if err != nil {
+			t.Fatalf("FindPrivate(%v): %v", tg, err)
+		}
+		if elem.Tag != tg || elem.VR != "DS" || elem.Name != "AcmeScale" || elem.Creator != "ACME 1.0" {
+			t.Errorf("FindPrivate(%v): got %+v", tg, elem)
+		}
+	}
+	if _, err := FindPrivate(Tag{0x0029, 0x1010}, "OTHER"); err == nil {
+		t.Error("FindPrivate with an unknown creator: expected an error")
+	}
+	if _, err := FindPrivate(Tag{0x0029, 0x1011}, "ACME 1.0"); err == nil {
+		t.Error("FindPrivate of an unknown element: expected an error")
+	}
+
+	elem, err := Find(Tag{0x0029, 0x0010})
+	if err != nil {
+		t.Fatalf("Find(private creator): %v", err)
+	}
+	if elem.VR != "LO" || elem.Name != "PrivateCreator" {
+		t.Errorf("Find(private creator): got %+v", elem)
+	}
+
+	if got, want := PrivateDebugString(Tag{0x0029, 0x1010}, "ACME 1.0"), "(0029,1010)[ACME 1.0:AcmeScale]"; got != want {
+		t.Errorf("PrivateDebugString: got %q, want %q", got, want)
+	}
+	if got, want := PrivateDebugString(Tag{0x0029, 0x1011}, "ACME 1.0"), "(0029,1011)[ACME 1.0:private]"; got != want {
+		t.Errorf("PrivateDebugString: got %q, want %q", got, want)
+	}
+}
+
+func TestFind_Metadata(t *testing.T) {
+	cases := []struct {
+		tg      Tag
+		vr      string
+		vrs     []string
+		retired bool
+		source  Source
+	}{
+		{tg: PixelData, vr: "OW", vrs: []string{"OW", "OB"}, source: SourceStandard},
+		{tg: SmallestImagePixelValue, vr: "US", vrs: []string{"US", "SS"}, source: SourceStandard},
+		{tg: Tag{0x0004, 0x1200}, vr: "UL", source: SourceStandard},
+		{tg: Tag{0x0008, 0x0010}, vr: "SH", retired: true, source: SourceStandard},
+		{tg: Tag{0x6002, 0x3000}, vr: "OW", vrs: []string{"OW", "OB"}, source: SourceRepeating},
+		{tg: Tag{0x0029, 0x0010}, vr: "LO", source: SourceRepeating},
+	}
+	for _, tc := range cases {
+		elem, err := Find(tc.tg)
+		if err != nil {
+			t.Errorf("Find(%v): %v", tc.tg, err)
+			continue
+		}
+		if elem.Tag != tc.tg || elem.VR != tc.vr || elem.Retired != tc.retired || elem.Source != tc.source {
+			t.Errorf("Find(%v): got %+v", tc.tg, elem)
+		}
+		if len(elem.VRs) != len(tc.vrs) {
+			t.Errorf("Find(%v): got VRs %v, want %v", tc.tg, elem.VRs, tc.vrs)
+		}
+		for _, vr := range append([]string{tc.vr}, tc.vrs...) {
+			if !elem.HasVR(vr) {
+				t.Errorf("Find(%v).HasVR(%s) = false, want true", tc.tg, vr)
+			}
+		}
+	}
+	if _, err := Find(Tag{0x6001, 0x3000}); err == nil {
+		t.Error("Find of an odd overlay group: expected an error")
+	}
+}
+
+func TestFindByName_Index(t *testing.T) {
+	for name, want := range map[string]Tag{
+		"PixelData":               PixelData,
+		"SmallestImagePixelValue": SmallestImagePixelValue,
+		"OverlayData":             {0x6000, 0x3000},
+	} {
+		elem, err := FindByName(name)
+		if err != nil {
+			t.Errorf("FindByName(%s): %v", name, err)
+			continue
+		}
+		if elem.Tag != want || elem.Name != name {
+			t.Errorf("FindByName(%s): got %+v, want tag %v", name, elem, want)
+		}
+	}
+	if elem, err := FindByName("RETIRED_GeneralPurposeScheduledProcedureStepStatus"); err != nil || !elem.Retired {
+		t.Errorf("FindByName of a retired tag: got %+v, %v", elem, err)
+	}
+	if _, err := FindByName("NoSuchKeyword"); err == nil {
+		t.Error("FindByName(NoSuchKeyword): expected an error")
+	}
+
+	SetCustomDict(map[Tag]TagInfo{{0x0009, 0x1001}: {Tag: Tag{0x0009, 0x1001}, VR: "LO", Name: "AcmeBlock", VM: "1"}})
+	defer SetCustomDict(nil)
+	elem, err := FindByName("AcmeBlock")
+	if err != nil || elem.Tag != (Tag{0x0009, 0x1001}) || elem.Source != SourceCustom {
+		t.Errorf("FindByName(AcmeBlock): got %+v, %v", elem, err)
+	}
+}
+
+func TestParseVM(t *testing.T) {
+	cases := []struct {
+		vm      string
+		want    Multiplicity
+		allowed []int
+		denied  []int
+	}{
+		{vm: "1", want: Multiplicity{Min: 1, Max: 1, Step: 1}, allowed: []int{1}, denied: []int{0, 2}},
+		{vm: "1-3", want: Multiplicity{Min: 1, Max: 3, Step: 1}, allowed: []int{1, 3}, denied: []int{0, 4}},
+		{vm: "1-n", want: Multiplicity{Min: 1, Max: -1, Step: 1}, allowed: []int{1, 100}, denied: []int{0}},
+		{vm: "2-2n", want: Multiplicity{Min: 2, Max: -1, Step: 2}, allowed: []int{2, 4, 6}, denied: []int{1, 3, 5}},
+		{vm: "3-3n", want: Multiplicity{Min: 3, Max: -1, Step: 3}, allowed: []int{3, 6}, denied: []int{4, 5}},
+	}
+	for _, tc := range cases {
+		got, err := ParseVM(tc.vm)
+		if err != nil {
+			t.Errorf("ParseVM(%q): %v", tc.vm, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("ParseVM(%q): got %+v, want %+v", tc.vm, got, tc.want)
+		}
+		if got.String() != tc.vm {
+			t.Errorf("ParseVM(%q).String() = %q", tc.vm, got.String())
+		}
+		for _, n := range tc.allowed {
+			if !got.Allows(n) {
+				t.Errorf("ParseVM(%q).Allows(%d) = false, want true", tc.vm, n)
+			}
+		}
+		for _, n := range tc.denied {
+			if got.Allows(n) {
+				t.Errorf("ParseVM(%q).Allows(%d) = true, want false", tc.vm, n)
+			}
+		}
+	}
+	for _, vm := range []string{"", "n", "2-1", "1-xn", "1-2-3"} {
+		if _, err := ParseVM(vm); err == nil {
+			t.Errorf("ParseVM(%q): expected an error", vm)
+		}
+	}
+}
+
+func TestParseVM_Dictionary(t *testing.T) {
+	maybeInitTagDict()
+	for tg, info := range tagDict {
+		if _, err := info.Multiplicity(); err != nil {
+			t.Errorf("%v: %v", tg, err)
+		}
+	}
+	for _, r := range repeatingDict {
+		if _, err := r.info.Multiplicity(); err != nil {
+			t.Errorf("%v: %v", r.info.Tag, err)
+		}
+	}
+}
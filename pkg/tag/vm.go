@@ -0,0 +1,68 @@
+package tag
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Multiplicity is a parsed Value Multiplicity (VM), the number of values an
+// element may have. See PS3.5 6.4.
+type Multiplicity struct {
+	// Min is the minimum number of values.
+	Min int
+	// Max is the maximum number of values, or -1 if it is unbounded.
+	Max int
+	// Step is the increment between allowed numbers of values, e.g. 2 for
+	// "2-2n".
+	Step int
+}
+
+// ParseVM parses a VM of the forms "2", "1-3", "1-n" and "2-2n".
+func ParseVM(vm string) (Multiplicity, error) {
+	vm = strings.TrimSpace(vm)
+	parts := strings.Split(vm, "-")
+	min, err := strconv.Atoi(parts[0])
+	if err != nil || min < 0 || len(parts) > 2 {
+		return Multiplicity{}, fmt.Errorf("invalid VM %q", vm)
+	}
+	if len(parts) == 1 {
+		return Multiplicity{Min: min, Max: min, Step: 1}, nil
+	}
+
+	if max := parts[1]; strings.HasSuffix(max, "n") {
+		step := 1
+		if max != "n" {
+			if step, err = strconv.Atoi(strings.TrimSuffix(max, "n")); err != nil || step < 1 {
+				return Multiplicity{}, fmt.Errorf("invalid VM %q", vm)
+			}
+		}
+		return Multiplicity{Min: min, Max: -1, Step: step}, nil
+	}
+	max, err := strconv.Atoi(parts[1])
+	if err != nil || max < min {
+		return Multiplicity{}, fmt.Errorf("invalid VM %q", vm)
+	}
+	return Multiplicity{Min: min, Max: max, Step: 1}, nil
+}
+
+// Allows reports whether an element with n values satisfies m.
+func (m Multiplicity) Allows(n int) bool {
+	if n < m.Min || m.Max >= 0 && n > m.Max {
+		return false
+	}
+	return m.Step <= 1 || (n-m.Min)%m.Step == 0
+}
+
+// String returns m in the notation of PS3.6, e.g. "1-n" or "2-2n".
+func (m Multiplicity) String() string {
+	switch {
+	case m.Max == m.Min:
+		return strconv.Itoa(m.Min)
+	case m.Max >= 0:
+		return fmt.Sprintf("%d-%d", m.Min, m.Max)
+	case m.Step > 1:
+		return fmt.Sprintf("%d-%dn", m.Min, m.Step)
+	}
+	return fmt.Sprintf("%d-n", m.Min)
+}
@@ -0,0 +1,125 @@
+package uid
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// MaxLength is the maximum length of a UID, see PS3.5 9.1.
+const MaxLength = 64
+
+// UUIDRoot is the root of the UIDs derived from UUIDs, see PS3.5 B.2.
+const UUIDRoot = "2.25"
+
+// ErrorInvalidRoot is returned by NewGenerator when the org root is not a
+// valid UID or leaves no room for the generated suffix.
+var ErrorInvalidRoot = errors.New("invalid UID root")
+
+// IsValidUID reports whether uid follows the rules of PS3.5 9.1: at most 64
+// characters of numeric components separated by periods, where components
+// have no leading zeros unless they are a single zero. A single trailing NULL
+// padding byte is allowed.
+func IsValidUID(uid string) bool {
+	uid = strings.TrimSuffix(uid, "\x00")
+	if uid == "" || len(uid) > MaxLength {
+		return false
+	}
+	for _, c := range strings.Split(uid, ".") {
+		if c == "" || (c[0] == '0' && len(c) > 1) {
+			return false
+		}
+		for i := 0; i < len(c); i++ {
+			if c[i] < '0' || c[i] > '9' {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// NewUUIDUID returns a UID of the form 2.25.<uuid as decimal>, with a random
+// version 4 UUID.
+func NewUUIDUID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(err)
+	}
+	b[6] = b[6]&0x0f | 0x40 // Version 4
+	b[8] = b[8]&0x3f | 0x80 // Variant RFC 4122
+	return uuidUID(b[:])
+}
+
+// DeriveUID returns a UID of the form 2.25.<uuid as decimal>, with the UUID
+// derived from uid and salt with HMAC-SHA256. The same uid and salt always
+// give the same UID. Keep salt secret if uid must not be confirmed from the
+// derived UID.
+func DeriveUID(uid string, salt []byte) string {
+	mac := hmac.New(sha256.New, salt)
+	mac.Write([]byte(uid))
+	b := mac.Sum(nil)[:16]
+	b[6] = b[6]&0x0f | 0x80 // Version 8
+	b[8] = b[8]&0x3f | 0x80 // Variant RFC 4122
+	return uuidUID(b)
+}
+
+func uuidUID(b []byte) string {
+	return UUIDRoot + "." + new(big.Int).SetBytes(b).String()
+}
+
+// minSuffixLength is the length of the shortest suffix a Generator appends
+// to its root: a period, a 10 digit timestamp, a period and a counter of up
+// to 10 digits.
+const minSuffixLength = 1 + 10 + 1 + 10
+
+// Generator generates UIDs under an org root registered by the caller. It is
+// safe for concurrent use.
+type Generator struct {
+	root    string
+	counter uint32
+}
+
+// NewGenerator returns a Generator of UIDs under root. It returns
+// ErrorInvalidRoot unless root is a valid UID of at most 42 characters,
+// which leaves room for the suffix.
+func NewGenerator(root string) (*Generator, error) {
+	if !IsValidUID(root) || len(root) > MaxLength-minSuffixLength {
+		return nil, fmt.Errorf("%w: %q", ErrorInvalidRoot, root)
+	}
+	var b [4]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return nil, err
+	}
+	// Start the counter at random to make collisions between processes
+	// started in the same second unlikely.
+	counter := uint32(b[0])<<24 | uint32(b[1])<<16 | uint32(b[2])<<8 | uint32(b[3])
+	return &Generator{root: root, counter: counter}, nil
+}
+
+// New returns a new UID of the form <root>.<unix time>.<counter>.<random>,
+// where the random component is shortened to keep the UID within 64
+// characters, or left out if there is no room for it.
+func (g *Generator) New() string {
+	uid := g.root + "." + strconv.FormatInt(time.Now().Unix(), 10) + "." +
+		strconv.FormatUint(uint64(atomic.AddUint32(&g.counter, 1)), 10)
+	room := MaxLength - len(uid) - 1
+	if room <= 0 {
+		return uid
+	}
+	n, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 64))
+	if err != nil {
+		panic(err)
+	}
+	random := n.String()
+	if len(random) > room {
+		random = random[:room]
+	}
+	return uid + "." + random
+}
@@ -0,0 +1,90 @@
+package uid
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestIsValidUID(t *testing.T) {
+	cases := []struct {
+		uid  string
+		want bool
+	}{
+		{"1.2.840.10008.1.2", true},
+		{"1.2.0.3", true},
+		{"1.2.3\x00", true},
+		{"0", true},
+		{"", false},
+		{"1.02.3", false},
+		{"1..2", false},
+		{"1.2.", false},
+		{".1.2", false},
+		{"1.2a.3", false},
+		{"1.2.3 ", false},
+		{"1." + strings.Repeat("2", 62), true},
+		{"1." + strings.Repeat("2", 63), false},
+	}
+	for _, tc := range cases {
+		if got := IsValidUID(tc.uid); got != tc.want {
+			t.Errorf("IsValidUID(%q): got %v, want %v", tc.uid, got, tc.want)
+		}
+	}
+}
+
+func TestNewUUIDUID(t *testing.T) {
+	a, b := NewUUIDUID(), NewUUIDUID()
+	for _, u := range []string{a, b} {
+		if !strings.HasPrefix(u, UUIDRoot+".") || !IsValidUID(u) {
+			t.Errorf("NewUUIDUID: got invalid UID %q", u)
+		}
+	}
+	if a == b {
+		t.Errorf("NewUUIDUID: got %q twice", a)
+	}
+}
+
+func TestDeriveUID(t *testing.T) {
+	salt := []byte("salt")
+	a := DeriveUID("1.2.3.4", salt)
+	if !strings.HasPrefix(a, UUIDRoot+".") || !IsValidUID(a) {
+		t.Errorf("DeriveUID: got invalid UID %q", a)
+	}
+	if b := DeriveUID("1.2.3.4", salt); a != b {
+		t.Errorf("DeriveUID: got %q and %q for the same input", a, b)
+	}
+	if b := DeriveUID("1.2.3.5", salt); a == b {
+		t.Errorf("DeriveUID: got %q for different UIDs", a)
+	}
+	if b := DeriveUID("1.2.3.4", []byte("pepper")); a == b {
+		t.Errorf("DeriveUID: got %q for different salts", a)
+	}
+}
+
+func TestGenerator(t *testing.T) {
+	for _, root := range []string{"1.2.3", "1.2.826.0.1.3680043.10.1234", "1." + strings.Repeat("2", 40)} {
+		g, err := NewGenerator(root)
+		if err != nil {
+			t.Fatalf("NewGenerator(%q): %v", root, err)
+		}
+		seen := map[string]bool{}
+		for i := 0; i < 100; i++ {
+			u := g.New()
+			if !strings.HasPrefix(u, root+".") || !IsValidUID(u) {
+				t.Fatalf("New: got invalid UID %q for root %q", u, root)
+			}
+			if seen[u] {
+				t.Fatalf("New: got %q twice", u)
+			}
+			seen[u] = true
+		}
+	}
+}
+
+func TestNewGenerator_InvalidRoot(t *testing.T) {
+	for _, root := range []string{"", "1.02", "1." + strings.Repeat("2", 41)} {
+		if _, err := NewGenerator(root); !errors.Is(err, ErrorInvalidRoot) {
+			t.Errorf("NewGenerator(%q): got %v, want %v", root, err, ErrorInvalidRoot)
+		}
+	}
+}
@@ -28,11 +28,18 @@ var (
 	ModalityWorklistInformationFind = standardUID("1.2.840.10008.5.1.4.31")
 	VerificationSOPClass            = standardUID("1.2.840.10008.1.1")
 
+	CTImageStorage               = standardUID("1.2.840.10008.5.1.4.1.1.2")
+	MRImageStorage               = standardUID("1.2.840.10008.5.1.4.1.1.4")
+	SecondaryCaptureImageStorage = standardUID("1.2.840.10008.5.1.4.1.1.7")
+	RTStructureSetStorage        = standardUID("1.2.840.10008.5.1.4.1.1.481.3")
+	EnhancedSRStorage            = standardUID("1.2.840.10008.5.1.4.1.1.88.22")
+
 	// https://www.dicomlibrary.com/dicom/transfer-syntax/
 	ImplicitVRLittleEndian         = standardUID("1.2.840.10008.1.2")
 	ExplicitVRLittleEndian         = standardUID("1.2.840.10008.1.2.1")
 	ExplicitVRBigEndian            = standardUID("1.2.840.10008.1.2.2")
 	DeflatedExplicitVRLittleEndian = standardUID("1.2.840.10008.1.2.1.99")
+	JPEGBaseline8Bit               = standardUID("1.2.840.10008.1.2.4.50")
 )
 
 // Info holds detailed information about a DICOM UID
@@ -0,0 +1,160 @@
+package dicom
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ginuerzh/dicom/pkg/tag"
+)
+
+var (
+	// ErrorInvalidPrivateGroup indicates that a group passed to
+	// NewPrivateElement cannot hold private elements. Private groups are the
+	// odd groups other than 0001, 0003, 0005, 0007 and FFFF.
+	ErrorInvalidPrivateGroup = errors.New("group is not a private group")
+	// ErrorInvalidPrivateCreator indicates that a private creator is empty or
+	// longer than the 64 characters allowed by its LO VR.
+	ErrorInvalidPrivateCreator = errors.New("invalid private creator")
+	// ErrorPrivateBlocksExhausted indicates that all private creator blocks
+	// (gggg,0010)-(gggg,00FF) of a group are already reserved.
+	ErrorPrivateBlocksExhausted = errors.New("no free private creator block in group")
+)
+
+// NewPrivateElement creates a new private Element in the block of group
+// reserved by creator, with a value built from the provided data (see
+// NewElementWithVR). If creator has not reserved a block in group yet, the
+// first free block is reserved by adding a Private Creator element
+// (gggg,00xx) to the Dataset. The returned element has the tag
+// (gggg,xxoo), where xx is the block and oo is offset, and is not added to the
+// Dataset.
+func (d *Dataset) NewPrivateElement(group uint16, creator string, offset uint8, vr string, data interface{}) (*Element, error) {
+	return newPrivateElement(d.Elements, d.Set, group, creator, offset, vr, data)
+}
+
+// NewPrivateElement is like Dataset.NewPrivateElement, but reserves the
+// private creator block in this sequence item.
+func (s *SequenceItemValue) NewPrivateElement(group uint16, creator string, offset uint8, vr string, data interface{}) (*Element, error) {
+	return newPrivateElement(s.elements, s.Set, group, creator, offset, vr, data)
+}
+
+func newPrivateElement(elems []*Element, set func(*Element), group uint16, creator string, offset uint8, vr string, data interface{}) (*Element, error) {
+	if !isPrivateGroup(group) {
+		return nil, fmt.Errorf("%w: %04x", ErrorInvalidPrivateGroup, group)
+	}
+	if creator == "" || len(creator) > 64 {
+		return nil, fmt.Errorf("%w: %q", ErrorInvalidPrivateCreator, creator)
+	}
+
+	block, ok := findPrivateCreator(elems, group, creator)
+	if !ok {
+		if block, ok = freePrivateBlock(elems, group); !ok {
+			return nil, fmt.Errorf("%w: %04x", ErrorPrivateBlocksExhausted, group)
+		}
+		creatorElem, err := NewElementWithVR(tag.Tag{Group: group, Element: uint16(block)}, "LO", []string{creator})
+		if err != nil {
+			return nil, err
+		}
+		set(creatorElem)
+	}
+	return NewElementWithVR(tag.Tag{Group: group, Element: uint16(block)<<8 | uint16(offset)}, vr, data)
+}
+
+// PrivateCreator returns the Private Creator that reserved the block holding
+// the private element t in this Dataset, or "" if t is not a private element
+// or its block is not reserved.
+func (d *Dataset) PrivateCreator(t tag.Tag) string {
+	if !isPrivateElement(t) {
+		return ""
+	}
+	creator, err := d.FindElementByTag(tag.PrivateCreatorTag(t))
+	if err != nil {
+		return ""
+	}
+	return firstString(creator)
+}
+
+// PrivateCreator is like Dataset.PrivateCreator, but looks up the block
+// reservation in this sequence item.
+func (s *SequenceItemValue) PrivateCreator(t tag.Tag) string {
+	return privateCreator(s.elements, t)
+}
+
+// TagInfo returns the dictionary information for t. Private elements are
+// looked up in the private dictionary of the creator that reserved their
+// block in this Dataset (see tag.FindPrivate), falling back to tag.Find.
+func (d *Dataset) TagInfo(t tag.Tag) (tag.TagInfo, error) {
+	return findTagInfo(t, d.PrivateCreator(t))
+}
+
+// TagInfo is like Dataset.TagInfo, but resolves private creators in this
+// sequence item.
+func (s *SequenceItemValue) TagInfo(t tag.Tag) (tag.TagInfo, error) {
+	return findTagInfo(t, s.PrivateCreator(t))
+}
+
+// findTagInfo looks t up in the private dictionary of creator, if any, and in
+// the standard and custom dictionaries otherwise.
+func findTagInfo(t tag.Tag, creator string) (tag.TagInfo, error) {
+	if creator != "" {
+		if info, err := tag.FindPrivate(t, creator); err == nil {
+			return info, nil
+		}
+	}
+	return tag.Find(t)
+}
+
+// privateCreator returns the Private Creator that reserved the block holding
+// the private element t among elems.
+func privateCreator(elems []*Element, t tag.Tag) string {
+	if !isPrivateElement(t) {
+		return ""
+	}
+	creatorTag := tag.PrivateCreatorTag(t)
+	for _, e := range elems {
+		if e.Tag == creatorTag {
+			return firstString(e)
+		}
+	}
+	return ""
+}
+
+// isPrivateElement reports whether t is a private element within a block
+// reserved by a Private Creator, i.e. (gggg,1000-FFFF) of a private group.
+func isPrivateElement(t tag.Tag) bool {
+	return isPrivateGroup(t.Group) && t.Element >= 0x1000
+}
+
+// isPrivateGroup reports whether group can hold private elements.
+func isPrivateGroup(group uint16) bool {
+	return tag.IsPrivate(group) && group > 0x0007 && group != 0xFFFF
+}
+
+// findPrivateCreator returns the block reserved by creator in group.
+func findPrivateCreator(elems []*Element, group uint16, creator string) (uint8, bool) {
+	for _, e := range elems {
+		if e.Tag.Group != group || !tag.IsPrivateCreator(e.Tag) {
+			continue
+		}
+		if firstString(e) == creator {
+			return uint8(e.Tag.Element), true
+		}
+	}
+	return 0, false
+}
+
+// freePrivateBlock returns the first private creator block of group that is
+// not reserved.
+func freePrivateBlock(elems []*Element, group uint16) (uint8, bool) {
+	used := make(map[uint16]bool)
+	for _, e := range elems {
+		if e.Tag.Group == group && tag.IsPrivateCreator(e.Tag) {
+			used[e.Tag.Element] = true
+		}
+	}
+	for block := uint16(0x0010); block <= 0x00FF; block++ {
+		if !used[block] {
+			return uint8(block), true
+		}
+	}
+	return 0, false
+}
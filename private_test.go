@@ -0,0 +1,143 @@
+package dicom
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/ginuerzh/dicom/pkg/tag"
+	"github.com/ginuerzh/dicom/pkg/uid"
+)
+
+func TestDataset_NewPrivateElement(t *testing.T) {
+	ds := Dataset{Elements: []*Element{
+		mustNewElement(tag.TransferSyntaxUID, []string{uid.ExplicitVRLittleEndian}),
+		mustNewElement(tag.Rows, []uint64{128}),
+	}}
+
+	acme, err := ds.NewPrivateElement(0x0029, "ACME 1.0", 0x10, "DS", []string{"2.5"})
+	if err != nil {
+		t.Fatalf("NewPrivateElement(ACME 1.0): %v", err)
+	}
+	if want := (tag.Tag{Group: 0x0029, Element: 0x1010}); acme.Tag != want {
+		t.Errorf("NewPrivateElement(ACME 1.0) tag: got %v, want %v", acme.Tag, want)
+	}
+	creator, err := ds.FindElementByTag(tag.Tag{Group: 0x0029, Element: 0x0010})
+	if err != nil {
+		t.Fatalf("private creator was not reserved: %v", err)
+	}
+	if got := MustGetStrings(creator.Value); len(got) != 1 || got[0] != "ACME 1.0" {
+		t.Errorf("unexpected private creator: %v", got)
+	}
+	ds.Set(acme)
+
+	// The same creator reuses its block, another creator gets the next one.
+	again, err := ds.NewPrivateElement(0x0029, "ACME 1.0", 0x11, "US", []uint64{7})
+	if err != nil {
+		t.Fatalf("NewPrivateElement(ACME 1.0) again: %v", err)
+	}
+	if want := (tag.Tag{Group: 0x0029, Element: 0x1011}); again.Tag != want {
+		t.Errorf("NewPrivateElement(ACME 1.0) again tag: got %v, want %v", again.Tag, want)
+	}
+	other, err := ds.NewPrivateElement(0x0029, "OTHER", 0x01, "LO", []string{"x"})
+	if err != nil {
+		t.Fatalf("NewPrivateElement(OTHER): %v", err)
+	}
+	if want := (tag.Tag{Group: 0x0029, Element: 0x1101}); other.Tag != want {
+		t.Errorf("NewPrivateElement(OTHER) tag: got %v, want %v", other.Tag, want)
+	}
+	ds.Set(again)
+	ds.Set(other)
+
+	if _, err := ds.NewPrivateElement(0x0028, "ACME 1.0", 0x10, "DS", []string{"1"}); !errors.Is(err, ErrorInvalidPrivateGroup) {
+		t.Errorf("NewPrivateElement in an even group: got %v, want %v", err, ErrorInvalidPrivateGroup)
+	}
+
+	// Private elements keep their own VR when written as explicit VR.
+	buf := &bytes.Buffer{}
+	if err := Write(buf, ds); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	parsed, err := Parse(buf, int64(buf.Len()), nil)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	for _, want := range []*Element{acme, again, other} {
+		got, err := parsed.FindElementByTag(want.Tag)
+		if err != nil {
+			t.Errorf("FindElementByTag(%v): %v", want.Tag, err)
+			continue
+		}
+		if got.RawValueRepresentation != want.RawValueRepresentation || !got.Value.Equal(want.Value) {
+			t.Errorf("%v: got %v %v, want %v %v", want.Tag, got.RawValueRepresentation, got.Value, want.RawValueRepresentation, want.Value)
+		}
+	}
+}
+
+func TestParse_ImplicitPrivateElements(t *testing.T) {
+	tag.SetPrivateDict(map[tag.PrivateTag]tag.TagInfo{
+		{Creator: "ACME 1.0", Group: 0x0029, Element: 0x10}: {VR: "DS", Name: "AcmeScale", VM: "1"},
+		{Creator: "OTHER", Group: 0x0029, Element: 0x10}:    {VR: "US", Name: "OtherCount", VM: "1"},
+	})
+	defer tag.SetPrivateDict(nil)
+
+	ds := Dataset{Elements: []*Element{
+		mustNewElement(tag.TransferSyntaxUID, []string{uid.ImplicitVRLittleEndian}),
+		mustNewElement(tag.Rows, []uint64{128}),
+	}}
+	acme, err := ds.NewPrivateElement(0x0029, "ACME 1.0", 0x10, "DS", []string{"2.5"})
+	if err != nil {
+		t.Fatalf("NewPrivateElement(ACME 1.0): %v", err)
+	}
+	ds.Set(acme)
+
+	// The same element number means something else in an item where another
+	// creator reserved the block.
+	item := &SequenceItemValue{}
+	other, err := item.NewPrivateElement(0x0029, "OTHER", 0x10, "US", []uint64{7})
+	if err != nil {
+		t.Fatalf("NewPrivateElement(OTHER): %v", err)
+	}
+	item.Set(other)
+	seq, err := NewElement(tag.AddOtherSequence, [][]*Element{item.elements})
+	if err != nil {
+		t.Fatalf("NewElement(%v): %v", tag.AddOtherSequence, err)
+	}
+	ds.Set(seq)
+
+	buf := &bytes.Buffer{}
+	if err := Write(buf, ds); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	parsed, err := Parse(buf, int64(buf.Len()), nil)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	got, err := parsed.FindElementByTag(acme.Tag)
+	if err != nil {
+		t.Fatalf("FindElementByTag(%v): %v", acme.Tag, err)
+	}
+	if got.RawValueRepresentation != "DS" || !got.Value.Equal(acme.Value) {
+		t.Errorf("%v: got %v %v, want DS %v", acme.Tag, got.RawValueRepresentation, got.Value, acme.Value)
+	}
+	if info, err := parsed.TagInfo(acme.Tag); err != nil || info.Name != "AcmeScale" || info.Creator != "ACME 1.0" {
+		t.Errorf("TagInfo(%v): got %+v, %v", acme.Tag, info, err)
+	}
+
+	gotSeq, err := parsed.FindElementByTag(tag.AddOtherSequence)
+	if err != nil {
+		t.Fatalf("FindElementByTag(%v): %v", tag.AddOtherSequence, err)
+	}
+	gotItem := gotSeq.Value.GetValue().([]*SequenceItemValue)[0]
+	got, err = gotItem.FindElementByTag(other.Tag)
+	if err != nil {
+		t.Fatalf("item FindElementByTag(%v): %v", other.Tag, err)
+	}
+	if got.RawValueRepresentation != "US" || !got.Value.Equal(other.Value) {
+		t.Errorf("item %v: got %v %v, want US %v", other.Tag, got.RawValueRepresentation, got.Value, other.Value)
+	}
+	if info, err := gotItem.TagInfo(other.Tag); err != nil || info.Name != "OtherCount" {
+		t.Errorf("item TagInfo(%v): got %+v, %v", other.Tag, info, err)
+	}
+}
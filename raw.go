@@ -0,0 +1,248 @@
+package dicom
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"hash"
+
+	"github.com/ginuerzh/dicom/pkg/dicomio"
+	"github.com/ginuerzh/dicom/pkg/tag"
+)
+
+// rawValue is the encoding of an Element as it was parsed with
+// RetainRawValues.
+type rawValue struct {
+	vr       string
+	vl       uint32
+	bo       binary.ByteOrder
+	implicit bool
+	// sequence is set for sequences, whose items and elements are retained
+	// individually instead of as data.
+	sequence bool
+	// data holds the value bytes exactly as they were read.
+	data []byte
+	// digest is the valueDigest of the parsed Value, used to detect
+	// modification without keeping a copy of it.
+	digest [sha256.Size]byte
+}
+
+// rawValues holds the retained encodings of the Elements of a Dataset parsed
+// with RetainRawValues.
+type rawValues struct {
+	// bo is the byte order the parser is currently reading with.
+	bo     binary.ByteOrder
+	values map[*Element]*rawValue
+	// unchanged caches whether the digest of an element still matches, so
+	// that a Writer computes it only once per element (see forWrite).
+	unchanged map[*Element]bool
+}
+
+func newRawValues() *rawValues {
+	return &rawValues{bo: binary.LittleEndian, values: make(map[*Element]*rawValue)}
+}
+
+// retain records the encoding elem was just read with from r. data holds the
+// recorded value bytes, and is ignored for sequences.
+func (rv *rawValues) retain(elem *Element, r dicomio.Reader, data []byte) {
+	raw := &rawValue{
+		vr:       elem.RawValueRepresentation,
+		vl:       elem.ValueLength,
+		bo:       rv.bo,
+		implicit: r.IsImplicit(),
+	}
+	if elem.ValueRepresentation == tag.VRSequence {
+		raw.sequence = true
+	} else {
+		raw.data = data
+		raw.digest = valueDigest(elem.Value)
+	}
+	rv.values[elem] = raw
+}
+
+// forWrite returns a view of rv for a single Writer, which caches the result
+// of comparing value digests. Elements must not be modified while the Writer
+// is in use.
+func (rv *rawValues) forWrite() *rawValues {
+	if rv == nil {
+		return nil
+	}
+	return &rawValues{bo: rv.bo, values: rv.values, unchanged: make(map[*Element]bool)}
+}
+
+// lookup returns the retained encoding of elem if it can be written as is to
+// w: elem must not have been modified since it was parsed, and w must use the
+// transfer syntax it was read with. For sequences only the VR and length
+// encoding are retained, and their elements are checked individually.
+func (rv *rawValues) lookup(w dicomio.Writer, elem *Element) *rawValue {
+	if rv == nil {
+		return nil
+	}
+	raw, ok := rv.values[elem]
+	if !ok {
+		return nil
+	}
+	bo, implicit := w.GetTransferSyntax()
+	if raw.bo != bo || raw.implicit != implicit || raw.vr != elem.RawValueRepresentation {
+		return nil
+	}
+	if raw.sequence {
+		if elem.Value == nil || elem.Value.ValueType() != Sequences {
+			return nil
+		}
+		return raw
+	}
+	if raw.vl != elem.ValueLength || elem.Value == nil {
+		return nil
+	}
+	unchanged, ok := rv.unchanged[elem]
+	if !ok {
+		unchanged = valueDigest(elem.Value) == raw.digest
+		if rv.unchanged != nil {
+			rv.unchanged[elem] = unchanged
+		}
+	}
+	if !unchanged {
+		return nil
+	}
+	return raw
+}
+
+// replace discards the retained encodings of old and of the elements nested
+// within it, except those still reachable from elem which replaces it. old
+// may be nil.
+func (rv *rawValues) replace(old, elem *Element) {
+	if rv == nil || old == nil || old == elem {
+		return
+	}
+	keep := map[*Element]bool{}
+	if elem != nil {
+		walkNested([]*Element{elem}, func(e *Element) { keep[e] = true })
+	}
+	walkNested([]*Element{old}, func(e *Element) {
+		if !keep[e] {
+			delete(rv.values, e)
+		}
+	})
+}
+
+// prune drops the retained encodings of elements that are no longer part of
+// elems. It walks the whole Dataset, so it is only used by Sort, which may
+// drop any number of duplicates.
+func (rv *rawValues) prune(elems []*Element) {
+	if rv == nil {
+		return
+	}
+	live := make(map[*Element]bool, len(rv.values))
+	walkNested(elems, func(e *Element) { live[e] = true })
+	for e := range rv.values {
+		if !live[e] {
+			delete(rv.values, e)
+		}
+	}
+}
+
+// walkNested calls fn for each of elems and every element nested within
+// their sequence items.
+func walkNested(elems []*Element, fn func(*Element)) {
+	for _, e := range elems {
+		fn(e)
+		if seq, ok := e.Value.(*sequencesValue); ok {
+			for _, item := range seq.value {
+				walkNested(item.elements, fn)
+			}
+		}
+	}
+}
+
+// valueDigest returns a SHA-256 digest of the contents of v, which is not a
+// sequence. Each part is written with its length so that different values
+// cannot have the same encoding.
+func valueDigest(v Value) [sha256.Size]byte {
+	d := digestWriter{sha256.New()}
+	d.int(int(v.ValueType()))
+	switch val := v.GetValue().(type) {
+	case []string:
+		d.int(len(val))
+		for _, s := range val {
+			d.bytes([]byte(s))
+		}
+	case []byte:
+		d.bytes(val)
+	case []int64:
+		d.int(len(val))
+		binary.Write(d, binary.LittleEndian, val)
+	case []uint64:
+		d.int(len(val))
+		binary.Write(d, binary.LittleEndian, val)
+	case []float64:
+		d.int(len(val))
+		binary.Write(d, binary.LittleEndian, val)
+	case PixelDataInfo:
+		binary.Write(d, binary.LittleEndian, val.IsEncapsulated)
+		d.int(len(val.Offsets))
+		binary.Write(d, binary.LittleEndian, val.Offsets)
+		d.int(len(val.Frames))
+		for _, f := range val.Frames {
+			binary.Write(d, binary.LittleEndian, f.Encapsulated)
+			d.bytes(f.EncapsulatedData.Data)
+			n := f.NativeData
+			for _, i := range []int{n.Rows, n.Cols, n.SamplesPerPixel, n.BitsPerSample} {
+				d.int(i)
+			}
+			d.bytes(n.Data)
+		}
+	}
+	var sum [sha256.Size]byte
+	copy(sum[:], d.Sum(nil))
+	return sum
+}
+
+// digestWriter writes the parts of a value to a hash for valueDigest.
+type digestWriter struct {
+	hash.Hash
+}
+
+func (d digestWriter) int(n int) {
+	binary.Write(d, binary.LittleEndian, int64(n))
+}
+
+func (d digestWriter) bytes(b []byte) {
+	d.int(len(b))
+	d.Write(b)
+}
+
+// cloneInto copies the retained encodings of oldElems to the corresponding
+// elements of newElems, which must be a deep copy of oldElems.
+func (rv *rawValues) cloneInto(out *rawValues, oldElems, newElems []*Element) {
+	for i, old := range oldElems {
+		if raw, ok := rv.values[old]; ok {
+			out.values[newElems[i]] = raw
+		}
+		oldSeq, ok := old.Value.(*sequencesValue)
+		if !ok {
+			continue
+		}
+		newSeq := newElems[i].Value.(*sequencesValue)
+		for j, item := range oldSeq.value {
+			rv.cloneInto(out, item.elements, newSeq.value[j].elements)
+		}
+	}
+}
+
+// writeRawElement writes elem exactly as it was parsed.
+func writeRawElement(w dicomio.Writer, t tag.Tag, raw *rawValue) error {
+	if err := w.WriteUInt16(t.Group); err != nil {
+		return err
+	}
+	if err := w.WriteUInt16(t.Element); err != nil {
+		return err
+	}
+	if raw.implicit {
+		if err := w.WriteUInt32(raw.vl); err != nil {
+			return err
+		}
+	} else if err := writeVRVL(w, t, raw.vr, raw.vl); err != nil {
+		return err
+	}
+	return w.WriteBytes(raw.data)
+}
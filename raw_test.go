@@ -0,0 +1,155 @@
+package dicom
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/ginuerzh/dicom/pkg/tag"
+)
+
+func TestRetainRawValues_RoundTrip(t *testing.T) {
+	files, err := ioutil.ReadDir("./testfiles")
+	if err != nil {
+		t.Fatalf("unable to read testfiles/: %v", err)
+	}
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), ".dcm") {
+			continue
+		}
+		t.Run(f.Name(), func(t *testing.T) {
+			data, err := ioutil.ReadFile("./testfiles/" + f.Name())
+			if err != nil {
+				t.Fatalf("ReadFile: %v", err)
+			}
+			ds, err := Parse(bytes.NewReader(data), int64(len(data)), nil, RetainRawValues())
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			for name, ds := range map[string]Dataset{"parsed": ds, "cloned": ds.Clone()} {
+				buf := &bytes.Buffer{}
+				if err := Write(buf, ds); err != nil {
+					t.Fatalf("Write(%s): %v", name, err)
+				}
+				if !bytes.Equal(buf.Bytes(), data) {
+					t.Errorf("Write(%s) did not reproduce the input byte for byte", name)
+				}
+			}
+		})
+	}
+}
+
+func TestRetainRawValues_Modified(t *testing.T) {
+	data, err := ioutil.ReadFile("./testfiles/1.dcm")
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	ds, err := Parse(bytes.NewReader(data), int64(len(data)), nil, RetainRawValues())
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	elem, err := ds.FindElementByTag(tag.StudyDescription)
+	if err != nil {
+		t.Fatalf("FindElementByTag(StudyDescription): %v", err)
+	}
+	original := elem.Value.Clone()
+
+	write := func() []byte {
+		t.Helper()
+		buf := &bytes.Buffer{}
+		if err := Write(buf, ds); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+		return buf.Bytes()
+	}
+
+	// Modify the value in place.
+	MustGetStrings(elem.Value)[0] = "MODIFIED"
+	modified := write()
+	if bytes.Equal(modified, data) {
+		t.Fatalf("Write after modifying StudyDescription reproduced the original bytes")
+	}
+	parsed, err := Parse(bytes.NewReader(modified), int64(len(modified)), nil)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	got, err := parsed.FindElementByTag(tag.StudyDescription)
+	if err != nil {
+		t.Fatalf("FindElementByTag(StudyDescription): %v", err)
+	}
+	if s := MustGetStrings(got.Value); len(s) != 1 || s[0] != "MODIFIED" {
+		t.Errorf("unexpected StudyDescription after modification: %v", s)
+	}
+
+	// Restoring the value restores the original encoding.
+	elem.Value = original
+	if !bytes.Equal(write(), data) {
+		t.Errorf("Write after restoring StudyDescription did not reproduce the input byte for byte")
+	}
+}
+
+func TestRetainRawValues_PixelDataModified(t *testing.T) {
+	data, err := ioutil.ReadFile("./testfiles/1.dcm")
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	ds, err := Parse(bytes.NewReader(data), int64(len(data)), nil, RetainRawValues())
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	elem, err := ds.FindElementByTag(tag.PixelData)
+	if err != nil {
+		t.Fatalf("FindElementByTag(PixelData): %v", err)
+	}
+	info := MustGetPixelDataInfo(elem.Value)
+	if info.IsEncapsulated {
+		info.Frames[0].EncapsulatedData.Data[0] ^= 0xff
+	} else {
+		info.Frames[0].NativeData.Data[0] ^= 0xff
+	}
+	buf := &bytes.Buffer{}
+	if err := Write(buf, ds); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if bytes.Equal(buf.Bytes(), data) {
+		t.Errorf("Write after modifying PixelData in place reproduced the original bytes")
+	}
+}
+
+func TestRetainRawValues_Prune(t *testing.T) {
+	data, err := ioutil.ReadFile("./testfiles/1.dcm")
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	ds, err := Parse(bytes.NewReader(data), int64(len(data)), nil, RetainRawValues())
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	old, err := ds.FindElementByTag(tag.StudyDescription)
+	if err != nil {
+		t.Fatalf("FindElementByTag(StudyDescription): %v", err)
+	}
+	ds.Set(mustNewElement(tag.StudyDescription, []string{"REPLACED"}))
+	if _, ok := ds.raw.values[old]; ok {
+		t.Errorf("Set kept the retained encoding of the replaced element")
+	}
+	rows, err := ds.FindElementByTag(tag.Rows)
+	if err != nil {
+		t.Fatalf("FindElementByTag(Rows): %v", err)
+	}
+	ds.Update(rows)
+	if _, ok := ds.raw.values[rows]; !ok {
+		t.Errorf("Update with the same element dropped its retained encoding")
+	}
+	pixelData, err := ds.FindElementByTag(tag.PixelData)
+	if err != nil {
+		t.Fatalf("FindElementByTag(PixelData): %v", err)
+	}
+	if err := ds.Delete(tag.PixelData); err != nil {
+		t.Fatalf("Delete(PixelData): %v", err)
+	}
+	if _, ok := ds.raw.values[pixelData]; ok {
+		t.Errorf("Delete kept the retained encoding of the deleted element")
+	}
+}
@@ -36,10 +36,18 @@ func readTag(r dicomio.Reader) (*tag.Tag, error) {
 	return nil, fmt.Errorf("error reading tag: %v %v", gerr, eerr)
 }
 
+// readVR reads the VR of the element t. In implicit VR transfer syntaxes the
+// VR is looked up in the dictionary instead, resolving private elements
+// through the Private Creator that reserved their block in d, the Dataset or
+// sequence item read so far, which may be nil.
 // TODO: Parsed VR should be an enum. Will require refactors of tag pkg.
-func readVR(r dicomio.Reader, isImplicit bool, t tag.Tag) (string, error) {
+func readVR(r dicomio.Reader, isImplicit bool, t tag.Tag, d *Dataset) (string, error) {
 	if isImplicit {
-		if entry, err := tag.Find(t); err == nil {
+		var creator string
+		if d != nil {
+			creator = d.PrivateCreator(t)
+		}
+		if entry, err := findTagInfo(t, creator); err == nil {
 			return entry.VR, nil
 		}
 		return tag.UNKNOWN, nil
@@ -85,7 +93,7 @@ func readVL(r dicomio.Reader, isImplicit bool, t tag.Tag, vr string) (uint32, er
 	}
 }
 
-func readValue(r dicomio.Reader, t tag.Tag, vr string, vl uint32, isImplicit bool, d *Dataset, fc chan<- *frame.Frame) (Value, error) {
+func readValue(r dicomio.Reader, t tag.Tag, vr string, vl uint32, isImplicit bool, d *Dataset, fc chan<- *frame.Frame, opts parseOptSet) (Value, error) {
 	vrkind := tag.GetVRKind(t, vr)
 	// TODO: if we keep consistent function signature, consider a static map of VR to func?
 	switch vrkind {
@@ -100,9 +108,9 @@ func readValue(r dicomio.Reader, t tag.Tag, vr string, vl uint32, isImplicit boo
 	case tag.VRUInt16List, tag.VRUInt32List, tag.VRUInt64List, tag.VRTagList:
 		return readUInt(r, t, vr, vl)
 	case tag.VRSequence:
-		return readSequence(r, t, vr, vl)
+		return readSequence(r, t, vr, vl, opts)
 	case tag.VRItem:
-		return readSequenceItem(r, t, vr, vl)
+		return readSequenceItem(r, t, vr, vl, opts)
 	case tag.VRPixelData:
 		return readPixelData(r, t, vr, vl, d, fc)
 	case tag.VRFloat32List, tag.VRFloat64List:
@@ -259,12 +267,12 @@ func readNativeFrames(d dicomio.Reader, parsedData *Dataset, vl int64, fc chan<-
 // readSequence reads a sequence element (VR = SQ) that contains a subset of Items. Each item contains
 // a set of Elements.
 // See http://dicom.nema.org/medical/dicom/current/output/chtml/part05/sect_7.5.2.html#table_7.5-1
-func readSequence(r dicomio.Reader, t tag.Tag, vr string, vl uint32) (Value, error) {
+func readSequence(r dicomio.Reader, t tag.Tag, vr string, vl uint32, opts parseOptSet) (Value, error) {
 	var sequences sequencesValue
 
 	if vl == tag.VLUndefinedLength {
 		for {
-			subElement, err := readElement(r, nil, nil)
+			subElement, err := readElement(r, nil, nil, opts)
 			if err != nil {
 				// Stop reading due to error
 				log.Println("error reading subitem, ", err)
@@ -291,7 +299,7 @@ func readSequence(r dicomio.Reader, t tag.Tag, vr string, vl uint32) (Value, err
 			return nil, err
 		}
 		for !r.IsLimitExhausted() {
-			subElement, err := readElement(r, nil, nil)
+			subElement, err := readElement(r, nil, nil, opts)
 			if err != nil {
 				// TODO: option to ignore errors parsing subelements?
 				return nil, err
@@ -308,8 +316,8 @@ func readSequence(r dicomio.Reader, t tag.Tag, vr string, vl uint32) (Value, err
 
 // readSequenceItem reads an item component of a sequence dicom element and returns an Element
 // with a SequenceItem value.
-func readSequenceItem(r dicomio.Reader, t tag.Tag, vr string, vl uint32) (Value, error) {
-	var sequenceItem SequenceItemValue
+func readSequenceItem(r dicomio.Reader, t tag.Tag, vr string, vl uint32, opts parseOptSet) (Value, error) {
+	sequenceItem := SequenceItemValue{definedLength: vl != tag.VLUndefinedLength}
 
 	// seqElements holds items read so far.
 	// TODO: deduplicate with sequenceItem above
@@ -317,7 +325,7 @@ func readSequenceItem(r dicomio.Reader, t tag.Tag, vr string, vl uint32) (Value,
 
 	if vl == tag.VLUndefinedLength {
 		for {
-			subElem, err := readElement(r, &seqElements, nil)
+			subElem, err := readElement(r, &seqElements, nil, opts)
 			if err != nil {
 				return nil, err
 			}
@@ -326,7 +334,7 @@ func readSequenceItem(r dicomio.Reader, t tag.Tag, vr string, vl uint32) (Value,
 			}
 
 			sequenceItem.elements = append(sequenceItem.elements, subElem)
-			seqElements.Elements = append(seqElements.Elements, subElem)
+			seqElements.appendElement(subElem)
 		}
 	} else {
 		err := r.PushLimit(int64(vl))
@@ -335,13 +343,13 @@ func readSequenceItem(r dicomio.Reader, t tag.Tag, vr string, vl uint32) (Value,
 		}
 
 		for !r.IsLimitExhausted() {
-			subElem, err := readElement(r, &seqElements, nil)
+			subElem, err := readElement(r, &seqElements, nil, opts)
 			if err != nil {
 				return nil, err
 			}
 
 			sequenceItem.elements = append(sequenceItem.elements, subElem)
-			seqElements.Elements = append(seqElements.Elements, subElem)
+			seqElements.appendElement(subElem)
 		}
 		r.PopLimit()
 	}
@@ -380,7 +388,11 @@ func readBytes(r dicomio.Reader, t tag.Tag, vr string, vl uint32) (Value, error)
 }
 
 func readString(r dicomio.Reader, t tag.Tag, vr string, vl uint32) (Value, error) {
-	str, err := r.ReadString(vl)
+	read := r.ReadString
+	if vr == "PN" {
+		read = r.ReadPersonName
+	}
+	str, err := read(vl)
 	onlySpaces := true
 	for _, char := range str {
 		if !unicode.IsSpace(char) {
@@ -517,7 +529,7 @@ func readUInt(r dicomio.Reader, t tag.Tag, vr string, vl uint32) (Value, error)
 // elements read so far, since previously read elements may be needed to parse
 // certain Elements (like native PixelData). If the Dataset is nil, it is
 // treated as an empty Dataset.
-func readElement(r dicomio.Reader, d *Dataset, fc chan<- *frame.Frame) (*Element, error) {
+func readElement(r dicomio.Reader, d *Dataset, fc chan<- *frame.Frame, opts parseOptSet) (*Element, error) {
 	t, err := readTag(r)
 	if err != nil {
 		return nil, err
@@ -529,7 +541,7 @@ func readElement(r dicomio.Reader, d *Dataset, fc chan<- *frame.Frame) (*Element
 		readImplicit = true
 	}
 
-	vr, err := readVR(r, readImplicit, *t)
+	vr, err := readVR(r, readImplicit, *t, d)
 	if err != nil {
 		return nil, err
 	}
@@ -539,13 +551,28 @@ func readElement(r dicomio.Reader, d *Dataset, fc chan<- *frame.Frame) (*Element
 		return nil, err
 	}
 
-	val, err := readValue(r, *t, vr, vl, readImplicit, d, fc)
+	vrKind := tag.GetVRKind(*t, vr)
+	// Sequences are not recorded as a whole, their elements are recorded
+	// individually instead.
+	record := opts.raw != nil && t.Group != tag.GROUP_ItemSeq && vrKind != tag.VRSequence && vrKind != tag.VRItem
+	if record {
+		r.StartRecording()
+	}
+	val, err := readValue(r, *t, vr, vl, readImplicit, d, fc, opts)
+	var data []byte
+	if record {
+		data = r.StopRecording()
+	}
 	if err != nil {
 		log.Println("error reading value", *t, vr, vl, readImplicit, err)
 		return nil, err
 	}
 
-	return &Element{Tag: *t, ValueRepresentation: tag.GetVRKind(*t, vr), RawValueRepresentation: vr, ValueLength: vl, Value: val}, nil
+	elem := &Element{Tag: *t, ValueRepresentation: vrKind, RawValueRepresentation: vr, ValueLength: vl, Value: val}
+	if opts.raw != nil && t.Group != tag.GROUP_ItemSeq {
+		opts.raw.retain(elem, r, data)
+	}
+	return elem, nil
 
 }
 
@@ -558,7 +585,7 @@ func readRawItem(r dicomio.Reader) ([]byte, bool, error) {
 		return nil, true, err
 	}
 	// Item is always encoded implicit. PS3.6 7.5
-	vr, err := readVR(r, true, *t)
+	vr, err := readVR(r, true, *t, nil)
 	if err != nil {
 		return nil, true, err
 	}
@@ -0,0 +1,440 @@
+package dicom
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	_ "crypto/sha1" // Registers SHA1 for verifying signatures.
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+	"crypto/x509"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ginuerzh/dicom/pkg/dicomio"
+	"github.com/ginuerzh/dicom/pkg/tag"
+	"github.com/ginuerzh/dicom/pkg/uid"
+)
+
+var (
+	// ErrorInvalidSignature indicates that a digital signature does not
+	// match the attributes it covers.
+	ErrorInvalidSignature = errors.New("invalid digital signature")
+	// ErrorUnsupportedSignature indicates a MAC algorithm, certificate type
+	// or key not supported for digital signatures.
+	ErrorUnsupportedSignature = errors.New("unsupported digital signature")
+)
+
+// x509CertificateType is the Certificate Type of X.509 certificates.
+const x509CertificateType = "X509_1993_SIG"
+
+// macAlgorithms maps the MAC Algorithms of PS3.3 C.12.1.1.3.1.2 to hashes.
+var macAlgorithms = map[string]crypto.Hash{
+	"SHA1":   crypto.SHA1,
+	"SHA256": crypto.SHA256,
+	"SHA384": crypto.SHA384,
+	"SHA512": crypto.SHA512,
+}
+
+// SignOption represents an option that can be passed to Dataset.Sign.
+type SignOption func(*signOptSet)
+
+// signOptSet represents the flattened option set after all SignOptions have been applied.
+type signOptSet struct {
+	algorithm      string
+	transferSyntax string
+}
+
+// MACAlgorithm returns a SignOption that hashes the signed attributes with
+// algorithm, one of "SHA256" (the default), "SHA384" or "SHA512".
+func MACAlgorithm(algorithm string) SignOption {
+	return func(set *signOptSet) {
+		set.algorithm = algorithm
+	}
+}
+
+// MACTransferSyntax returns a SignOption that encodes the signed attributes
+// in the transfer syntax transferSyntaxUID, instead of Explicit VR Little
+// Endian.
+func MACTransferSyntax(transferSyntaxUID string) SignOption {
+	return func(set *signOptSet) {
+		set.transferSyntax = transferSyntaxUID
+	}
+}
+
+// Sign adds a digital signature of the top level elements of d with tags,
+// or of all of them if tags is empty, to the Digital Signatures Sequence
+// (FFFA,FFFA) of d, with the MAC Parameters Sequence (4FFE,0001) item
+// describing how it was computed, see PS3.3 C.12.1.1.3 and PS3.15 Annex C.
+// The File Meta Information, the MAC Parameters Sequence and the Digital
+// Signatures Sequence itself are never signed, and Data Set Trailing Padding
+// (FFFC,FFFC) is only signed if listed in tags. key must be the RSA or ECDSA
+// private key of cert.
+func (d *Dataset) Sign(key crypto.Signer, cert *x509.Certificate, tags []tag.Tag, opts ...SignOption) error {
+	set := signOptSet{algorithm: "SHA256", transferSyntax: uid.ExplicitVRLittleEndian}
+	for _, opt := range opts {
+		opt(&set)
+	}
+	hash, ok := macAlgorithms[set.algorithm]
+	if !ok || hash == crypto.SHA1 {
+		return fmt.Errorf("%w: MAC algorithm %q", ErrorUnsupportedSignature, set.algorithm)
+	}
+	switch key.Public().(type) {
+	case *rsa.PublicKey, *ecdsa.PublicKey:
+	default:
+		return fmt.Errorf("%w: %T key", ErrorUnsupportedSignature, key.Public())
+	}
+
+	if len(tags) == 0 {
+		for _, e := range d.Elements {
+			if !unsignable(e.Tag) && e.Tag != tag.DataSetTrailingPadding {
+				tags = append(tags, e.Tag)
+			}
+		}
+	}
+	tags = append([]tag.Tag(nil), tags...)
+	sort.Slice(tags, func(i, j int) bool { return tags[i].Compare(tags[j]) < 0 })
+	signed := make([]uint64, len(tags))
+	for i, t := range tags {
+		if unsignable(t) {
+			return fmt.Errorf("dicom: %v cannot be signed", t)
+		}
+		signed[i] = atValue(t)
+	}
+
+	macParams, err := sequenceItems(d, tag.MACParametersSequence)
+	if err != nil {
+		return err
+	}
+	macID := uint64(1)
+	for _, item := range macParams {
+		if e, err := findItemElement(item, tag.MACIDNumber); err == nil && e.Value.ValueType() == UInts {
+			if ids := MustGetUInts(e.Value); len(ids) > 0 && ids[0] >= macID {
+				macID = ids[0] + 1
+			}
+		}
+	}
+
+	digest, err := macDigest(d, tags, set.transferSyntax, hash)
+	if err != nil {
+		return err
+	}
+	signature, err := key.Sign(rand.Reader, digest, hash)
+	if err != nil {
+		return err
+	}
+
+	macParams = append(macParams, []*Element{
+		mustNewElement(tag.MACIDNumber, []uint64{macID}),
+		mustNewElement(tag.MACCalculationTransferSyntaxUID, []string{set.transferSyntax}),
+		mustNewElement(tag.MACAlgorithm, []string{set.algorithm}),
+		mustNewElement(tag.DataElementsSigned, signed),
+	})
+	signatures, err := sequenceItems(d, tag.DigitalSignaturesSequence)
+	if err != nil {
+		return err
+	}
+	signatures = append(signatures, []*Element{
+		mustNewElement(tag.MACIDNumber, []uint64{macID}),
+		mustNewElement(tag.DigitalSignatureUID, []string{uid.NewUUIDUID()}),
+		mustNewElement(tag.DigitalSignatureDateTime, []string{time.Now().Format("20060102150405.000000-0700")}),
+		mustNewElement(tag.CertificateType, []string{x509CertificateType}),
+		mustNewElement(tag.CertificateOfSigner, cert.Raw),
+		mustNewElement(tag.Signature, signature),
+	})
+	d.Update(
+		mustNewElement(tag.MACParametersSequence, macParams),
+		mustNewElement(tag.DigitalSignaturesSequence, signatures),
+	)
+	return nil
+}
+
+// SignatureReport is the result of verifying one digital signature.
+type SignatureReport struct {
+	// MACIDNumber identifies the MAC Parameters Sequence item of the
+	// signature.
+	MACIDNumber int
+	UID         string
+	DateTime    string
+	// MACAlgorithm is the hash the signed attributes were hashed with.
+	MACAlgorithm string
+	// Certificate is the certificate of the signer. VerifySignatures does not
+	// verify it; check it against the trusted roots of the application.
+	Certificate *x509.Certificate
+	// Tags are the top level attributes covered by the signature.
+	Tags []tag.Tag
+	// Err is nil if the signature is valid, and otherwise describes why it
+	// is not.
+	Err error
+}
+
+// Valid reports whether the signature matches the attributes it covers.
+func (r SignatureReport) Valid() bool {
+	return r.Err == nil
+}
+
+// VerifySignatures verifies the signatures in the top level Digital
+// Signatures Sequence of d, and reports one SignatureReport per signature in
+// the order they appear. It does not verify the certificates of the signers.
+func (d *Dataset) VerifySignatures() ([]SignatureReport, error) {
+	signatures, err := sequenceItems(d, tag.DigitalSignaturesSequence)
+	if err != nil {
+		return nil, err
+	}
+	macParams, err := sequenceItems(d, tag.MACParametersSequence)
+	if err != nil {
+		return nil, err
+	}
+	reports := make([]SignatureReport, len(signatures))
+	for i, item := range signatures {
+		reports[i] = verifySignature(d, item, macParams)
+	}
+	return reports, nil
+}
+
+// verifySignature verifies the Digital Signatures Sequence item.
+func verifySignature(d *Dataset, item []*Element, macParams [][]*Element) SignatureReport {
+	var r SignatureReport
+	macID, err := itemUInt(item, tag.MACIDNumber)
+	if err != nil {
+		r.Err = err
+		return r
+	}
+	r.MACIDNumber = int(macID)
+	r.UID = itemString(item, tag.DigitalSignatureUID)
+	r.DateTime = itemString(item, tag.DigitalSignatureDateTime)
+
+	var params []*Element
+	for _, p := range macParams {
+		if id, err := itemUInt(p, tag.MACIDNumber); err == nil && id == macID {
+			params = p
+		}
+	}
+	if params == nil {
+		r.Err = fmt.Errorf("%w: no MAC parameters with MAC ID number %d", ErrorInvalidSignature, macID)
+		return r
+	}
+	if e, err := findItemElement(params, tag.DataElementsSigned); err == nil && e.Value.ValueType() == UInts {
+		for _, v := range MustGetUInts(e.Value) {
+			r.Tags = append(r.Tags, atTag(v))
+		}
+	}
+	r.MACAlgorithm = itemString(params, tag.MACAlgorithm)
+	hash, ok := macAlgorithms[r.MACAlgorithm]
+	if !ok {
+		r.Err = fmt.Errorf("%w: MAC algorithm %q", ErrorUnsupportedSignature, r.MACAlgorithm)
+		return r
+	}
+	if certType := itemString(item, tag.CertificateType); certType != x509CertificateType {
+		r.Err = fmt.Errorf("%w: certificate type %q", ErrorUnsupportedSignature, certType)
+		return r
+	}
+	certificate, err := itemBytes(item, tag.CertificateOfSigner)
+	if err == nil {
+		r.Certificate, err = x509.ParseCertificate(trimDER(certificate))
+	}
+	if err != nil {
+		r.Err = fmt.Errorf("%w: certificate of signer: %v", ErrorInvalidSignature, err)
+		return r
+	}
+	signature, err := itemBytes(item, tag.Signature)
+	if err != nil {
+		r.Err = err
+		return r
+	}
+
+	digest, err := macDigest(d, r.Tags, itemString(params, tag.MACCalculationTransferSyntaxUID), hash)
+	if err != nil {
+		r.Err = fmt.Errorf("%w: %v", ErrorInvalidSignature, err)
+		return r
+	}
+	switch pub := r.Certificate.PublicKey.(type) {
+	case *rsa.PublicKey:
+		if len(signature) > pub.Size() {
+			// Remove the padding of odd length signatures.
+			signature = signature[:pub.Size()]
+		}
+		if err := rsa.VerifyPKCS1v15(pub, hash, digest, signature); err != nil {
+			r.Err = ErrorInvalidSignature
+		}
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(pub, digest, trimDER(signature)) {
+			r.Err = ErrorInvalidSignature
+		}
+	default:
+		r.Err = fmt.Errorf("%w: %T key", ErrorUnsupportedSignature, pub)
+	}
+	return r
+}
+
+// MACBytes returns the byte stream the MAC of the top level elements of d
+// with tags is computed from, see PS3.3 C.12.1.1.3.1.3. The elements are
+// encoded as by WriteDataset in the transfer syntax transferSyntaxUID,
+// except that sequences and items are written without their Value Length
+// and always end with delimitation items, so that the stream does not depend
+// on how their lengths were encoded. Text values are encoded in the Specific
+// Character Set of d, as they are written. It returns ErrorElementNotFound if an
+// element is missing.
+func (d *Dataset) MACBytes(tags []tag.Tag, transferSyntaxUID string) ([]byte, error) {
+	bo, implicit, err := uid.ParseTransferSyntaxUID(transferSyntaxUID)
+	if err != nil {
+		return nil, err
+	}
+	elems := make([]*Element, len(tags))
+	for i, t := range tags {
+		if elems[i], err = d.FindElementByTag(t); err != nil {
+			return nil, fmt.Errorf("%w: %v", err, t)
+		}
+	}
+	buf := &bytes.Buffer{}
+	w := dicomio.NewWriter(buf, bo, implicit)
+	e, _ := d.FindElementByTag(tag.SpecificCharacterSet)
+	w.SetEncoder(characterSetEncoder(e, writeOptSet{}))
+	if err := writeMACElements(w, sortElements(elems)); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writeMACElements(w dicomio.Writer, elems []*Element) error {
+	// Retained raw values are not used, as the MAC must be computed from the
+	// same encoding when signing and verifying.
+	opts := writeOptSet{skipVRVerification: true}
+	for _, elem := range elems {
+		if elem.Value == nil || elem.Value.ValueType() != Sequences {
+			if err := writeElement(w, elem, opts); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := writeTag(w, elem.Tag, tag.VLUndefinedLength); err != nil {
+			return err
+		}
+		if _, implicit := w.GetTransferSyntax(); !implicit {
+			if err := w.WriteString("SQ"); err != nil {
+				return err
+			}
+			if err := w.WriteZeros(2); err != nil {
+				return err
+			}
+		}
+		for _, item := range elem.Value.GetValue().([]*SequenceItemValue) {
+			if err := writeTag(w, tag.Item, tag.VLUndefinedLength); err != nil {
+				return err
+			}
+			if err := writeMACElements(w, item.elements); err != nil {
+				return err
+			}
+			if err := encodeElementHeader(w, tag.ItemDelimitationItem, "", 0); err != nil {
+				return err
+			}
+		}
+		if err := encodeElementHeader(w, tag.SequenceDelimitationItem, "", 0); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// macDigest returns the hash of the MACBytes of the elements of d with tags.
+func macDigest(d *Dataset, tags []tag.Tag, transferSyntaxUID string, hash crypto.Hash) ([]byte, error) {
+	data, err := d.MACBytes(tags, transferSyntaxUID)
+	if err != nil {
+		return nil, err
+	}
+	h := hash.New()
+	h.Write(data)
+	return h.Sum(nil), nil
+}
+
+// unsignable reports whether the element t cannot be covered by a
+// signature. The MAC Parameters Sequence is replaced when signing, so a
+// signature covering it could never be verified.
+func unsignable(t tag.Tag) bool {
+	return t.Group == tag.MetadataGroup || t == tag.DigitalSignaturesSequence || t == tag.MACParametersSequence
+}
+
+// atValue returns the value of an AT element holding t, which is read and
+// written as a little endian 32 bit integer.
+func atValue(t tag.Tag) uint64 {
+	return uint64(t.Element)<<16 | uint64(t.Group)
+}
+
+// atTag returns the tag held by the value v of an AT element.
+func atTag(v uint64) tag.Tag {
+	return tag.Tag{Group: uint16(v), Element: uint16(v >> 16)}
+}
+
+// trimDER returns the DER encoded value at the start of b, without the
+// padding added to odd length values.
+func trimDER(b []byte) []byte {
+	rest, err := asn1.Unmarshal(b, &asn1.RawValue{})
+	if err != nil {
+		return b
+	}
+	return b[:len(b)-len(rest)]
+}
+
+// sequenceItems returns the elements of the items of the top level sequence
+// t of d, if any.
+func sequenceItems(d *Dataset, t tag.Tag) ([][]*Element, error) {
+	e, err := d.FindElementByTag(t)
+	if err == ErrorElementNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if e.Value.ValueType() != Sequences {
+		return nil, fmt.Errorf("%w: %v is not a sequence", ErrorUnexpectedValueType, t)
+	}
+	var items [][]*Element
+	for _, item := range e.Value.GetValue().([]*SequenceItemValue) {
+		items = append(items, item.elements)
+	}
+	return items, nil
+}
+
+func findItemElement(item []*Element, t tag.Tag) (*Element, error) {
+	for _, e := range item {
+		if e.Tag == t {
+			return e, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %v", ErrorElementNotFound, t)
+}
+
+func itemString(item []*Element, t tag.Tag) string {
+	e, err := findItemElement(item, t)
+	if err != nil {
+		return ""
+	}
+	return firstString(e)
+}
+
+func itemUInt(item []*Element, t tag.Tag) (uint64, error) {
+	e, err := findItemElement(item, t)
+	if err != nil {
+		return 0, err
+	}
+	if e.Value.ValueType() != UInts || len(MustGetUInts(e.Value)) == 0 {
+		return 0, fmt.Errorf("%w: %v", ErrorUnexpectedValueType, t)
+	}
+	return MustGetUInts(e.Value)[0], nil
+}
+
+func itemBytes(item []*Element, t tag.Tag) ([]byte, error) {
+	e, err := findItemElement(item, t)
+	if err != nil {
+		return nil, err
+	}
+	if e.Value.ValueType() != Bytes {
+		return nil, fmt.Errorf("%w: %v", ErrorUnexpectedValueType, t)
+	}
+	return MustGetBytes(e.Value), nil
+}
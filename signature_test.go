@@ -0,0 +1,215 @@
+package dicom
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ginuerzh/dicom/pkg/tag"
+	"github.com/ginuerzh/dicom/pkg/uid"
+	"github.com/google/go-cmp/cmp"
+)
+
+func newSigner(t *testing.T, key crypto.Signer) *x509.Certificate {
+	t.Helper()
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "Radiologist"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		t.Fatalf("x509.CreateCertificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("x509.ParseCertificate: %v", err)
+	}
+	return cert
+}
+
+func TestSign(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("rsa.GenerateKey: %v", err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("ecdsa.GenerateKey: %v", err)
+	}
+	studyDescription := tag.Tag{Group: 0x0008, Element: 0x1030}
+	seriesNumber := tag.Tag{Group: 0x0020, Element: 0x0011}
+	covered := []tag.Tag{tag.SOPInstanceUID, studyDescription, tag.AddOtherSequence}
+
+	cases := []struct {
+		name   string
+		key    crypto.Signer
+		opts   []SignOption
+		modify func(ds *Dataset)
+		valid  bool
+	}{
+		{name: "RSA", key: rsaKey, valid: true},
+		{name: "ECDSA", key: ecKey, valid: true},
+		{name: "SHA512, implicit VR", key: rsaKey, opts: []SignOption{MACAlgorithm("SHA512"), MACTransferSyntax(uid.ImplicitVRLittleEndian)}, valid: true},
+		{
+			name: "uncovered attribute modified",
+			key:  ecKey,
+			modify: func(ds *Dataset) {
+				ds.Set(mustNewElement(seriesNumber, []string{"42"}))
+			},
+			valid: true,
+		},
+		{
+			name: "covered attribute modified",
+			key:  ecKey,
+			modify: func(ds *Dataset) {
+				ds.Set(mustNewElement(studyDescription, []string{"Tampered"}))
+			},
+		},
+		{
+			name: "covered sequence item modified",
+			key:  rsaKey,
+			modify: func(ds *Dataset) {
+				ds.Set(makeSequenceElement(tag.AddOtherSequence, [][]*Element{{mustNewElement(tag.Rows, []uint64{101})}}))
+			},
+		},
+		{
+			name: "covered attribute removed",
+			key:  rsaKey,
+			modify: func(ds *Dataset) {
+				ds.Delete(studyDescription)
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ds := Dataset{Elements: []*Element{
+				mustNewElement(tag.MediaStorageSOPClassUID, []string{uid.SecondaryCaptureImageStorage}),
+				mustNewElement(tag.MediaStorageSOPInstanceUID, []string{"1.2.3.4"}),
+				mustNewElement(tag.TransferSyntaxUID, []string{uid.ExplicitVRLittleEndian}),
+				mustNewElement(tag.SOPInstanceUID, []string{"1.2.3.4"}),
+				mustNewElement(studyDescription, []string{"Report"}),
+				mustNewElement(seriesNumber, []string{"1"}),
+				makeSequenceElement(tag.AddOtherSequence, [][]*Element{{mustNewElement(tag.Rows, []uint64{100})}}),
+			}}
+			cert := newSigner(t, tc.key)
+			if err := ds.Sign(tc.key, cert, covered, tc.opts...); err != nil {
+				t.Fatalf("Sign: %v", err)
+			}
+
+			// Signatures must survive writing, even with other length encodings.
+			buf := &bytes.Buffer{}
+			if err := Write(buf, ds, DefinedLengthSequences()); err != nil {
+				t.Fatalf("Write: %v", err)
+			}
+			parsed, err := Parse(bytes.NewReader(buf.Bytes()), int64(buf.Len()), nil)
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			if tc.modify != nil {
+				tc.modify(&parsed)
+			}
+
+			reports, err := parsed.VerifySignatures()
+			if err != nil {
+				t.Fatalf("VerifySignatures: %v", err)
+			}
+			if len(reports) != 1 {
+				t.Fatalf("VerifySignatures: got %d reports, want 1", len(reports))
+			}
+			r := reports[0]
+			if r.Valid() != tc.valid {
+				t.Errorf("VerifySignatures: got Valid() %v (%v), want %v", r.Valid(), r.Err, tc.valid)
+			}
+			if !tc.valid && !errors.Is(r.Err, ErrorInvalidSignature) {
+				t.Errorf("VerifySignatures: got error %v, want %v", r.Err, ErrorInvalidSignature)
+			}
+			if diff := cmp.Diff(covered, r.Tags); diff != "" {
+				t.Errorf("VerifySignatures: unexpected covered tags (-want +got):\n%s", diff)
+			}
+			if r.MACIDNumber != 1 || r.Certificate == nil || !r.Certificate.Equal(cert) || !uid.IsValidUID(r.UID) {
+				t.Errorf("VerifySignatures: unexpected report %+v", r)
+			}
+		})
+	}
+}
+
+func TestSign_Multiple(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("ecdsa.GenerateKey: %v", err)
+	}
+	cert := newSigner(t, key)
+	ds, err := ParseFile("./testfiles/3.dcm", nil)
+	if err != nil {
+		t.Fatalf("ParseFile: %v", err)
+	}
+	ds.Set(mustNewElement(tag.DataSetTrailingPadding, []byte{0, 0}))
+	for i := 0; i < 2; i++ {
+		if err := ds.Sign(key, cert, nil); err != nil {
+			t.Fatalf("Sign: %v", err)
+		}
+	}
+	reports, err := ds.VerifySignatures()
+	if err != nil {
+		t.Fatalf("VerifySignatures: %v", err)
+	}
+	if len(reports) != 2 {
+		t.Fatalf("VerifySignatures: got %d reports, want 2", len(reports))
+	}
+	for i, r := range reports {
+		if !r.Valid() || r.MACIDNumber != i+1 {
+			t.Errorf("VerifySignatures: got report %d with MAC ID %d: %v", i, r.MACIDNumber, r.Err)
+		}
+		for _, c := range r.Tags {
+			if c.Group == tag.MetadataGroup || c == tag.MACParametersSequence || c == tag.DigitalSignaturesSequence || c == tag.DataSetTrailingPadding {
+				t.Errorf("VerifySignatures: signature %d covers %v", i, c)
+			}
+		}
+	}
+	if got, want := len(reports[1].Tags), len(reports[0].Tags); got != want {
+		t.Errorf("VerifySignatures: second signature covers %d tags, want %d", got, want)
+	}
+
+	for _, unsigned := range []tag.Tag{tag.DigitalSignaturesSequence, tag.MACParametersSequence} {
+		if err := ds.Sign(key, cert, []tag.Tag{unsigned}); err == nil {
+			t.Errorf("Sign(%v): got no error", unsigned)
+		}
+	}
+	if err := ds.Sign(key, cert, nil, MACAlgorithm("MD5")); !errors.Is(err, ErrorUnsupportedSignature) {
+		t.Errorf("Sign(MD5): got %v, want %v", err, ErrorUnsupportedSignature)
+	}
+}
+
+func TestMACBytes(t *testing.T) {
+	item := [][]*Element{{mustNewElement(tag.Rows, []uint64{100})}}
+	ds := Dataset{Elements: []*Element{makeSequenceElement(tag.AddOtherSequence, item)}}
+	got, err := ds.MACBytes([]tag.Tag{tag.AddOtherSequence}, uid.ExplicitVRLittleEndian)
+	if err != nil {
+		t.Fatalf("MACBytes: %v", err)
+	}
+	want := []byte{
+		0x46, 0x00, 0x02, 0x01, 'S', 'Q', 0, 0, // AddOtherSequence, without length
+		0xfe, 0xff, 0x00, 0xe0, // Item, without length
+		0x28, 0x00, 0x10, 0x00, 'U', 'S', 2, 0, 100, 0, // Rows
+		0xfe, 0xff, 0x0d, 0xe0, 0, 0, 0, 0, // Item Delimitation Item
+		0xfe, 0xff, 0xdd, 0xe0, 0, 0, 0, 0, // Sequence Delimitation Item
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("MACBytes: unexpected bytes (-want +got):\n%s", diff)
+	}
+	if _, err := ds.MACBytes([]tag.Tag{tag.Rows}, uid.ExplicitVRLittleEndian); !errors.Is(err, ErrorElementNotFound) {
+		t.Errorf("MACBytes of a missing element: got %v, want %v", err, ErrorElementNotFound)
+	}
+}
@@ -0,0 +1,324 @@
+package dicom
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/ginuerzh/dicom/pkg/tag"
+)
+
+// ErrorInvalidValue indicates that an element value does not conform to the
+// rules of its VR. Errors returned by Write with ValidateValues wrap it in a
+// *ValueError.
+var ErrorInvalidValue = errors.New("value does not conform to its VR")
+
+// ValueRule is a rule of PS3.5 6.2 that an element value can violate.
+type ValueRule string
+
+const (
+	// RuleMaxLength is violated by values longer than their VR allows.
+	RuleMaxLength ValueRule = "max-length"
+	// RuleCharacterRepertoire is violated by values containing characters
+	// their VR does not allow, like lowercase letters in a CS.
+	RuleCharacterRepertoire ValueRule = "character-repertoire"
+	// RuleFormat is violated by values that do not have the format of their
+	// VR, like a DA of "2020-11-12" or a DS of "1,5".
+	RuleFormat ValueRule = "format"
+	// RulePadding is violated by values of odd length or with the wrong
+	// padding character.
+	RulePadding ValueRule = "padding"
+	// RuleVM is violated by elements whose number of values does not match
+	// the VM of their tag.
+	RuleVM ValueRule = "vm"
+)
+
+// ValueViolation is a single violation of a VR rule found by ValidateElement
+// or Dataset.ValidateValues.
+type ValueViolation struct {
+	// Path locates the element from the root of the Dataset, for example
+	// "(0040,a730)[0].(0008,0100)".
+	Path string    `json:"path"`
+	Tag  tag.Tag   `json:"tag"`
+	VR   string    `json:"vr"`
+	Rule ValueRule `json:"rule"`
+	// Index is the index of the offending value of a multi-valued element,
+	// or -1 if the violation concerns the element as a whole.
+	Index   int    `json:"index"`
+	Message string `json:"message"`
+}
+
+func (v ValueViolation) String() string {
+	if v.Index < 0 {
+		return fmt.Sprintf("%s %s: %s: %s", v.Path, v.VR, v.Rule, v.Message)
+	}
+	return fmt.Sprintf("%s %s value %d: %s: %s", v.Path, v.VR, v.Index, v.Rule, v.Message)
+}
+
+// ValueError is returned by Write with ValidateValues for an element that
+// violates the rules of its VR. It wraps ErrorInvalidValue.
+type ValueError struct {
+	Violations []ValueViolation
+}
+
+func (e *ValueError) Error() string {
+	msgs := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		msgs[i] = v.String()
+	}
+	return fmt.Sprintf("%v: %s", ErrorInvalidValue, strings.Join(msgs, "; "))
+}
+
+func (e *ValueError) Unwrap() error {
+	return ErrorInvalidValue
+}
+
+// ValidateValues returns a WriteOption that checks every element against the
+// rules of PS3.5 6.2 for its VR before it is written (see ValidateElement).
+// Write fails with a *ValueError for the first element that violates them.
+func ValidateValues() WriteOption {
+	return func(set *writeOptSet) {
+		set.validateValues = true
+	}
+}
+
+// ValidateElement checks the value of e against the rules of PS3.5 6.2 for
+// its VR: maximum length, character repertoire, the formats of AS, DA, DS,
+// DT, IS, TM and UI, padding of its ValueLength to even length, and the
+// number of values against the VM of its tag. Elements nested in sequences are not checked,
+// see Dataset.ValidateValues for that. If e has no VR, the dictionary VR of
+// its tag is used.
+func ValidateElement(e *Element) []ValueViolation {
+	vr := e.RawValueRepresentation
+	if vr == "" {
+		if info, err := tag.Find(e.Tag); err == nil {
+			vr = info.VR
+		}
+	}
+	return validateElement(e, vr, Path{Tag: e.Tag}.String(), e.ValueLength)
+}
+
+// ValidateValues checks every element of the Dataset, including the
+// elements nested in sequences, with ValidateElement.
+func (d *Dataset) ValidateValues() []ValueViolation {
+	var violations []ValueViolation
+	d.Walk(func(path Path, e *Element) WalkAction {
+		vr := e.RawValueRepresentation
+		if vr == "" {
+			if info, err := tag.Find(e.Tag); err == nil {
+				vr = info.VR
+			}
+		}
+		violations = append(violations, validateElement(e, vr, path.String(), e.ValueLength)...)
+		return WalkContinue
+	})
+	return violations
+}
+
+// valueValidator collects the violations of one element.
+type valueValidator struct {
+	elem       *Element
+	vr         string
+	path       string
+	violations []ValueViolation
+}
+
+func (v *valueValidator) add(rule ValueRule, index int, format string, args ...interface{}) {
+	v.violations = append(v.violations, ValueViolation{
+		Path:    v.path,
+		Tag:     v.elem.Tag,
+		VR:      v.vr,
+		Rule:    rule,
+		Index:   index,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+// validateElement checks e, where length is the value length e is encoded
+// with. Byte values are not checked for padding themselves, as they are
+// padded to even length when written.
+func validateElement(e *Element, vr, path string, length uint32) []ValueViolation {
+	if e.Value == nil {
+		return nil
+	}
+	v := &valueValidator{elem: e, vr: vr, path: path}
+	if length%2 != 0 && length != tag.VLUndefinedLength {
+		v.add(RulePadding, -1, "odd value length %d", length)
+	}
+
+	n := -1
+	switch value := e.Value.(type) {
+	case *stringsValue:
+		n = v.validateStrings(value.value)
+	case *intsValue:
+		n = len(value.value)
+	case *uintsValue:
+		n = len(value.value)
+	case *floatsValue:
+		n = len(value.value)
+	}
+	if n > 0 && !strings.HasPrefix(vr, "O") {
+		// The O* VRs hold a single value of binary data.
+		v.validateVM(n)
+	}
+	return v.violations
+}
+
+// validateVM checks the number of values n against the VM of the element.
+func (v *valueValidator) validateVM(n int) {
+	info, err := tag.Find(v.elem.Tag)
+	if err != nil || !info.HasVR(v.vr) {
+		return
+	}
+	vm, err := info.Multiplicity()
+	if err != nil {
+		return
+	}
+	if !vm.Allows(n) {
+		v.add(RuleVM, -1, "%d values, but the VM of %s is %s", n, info.Name, vm)
+	}
+}
+
+// maxStringLengths are the maximum lengths of values of string VRs. The
+// lengths of the VRs in charLengthVRs are in characters, all others in bytes.
+// The lengths of UC, UR and UT are only limited by the 32 bit value length.
+var maxStringLengths = map[string]int{
+	"AE": 16, "AS": 4, "CS": 16, "DA": 8, "DS": 16, "DT": 26, "IS": 12,
+	"LO": 64, "LT": 10240, "SH": 16, "ST": 1024, "TM": 14, "UI": 64,
+}
+
+var charLengthVRs = map[string]bool{"LO": true, "LT": true, "PN": true, "SH": true, "ST": true}
+
+// singleValueVRs are the VRs whose value may contain backslashes, and
+// therefore always have a single value.
+var singleValueVRs = map[string]bool{"LT": true, "ST": true, "UR": true, "UT": true}
+
+var (
+	asFormat = regexp.MustCompile(`^\d{3}[DWMY]$`)
+	csFormat = regexp.MustCompile(`^[A-Z0-9 _]*$`)
+	dsFormat = regexp.MustCompile(`^[+-]?(\d+\.?\d*|\.\d+)([eE][+-]?\d+)?$`)
+	isFormat = regexp.MustCompile(`^[+-]?\d+$`)
+	tmFormat = regexp.MustCompile(`^([01]\d|2[0-3])([0-5]\d(([0-5]\d|60)(\.\d{1,6})?)?)?$`)
+	dtFormat = regexp.MustCompile(`^\d{4}((0[1-9]|1[0-2])((0[1-9]|[12]\d|3[01])(([01]\d|2[0-3])([0-5]\d(([0-5]\d|60)(\.\d{1,6})?)?)?)?)?)?([+-]\d{4})?$`)
+	uiFormat = regexp.MustCompile(`^(0|[1-9]\d*)(\.(0|[1-9]\d*))*$`)
+)
+
+// validateStrings checks the values of a string VR, and returns the number
+// of values.
+func (v *valueValidator) validateStrings(values []string) int {
+	if singleValueVRs[v.vr] {
+		values = []string{strings.Join(values, "\\")}
+	}
+	if len(values) == 1 && values[0] == "" {
+		// Zero length values are always valid.
+		return 0
+	}
+	for i, value := range values {
+		v.validateString(i, value)
+	}
+	return len(values)
+}
+
+func (v *valueValidator) validateString(i int, value string) {
+	if v.vr == "UI" {
+		if strings.HasSuffix(value, " ") {
+			v.add(RulePadding, i, "UI values are padded with NUL, not space")
+		}
+		value = strings.TrimRight(value, "\x00")
+	} else if strings.HasSuffix(value, "\x00") {
+		v.add(RulePadding, i, "%s values are padded with space, not NUL", v.vr)
+		value = strings.TrimRight(value, "\x00")
+	}
+
+	if max, ok := maxStringLengths[v.vr]; ok {
+		length := len(value)
+		if charLengthVRs[v.vr] {
+			length = utf8.RuneCountInString(value)
+		}
+		if length > max {
+			v.add(RuleMaxLength, i, "length %d exceeds the maximum of %d", length, max)
+		}
+	}
+
+	trimmed := strings.TrimSpace(value)
+	switch v.vr {
+	case "AE":
+		v.validateRepertoire(i, value, "")
+	case "AS":
+		if !asFormat.MatchString(value) {
+			v.add(RuleFormat, i, "%q is not of the form nnnD, nnnW, nnnM or nnnY", value)
+		}
+	case "CS":
+		if !csFormat.MatchString(value) {
+			v.add(RuleCharacterRepertoire, i, "%q contains characters other than uppercase letters, digits, space and underscore", value)
+		}
+	case "DA":
+		if _, err := time.Parse("20060102", trimmed); err != nil || len(trimmed) != 8 {
+			v.add(RuleFormat, i, "%q is not a date of the form YYYYMMDD", value)
+		}
+	case "DS":
+		if !dsFormat.MatchString(trimmed) {
+			v.add(RuleFormat, i, "%q is not a decimal string", value)
+		}
+	case "DT":
+		if !dtFormat.MatchString(trimmed) {
+			v.add(RuleFormat, i, "%q is not a date time of the form YYYYMMDDHHMMSS.FFFFFF&ZZXX", value)
+		}
+	case "IS":
+		if n, err := strconv.ParseInt(trimmed, 10, 64); !isFormat.MatchString(trimmed) || err != nil || n < math.MinInt32 || n > math.MaxInt32 {
+			v.add(RuleFormat, i, "%q is not an integer string in the range -2^31 to 2^31-1", value)
+		}
+	case "TM":
+		if !tmFormat.MatchString(trimmed) {
+			v.add(RuleFormat, i, "%q is not a time of the form HHMMSS.FFFFFF", value)
+		}
+	case "UI":
+		if !uiFormat.MatchString(value) {
+			v.add(RuleFormat, i, "%q is not a UID of numeric components separated by periods, without leading zeros", value)
+		}
+	case "LO", "SH", "UC":
+		v.validateRepertoire(i, value, "\x1b")
+	case "PN":
+		v.validateRepertoire(i, value, "\x1b")
+		v.validatePersonName(i, value)
+	case "LT", "ST", "UT":
+		v.validateRepertoire(i, value, "\x1b\r\n\f\t\\")
+	case "UR":
+		if strings.ContainsAny(strings.TrimRight(value, " "), " ") {
+			v.add(RuleCharacterRepertoire, i, "URIs may only have trailing spaces")
+		}
+		v.validateRepertoire(i, value, "\\")
+	}
+}
+
+// validateRepertoire reports control characters and backslashes in value,
+// except for those in allowed.
+func (v *valueValidator) validateRepertoire(i int, value, allowed string) {
+	for _, r := range value {
+		if (r < 0x20 || r == 0x7f || r == '\\') && !strings.ContainsRune(allowed, r) {
+			v.add(RuleCharacterRepertoire, i, "%q contains the character %U", value, r)
+			return
+		}
+	}
+}
+
+// validatePersonName checks the component groups and components of a PN.
+func (v *valueValidator) validatePersonName(i int, value string) {
+	groups := strings.Split(value, "=")
+	if len(groups) > 3 {
+		v.add(RuleFormat, i, "%q has more than 3 component groups", value)
+	}
+	for _, group := range groups {
+		if n := utf8.RuneCountInString(group); n > 64 {
+			v.add(RuleMaxLength, i, "component group length %d exceeds the maximum of 64", n)
+		}
+		if strings.Count(group, "^") > 4 {
+			v.add(RuleFormat, i, "%q has more than 5 components in a group", value)
+		}
+	}
+}
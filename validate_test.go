@@ -0,0 +1,160 @@
+package dicom
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/ginuerzh/dicom/pkg/tag"
+	"github.com/ginuerzh/dicom/pkg/uid"
+)
+
+func TestValidateElement(t *testing.T) {
+	cases := []struct {
+		name  string
+		elem  *Element
+		rules []ValueRule
+	}{
+		{name: "valid LO", elem: mustNewElementWithVR(tag.Tag{Group: 0x0008, Element: 0x0070}, "LO", []string{"ACME"})},
+		{name: "long LO", elem: mustNewElementWithVR(tag.Tag{Group: 0x0008, Element: 0x0070}, "LO", []string{string(bytes.Repeat([]byte("a"), 70))}), rules: []ValueRule{RuleMaxLength}},
+		{name: "LO with control character", elem: mustNewElementWithVR(tag.Tag{Group: 0x0008, Element: 0x0070}, "LO", []string{"a\nb"}), rules: []ValueRule{RuleCharacterRepertoire}},
+		{name: "UI with letters", elem: mustNewElement(tag.SOPInstanceUID, []string{"1.2.abc"}), rules: []ValueRule{RuleFormat}},
+		{name: "UI with leading zero", elem: mustNewElement(tag.SOPInstanceUID, []string{"1.02.3"}), rules: []ValueRule{RuleFormat}},
+		{name: "UI padded with space", elem: mustNewElement(tag.SOPInstanceUID, []string{"1.2.3 "}), rules: []ValueRule{RulePadding, RuleFormat}},
+		{name: "valid UI", elem: mustNewElement(tag.SOPInstanceUID, []string{"1.2.840.10008.1.2"})},
+		{name: "DA with dashes", elem: mustNewElementWithVR(tag.Tag{Group: 0x0008, Element: 0x0020}, "DA", []string{"2020-11-12"}), rules: []ValueRule{RuleMaxLength, RuleFormat}},
+		{name: "DA with invalid month", elem: mustNewElementWithVR(tag.Tag{Group: 0x0008, Element: 0x0020}, "DA", []string{"20201312"}), rules: []ValueRule{RuleFormat}},
+		{name: "valid DA", elem: mustNewElementWithVR(tag.Tag{Group: 0x0008, Element: 0x0020}, "DA", []string{"20201112"})},
+		{name: "lowercase CS", elem: mustNewElement(tag.Modality, []string{"ct"}), rules: []ValueRule{RuleCharacterRepertoire}},
+		{name: "DS with comma", elem: mustNewElementWithVR(tag.Tag{Group: 0x0018, Element: 0x0050}, "DS", []string{"1,5"}), rules: []ValueRule{RuleFormat}},
+		{name: "valid DS", elem: mustNewElementWithVR(tag.Tag{Group: 0x0018, Element: 0x0050}, "DS", []string{" 1.5E-3"})},
+		{name: "IS out of range", elem: mustNewElementWithVR(tag.Tag{Group: 0x0020, Element: 0x0013}, "IS", []string{"4294967296"}), rules: []ValueRule{RuleFormat}},
+		{name: "invalid TM", elem: mustNewElementWithVR(tag.Tag{Group: 0x0008, Element: 0x0030}, "TM", []string{"25:00"}), rules: []ValueRule{RuleFormat}},
+		{name: "valid TM", elem: mustNewElementWithVR(tag.Tag{Group: 0x0008, Element: 0x0030}, "TM", []string{"142400.999"})},
+		{name: "valid DT", elem: mustNewElementWithVR(tag.Tag{Group: 0x0008, Element: 0x002A}, "DT", []string{"20201112142400.999999+0800"})},
+		{name: "invalid DT", elem: mustNewElementWithVR(tag.Tag{Group: 0x0008, Element: 0x002A}, "DT", []string{"2020111214240"}), rules: []ValueRule{RuleFormat}},
+		{name: "invalid AS", elem: mustNewElementWithVR(tag.Tag{Group: 0x0010, Element: 0x1010}, "AS", []string{"18Y"}), rules: []ValueRule{RuleFormat}},
+		{name: "PN with too many groups", elem: mustNewElementWithVR(tag.Tag{Group: 0x0010, Element: 0x0010}, "PN", []string{"a=b=c=d"}), rules: []ValueRule{RuleFormat}},
+		{name: "too many values", elem: mustNewElement(tag.Rows, []uint64{1, 2}), rules: []ValueRule{RuleVM}},
+		{name: "empty value", elem: mustNewElement(tag.SOPInstanceUID, []string{""})},
+		{name: "odd OB", elem: mustNewElementWithVR(tag.Tag{Group: 0x0009, Element: 0x1001}, "OB", []byte{1, 2, 3})},
+		{name: "odd value length", elem: withValueLength(mustNewElementWithVR(tag.Tag{Group: 0x0009, Element: 0x1001}, "OB", []byte{1, 2, 3}), 3), rules: []ValueRule{RulePadding}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			violations := ValidateElement(tc.elem)
+			var rules []ValueRule
+			for _, v := range violations {
+				rules = append(rules, v.Rule)
+				if v.Tag != tc.elem.Tag || v.Path != tc.elem.Tag.String() {
+					t.Errorf("ValidateElement: unexpected location of violation %v", v)
+				}
+			}
+			if len(rules) != len(tc.rules) {
+				t.Fatalf("ValidateElement: got violations %v, want rules %v", violations, tc.rules)
+			}
+			for i := range rules {
+				if rules[i] != tc.rules[i] {
+					t.Errorf("ValidateElement: got violations %v, want rules %v", violations, tc.rules)
+				}
+			}
+		})
+	}
+}
+
+func TestDataset_ValidateValues(t *testing.T) {
+	ds := Dataset{Elements: []*Element{
+		mustNewElement(tag.ImageType, []string{"ORIGINAL", "primary"}),
+		makeSequenceElement(tag.AddOtherSequence, [][]*Element{
+			{mustNewElement(tag.SOPInstanceUID, []string{"1.2.x"})},
+		}),
+	}}
+	violations := ds.ValidateValues()
+	if len(violations) != 2 {
+		t.Fatalf("ValidateValues: got %v, want 2 violations", violations)
+	}
+	if v := violations[0]; v.Path != "(0008,0008)" || v.Index != 1 || v.Rule != RuleCharacterRepertoire {
+		t.Errorf("ValidateValues: unexpected violation %v", v)
+	}
+	if v := violations[1]; v.Path != tag.AddOtherSequence.String()+"[0].(0008,0018)" || v.Index != 0 || v.Rule != RuleFormat {
+		t.Errorf("ValidateValues: unexpected violation %v", v)
+	}
+}
+
+func TestWrite_ValidateValues(t *testing.T) {
+	ds := Dataset{Elements: []*Element{
+		mustNewElement(tag.MediaStorageSOPClassUID, []string{"1.2.840.10008.5.1.4.1.1.1"}),
+		mustNewElement(tag.MediaStorageSOPInstanceUID, []string{"1.2.3.4"}),
+		mustNewElement(tag.TransferSyntaxUID, []string{uid.ExplicitVRLittleEndian}),
+		mustNewElement(tag.Modality, []string{"ct"}),
+	}}
+
+	// Values are only validated when asked to.
+	if err := Write(&bytes.Buffer{}, ds); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	err := Write(&bytes.Buffer{}, ds, ValidateValues())
+	if !errors.Is(err, ErrorInvalidValue) {
+		t.Fatalf("Write with ValidateValues: got %v, want %v", err, ErrorInvalidValue)
+	}
+	var valueErr *ValueError
+	if !errors.As(err, &valueErr) || len(valueErr.Violations) != 1 || valueErr.Violations[0].Tag != tag.Modality {
+		t.Errorf("Write with ValidateValues: unexpected error %v", err)
+	}
+}
+
+func TestWrite_ValidateValues_Nested(t *testing.T) {
+	ds := Dataset{Elements: []*Element{
+		mustNewElement(tag.MediaStorageSOPClassUID, []string{"1.2.840.10008.5.1.4.1.1.1"}),
+		mustNewElement(tag.MediaStorageSOPInstanceUID, []string{"1.2.3.4"}),
+		mustNewElement(tag.TransferSyntaxUID, []string{uid.ExplicitVRLittleEndian}),
+		mustNewElementWithVR(tag.Tag{Group: 0x0009, Element: 0x1001}, "OB", []byte{1, 2, 3}),
+		makeSequenceElement(tag.AddOtherSequence, [][]*Element{
+			{mustNewElement(tag.SOPInstanceUID, []string{"1.2.3"})},
+			{mustNewElement(tag.SOPInstanceUID, []string{"1.2.x"})},
+		}),
+	}}
+
+	w, err := NewWriter(&bytes.Buffer{}, ds, ValidateValues())
+	if err != nil {
+		t.Fatalf("NewWriter: %v", err)
+	}
+	mustWrite := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	mustWrite(w.WriteElement(ds.Elements[3]))
+	mustWrite(w.BeginSequence(tag.AddOtherSequence))
+	mustWrite(w.BeginItem())
+	mustWrite(w.WriteElement(mustNewElement(tag.SOPInstanceUID, []string{"1.2.3"})))
+	mustWrite(w.EndItem())
+	mustWrite(w.BeginItem())
+	streamErr := w.WriteElement(mustNewElement(tag.SOPInstanceUID, []string{"1.2.x"}))
+	for name, err := range map[string]error{
+		"Write":               Write(&bytes.Buffer{}, ds, ValidateValues()),
+		"Writer.WriteElement": streamErr,
+	} {
+		var valueErr *ValueError
+		if !errors.As(err, &valueErr) || len(valueErr.Violations) != 1 {
+			t.Fatalf("%s with ValidateValues: got %v, want a single violation", name, err)
+		}
+		if v := valueErr.Violations[0]; v.Path != tag.AddOtherSequence.String()+"[1].(0008,0018)" || v.Rule != RuleFormat {
+			t.Errorf("%s with ValidateValues: unexpected violation %v", name, v)
+		}
+	}
+}
+
+func withValueLength(e *Element, vl uint32) *Element {
+	e.ValueLength = vl
+	return e
+}
+
+func mustNewElementWithVR(t tag.Tag, vr string, data interface{}) *Element {
+	elem, err := NewElementWithVR(t, vr, data)
+	if err != nil {
+		panic(err)
+	}
+	return elem
+}
@@ -0,0 +1,119 @@
+package dicom
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ginuerzh/dicom/pkg/tag"
+)
+
+// PathItem is one level of nesting in a Path: the sequence element's tag and
+// the index of the item within that sequence.
+type PathItem struct {
+	Sequence tag.Tag
+	Index    int
+}
+
+// Path locates an Element within a Dataset. Items lists the sequence items
+// enclosing the element, outermost first, and Tag is the element's own tag.
+type Path struct {
+	Items []PathItem
+	Tag   tag.Tag
+}
+
+// Depth returns the nesting level of the element, where 0 means it is a
+// top-level element of the Dataset.
+func (p Path) Depth() int {
+	return len(p.Items)
+}
+
+// String returns the path in the form "(0040,a730)[0].(0008,0100)".
+func (p Path) String() string {
+	var b strings.Builder
+	for _, item := range p.Items {
+		b.WriteString(fmt.Sprintf("%s[%d].", item.Sequence, item.Index))
+	}
+	b.WriteString(p.Tag.String())
+	return b.String()
+}
+
+// WalkAction is returned by a WalkFunc to tell Walk how to proceed.
+type WalkAction int
+
+const (
+	// WalkContinue continues the walk, descending into the items of the
+	// current element if it is a sequence.
+	WalkContinue WalkAction = iota
+	// WalkSkipChildren continues the walk, but does not visit the elements
+	// nested inside the current sequence element.
+	WalkSkipChildren
+	// WalkStop ends the walk immediately.
+	WalkStop
+	// WalkDelete removes the current element from its Dataset or sequence
+	// item and continues with the next element.
+	WalkDelete
+)
+
+// WalkFunc is called by Walk for every element. The element may be modified
+// in place, including replacing it entirely with *e = *replacement, as long
+// as its tag is left unchanged.
+type WalkFunc func(path Path, e *Element) WalkAction
+
+// Walk calls fn for every element in this Dataset in order, including
+// elements nested inside sequence items. A sequence element is visited before
+// the elements of its items. Unlike FlatIterator, Walk runs synchronously and
+// can be stopped at any time without leaking resources.
+func (d *Dataset) Walk(fn WalkFunc) {
+	elems, deleted, _ := walkElements(d.Elements, nil, fn)
+	if deleted {
+		d.Elements = elems
+		d.reindex()
+	}
+}
+
+// walkElements walks elems and returns the (possibly shortened) slice,
+// whether any element was deleted, and whether the walk was stopped.
+func walkElements(elems []*Element, parents []PathItem, fn WalkFunc) ([]*Element, bool, bool) {
+	deleted, stop := false, false
+	out := elems[:0]
+	i := 0
+	for ; i < len(elems) && !stop; i++ {
+		elem := elems[i]
+		action := fn(Path{Items: parents, Tag: elem.Tag}, elem)
+		if action == WalkStop {
+			break
+		}
+		if action == WalkDelete {
+			deleted = true
+			continue
+		}
+		out = append(out, elem)
+
+		if action == WalkSkipChildren || elem.Value == nil || elem.Value.ValueType() != Sequences {
+			continue
+		}
+		for j, item := range elem.Value.GetValue().([]*SequenceItemValue) {
+			// Limit capacity so that sibling paths never share appended storage.
+			itemPath := append(parents[:len(parents):len(parents)], PathItem{Sequence: elem.Tag, Index: j})
+			var itemElems []*Element
+			var itemDeleted bool
+			itemElems, itemDeleted, stop = walkElements(item.elements, itemPath, fn)
+			if itemDeleted {
+				item.elements = itemElems
+			}
+			if stop {
+				break
+			}
+		}
+	}
+	if !deleted {
+		return elems, false, stop || i < len(elems)
+	}
+	// Keep the elements that were never visited because the walk stopped.
+	n := len(elems)
+	out = append(out, elems[i:]...)
+	for k := len(out); k < n; k++ {
+		elems[k] = nil
+	}
+	return out, true, stop || i < n
+}
@@ -0,0 +1,148 @@
+package dicom
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ginuerzh/dicom/pkg/tag"
+	"github.com/google/go-cmp/cmp"
+)
+
+func walkTestDataset() Dataset {
+	return Dataset{Elements: []*Element{
+		mustNewElement(tag.ComponentName, []string{"Bob"}),
+		makeSequenceElement(tag.AddOtherSequence, [][]*Element{
+			{
+				mustNewElement(tag.Rows, []uint64{1}),
+				makeSequenceElement(tag.AnatomicRegionSequence, [][]*Element{
+					{mustNewElement(tag.Columns, []uint64{2})},
+				}),
+			},
+			{mustNewElement(tag.Rows, []uint64{3})},
+		}),
+		mustNewElement(tag.PixelData, PixelDataInfo{}),
+	}}
+}
+
+func TestDataset_Walk(t *testing.T) {
+	cases := []struct {
+		name      string
+		stopAt    string
+		skipAt    string
+		wantPaths []string
+	}{
+		{
+			name: "all elements",
+			wantPaths: []string{
+				"(0010,0010)",
+				"(0046,0102)",
+				"(0046,0102)[0].(0028,0010)",
+				"(0046,0102)[0].(0008,2218)",
+				"(0046,0102)[0].(0008,2218)[0].(0028,0011)",
+				"(0046,0102)[1].(0028,0010)",
+				"(7fe0,0010)",
+			},
+		},
+		{
+			name:   "skip children",
+			skipAt: "(0046,0102)[0].(0008,2218)",
+			wantPaths: []string{
+				"(0010,0010)",
+				"(0046,0102)",
+				"(0046,0102)[0].(0028,0010)",
+				"(0046,0102)[0].(0008,2218)",
+				"(0046,0102)[1].(0028,0010)",
+				"(7fe0,0010)",
+			},
+		},
+		{
+			name:   "stop",
+			stopAt: "(0046,0102)[0].(0028,0010)",
+			wantPaths: []string{
+				"(0010,0010)",
+				"(0046,0102)",
+				"(0046,0102)[0].(0028,0010)",
+			},
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ds := walkTestDataset()
+			var got []string
+			ds.Walk(func(path Path, e *Element) WalkAction {
+				got = append(got, path.String())
+				switch path.String() {
+				case tc.stopAt:
+					return WalkStop
+				case tc.skipAt:
+					return WalkSkipChildren
+				}
+				return WalkContinue
+			})
+			if diff := cmp.Diff(tc.wantPaths, got); diff != "" {
+				t.Errorf("Walk() visited unexpected paths. diff: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDataset_Walk_depth(t *testing.T) {
+	ds := walkTestDataset()
+	depths := map[string]int{}
+	ds.Walk(func(path Path, e *Element) WalkAction {
+		depths[path.String()] = path.Depth()
+		return WalkContinue
+	})
+	if got := depths["(0046,0102)[0].(0008,2218)[0].(0028,0011)"]; got != 2 {
+		t.Errorf("Path.Depth() of doubly nested element: got: %d, want: %d", got, 2)
+	}
+	if got := depths["(0010,0010)"]; got != 0 {
+		t.Errorf("Path.Depth() of top level element: got: %d, want: %d", got, 0)
+	}
+}
+
+func TestDataset_Walk_deleteAndReplace(t *testing.T) {
+	ds := walkTestDataset()
+	ds.Walk(func(path Path, e *Element) WalkAction {
+		switch e.Tag {
+		case tag.Rows:
+			return WalkDelete
+		case tag.ComponentName:
+			*e = *mustNewElement(tag.ComponentName, []string{"Alice"})
+		}
+		return WalkContinue
+	})
+
+	if _, err := ds.FindElementByTagNested(tag.Rows); err != ErrorElementNotFound {
+		t.Errorf("FindElementByTagNested(%v) after delete: got err: %v, want: %v", tag.Rows, err, ErrorElementNotFound)
+	}
+	if _, err := ds.FindElementByTagNested(tag.Columns); err != nil {
+		t.Errorf("FindElementByTagNested(%v) after delete: unexpected err: %v", tag.Columns, err)
+	}
+	name, err := ds.FindElementByTag(tag.ComponentName)
+	if err != nil {
+		t.Fatalf("FindElementByTag(%v): unexpected err: %v", tag.ComponentName, err)
+	}
+	if got := MustGetStrings(name.Value)[0]; got != "Alice" {
+		t.Errorf("replaced element has unexpected value. got: %v, want: %v", got, "Alice")
+	}
+}
+
+func ExampleDataset_Walk() {
+	ds := Dataset{Elements: []*Element{
+		mustNewElement(tag.Rows, []uint64{100}),
+		makeSequenceElement(tag.AddOtherSequence, [][]*Element{
+			{mustNewElement(tag.ComponentName, []string{"Bob"})},
+		}),
+	}}
+
+	ds.Walk(func(path Path, e *Element) WalkAction {
+		fmt.Println(path.Depth(), path)
+		return WalkContinue
+	})
+
+	// Output:
+	// 0 (0028,0010)
+	// 0 (0046,0102)
+	// 1 (0046,0102)[0].(0010,0010)
+}
@@ -1,13 +1,15 @@
 package dicom
 
 import (
-	"bufio"
 	"bytes"
 	"encoding/binary"
 	"errors"
 	"fmt"
 	"io"
+	"sort"
+	"strings"
 
+	"github.com/ginuerzh/dicom/pkg/charset"
 	"github.com/ginuerzh/dicom/pkg/dicomio"
 	"github.com/ginuerzh/dicom/pkg/tag"
 	"github.com/ginuerzh/dicom/pkg/uid"
@@ -23,47 +25,60 @@ var (
 	// ErrorUnsupportedBitsPerSample indicates that the BitsPerSample in this
 	// Dataset is not supported when unpacking native PixelData.
 	ErrorUnsupportedBitsPerSample = errors.New("unsupported BitsPerSample value")
+	// ErrorDuplicateTag indicates that the same tag appears more than once
+	// within a Dataset or sequence item being written.
+	ErrorDuplicateTag = errors.New("duplicate tag in dataset")
 )
 
-// TODO(suyashkumar): consider adding an element-by-element write API.
-
 // Write will write the input DICOM dataset to the provided io.Writer as a complete DICOM (including any header
-// information if available).
+// information if available). To write a DICOM without holding the whole
+// Dataset in memory, use NewWriter.
 func Write(out io.Writer, ds Dataset, opts ...WriteOption) error {
-	optSet := toOptSet(opts...)
-	w := dicomio.NewWriter(out, nil, false)
-	var metaElems []*Element
-	for _, elem := range ds.Elements {
-		if elem.Tag.Group == tag.MetadataGroup {
-			metaElems = append(metaElems, elem)
-		}
+	w, err := NewWriter(out, ds, opts...)
+	if err != nil {
+		return err
 	}
 
-	err := writeFileHeader(w, &ds, metaElems, *optSet)
+	elems, err := orderedElements(ds.Elements, w.opts)
 	if err != nil {
 		return err
 	}
+	for _, elem := range elems {
+		if elem.Tag.Group != tag.MetadataGroup {
+			if err := w.WriteElement(elem); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
 
-	endian, implicit, err := ds.transferSyntax()
-	if (err != nil && err != ErrorElementNotFound) || (err == ErrorElementNotFound && !optSet.defaultMissingTransferSyntax) {
+// WriteDataset writes the elements of ds, other than the File Meta
+// Information, in the transfer syntax transferSyntaxUID, without a preamble
+// or File Meta Information header. This is the encoding used inside DICOM
+// objects by PS3.15, e.g. for the Encrypted Content of the Encrypted
+// Attributes Sequence. Use ParseDataset to read it back.
+func WriteDataset(out io.Writer, ds Dataset, transferSyntaxUID string, opts ...WriteOption) error {
+	bo, implicit, err := uid.ParseTransferSyntaxUID(transferSyntaxUID)
+	if err != nil {
 		return err
 	}
+	optSet := toOptSet(opts...)
+	optSet.raw = ds.raw.forWrite()
+	cw := &countingWriter{out: out}
+	w := newWriter(out, cw, dicomio.NewWriter(cw, bo, implicit), *optSet)
 
-	if err == ErrorElementNotFound && optSet.defaultMissingTransferSyntax {
-		w.SetTransferSyntax(binary.LittleEndian, true)
-	} else {
-		w.SetTransferSyntax(endian, implicit)
+	elems, err := orderedElements(ds.Elements, w.opts)
+	if err != nil {
+		return err
 	}
-
-	for _, elem := range ds.Elements {
+	for _, elem := range elems {
 		if elem.Tag.Group != tag.MetadataGroup {
-			err = writeElement(w, elem, *optSet)
-			if err != nil {
+			if err := w.WriteElement(elem); err != nil {
 				return err
 			}
 		}
 	}
-
 	return nil
 }
 
@@ -96,11 +111,102 @@ func DefaultMissingTransferSyntax() WriteOption {
 	}
 }
 
+// SkipElementSorting returns a WriteOption that writes elements in the order
+// they appear in the Dataset and its sequence items, instead of sorting them
+// into ascending tag order and rejecting duplicate tags.
+func SkipElementSorting() WriteOption {
+	return func(set *writeOptSet) {
+		set.skipElementSorting = true
+	}
+}
+
+// DefinedLengthSequences returns a WriteOption that writes every sequence and
+// sequence item, including nested ones, with an explicit length computed from
+// its contents instead of the undefined length and delimitation items that are
+// written by default.
+func DefinedLengthSequences() WriteOption {
+	return func(set *writeOptSet) {
+		set.sequenceLengths = definedLengths
+	}
+}
+
+// PreserveLengthEncoding returns a WriteOption that writes each sequence and
+// sequence item with the same kind of length encoding it was parsed with: a
+// sequence whose ValueLength is tag.VLUndefinedLength, or an item that was
+// parsed with an Item Delimitation Item, is written with undefined length, and
+// all others are written with an explicit length computed from their contents.
+func PreserveLengthEncoding() WriteOption {
+	return func(set *writeOptSet) {
+		set.sequenceLengths = preservedLengths
+	}
+}
+
+// ReplaceUnrepresentableCharacters returns a WriteOption that writes
+// replacement, for example "?", in place of characters of text values that
+// the Specific Character Set of the Dataset cannot represent, instead of
+// failing with charset.ErrorUnrepresentable. Without a Specific Character
+// Set, that is the default character repertoire (ISO-IR 6).
+func ReplaceUnrepresentableCharacters(replacement string) WriteOption {
+	return func(set *writeOptSet) {
+		set.characterReplacement = &replacement
+	}
+}
+
 // writeOptSet represents the flattened option set after all WriteOptions have been applied.
 type writeOptSet struct {
 	skipVRVerification           bool
 	skipValueTypeVerification    bool
 	defaultMissingTransferSyntax bool
+	skipElementSorting           bool
+	sequenceLengths              lengthEncoding
+	populateFileMeta             bool
+	implementationClassUID       *string
+	implementationVersionName    *string
+	sourceApplicationEntityTitle string
+	validateValues               bool
+	characterReplacement         *string
+	// items locates the sequence item holding the elements being written,
+	// for the paths reported by ValidateValues.
+	items []PathItem
+	// raw holds the encodings retained by RetainRawValues for the Dataset
+	// being written.
+	raw *rawValues
+}
+
+// lengthEncoding determines how the lengths of sequences and items are
+// written.
+type lengthEncoding int
+
+const (
+	undefinedLengths lengthEncoding = iota
+	definedLengths
+	preservedLengths
+)
+
+// undefinedSequenceLength reports whether a sequence element with the
+// provided ValueLength should be written with undefined length.
+func (o writeOptSet) undefinedSequenceLength(vl uint32) bool {
+	switch o.sequenceLengths {
+	case definedLengths:
+		return false
+	case preservedLengths:
+		return vl == tag.VLUndefinedLength
+	default:
+		return true
+	}
+}
+
+// undefinedItemLength reports whether the provided sequence item should be
+// written with undefined length.
+func (o writeOptSet) undefinedItemLength(item *SequenceItemValue) bool {
+	switch o.sequenceLengths {
+	case definedLengths:
+		return false
+	case preservedLengths:
+		return !item.definedLength
+	default:
+		return true
+	}
 }
 
 func toOptSet(opts ...WriteOption) *writeOptSet {
@@ -111,7 +217,33 @@ func toOptSet(opts ...WriteOption) *writeOptSet {
 	return optSet
 }
 
+// orderedElements returns the elements in the order they should be written.
+// Unless skipElementSorting is set, this is a sorted copy of elems, and an
+// error is returned if a tag is duplicated.
+func orderedElements(elems []*Element, opts writeOptSet) ([]*Element, error) {
+	if opts.skipElementSorting || elementsSorted(elems) {
+		return elems, nil
+	}
+	sorted := make([]*Element, len(elems))
+	copy(sorted, elems)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Tag.Compare(sorted[j].Tag) < 0
+	})
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i-1].Tag == sorted[i].Tag {
+			return nil, fmt.Errorf("%w: %v", ErrorDuplicateTag, tag.DebugString(sorted[i].Tag))
+		}
+	}
+	return sorted, nil
+}
+
 func writeFileHeader(w dicomio.Writer, ds *Dataset, metaElems []*Element, opts writeOptSet) error {
+	metaElems, err := fileMetaElements(ds, metaElems, opts)
+	if err != nil {
+		return err
+	}
+	meta := &Dataset{Elements: metaElems}
+
 	// File headers are always written in littleEndian explicit
 	w.SetTransferSyntax(binary.LittleEndian, false)
 
@@ -120,19 +252,19 @@ func writeFileHeader(w dicomio.Writer, ds *Dataset, metaElems []*Element, opts w
 	tagsUsed := make(map[tag.Tag]bool)
 	tagsUsed[tag.FileMetaInformationGroupLength] = true
 
-	err := writeMetaElem(subWriter, tag.FileMetaInformationVersion, ds, &tagsUsed, opts)
+	err = writeMetaElem(subWriter, tag.FileMetaInformationVersion, meta, &tagsUsed, opts)
 	if err != nil && err != ErrorElementNotFound {
 		return err
 	}
-	err = writeMetaElem(subWriter, tag.MediaStorageSOPClassUID, ds, &tagsUsed, opts)
+	err = writeMetaElem(subWriter, tag.MediaStorageSOPClassUID, meta, &tagsUsed, opts)
 	if err != nil && err != ErrorElementNotFound {
 		return err
 	}
-	err = writeMetaElem(subWriter, tag.MediaStorageSOPInstanceUID, ds, &tagsUsed, opts)
+	err = writeMetaElem(subWriter, tag.MediaStorageSOPInstanceUID, meta, &tagsUsed, opts)
 	if err != nil && err != ErrorElementNotFound {
 		return err
 	}
-	err = writeMetaElem(subWriter, tag.TransferSyntaxUID, ds, &tagsUsed, opts)
+	err = writeMetaElem(subWriter, tag.TransferSyntaxUID, meta, &tagsUsed, opts)
 	if err != nil && err != ErrorElementNotFound || err == ErrorElementNotFound && !opts.defaultMissingTransferSyntax {
 		return err
 	}
@@ -182,13 +314,15 @@ func writeFileHeader(w dicomio.Writer, ds *Dataset, metaElems []*Element, opts w
 }
 
 func writeElement(w dicomio.Writer, elem *Element, opts writeOptSet) error {
-	vr := elem.RawValueRepresentation
-	if !opts.skipVRVerification {
-		var err error
-		vr, err = verifyVROrDefault(elem.Tag, elem.RawValueRepresentation)
-		if err != nil {
+	vr, opts, raw, err := elementEncoding(w, elem, opts)
+	if err != nil {
+		return err
+	}
+	if raw != nil {
+		if err := validateEncoded(elem, vr, raw.vl, opts); err != nil {
 			return err
 		}
+		return writeRawElement(w, elem.Tag, raw)
 	}
 	if !opts.skipValueTypeVerification && elem.Value != nil {
 		err := verifyValueType(elem.Tag, elem.Value, vr)
@@ -197,34 +331,70 @@ func writeElement(w dicomio.Writer, elem *Element, opts writeOptSet) error {
 		}
 	}
 
+	// The encoded length is computed up front so that the value can be
+	// written straight to w, without first encoding it into a buffer.
 	length := elem.ValueLength
-	var valueData = &bytes.Buffer{}
 	if elem.Value != nil {
-		bo, implicit := w.GetTransferSyntax()
-		subWriter := dicomio.NewWriter(valueData, bo, implicit)
-		err := writeValue(subWriter, elem.Tag, elem.Value, elem.Value.ValueType(), vr, elem.ValueLength, opts)
+		length, err = encodedValueLength(w, elem.Tag, elem.Value, vr, elem.ValueLength, opts)
 		if err != nil {
 			return err
 		}
-
-		length = uint32(len(valueData.Bytes()))
-		if elem.ValueLength == tag.VLUndefinedLength {
-			length = tag.VLUndefinedLength
-		}
+	} else if vr == "SQ" || elem.Tag == tag.Item {
+		// Sequences and items without a value are only headers of
+		// undefined length elements, like those written by Writer.
+		length = tag.VLUndefinedLength
+	}
+	if err := validateEncoded(elem, vr, length, opts); err != nil {
+		return err
 	}
 
-	err := encodeElementHeader(w, elem.Tag, vr, length)
+	err = encodeElementHeader(w, elem.Tag, vr, length)
 	if err != nil {
 		return err
 	}
 
 	if elem.Value != nil {
-		// Write the bytes to the original writer
-		w.WriteBytes(valueData.Bytes())
+		return writeValue(w, elem.Tag, elem.Value, elem.Value.ValueType(), vr, length, opts)
 	}
 	return nil
 }
 
+// validateEncoded checks elem against the rules of its VR if ValidateValues
+// is set, where length is the value length it is encoded with.
+func validateEncoded(elem *Element, vr string, length uint32, opts writeOptSet) error {
+	if !opts.validateValues {
+		return nil
+	}
+	if violations := validateElement(elem, vr, Path{Items: opts.items, Tag: elem.Tag}.String(), length); len(violations) > 0 {
+		return &ValueError{Violations: violations}
+	}
+	return nil
+}
+
+// elementEncoding returns the VR and options elem will be written with. If
+// elem can be written exactly as it was parsed with RetainRawValues, its
+// retained encoding is returned as well. Sequences parsed with
+// RetainRawValues keep their VR and length encoding.
+func elementEncoding(w dicomio.Writer, elem *Element, opts writeOptSet) (string, writeOptSet, *rawValue, error) {
+	if raw := opts.raw.lookup(w, elem); raw != nil {
+		if !raw.sequence {
+			return raw.vr, opts, raw, nil
+		}
+		opts.sequenceLengths = preservedLengths
+		return raw.vr, opts, nil, nil
+	}
+	vr, err := elementVR(elem, opts)
+	return vr, opts, nil, err
+}
+
+// elementVR returns the VR elem will be written with.
+func elementVR(elem *Element, opts writeOptSet) (string, error) {
+	if opts.skipVRVerification {
+		return elem.RawValueRepresentation, nil
+	}
+	return verifyVROrDefault(elem.Tag, elem.RawValueRepresentation)
+}
+
 func writeMetaElem(w dicomio.Writer, t tag.Tag, ds *Dataset, tagsUsed *map[tag.Tag]bool, optSet writeOptSet) error {
 	elem, err := ds.FindElementByTag(t)
 	if err != nil {
@@ -238,15 +408,21 @@ func writeMetaElem(w dicomio.Writer, t tag.Tag, ds *Dataset, tagsUsed *map[tag.T
 	return nil
 }
 
+// verifyVROrDefault checks vr against the dictionary VRs of t, and returns
+// the VR to write. For tags that are not in the dictionary vr is kept, or UN
+// is used if vr is empty.
 func verifyVROrDefault(t tag.Tag, vr string) (string, error) {
 	tagInfo, err := tag.Find(t)
 	if err != nil {
+		if vr != "" {
+			return vr, nil
+		}
 		return "UN", nil
 	}
 	if vr == "" {
 		return tagInfo.VR, nil
 	}
-	if tagInfo.VR != vr {
+	if !tagInfo.HasVR(vr) {
 		return "", fmt.Errorf("ERROR dicomio.veryifyElement: VR mismatch for tag %v. Element.VR=%v, but DICOM standard defines VR to be %v",
 			tag.DebugString(t), vr, tagInfo.VR)
 	}
@@ -301,11 +477,6 @@ func writeVRVL(w dicomio.Writer, t tag.Tag, vr string, vl uint32) error {
 		vl = tag.VLUndefinedLength
 	}
 
-	if vr == "SQ" || t == tag.Item {
-		// We are going to write these out with undefined length always.
-		vl = tag.VLUndefinedLength
-	}
-
 	if len(vr) != 2 && vl != tag.VLUndefinedLength &&
 		t != tag.SequenceDelimitationItem && t != tag.ItemDelimitationItem {
 		return fmt.Errorf("ERROR dicomio.writeVRVL: Value Representation must be of length 2, e.g. 'UN'. For tag=%v, it was RawValueRepresentation=%v",
@@ -343,15 +514,19 @@ func writeVRVL(w dicomio.Writer, t tag.Tag, vr string, vl uint32) error {
 }
 
 func writeRawItem(w dicomio.Writer, data []byte) error {
-	length := uint32(len(data))
-	if err := writeTag(w, tag.Item, length); err != nil {
+	if err := writeItemHeader(w, uint32(len(data))); err != nil {
 		return err
 	}
-	if err := writeVRVL(w, tag.Item, "NA", length); err != nil {
+	return w.WriteBytes(data)
+}
+
+// writeItemHeader writes an Item tag with the provided length. Items are
+// always encoded as implicit VR.
+func writeItemHeader(w dicomio.Writer, length uint32) error {
+	if err := writeTag(w, tag.Item, length); err != nil {
 		return err
 	}
-	w.WriteBytes(data)
-	return nil
+	return w.WriteUInt32(length)
 }
 
 func writeBasicOffsetTable(w dicomio.Writer, offsets []uint32) error {
@@ -383,22 +558,23 @@ func writeValue(w dicomio.Writer, t tag.Tag, value Value, valueType ValueType, v
 		return fmt.Errorf("encoding undefined-length element not yet supported: %v", t)
 	}
 
-	v := value.GetValue()
+	// The concrete types are used instead of GetValue, which would allocate
+	// to box the underlying slice.
 	switch valueType {
 	case Strings:
-		return writeStrings(w, v.([]string), vr)
+		return writeStrings(w, value.(*stringsValue).value, vr)
 	case Bytes:
-		return writeBytes(w, v.([]byte), vr)
+		return writeBytes(w, value.(*bytesValue).value, vr)
 	case Ints:
-		return writeInts(w, v.([]int64), vr)
+		return writeInts(w, value.(*intsValue).value, vr)
 	case UInts:
-		return writeUInts(w, v.([]uint64), vr)
+		return writeUInts(w, value.(*uintsValue).value, vr)
 	case PixelData:
 		return writePixelData(w, t, value, vr, vl)
 	case SequenceItem:
-		return writeSequenceItem(w, t, v.([]*Element), vr, vl, opts)
+		return writeSequenceItem(w, t, value.(*SequenceItemValue).elements, vr, vl, opts)
 	case Sequences:
-		return writeSequence(w, t, v.([]*SequenceItemValue), vr, vl, opts)
+		return writeSequence(w, t, value.(*sequencesValue).value, vr, vl, opts)
 	case Floats:
 		return writeFloats(w, value, vr)
 	default:
@@ -406,32 +582,281 @@ func writeValue(w dicomio.Writer, t tag.Tag, value Value, valueType ValueType, v
 	}
 }
 
-func writeStrings(w dicomio.Writer, values []string, vr string) error {
-	s := ""
-	for i, substr := range values {
-		if i > 0 {
-			s += "\\"
+// encodedValueLength returns the number of bytes writeValue will write for
+// value, or tag.VLUndefinedLength if the value is written with undefined
+// length. It reports the same errors writeValue would, so that nothing is
+// written for an element that cannot be encoded.
+func encodedValueLength(w dicomio.Writer, t tag.Tag, value Value, vr string, vl uint32, opts writeOptSet) (uint32, error) {
+	valueType := value.ValueType()
+	switch valueType {
+	case Sequences:
+		if opts.undefinedSequenceLength(vl) {
+			return tag.VLUndefinedLength, nil
 		}
-		s += substr
+		size, err := itemsSize(w, value.(*sequencesValue).value, opts)
+		if err != nil {
+			return 0, err
+		}
+		if size >= int64(tag.VLUndefinedLength) {
+			return 0, fmt.Errorf("sequence %v is too long to be written with a defined length", tag.DebugString(t))
+		}
+		return uint32(size), nil
+	case SequenceItem:
+		// Items that are Element values are always written with undefined
+		// length.
+		return tag.VLUndefinedLength, nil
 	}
-	if err := w.WriteString(s); err != nil {
-		return err
+	if vl == tag.VLUndefinedLength {
+		if valueType <= 3 { // strings, bytes, ints or uints
+			return 0, fmt.Errorf("encoding undefined-length element not yet supported: %v", t)
+		}
+		return tag.VLUndefinedLength, nil
 	}
-	if len(s)%2 == 1 {
+
+	var length int
+	switch valueType {
+	case Strings:
+		for i, s := range value.(*stringsValue).value {
+			if i > 0 {
+				length++
+			}
+			encoded, err := encodeString(w, s, vr)
+			if err != nil {
+				return 0, fmt.Errorf("%v: %w", tag.DebugString(t), err)
+			}
+			length += len(encoded)
+		}
+	case Bytes:
+		data := value.(*bytesValue).value
 		switch vr {
-		case "AE", "CS", "DS", "DT", "IS", "LO", "LT", "PN", "SH", "ST", "TM", "UC", "UN", "UR", "UT":
-			if err := w.WriteString(" "); err != nil { // http://dicom.nema.org/medical/dicom/current/output/html/part05.html#sect_6.2
-				return err
+		case "OW":
+			if len(data)%2 != 0 {
+				return 0, ErrorOWRequiresEvenVL
 			}
+		case "OB", "UN":
+		default:
+			return 0, ErrorMismatchValueTypeAndVR
+		}
+		length = len(data)
+	case Ints:
+		size, err := intSize(vr, len(value.(*intsValue).value), "SS", "SL", "SV")
+		if err != nil {
+			return 0, err
+		}
+		length = size
+	case UInts:
+		size, err := intSize(vr, len(value.(*uintsValue).value), "US", "UL", "UV", "AT", "OL", "OV")
+		if err != nil {
+			return 0, err
+		}
+		length = size
+	case Floats:
+		n := len(value.(*floatsValue).value)
+		switch vr {
+		case "FL", "OF":
+			length = 4 * n
+		case "FD", "OD":
+			length = 8 * n
+		}
+	case PixelData:
+		for _, frame := range value.(*pixelDataValue).Frames {
+			length += len(frame.NativeData.Data)
+		}
+		return uint32(length), nil
+	default:
+		return 0, fmt.Errorf("ValueType not supported")
+	}
+	return uint32(length + length%2), nil
+}
+
+// elementSize returns the number of bytes writeElement will write for elem.
+func elementSize(w dicomio.Writer, elem *Element, opts writeOptSet) (int64, error) {
+	vr, opts, raw, err := elementEncoding(w, elem, opts)
+	if err != nil {
+		return 0, err
+	}
+	size := headerSize(w, elem.Tag, vr)
+	if raw != nil {
+		return size + int64(len(raw.data)), nil
+	}
+	if elem.Value == nil {
+		if elem.ValueLength != tag.VLUndefinedLength && vr != "SQ" && elem.Tag != tag.Item {
+			size += int64(elem.ValueLength)
+		}
+		return size, nil
+	}
+	length, err := encodedValueLength(w, elem.Tag, elem.Value, vr, elem.ValueLength, opts)
+	if err != nil {
+		return 0, err
+	}
+	if length != tag.VLUndefinedLength {
+		return size + int64(length), nil
+	}
+
+	// Undefined length values are followed by a delimitation item.
+	switch v := elem.Value.(type) {
+	case *sequencesValue:
+		items, err := itemsSize(w, v.value, opts)
+		if err != nil {
+			return 0, err
+		}
+		return size + items + 8, nil
+	case *SequenceItemValue:
+		items, err := itemsSize(w, []*SequenceItemValue{v}, opts)
+		if err != nil {
+			return 0, err
+		}
+		return size + items, nil
+	case *pixelDataValue:
+		size += 8 + 4*int64(len(v.Offsets))
+		for _, frame := range v.Frames {
+			size += 8 + int64(len(frame.EncapsulatedData.Data))
+		}
+		return size + 8, nil
+	default:
+		return size, nil
+	}
+}
+
+// itemsSize returns the number of bytes needed to write the provided sequence
+// items, including their headers and any Item Delimitation Items.
+func itemsSize(w dicomio.Writer, items []*SequenceItemValue, opts writeOptSet) (int64, error) {
+	var size int64
+	for _, item := range items {
+		body, err := itemBodySize(w, item, opts)
+		if err != nil {
+			return 0, err
+		}
+		size += 8 + body
+		if opts.undefinedItemLength(item) {
+			size += 8
+		}
+	}
+	return size, nil
+}
+
+// itemBodySize returns the number of bytes needed to write the elements of
+// the provided sequence item.
+func itemBodySize(w dicomio.Writer, item *SequenceItemValue, opts writeOptSet) (int64, error) {
+	var size int64
+	for _, elem := range item.elements {
+		elemSize, err := elementSize(w, elem, opts)
+		if err != nil {
+			return 0, err
+		}
+		size += elemSize
+	}
+	return size, nil
+}
+
+// headerSize returns the size of the header encodeElementHeader writes for
+// an element with the provided tag and VR.
+func headerSize(w dicomio.Writer, t tag.Tag, vr string) int64 {
+	_, implicit := w.GetTransferSyntax()
+	if implicit || t.Group == tag.GROUP_ItemSeq {
+		return 8
+	}
+	switch vr {
+	case "NA", "OB", "OD", "OF", "OL", "OV", "OW", "SQ", "SV", "UN", "UC", "UR", "UV", "UT":
+		return 12
+	default:
+		return 8
+	}
+}
+
+// intSize returns the encoded size of n integers of the provided VR, which
+// must be one of the allowed VRs.
+func intSize(vr string, n int, allowed ...string) (int, error) {
+	if n == 0 {
+		return 0, nil
+	}
+	for _, a := range allowed {
+		if a != vr {
+			continue
+		}
+		switch vr {
+		case "SS", "US":
+			return 2 * n, nil
+		case "SV", "UV", "OV":
+			return 8 * n, nil
 		default:
-			if err := w.WriteByte(0); err != nil {
+			return 4 * n, nil
+		}
+	}
+	return 0, ErrorMismatchValueTypeAndVR
+}
+
+func writeStrings(w dicomio.Writer, values []string, vr string) error {
+	length := 0
+	for i, substr := range values {
+		if i > 0 {
+			if err := w.WriteString("\\"); err != nil {
 				return err
 			}
+			length++
+		}
+		encoded, err := encodeString(w, substr, vr)
+		if err != nil {
+			return err
+		}
+		if err := w.WriteString(encoded); err != nil {
+			return err
+		}
+		length += len(encoded)
+	}
+	if length%2 == 1 {
+		if err := w.WriteByte(stringPadding(vr)); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
+// characterSetEncoder returns the charset.Encoder for the text values of a
+// Dataset with the SpecificCharacterSet element elem. If elem is nil or has no
+// values, the encoder of the default character repertoire (ISO-IR 6) is
+// returned. If its values are not supported, it returns the error of
+// charset.NewEncoder, which is only reported when a text value outside the
+// default character repertoire is written (see dicomio.Writer.SetEncoder).
+func characterSetEncoder(elem *Element, opts writeOptSet) (*charset.Encoder, error) {
+	var names []string
+	if elem != nil {
+		names, _ = elem.Value.GetValue().([]string)
+		if strings.TrimSpace(strings.Join(names, "")) == "" {
+			names = nil
+		}
+	}
+	var encoderOpts []charset.EncoderOption
+	if opts.characterReplacement != nil {
+		encoderOpts = append(encoderOpts, charset.ReplaceUnrepresentable(*opts.characterReplacement))
+	}
+	return charset.NewEncoder(names, encoderOpts...)
+}
+
+// encodeString returns s as it is written for vr. Values of the VRs that use
+// the Specific Character Set are encoded with the charset.Encoder of w, see
+// PS3.5 6.1.2.3; other values only use the default character repertoire.
+func encodeString(w dicomio.Writer, s, vr string) (string, error) {
+	switch vr {
+	case "LO", "LT", "PN", "SH", "ST", "UC", "UT":
+		return w.EncodeString(s)
+	default:
+		return s, nil
+	}
+}
+
+// stringPadding returns the byte used to pad string values of the provided VR
+// to an even length.
+// http://dicom.nema.org/medical/dicom/current/output/html/part05.html#sect_6.2
+func stringPadding(vr string) byte {
+	switch vr {
+	case "AE", "CS", "DS", "DT", "IS", "LO", "LT", "PN", "SH", "ST", "TM", "UC", "UN", "UR", "UT":
+		return ' '
+	default:
+		return 0
+	}
+}
+
 func writeBytes(w dicomio.Writer, values []byte, vr string) error {
 	var err error
 	switch vr {
@@ -496,7 +921,7 @@ func writeFloats(w dicomio.Writer, v Value, vr string) error {
 	if v.ValueType() != Floats {
 		return ErrorUnexpectedValueType
 	}
-	floats := MustGetFloats(v)
+	floats := v.(*floatsValue).value
 	for _, fl := range floats {
 		switch vr {
 		case "FL", "OF":
@@ -519,7 +944,7 @@ func writeFloats(w dicomio.Writer, v Value, vr string) error {
 }
 
 func writePixelData(w dicomio.Writer, t tag.Tag, value Value, vr string, vl uint32) error {
-	image := MustGetPixelDataInfo(value)
+	image := value.(*pixelDataValue)
 	if vl == tag.VLUndefinedLength {
 		if err := writeBasicOffsetTable(w, image.Offsets); err != nil {
 			return err
@@ -549,19 +974,37 @@ var sequenceDelimitationItem = &Element{
 }
 
 func writeSequence(w dicomio.Writer, t tag.Tag, values []*SequenceItemValue, vr string, vl uint32, opts writeOptSet) error {
-	// We always write out sequences using the undefined length encoding.
-	// Note: we currently don't validate that the length of the sequence matches
-	// the VL if it's not undefined VL.
+	// Sequences are written with undefined length unless a WriteOption asks
+	// for defined lengths, in which case vl holds the computed length.
 	// More details about the sequence structure can be found at:
 	// http://dicom.nema.org/medical/dicom/current/output/chtml/part05/sect_7.5.html
 
 	// Write out the items.
-	for _, seqItem := range values {
-		if err := writeSequenceItem(w, t, seqItem.elements, vr, vl, opts); err != nil {
+	for i, seqItem := range values {
+		itemLength := tag.VLUndefinedLength
+		if !opts.undefinedItemLength(seqItem) {
+			size, err := itemBodySize(w, seqItem, opts)
+			if err != nil {
+				return err
+			}
+			if size >= int64(tag.VLUndefinedLength) {
+				return fmt.Errorf("item in sequence %v is too long to be written with a defined length", tag.DebugString(t))
+			}
+			itemLength = uint32(size)
+		}
+		itemOpts := opts
+		if opts.validateValues {
+			itemOpts.items = append(opts.items[:len(opts.items):len(opts.items)], PathItem{Sequence: t, Index: i})
+		}
+		if err := writeSequenceItem(w, t, seqItem.elements, vr, itemLength, itemOpts); err != nil {
 			return err
 		}
 	}
 
+	if vl != tag.VLUndefinedLength {
+		return nil
+	}
+
 	// Write Sequence Delimitation Item as implicit VR
 	oldBO, oldImplicit := w.GetTransferSyntax()
 	w.SetTransferSyntax(oldBO, true)
@@ -583,19 +1026,29 @@ var item = &Element{
 	ValueLength: tag.VLUndefinedLength,
 }
 
+// writeSequenceItem writes an item holding values. If vl is
+// tag.VLUndefinedLength the item is terminated by an Item Delimitation Item,
+// otherwise vl must be the encoded length of values.
 func writeSequenceItem(w dicomio.Writer, t tag.Tag, values []*Element, vr string, vl uint32, opts writeOptSet) error {
 	// Write out item header.
-	if err := writeElement(w, item, opts); err != nil {
+	if err := writeItemHeader(w, vl); err != nil {
 		return err
 	}
 
 	// Write out nested Dataset elements.
+	values, err := orderedElements(values, opts)
+	if err != nil {
+		return err
+	}
 	for _, elem := range values {
 		if err := writeElement(w, elem, opts); err != nil {
 			return err
 		}
 	}
 
+	if vl != tag.VLUndefinedLength {
+		return nil
+	}
 	// Write ItemDelimitationItem.
 	return writeElement(w, sequenceItemDelimitationItem, opts)
 }
@@ -604,21 +1057,8 @@ func writeOtherWordString(w dicomio.Writer, data []byte) error {
 	if len(data)%2 != 0 {
 		return ErrorOWRequiresEvenVL
 	}
-	bo, _ := w.GetTransferSyntax()
-	r, err := dicomio.NewReader(bufio.NewReader(bytes.NewBuffer(data)), bo, int64(len(data)))
-	if err != nil {
-		return err
-	}
-	for i := 0; i < int(len(data)/2); i++ {
-		v, err := r.ReadUInt16()
-		if err != nil {
-			return err
-		}
-		if err := w.WriteUInt16(v); err != nil {
-			return err
-		}
-	}
-	return nil
+	// The raw bytes are already in the byte order of the dataset.
+	return w.WriteBytes(data)
 }
 
 func writeOtherByteString(w dicomio.Writer, data []byte) error {
@@ -3,10 +3,14 @@ package dicom
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
+	"fmt"
 	"io/ioutil"
 	"os"
+	"strings"
 	"testing"
 
+	"github.com/ginuerzh/dicom/pkg/charset"
 	"github.com/ginuerzh/dicom/pkg/dicomio"
 	"github.com/ginuerzh/dicom/pkg/tag"
 	"github.com/ginuerzh/dicom/pkg/uid"
@@ -121,6 +125,28 @@ func TestWrite(t *testing.T) {
 			}},
 			expectedError: nil,
 		},
+		{
+			name: "out of order elements are sorted",
+			dataset: Dataset{Elements: []*Element{
+				mustNewElement(tag.Rows, []uint64{128}),
+				mustNewElement(tag.TransferSyntaxUID, []string{uid.ImplicitVRLittleEndian}),
+				mustNewElement(tag.ComponentName, []string{"Bob", "Jones"}),
+				mustNewElement(tag.MediaStorageSOPClassUID, []string{"1.2.840.10008.5.1.4.1.1.1.2"}),
+				mustNewElement(tag.MediaStorageSOPInstanceUID, []string{"1.2.3.4.5.6.7"}),
+			}},
+			expectedError: nil,
+		},
+		{
+			name: "duplicate tags",
+			dataset: Dataset{Elements: []*Element{
+				mustNewElement(tag.MediaStorageSOPClassUID, []string{"1.2.840.10008.5.1.4.1.1.1.2"}),
+				mustNewElement(tag.MediaStorageSOPInstanceUID, []string{"1.2.3.4.5.6.7"}),
+				mustNewElement(tag.TransferSyntaxUID, []string{uid.ImplicitVRLittleEndian}),
+				mustNewElement(tag.Rows, []uint64{128}),
+				mustNewElement(tag.Rows, []uint64{256}),
+			}},
+			expectedError: ErrorDuplicateTag,
+		},
 		{
 			name: "without transfer syntax",
 			dataset: Dataset{Elements: []*Element{
@@ -153,7 +179,7 @@ func TestWrite(t *testing.T) {
 			if err != nil {
 				t.Fatalf("Unexpected error when creating tempfile: %v", err)
 			}
-			if err = Write(file, tc.dataset, tc.opts...); err != tc.expectedError {
+			if err = Write(file, tc.dataset, tc.opts...); !errors.Is(err, tc.expectedError) {
 				t.Errorf("Write(%v): unexpected error. got: %v, want: %v", tc.dataset, err, tc.expectedError)
 			}
 			file.Close()
@@ -196,6 +222,213 @@ func TestWrite(t *testing.T) {
 	}
 }
 
+func TestWrite_SequenceLengths(t *testing.T) {
+	ds := Dataset{Elements: []*Element{
+		mustNewElement(tag.MediaStorageSOPClassUID, []string{"1.2.840.10008.5.1.4.1.1.1.2"}),
+		mustNewElement(tag.MediaStorageSOPInstanceUID, []string{"1.2.3.4.5.6.7"}),
+		mustNewElement(tag.TransferSyntaxUID, []string{uid.ExplicitVRLittleEndian}),
+		mustNewElement(tag.ComponentName, []string{"Bob", "Jones"}),
+		makeSequenceElement(tag.AddOtherSequence, [][]*Element{
+			{
+				mustNewElement(tag.ComponentName, []string{"Bob"}),
+				makeSequenceElement(tag.AddOtherSequence, [][]*Element{
+					{mustNewElement(tag.Rows, []uint64{100})},
+					{mustNewElement(tag.Columns, []uint64{200})},
+				}),
+			},
+			{mustNewElement(tag.Rows, []uint64{300})},
+		}),
+	}}
+	itemDelimitation := []byte{0xfe, 0xff, 0x0d, 0xe0}
+	sequenceDelimitation := []byte{0xfe, 0xff, 0xdd, 0xe0}
+
+	write := func(ds Dataset, opts ...WriteOption) []byte {
+		t.Helper()
+		buf := &bytes.Buffer{}
+		if err := Write(buf, ds, opts...); err != nil {
+			t.Fatalf("Write: %v", err)
+		}
+		return buf.Bytes()
+	}
+	parse := func(data []byte) Dataset {
+		t.Helper()
+		parsed, err := Parse(bytes.NewReader(data), int64(len(data)), nil)
+		if err != nil {
+			t.Fatalf("Parse: %v", err)
+		}
+		return parsed
+	}
+
+	undefined := write(ds)
+	if !bytes.Contains(undefined, itemDelimitation) || !bytes.Contains(undefined, sequenceDelimitation) {
+		t.Errorf("Write() without options did not write delimitation items")
+	}
+
+	defined := write(ds, DefinedLengthSequences())
+	if bytes.Contains(defined, itemDelimitation) || bytes.Contains(defined, sequenceDelimitation) {
+		t.Errorf("Write(DefinedLengthSequences()) wrote delimitation items")
+	}
+	definedDS := parse(defined)
+	if !definedDS.Equal(&ds, IgnoreValueLength(), IgnoreGroupLengths()) {
+		t.Errorf("Parse(Write(DefinedLengthSequences())) did not match the written dataset")
+	}
+	seq, err := definedDS.FindElementByTag(tag.AddOtherSequence)
+	if err != nil {
+		t.Fatalf("FindElementByTag(AddOtherSequence): %v", err)
+	}
+	if seq.ValueLength == tag.VLUndefinedLength {
+		t.Errorf("sequence written with DefinedLengthSequences() was parsed with undefined length")
+	}
+
+	if got := write(definedDS, PreserveLengthEncoding()); !bytes.Equal(got, defined) {
+		t.Errorf("Write(PreserveLengthEncoding()) of a defined length dataset changed its encoding")
+	}
+	if got := write(parse(undefined), PreserveLengthEncoding()); !bytes.Equal(got, undefined) {
+		t.Errorf("Write(PreserveLengthEncoding()) of an undefined length dataset changed its encoding")
+	}
+}
+
+func TestWriteDataset(t *testing.T) {
+	ds := Dataset{Elements: []*Element{
+		mustNewElement(tag.ComponentName, []string{"Bob", "Jones"}),
+		makeSequenceElement(tag.AddOtherSequence, [][]*Element{
+			{mustNewElement(tag.Rows, []uint64{100})},
+			{mustNewElement(tag.ComponentName, []string{"Bob"})},
+		}),
+	}}
+	for _, ts := range []string{uid.ImplicitVRLittleEndian, uid.ExplicitVRLittleEndian, uid.ExplicitVRBigEndian} {
+		t.Run(ts, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			if err := WriteDataset(buf, ds, ts); err != nil {
+				t.Fatalf("WriteDataset: %v", err)
+			}
+			if bytes.Contains(buf.Bytes(), []byte(magicWord)) {
+				t.Errorf("WriteDataset wrote a File Meta Information header")
+			}
+			parsed, err := ParseDataset(bytes.NewReader(buf.Bytes()), int64(buf.Len()), ts)
+			if err != nil {
+				t.Fatalf("ParseDataset: %v", err)
+			}
+			if !parsed.Equal(&ds, IgnoreValueLength()) {
+				t.Errorf("ParseDataset(WriteDataset()) did not match the written dataset:\n%v", parsed)
+			}
+		})
+	}
+
+	if err := WriteDataset(&bytes.Buffer{}, ds, "1.2.3"); err == nil {
+		t.Errorf("WriteDataset with an unknown transfer syntax: got no error")
+	}
+}
+
+func TestWrite_SpecificCharacterSet(t *testing.T) {
+	studyDescription := tag.Tag{Group: 0x0008, Element: 0x1030}
+	cases := []struct {
+		specificCharacterSet []string
+		name                 string
+		want                 []byte
+	}{
+		{[]string{"ISO_IR 100"}, "Buc^Jérôme", []byte("Buc^J\xe9r\xf4me")},
+		{[]string{"ISO_IR 100"}, "Jérôme", []byte("J\xe9r\xf4me")},
+		{[]string{"GB18030"}, "Wang^XiaoDong=王^小東=", []byte("Wang^XiaoDong=\xcd\xf5^\xd0\xa1\x96\x7c=")},
+		{[]string{"ISO_IR 192"}, "Wang^XiaoDong=王^小東=", []byte("Wang^XiaoDong=王^小東=")},
+		{
+			[]string{"", "ISO 2022 IR 87"},
+			"Yamada^Tarou=山田^太郎=やまだ^たろう",
+			[]byte("Yamada^Tarou=\x1b$B;3ED\x1b(B^\x1b$BB@O:\x1b(B=\x1b$B$d$^$@\x1b(B^\x1b$B$?$m$&\x1b(B"),
+		},
+		{
+			[]string{"", "ISO 2022 IR 149"},
+			"Hong^Gildong=洪^吉洞=홍^길동",
+			[]byte("Hong^Gildong=\x1b$)C\xfb\xf3^\x1b$)C\xd1\xce\xd4\xd7=\x1b$)C\xc8\xab^\x1b$)C\xb1\xe6\xb5\xbf"),
+		},
+	}
+	for _, tc := range cases {
+		t.Run(strings.Join(tc.specificCharacterSet, "\\")+" "+tc.name, func(t *testing.T) {
+			ds := Dataset{Elements: []*Element{
+				mustNewElement(tag.SpecificCharacterSet, tc.specificCharacterSet),
+				mustNewElement(studyDescription, []string{tc.name}),
+				mustNewElement(tag.ComponentName, []string{tc.name}),
+			}}
+			buf := &bytes.Buffer{}
+			if err := WriteDataset(buf, ds, uid.ExplicitVRLittleEndian); err != nil {
+				t.Fatalf("WriteDataset: %v", err)
+			}
+			if got := bytes.Count(buf.Bytes(), tc.want); got != 2 {
+				t.Errorf("WriteDataset: found %d values encoded as %q in %q, want 2", got, tc.want, buf.Bytes())
+			}
+			parsed, err := ParseDataset(bytes.NewReader(buf.Bytes()), int64(buf.Len()), uid.ExplicitVRLittleEndian)
+			if err != nil {
+				t.Fatalf("ParseDataset: %v", err)
+			}
+			if !parsed.Equal(&ds, IgnoreValueLength()) {
+				t.Errorf("ParseDataset(WriteDataset()) did not match the written dataset:\n%v", parsed)
+			}
+		})
+	}
+}
+
+func TestWrite_UnrepresentableCharacters(t *testing.T) {
+	ds := Dataset{Elements: []*Element{
+		mustNewElement(tag.SpecificCharacterSet, []string{"ISO_IR 100"}),
+		mustNewElement(tag.ComponentName, []string{"Yamada^山田"}),
+	}}
+	if err := WriteDataset(&bytes.Buffer{}, ds, uid.ExplicitVRLittleEndian); !errors.Is(err, charset.ErrorUnrepresentable) {
+		t.Errorf("WriteDataset: got %v, want %v", err, charset.ErrorUnrepresentable)
+	}
+
+	buf := &bytes.Buffer{}
+	if err := WriteDataset(buf, ds, uid.ExplicitVRLittleEndian, ReplaceUnrepresentableCharacters("?")); err != nil {
+		t.Fatalf("WriteDataset: %v", err)
+	}
+	if !bytes.Contains(buf.Bytes(), []byte("Yamada^??")) {
+		t.Errorf("WriteDataset: got %q, want the name with replaced characters", buf.Bytes())
+	}
+
+}
+
+func TestWrite_DefaultCharacterRepertoire(t *testing.T) {
+	for _, specificCharacterSet := range [][]string{nil, {""}} {
+		t.Run(fmt.Sprintf("%q", specificCharacterSet), func(t *testing.T) {
+			ds := Dataset{Elements: []*Element{mustNewElement(tag.ComponentName, []string{"Müller^Jörg"})}}
+			if specificCharacterSet != nil {
+				ds.Set(mustNewElement(tag.SpecificCharacterSet, specificCharacterSet))
+			}
+			if err := WriteDataset(&bytes.Buffer{}, ds, uid.ExplicitVRLittleEndian); !errors.Is(err, charset.ErrorUnrepresentable) {
+				t.Errorf("WriteDataset: got %v, want %v", err, charset.ErrorUnrepresentable)
+			}
+			buf := &bytes.Buffer{}
+			if err := WriteDataset(buf, ds, uid.ExplicitVRLittleEndian, ReplaceUnrepresentableCharacters("?")); err != nil {
+				t.Fatalf("WriteDataset: %v", err)
+			}
+			if !bytes.Contains(buf.Bytes(), []byte("M?ller^J?rg")) {
+				t.Errorf("WriteDataset: got %q, want the name with replaced characters", buf.Bytes())
+			}
+		})
+	}
+}
+
+func TestWrite_UnsupportedCharacterSet(t *testing.T) {
+	for _, specificCharacterSet := range [][]string{
+		{"ISO_IR 1OO"},
+		{"ISO_IR 192", "ISO 2022 IR 87"},
+	} {
+		t.Run(strings.Join(specificCharacterSet, `\`), func(t *testing.T) {
+			ds := Dataset{Elements: []*Element{
+				mustNewElement(tag.SpecificCharacterSet, specificCharacterSet),
+				mustNewElement(tag.ComponentName, []string{"Yamada^Tarou"}),
+			}}
+			// Values in the default character repertoire are unaffected.
+			if err := WriteDataset(&bytes.Buffer{}, ds, uid.ExplicitVRLittleEndian); err != nil {
+				t.Errorf("WriteDataset with ASCII values: %v", err)
+			}
+			ds.Set(mustNewElement(tag.ComponentName, []string{"Yamada^山田"}))
+			if err := WriteDataset(&bytes.Buffer{}, ds, uid.ExplicitVRLittleEndian); !errors.Is(err, charset.ErrorUnknownCharacterSet) {
+				t.Errorf("WriteDataset: got %v, want %v", err, charset.ErrorUnknownCharacterSet)
+			}
+		})
+	}
+}
+
 func TestVerifyVR(t *testing.T) {
 	cases := []struct {
 		name    string
@@ -228,6 +461,37 @@ func TestVerifyVR(t *testing.T) {
 			wantVR:  "UN",
 			wantErr: false,
 		},
+		{
+			name:    "alternative vr",
+			tg:      tag.SmallestImagePixelValue,
+			inVR:    "SS",
+			wantVR:  "SS",
+			wantErr: false,
+		},
+		{
+			name:    "vr not among alternatives",
+			tg:      tag.SmallestImagePixelValue,
+			inVR:    "UL",
+			wantVR:  "",
+			wantErr: true,
+		},
+		{
+			name:    "repeating group",
+			tg:      tag.Tag{Group: 0x6002, Element: 0x3000},
+			inVR:    "OB",
+			wantVR:  "OB",
+			wantErr: false,
+		},
+		{
+			name: "made up tag with vr",
+			tg: tag.Tag{
+				Group:   0x0029,
+				Element: 0x1010,
+			},
+			inVR:    "LO",
+			wantVR:  "LO",
+			wantErr: false,
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -317,3 +581,37 @@ func TestWriteFloats(t *testing.T) {
 	}
 
 }
+
+func BenchmarkWrite(b *testing.B) {
+	files, err := ioutil.ReadDir("./testfiles")
+	if err != nil {
+		b.Fatalf("unable to read testfiles/: %v", err)
+	}
+	for _, f := range files {
+		if !f.IsDir() && strings.HasSuffix(f.Name(), ".dcm") {
+			b.Run(f.Name(), func(b *testing.B) {
+				dcm, err := os.Open("./testfiles/" + f.Name())
+				if err != nil {
+					b.Fatalf("Unable to open %s. Error: %v", f.Name(), err)
+				}
+				defer dcm.Close()
+				info, err := dcm.Stat()
+				if err != nil {
+					b.Fatalf("Unable to stat %s. Error: %v", f.Name(), err)
+				}
+				ds, err := Parse(dcm, info.Size(), nil)
+				if err != nil {
+					b.Fatalf("Unable to parse %s. Error: %v", f.Name(), err)
+				}
+
+				b.ReportAllocs()
+				b.ResetTimer()
+				for i := 0; i < b.N; i++ {
+					if err := Write(ioutil.Discard, ds, SkipVRVerification()); err != nil {
+						b.Fatalf("Unable to write %s. Error: %v", f.Name(), err)
+					}
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,384 @@
+package dicom
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/ginuerzh/dicom/pkg/dicomio"
+	"github.com/ginuerzh/dicom/pkg/tag"
+)
+
+var (
+	// ErrorWriterState indicates that a Writer method was called out of order,
+	// for example EndItem without a matching BeginItem.
+	ErrorWriterState = errors.New("writer method called in an invalid state")
+	// ErrorElementOrder indicates that an element was written with a tag that
+	// is not greater than the previous tag at the same nesting level.
+	ErrorElementOrder = errors.New("elements must be written in ascending tag order")
+	// ErrorFrameSize indicates that a native frame passed to a
+	// PixelDataStreamer does not have the size declared when it was created.
+	ErrorFrameSize = errors.New("frame does not have the declared size")
+	// ErrorPixelDataLength indicates that the frame size or number of frames
+	// passed to BeginNativePixelData is negative, or that the PixelData they
+	// add up to does not fit a 32-bit value length.
+	ErrorPixelDataLength = errors.New("invalid native PixelData length")
+)
+
+// Writer writes a DICOM element by element, so that a Dataset never needs to
+// be held in memory in full. Create one with NewWriter, then write elements in
+// ascending tag order with WriteElement, nest them with BeginSequence,
+// BeginItem, EndItem and EndSequence, and stream PixelData frames one at a time
+// with BeginNativePixelData or BeginEncapsulatedPixelData.
+//
+// Sequences and items are always written with undefined length.
+type Writer struct {
+	out  io.Writer
+	cw   *countingWriter
+	w    dicomio.Writer
+	opts writeOptSet
+	// levels holds the state of the top-level dataset followed by each
+	// currently open sequence or item.
+	levels []*writerLevel
+	pixels *PixelDataStreamer
+}
+
+type writerLevel struct {
+	isSequence bool
+	hasLastTag bool
+	lastTag    tag.Tag
+	// items is the number of items begun in a sequence.
+	items int
+}
+
+// NewWriter writes the preamble and File Meta Information header built from
+// the metadata group (0002,xxxx) elements of meta to out, and returns a Writer
+// for the rest of the dataset. Other elements in meta are only used to
+// populate and validate the File Meta Information (see
+// PopulateFileMetaInformation). The transfer syntax of the body is taken from
+// meta's TransferSyntaxUID.
+func NewWriter(out io.Writer, meta Dataset, opts ...WriteOption) (*Writer, error) {
+	optSet := toOptSet(opts...)
+	optSet.raw = meta.raw.forWrite()
+	cw := &countingWriter{out: out}
+	w := dicomio.NewWriter(cw, nil, false)
+
+	elems, err := orderedElements(meta.Elements, *optSet)
+	if err != nil {
+		return nil, err
+	}
+	var metaElems []*Element
+	for _, elem := range elems {
+		if elem.Tag.Group == tag.MetadataGroup {
+			metaElems = append(metaElems, elem)
+		}
+	}
+	if err := writeFileHeader(w, &meta, metaElems, *optSet); err != nil {
+		return nil, err
+	}
+
+	endian, implicit, err := meta.transferSyntax()
+	if (err != nil && err != ErrorElementNotFound) || (err == ErrorElementNotFound && !optSet.defaultMissingTransferSyntax) {
+		return nil, err
+	}
+	if err == ErrorElementNotFound && optSet.defaultMissingTransferSyntax {
+		w.SetTransferSyntax(binary.LittleEndian, true)
+	} else {
+		w.SetTransferSyntax(endian, implicit)
+	}
+	return newWriter(out, cw, w, *optSet), nil
+}
+
+// newWriter returns a Writer for the elements of a dataset, written with w to
+// cw, which counts the bytes written to out.
+func newWriter(out io.Writer, cw *countingWriter, w dicomio.Writer, opts writeOptSet) *Writer {
+	// Text is limited to the default character repertoire until a
+	// SpecificCharacterSet is written.
+	w.SetEncoder(characterSetEncoder(nil, opts))
+	return &Writer{
+		out:    out,
+		cw:     cw,
+		w:      w,
+		opts:   opts,
+		levels: []*writerLevel{{}},
+	}
+}
+
+// WriteElement writes a complete element, including any nested sequence
+// items, at the current nesting level.
+func (w *Writer) WriteElement(elem *Element) error {
+	if err := w.checkNext(elem.Tag, false); err != nil {
+		return err
+	}
+	opts := w.opts
+	if opts.validateValues {
+		opts.items = w.items()
+	}
+	if err := writeElement(w.w, elem, opts); err != nil {
+		return err
+	}
+	if elem.Tag == tag.SpecificCharacterSet && len(w.levels) == 1 {
+		w.w.SetEncoder(characterSetEncoder(elem, w.opts))
+	}
+	return nil
+}
+
+// BeginSequence starts an undefined length sequence element with tag t.
+// Items are then added with BeginItem and EndItem, and the sequence is
+// finished with EndSequence.
+func (w *Writer) BeginSequence(t tag.Tag) error {
+	if err := w.checkNext(t, false); err != nil {
+		return err
+	}
+	if err := encodeElementHeader(w.w, t, "SQ", tag.VLUndefinedLength); err != nil {
+		return err
+	}
+	w.levels = append(w.levels, &writerLevel{isSequence: true})
+	return nil
+}
+
+// BeginItem starts a new undefined length item in the current sequence.
+func (w *Writer) BeginItem() error {
+	if w.pixels != nil || !w.top().isSequence {
+		return fmt.Errorf("%w: BeginItem outside of a sequence", ErrorWriterState)
+	}
+	if err := writeElement(w.w, item, w.opts); err != nil {
+		return err
+	}
+	w.top().items++
+	w.levels = append(w.levels, &writerLevel{})
+	return nil
+}
+
+// EndItem finishes the current item.
+func (w *Writer) EndItem() error {
+	if w.pixels != nil || len(w.levels) < 2 || w.top().isSequence {
+		return fmt.Errorf("%w: EndItem without BeginItem", ErrorWriterState)
+	}
+	if err := writeElement(w.w, sequenceItemDelimitationItem, w.opts); err != nil {
+		return err
+	}
+	w.levels = w.levels[:len(w.levels)-1]
+	return nil
+}
+
+// EndSequence finishes the current sequence.
+func (w *Writer) EndSequence() error {
+	if w.pixels != nil || !w.top().isSequence {
+		return fmt.Errorf("%w: EndSequence without BeginSequence", ErrorWriterState)
+	}
+	// Write Sequence Delimitation Item as implicit VR
+	oldBO, oldImplicit := w.w.GetTransferSyntax()
+	w.w.SetTransferSyntax(oldBO, true)
+	err := writeElement(w.w, sequenceDelimitationItem, w.opts)
+	w.w.SetTransferSyntax(oldBO, oldImplicit)
+	if err != nil {
+		return err
+	}
+	w.levels = w.levels[:len(w.levels)-1]
+	return nil
+}
+
+// BeginNativePixelData starts a native (uncompressed) PixelData element with
+// the provided VR ("OB" or "OW") that will hold numFrames frames of frameSize
+// bytes each. Frames are then written with the returned streamer's
+// WriteFrame, and the element is finished with its Close method.
+//
+// Native PixelData has an explicit 32-bit value length, so it returns
+// ErrorPixelDataLength if the frames add up to 0xFFFFFFFF bytes or more
+// (after padding to even length).
+func (w *Writer) BeginNativePixelData(vr string, frameSize, numFrames int) (*PixelDataStreamer, error) {
+	if frameSize < 0 || numFrames < 0 {
+		return nil, fmt.Errorf("%w: frame size %d, %d frames", ErrorPixelDataLength, frameSize, numFrames)
+	}
+	const maxLength = uint64(tag.VLUndefinedLength) - 1
+	if numFrames > 0 && uint64(frameSize) > maxLength/uint64(numFrames) {
+		return nil, fmt.Errorf("%w: %d frames of %d bytes exceed %d bytes", ErrorPixelDataLength, numFrames, frameSize, maxLength)
+	}
+	if err := w.checkNext(tag.PixelData, true); err != nil {
+		return nil, err
+	}
+	length := uint32(frameSize) * uint32(numFrames)
+	if err := encodeElementHeader(w.w, tag.PixelData, vr, length+length%2); err != nil {
+		return nil, err
+	}
+	w.pixels = &PixelDataStreamer{w: w, frameSize: frameSize, numFrames: numFrames}
+	return w.pixels, nil
+}
+
+// BeginEncapsulatedPixelData starts an encapsulated PixelData element whose
+// frames are written one per item with the returned streamer's WriteFrame.
+//
+// If numFrames is greater than zero and the underlying io.Writer is an
+// io.WriteSeeker, space for the Basic Offset Table is reserved and filled in
+// when the streamer is closed. Otherwise an empty Basic Offset Table is
+// written.
+func (w *Writer) BeginEncapsulatedPixelData(numFrames int) (*PixelDataStreamer, error) {
+	if err := w.checkNext(tag.PixelData, true); err != nil {
+		return nil, err
+	}
+	if err := encodeElementHeader(w.w, tag.PixelData, "OB", tag.VLUndefinedLength); err != nil {
+		return nil, err
+	}
+	p := &PixelDataStreamer{w: w, encapsulated: true, numFrames: numFrames}
+	seeker, ok := w.out.(io.WriteSeeker)
+	if !ok || numFrames <= 0 {
+		if err := writeBasicOffsetTable(w.w, nil); err != nil {
+			return nil, err
+		}
+	} else {
+		// Remember where the offsets go, and reserve room for them.
+		if err := writeItemHeader(w.w, uint32(4*numFrames)); err != nil {
+			return nil, err
+		}
+		pos, err := seeker.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return nil, err
+		}
+		if err := w.w.WriteZeros(4 * numFrames); err != nil {
+			return nil, err
+		}
+		p.seeker, p.offsetTablePos = seeker, pos
+	}
+	p.firstFramePos = w.cw.n
+	w.pixels = p
+	return p, nil
+}
+
+func (w *Writer) top() *writerLevel {
+	return w.levels[len(w.levels)-1]
+}
+
+// items returns the sequence items enclosing the current level. The tag of
+// each sequence is the last tag written at the level it was begun in.
+func (w *Writer) items() []PathItem {
+	var items []PathItem
+	for i := 1; i < len(w.levels); i++ {
+		if seq := w.levels[i]; seq.isSequence && i+1 < len(w.levels) {
+			items = append(items, PathItem{Sequence: w.levels[i-1].lastTag, Index: seq.items - 1})
+		}
+	}
+	return items
+}
+
+// checkNext verifies that an element with tag t may be written next.
+func (w *Writer) checkNext(t tag.Tag, pixelData bool) error {
+	if w.pixels != nil {
+		return fmt.Errorf("%w: PixelData streamer not closed", ErrorWriterState)
+	}
+	level := w.top()
+	if level.isSequence {
+		return fmt.Errorf("%w: element %v written directly inside a sequence, use BeginItem", ErrorWriterState, t)
+	}
+	if !w.opts.skipElementSorting && level.hasLastTag && level.lastTag.Compare(t) >= 0 {
+		return fmt.Errorf("%w: %v after %v", ErrorElementOrder, t, level.lastTag)
+	}
+	level.hasLastTag, level.lastTag = true, t
+	return nil
+}
+
+// PixelDataStreamer writes the frames of a PixelData element one at a time.
+// It is returned by Writer.BeginNativePixelData and
+// Writer.BeginEncapsulatedPixelData.
+type PixelDataStreamer struct {
+	w            *Writer
+	encapsulated bool
+	frameSize    int
+	numFrames    int
+	written      int
+
+	seeker         io.WriteSeeker
+	offsetTablePos int64
+	firstFramePos  int64
+	offsets        []uint32
+}
+
+// WriteFrame writes the next frame. For native PixelData, data must be
+// exactly the frame size declared in BeginNativePixelData. For encapsulated
+// PixelData, data holds one compressed frame.
+func (p *PixelDataStreamer) WriteFrame(data []byte) error {
+	if p.w.pixels != p {
+		return fmt.Errorf("%w: PixelData streamer already closed", ErrorWriterState)
+	}
+	if !p.encapsulated {
+		if len(data) != p.frameSize || p.written >= p.numFrames {
+			return ErrorFrameSize
+		}
+		p.written++
+		return p.w.w.WriteBytes(data)
+	}
+	p.offsets = append(p.offsets, uint32(p.w.cw.n-p.firstFramePos))
+	p.written++
+	if len(data)%2 == 1 {
+		// Items must have an even length.
+		data = append(data[:len(data):len(data)], 0)
+	}
+	return writeRawItem(p.w.w, data)
+}
+
+// Close finishes the PixelData element, filling in the Basic Offset Table if
+// space for it was reserved.
+func (p *PixelDataStreamer) Close() error {
+	if p.w.pixels != p {
+		return fmt.Errorf("%w: PixelData streamer already closed", ErrorWriterState)
+	}
+	p.w.pixels = nil
+	if !p.encapsulated {
+		if p.written != p.numFrames {
+			return fmt.Errorf("%w: wrote %d of %d frames", ErrorWriterState, p.written, p.numFrames)
+		}
+		if (p.frameSize*p.numFrames)%2 == 1 {
+			return p.w.w.WriteByte(0)
+		}
+		return nil
+	}
+
+	if err := encodeElementHeader(p.w.w, tag.SequenceDelimitationItem, "", 0); err != nil {
+		return err
+	}
+	if p.seeker == nil {
+		return nil
+	}
+	if len(p.offsets) != p.numFrames {
+		return fmt.Errorf("%w: wrote %d of %d frames", ErrorWriterState, len(p.offsets), p.numFrames)
+	}
+	end, err := p.seeker.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	if _, err := p.seeker.Seek(p.offsetTablePos, io.SeekStart); err != nil {
+		return err
+	}
+	bo, _ := p.w.w.GetTransferSyntax()
+	table := make([]byte, 4*len(p.offsets))
+	for i, o := range p.offsets {
+		bo.PutUint32(table[4*i:], o)
+	}
+	if _, err := p.seeker.Write(table); err != nil {
+		return err
+	}
+	_, err = p.seeker.Seek(end, io.SeekStart)
+	return err
+}
+
+// countingWriter counts the bytes written through it, which is used to
+// compute Basic Offset Table entries.
+type countingWriter struct {
+	out io.Writer
+	n   int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.out.Write(p)
+	c.n += int64(n)
+	return n, err
+}
+
+// WriteString lets dicomio.Writer write strings without converting them to
+// a []byte when out supports it.
+func (c *countingWriter) WriteString(s string) (int, error) {
+	n, err := io.WriteString(c.out, s)
+	c.n += int64(n)
+	return n, err
+}
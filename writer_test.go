@@ -0,0 +1,187 @@
+package dicom
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/ginuerzh/dicom/pkg/frame"
+	"github.com/ginuerzh/dicom/pkg/tag"
+	"github.com/ginuerzh/dicom/pkg/uid"
+)
+
+func writerTestMeta(ts string) Dataset {
+	return Dataset{Elements: []*Element{
+		mustNewElement(tag.MediaStorageSOPClassUID, []string{"1.2.840.10008.5.1.4.1.1.1.2"}),
+		mustNewElement(tag.MediaStorageSOPInstanceUID, []string{"1.2.3.4.5.6.7"}),
+		mustNewElement(tag.TransferSyntaxUID, []string{ts}),
+	}}
+}
+
+func TestWriter(t *testing.T) {
+	buf := &bytes.Buffer{}
+	w, err := NewWriter(buf, writerTestMeta(uid.ExplicitVRLittleEndian))
+	if err != nil {
+		t.Fatalf("NewWriter: %v", err)
+	}
+	mustWrite := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	mustWrite(w.WriteElement(mustNewElement(tag.ComponentName, []string{"Bob", "Jones"})))
+	mustWrite(w.WriteElement(mustNewElement(tag.SamplesPerPixel, []uint64{1})))
+	mustWrite(w.WriteElement(mustNewElement(tag.NumberOfFrames, []string{"2"})))
+	mustWrite(w.WriteElement(mustNewElement(tag.Rows, []uint64{2})))
+	mustWrite(w.WriteElement(mustNewElement(tag.Columns, []uint64{2})))
+	mustWrite(w.WriteElement(mustNewElement(tag.BitsAllocated, []uint64{8})))
+	mustWrite(w.BeginSequence(tag.AddOtherSequence))
+	for i := 0; i < 2; i++ {
+		mustWrite(w.BeginItem())
+		mustWrite(w.WriteElement(mustNewElement(tag.Rows, []uint64{uint64(100 + i)})))
+		mustWrite(w.EndItem())
+	}
+	mustWrite(w.EndSequence())
+	p, err := w.BeginNativePixelData("OB", 4, 2)
+	mustWrite(err)
+	if err := w.WriteElement(mustNewElement(tag.Rows, []uint64{2})); !errors.Is(err, ErrorWriterState) {
+		t.Errorf("WriteElement while streaming PixelData: got %v, want %v", err, ErrorWriterState)
+	}
+	mustWrite(p.WriteFrame([]byte{1, 2, 3, 4}))
+	if err := p.WriteFrame([]byte{1, 2}); !errors.Is(err, ErrorFrameSize) {
+		t.Errorf("WriteFrame with short frame: got %v, want %v", err, ErrorFrameSize)
+	}
+	mustWrite(p.WriteFrame([]byte{5, 6, 7, 8}))
+	mustWrite(p.Close())
+
+	ds, err := Parse(buf, int64(buf.Len()), nil)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	seq, err := ds.FindElementByTag(tag.AddOtherSequence)
+	if err != nil {
+		t.Fatalf("FindElementByTag(AddOtherSequence): %v", err)
+	}
+	if items := seq.Value.GetValue().([]*SequenceItemValue); len(items) != 2 {
+		t.Errorf("got %d sequence items, want 2", len(items))
+	}
+	pixelData, err := ds.FindElementByTag(tag.PixelData)
+	if err != nil {
+		t.Fatalf("FindElementByTag(PixelData): %v", err)
+	}
+	info := MustGetPixelDataInfo(pixelData.Value)
+	if len(info.Frames) != 2 {
+		t.Fatalf("got %d frames, want 2", len(info.Frames))
+	}
+	if got := info.Frames[1].NativeData.Data[1]; got != 6 {
+		t.Errorf("unexpected pixel value in frame 1: got %d, want 6", got)
+	}
+}
+
+func TestWriter_ElementOrder(t *testing.T) {
+	w, err := NewWriter(ioutil.Discard, writerTestMeta(uid.ImplicitVRLittleEndian))
+	if err != nil {
+		t.Fatalf("NewWriter: %v", err)
+	}
+	if err := w.WriteElement(mustNewElement(tag.Columns, []uint64{2})); err != nil {
+		t.Fatalf("WriteElement: %v", err)
+	}
+	if err := w.WriteElement(mustNewElement(tag.Rows, []uint64{2})); !errors.Is(err, ErrorElementOrder) {
+		t.Errorf("WriteElement out of order: got %v, want %v", err, ErrorElementOrder)
+	}
+	if err := w.EndItem(); !errors.Is(err, ErrorWriterState) {
+		t.Errorf("EndItem without BeginItem: got %v, want %v", err, ErrorWriterState)
+	}
+}
+
+func TestWriter_NativePixelDataLength(t *testing.T) {
+	cases := []struct {
+		name                 string
+		frameSize, numFrames int
+	}{
+		{name: "4 GiB", frameSize: 1 << 30, numFrames: 4},
+		{name: "undefined length", frameSize: 0x55555555, numFrames: 3},
+		{name: "product overflows", frameSize: 1 << 30, numFrames: 1 << 30},
+		{name: "negative frame size", frameSize: -1, numFrames: 1},
+		{name: "negative frames", frameSize: 1, numFrames: -1},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			w, err := NewWriter(ioutil.Discard, writerTestMeta(uid.ImplicitVRLittleEndian))
+			if err != nil {
+				t.Fatalf("NewWriter: %v", err)
+			}
+			if _, err := w.BeginNativePixelData("OW", tc.frameSize, tc.numFrames); !errors.Is(err, ErrorPixelDataLength) {
+				t.Errorf("BeginNativePixelData(%d, %d): got %v, want %v", tc.frameSize, tc.numFrames, err, ErrorPixelDataLength)
+			}
+		})
+	}
+}
+
+func TestWriter_EncapsulatedOffsetTable(t *testing.T) {
+	file, err := ioutil.TempFile("", "writer_test")
+	if err != nil {
+		t.Fatalf("TempFile: %v", err)
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+
+	w, err := NewWriter(file, writerTestMeta(uid.ExplicitVRLittleEndian))
+	if err != nil {
+		t.Fatalf("NewWriter: %v", err)
+	}
+	p, err := w.BeginEncapsulatedPixelData(2)
+	if err != nil {
+		t.Fatalf("BeginEncapsulatedPixelData: %v", err)
+	}
+	frames := [][]byte{{1, 2, 3}, {4, 5, 6, 7}}
+	for _, f := range frames {
+		if err := p.WriteFrame(f); err != nil {
+			t.Fatalf("WriteFrame: %v", err)
+		}
+	}
+	if err := p.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	data, err := ioutil.ReadFile(file.Name())
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	header := []byte{0xe0, 0x7f, 0x10, 0x00, 'O', 'B', 0, 0, 0xff, 0xff, 0xff, 0xff, 0xfe, 0xff, 0x00, 0xe0, 8, 0, 0, 0}
+	i := bytes.Index(data, header)
+	if i < 0 {
+		t.Fatalf("PixelData header with an 8 byte offset table not found")
+	}
+	table := data[i+len(header) : i+len(header)+8]
+	// The first frame is padded to 4 bytes and has an 8 byte item header.
+	if got := []uint32{binary.LittleEndian.Uint32(table), binary.LittleEndian.Uint32(table[4:])}; got[0] != 0 || got[1] != 12 {
+		t.Errorf("unexpected offset table: got %v, want [0 12]", got)
+	}
+
+	ds, err := Parse(bytes.NewReader(data), int64(len(data)), nil)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	pixelData, err := ds.FindElementByTag(tag.PixelData)
+	if err != nil {
+		t.Fatalf("FindElementByTag(PixelData): %v", err)
+	}
+	info := MustGetPixelDataInfo(pixelData.Value)
+	want := []frame.Frame{
+		{Encapsulated: true, EncapsulatedData: frame.EncapsulatedFrame{Data: []byte{1, 2, 3, 0}}},
+		{Encapsulated: true, EncapsulatedData: frame.EncapsulatedFrame{Data: []byte{4, 5, 6, 7}}},
+	}
+	if len(info.Frames) != len(want) {
+		t.Fatalf("got %d frames, want %d", len(info.Frames), len(want))
+	}
+	for i := range want {
+		if !bytes.Equal(info.Frames[i].EncapsulatedData.Data, want[i].EncapsulatedData.Data) {
+			t.Errorf("frame %d: got %v, want %v", i, info.Frames[i].EncapsulatedData.Data, want[i].EncapsulatedData.Data)
+		}
+	}
+}